@@ -8,7 +8,24 @@ const (
 )
 
 type Payments struct {
-	AccountNumber string `toml:"account_number"`
+	Provider            string  `toml:"provider"`
+	AccountNumber       string  `toml:"account_number"`
+	NotificationSecret  string  `toml:"notification_secret"`
+	AccessToken         string  `toml:"access_token"`
+	FakeWebhookUrl      string  `toml:"fake_webhook_url"`
+	RenewalNoticeHours  int     `toml:"renewal_notice_hours"`
+	GracePeriodHours    int     `toml:"grace_period_hours"`
+	RenewalCheckMinutes int     `toml:"renewal_check_minutes"`
+	PlatformFeePercent  int     `toml:"platform_fee_percent"`
+	PayoutAdmins        []int64 `toml:"payout_admins"`
+	MinTipAmount        int64   `toml:"min_tip_amount"`
+	// ExchangeRates prices of one unit of currency in models.DefaultCurrency, like USD = "74.35"
+	ExchangeRates map[string]string `toml:"exchange_rates"`
+	// CheckoutSweepMinutes how often not paid checkouts older than CheckoutTTLMinutes are expired,
+	// with SweeperDryRun they are only counted
+	CheckoutSweepMinutes int  `toml:"checkout_sweep_minutes"`
+	CheckoutTTLMinutes   int  `toml:"checkout_ttl_minutes"`
+	SweeperDryRun        bool `toml:"sweeper_dry_run"`
 }
 
 type Microservice struct {
@@ -31,6 +48,8 @@ type Config struct {
 	LocalRepository  RepositoryConnections `toml:"local"`
 	Cors             internal.CorsConfig   `toml:"cors"`
 	PaymentsInfo     Payments              `toml:"payments"`
+	// PublishCheckMinutes how often scheduled posts are checked for publication
+	PublishCheckMinutes int `toml:"publish_check_minutes"`
 }
 
 func NewConfig() *Config {
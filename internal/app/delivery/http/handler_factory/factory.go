@@ -5,21 +5,34 @@ import (
 	"patreon/internal/app/delivery/http/handlers/creator_handler"
 	search_creators_handler "patreon/internal/app/delivery/http/handlers/creator_handler/search_creators"
 	"patreon/internal/app/delivery/http/handlers/creator_handler/subscribe_handler"
+	subscribers_events_handler "patreon/internal/app/delivery/http/handlers/creator_handler/subscribe_handler/events_handler"
 	"patreon/internal/app/delivery/http/handlers/creator_id_handler"
 	"patreon/internal/app/delivery/http/handlers/creator_id_handler/aw_handler"
 	"patreon/internal/app/delivery/http/handlers/creator_id_handler/aw_id_handler"
+	aw_gifts_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/aw_id_handler/gifts_handler"
 	aw_subscribe_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/aw_id_handler/subscribe_handler"
+	aw_trial_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/aw_id_handler/trial_handler"
 	aw_upd_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/aw_id_handler/upd_aw_handler"
 	upd_cover_awards_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/aw_id_handler/upd_cover_awards"
+	aw_waitlist_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/aw_id_handler/waitlist_handler"
+	"patreon/internal/app/delivery/http/handlers/creator_id_handler/balance_handler"
+	"patreon/internal/app/delivery/http/handlers/creator_id_handler/blocked_users_handler"
+	"patreon/internal/app/delivery/http/handlers/creator_id_handler/ledger_handler"
 	creator_payments_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/payments_handler"
+	payments_export_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/payments_handler/export_handler"
+	payments_refund_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/payments_handler/refund_handler"
+	payments_totals_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/payments_handler/totals_handler"
+	creator_payouts_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/payouts_handler"
 	"patreon/internal/app/delivery/http/handlers/creator_id_handler/posts_handler"
 	"patreon/internal/app/delivery/http/handlers/creator_id_handler/posts_id_handler"
 	"patreon/internal/app/delivery/http/handlers/creator_id_handler/posts_id_handler/attaches_handler"
 	"patreon/internal/app/delivery/http/handlers/creator_id_handler/posts_id_handler/attaches_handler/upl_audio_attach_handler"
 	"patreon/internal/app/delivery/http/handlers/creator_id_handler/posts_id_handler/attaches_handler/upl_img_attach_handler"
+	"patreon/internal/app/delivery/http/handlers/creator_id_handler/posts_id_handler/attaches_handler/upl_poll_attach_handler"
 	"patreon/internal/app/delivery/http/handlers/creator_id_handler/posts_id_handler/attaches_handler/upl_text_attach_handler"
 	"patreon/internal/app/delivery/http/handlers/creator_id_handler/posts_id_handler/attaches_handler/upl_video_attach_handler"
 	"patreon/internal/app/delivery/http/handlers/creator_id_handler/posts_id_handler/attaches_id_handler"
+	"patreon/internal/app/delivery/http/handlers/creator_id_handler/posts_id_handler/attaches_id_handler/poll_handler"
 	upd_audio_attach_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/posts_id_handler/attaches_id_handler/upd_audio_post_handler"
 	upd_img_data_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/posts_id_handler/attaches_id_handler/upd_image_post_handler"
 	upd_text_data_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/posts_id_handler/attaches_id_handler/upd_text_post_handler"
@@ -27,29 +40,47 @@ import (
 	comments_id_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/posts_id_handler/comment_id_handler"
 	comments_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/posts_id_handler/comments_handler"
 	"patreon/internal/app/delivery/http/handlers/creator_id_handler/posts_id_handler/likes_handler"
+	post_revisions_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/posts_id_handler/revisions_handler"
+	revisions_diff_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/posts_id_handler/revisions_handler/diff_handler"
+	revisions_restore_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/posts_id_handler/revisions_handler/restore_handler"
+	posts_tips_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/posts_id_handler/tips_handler"
+	posts_unlock_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/posts_id_handler/unlock_handler"
 	upl_cover_posts_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/posts_id_handler/upd_cover_post_handler"
 	posts_upd_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/posts_id_handler/upd_handler"
+	"patreon/internal/app/delivery/http/handlers/creator_id_handler/promo_codes_handler"
+	"patreon/internal/app/delivery/http/handlers/creator_id_handler/promo_codes_id_handler"
 	statistics_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/statistics_handler/creator_subscribers_handler"
 	statistics_total_income_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/statistics_handler/creator_total_income_handler"
+	statistics_trials_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/statistics_handler/creator_trials_handler"
 	statistics_count_posts_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/statistics_handler/posts_handler/creator_count_posts_handler"
 	statistics_count_posts_views_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/statistics_handler/posts_handler/creator_count_posts_views_handler"
+	"patreon/internal/app/delivery/http/handlers/creator_id_handler/subscription_handler"
+	"patreon/internal/app/delivery/http/handlers/creator_id_handler/tags_handler"
+	"patreon/internal/app/delivery/http/handlers/creator_id_handler/tips_handler"
 	upd_avatar_creator_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/upd_avatar_handler"
 	upd_cover_creator_handler "patreon/internal/app/delivery/http/handlers/creator_id_handler/upd_cover_handler"
 	"patreon/internal/app/delivery/http/handlers/csrf_handler"
 	"patreon/internal/app/delivery/http/handlers/info_handler"
 	"patreon/internal/app/delivery/http/handlers/login_handler"
 	"patreon/internal/app/delivery/http/handlers/logout_handler"
+	"patreon/internal/app/delivery/http/handlers/payouts_handler"
 	"patreon/internal/app/delivery/http/handlers/profile_handler"
+	"patreon/internal/app/delivery/http/handlers/profile_handler/gifts_handler"
+	"patreon/internal/app/delivery/http/handlers/profile_handler/gifts_handler/redeem_handler"
 	"patreon/internal/app/delivery/http/handlers/profile_handler/payments_handler"
 	pay_account_handler "patreon/internal/app/delivery/http/handlers/profile_handler/payments_handler/account_handler"
+	pay_checkout_handler "patreon/internal/app/delivery/http/handlers/profile_handler/payments_handler/checkout_handler"
+	pay_checkouts_handler "patreon/internal/app/delivery/http/handlers/profile_handler/payments_handler/checkouts_handler"
 	pay_token_handler "patreon/internal/app/delivery/http/handlers/profile_handler/payments_handler/token_handler"
 	"patreon/internal/app/delivery/http/handlers/profile_handler/subscriptions_handler"
+	subscriptions_history_handler "patreon/internal/app/delivery/http/handlers/profile_handler/subscriptions_handler/history_handler"
 	"patreon/internal/app/delivery/http/handlers/profile_handler/update_handler/avatar_handler"
 	"patreon/internal/app/delivery/http/handlers/profile_handler/update_handler/nickname_handler"
 	"patreon/internal/app/delivery/http/handlers/profile_handler/update_handler/password_handler"
 	"patreon/internal/app/delivery/http/handlers/profile_handler/user_comments_handler"
 	"patreon/internal/app/delivery/http/handlers/profile_handler/user_posts_handler"
 	"patreon/internal/app/delivery/http/handlers/register_handler"
+	"patreon/internal/app/delivery/http/handlers/search_posts_handler"
 	"patreon/internal/microservices/auth/delivery/grpc/client"
 
 	"google.golang.org/grpc"
@@ -107,6 +138,38 @@ const (
 	USER_PAYMENTS_TOKEN
 	PAYMENTS_ACCOUNT
 	CREATOR_PAYMENTS
+	USER_PAYMENTS_CHECKOUT
+	USER_PAYMENTS_CHECKOUTS
+	CREATOR_SUBSCRIPTION
+	CREATOR_BALANCE
+	CREATOR_LEDGER
+	CREATOR_PAYOUTS
+	PAYOUT_WITH_ID
+	CREATOR_PROMO_CODES
+	CREATOR_PROMO_CODE_WITH_ID
+	AWARDS_GIFTS
+	USER_GIFTS
+	USER_GIFTS_REDEEM
+	AWARDS_TRIAL
+	STATS_TRIALS
+	CREATOR_TIPS
+	POST_TIPS
+	POST_UNLOCK
+	CREATOR_PAYMENTS_EXPORT
+	CREATOR_PAYMENTS_TOTALS
+	USER_SUBSCRIPTIONS_HISTORY
+	SUBSCRIBERS_EVENTS
+	AWARDS_WAITLIST
+	CREATOR_BLOCKED_USERS
+	POST_REVISIONS
+	POST_REVISIONS_DIFF
+	POST_REVISION_RESTORE
+	CREATOR_TAGS
+	SEARCH_POSTS
+	CREATOR_SEARCH_POSTS
+	ATTACH_ADD_POLL
+	ATTACH_POLL
+	CREATOR_PAYMENTS_REFUND
 )
 
 type HandlerFactory struct {
@@ -139,56 +202,96 @@ func (f *HandlerFactory) initAllHandlers() map[int]app.Handler {
 	sManager := client.NewSessionClient(f.sessionClientConn)
 	ucStats := f.usecaseFactory.GetStatsUsecase()
 	ucPayToken := f.usecaseFactory.GetPayTokenUsecase()
+	ucLedger := f.usecaseFactory.GetLedgerUsecase()
+	ucPromoCodes := f.usecaseFactory.GetPromoCodesUsecase()
+	ucGifts := f.usecaseFactory.GetGiftsUsecase()
+	ucTips := f.usecaseFactory.GetTipsUsecase()
+	ucPostUnlocks := f.usecaseFactory.GetPostUnlocksUsecase()
+	ucBlocked := f.usecaseFactory.GetBlockedUsersUsecase()
+	ucRevisions := f.usecaseFactory.GetPostRevisionsUsecase()
+	ucPolls := f.usecaseFactory.GetPollsUsecase()
 
 	return map[int]app.Handler{
-		INFO:                     info_handler.NewInfoHandler(f.logger, ucInfo),
-		REGISTER:                 register_handler.NewRegisterHandler(f.logger, sManager, ucUser),
-		LOGIN:                    login_handler.NewLoginHandler(f.logger, sManager, ucUser),
-		LOGOUT:                   logout_handler.NewLogoutHandler(f.logger, sManager),
-		PROFILE:                  profile_handler.NewProfileHandler(f.logger, sManager, ucUser),
-		CREATORS:                 creator_handler.NewCreatorHandler(f.logger, sManager, ucCreator, ucUser),
-		CREATOR_WITH_ID:          creator_id_handler.NewCreatorIdHandler(f.logger, sManager, ucCreator),
-		SEARCH_CREATORS:          search_creators_handler.NewCreatorHandler(f.logger, sManager, ucCreator),
-		UPDATE_PASSWORD:          password_handler.NewUpdatePasswordHandler(f.logger, sManager, ucUser),
-		UPDATE_AVATAR:            avatar_handler.NewUpdateAvatarHandler(f.logger, sManager, ucUser),
-		UPDATE_NICKNAME:          nickname_handler.NewUpdateNicknameHandler(f.logger, sManager, ucUser),
-		AWARDS:                   aw_handler.NewAwardsHandler(f.logger, ucAwards, sManager),
-		AWARDS_WITH_ID:           aw_id_handler.NewAwardsIdHandler(f.logger, ucAwards, sManager),
-		AWARDS_UPDATE:            aw_upd_handler.NewAwardsUpdHandler(f.logger, ucAwards, sManager),
-		POSTS:                    posts_handler.NewPostsHandler(f.logger, ucPosts, sManager),
-		POSTS_WITH_ID:            posts_id_handler.NewPostsIDHandler(f.logger, ucPosts, ucUser, sManager),
-		POSTS_UPD:                posts_upd_handler.NewPostsUpdateHandler(f.logger, ucPosts, sManager),
-		POSTS_LIKES:              likes_handler.NewLikesHandler(f.logger, ucLikes, ucPosts, sManager),
-		GET_CSRF_TOKEN:           csrf_handler.NewCsrfHandler(f.logger, sManager, ucCsrf),
-		GET_USER_SUBSCRIPTIONS:   subscriptions_handler.NewSubscriptionsHandler(f.logger, sManager, ucSubscr),
-		SUBSCRIBES:               subscribe_handler.NewSubscribeHandler(f.logger, sManager, ucSubscr),
-		POST_UPD_COVER:           upl_cover_posts_handler.NewPostsUpdateCoverHandler(f.logger, ucPosts, sManager),
-		ATTACH_ADD_TEXT:          upl_text_attach_handler.NewAttachesUploadTextHandler(f.logger, ucAttaches, ucPosts, sManager),
-		ATTACH_ADD_IMAGE:         upl_img_attach_handler.NewPostsUploadImageHandler(f.logger, ucAttaches, ucPosts, sManager),
-		ATTACH_ID:                attaches_id_handler.NewAttachesIDHandler(f.logger, ucAttaches, ucPosts, sManager),
-		CREATOR_AVATAR:           upd_avatar_creator_handler.NewUpdateAvatarHandler(f.logger, sManager, ucCreator),
-		CREATOR_COVER:            upd_cover_creator_handler.NewUpdateCoverHandler(f.logger, sManager, ucCreator),
-		AWARDS_COVER:             upd_cover_awards_handler.NewUpdateCoverAwardsHandler(f.logger, sManager, ucAwards),
-		ATTACH_UPD_IMAGE:         upd_img_data_handler.NewAttachUploadImageHandler(f.logger, ucAttaches, ucPosts, sManager),
-		ATTACH_UPD_TEXT:          upd_text_data_handler.NewAttachesUpdateTextHandler(f.logger, ucAttaches, ucPosts, sManager),
-		AWARDS_CREATOR_SUBSCRIBE: aw_subscribe_handler.NewAwardsSubscribeHandler(f.logger, sManager, ucSubscr, ucAwards, ucPayToken),
-		USER_PAYMENTS:            payments_handler.NewPaymentsHandler(f.logger, sManager, ucPayments),
-		ATTACHES:                 attaches_handler.NewAttachesHandler(f.logger, ucAttaches, ucPosts, sManager),
-		ATTACH_ADD_VIDEO:         upl_video_attach_handler.NewPostsUploadVideoHandler(f.logger, ucAttaches, ucPosts, sManager),
-		ATTACH_ADD_AUDIO:         upl_audio_attach_handler.NewPostsUploadAudioHandler(f.logger, ucAttaches, ucPosts, sManager),
-		ATTACH_UPD_VIDEO:         upd_video_attach_handler.NewAttachUploadVideoHandler(f.logger, ucAttaches, ucPosts, sManager),
-		ATTACH_UPD_AUDIO:         upd_audio_attach_handler.NewAttachUploadAudioHandler(f.logger, ucAttaches, ucPosts, sManager),
-		POSTS_AVAILABLE:          user_posts_handler.NewPostsHandler(f.logger, sManager, ucPosts),
-		STATS_COUNT_SUBSCRIBERS:  statistics_handler.NewCreatorCountSubscribersHandler(f.logger, ucStats),
-		STATS_COUNT_POSTS:        statistics_count_posts_handler.NewCreatorCountPostsHandler(f.logger, ucStats),
-		STATS_POSTS_VIEWS:        statistics_count_posts_views_handler.NewCreatorViewsHandler(f.logger, ucStats),
-		STATS_TOTAL_INCOMES:      statistics_total_income_handler.NewCreatorTotalIncomeHandler(f.logger, ucStats),
-		POST_COMMENTS:            comments_handler.NewCommentsHandler(f.logger, ucComment, ucPosts, sManager),
-		COMMENTS_ID:              comments_id_handler.NewCommentsIdHandler(f.logger, ucComment, ucPosts, sManager),
-		USER_COMMENTS:            user_comments_handler.NewUserCommentsHandler(f.logger, ucComment, sManager),
-		USER_PAYMENTS_TOKEN:      pay_token_handler.NewTokenHandler(f.logger, sManager, ucPayToken, ucPayments),
-		PAYMENTS_ACCOUNT:         pay_account_handler.NewAccountHandler(f.logger, ucPayToken),
-		CREATOR_PAYMENTS:         creator_payments_handler.NewPaymentsHandler(f.logger, sManager, ucPayments),
+		INFO:                       info_handler.NewInfoHandler(f.logger, ucInfo),
+		REGISTER:                   register_handler.NewRegisterHandler(f.logger, sManager, ucUser),
+		LOGIN:                      login_handler.NewLoginHandler(f.logger, sManager, ucUser),
+		LOGOUT:                     logout_handler.NewLogoutHandler(f.logger, sManager),
+		PROFILE:                    profile_handler.NewProfileHandler(f.logger, sManager, ucUser),
+		CREATORS:                   creator_handler.NewCreatorHandler(f.logger, sManager, ucCreator, ucUser),
+		CREATOR_WITH_ID:            creator_id_handler.NewCreatorIdHandler(f.logger, sManager, ucCreator),
+		SEARCH_CREATORS:            search_creators_handler.NewCreatorHandler(f.logger, sManager, ucCreator),
+		UPDATE_PASSWORD:            password_handler.NewUpdatePasswordHandler(f.logger, sManager, ucUser),
+		UPDATE_AVATAR:              avatar_handler.NewUpdateAvatarHandler(f.logger, sManager, ucUser),
+		UPDATE_NICKNAME:            nickname_handler.NewUpdateNicknameHandler(f.logger, sManager, ucUser),
+		AWARDS:                     aw_handler.NewAwardsHandler(f.logger, ucAwards, sManager),
+		AWARDS_WITH_ID:             aw_id_handler.NewAwardsIdHandler(f.logger, ucAwards, sManager),
+		AWARDS_UPDATE:              aw_upd_handler.NewAwardsUpdHandler(f.logger, ucAwards, sManager),
+		POSTS:                      posts_handler.NewPostsHandler(f.logger, ucPosts, sManager),
+		POSTS_WITH_ID:              posts_id_handler.NewPostsIDHandler(f.logger, ucPosts, sManager),
+		POSTS_UPD:                  posts_upd_handler.NewPostsUpdateHandler(f.logger, ucPosts, sManager),
+		POSTS_LIKES:                likes_handler.NewLikesHandler(f.logger, ucLikes, ucPosts, sManager),
+		GET_CSRF_TOKEN:             csrf_handler.NewCsrfHandler(f.logger, sManager, ucCsrf),
+		GET_USER_SUBSCRIPTIONS:     subscriptions_handler.NewSubscriptionsHandler(f.logger, sManager, ucSubscr),
+		SUBSCRIBES:                 subscribe_handler.NewSubscribeHandler(f.logger, sManager, ucSubscr),
+		POST_UPD_COVER:             upl_cover_posts_handler.NewPostsUpdateCoverHandler(f.logger, ucPosts, sManager),
+		ATTACH_ADD_TEXT:            upl_text_attach_handler.NewAttachesUploadTextHandler(f.logger, ucAttaches, ucPosts, sManager),
+		ATTACH_ADD_IMAGE:           upl_img_attach_handler.NewPostsUploadImageHandler(f.logger, ucAttaches, ucPosts, sManager),
+		ATTACH_ID:                  attaches_id_handler.NewAttachesIDHandler(f.logger, ucAttaches, ucPosts, sManager),
+		CREATOR_AVATAR:             upd_avatar_creator_handler.NewUpdateAvatarHandler(f.logger, sManager, ucCreator),
+		CREATOR_COVER:              upd_cover_creator_handler.NewUpdateCoverHandler(f.logger, sManager, ucCreator),
+		AWARDS_COVER:               upd_cover_awards_handler.NewUpdateCoverAwardsHandler(f.logger, sManager, ucAwards),
+		ATTACH_UPD_IMAGE:           upd_img_data_handler.NewAttachUploadImageHandler(f.logger, ucAttaches, ucPosts, sManager),
+		ATTACH_UPD_TEXT:            upd_text_data_handler.NewAttachesUpdateTextHandler(f.logger, ucAttaches, ucPosts, sManager),
+		AWARDS_CREATOR_SUBSCRIBE:   aw_subscribe_handler.NewAwardsSubscribeHandler(f.logger, sManager, ucSubscr, ucAwards, ucPayToken),
+		USER_PAYMENTS:              payments_handler.NewPaymentsHandler(f.logger, sManager, ucPayments),
+		ATTACHES:                   attaches_handler.NewAttachesHandler(f.logger, ucAttaches, ucPosts, sManager),
+		ATTACH_ADD_VIDEO:           upl_video_attach_handler.NewPostsUploadVideoHandler(f.logger, ucAttaches, ucPosts, sManager),
+		ATTACH_ADD_AUDIO:           upl_audio_attach_handler.NewPostsUploadAudioHandler(f.logger, ucAttaches, ucPosts, sManager),
+		ATTACH_UPD_VIDEO:           upd_video_attach_handler.NewAttachUploadVideoHandler(f.logger, ucAttaches, ucPosts, sManager),
+		ATTACH_UPD_AUDIO:           upd_audio_attach_handler.NewAttachUploadAudioHandler(f.logger, ucAttaches, ucPosts, sManager),
+		POSTS_AVAILABLE:            user_posts_handler.NewPostsHandler(f.logger, sManager, ucPosts),
+		STATS_COUNT_SUBSCRIBERS:    statistics_handler.NewCreatorCountSubscribersHandler(f.logger, ucStats),
+		STATS_COUNT_POSTS:          statistics_count_posts_handler.NewCreatorCountPostsHandler(f.logger, ucStats),
+		STATS_POSTS_VIEWS:          statistics_count_posts_views_handler.NewCreatorViewsHandler(f.logger, ucStats),
+		STATS_TOTAL_INCOMES:        statistics_total_income_handler.NewCreatorTotalIncomeHandler(f.logger, ucStats),
+		POST_COMMENTS:              comments_handler.NewCommentsHandler(f.logger, ucComment, ucPosts, sManager),
+		COMMENTS_ID:                comments_id_handler.NewCommentsIdHandler(f.logger, ucComment, ucPosts, sManager),
+		USER_COMMENTS:              user_comments_handler.NewUserCommentsHandler(f.logger, ucComment, sManager),
+		USER_PAYMENTS_TOKEN:        pay_token_handler.NewTokenHandler(f.logger, sManager, ucPayToken, ucPayments),
+		PAYMENTS_ACCOUNT:           pay_account_handler.NewAccountHandler(f.logger, ucPayToken),
+		CREATOR_PAYMENTS:           creator_payments_handler.NewPaymentsHandler(f.logger, sManager, ucPayments),
+		USER_PAYMENTS_CHECKOUT:     pay_checkout_handler.NewCheckoutHandler(f.logger, sManager, ucPayments),
+		USER_PAYMENTS_CHECKOUTS:    pay_checkouts_handler.NewCheckoutsHandler(f.logger, sManager, ucPayToken),
+		CREATOR_SUBSCRIPTION:       subscription_handler.NewSubscriptionHandler(f.logger, sManager, ucSubscr),
+		CREATOR_BALANCE:            balance_handler.NewBalanceHandler(f.logger, sManager, ucLedger),
+		CREATOR_LEDGER:             ledger_handler.NewLedgerHandler(f.logger, sManager, ucLedger),
+		CREATOR_PAYOUTS:            creator_payouts_handler.NewPayoutsHandler(f.logger, sManager, ucLedger),
+		PAYOUT_WITH_ID:             payouts_handler.NewPayoutIdHandler(f.logger, sManager, ucLedger),
+		CREATOR_PROMO_CODES:        promo_codes_handler.NewPromoCodesHandler(f.logger, sManager, ucPromoCodes),
+		CREATOR_PROMO_CODE_WITH_ID: promo_codes_id_handler.NewPromoCodesIdHandler(f.logger, sManager, ucPromoCodes),
+		AWARDS_GIFTS:               aw_gifts_handler.NewAwardsGiftsHandler(f.logger, sManager, ucGifts, ucAwards),
+		USER_GIFTS:                 gifts_handler.NewGiftsHandler(f.logger, sManager, ucGifts),
+		USER_GIFTS_REDEEM:          redeem_handler.NewRedeemHandler(f.logger, sManager, ucGifts),
+		AWARDS_TRIAL:               aw_trial_handler.NewAwardsTrialHandler(f.logger, sManager, ucSubscr, ucAwards),
+		STATS_TRIALS:               statistics_trials_handler.NewCreatorTrialsHandler(f.logger, ucStats),
+		CREATOR_TIPS:               tips_handler.NewTipsHandler(f.logger, sManager, ucTips),
+		POST_TIPS:                  posts_tips_handler.NewPostsTipsHandler(f.logger, sManager, ucTips, ucPosts),
+		POST_UNLOCK:                posts_unlock_handler.NewPostsUnlockHandler(f.logger, sManager, ucPostUnlocks, ucPosts),
+		CREATOR_PAYMENTS_EXPORT:    payments_export_handler.NewPaymentsExportHandler(f.logger, sManager, ucPayments),
+		CREATOR_PAYMENTS_TOTALS:    payments_totals_handler.NewPaymentsTotalsHandler(f.logger, sManager, ucPayments),
+		USER_SUBSCRIPTIONS_HISTORY: subscriptions_history_handler.NewSubscriptionsHistoryHandler(f.logger, sManager, ucSubscr),
+		SUBSCRIBERS_EVENTS:         subscribers_events_handler.NewSubscribersEventsHandler(f.logger, sManager, ucSubscr),
+		AWARDS_WAITLIST:            aw_waitlist_handler.NewAwardsWaitlistHandler(f.logger, sManager, ucSubscr, ucAwards),
+		CREATOR_BLOCKED_USERS:      blocked_users_handler.NewBlockedUsersHandler(f.logger, sManager, ucBlocked),
+		POST_REVISIONS:             post_revisions_handler.NewPostRevisionsHandler(f.logger, sManager, ucRevisions, ucPosts),
+		POST_REVISIONS_DIFF:        revisions_diff_handler.NewRevisionsDiffHandler(f.logger, sManager, ucRevisions, ucPosts),
+		POST_REVISION_RESTORE:      revisions_restore_handler.NewRevisionsRestoreHandler(f.logger, sManager, ucRevisions, ucPosts),
+		CREATOR_TAGS:               tags_handler.NewTagsHandler(f.logger, ucPosts),
+		SEARCH_POSTS:               search_posts_handler.NewSearchPostsHandler(f.logger, sManager, ucPosts),
+		CREATOR_SEARCH_POSTS:       search_posts_handler.NewSearchPostsHandler(f.logger, sManager, ucPosts),
+		ATTACH_ADD_POLL:            upl_poll_attach_handler.NewAttachesUploadPollHandler(f.logger, ucAttaches, ucPosts, sManager),
+		ATTACH_POLL:                poll_handler.NewPollHandler(f.logger, sManager, ucPolls, ucPosts, ucAttaches),
+		CREATOR_PAYMENTS_REFUND:    payments_refund_handler.NewPaymentsRefundHandler(f.logger, sManager, ucPayments),
 	}
 }
 
@@ -205,36 +308,64 @@ func (f *HandlerFactory) GetHandleUrls() *map[string]app.Handler {
 		"/logout":   hs[LOGOUT],
 		"/register": hs[REGISTER],
 		// /user     ---------------------------------------------------------////
-		"/user":                  hs[PROFILE],
-		"/user/update/password":  hs[UPDATE_PASSWORD],
-		"/user/update/avatar":    hs[UPDATE_AVATAR],
-		"/user/update/nickname":  hs[UPDATE_NICKNAME],
-		"/user/subscriptions":    hs[GET_USER_SUBSCRIPTIONS],
-		"/user/payments":         hs[USER_PAYMENTS],
-		"/user/payments/token":   hs[USER_PAYMENTS_TOKEN],
-		"/user/payments/account": hs[PAYMENTS_ACCOUNT],
-		"/user/comments":         hs[USER_COMMENTS],
-		"/user/posts":            hs[POSTS_AVAILABLE],
+		"/user":                       hs[PROFILE],
+		"/user/update/password":       hs[UPDATE_PASSWORD],
+		"/user/update/avatar":         hs[UPDATE_AVATAR],
+		"/user/update/nickname":       hs[UPDATE_NICKNAME],
+		"/user/subscriptions":         hs[GET_USER_SUBSCRIPTIONS],
+		"/user/subscriptions/history": hs[USER_SUBSCRIPTIONS_HISTORY],
+		"/user/payments":              hs[USER_PAYMENTS],
+		"/user/payments/token":        hs[USER_PAYMENTS_TOKEN],
+		"/user/payments/account":      hs[PAYMENTS_ACCOUNT],
+		"/user/payments/checkout":     hs[USER_PAYMENTS_CHECKOUT],
+		"/user/payments/checkouts":    hs[USER_PAYMENTS_CHECKOUTS],
+		"/user/comments":              hs[USER_COMMENTS],
+		"/user/gifts":                 hs[USER_GIFTS],
+		"/user/gifts/redeem":          hs[USER_GIFTS_REDEEM],
+		"/user/posts":                 hs[POSTS_AVAILABLE],
 		// /creators ---------------------------------------------------------////
-		"/creators":                                   hs[CREATORS],
-		"/creators/{creator_id:[0-9]+}":               hs[CREATOR_WITH_ID],
-		"/creators/{creator_id:[0-9]+}/subscribers":   hs[SUBSCRIBES],
-		"/creators/{creator_id:[0-9]+}/update/avatar": hs[CREATOR_AVATAR],
-		"/creators/{creator_id:[0-9]+}/update/cover":  hs[CREATOR_COVER],
-		"/creators/{creator_id:[0-9]+}/payments":      hs[CREATOR_PAYMENTS],
-		"/creators/search":                            hs[SEARCH_CREATORS],
+		"/creators":                                                         hs[CREATORS],
+		"/creators/{creator_id:[0-9]+}":                                     hs[CREATOR_WITH_ID],
+		"/creators/{creator_id:[0-9]+}/subscribers":                         hs[SUBSCRIBES],
+		"/creators/{creator_id:[0-9]+}/subscribers/events":                  hs[SUBSCRIBERS_EVENTS],
+		"/creators/{creator_id:[0-9]+}/subscription":                        hs[CREATOR_SUBSCRIPTION],
+		"/creators/{creator_id:[0-9]+}/update/avatar":                       hs[CREATOR_AVATAR],
+		"/creators/{creator_id:[0-9]+}/update/cover":                        hs[CREATOR_COVER],
+		"/creators/{creator_id:[0-9]+}/payments":                            hs[CREATOR_PAYMENTS],
+		"/creators/{creator_id:[0-9]+}/payments/export":                     hs[CREATOR_PAYMENTS_EXPORT],
+		"/creators/{creator_id:[0-9]+}/payments/totals":                     hs[CREATOR_PAYMENTS_TOTALS],
+		"/creators/{creator_id:[0-9]+}/payments/{payment_id:[0-9]+}/refund": hs[CREATOR_PAYMENTS_REFUND],
+		"/creators/{creator_id:[0-9]+}/balance":                             hs[CREATOR_BALANCE],
+		"/creators/{creator_id:[0-9]+}/ledger":                              hs[CREATOR_LEDGER],
+		"/creators/{creator_id:[0-9]+}/payouts":                             hs[CREATOR_PAYOUTS],
+		"/creators/{creator_id:[0-9]+}/promo_codes":                         hs[CREATOR_PROMO_CODES],
+		"/creators/{creator_id:[0-9]+}/tips":                                hs[CREATOR_TIPS],
+		"/creators/{creator_id:[0-9]+}/blocked":                             hs[CREATOR_BLOCKED_USERS],
+		"/creators/{creator_id:[0-9]+}/tags":                                hs[CREATOR_TAGS],
+		"/creators/{creator_id:[0-9]+}/promo_codes/{promo_code_id:[0-9]+}":  hs[CREATOR_PROMO_CODE_WITH_ID],
+		"/creators/search":                                                  hs[SEARCH_CREATORS],
 		// ../awards ---------------------------------------------------------////
 		"/creators/{creator_id:[0-9]+}/awards":                                hs[AWARDS],
 		"/creators/{creator_id:[0-9]+}/awards/{award_id:[0-9]+}":              hs[AWARDS_WITH_ID],
 		"/creators/{creator_id:[0-9]+}/awards/{award_id:[0-9]+}/update":       hs[AWARDS_UPDATE],
 		"/creators/{creator_id:[0-9]+}/awards/{award_id:[0-9]+}/update/cover": hs[AWARDS_COVER],
 		"/creators/{creator_id:[0-9]+}/awards/{award_id:[0-9]+}/subscribe":    hs[AWARDS_CREATOR_SUBSCRIBE],
+		"/creators/{creator_id:[0-9]+}/awards/{award_id:[0-9]+}/gifts":        hs[AWARDS_GIFTS],
+		"/creators/{creator_id:[0-9]+}/awards/{award_id:[0-9]+}/trial":        hs[AWARDS_TRIAL],
+		"/creators/{creator_id:[0-9]+}/awards/{award_id:[0-9]+}/waitlist":     hs[AWARDS_WAITLIST],
 		// ../posts  ---------------------------------------------------------////
 		"/creators/{creator_id:[0-9]+}/posts":                               hs[POSTS],
+		"/creators/{creator_id:[0-9]+}/posts/search":                        hs[CREATOR_SEARCH_POSTS],
 		"/creators/{creator_id:[0-9]+}/posts/{post_id:[0-9]+}":              hs[POSTS_WITH_ID],
 		"/creators/{creator_id:[0-9]+}/posts/{post_id:[0-9]+}/update":       hs[POSTS_UPD],
 		"/creators/{creator_id:[0-9]+}/posts/{post_id:[0-9]+}/update/cover": hs[POST_UPD_COVER],
 		"/creators/{creator_id:[0-9]+}/posts/{post_id:[0-9]+}/like":         hs[POSTS_LIKES],
+		"/creators/{creator_id:[0-9]+}/posts/{post_id:[0-9]+}/tips":         hs[POST_TIPS],
+		"/creators/{creator_id:[0-9]+}/posts/{post_id:[0-9]+}/unlock":       hs[POST_UNLOCK],
+		// ../revisions -----------------------------------------------------////
+		"/creators/{creator_id:[0-9]+}/posts/{post_id:[0-9]+}/revisions":                              hs[POST_REVISIONS],
+		"/creators/{creator_id:[0-9]+}/posts/{post_id:[0-9]+}/revisions/diff":                         hs[POST_REVISIONS_DIFF],
+		"/creators/{creator_id:[0-9]+}/posts/{post_id:[0-9]+}/revisions/{revision_id:[0-9]+}/restore": hs[POST_REVISION_RESTORE],
 		// ../comments -----------------------------------------------------////
 		"/creators/{creator_id:[0-9]+}/posts/{post_id:[0-9]+}/comments":                     hs[POST_COMMENTS],
 		"/creators/{creator_id:[0-9]+}/posts/{post_id:[0-9]+}/comments/{comment_id:[0-9]+}": hs[COMMENTS_ID],
@@ -244,16 +375,25 @@ func (f *HandlerFactory) GetHandleUrls() *map[string]app.Handler {
 		"/creators/{creator_id:[0-9]+}/posts/{post_id:[0-9]+}/attaches/image":                  hs[ATTACH_ADD_IMAGE],
 		"/creators/{creator_id:[0-9]+}/posts/{post_id:[0-9]+}/attaches/video":                  hs[ATTACH_ADD_VIDEO],
 		"/creators/{creator_id:[0-9]+}/posts/{post_id:[0-9]+}/attaches/audio":                  hs[ATTACH_ADD_AUDIO],
+		"/creators/{creator_id:[0-9]+}/posts/{post_id:[0-9]+}/attaches/poll":                   hs[ATTACH_ADD_POLL],
 		"/creators/{creator_id:[0-9]+}/posts/{post_id:[0-9]+}/{attach_id:[0-9]+}":              hs[ATTACH_ID],
 		"/creators/{creator_id:[0-9]+}/posts/{post_id:[0-9]+}/{attach_id:[0-9]+}/update/text":  hs[ATTACH_UPD_TEXT],
 		"/creators/{creator_id:[0-9]+}/posts/{post_id:[0-9]+}/{attach_id:[0-9]+}/update/image": hs[ATTACH_UPD_IMAGE],
 		"/creators/{creator_id:[0-9]+}/posts/{post_id:[0-9]+}/{attach_id:[0-9]+}/update/video": hs[ATTACH_UPD_VIDEO],
 		"/creators/{creator_id:[0-9]+}/posts/{post_id:[0-9]+}/{attach_id:[0-9]+}/update/audio": hs[ATTACH_UPD_AUDIO],
+		"/creators/{creator_id:[0-9]+}/posts/{post_id:[0-9]+}/{attach_id:[0-9]+}/poll":         hs[ATTACH_POLL],
 		// ../statistics -----------------------------------------------------////
 		"/creators/{creator_id:[0-9]+}/statistics/posts/views":  hs[STATS_POSTS_VIEWS],
 		"/creators/{creator_id:[0-9]+}/statistics/posts/count":  hs[STATS_COUNT_POSTS],
 		"/creators/{creator_id:[0-9]+}/statistics/total_income": hs[STATS_TOTAL_INCOMES],
 		"/creators/{creator_id:[0-9]+}/statistics/subscribers":  hs[STATS_COUNT_SUBSCRIBERS],
+		"/creators/{creator_id:[0-9]+}/statistics/trials":       hs[STATS_TRIALS],
+
+		// /posts ------------------------------------------------------------////
+		"/posts/search": hs[SEARCH_POSTS],
+
+		// /payouts ----------------------------------------------------------////
+		"/payouts/{payout_id:[0-9]+}": hs[PAYOUT_WITH_ID],
 
 		//   /token  ---------------------------------------------------------////
 		"/token": hs[GET_CSRF_TOKEN],
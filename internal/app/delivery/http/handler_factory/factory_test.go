@@ -42,6 +42,14 @@ func (s *FactorySuite) TestInitHandlers() {
 	s.usecaseFactory.EXPECT().GetStatsUsecase().Times(1)
 	s.usecaseFactory.EXPECT().GetCommentsUsecase().Times(1)
 	s.usecaseFactory.EXPECT().GetPayTokenUsecase().Times(1)
+	s.usecaseFactory.EXPECT().GetLedgerUsecase().Times(1)
+	s.usecaseFactory.EXPECT().GetPromoCodesUsecase().Times(1)
+	s.usecaseFactory.EXPECT().GetGiftsUsecase().Times(1)
+	s.usecaseFactory.EXPECT().GetPostUnlocksUsecase().Times(1)
+	s.usecaseFactory.EXPECT().GetTipsUsecase().Times(1)
+	s.usecaseFactory.EXPECT().GetBlockedUsersUsecase().Times(1)
+	s.usecaseFactory.EXPECT().GetPostRevisionsUsecase().Times(1)
+	s.usecaseFactory.EXPECT().GetPollsUsecase().Times(1)
 
 	defer func() {
 		if r := recover(); r != nil {
@@ -72,6 +80,14 @@ func (s *FactorySuite) TestGetHandlersUrlsAlreadyExists() {
 	s.usecaseFactory.EXPECT().GetStatsUsecase().Times(1)
 	s.usecaseFactory.EXPECT().GetCommentsUsecase().Times(1)
 	s.usecaseFactory.EXPECT().GetPayTokenUsecase().Times(1)
+	s.usecaseFactory.EXPECT().GetLedgerUsecase().Times(1)
+	s.usecaseFactory.EXPECT().GetPromoCodesUsecase().Times(1)
+	s.usecaseFactory.EXPECT().GetGiftsUsecase().Times(1)
+	s.usecaseFactory.EXPECT().GetPostUnlocksUsecase().Times(1)
+	s.usecaseFactory.EXPECT().GetTipsUsecase().Times(1)
+	s.usecaseFactory.EXPECT().GetBlockedUsersUsecase().Times(1)
+	s.usecaseFactory.EXPECT().GetPostRevisionsUsecase().Times(1)
+	s.usecaseFactory.EXPECT().GetPollsUsecase().Times(1)
 
 	s.factory.urlHandler = nil
 	defer func() {
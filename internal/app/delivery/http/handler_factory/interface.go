@@ -4,15 +4,23 @@ import (
 	useCsrf "patreon/internal/app/csrf/usecase"
 	useAttaches "patreon/internal/app/usecase/attaches"
 	useAwards "patreon/internal/app/usecase/awards"
+	useBlocked "patreon/internal/app/usecase/blocked_users"
 	useComments "patreon/internal/app/usecase/comments"
 	useCreator "patreon/internal/app/usecase/creator"
+	useGifts "patreon/internal/app/usecase/gifts"
 	useInfo "patreon/internal/app/usecase/info"
+	useLedger "patreon/internal/app/usecase/ledger"
 	useLikes "patreon/internal/app/usecase/likes"
 	usePayToken "patreon/internal/app/usecase/pay_token"
 	usePayments "patreon/internal/app/usecase/payments"
+	usePolls "patreon/internal/app/usecase/polls"
+	usePostRevisions "patreon/internal/app/usecase/post_revisions"
+	usePostUnlocks "patreon/internal/app/usecase/post_unlocks"
 	usePosts "patreon/internal/app/usecase/posts"
+	usePromoCodes "patreon/internal/app/usecase/promo_codes"
 	useStats "patreon/internal/app/usecase/statistics"
 	useSubscr "patreon/internal/app/usecase/subscribers"
+	useTips "patreon/internal/app/usecase/tips"
 	useUser "patreon/internal/app/usecase/user"
 )
 
@@ -32,4 +40,12 @@ type UsecaseFactory interface {
 	GetCommentsUsecase() useComments.Usecase
 	GetStatsUsecase() useStats.Usecase
 	GetPayTokenUsecase() usePayToken.Usecase
+	GetLedgerUsecase() useLedger.Usecase
+	GetPromoCodesUsecase() usePromoCodes.Usecase
+	GetGiftsUsecase() useGifts.Usecase
+	GetTipsUsecase() useTips.Usecase
+	GetPostUnlocksUsecase() usePostUnlocks.Usecase
+	GetBlockedUsersUsecase() useBlocked.Usecase
+	GetPostRevisionsUsecase() usePostRevisions.Usecase
+	GetPollsUsecase() usePolls.Usecase
 }
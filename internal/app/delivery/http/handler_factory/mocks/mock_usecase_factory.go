@@ -8,15 +8,23 @@ import (
 	usecase_csrf "patreon/internal/app/csrf/usecase"
 	attaches "patreon/internal/app/usecase/attaches"
 	usecase_awards "patreon/internal/app/usecase/awards"
+	usecase_blocked_users "patreon/internal/app/usecase/blocked_users"
 	usecase_comments "patreon/internal/app/usecase/comments"
 	usecase_creator "patreon/internal/app/usecase/creator"
+	usecase_gifts "patreon/internal/app/usecase/gifts"
 	usecase_info "patreon/internal/app/usecase/info"
+	usecase_ledger "patreon/internal/app/usecase/ledger"
 	usecase_likes "patreon/internal/app/usecase/likes"
 	usecase_pay_token "patreon/internal/app/usecase/pay_token"
 	payments "patreon/internal/app/usecase/payments"
+	usecase_polls "patreon/internal/app/usecase/polls"
+	usecase_post_revisions "patreon/internal/app/usecase/post_revisions"
+	usecase_post_unlocks "patreon/internal/app/usecase/post_unlocks"
 	posts "patreon/internal/app/usecase/posts"
+	usecase_promo_codes "patreon/internal/app/usecase/promo_codes"
 	statistics "patreon/internal/app/usecase/statistics"
 	usecase_subscribers "patreon/internal/app/usecase/subscribers"
+	usecase_tips "patreon/internal/app/usecase/tips"
 	usercase_user "patreon/internal/app/usecase/user"
 	reflect "reflect"
 
@@ -74,6 +82,20 @@ func (mr *MockUsecaseFactoryMockRecorder) GetAwardsUsecase() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAwardsUsecase", reflect.TypeOf((*MockUsecaseFactory)(nil).GetAwardsUsecase))
 }
 
+// GetBlockedUsersUsecase mocks base method.
+func (m *MockUsecaseFactory) GetBlockedUsersUsecase() usecase_blocked_users.Usecase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedUsersUsecase")
+	ret0, _ := ret[0].(usecase_blocked_users.Usecase)
+	return ret0
+}
+
+// GetBlockedUsersUsecase indicates an expected call of GetBlockedUsersUsecase.
+func (mr *MockUsecaseFactoryMockRecorder) GetBlockedUsersUsecase() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedUsersUsecase", reflect.TypeOf((*MockUsecaseFactory)(nil).GetBlockedUsersUsecase))
+}
+
 // GetCommentsUsecase mocks base method.
 func (m *MockUsecaseFactory) GetCommentsUsecase() usecase_comments.Usecase {
 	m.ctrl.T.Helper()
@@ -116,6 +138,20 @@ func (mr *MockUsecaseFactoryMockRecorder) GetCsrfUsecase() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCsrfUsecase", reflect.TypeOf((*MockUsecaseFactory)(nil).GetCsrfUsecase))
 }
 
+// GetGiftsUsecase mocks base method.
+func (m *MockUsecaseFactory) GetGiftsUsecase() usecase_gifts.Usecase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGiftsUsecase")
+	ret0, _ := ret[0].(usecase_gifts.Usecase)
+	return ret0
+}
+
+// GetGiftsUsecase indicates an expected call of GetGiftsUsecase.
+func (mr *MockUsecaseFactoryMockRecorder) GetGiftsUsecase() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGiftsUsecase", reflect.TypeOf((*MockUsecaseFactory)(nil).GetGiftsUsecase))
+}
+
 // GetInfoUsecase mocks base method.
 func (m *MockUsecaseFactory) GetInfoUsecase() usecase_info.Usecase {
 	m.ctrl.T.Helper()
@@ -130,6 +166,20 @@ func (mr *MockUsecaseFactoryMockRecorder) GetInfoUsecase() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInfoUsecase", reflect.TypeOf((*MockUsecaseFactory)(nil).GetInfoUsecase))
 }
 
+// GetLedgerUsecase mocks base method.
+func (m *MockUsecaseFactory) GetLedgerUsecase() usecase_ledger.Usecase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLedgerUsecase")
+	ret0, _ := ret[0].(usecase_ledger.Usecase)
+	return ret0
+}
+
+// GetLedgerUsecase indicates an expected call of GetLedgerUsecase.
+func (mr *MockUsecaseFactoryMockRecorder) GetLedgerUsecase() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLedgerUsecase", reflect.TypeOf((*MockUsecaseFactory)(nil).GetLedgerUsecase))
+}
+
 // GetLikesUsecase mocks base method.
 func (m *MockUsecaseFactory) GetLikesUsecase() usecase_likes.Usecase {
 	m.ctrl.T.Helper()
@@ -172,6 +222,48 @@ func (mr *MockUsecaseFactoryMockRecorder) GetPaymentsUsecase() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentsUsecase", reflect.TypeOf((*MockUsecaseFactory)(nil).GetPaymentsUsecase))
 }
 
+// GetPollsUsecase mocks base method.
+func (m *MockUsecaseFactory) GetPollsUsecase() usecase_polls.Usecase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPollsUsecase")
+	ret0, _ := ret[0].(usecase_polls.Usecase)
+	return ret0
+}
+
+// GetPollsUsecase indicates an expected call of GetPollsUsecase.
+func (mr *MockUsecaseFactoryMockRecorder) GetPollsUsecase() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPollsUsecase", reflect.TypeOf((*MockUsecaseFactory)(nil).GetPollsUsecase))
+}
+
+// GetPostRevisionsUsecase mocks base method.
+func (m *MockUsecaseFactory) GetPostRevisionsUsecase() usecase_post_revisions.Usecase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostRevisionsUsecase")
+	ret0, _ := ret[0].(usecase_post_revisions.Usecase)
+	return ret0
+}
+
+// GetPostRevisionsUsecase indicates an expected call of GetPostRevisionsUsecase.
+func (mr *MockUsecaseFactoryMockRecorder) GetPostRevisionsUsecase() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostRevisionsUsecase", reflect.TypeOf((*MockUsecaseFactory)(nil).GetPostRevisionsUsecase))
+}
+
+// GetPostUnlocksUsecase mocks base method.
+func (m *MockUsecaseFactory) GetPostUnlocksUsecase() usecase_post_unlocks.Usecase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostUnlocksUsecase")
+	ret0, _ := ret[0].(usecase_post_unlocks.Usecase)
+	return ret0
+}
+
+// GetPostUnlocksUsecase indicates an expected call of GetPostUnlocksUsecase.
+func (mr *MockUsecaseFactoryMockRecorder) GetPostUnlocksUsecase() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostUnlocksUsecase", reflect.TypeOf((*MockUsecaseFactory)(nil).GetPostUnlocksUsecase))
+}
+
 // GetPostsUsecase mocks base method.
 func (m *MockUsecaseFactory) GetPostsUsecase() posts.Usecase {
 	m.ctrl.T.Helper()
@@ -186,6 +278,20 @@ func (mr *MockUsecaseFactoryMockRecorder) GetPostsUsecase() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostsUsecase", reflect.TypeOf((*MockUsecaseFactory)(nil).GetPostsUsecase))
 }
 
+// GetPromoCodesUsecase mocks base method.
+func (m *MockUsecaseFactory) GetPromoCodesUsecase() usecase_promo_codes.Usecase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromoCodesUsecase")
+	ret0, _ := ret[0].(usecase_promo_codes.Usecase)
+	return ret0
+}
+
+// GetPromoCodesUsecase indicates an expected call of GetPromoCodesUsecase.
+func (mr *MockUsecaseFactoryMockRecorder) GetPromoCodesUsecase() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromoCodesUsecase", reflect.TypeOf((*MockUsecaseFactory)(nil).GetPromoCodesUsecase))
+}
+
 // GetStatsUsecase mocks base method.
 func (m *MockUsecaseFactory) GetStatsUsecase() statistics.Usecase {
 	m.ctrl.T.Helper()
@@ -214,6 +320,20 @@ func (mr *MockUsecaseFactoryMockRecorder) GetSubscribersUsecase() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscribersUsecase", reflect.TypeOf((*MockUsecaseFactory)(nil).GetSubscribersUsecase))
 }
 
+// GetTipsUsecase mocks base method.
+func (m *MockUsecaseFactory) GetTipsUsecase() usecase_tips.Usecase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTipsUsecase")
+	ret0, _ := ret[0].(usecase_tips.Usecase)
+	return ret0
+}
+
+// GetTipsUsecase indicates an expected call of GetTipsUsecase.
+func (mr *MockUsecaseFactoryMockRecorder) GetTipsUsecase() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTipsUsecase", reflect.TypeOf((*MockUsecaseFactory)(nil).GetTipsUsecase))
+}
+
 // GetUserUsecase mocks base method.
 func (m *MockUsecaseFactory) GetUserUsecase() usercase_user.Usecase {
 	m.ctrl.T.Helper()
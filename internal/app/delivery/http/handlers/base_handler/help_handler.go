@@ -7,12 +7,14 @@ import (
 	"net/http"
 	"patreon/internal/app"
 	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/models"
 	usePosts "patreon/internal/app/usecase/posts"
 	"patreon/internal/app/utilits"
 	repFiles "patreon/internal/microservices/files/files/repository/files"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/microcosm-cc/bluemonday"
@@ -23,7 +25,8 @@ import (
 )
 
 const (
-	EmptyQuery = -2
+	EmptyQuery      = -2
+	QueryDateLayout = "2006-01-02"
 )
 
 type Sanitizable interface {
@@ -128,6 +131,67 @@ func (h *HelpHandlers) GetInt64FromQueries(w http.ResponseWriter, r *http.Reques
 	return numberInt, true
 }
 
+// GetPaymentsFilterFromQuery Expected api param:
+//	Param from query string false "first date of payments in format YYYY-MM-DD"
+//	Param to query string false "last date of payments in format YYYY-MM-DD"
+//	Param award_id query uint64 false "award paid by payments"
+//	Param state query string false "comma separated payment states"
+// Errors:
+//	Status 400 handler_errors.InvalidQueries
+//	Status 400 handler_errors.IncorrectPaymentsPeriod
+//	Status 400 handler_errors.IncorrectPaymentState
+func (h *HelpHandlers) GetPaymentsFilterFromQuery(w http.ResponseWriter, r *http.Request) (*models.PaymentsFilter, bool) {
+	filter := &models.PaymentsFilter{}
+	query := r.URL.Query()
+	var err error
+	if from := query.Get("from"); from != "" {
+		if filter.From, err = time.Parse(QueryDateLayout, from); err != nil {
+			h.Log(r).Infof("can'not get date from from query url %s)", r.URL)
+			h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidQueries)
+			return nil, false
+		}
+	}
+	if to := query.Get("to"); to != "" {
+		if filter.To, err = time.Parse(QueryDateLayout, to); err != nil {
+			h.Log(r).Infof("can'not get date to from query url %s)", r.URL)
+			h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidQueries)
+			return nil, false
+		}
+		// last date is included in period
+		filter.To = filter.To.AddDate(0, 0, 1)
+	}
+
+	awardID, ok := h.GetInt64FromQueries(w, r, "award_id")
+	if !ok && awardID != EmptyQuery {
+		return nil, false
+	}
+	if ok {
+		if awardID <= 0 {
+			h.Log(r).Infof("not positive award_id in query url %s)", r.URL)
+			h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidQueries)
+			return nil, false
+		}
+		filter.AwardID = awardID
+	}
+
+	if states := query.Get("state"); states != "" {
+		for _, state := range strings.Split(states, ",") {
+			filter.States = append(filter.States, models.PaymentState(strings.TrimSpace(state)))
+		}
+	}
+
+	if err = filter.Validate(); err != nil {
+		h.Log(r).Infof("invalid payments filter in query url %s: %s", r.URL, err)
+		if errors.Is(err, models.IncorrectPaymentsPeriod) {
+			h.Error(w, r, http.StatusBadRequest, handler_errors.IncorrectPaymentsPeriod)
+		} else {
+			h.Error(w, r, http.StatusBadRequest, handler_errors.IncorrectPaymentState)
+		}
+		return nil, false
+	}
+	return filter, true
+}
+
 func (h *HelpHandlers) UsecaseError(w http.ResponseWriter, r *http.Request, usecaseErr error, codeByErr CodeMap) {
 	var generalError *app.GeneralError
 	orginalError := usecaseErr
@@ -34,4 +34,8 @@ var codesByErrorsPOST = base_handler.CodeMap{
 		http.StatusUnprocessableEntity, handler_errors.InvalidNickname, logrus.InfoLevel},
 	models.IncorrectCreatorDescription: {
 		http.StatusUnprocessableEntity, handler_errors.InvalidDescription, logrus.InfoLevel},
+	models.IncorrectCurrency: {
+		http.StatusUnprocessableEntity, handler_errors.IncorrectCurrency, logrus.InfoLevel},
+	models.UnknownCurrency: {
+		http.StatusUnprocessableEntity, handler_errors.IncorrectCurrency, logrus.InfoLevel},
 }
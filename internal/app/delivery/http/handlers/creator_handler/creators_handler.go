@@ -13,6 +13,7 @@ import (
 	usecase_user "patreon/internal/app/usecase/user"
 	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
 	"patreon/internal/microservices/auth/sessions/middleware"
+	"strings"
 
 	"github.com/microcosm-cc/bluemonday"
 
@@ -76,7 +77,7 @@ func (h *CreatorHandler) GET(w http.ResponseWriter, r *http.Request) {
 // @Failure 409 {object} http_models.ErrResponse "creator already exist"
 // @Failure 404 {object} http_models.ErrResponse "user with this id not found"
 // @Failure 500 {object} http_models.ErrResponse "can not do bd operation", "server error"
-// @Failure 422 {object} http_models.ErrResponse "invalid creator nickname", "invalid creator category-description", "invalid creator category", "invalid body in request"
+// @Failure 422 {object} http_models.ErrResponse "invalid creator nickname", "invalid creator category-description", "invalid creator category", "currency must be ISO 4217 code with known exchange rate", "invalid body in request"
 // @Failure 403 {object} http_models.ErrResponse "csrf token is invalid, get new token"
 // @Failure 401 "user are not authorized"
 // @Router /creators [POST]
@@ -107,6 +108,7 @@ func (h *CreatorHandler) POST(w http.ResponseWriter, r *http.Request) {
 		Nickname:    u.Nickname,
 		Category:    req.Category,
 		Description: req.Description,
+		Currency:    strings.ToUpper(req.Currency),
 	}
 
 	creatorId, err := h.creatorUsecase.Create(cr)
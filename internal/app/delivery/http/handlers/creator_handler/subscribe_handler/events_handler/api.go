@@ -0,0 +1,15 @@
+package subscribers_events_handler
+
+import (
+	"net/http"
+	"patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/repository"
+
+	"github.com/sirupsen/logrus"
+)
+
+var codesByErrorsGET = base_handler.CodeMap{
+	repository.DefaultErrDB: {
+		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
+}
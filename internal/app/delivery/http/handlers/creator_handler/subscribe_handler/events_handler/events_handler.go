@@ -0,0 +1,92 @@
+package subscribers_events_handler
+
+import (
+	"net/http"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/delivery/http/models"
+	"patreon/internal/app/middleware"
+	db_models "patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	usecase_subscribers "patreon/internal/app/usecase/subscribers"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+)
+
+type SubscribersEventsHandler struct {
+	sessionClient     session_client.AuthCheckerClient
+	subscriberUsecase usecase_subscribers.Usecase
+	bh.BaseHandler
+}
+
+func NewSubscribersEventsHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucSubscribers usecase_subscribers.Usecase) *SubscribersEventsHandler {
+	h := &SubscribersEventsHandler{
+		BaseHandler:       *bh.NewBaseHandler(log),
+		subscriberUsecase: ucSubscribers,
+		sessionClient:     sClient,
+	}
+	h.AddMethod(http.MethodGet, h.GET,
+		session_middleware.NewSessionMiddleware(h.sessionClient, log).CheckFunc,
+		middleware.NewCreatorsMiddleware(log).CheckAllowUserFunc,
+	)
+	return h
+}
+
+// GET SubscribersEvents
+// @Summary events of creator subscriptions
+// @tags creators
+// @Description get page of events of subscriptions on the creator with id = creator_id, the last first.
+// @Description Cancelled events have reason given by subscriber if any. Only creator can get his events
+// @Produce json
+// @Param creator_id path int true "creator_id"
+// @Param page query uint64 true "start page number of events mutually exclusive with offset"
+// @Param offset query uint64 true "start number of events mutually exclusive with page"
+// @Param limit query uint64 true "events to return"
+// @Param kind query string false "subscribed, renewed, tier_changed, cancelled, expired or refunded"
+// @Success 200 {object} http_models.ResponseSubscriptionEvents "Successfully get subscription events of creator"
+// @Failure 204 {object} http_models.OkResponse "subscription events not found"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters", "invalid parameters in query", "subscription event kind must be subscribed, renewed, tier_changed, cancelled, expired or refunded"
+// @Failure 403 {object} http_models.ErrResponse "this user not have permission for this creator"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/subscribers/events [GET]
+func (h *SubscribersEventsHandler) GET(w http.ResponseWriter, r *http.Request) {
+	limit, offset, ok := h.GetPaginationFromQuery(w, r)
+	if !ok {
+		return
+	}
+	creatorID, ok := h.GetInt64FromParam(w, r, "creator_id")
+	if !ok {
+		return
+	}
+	if len(mux.Vars(r)) > 1 {
+		h.Log(r).Warnf("Too many parameters %v", mux.Vars(r))
+		h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidParameters)
+		return
+	}
+	kind := db_models.SubscriptionEventKind(r.URL.Query().Get("kind"))
+	if kind != "" && kind.Validate() != nil {
+		h.Log(r).Infof("invalid subscription event kind in query url %s", r.URL)
+		h.Error(w, r, http.StatusBadRequest, handler_errors.IncorrectSubscriptionEventKind)
+		return
+	}
+
+	events, err := h.subscriberUsecase.GetCreatorEvents(creatorID, kind,
+		&db_models.Pagination{Limit: limit, Offset: offset})
+	if err != nil {
+		if err == repository.NotFound {
+			h.Respond(w, r, http.StatusNoContent, http_models.OkResponse{
+				Ok: handler_errors.SubscrEventsNotFound.Error(),
+			})
+		} else {
+			h.UsecaseError(w, r, err, codesByErrorsGET)
+		}
+		return
+	}
+	h.Log(r).Debugf("get %d subscription events of creator %d", len(events), creatorID)
+	h.Respond(w, r, http.StatusOK, http_models.ResponseSubscriptionEvents{Events: events})
+}
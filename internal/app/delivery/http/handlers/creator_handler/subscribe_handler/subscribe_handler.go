@@ -5,9 +5,14 @@ import (
 	bh "patreon/internal/app/delivery/http/handlers/base_handler"
 	"patreon/internal/app/delivery/http/handlers/handler_errors"
 	"patreon/internal/app/delivery/http/models"
+	"patreon/internal/app/middleware"
+	db_models "patreon/internal/app/models"
+	"patreon/internal/app/repository"
 	usecase_subscribers "patreon/internal/app/usecase/subscribers"
 	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
-	"patreon/internal/microservices/auth/sessions/middleware"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/sirupsen/logrus"
@@ -26,26 +31,89 @@ func NewSubscribeHandler(log *logrus.Logger, sClient session_client.AuthCheckerC
 		subscriberUsecase: ucSubscribers,
 		sessionClient:     sClient,
 	}
-	h.AddMethod(http.MethodGet, h.GET, middleware.NewSessionMiddleware(h.sessionClient, log).CheckFunc)
+	h.AddMethod(http.MethodGet, h.GET,
+		session_middleware.NewSessionMiddleware(h.sessionClient, log).CheckFunc,
+		middleware.NewCreatorsMiddleware(log).CheckAllowUserFunc,
+	)
 	return h
 }
 
+// getFilter Errors:
+//		Status 400 handler_errors.InvalidQueries
+//		Status 400 handler_errors.IncorrectSubscriptionStatus
+//		Status 400 handler_errors.IncorrectSubscribersSort
+func (h *SubscribeHandler) getFilter(w http.ResponseWriter, r *http.Request) (*db_models.SubscribersFilter, bool) {
+	query := r.URL.Query()
+	filter := &db_models.SubscribersFilter{
+		Status:   db_models.SubscriptionStatus(query.Get("status")),
+		Nickname: strings.TrimSpace(query.Get("search")),
+		Sort:     db_models.SubscribersSort(query.Get("sort")),
+	}
+
+	awardID, ok := h.GetInt64FromQueries(w, r, "award_id")
+	if !ok && awardID != bh.EmptyQuery {
+		return nil, false
+	}
+	if ok {
+		if awardID <= 0 {
+			h.Log(r).Infof("not positive award_id in query url %s)", r.URL)
+			h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidQueries)
+			return nil, false
+		}
+		filter.AwardID = awardID
+	}
+
+	if since := query.Get("since"); since != "" {
+		var err error
+		if filter.Since, err = time.Parse(bh.QueryDateLayout, since); err != nil {
+			h.Log(r).Infof("can'not get date since from query url %s)", r.URL)
+			h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidQueries)
+			return nil, false
+		}
+	}
+
+	if err := filter.Validate(); err != nil {
+		h.Log(r).Infof("invalid subscribers filter in query url %s: %s", r.URL, err)
+		if err == db_models.IncorrectSubscriptionStatus {
+			h.Error(w, r, http.StatusBadRequest, handler_errors.IncorrectSubscriptionStatus)
+		} else {
+			h.Error(w, r, http.StatusBadRequest, handler_errors.IncorrectSubscribersSort)
+		}
+		return nil, false
+	}
+	return filter, true
+}
+
 // GET Subscribers
 // @Summary subscribers of the creator
 // @tags creators
-// @Description get subscribers of the creators with id = creator_id
+// @Description get page of subscribers of the creator with id = creator_id with their award, start of subscription,
+// @Description amount paid to creator and months of paid subscription. Expired subscribers are listed too.
+// @Description Only creator can get his subscribers
 // @Produce json
 // @Param creator_id path int true "creator_id"
+// @Param page query uint64 true "start page number of subscribers mutually exclusive with offset"
+// @Param offset query uint64 true "start number of subscribers mutually exclusive with page"
+// @Param limit query uint64 true "subscribers to return"
+// @Param award_id query uint64 false "current award of subscribers"
+// @Param status query string false "active or expired"
+// @Param since query string false "earliest start of subscription in format YYYY-MM-DD"
+// @Param search query string false "part of subscriber nickname"
+// @Param sort query string false "newest (default), oldest, amount or nickname"
 // @Success 200 {object} http_models.SubscribersCreatorResponse "Successfully get creator subscribers with creator id = creator_id"
-// @Failure 400 {object} http_models.ErrResponse "invalid parameters"
+// @Failure 204 {object} http_models.OkResponse "subscribers not found"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters", "invalid parameters in query", "subscription status must be active or expired", "subscribers sort must be newest, oldest, amount or nickname"
+// @Failure 403 {object} http_models.ErrResponse "this user not have permission for this creator"
 // @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
 // @Failure 401 "user are not authorized"
 // @Router /creators/{:creator_id}/subscribers [GET]
 func (h *SubscribeHandler) GET(w http.ResponseWriter, r *http.Request) {
+	limit, offset, ok := h.GetPaginationFromQuery(w, r)
+	if !ok {
+		return
+	}
 	creatorID, ok := h.GetInt64FromParam(w, r, "creator_id")
 	if !ok {
-		h.Log(r).Warnf("invalid creator_id %v", mux.Vars(r))
-		h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidParameters)
 		return
 	}
 	if len(mux.Vars(r)) > 1 {
@@ -53,12 +121,23 @@ func (h *SubscribeHandler) GET(w http.ResponseWriter, r *http.Request) {
 		h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidParameters)
 		return
 	}
-	subscribers, err := h.subscriberUsecase.GetSubscribers(creatorID)
+	filter, ok := h.getFilter(w, r)
+	if !ok {
+		return
+	}
+
+	subscribers, err := h.subscriberUsecase.GetSubscribers(creatorID,
+		&db_models.Pagination{Limit: limit, Offset: offset}, filter)
 	if err != nil {
-		h.UsecaseError(w, r, err, codesByErrorsGET)
+		if err == repository.NotFound {
+			h.Respond(w, r, http.StatusNoContent, http_models.OkResponse{
+				Ok: handler_errors.SubscribersNotFound.Error(),
+			})
+		} else {
+			h.UsecaseError(w, r, err, codesByErrorsGET)
+		}
 		return
 	}
-	res := http_models.ToSubscribersCreatorResponse(subscribers)
-	h.Log(r).Debugf("get users %v", subscribers)
-	h.Respond(w, r, http.StatusOK, res)
+	h.Log(r).Debugf("get %d subscribers of creator %d", len(subscribers), creatorID)
+	h.Respond(w, r, http.StatusOK, http_models.ToSubscribersCreatorResponse(subscribers))
 }
@@ -25,6 +25,10 @@ var codesByErrorsPOST = base_handler.CodeMap{
 		http.StatusUnprocessableEntity, handler_errors.EmptyName, logrus.WarnLevel},
 	models.IncorrectAwardsPrice: {
 		http.StatusUnprocessableEntity, handler_errors.IncorrectPrice, logrus.WarnLevel},
+	models.IncorrectTrialDays: {
+		http.StatusUnprocessableEntity, handler_errors.IncorrectTrialDays, logrus.WarnLevel},
+	models.IncorrectMaxSubscribers: {
+		http.StatusUnprocessableEntity, handler_errors.IncorrectMaxSubscribers, logrus.WarnLevel},
 	repository.DefaultErrDB: {
 		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
 	app.UnknownError: {
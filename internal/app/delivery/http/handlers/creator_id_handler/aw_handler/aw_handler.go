@@ -85,7 +85,7 @@ func (h *AwardsHandler) GET(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Success 201 {object} http_models.IdResponse "id awards"
 // @Failure 400 {object} http_models.ErrResponse "invalid parameters"
-// @Failure 422 {object} http_models.ErrResponse "empty name in request", "incorrect value of price", "invalid body in request"
+// @Failure 422 {object} http_models.ErrResponse "empty name in request", "incorrect value of price", "invalid body in request", "trial days must be from 0 to 90", "max subscribers must not be negative"
 // @Failure 500 {object} http_models.ErrResponse "can not do bd operation", "server error"
 // @Failure 409 {object} http_models.ErrResponse "awards with this price already exists", "awards with this name already exists"
 // @Failure 403 {object} http_models.ErrResponse "for this user forbidden change creator",  "csrf token is invalid, get new token"
@@ -112,11 +112,13 @@ func (h *AwardsHandler) POST(w http.ResponseWriter, r *http.Request) {
 	}
 
 	aw := &db_models.Award{
-		Name:        req.Name,
-		Price:       req.Price,
-		Description: req.Description,
-		Color:       color.RGBA{R: req.Color.R, G: req.Color.G, B: req.Color.B, A: req.Color.A},
-		CreatorId:   idInt,
+		Name:           req.Name,
+		Price:          req.Price,
+		Description:    req.Description,
+		Color:          color.RGBA{R: req.Color.R, G: req.Color.G, B: req.Color.B, A: req.Color.A},
+		CreatorId:      idInt,
+		TrialDays:      req.TrialDays,
+		MaxSubscribers: req.MaxSubscribers,
 	}
 
 	awardsId, err := h.awardsUsecase.Create(aw)
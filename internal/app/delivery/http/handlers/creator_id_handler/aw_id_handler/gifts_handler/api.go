@@ -0,0 +1,40 @@
+package aw_gifts_handler
+
+import (
+	"net/http"
+	"patreon/internal/app"
+	"patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_redis "patreon/internal/app/repository/pay_token/redis"
+	repository_subscribers "patreon/internal/app/repository/subscribers"
+	usecase_gifts "patreon/internal/app/usecase/gifts"
+
+	"github.com/sirupsen/logrus"
+)
+
+var codesByErrorsPOST = base_handler.CodeMap{
+	models.IncorrectGiftPeriods: {
+		http.StatusUnprocessableEntity, handler_errors.IncorrectGiftPeriods, logrus.WarnLevel},
+	usecase_gifts.AwardNotBelongCreator: {
+		http.StatusBadRequest, handler_errors.AwardNotBelongCreator, logrus.WarnLevel},
+	usecase_gifts.UserBlocked: {
+		http.StatusForbidden, handler_errors.UserBlocked, logrus.InfoLevel},
+	usecase_gifts.RecipientNotFound: {
+		http.StatusNotFound, handler_errors.GiftRecipientNotFound, logrus.WarnLevel},
+	usecase_gifts.GiftToSelf: {
+		http.StatusUnprocessableEntity, handler_errors.GiftToSelf, logrus.WarnLevel},
+	usecase_gifts.RecipientSubscribed: {
+		http.StatusConflict, handler_errors.GiftRecipientSubscribed, logrus.WarnLevel},
+	repository_subscribers.AwardSoldOut: {
+		http.StatusConflict, handler_errors.AwardSoldOut, logrus.InfoLevel},
+	repository.NotFound: {
+		http.StatusNotFound, handler_errors.AwardNotFound, logrus.WarnLevel},
+	repository_redis.SetError: {
+		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
+	repository.DefaultErrDB: {
+		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
+	app.UnknownError: {
+		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
+}
@@ -0,0 +1,98 @@
+package aw_gifts_handler
+
+import (
+	"net/http"
+	csrf_middleware "patreon/internal/app/csrf/middleware"
+	repository_jwt "patreon/internal/app/csrf/repository/jwt"
+	usecase_csrf "patreon/internal/app/csrf/usecase"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/delivery/http/models"
+	"patreon/internal/app/middleware"
+	db_models "patreon/internal/app/models"
+	useAwards "patreon/internal/app/usecase/awards"
+	usecase_gifts "patreon/internal/app/usecase/gifts"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/sirupsen/logrus"
+)
+
+type AwardsGiftsHandler struct {
+	giftsUsecase usecase_gifts.Usecase
+	bh.BaseHandler
+}
+
+func NewAwardsGiftsHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucGifts usecase_gifts.Usecase, ucAwards useAwards.Usecase) *AwardsGiftsHandler {
+	h := &AwardsGiftsHandler{
+		giftsUsecase: ucGifts,
+		BaseHandler:  *bh.NewBaseHandler(log),
+	}
+	h.AddMethod(http.MethodPost, h.POST, session_middleware.NewSessionMiddleware(sClient, log).CheckFunc,
+		csrf_middleware.NewCsrfMiddleware(log, usecase_csrf.NewCsrfUsecase(repository_jwt.NewJwtRepository())).CheckCsrfTokenFunc,
+		middleware.NewAwardsMiddleware(log, ucAwards).CheckCorrectAwardFunc,
+	)
+	return h
+}
+
+// POST BuyGift
+// @Summary buy gift subscription on award
+// @tags gifts
+// @Description buy subscription on award for periods months for other user. Gift is paid by returned pay_token
+// @Description with /user/payments/checkout, amount is award price multiplied by periods.
+// @Description Gift for recipient_nickname is granted to recipient at once after payment,
+// @Description otherwise returned code can be redeemed by anyone with /user/gifts/redeem
+// @Accept json
+// @Produce json
+// @Param award_id path int true "award_id"
+// @Param creator_id path int true "creator_id"
+// @Param gift body http_models.RequestGift true "Request body"
+// @Success 201 {object} http_models.ResponseGift "Gift created, waits for payment"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters", "award not belongs to creator"
+// @Failure 404 {object} http_models.ErrResponse "award with this id not found", "gift recipient not found"
+// @Failure 409 {object} http_models.ErrResponse "recipient is already subscribed on other award of this creator", "all seats of award are taken, join its waitlist"
+// @Failure 422 {object} http_models.ErrResponse "invalid body in request", "gift periods must be from 1 to 12", "gift can not be bought for yourself"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 403 {object} http_models.ErrResponse "this awards not belongs this creators", "csrf token is invalid, get new token", "user is blocked by creator"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/awards/{:award_id}/gifts [POST]
+func (h *AwardsGiftsHandler) POST(w http.ResponseWriter, r *http.Request) {
+	req := &http_models.RequestGift{}
+
+	err := h.GetRequestBody(w, r, req, *bluemonday.UGCPolicy())
+	if err != nil || req.Validate() != nil {
+		h.Log(r).Warnf("can not parse request %s", err)
+		h.Error(w, r, http.StatusUnprocessableEntity, handler_errors.InvalidBody)
+		return
+	}
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		h.Log(r).Error("can not get user_id from context")
+		h.Error(w, r, http.StatusInternalServerError, handler_errors.InternalError)
+		return
+	}
+	creatorID, ok := h.GetInt64FromParam(w, r, "creator_id")
+	if !ok {
+		return
+	}
+	awardID, ok := h.GetInt64FromParam(w, r, "award_id")
+	if !ok {
+		return
+	}
+
+	gift, err := h.giftsUsecase.Create(&db_models.Gift{
+		PayerID:           userID.(int64),
+		RecipientNickname: req.RecipientNickname,
+		CreatorID:         creatorID,
+		AwardID:           awardID,
+		Periods:           req.Periods,
+	})
+	if err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsPOST)
+		return
+	}
+	h.Log(r).Debugf("gift %d on award %d bought by user %d", gift.ID, awardID, gift.PayerID)
+	h.Respond(w, r, http.StatusCreated, http_models.ResponseGift{Gift: *gift})
+}
@@ -6,6 +6,8 @@ import (
 	"patreon/internal/app/delivery/http/handlers/handler_errors"
 	"patreon/internal/app/repository"
 	repository_redis "patreon/internal/app/repository/pay_token/redis"
+	repository_promo_codes "patreon/internal/app/repository/promo_codes"
+	repository_subscribers "patreon/internal/app/repository/subscribers"
 	usecase_pay_token "patreon/internal/app/usecase/pay_token"
 	usecase_subscribers "patreon/internal/app/usecase/subscribers"
 
@@ -15,10 +17,26 @@ import (
 var codesByErrorsPOST = base_handler.CodeMap{
 	usecase_pay_token.InvalidUserToken: {
 		http.StatusBadRequest, handler_errors.InvalidUserPayToken, logrus.WarnLevel},
+	usecase_pay_token.TokenNotForAward: {
+		http.StatusBadRequest, handler_errors.PayTokenNotForAward, logrus.WarnLevel},
+	usecase_pay_token.TokenAlreadyUsed: {
+		http.StatusConflict, handler_errors.PayTokenAlreadyUsed, logrus.WarnLevel},
+	repository_redis.NotFound: {
+		http.StatusNotFound, handler_errors.PayTokenNotFound, logrus.WarnLevel},
+	repository_redis.InvalidStorageData: {
+		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
 	repository_redis.SetError: {
 		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
 	usecase_subscribers.SubscriptionAlreadyExists: {
 		http.StatusConflict, handler_errors.UserAlreadySubscribe, logrus.ErrorLevel},
+	repository_promo_codes.PromoCodeExhausted: {
+		http.StatusUnprocessableEntity, handler_errors.PromoCodeNotActive, logrus.WarnLevel},
+	repository_promo_codes.PromoCodeAlreadyUsed: {
+		http.StatusConflict, handler_errors.PromoCodeAlreadyUsed, logrus.WarnLevel},
+	repository_subscribers.AwardSoldOut: {
+		http.StatusConflict, handler_errors.AwardSoldOut, logrus.InfoLevel},
+	usecase_subscribers.UserBlocked: {
+		http.StatusForbidden, handler_errors.UserBlocked, logrus.InfoLevel},
 	repository.DefaultErrDB: {
 		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
 }
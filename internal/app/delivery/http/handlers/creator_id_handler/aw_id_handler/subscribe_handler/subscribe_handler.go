@@ -16,6 +16,7 @@ import (
 	usecase_subscribers "patreon/internal/app/usecase/subscribers"
 	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
 	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+	"strings"
 
 	"github.com/gorilla/mux"
 	"github.com/sirupsen/logrus"
@@ -50,12 +51,15 @@ func NewAwardsSubscribeHandler(log *logrus.Logger, sClient session_client.AuthCh
 // DELETE Unsubscribe
 // @Summary unsubscribe from the creator
 // @tags awards
-// @Description unsubscribe from the creator with id = creator_id and awards_id = award_id
+// @Description unsubscribe from the creator with id = creator_id and awards_id = award_id.
+// @Description Reason of cancellation is optional, it is shown to creator in subscription events
 // @Produce json
 // @Param award_id path int true "award_id"
 // @Param creator_id path int true "creator_id"
+// @Param reason query string false "reason of cancellation"
 // @Success 200 "Successfully unsubscribe on the creator with id = creator_id"
 // @Failure 400 {object} http_models.ErrResponse "invalid parameters"
+// @Failure 422 {object} http_models.ErrResponse "cancel reason is too long"
 // @Failure 404 {object} http_models.ErrResponse "award with this id not found"
 // @Failure 409 {object} http_models.ErrResponse "subscribes on the creator not found"
 // @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
@@ -76,12 +80,18 @@ func (h *AwardsSubscribeHandler) DELETE(w http.ResponseWriter, r *http.Request)
 		h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidParameters)
 		return
 	}
+	reason := strings.TrimSpace(bluemonday.UGCPolicy().Sanitize(r.URL.Query().Get("reason")))
+	if err := models.ValidateCancelReason(reason); err != nil {
+		h.Log(r).Warnf("invalid cancel reason: %s", err)
+		h.Error(w, r, http.StatusUnprocessableEntity, handler_errors.IncorrectCancelReason)
+		return
+	}
 	subscriber := &models.Subscriber{
 		UserID:    userID.(int64),
 		CreatorID: creatorID,
 		AwardID:   awardID,
 	}
-	err := h.subscriberUsecase.UnSubscribe(subscriber)
+	err := h.subscriberUsecase.UnSubscribe(h.Log(r), subscriber, reason)
 	if err != nil {
 		h.UsecaseError(w, r, err, codesByErrorsDELETE)
 		return
@@ -93,18 +103,21 @@ func (h *AwardsSubscribeHandler) DELETE(w http.ResponseWriter, r *http.Request)
 // POST Subscribe
 // @Summary subscribes on the creator
 // @tags awards
-// @Description subscribes on the creator with id = creator_id
+// @Description subscribes on the creator with id = creator_id, pay token must be given for this award
+// @Description and can be used only once. Payment amount is award price at the moment token was given
+// @Description minus discount of promo code fixed in token, promo code use is counted on subscribe
 // @Accept json
 // @Produce json
 // @Param award_id path int true "award_id"
 // @Param creator_id path int true "creator_id"
 // @Param pay_token body http_models.SubscribeRequest true "Request payToken"
 // @Success 201 "Successfully subscribe on the creator with id = creator_id"
-// @Failure 400 {object} http_models.ErrResponse "invalid parameters", "this user was not given this token"
-// @Failure 409 {object} http_models.ErrResponse "this user already have subscribe on creator"
-// @Failure 404 {object} http_models.ErrResponse "award with this id not found"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters", "this user was not given this token", "pay token was given for other award"
+// @Failure 409 {object} http_models.ErrResponse "this user already have subscribe on creator", "pay token already used", "user already used this promo code", "all seats of award are taken, join its waitlist"
+// @Failure 404 {object} http_models.ErrResponse "award with this id not found", "pay token not found"
+// @Failure 422 {object} http_models.ErrResponse "promo code is expired, deactivated or exhausted"
 // @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
-// @Failure 403 {object} http_models.ErrResponse "this awards not belongs this creators", "csrf token is invalid, get new token"
+// @Failure 403 {object} http_models.ErrResponse "this awards not belongs this creators", "csrf token is invalid, get new token", "user is blocked by creator"
 // @Failure 401 "user are not authorized"
 // @Router /creators/{:creator_id}/awards/{:award_id}/subscribe [POST]
 func (h *AwardsSubscribeHandler) POST(w http.ResponseWriter, r *http.Request) {
@@ -137,14 +150,18 @@ func (h *AwardsSubscribeHandler) POST(w http.ResponseWriter, r *http.Request) {
 		AwardID:   awardID,
 	}
 
-	err = h.payTokenUsecase.CheckTokenByUser(models.PayToken{Token: req.Token}, userID.(int64))
+	payToken, err := h.payTokenUsecase.UseToken(models.PayToken{Token: req.Token}, subscriber)
 	if err != nil {
-		h.Log(r).Warnf("checkTokenVyUser error; err = %s, token from req = %s, userID = %v", err, req.Token, userID)
+		h.Log(r).Warnf("useToken error; err = %s, token from req = %s, userID = %v", err, req.Token, userID)
 		h.UsecaseError(w, r, err, codesByErrorsPOST)
 		return
 	}
-	err = h.subscriberUsecase.Subscribe(subscriber, req.Token)
+	err = h.subscriberUsecase.Subscribe(subscriber, payToken)
 	if err != nil {
+		// subscription was not created, so token can be used for other try
+		if errRelease := h.payTokenUsecase.ReleaseToken(payToken); errRelease != nil {
+			h.Log(r).Errorf("Try release token %s, and got err %s", req.Token, errRelease)
+		}
 		h.UsecaseError(w, r, err, codesByErrorsPOST)
 		return
 	}
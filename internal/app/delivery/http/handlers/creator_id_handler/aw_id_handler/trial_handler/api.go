@@ -0,0 +1,31 @@
+package aw_trial_handler
+
+import (
+	"net/http"
+	"patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/repository"
+	repository_subscribers "patreon/internal/app/repository/subscribers"
+	usecase_subscribers "patreon/internal/app/usecase/subscribers"
+
+	"github.com/sirupsen/logrus"
+)
+
+var codesByErrorsPOST = base_handler.CodeMap{
+	usecase_subscribers.AwardNotBelongCreator: {
+		http.StatusBadRequest, handler_errors.AwardNotBelongCreator, logrus.WarnLevel},
+	usecase_subscribers.TrialNotAvailable: {
+		http.StatusConflict, handler_errors.TrialNotAvailable, logrus.InfoLevel},
+	repository_subscribers.TrialNotAllowed: {
+		http.StatusConflict, handler_errors.TrialNotAllowed, logrus.InfoLevel},
+	repository_subscribers.TrialAlreadyUsed: {
+		http.StatusConflict, handler_errors.TrialAlreadyUsed, logrus.InfoLevel},
+	repository_subscribers.AwardSoldOut: {
+		http.StatusConflict, handler_errors.AwardSoldOut, logrus.InfoLevel},
+	usecase_subscribers.UserBlocked: {
+		http.StatusForbidden, handler_errors.UserBlocked, logrus.InfoLevel},
+	repository.NotFound: {
+		http.StatusNotFound, handler_errors.AwardNotFound, logrus.WarnLevel},
+	repository.DefaultErrDB: {
+		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
+}
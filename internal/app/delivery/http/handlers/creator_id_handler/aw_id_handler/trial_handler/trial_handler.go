@@ -0,0 +1,82 @@
+package aw_trial_handler
+
+import (
+	"net/http"
+	csrf_middleware "patreon/internal/app/csrf/middleware"
+	repository_jwt "patreon/internal/app/csrf/repository/jwt"
+	usecase_csrf "patreon/internal/app/csrf/usecase"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/delivery/http/models"
+	"patreon/internal/app/middleware"
+	db_models "patreon/internal/app/models"
+	useAwards "patreon/internal/app/usecase/awards"
+	usecase_subscribers "patreon/internal/app/usecase/subscribers"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/sirupsen/logrus"
+)
+
+type AwardsTrialHandler struct {
+	subscribersUsecase usecase_subscribers.Usecase
+	bh.BaseHandler
+}
+
+func NewAwardsTrialHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucSubscribers usecase_subscribers.Usecase, ucAwards useAwards.Usecase) *AwardsTrialHandler {
+	h := &AwardsTrialHandler{
+		subscribersUsecase: ucSubscribers,
+		BaseHandler:        *bh.NewBaseHandler(log),
+	}
+	h.AddMethod(http.MethodPost, h.POST, session_middleware.NewSessionMiddleware(sClient, log).CheckFunc,
+		csrf_middleware.NewCsrfMiddleware(log, usecase_csrf.NewCsrfUsecase(repository_jwt.NewJwtRepository())).CheckCsrfTokenFunc,
+		middleware.NewAwardsMiddleware(log, ucAwards).CheckCorrectAwardFunc,
+	)
+	return h
+}
+
+// POST StartTrial
+// @Summary start free trial of award
+// @tags subscribers
+// @Description start free trial of award without payment. Trial is allowed once and only for user who was
+// @Description never subscribed on creator. Trial subscription must be paid by renewal pay token before it ends
+// @Produce json
+// @Param award_id path int true "award_id"
+// @Param creator_id path int true "creator_id"
+// @Success 201 {object} http_models.ResponseTrial "Trial started"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters", "award not belongs to creator"
+// @Failure 404 {object} http_models.ErrResponse "award with this id not found"
+// @Failure 409 {object} http_models.ErrResponse "award has not free trial", "free trial is only for users never subscribed on this creator", "free trial on this creator already used", "all seats of award are taken, join its waitlist"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 403 {object} http_models.ErrResponse "this awards not belongs this creators", "csrf token is invalid, get new token", "user is blocked by creator"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/awards/{:award_id}/trial [POST]
+func (h *AwardsTrialHandler) POST(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		h.Log(r).Error("can not get user_id from context")
+		h.Error(w, r, http.StatusInternalServerError, handler_errors.InternalError)
+		return
+	}
+	creatorID, ok := h.GetInt64FromParam(w, r, "creator_id")
+	if !ok {
+		return
+	}
+	awardID, ok := h.GetInt64FromParam(w, r, "award_id")
+	if !ok {
+		return
+	}
+
+	trial := &db_models.Trial{
+		UserID:    userID.(int64),
+		CreatorID: creatorID,
+		AwardID:   awardID,
+	}
+	if err := h.subscribersUsecase.StartTrial(trial); err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsPOST)
+		return
+	}
+	h.Log(r).Debugf("user %d started trial of award %d until %v", trial.UserID, awardID, trial.EndsAt)
+	h.Respond(w, r, http.StatusCreated, http_models.ResponseTrial{Trial: *trial})
+}
@@ -24,6 +24,10 @@ var codesByErrorsPUT = base_handler.CodeMap{
 		http.StatusUnprocessableEntity, handler_errors.EmptyName, logrus.WarnLevel},
 	models.IncorrectAwardsPrice: {
 		http.StatusUnprocessableEntity, handler_errors.IncorrectPrice, logrus.WarnLevel},
+	models.IncorrectTrialDays: {
+		http.StatusUnprocessableEntity, handler_errors.IncorrectTrialDays, logrus.WarnLevel},
+	models.IncorrectMaxSubscribers: {
+		http.StatusUnprocessableEntity, handler_errors.IncorrectMaxSubscribers, logrus.WarnLevel},
 	app.UnknownError: {
 		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
 }
@@ -51,7 +51,7 @@ func NewAwardsUpdHandler(log *logrus.Logger,
 // @Success 200
 // @Failure 400 {object} http_models.ErrResponse "invalid parameters"
 // @Failure 404 {object} http_models.ErrResponse "award with this id not found"
-// @Failure 422 {object} http_models.ErrResponse "invalid body in request", "incorrect value of price", "empty name in request"
+// @Failure 422 {object} http_models.ErrResponse "invalid body in request", "incorrect value of price", "empty name in request", "trial days must be from 0 to 90", "max subscribers must not be negative"
 // @Failure 409 {object} http_models.ErrResponse "awards with this name already exists", "awards with this price already exists"
 // @Failure 500 {object} http_models.ErrResponse "can not do bd operation", "server error"
 // @Failure 403 {object} http_models.ErrResponse "for this user forbidden change creator", "this awards not belongs this creators", "csrf token is invalid, get new token"
@@ -78,11 +78,13 @@ func (h *AwardsUpdHandler) PUT(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	award := &bd_modle.Award{
-		ID:          awardsId,
-		Name:        req.Name,
-		Description: req.Description,
-		Price:       req.Price,
-		Color:       color.RGBA{R: req.Color.R, B: req.Color.B, G: req.Color.G, A: req.Color.A},
+		ID:             awardsId,
+		Name:           req.Name,
+		Description:    req.Description,
+		Price:          req.Price,
+		Color:          color.RGBA{R: req.Color.R, B: req.Color.B, G: req.Color.G, A: req.Color.A},
+		TrialDays:      req.TrialDays,
+		MaxSubscribers: req.MaxSubscribers,
 	}
 
 	err = h.awardsUsecase.Update(award)
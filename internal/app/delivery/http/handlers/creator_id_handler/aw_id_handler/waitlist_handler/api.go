@@ -0,0 +1,27 @@
+package aw_waitlist_handler
+
+import (
+	"net/http"
+	"patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/repository"
+	usecase_subscribers "patreon/internal/app/usecase/subscribers"
+
+	"github.com/sirupsen/logrus"
+)
+
+var codesByErrorsPOST = base_handler.CodeMap{
+	usecase_subscribers.AwardNotBelongCreator: {
+		http.StatusBadRequest, handler_errors.AwardNotBelongCreator, logrus.WarnLevel},
+	usecase_subscribers.AwardHasFreeSeats: {
+		http.StatusConflict, handler_errors.AwardHasFreeSeats, logrus.InfoLevel},
+	repository.NotFound: {
+		http.StatusNotFound, handler_errors.AwardNotFound, logrus.WarnLevel},
+	repository.DefaultErrDB: {
+		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
+}
+
+var codesByErrorsDELETE = base_handler.CodeMap{
+	repository.DefaultErrDB: {
+		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
+}
@@ -0,0 +1,119 @@
+package aw_waitlist_handler
+
+import (
+	"net/http"
+	csrf_middleware "patreon/internal/app/csrf/middleware"
+	repository_jwt "patreon/internal/app/csrf/repository/jwt"
+	usecase_csrf "patreon/internal/app/csrf/usecase"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/middleware"
+	db_models "patreon/internal/app/models"
+	useAwards "patreon/internal/app/usecase/awards"
+	usecase_subscribers "patreon/internal/app/usecase/subscribers"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/sirupsen/logrus"
+)
+
+type AwardsWaitlistHandler struct {
+	subscribersUsecase usecase_subscribers.Usecase
+	bh.BaseHandler
+}
+
+func NewAwardsWaitlistHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucSubscribers usecase_subscribers.Usecase, ucAwards useAwards.Usecase) *AwardsWaitlistHandler {
+	h := &AwardsWaitlistHandler{
+		subscribersUsecase: ucSubscribers,
+		BaseHandler:        *bh.NewBaseHandler(log),
+	}
+	h.AddMethod(http.MethodPost, h.POST, session_middleware.NewSessionMiddleware(sClient, log).CheckFunc,
+		csrf_middleware.NewCsrfMiddleware(log, usecase_csrf.NewCsrfUsecase(repository_jwt.NewJwtRepository())).CheckCsrfTokenFunc,
+		middleware.NewAwardsMiddleware(log, ucAwards).CheckCorrectAwardFunc,
+	)
+	h.AddMethod(http.MethodDelete, h.DELETE, session_middleware.NewSessionMiddleware(sClient, log).CheckFunc,
+		csrf_middleware.NewCsrfMiddleware(log, usecase_csrf.NewCsrfUsecase(repository_jwt.NewJwtRepository())).CheckCsrfTokenFunc,
+		middleware.NewAwardsMiddleware(log, ucAwards).CheckCorrectAwardFunc,
+	)
+	return h
+}
+
+// POST JoinWaitlist
+// @Summary join waitlist of award
+// @tags subscribers
+// @Description join waitlist of award which all seats are taken. Users of waitlist get push
+// @Description when seat of award is freed, user leaves waitlist when subscribes on award
+// @Produce json
+// @Param award_id path int true "award_id"
+// @Param creator_id path int true "creator_id"
+// @Success 201 "User joined waitlist"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters", "award not belongs to creator"
+// @Failure 404 {object} http_models.ErrResponse "award with this id not found"
+// @Failure 409 {object} http_models.ErrResponse "award has free seats, subscribe on it"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 403 {object} http_models.ErrResponse "this awards not belongs this creators", "csrf token is invalid, get new token"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/awards/{:award_id}/waitlist [POST]
+func (h *AwardsWaitlistHandler) POST(w http.ResponseWriter, r *http.Request) {
+	subscriber, ok := h.getSubscriber(w, r)
+	if !ok {
+		return
+	}
+	if err := h.subscribersUsecase.JoinWaitlist(subscriber); err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsPOST)
+		return
+	}
+	h.Log(r).Debugf("user %d joined waitlist of award %d", subscriber.UserID, subscriber.AwardID)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// DELETE LeaveWaitlist
+// @Summary leave waitlist of award
+// @tags subscribers
+// @Description leave waitlist of award, nothing happens if user is not in waitlist
+// @Produce json
+// @Param award_id path int true "award_id"
+// @Param creator_id path int true "creator_id"
+// @Success 200 "User left waitlist"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters"
+// @Failure 404 {object} http_models.ErrResponse "award with this id not found"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 403 {object} http_models.ErrResponse "this awards not belongs this creators", "csrf token is invalid, get new token"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/awards/{:award_id}/waitlist [DELETE]
+func (h *AwardsWaitlistHandler) DELETE(w http.ResponseWriter, r *http.Request) {
+	subscriber, ok := h.getSubscriber(w, r)
+	if !ok {
+		return
+	}
+	if err := h.subscribersUsecase.LeaveWaitlist(subscriber); err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsDELETE)
+		return
+	}
+	h.Log(r).Debugf("user %d left waitlist of award %d", subscriber.UserID, subscriber.AwardID)
+	w.WriteHeader(http.StatusOK)
+}
+
+// getSubscriber user from context with creator and award from path
+func (h *AwardsWaitlistHandler) getSubscriber(w http.ResponseWriter, r *http.Request) (*db_models.Subscriber, bool) {
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		h.Log(r).Error("can not get user_id from context")
+		h.Error(w, r, http.StatusInternalServerError, handler_errors.InternalError)
+		return nil, false
+	}
+	creatorID, ok := h.GetInt64FromParam(w, r, "creator_id")
+	if !ok {
+		return nil, false
+	}
+	awardID, ok := h.GetInt64FromParam(w, r, "award_id")
+	if !ok {
+		return nil, false
+	}
+	return &db_models.Subscriber{
+		UserID:    userID.(int64),
+		CreatorID: creatorID,
+		AwardID:   awardID,
+	}, true
+}
@@ -0,0 +1,68 @@
+package balance_handler
+
+import (
+	"net/http"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/delivery/http/models"
+	"patreon/internal/app/middleware"
+	usecase_ledger "patreon/internal/app/usecase/ledger"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+)
+
+type BalanceHandler struct {
+	ledgerUsecase usecase_ledger.Usecase
+	bh.BaseHandler
+}
+
+func NewBalanceHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucLedger usecase_ledger.Usecase) *BalanceHandler {
+	h := &BalanceHandler{
+		ledgerUsecase: ucLedger,
+		BaseHandler:   *bh.NewBaseHandler(log),
+	}
+	h.AddMethod(http.MethodGet, h.GET,
+		session_middleware.NewSessionMiddleware(sClient, log).CheckFunc,
+		middleware.NewCreatorsMiddleware(log).CheckAllowUserFunc,
+	)
+	return h
+}
+
+// GET CreatorBalance
+// @Summary get creator balance
+// @tags payouts
+// @Description get creator balance with platform fees, requested and paid payouts.
+// @Description Reconciled is false if ledger totals differ from paid payments minus refunds
+// @Produce json
+// @Param creator_id path int true "creator_id"
+// @Success 200 {object} http_models.ResponseCreatorBalance "Success"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters"
+// @Failure 403 {object} http_models.ErrResponse "this user not have permission for this creator"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/balance [GET]
+func (h *BalanceHandler) GET(w http.ResponseWriter, r *http.Request) {
+	creatorID, ok := h.GetInt64FromParam(w, r, "creator_id")
+	if !ok {
+		return
+	}
+	if len(mux.Vars(r)) > 1 {
+		h.Log(r).Warnf("Too many parametres %v", mux.Vars(r))
+		h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidParameters)
+		return
+	}
+
+	balance, err := h.ledgerUsecase.GetBalance(creatorID)
+	if err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsGET)
+		return
+	}
+	if !balance.Reconciled {
+		h.Log(r).Errorf("ledger of creator %d is not reconciled with payments: %+v", creatorID, balance)
+	}
+	h.Respond(w, r, http.StatusOK, http_models.ResponseCreatorBalance{CreatorBalance: *balance})
+}
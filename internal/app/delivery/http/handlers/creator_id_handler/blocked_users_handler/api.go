@@ -0,0 +1,32 @@
+package blocked_users_handler
+
+import (
+	"net/http"
+	"patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/repository"
+	usecase_blocked_users "patreon/internal/app/usecase/blocked_users"
+
+	"github.com/sirupsen/logrus"
+)
+
+var codesByErrorsGET = base_handler.CodeMap{
+	repository.DefaultErrDB: {
+		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
+}
+
+var codesByErrorsPOST = base_handler.CodeMap{
+	usecase_blocked_users.CanNotBlockSelf: {
+		http.StatusUnprocessableEntity, handler_errors.BlockSelf, logrus.WarnLevel},
+	repository.NotFound: {
+		http.StatusNotFound, handler_errors.UserNotFound, logrus.WarnLevel},
+	repository.DefaultErrDB: {
+		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
+}
+
+var codesByErrorsDELETE = base_handler.CodeMap{
+	repository.NotFound: {
+		http.StatusNotFound, handler_errors.UserNotFound, logrus.WarnLevel},
+	repository.DefaultErrDB: {
+		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
+}
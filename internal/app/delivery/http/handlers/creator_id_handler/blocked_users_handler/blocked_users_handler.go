@@ -0,0 +1,170 @@
+package blocked_users_handler
+
+import (
+	"net/http"
+	csrf_middleware "patreon/internal/app/csrf/middleware"
+	repository_jwt "patreon/internal/app/csrf/repository/jwt"
+	usecase_csrf "patreon/internal/app/csrf/usecase"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/delivery/http/models"
+	"patreon/internal/app/middleware"
+	db_models "patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	usecase_blocked_users "patreon/internal/app/usecase/blocked_users"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/gorilla/mux"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/sirupsen/logrus"
+)
+
+type BlockedUsersHandler struct {
+	blockedUsecase usecase_blocked_users.Usecase
+	bh.BaseHandler
+}
+
+func NewBlockedUsersHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucBlocked usecase_blocked_users.Usecase) *BlockedUsersHandler {
+	h := &BlockedUsersHandler{
+		blockedUsecase: ucBlocked,
+		BaseHandler:    *bh.NewBaseHandler(log),
+	}
+	h.AddMethod(http.MethodGet, h.GET,
+		session_middleware.NewSessionMiddleware(sClient, log).CheckFunc,
+		middleware.NewCreatorsMiddleware(log).CheckAllowUserFunc,
+	)
+	h.AddMethod(http.MethodPost, h.POST,
+		session_middleware.NewSessionMiddleware(sClient, log).CheckFunc,
+		middleware.NewCreatorsMiddleware(log).CheckAllowUserFunc,
+		csrf_middleware.NewCsrfMiddleware(log, usecase_csrf.NewCsrfUsecase(repository_jwt.NewJwtRepository())).CheckCsrfTokenFunc,
+	)
+	h.AddMethod(http.MethodDelete, h.DELETE,
+		session_middleware.NewSessionMiddleware(sClient, log).CheckFunc,
+		middleware.NewCreatorsMiddleware(log).CheckAllowUserFunc,
+		csrf_middleware.NewCsrfMiddleware(log, usecase_csrf.NewCsrfUsecase(repository_jwt.NewJwtRepository())).CheckCsrfTokenFunc,
+	)
+	return h
+}
+
+// GET BlockedUsers
+// @Summary get blocked users
+// @tags creators
+// @Description get page of users blocked by creator, the last blocked first
+// @Produce json
+// @Param creator_id path int true "creator_id"
+// @Param page query uint64 true "start page number of users mutually exclusive with offset"
+// @Param offset query uint64 true "start number of users mutually exclusive with page"
+// @Param limit query uint64 true "users to return"
+// @Success 200 {object} http_models.ResponseBlockedUsers "Success"
+// @Failure 204 {object} http_models.OkResponse "creator blocked users not found"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters", "invalid parameters in query"
+// @Failure 403 {object} http_models.ErrResponse "this user not have permission for this creator"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/blocked [GET]
+func (h *BlockedUsersHandler) GET(w http.ResponseWriter, r *http.Request) {
+	limit, offset, ok := h.GetPaginationFromQuery(w, r)
+	if !ok {
+		return
+	}
+	creatorID, ok := h.GetInt64FromParam(w, r, "creator_id")
+	if !ok {
+		return
+	}
+	if len(mux.Vars(r)) > 1 {
+		h.Log(r).Warnf("Too many parameters %v", mux.Vars(r))
+		h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidParameters)
+		return
+	}
+
+	users, err := h.blockedUsecase.GetBlocked(creatorID, &db_models.Pagination{Limit: limit, Offset: offset})
+	if err != nil {
+		if err == repository.NotFound {
+			h.Respond(w, r, http.StatusNoContent, http_models.OkResponse{
+				Ok: handler_errors.BlockedUsersNotFound.Error(),
+			})
+		} else {
+			h.UsecaseError(w, r, err, codesByErrorsGET)
+		}
+		return
+	}
+	h.Log(r).Debugf("get %d blocked users of creator %d", len(users), creatorID)
+	h.Respond(w, r, http.StatusOK, http_models.ResponseBlockedUsers{BlockedUsers: users})
+}
+
+// POST BlockUser
+// @Summary block user
+// @tags creators
+// @Description block user, he can not subscribe on creator and comment his posts, his comments are hidden.
+// @Description Active subscription of user stays until the end of paid period, with cancel_subscription
+// @Description it is not renewed after it. Blocking of already blocked user changes nothing
+// @Accept json
+// @Produce json
+// @Param creator_id path int true "creator_id"
+// @Param user body http_models.RequestBlockUser true "Request body"
+// @Success 201 "User blocked"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters"
+// @Failure 404 {object} http_models.ErrResponse "user not found"
+// @Failure 422 {object} http_models.ErrResponse "invalid body in request", "creator can not block himself"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 403 {object} http_models.ErrResponse "csrf token is invalid, get new token", "this user not have permission for this creator"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/blocked [POST]
+func (h *BlockedUsersHandler) POST(w http.ResponseWriter, r *http.Request) {
+	req := &http_models.RequestBlockUser{}
+
+	err := h.GetRequestBody(w, r, req, *bluemonday.UGCPolicy())
+	if err != nil || req.Validate() != nil {
+		h.Log(r).Warnf("can not parse request %s", err)
+		h.Error(w, r, http.StatusUnprocessableEntity, handler_errors.InvalidBody)
+		return
+	}
+	creatorID, ok := h.GetInt64FromParam(w, r, "creator_id")
+	if !ok {
+		return
+	}
+
+	if err = h.blockedUsecase.Block(creatorID, req.UserID, req.CancelSubscription); err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsPOST)
+		return
+	}
+	h.Log(r).Debugf("user %d blocked by creator %d", req.UserID, creatorID)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// DELETE UnblockUser
+// @Summary unblock user
+// @tags creators
+// @Description remove user from block list of creator, subscription stopped by blocking is not restored
+// @Produce json
+// @Param creator_id path int true "creator_id"
+// @Param user_id query uint64 true "blocked user"
+// @Success 200 "User unblocked"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters", "invalid parameters in query"
+// @Failure 404 {object} http_models.ErrResponse "user not found"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 403 {object} http_models.ErrResponse "csrf token is invalid, get new token", "this user not have permission for this creator"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/blocked [DELETE]
+func (h *BlockedUsersHandler) DELETE(w http.ResponseWriter, r *http.Request) {
+	creatorID, ok := h.GetInt64FromParam(w, r, "creator_id")
+	if !ok {
+		return
+	}
+	userID, ok := h.GetInt64FromQueries(w, r, "user_id")
+	if !ok {
+		if userID == bh.EmptyQuery {
+			h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidQueries)
+		}
+		return
+	}
+
+	if err := h.blockedUsecase.Unblock(creatorID, userID); err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsDELETE)
+		return
+	}
+	h.Log(r).Debugf("user %d unblocked by creator %d", userID, creatorID)
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,75 @@
+package ledger_handler
+
+import (
+	"net/http"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/delivery/http/models"
+	"patreon/internal/app/middleware"
+	db_models "patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	usecase_ledger "patreon/internal/app/usecase/ledger"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/sirupsen/logrus"
+)
+
+type LedgerHandler struct {
+	ledgerUsecase usecase_ledger.Usecase
+	bh.BaseHandler
+}
+
+func NewLedgerHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucLedger usecase_ledger.Usecase) *LedgerHandler {
+	h := &LedgerHandler{
+		ledgerUsecase: ucLedger,
+		BaseHandler:   *bh.NewBaseHandler(log),
+	}
+	h.AddMethod(http.MethodGet, h.GET,
+		session_middleware.NewSessionMiddleware(sClient, log).CheckFunc,
+		middleware.NewCreatorsMiddleware(log).CheckAllowUserFunc,
+	)
+	return h
+}
+
+// GET LedgerEntries
+// @Summary get creator ledger entries
+// @tags payouts
+// @Description get postings of creator ledger, last first. Every payment, refund and payout
+// @Description is recorded by entries on payer, creator, platform and payout accounts with zero sum
+// @Produce json
+// @Param creator_id path int true "creator_id"
+// @Param page query uint64 true "start page number of entries mutually exclusive with offset"
+// @Param offset query uint64 true "start number of entries mutually exclusive with page"
+// @Param limit query uint64 true "entries to return"
+// @Success 200 {object} http_models.ResponseLedgerEntries "Success"
+// @Failure 204 {object} http_models.OkResponse "ledger entries not found"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters"
+// @Failure 403 {object} http_models.ErrResponse "this user not have permission for this creator"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/ledger [GET]
+func (h *LedgerHandler) GET(w http.ResponseWriter, r *http.Request) {
+	limit, offset, ok := h.GetPaginationFromQuery(w, r)
+	if !ok {
+		return
+	}
+	creatorID, ok := h.GetInt64FromParam(w, r, "creator_id")
+	if !ok {
+		return
+	}
+
+	entries, err := h.ledgerUsecase.GetEntries(creatorID, &db_models.Pagination{Limit: limit, Offset: offset})
+	if err != nil {
+		if err == repository.NotFound {
+			h.Respond(w, r, http.StatusNoContent, http_models.OkResponse{
+				Ok: handler_errors.LedgerEntriesNotFound.Error(),
+			})
+		} else {
+			h.UsecaseError(w, r, err, codesByErrorsGET)
+		}
+		return
+	}
+	h.Respond(w, r, http.StatusOK, http_models.ResponseLedgerEntries{Entries: entries})
+}
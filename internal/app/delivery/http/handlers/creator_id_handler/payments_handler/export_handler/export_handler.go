@@ -0,0 +1,87 @@
+package payments_export_handler
+
+import (
+	"fmt"
+	"net/http"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/middleware"
+	"patreon/internal/app/usecase/payments"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/sirupsen/logrus"
+)
+
+type PaymentsExportHandler struct {
+	paymentsUsecase payments.Usecase
+	bh.BaseHandler
+}
+
+func NewPaymentsExportHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucPayments payments.Usecase) *PaymentsExportHandler {
+	h := &PaymentsExportHandler{
+		paymentsUsecase: ucPayments,
+		BaseHandler:     *bh.NewBaseHandler(log),
+	}
+	h.AddMethod(http.MethodGet, h.GET,
+		session_middleware.NewSessionMiddleware(sClient, log).CheckFunc,
+		middleware.NewCreatorsMiddleware(log).CheckAllowUserFunc,
+	)
+	return h
+}
+
+// GET PaymentsExport
+// @Summary export creator payments
+// @tags payments
+// @Description download all paid creator payments from filter as csv with header or as json lines, the last first.
+// @Description Payments are streamed, so an error in the middle of export breaks the file
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Param creator_id path int true "creator_id"
+// @Param format query string false "csv (default) or jsonl"
+// @Param from query string false "first date of payments in format YYYY-MM-DD"
+// @Param to query string false "last date of payments in format YYYY-MM-DD"
+// @Param award_id query uint64 false "award paid by payments"
+// @Param state query string false "comma separated payment states"
+// @Success 200 {object} http_models.ResponseExportPayment "Success, one object per line in jsonl format"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters", "invalid parameters in query", "date to must not be before date from", "unknown payment state in filter", "export format must be csv or jsonl"
+// @Failure 403 {object} http_models.ErrResponse "this user not have permission for this creator"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/payments/export [GET]
+func (h *PaymentsExportHandler) GET(w http.ResponseWriter, r *http.Request) {
+	creatorID, ok := h.GetInt64FromParam(w, r, "creator_id")
+	if !ok {
+		return
+	}
+	filter, ok := h.GetPaymentsFilterFromQuery(w, r)
+	if !ok {
+		return
+	}
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = FormatCSV
+	}
+	if format != FormatCSV && format != FormatJSONLines {
+		h.Log(r).Infof("unknown export format %s", format)
+		h.Error(w, r, http.StatusBadRequest, handler_errors.IncorrectExportFormat)
+		return
+	}
+
+	writer := newPaymentsWriter(w, format, fmt.Sprintf("payments_%d", creatorID))
+	err := h.paymentsUsecase.ExportCreatorPayments(creatorID, filter, writer.Write)
+	if err == nil {
+		err = writer.Close()
+	}
+	if err != nil {
+		if !writer.started {
+			h.UsecaseError(w, r, err, codesByErrorsGET)
+			return
+		}
+		h.Log(r).Errorf("export of creator %d payments was interrupted after %d payments with err: %s",
+			creatorID, writer.written, err)
+		return
+	}
+	h.Log(r).Debugf("exported %d payments of creator %d", writer.written, creatorID)
+}
@@ -0,0 +1,110 @@
+package payments_export_handler
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"patreon/internal/app/delivery/http/models"
+	"patreon/internal/app/models"
+
+	"github.com/mailru/easyjson"
+)
+
+const (
+	FormatCSV        = "csv"
+	FormatJSONLines  = "jsonl"
+	flushEveryRecord = 100
+)
+
+// paymentsWriter streams exported payments to response in csv or json lines format.
+// Headers of response are sent with the first payment, so errors before it can still be returned with status code
+type paymentsWriter struct {
+	w        http.ResponseWriter
+	format   string
+	fileName string
+	csv      *csv.Writer
+	started  bool
+	written  int64
+}
+
+func newPaymentsWriter(w http.ResponseWriter, format string, fileName string) *paymentsWriter {
+	pw := &paymentsWriter{
+		w:        w,
+		format:   format,
+		fileName: fileName,
+	}
+	if format == FormatCSV {
+		pw.csv = csv.NewWriter(w)
+	}
+	return pw
+}
+
+func (pw *paymentsWriter) start() error {
+	pw.started = true
+	contentType := "application/x-ndjson"
+	if pw.format == FormatCSV {
+		contentType = "text/csv; charset=utf-8"
+	}
+	pw.w.Header().Set("Content-Type", contentType)
+	pw.w.Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename=\"%s.%s\"", pw.fileName, pw.format))
+	pw.w.WriteHeader(http.StatusOK)
+
+	if pw.format == FormatCSV {
+		return pw.csv.Write(http_models.ExportPaymentsHeader)
+	}
+	return nil
+}
+
+// Write payment to response, it is used as handler of usecase export
+func (pw *paymentsWriter) Write(payment *models.CreatorPayments) error {
+	if !pw.started {
+		if err := pw.start(); err != nil {
+			return err
+		}
+	}
+
+	record := http_models.ToResponseExportPayment(payment)
+	if pw.format == FormatCSV {
+		if err := pw.csv.Write(record.CSVRecord()); err != nil {
+			return err
+		}
+	} else {
+		data, err := easyjson.Marshal(&record)
+		if err != nil {
+			return err
+		}
+		if _, err = pw.w.Write(append(data, '\n')); err != nil {
+			return err
+		}
+	}
+
+	pw.written++
+	if pw.written%flushEveryRecord == 0 {
+		return pw.flush()
+	}
+	return nil
+}
+
+// Close send headers if there was no payments and flush buffered payments
+func (pw *paymentsWriter) Close() error {
+	if !pw.started {
+		if err := pw.start(); err != nil {
+			return err
+		}
+	}
+	return pw.flush()
+}
+
+func (pw *paymentsWriter) flush() error {
+	if pw.format == FormatCSV {
+		pw.csv.Flush()
+		if err := pw.csv.Error(); err != nil {
+			return err
+		}
+	}
+	if flusher, ok := pw.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package payments_export_handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"patreon/internal/app/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testExportPayment() *models.CreatorPayments {
+	payment := &models.CreatorPayments{
+		Payments:     *models.TestPayment(),
+		UserNickname: "user, \"the best\"",
+		AwardID:      3,
+		AwardName:    "gold",
+	}
+	payment.Date = time.Date(2021, 11, 2, 10, 0, 0, 0, time.UTC)
+	payment.State = models.PaymentPartiallyRefunded
+	payment.RefundedAmount = models.Decimal(1050)
+	return payment
+}
+
+func TestPaymentsWriter_CSV(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	writer := newPaymentsWriter(recorder, FormatCSV, "payments_1")
+
+	require.NoError(t, writer.Write(testExportPayment()))
+	require.NoError(t, writer.Close())
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, "attachment; filename=\"payments_1.csv\"", recorder.Header().Get("Content-Disposition"))
+	assert.Equal(t, "date,type,state,amount,refunded_amount,currency,discount,promo_code,award_id,award_name,"+
+		"user_id,user_nickname,tip_message\n"+
+		"2021-11-02T10:00:00Z,subscription,partially_refunded,100.00,10.50,RUB,0.00,,3,gold,11,"+
+		"\"user, \"\"the best\"\"\",\n", recorder.Body.String())
+}
+
+func TestPaymentsWriter_JSONLines(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	writer := newPaymentsWriter(recorder, FormatJSONLines, "payments_1")
+
+	require.NoError(t, writer.Write(testExportPayment()))
+	require.NoError(t, writer.Write(testExportPayment()))
+	require.NoError(t, writer.Close())
+
+	line := `{"date":"2021-11-02T10:00:00Z","type":"subscription","state":"partially_refunded",` +
+		`"amount":100.00,"refunded_amount":10.50,"currency":"RUB","discount":0.00,"promo_code":"",` +
+		`"award_id":3,"award_name":"gold","user_id":11,"user_nickname":"user, \"the best\"","tip_message":""}` + "\n"
+	assert.Equal(t, "application/x-ndjson", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, line+line, recorder.Body.String())
+	assert.Equal(t, int64(2), writer.written)
+}
+
+func TestPaymentsWriter_Empty(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	writer := newPaymentsWriter(recorder, FormatCSV, "payments_1")
+	assert.False(t, writer.started)
+
+	require.NoError(t, writer.Close())
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "date,type,state,amount,refunded_amount,currency,discount,promo_code,award_id,award_name,"+
+		"user_id,user_nickname,tip_message\n", recorder.Body.String())
+}
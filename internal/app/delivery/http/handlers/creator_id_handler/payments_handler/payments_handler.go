@@ -52,13 +52,18 @@ func (h *PaymentsHandler) redirect(w http.ResponseWriter, r *http.Request) {
 // GET CreatorPayments
 // @Summary get all creator payments
 // @tags payments
-// @Description get all creator payments
+// @Description get paid creator payments with current state and history of state changes
 // @Produce json
 // @Param page query uint64 true "start page number of posts mutually exclusive with offset"
 // @Param offset query uint64 true "start number of posts mutually exclusive with page"
 // @Param limit query uint64 true "posts to return"
+// @Param from query string false "first date of payments in format YYYY-MM-DD"
+// @Param to query string false "last date of payments in format YYYY-MM-DD"
+// @Param award_id query uint64 false "award paid by payments"
+// @Param state query string false "comma separated payment states"
 // @Success 200 {object} http_models.ResponseCreatorPayments "Success"
 // @Failure 204 {object} http_models.OkResponse "payments not found"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters in query", "date to must not be before date from", "unknown payment state in filter"
 // @Failure 500 {object} http_models.ErrResponse "server error"
 // @Failure 401 "user are not authorized"
 // @Router /creators/{:creator_id}/payments [GET]
@@ -67,6 +72,10 @@ func (h *PaymentsHandler) GET(w http.ResponseWriter, r *http.Request) {
 	if !ok {
 		return
 	}
+	filter, ok := h.GetPaymentsFilterFromQuery(w, r)
+	if !ok {
+		return
+	}
 
 	vars := mux.Vars(r)
 	id, ok := vars["creator_id"]
@@ -77,7 +86,7 @@ func (h *PaymentsHandler) GET(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	creatorPayments, err := h.paymentsUsecase.GetCreatorPayments(creatorID,
-		&db_models.Pagination{Limit: limit, Offset: offset})
+		&db_models.Pagination{Limit: limit, Offset: offset}, filter)
 	if err != nil {
 		if err == repository.NotFound {
 			h.Respond(w, r, http.StatusNoContent, http_models.OkResponse{
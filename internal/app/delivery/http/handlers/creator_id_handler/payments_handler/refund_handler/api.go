@@ -0,0 +1,34 @@
+package payments_refund_handler
+
+import (
+	"net/http"
+	"patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/payment_provider"
+	"patreon/internal/app/repository"
+	repository_payments "patreon/internal/app/repository/payments"
+	"patreon/internal/app/usecase/payments"
+
+	"github.com/sirupsen/logrus"
+)
+
+var codesByErrorsPOST = base_handler.CodeMap{
+	repository.NotFound: {
+		http.StatusNotFound, handler_errors.PaymentNotFound, logrus.WarnLevel},
+	payments.InvalidRefundAmount: {
+		http.StatusUnprocessableEntity, handler_errors.InvalidRefundAmount, logrus.WarnLevel},
+	payments.InvalidStateTransition: {
+		http.StatusConflict, handler_errors.InvalidPaymentState, logrus.WarnLevel},
+	repository_payments.PaymentStateChanged: {
+		http.StatusConflict, handler_errors.InvalidPaymentState, logrus.WarnLevel},
+	payment_provider.NotSupported: {
+		http.StatusUnprocessableEntity, handler_errors.RefundNotSupported, logrus.WarnLevel},
+	payment_provider.InvalidRefund: {
+		http.StatusConflict, handler_errors.InvalidPaymentState, logrus.ErrorLevel},
+	payment_provider.PaymentNotFound: {
+		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
+	payment_provider.ProviderError: {
+		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
+	repository.DefaultErrDB: {
+		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
+}
@@ -0,0 +1,82 @@
+package payments_refund_handler
+
+import (
+	"net/http"
+	csrf_middleware "patreon/internal/app/csrf/middleware"
+	repository_jwt "patreon/internal/app/csrf/repository/jwt"
+	usecase_csrf "patreon/internal/app/csrf/usecase"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/delivery/http/models"
+	"patreon/internal/app/middleware"
+	"patreon/internal/app/usecase/payments"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/sirupsen/logrus"
+)
+
+type PaymentsRefundHandler struct {
+	paymentsUsecase payments.Usecase
+	bh.BaseHandler
+}
+
+func NewPaymentsRefundHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucPayments payments.Usecase) *PaymentsRefundHandler {
+	h := &PaymentsRefundHandler{
+		paymentsUsecase: ucPayments,
+		BaseHandler:     *bh.NewBaseHandler(log),
+	}
+	h.AddMethod(http.MethodPost, h.POST,
+		session_middleware.NewSessionMiddleware(sClient, log).CheckFunc,
+		middleware.NewCreatorsMiddleware(log).CheckAllowUserFunc,
+		csrf_middleware.NewCsrfMiddleware(log, usecase_csrf.NewCsrfUsecase(repository_jwt.NewJwtRepository())).CheckCsrfTokenFunc,
+	)
+	return h
+}
+
+// POST RefundPayment
+// @Summary refund payment
+// @tags payments
+// @Description return amount of paid payment to user by payment provider, the amount is taken from creator balance.
+// @Description Payment becomes refunded when whole amount is returned, otherwise partially refunded
+// @Accept json
+// @Param creator_id path int true "creator_id"
+// @Param payment_id path int true "payment_id"
+// @Param refund body http_models.RequestRefund true "Request body"
+// @Success 200 "Payment refunded"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters"
+// @Failure 404 {object} http_models.ErrResponse "payment with this id not found"
+// @Failure 409 {object} http_models.ErrResponse "payment can not be paid in current state"
+// @Failure 422 {object} http_models.ErrResponse "invalid body in request", "refund amount must be positive and not greater than not refunded amount", "payment provider does not support refunds"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 403 {object} http_models.ErrResponse "csrf token is invalid, get new token", "this user not have permission for this creator"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/payments/{:payment_id}/refund [POST]
+func (h *PaymentsRefundHandler) POST(w http.ResponseWriter, r *http.Request) {
+	req := &http_models.RequestRefund{}
+
+	err := h.GetRequestBody(w, r, req, *bluemonday.UGCPolicy())
+	if err != nil || req.Validate() != nil {
+		h.Log(r).Warnf("can not parse request %s", err)
+		h.Error(w, r, http.StatusUnprocessableEntity, handler_errors.InvalidBody)
+		return
+	}
+	creatorID, ok := h.GetInt64FromParam(w, r, "creator_id")
+	if !ok {
+		return
+	}
+	paymentID, ok := h.GetInt64FromParam(w, r, "payment_id")
+	if !ok {
+		return
+	}
+
+	err = h.paymentsUsecase.RefundCreatorPayment(creatorID, paymentID, req.Amount, req.Reason)
+	if err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsPOST)
+		return
+	}
+	h.Log(r).Debugf("payment %d of creator %d refunded by %s", paymentID, creatorID, req.Amount)
+	w.WriteHeader(http.StatusOK)
+}
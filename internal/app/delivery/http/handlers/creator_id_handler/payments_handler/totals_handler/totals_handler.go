@@ -0,0 +1,66 @@
+package payments_totals_handler
+
+import (
+	"net/http"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/models"
+	"patreon/internal/app/middleware"
+	"patreon/internal/app/usecase/payments"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/sirupsen/logrus"
+)
+
+type PaymentsTotalsHandler struct {
+	paymentsUsecase payments.Usecase
+	bh.BaseHandler
+}
+
+func NewPaymentsTotalsHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucPayments payments.Usecase) *PaymentsTotalsHandler {
+	h := &PaymentsTotalsHandler{
+		paymentsUsecase: ucPayments,
+		BaseHandler:     *bh.NewBaseHandler(log),
+	}
+	h.AddMethod(http.MethodGet, h.GET,
+		session_middleware.NewSessionMiddleware(sClient, log).CheckFunc,
+		middleware.NewCreatorsMiddleware(log).CheckAllowUserFunc,
+	)
+	return h
+}
+
+// GET PaymentsTotals
+// @Summary get creator payments totals by months
+// @tags payments
+// @Description get count and sums of paid creator payments grouped by month, award and currency, the last month first.
+// @Description Payments without award (tips, unlocks) have no award_id
+// @Produce json
+// @Param creator_id path int true "creator_id"
+// @Param from query string false "first date of payments in format YYYY-MM-DD"
+// @Param to query string false "last date of payments in format YYYY-MM-DD"
+// @Param award_id query uint64 false "award paid by payments"
+// @Param state query string false "comma separated payment states"
+// @Success 200 {object} http_models.ResponsePaymentsTotals "Success"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters", "invalid parameters in query", "date to must not be before date from", "unknown payment state in filter"
+// @Failure 403 {object} http_models.ErrResponse "this user not have permission for this creator"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/payments/totals [GET]
+func (h *PaymentsTotalsHandler) GET(w http.ResponseWriter, r *http.Request) {
+	creatorID, ok := h.GetInt64FromParam(w, r, "creator_id")
+	if !ok {
+		return
+	}
+	filter, ok := h.GetPaymentsFilterFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	totals, err := h.paymentsUsecase.GetCreatorMonthlyTotals(creatorID, filter)
+	if err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsGET)
+		return
+	}
+	h.Respond(w, r, http.StatusOK, http_models.ResponsePaymentsTotals{Totals: totals})
+}
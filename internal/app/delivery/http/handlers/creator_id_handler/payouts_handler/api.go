@@ -0,0 +1,26 @@
+package payouts_handler
+
+import (
+	"net/http"
+	"patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/repository"
+	repository_ledger "patreon/internal/app/repository/ledger"
+	usecase_ledger "patreon/internal/app/usecase/ledger"
+
+	"github.com/sirupsen/logrus"
+)
+
+var codesByErrorsGET = base_handler.CodeMap{
+	repository.DefaultErrDB: {
+		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
+}
+
+var codesByErrorsPOST = base_handler.CodeMap{
+	usecase_ledger.InvalidPayoutAmount: {
+		http.StatusUnprocessableEntity, handler_errors.InvalidBody, logrus.WarnLevel},
+	repository_ledger.NotEnoughBalance: {
+		http.StatusConflict, handler_errors.NotEnoughBalance, logrus.WarnLevel},
+	repository.DefaultErrDB: {
+		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
+}
@@ -0,0 +1,123 @@
+package payouts_handler
+
+import (
+	"net/http"
+	csrf_middleware "patreon/internal/app/csrf/middleware"
+	repository_jwt "patreon/internal/app/csrf/repository/jwt"
+	usecase_csrf "patreon/internal/app/csrf/usecase"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/delivery/http/models"
+	"patreon/internal/app/middleware"
+	db_models "patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	usecase_ledger "patreon/internal/app/usecase/ledger"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/sirupsen/logrus"
+)
+
+type PayoutsHandler struct {
+	ledgerUsecase usecase_ledger.Usecase
+	bh.BaseHandler
+}
+
+func NewPayoutsHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucLedger usecase_ledger.Usecase) *PayoutsHandler {
+	h := &PayoutsHandler{
+		ledgerUsecase: ucLedger,
+		BaseHandler:   *bh.NewBaseHandler(log),
+	}
+	h.AddMethod(http.MethodGet, h.GET,
+		session_middleware.NewSessionMiddleware(sClient, log).CheckFunc,
+		middleware.NewCreatorsMiddleware(log).CheckAllowUserFunc,
+	)
+	h.AddMethod(http.MethodPost, h.POST,
+		session_middleware.NewSessionMiddleware(sClient, log).CheckFunc,
+		middleware.NewCreatorsMiddleware(log).CheckAllowUserFunc,
+		csrf_middleware.NewCsrfMiddleware(log, usecase_csrf.NewCsrfUsecase(repository_jwt.NewJwtRepository())).CheckCsrfTokenFunc,
+	)
+	return h
+}
+
+// GET Payouts
+// @Summary get creator payouts
+// @tags payouts
+// @Description get payouts requested by creator with current state, last first
+// @Produce json
+// @Param creator_id path int true "creator_id"
+// @Param page query uint64 true "start page number of payouts mutually exclusive with offset"
+// @Param offset query uint64 true "start number of payouts mutually exclusive with page"
+// @Param limit query uint64 true "payouts to return"
+// @Success 200 {object} http_models.ResponsePayouts "Success"
+// @Failure 204 {object} http_models.OkResponse "payouts not found"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters"
+// @Failure 403 {object} http_models.ErrResponse "this user not have permission for this creator"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/payouts [GET]
+func (h *PayoutsHandler) GET(w http.ResponseWriter, r *http.Request) {
+	limit, offset, ok := h.GetPaginationFromQuery(w, r)
+	if !ok {
+		return
+	}
+	creatorID, ok := h.GetInt64FromParam(w, r, "creator_id")
+	if !ok {
+		return
+	}
+
+	payouts, err := h.ledgerUsecase.GetPayouts(creatorID, &db_models.Pagination{Limit: limit, Offset: offset})
+	if err != nil {
+		if err == repository.NotFound {
+			h.Respond(w, r, http.StatusNoContent, http_models.OkResponse{
+				Ok: handler_errors.PayoutsNotFound.Error(),
+			})
+		} else {
+			h.UsecaseError(w, r, err, codesByErrorsGET)
+		}
+		return
+	}
+	h.Respond(w, r, http.StatusOK, http_models.ResponsePayouts{Payouts: payouts})
+}
+
+// POST RequestPayout
+// @Summary request payout
+// @tags payouts
+// @Description request payout of amount from creator balance, the amount is taken from balance at once
+// @Description and returned if payout is rejected
+// @Accept json
+// @Produce json
+// @Param creator_id path int true "creator_id"
+// @Param amount body http_models.RequestPayout true "Request body"
+// @Success 201 {object} http_models.ResponsePayout "Payout requested"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters"
+// @Failure 409 {object} http_models.ErrResponse "creator balance less than payout amount"
+// @Failure 422 {object} http_models.ErrResponse "invalid body in request"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 403 {object} http_models.ErrResponse "csrf token is invalid, get new token", "this user not have permission for this creator"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/payouts [POST]
+func (h *PayoutsHandler) POST(w http.ResponseWriter, r *http.Request) {
+	req := &http_models.RequestPayout{}
+
+	err := h.GetRequestBody(w, r, req, *bluemonday.UGCPolicy())
+	if err != nil || req.Validate() != nil {
+		h.Log(r).Warnf("can not parse request %s", err)
+		h.Error(w, r, http.StatusUnprocessableEntity, handler_errors.InvalidBody)
+		return
+	}
+	creatorID, ok := h.GetInt64FromParam(w, r, "creator_id")
+	if !ok {
+		return
+	}
+
+	payout, err := h.ledgerUsecase.RequestPayout(creatorID, req.Amount)
+	if err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsPOST)
+		return
+	}
+	h.Log(r).Debugf("payout %d of creator %d requested", payout.ID, creatorID)
+	h.Respond(w, r, http.StatusCreated, http_models.ResponsePayout{Payout: *payout})
+}
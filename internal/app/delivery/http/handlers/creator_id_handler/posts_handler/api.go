@@ -11,6 +11,8 @@ import (
 )
 
 var codesByErrorsGET = base_handler.CodeMap{
+	models.IncorrectTag: {
+		http.StatusBadRequest, handler_errors.IncorrectTag, logrus.InfoLevel},
 	repository.DefaultErrDB: {
 		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
 }
@@ -22,6 +24,12 @@ var codesByErrorsPOST = base_handler.CodeMap{
 		http.StatusUnprocessableEntity, handler_errors.IncorrectCreatorId, logrus.WarnLevel},
 	models.EmptyTitle: {
 		http.StatusUnprocessableEntity, handler_errors.EmptyTitle, logrus.WarnLevel},
+	models.InvalidUnlockPrice: {
+		http.StatusUnprocessableEntity, handler_errors.IncorrectUnlockPrice, logrus.WarnLevel},
+	models.IncorrectPublishAt: {
+		http.StatusUnprocessableEntity, handler_errors.IncorrectPublishAt, logrus.InfoLevel},
+	models.IncorrectTag: {
+		http.StatusUnprocessableEntity, handler_errors.IncorrectTag, logrus.InfoLevel},
 	repository.DefaultErrDB: {
 		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
 	app.UnknownError: {
@@ -54,9 +54,10 @@ func NewPostsHandler(log *logrus.Logger,
 // @Param page query uint64 true "start page number of posts mutually exclusive with offset"
 // @Param offset query uint64 true "start number of posts mutually exclusive with page"
 // @Param limit query uint64 true "posts to return"
-// @Param with-draft query bool false "if need add draft posts"
+// @Param with-draft query bool false "if need add draft posts, scheduled posts are drafts with publish_at"
+// @Param tag query string false "return only posts marked by this tag"
 // @Failure 500 {object} http_models.ErrResponse "can not do bd operation", "server error"
-// @Failure 400 {object} http_models.ErrResponse "invalid parameters", "invalid parameters in query"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters", "invalid parameters in query", "tag must be from 1 to 32 symbols without commas"
 // @Router /creators/{:creator_id}/posts [GET]
 func (h *PostsHandler) GET(w http.ResponseWriter, r *http.Request) {
 	limit, offset, ok := h.GetPaginationFromQuery(w, r)
@@ -91,7 +92,7 @@ func (h *PostsHandler) GET(w http.ResponseWriter, r *http.Request) {
 	}
 
 	posts, err := h.postsUsecase.GetPosts(creatorId, userId,
-		&db_models.Pagination{Limit: limit, Offset: offset}, withDraft)
+		&db_models.Pagination{Limit: limit, Offset: offset}, withDraft, r.URL.Query().Get("tag"))
 	if err != nil {
 		h.UsecaseError(w, r, err, codesByErrorsGET)
 		return
@@ -111,12 +112,13 @@ func (h *PostsHandler) GET(w http.ResponseWriter, r *http.Request) {
 // POST Create Posts
 // @Summary create posts
 // @tags posts
-// @Description create posts to creator with id from path
+// @Description create posts to creator with id from path, post with publish_at is kept as draft and published at this time.
+// @Description Tags are stored in lower case, creator tags are created with the first post marked by them
 // @Param post body http_models.RequestPosts true "Request body for posts"
 // @Produce json
 // @Success 201 {object} http_models.IdResponse "id posts"
 // @Failure 400 {object} http_models.ErrResponse "invalid body in request"
-// @Failure 422 {object} http_models.ErrResponse "this creator id not know", "this awards id not know", "empty title", "invalid parameters"
+// @Failure 422 {object} http_models.ErrResponse "this creator id not know", "this awards id not know", "empty title", "invalid parameters", "unlock price must not be negative", "publish time of scheduled post must be in future", "tag must be from 1 to 32 symbols without commas"
 // @Failure 500 {object} http_models.ErrResponse "can not do bd operation", "server error"
 // @Failure 403 {object} http_models.ErrResponse "for this user forbidden change creator", "csrf token is invalid, get new token"
 // @Failure 401 "user are not authorized"
@@ -152,6 +154,10 @@ func (h *PostsHandler) POST(w http.ResponseWriter, r *http.Request) {
 		Awards:      req.AwardsId,
 		CreatorId:   idInt,
 		IsDraft:     req.IsDraft,
+		UnlockPrice: req.UnlockPrice,
+		Preview:     req.Preview,
+		PublishAt:   req.PublishAt,
+		Tags:        req.Tags,
 	}
 
 	postsId, err := h.postsUsecase.Create(h.Log(r), aw)
@@ -8,17 +8,22 @@ import (
 	"patreon/internal/app/models"
 	"patreon/internal/app/repository"
 	repository_postgresql "patreon/internal/app/repository/attaches/postgresql"
+	useAttaches "patreon/internal/app/usecase/attaches"
 )
 
 var codesByErrorsPOST = base_handler.CodeMap{
 	repository.NotFound: {
 		http.StatusNotFound, handler_errors.AttachNotFound, logrus.ErrorLevel},
+	useAttaches.PollNotEditable: {
+		http.StatusUnprocessableEntity, handler_errors.PollNotEditable, logrus.WarnLevel},
 	repository.DefaultErrDB: {
 		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
 	models.IncorrectType: {
 		http.StatusUnprocessableEntity, handler_errors.IncorrectType, logrus.WarnLevel},
 	models.IncorrectAttachId: {
 		http.StatusUnprocessableEntity, handler_errors.IncorrectIdAttach, logrus.WarnLevel},
+	models.IncorrectPoll: {
+		http.StatusUnprocessableEntity, handler_errors.IncorrectPoll, logrus.WarnLevel},
 	models.IncorrectLevel: {
 		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
 	repository_postgresql.UnknownDataFormat: {
@@ -48,7 +48,7 @@ func NewAttachesHandler(log *logrus.Logger,
 // PUT attaches
 // @Summary update current attaches
 // @tags attaches
-// @Description update current attaches from current creator
+// @Description update current attaches from current creator, value of new poll is json with question and options, poll can only change its level
 // @Produce json
 // @Param attaches body http_models.RequestAttaches true "Request body for set attaches"
 // @Success 200 {object} http_models.ResponseApplyAttach
@@ -56,7 +56,7 @@ func NewAttachesHandler(log *logrus.Logger,
 // @Failure 404 {object} http_models.ErrResponse ""attach with this id not found""
 // @Failure 500 {object} http_models.ErrResponse ""can not do bd operation", "server error", "Not allow type, allowed type is: ...""
 // @Failure 403 {object} http_models.ErrResponse ""for this user forbidden change creator", "csrf token is invalid, get new token", "this post not belongs this creators""
-// @Failure 422 {object} http_models.ErrResponse ""Not allow type, allowed type is: ...", "Not valid attach id", "Not allow status, allowed status is: ...""
+// @Failure 422 {object} http_models.ErrResponse ""Not allow type, allowed type is: ...", "Not valid attach id", "Not allow status, allowed status is: ...", "poll must have question and from 2 to 10 not empty options", "poll can not be changed, remove it and add new one""
 // @Router /creators/{:creator_id}/posts/{:post_id}/attaches [PUT]
 func (h *AttachesHandler) PUT(w http.ResponseWriter, r *http.Request) {
 	req := &http_models.RequestAttaches{}
@@ -92,7 +92,7 @@ func (h *AttachesHandler) PUT(w http.ResponseWriter, r *http.Request) {
 	for level, attach := range req.Attaches {
 		model_attach := models.Attach{Id: attach.Id, Type: attach.Type,
 			Value: attach.Value, Level: int64(level + 1)}
-		if attach.Type == models.Text {
+		if attach.HaveStatus() {
 			if attach.Status == handlers.AddStatus {
 				newAttaches = append(newAttaches, model_attach)
 			} else {
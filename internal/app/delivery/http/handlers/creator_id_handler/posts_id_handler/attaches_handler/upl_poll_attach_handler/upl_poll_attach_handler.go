@@ -0,0 +1,84 @@
+package upl_poll_attach_handler
+
+import (
+	"net/http"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/delivery/http/models"
+	"patreon/internal/app/middleware"
+	models_db "patreon/internal/app/models"
+	useAttaches "patreon/internal/app/usecase/attaches"
+	usePosts "patreon/internal/app/usecase/posts"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/gorilla/mux"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/sirupsen/logrus"
+)
+
+type AttachesUploadPollHandler struct {
+	attachesUsecase useAttaches.Usecase
+	bh.BaseHandler
+}
+
+func NewAttachesUploadPollHandler(log *logrus.Logger,
+	ucAttaches useAttaches.Usecase, ucPosts usePosts.Usecase,
+	sClient session_client.AuthCheckerClient) *AttachesUploadPollHandler {
+	h := &AttachesUploadPollHandler{
+		BaseHandler:     *bh.NewBaseHandler(log),
+		attachesUsecase: ucAttaches,
+	}
+	sessionMiddleware := session_middleware.NewSessionMiddleware(sClient, log)
+	h.AddMiddleware(sessionMiddleware.Check, middleware.NewCreatorsMiddleware(log).CheckAllowUser,
+		middleware.NewPostsMiddleware(log, ucPosts).CheckCorrectPost, sessionMiddleware.AddUserId)
+	h.AddMethod(http.MethodPost, h.POST)
+	return h
+}
+
+// POST add poll to post
+// @Summary add poll to post
+// @tags attaches
+// @Description add poll to post, poll can not be changed after creation except its level in post
+// @Accept  json
+// @Param poll body http_models.RequestPoll true "Request body for poll"
+// @Success 201 {object} http_models.IdResponse "id attaches"
+// @Failure 500 {object} http_models.ErrResponse "can not do bd operation", "server error"
+// @Failure 422 {object} http_models.ErrResponse "invalid data type", "this post id not know", "poll must have question and from 2 to 10 not empty options"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters", "invalid body in request"
+// @Failure 403 {object} http_models.ErrResponse "for this user forbidden change creator", "this post not belongs this creators", "csrf token is invalid, get new token"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/posts/{:post_id}/attaches/poll [POST]
+func (h *AttachesUploadPollHandler) POST(w http.ResponseWriter, r *http.Request) {
+	req := &http_models.RequestPoll{}
+
+	err := h.GetRequestBody(w, r, req, *bluemonday.UGCPolicy())
+	if err != nil {
+		h.Log(r).Warnf("can not parse request %s", err)
+		h.Error(w, r, http.StatusUnprocessableEntity, handler_errors.InvalidBody)
+		return
+	}
+
+	var postId int64
+	var ok bool
+
+	if postId, ok = h.GetInt64FromParam(w, r, "post_id"); !ok {
+		return
+	}
+
+	if len(mux.Vars(r)) > 2 {
+		h.Log(r).Warnf("Too many parametres %v", mux.Vars(r))
+		h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidParameters)
+		return
+	}
+
+	poll := &models_db.PollAttach{Question: req.Question, Options: req.Options, Multiple: req.Multiple,
+		CloseAt: req.CloseAt, ResultsBeforeVote: req.ResultsBeforeVote}
+	attachId, err := h.attachesUsecase.LoadPoll(&models_db.AttachWithoutLevel{Value: poll.Value(), PostId: postId})
+	if err != nil {
+		h.UsecaseError(w, r, err, codeByErrorPOST)
+		return
+	}
+
+	h.Respond(w, r, http.StatusCreated, &http_models.IdResponse{ID: attachId})
+}
@@ -20,6 +20,7 @@ import (
 
 type AttachesIDHandler struct {
 	attachesUsecase useAttaches.Usecase
+	postsUsecase    usePosts.Usecase
 	bh.BaseHandler
 }
 
@@ -31,13 +32,14 @@ func NewAttachesIDHandler(
 	h := &AttachesIDHandler{
 		BaseHandler:     *bh.NewBaseHandler(log),
 		attachesUsecase: ucAttaches,
+		postsUsecase:    ucPosts,
 	}
 	sessionMiddleware := session_middleware.NewSessionMiddleware(sClient, log)
 
 	h.AddMiddleware(middleware.NewPostsMiddleware(log, ucPosts).CheckCorrectPost,
 		middleware.NewAttachesMiddleware(log, ucAttaches).CheckCorrectAttach)
 
-	h.AddMethod(http.MethodGet, h.GET)
+	h.AddMethod(http.MethodGet, h.GET, sessionMiddleware.AddUserIdFunc)
 
 	h.AddMethod(http.MethodDelete, h.DELETE,
 		sessionMiddleware.CheckFunc, csrf_middleware.NewCsrfMiddleware(log,
@@ -49,27 +51,47 @@ func NewAttachesIDHandler(
 // GET attaches
 // @Summary get current attaches
 // @tags attaches
-// @Description get current attaches from current creator
+// @Description get current attaches from current creator, attach of locked post is not available
 // @Produce json
 // @Success 200 {object} http_models.ResponseAttach
 // @Failure 400 {object} http_models.ErrResponse "invalid parameters"
 // @Failure 404 {object} http_models.ErrResponse "attach with this id not found"
 // @Failure 500 {object} http_models.ErrResponse "can not do bd operation", "server error"
-// @Failure 403 {object} http_models.ErrResponse "this post not belongs this creators"
+// @Failure 403 {object} http_models.ErrResponse "this post not belongs this creators", "this user not have award for this post"
 // @Router /creators/{:creator_id}/posts/{:post_id}/{:attach_id} [GET]
 func (h *AttachesIDHandler) GET(w http.ResponseWriter, r *http.Request) {
-	var attachId int64
+	var attachId, postId, userId int64
 	var ok bool
 	if attachId, ok = h.GetInt64FromParam(w, r, "attach_id"); !ok {
 		return
 	}
 
+	if postId, ok = h.GetInt64FromParam(w, r, "post_id"); !ok {
+		return
+	}
+
 	if len(mux.Vars(r)) > 3 {
 		h.Log(r).Warnf("Too many parametres %v", mux.Vars(r))
 		h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidParameters)
 		return
 	}
 
+	if userId, ok = r.Context().Value("user_id").(int64); !ok {
+		userId = usePosts.EmptyUser
+	}
+
+	post, err := h.postsUsecase.GetPost(postId, userId, false)
+	if err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsGET)
+		return
+	}
+
+	if post.Locked {
+		h.Log(r).Warnf("Fobidden for user %d attach %d of locked post %d", userId, attachId, postId)
+		h.Error(w, r, http.StatusForbidden, handler_errors.UserNotHaveAward)
+		return
+	}
+
 	attach, err := h.attachesUsecase.GetAttach(attachId)
 	if err != nil {
 		h.UsecaseError(w, r, err, codesByErrorsGET)
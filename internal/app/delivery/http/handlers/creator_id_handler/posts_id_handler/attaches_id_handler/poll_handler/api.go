@@ -0,0 +1,57 @@
+package poll_handler
+
+import (
+	"net/http"
+	"patreon/internal/app"
+	"patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_postgresql "patreon/internal/app/repository/polls/postgresql"
+	usecase_polls "patreon/internal/app/usecase/polls"
+
+	"github.com/sirupsen/logrus"
+)
+
+var codesByErrorsGET = base_handler.CodeMap{
+	repository.NotFound: {
+		http.StatusNotFound, handler_errors.AttachNotFound, logrus.WarnLevel},
+	usecase_polls.NotPoll: {
+		http.StatusUnprocessableEntity, handler_errors.AttachNotPoll, logrus.WarnLevel},
+	repository.DefaultErrDB: {
+		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
+	app.UnknownError: {
+		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
+}
+
+var codesByErrorsPOST = base_handler.CodeMap{
+	repository.NotFound: {
+		http.StatusNotFound, handler_errors.AttachNotFound, logrus.WarnLevel},
+	usecase_polls.NotPoll: {
+		http.StatusUnprocessableEntity, handler_errors.AttachNotPoll, logrus.WarnLevel},
+	models.IncorrectVote: {
+		http.StatusUnprocessableEntity, handler_errors.IncorrectVote, logrus.WarnLevel},
+	usecase_polls.PollClosed: {
+		http.StatusConflict, handler_errors.PollClosed, logrus.InfoLevel},
+	repository_postgresql.VoteAlreadyExist: {
+		http.StatusConflict, handler_errors.VoteAlreadyExist, logrus.InfoLevel},
+	repository.DefaultErrDB: {
+		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
+	app.UnknownError: {
+		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
+}
+
+var codesByErrorsDELETE = base_handler.CodeMap{
+	repository.NotFound: {
+		http.StatusNotFound, handler_errors.AttachNotFound, logrus.WarnLevel},
+	usecase_polls.NotPoll: {
+		http.StatusUnprocessableEntity, handler_errors.AttachNotPoll, logrus.WarnLevel},
+	usecase_polls.PollClosed: {
+		http.StatusConflict, handler_errors.PollClosed, logrus.InfoLevel},
+	usecase_polls.NotVoted: {
+		http.StatusConflict, handler_errors.NotVoted, logrus.InfoLevel},
+	repository.DefaultErrDB: {
+		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
+	app.UnknownError: {
+		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
+}
@@ -0,0 +1,194 @@
+package poll_handler
+
+import (
+	"net/http"
+	csrf_middleware "patreon/internal/app/csrf/middleware"
+	repository_jwt "patreon/internal/app/csrf/repository/jwt"
+	usecase_csrf "patreon/internal/app/csrf/usecase"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/delivery/http/models"
+	"patreon/internal/app/middleware"
+	useAttaches "patreon/internal/app/usecase/attaches"
+	usePolls "patreon/internal/app/usecase/polls"
+	usePosts "patreon/internal/app/usecase/posts"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/gorilla/mux"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/sirupsen/logrus"
+)
+
+type PollHandler struct {
+	pollsUsecase usePolls.Usecase
+	postsUsecase usePosts.Usecase
+	bh.BaseHandler
+}
+
+func NewPollHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucPolls usePolls.Usecase, ucPosts usePosts.Usecase, ucAttaches useAttaches.Usecase) *PollHandler {
+	h := &PollHandler{
+		BaseHandler:  *bh.NewBaseHandler(log),
+		pollsUsecase: ucPolls,
+		postsUsecase: ucPosts,
+	}
+	sessionMiddleware := session_middleware.NewSessionMiddleware(sClient, log)
+	csrfMiddleware := csrf_middleware.NewCsrfMiddleware(log,
+		usecase_csrf.NewCsrfUsecase(repository_jwt.NewJwtRepository()))
+
+	h.AddMiddleware(middleware.NewPostsMiddleware(log, ucPosts).CheckCorrectPost,
+		middleware.NewAttachesMiddleware(log, ucAttaches).CheckCorrectAttach)
+
+	h.AddMethod(http.MethodGet, h.GET, sessionMiddleware.AddUserIdFunc)
+	h.AddMethod(http.MethodPost, h.POST, sessionMiddleware.CheckFunc, csrfMiddleware.CheckCsrfTokenFunc)
+	h.AddMethod(http.MethodDelete, h.DELETE, sessionMiddleware.CheckFunc, csrfMiddleware.CheckCsrfTokenFunc)
+	return h
+}
+
+// checkAccess check that user can see attaches of post, it responds error otherwise
+func (h *PollHandler) checkAccess(w http.ResponseWriter, r *http.Request,
+	userId int64, codeByErrors bh.CodeMap) (int64, bool) {
+	var attachId, postId int64
+	var ok bool
+	if attachId, ok = h.GetInt64FromParam(w, r, "attach_id"); !ok {
+		return 0, false
+	}
+
+	if postId, ok = h.GetInt64FromParam(w, r, "post_id"); !ok {
+		return 0, false
+	}
+
+	if len(mux.Vars(r)) > 3 {
+		h.Log(r).Warnf("Too many parametres %v", mux.Vars(r))
+		h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidParameters)
+		return 0, false
+	}
+
+	post, err := h.postsUsecase.GetPost(postId, userId, false)
+	if err != nil {
+		h.UsecaseError(w, r, err, codeByErrors)
+		return 0, false
+	}
+
+	if post.Locked {
+		h.Log(r).Warnf("Fobidden for user %d poll %d of locked post %d", userId, attachId, postId)
+		h.Error(w, r, http.StatusForbidden, handler_errors.UserNotHaveAward)
+		return 0, false
+	}
+	return attachId, true
+}
+
+// GET Poll results
+// @Summary get poll with results
+// @tags attaches
+// @Description get poll with live count of votes for its options, counts are hidden from user who has not voted
+// @Description in open poll if poll does not show results before vote
+// @Produce json
+// @Success 200 {object} http_models.ResponsePollResults
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters"
+// @Failure 404 {object} http_models.ErrResponse "attach with this id not found"
+// @Failure 422 {object} http_models.ErrResponse "attach is not poll"
+// @Failure 500 {object} http_models.ErrResponse "can not do bd operation", "server error"
+// @Failure 403 {object} http_models.ErrResponse "this post not belongs this creators", "this user not have award for this post"
+// @Router /creators/{:creator_id}/posts/{:post_id}/{:attach_id}/poll [GET]
+func (h *PollHandler) GET(w http.ResponseWriter, r *http.Request) {
+	userId, ok := r.Context().Value("user_id").(int64)
+	if !ok {
+		userId = usePosts.EmptyUser
+	}
+
+	attachId, ok := h.checkAccess(w, r, userId, codesByErrorsGET)
+	if !ok {
+		return
+	}
+
+	res, err := h.pollsUsecase.GetResults(attachId, userId)
+	if err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsGET)
+		return
+	}
+
+	h.Respond(w, r, http.StatusOK, http_models.ResponsePollResults{PollResults: *res})
+}
+
+// POST Vote in poll
+// @Summary vote in poll
+// @tags attaches
+// @Description vote in open poll by numbers of options, user can vote only once
+// @Accept  json
+// @Produce json
+// @Param vote body http_models.RequestPollVote true "Request body for vote"
+// @Success 200 {object} http_models.ResponsePollResults
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters"
+// @Failure 404 {object} http_models.ErrResponse "attach with this id not found"
+// @Failure 409 {object} http_models.ErrResponse "poll is closed", "user already voted in this poll"
+// @Failure 422 {object} http_models.ErrResponse "invalid body in request", "attach is not poll", "vote must contain different options of poll, single choice poll allows one option"
+// @Failure 500 {object} http_models.ErrResponse "can not do bd operation", "server error"
+// @Failure 403 {object} http_models.ErrResponse "this post not belongs this creators", "this user not have award for this post", "csrf token is invalid, get new token"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/posts/{:post_id}/{:attach_id}/poll [POST]
+func (h *PollHandler) POST(w http.ResponseWriter, r *http.Request) {
+	req := &http_models.RequestPollVote{}
+	if err := h.GetRequestBody(w, r, req, *bluemonday.UGCPolicy()); err != nil {
+		h.Log(r).Warnf("can not parse request %s", err)
+		h.Error(w, r, http.StatusUnprocessableEntity, handler_errors.InvalidBody)
+		return
+	}
+
+	userId, ok := r.Context().Value("user_id").(int64)
+	if !ok {
+		h.Log(r).Error("can not get user_id from context")
+		h.Error(w, r, http.StatusInternalServerError, handler_errors.InternalError)
+		return
+	}
+
+	attachId, ok := h.checkAccess(w, r, userId, codesByErrorsPOST)
+	if !ok {
+		return
+	}
+
+	res, err := h.pollsUsecase.Vote(attachId, userId, req.Options)
+	if err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsPOST)
+		return
+	}
+
+	h.Respond(w, r, http.StatusOK, http_models.ResponsePollResults{PollResults: *res})
+}
+
+// DELETE Retract vote
+// @Summary retract vote in poll
+// @tags attaches
+// @Description retract vote of user in open poll, after it user can vote again
+// @Produce json
+// @Success 200 {object} http_models.ResponsePollResults
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters"
+// @Failure 404 {object} http_models.ErrResponse "attach with this id not found"
+// @Failure 409 {object} http_models.ErrResponse "poll is closed", "user has not voted in this poll"
+// @Failure 422 {object} http_models.ErrResponse "attach is not poll"
+// @Failure 500 {object} http_models.ErrResponse "can not do bd operation", "server error"
+// @Failure 403 {object} http_models.ErrResponse "this post not belongs this creators", "this user not have award for this post", "csrf token is invalid, get new token"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/posts/{:post_id}/{:attach_id}/poll [DELETE]
+func (h *PollHandler) DELETE(w http.ResponseWriter, r *http.Request) {
+	userId, ok := r.Context().Value("user_id").(int64)
+	if !ok {
+		h.Log(r).Error("can not get user_id from context")
+		h.Error(w, r, http.StatusInternalServerError, handler_errors.InternalError)
+		return
+	}
+
+	attachId, ok := h.checkAccess(w, r, userId, codesByErrorsDELETE)
+	if !ok {
+		return
+	}
+
+	res, err := h.pollsUsecase.Retract(attachId, userId)
+	if err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsDELETE)
+		return
+	}
+
+	h.Respond(w, r, http.StatusOK, http_models.ResponsePollResults{PollResults: *res})
+}
@@ -8,6 +8,7 @@ import (
 	"patreon/internal/app/models"
 	"patreon/internal/app/repository"
 	repository_postgresql "patreon/internal/app/repository/attaches/postgresql"
+	useAttaches "patreon/internal/app/usecase/attaches"
 	repository_os "patreon/internal/microservices/files/files/repository/files/os"
 
 	"github.com/sirupsen/logrus"
@@ -16,6 +17,8 @@ import (
 var codeByErrorPUT = base_handler.CodeMap{
 	repository.NotFound: {
 		http.StatusNotFound, handler_errors.AttachNotFound, logrus.ErrorLevel},
+	useAttaches.PollNotEditable: {
+		http.StatusUnprocessableEntity, handler_errors.PollNotEditable, logrus.WarnLevel},
 	repository_postgresql.UnknownDataFormat: {
 		http.StatusUnprocessableEntity, handler_errors.IncorrectDataType, logrus.WarnLevel},
 	models.InvalidType: {
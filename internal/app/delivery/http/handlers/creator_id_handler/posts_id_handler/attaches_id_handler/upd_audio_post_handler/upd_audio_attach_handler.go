@@ -54,7 +54,7 @@ func NewAttachUploadAudioHandler(
 // @Success 200
 // @Failure 400 {object} http_models.ErrResponse "size of file very big", "invalid form field name for load file", "please upload a some types"
 // @Failure 500 {object} http_models.ErrResponse "can not do bd operation", "server error"
-// @Failure 422 {object} http_models.ErrResponse "invalid data type", "this post id not know"
+// @Failure 422 {object} http_models.ErrResponse "invalid data type", "this post id not know", "poll can not be changed, remove it and add new one"
 // @Failure 404 {object} http_models.ErrResponse "attach with this id not found"
 // @Failure 400 {object} http_models.ErrResponse "invalid parameters"
 // @Failure 403 {object} http_models.ErrResponse "for this user forbidden change creator", "this post not belongs this creators", "csrf token is invalid, get new token"
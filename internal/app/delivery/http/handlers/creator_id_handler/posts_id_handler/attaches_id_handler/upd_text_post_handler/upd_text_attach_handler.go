@@ -53,7 +53,7 @@ func NewAttachesUpdateTextHandler(
 // @Param attach_text body http_models.RequestText true "Request body for text"
 // @Success 200
 // @Failure 500 {object} http_models.ErrResponse "can not do bd operation", "server error"
-// @Failure 422 {object} http_models.ErrResponse "this post id not know"
+// @Failure 422 {object} http_models.ErrResponse "this post id not know", "poll can not be changed, remove it and add new one"
 // @Failure 404 {object} http_models.ErrResponse "attach with this id not found"
 // @Failure 400 {object} http_models.ErrResponse "invalid parameters", "invalid data type", "invalid body in request"
 // @Failure 403 {object} http_models.ErrResponse "for this user forbidden change creator", "this post not belongs this creators", "csrf token is invalid, get new token"
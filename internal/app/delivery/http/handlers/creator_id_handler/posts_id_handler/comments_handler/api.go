@@ -7,6 +7,7 @@ import (
 	"patreon/internal/app/delivery/http/handlers/handler_errors"
 	"patreon/internal/app/models"
 	"patreon/internal/app/repository"
+	useComments "patreon/internal/app/usecase/comments"
 )
 
 var codesByErrorsPOST = base_handler.CodeMap{
@@ -16,6 +17,8 @@ var codesByErrorsPOST = base_handler.CodeMap{
 		http.StatusUnprocessableEntity, handler_errors.IncorrectPostId, logrus.WarnLevel},
 	models.InvalidUserId: {
 		http.StatusUnprocessableEntity, handler_errors.IncorrectUserId, logrus.WarnLevel},
+	useComments.UserBlocked: {
+		http.StatusForbidden, handler_errors.UserBlocked, logrus.InfoLevel},
 }
 
 var codesByErrorsGET = base_handler.CodeMap{
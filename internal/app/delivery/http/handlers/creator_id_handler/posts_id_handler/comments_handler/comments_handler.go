@@ -53,7 +53,7 @@ func NewCommentsHandler(log *logrus.Logger,
 // @Success 200 {object} http_models.IdResponse
 // @Failure 400 {object} http_models.ErrResponse ""invalid parameters""
 // @Failure 500 {object} http_models.ErrResponse ""can not do bd operation", "server error""
-// @Failure 403 {object} http_models.ErrResponse ""csrf token is invalid, get new token", "this post not belongs this creators", "this user can not add comment as creator""
+// @Failure 403 {object} http_models.ErrResponse ""csrf token is invalid, get new token", "this post not belongs this creators", "this user can not add comment as creator", "user is blocked by creator""
 // @Failure 422 {object} http_models.ErrResponse ""this post id not know", "this user id not know""
 // @Failure 401 "user are not authorized"
 // @Router /creators/{:creator_id}/posts/{:post_id}/comments [POST]
@@ -97,8 +97,8 @@ func (h *CommentsHandler) POST(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := h.commentsUsecase.Create(h.Log(r), &models.Comment{PostId: postId, AuthorId: userID, Body: req.Body,
-		AsCreator: req.AsCreator})
+	res, err := h.commentsUsecase.Create(h.Log(r), creatorId, &models.Comment{PostId: postId, AuthorId: userID,
+		Body: req.Body, AsCreator: req.AsCreator})
 	if err != nil {
 		h.UsecaseError(w, r, err, codesByErrorsPOST)
 		return
@@ -111,7 +111,7 @@ func (h *CommentsHandler) POST(w http.ResponseWriter, r *http.Request) {
 // GET comments
 // @Summary get post comments
 // @tags comments
-// @Description get comments for current post
+// @Description get comments for current post, comments of users blocked by creator are hidden
 // @Param page query uint64 true "start page number of posts mutually exclusive with offset"
 // @Param offset query uint64 true "start number of posts mutually exclusive with page"
 // @Param limit query uint64 true "posts to return"
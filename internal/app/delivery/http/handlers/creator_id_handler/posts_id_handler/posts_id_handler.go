@@ -13,26 +13,21 @@ import (
 	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
 	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
 
-	useUser "patreon/internal/app/usecase/user"
-
 	"github.com/gorilla/mux"
 	"github.com/sirupsen/logrus"
 )
 
 type PostsIDHandler struct {
 	postsUsecase usePosts.Usecase
-	userUsecase  useUser.Usecase
 	bh.BaseHandler
 }
 
 func NewPostsIDHandler(log *logrus.Logger,
 	ucPosts usePosts.Usecase,
-	ucUser useUser.Usecase,
 	sClient session_client.AuthCheckerClient) *PostsIDHandler {
 	h := &PostsIDHandler{
 		BaseHandler:  *bh.NewBaseHandler(log),
 		postsUsecase: ucPosts,
-		userUsecase:  ucUser,
 	}
 	sessionMiddleware := session_middleware.NewSessionMiddleware(sClient, log)
 	postMid := middleware.NewPostsMiddleware(log, ucPosts)
@@ -46,17 +41,18 @@ func NewPostsIDHandler(log *logrus.Logger,
 // GET Post
 // @Summary get current post
 // @tags posts
-// @Description get current post from current creator
+// @Description get current post from current creator, post unlocked by user is available without award.
+// @Description User without access to post gets its teaser with preview, award which opens post and counts of attaches
 // @Produce json
 // @Param add-view query string false "if need add view to this post" Enums("yes", "no")
-// @Success 200 {object} http_models.ResponsePostWithAttaches "posts"
+// @Success 200 {object} http_models.ResponsePostWithAttaches "posts, http_models.ResponseLockedPost with locked flag if user has no access"
 // @Failure 400 {object} http_models.ErrResponse "invalid parameters"
 // @Failure 404 {object} http_models.ErrResponse "post with this id not found"
 // @Failure 500 {object} http_models.ErrResponse "can not do bd operation", "server error"
-// @Failure 403 {object} http_models.ErrResponse "for this user forbidden change creator", "this post not belongs this creators", "this user not have award for this post"
+// @Failure 403 {object} http_models.ErrResponse "for this user forbidden change creator", "this post not belongs this creators"
 // @Router /creators/{:creator_id}/posts/{:post_id} [GET]
 func (h *PostsIDHandler) GET(w http.ResponseWriter, r *http.Request) {
-	var postId, userId, creatorId int64
+	var postId, userId int64
 	var addView bool
 	var ok bool
 
@@ -70,10 +66,6 @@ func (h *PostsIDHandler) GET(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if creatorId, ok = h.GetInt64FromParam(w, r, "creator_id"); !ok {
-		return
-	}
-
 	value := r.URL.Query().Get("add-view")
 	if value == "" {
 		addView = false
@@ -91,16 +83,9 @@ func (h *PostsIDHandler) GET(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	access, err := h.userUsecase.CheckAccessForAward(userId, post.Awards, creatorId)
-
-	if err != nil {
-		h.UsecaseError(w, r, err, codesByErrorsGET)
-		return
-	}
-
-	if !access {
-		h.Log(r).Warnf("Fobidden for user %d to post %v", userId, post)
-		h.Error(w, r, http.StatusForbidden, handler_errors.UserNotHaveAward)
+	if post.Locked {
+		h.Log(r).Debugf("get teaser of locked post with id %d for user %d", postId, userId)
+		h.Respond(w, r, http.StatusOK, http_models.ToResponseLockedPost(*post))
 		return
 	}
 
@@ -0,0 +1,80 @@
+package revisions_diff_handler
+
+import (
+	"net/http"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/delivery/http/models"
+	"patreon/internal/app/middleware"
+	usecase_post_revisions "patreon/internal/app/usecase/post_revisions"
+	usePosts "patreon/internal/app/usecase/posts"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/sirupsen/logrus"
+)
+
+type RevisionsDiffHandler struct {
+	revisionsUsecase usecase_post_revisions.Usecase
+	bh.BaseHandler
+}
+
+func NewRevisionsDiffHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucRevisions usecase_post_revisions.Usecase, ucPosts usePosts.Usecase) *RevisionsDiffHandler {
+	h := &RevisionsDiffHandler{
+		revisionsUsecase: ucRevisions,
+		BaseHandler:      *bh.NewBaseHandler(log),
+	}
+	h.AddMiddleware(session_middleware.NewSessionMiddleware(sClient, log).Check,
+		middleware.NewCreatorsMiddleware(log).CheckAllowUser,
+		middleware.NewPostsMiddleware(log, ucPosts).CheckCorrectPost)
+
+	h.AddMethod(http.MethodGet, h.GET)
+	return h
+}
+
+// GET RevisionsDiff
+// @Summary compare revisions of post
+// @tags posts
+// @Description get field by field changes from revision from to revision to, without to revision is compared
+// @Description with current content of post. Attaches are matched by attach id
+// @Produce json
+// @Param creator_id path int true "creator_id"
+// @Param post_id path int true "post_id"
+// @Param from query uint64 true "id of old revision"
+// @Param to query uint64 false "id of new revision, current content of post if empty"
+// @Success 200 {object} http_models.ResponsePostRevisionsDiff "Success"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters", "invalid parameters in query"
+// @Failure 404 {object} http_models.ErrResponse "post revision not found"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 403 {object} http_models.ErrResponse "for this user forbidden change creator", "this post not belongs this creators"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/posts/{:post_id}/revisions/diff [GET]
+func (h *RevisionsDiffHandler) GET(w http.ResponseWriter, r *http.Request) {
+	postID, ok := h.GetInt64FromParam(w, r, "post_id")
+	if !ok {
+		return
+	}
+	fromID, ok := h.GetInt64FromQueries(w, r, "from")
+	if !ok {
+		if fromID == bh.EmptyQuery {
+			h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidQueries)
+		}
+		return
+	}
+	toID, ok := h.GetInt64FromQueries(w, r, "to")
+	if !ok {
+		if toID != bh.EmptyQuery {
+			return
+		}
+		toID = usecase_post_revisions.CurrentRevision
+	}
+
+	changes, err := h.revisionsUsecase.Diff(postID, fromID, toID)
+	if err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsGET)
+		return
+	}
+	h.Log(r).Debugf("get %d changes of post %d from revision %d to %d", len(changes), postID, fromID, toID)
+	h.Respond(w, r, http.StatusOK, http_models.ResponsePostRevisionsDiff{Changes: changes})
+}
@@ -0,0 +1,72 @@
+package revisions_restore_handler
+
+import (
+	"net/http"
+	csrf_middleware "patreon/internal/app/csrf/middleware"
+	repository_jwt "patreon/internal/app/csrf/repository/jwt"
+	usecase_csrf "patreon/internal/app/csrf/usecase"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/middleware"
+	usecase_post_revisions "patreon/internal/app/usecase/post_revisions"
+	usePosts "patreon/internal/app/usecase/posts"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/sirupsen/logrus"
+)
+
+type RevisionsRestoreHandler struct {
+	revisionsUsecase usecase_post_revisions.Usecase
+	bh.BaseHandler
+}
+
+func NewRevisionsRestoreHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucRevisions usecase_post_revisions.Usecase, ucPosts usePosts.Usecase) *RevisionsRestoreHandler {
+	h := &RevisionsRestoreHandler{
+		revisionsUsecase: ucRevisions,
+		BaseHandler:      *bh.NewBaseHandler(log),
+	}
+	h.AddMiddleware(session_middleware.NewSessionMiddleware(sClient, log).Check,
+		middleware.NewCreatorsMiddleware(log).CheckAllowUser,
+		middleware.NewPostsMiddleware(log, ucPosts).CheckCorrectPost)
+
+	h.AddMethod(http.MethodPost, h.POST,
+		csrf_middleware.NewCsrfMiddleware(log,
+			usecase_csrf.NewCsrfUsecase(repository_jwt.NewJwtRepository())).CheckCsrfTokenFunc,
+	)
+	return h
+}
+
+// POST RestoreRevision
+// @Summary restore revision of post
+// @tags posts
+// @Description set title, description, award and attaches of post from revision. Current content of post
+// @Description is saved as new revision, so restore can be reverted. Attaches keep their ids
+// @Produce json
+// @Param creator_id path int true "creator_id"
+// @Param post_id path int true "post_id"
+// @Param revision_id path int true "revision_id"
+// @Success 200 "Revision restored"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters"
+// @Failure 404 {object} http_models.ErrResponse "post revision not found"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 403 {object} http_models.ErrResponse "for this user forbidden change creator", "this post not belongs this creators", "csrf token is invalid, get new token"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/posts/{:post_id}/revisions/{:revision_id}/restore [POST]
+func (h *RevisionsRestoreHandler) POST(w http.ResponseWriter, r *http.Request) {
+	postID, ok := h.GetInt64FromParam(w, r, "post_id")
+	if !ok {
+		return
+	}
+	revisionID, ok := h.GetInt64FromParam(w, r, "revision_id")
+	if !ok {
+		return
+	}
+
+	if err := h.revisionsUsecase.Restore(postID, revisionID); err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsPOST)
+		return
+	}
+	h.Log(r).Debugf("revision %d of post %d restored", revisionID, postID)
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,70 @@
+package post_revisions_handler
+
+import (
+	"net/http"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/models"
+	"patreon/internal/app/middleware"
+	db_models "patreon/internal/app/models"
+	usecase_post_revisions "patreon/internal/app/usecase/post_revisions"
+	usePosts "patreon/internal/app/usecase/posts"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/sirupsen/logrus"
+)
+
+type PostRevisionsHandler struct {
+	revisionsUsecase usecase_post_revisions.Usecase
+	bh.BaseHandler
+}
+
+func NewPostRevisionsHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucRevisions usecase_post_revisions.Usecase, ucPosts usePosts.Usecase) *PostRevisionsHandler {
+	h := &PostRevisionsHandler{
+		revisionsUsecase: ucRevisions,
+		BaseHandler:      *bh.NewBaseHandler(log),
+	}
+	h.AddMiddleware(session_middleware.NewSessionMiddleware(sClient, log).Check,
+		middleware.NewCreatorsMiddleware(log).CheckAllowUser,
+		middleware.NewPostsMiddleware(log, ucPosts).CheckCorrectPost)
+
+	h.AddMethod(http.MethodGet, h.GET)
+	return h
+}
+
+// GET PostRevisions
+// @Summary get revisions of post
+// @tags posts
+// @Description get page of saved revisions of post, the last first. Revision keeps title, description,
+// @Description award and attaches which post had before the update
+// @Produce json
+// @Param creator_id path int true "creator_id"
+// @Param post_id path int true "post_id"
+// @Param page query uint64 true "start page number of revisions mutually exclusive with offset"
+// @Param offset query uint64 true "start number of revisions mutually exclusive with page"
+// @Param limit query uint64 true "revisions to return"
+// @Success 200 {object} http_models.ResponsePostRevisions "Success"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters", "invalid parameters in query"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 403 {object} http_models.ErrResponse "for this user forbidden change creator", "this post not belongs this creators"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/posts/{:post_id}/revisions [GET]
+func (h *PostRevisionsHandler) GET(w http.ResponseWriter, r *http.Request) {
+	limit, offset, ok := h.GetPaginationFromQuery(w, r)
+	if !ok {
+		return
+	}
+	postID, ok := h.GetInt64FromParam(w, r, "post_id")
+	if !ok {
+		return
+	}
+
+	revisions, err := h.revisionsUsecase.GetRevisions(postID, &db_models.Pagination{Limit: limit, Offset: offset})
+	if err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsGET)
+		return
+	}
+	h.Log(r).Debugf("get %d revisions of post %d", len(revisions), postID)
+	h.Respond(w, r, http.StatusOK, http_models.ResponsePostRevisions{Revisions: revisions})
+}
@@ -0,0 +1,37 @@
+package posts_tips_handler
+
+import (
+	"net/http"
+	"patreon/internal/app"
+	"patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_redis "patreon/internal/app/repository/pay_token/redis"
+	usecase_tips "patreon/internal/app/usecase/tips"
+
+	"github.com/sirupsen/logrus"
+)
+
+var codesByErrorsPOST = base_handler.CodeMap{
+	models.IncorrectTipAmount: {
+		http.StatusUnprocessableEntity, handler_errors.IncorrectTipAmount, logrus.WarnLevel},
+	models.IncorrectTipMessage: {
+		http.StatusUnprocessableEntity, handler_errors.IncorrectTipMessage, logrus.WarnLevel},
+	usecase_tips.TipAmountTooSmall: {
+		http.StatusUnprocessableEntity, handler_errors.TipAmountTooSmall, logrus.WarnLevel},
+	usecase_tips.CreatorNotFound: {
+		http.StatusNotFound, handler_errors.CreatorNotFound, logrus.WarnLevel},
+	usecase_tips.TipToSelf: {
+		http.StatusUnprocessableEntity, handler_errors.TipToSelf, logrus.WarnLevel},
+	usecase_tips.PostNotBelongCreator: {
+		http.StatusBadRequest, handler_errors.PostNotBelongCreator, logrus.WarnLevel},
+	repository.NotFound: {
+		http.StatusNotFound, handler_errors.PostNotFound, logrus.WarnLevel},
+	repository_redis.SetError: {
+		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
+	repository.DefaultErrDB: {
+		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
+	app.UnknownError: {
+		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
+}
@@ -0,0 +1,30 @@
+package posts_unlock_handler
+
+import (
+	"net/http"
+	"patreon/internal/app"
+	"patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/repository"
+	repository_redis "patreon/internal/app/repository/pay_token/redis"
+	usecase_post_unlocks "patreon/internal/app/usecase/post_unlocks"
+
+	"github.com/sirupsen/logrus"
+)
+
+var codesByErrorsPOST = base_handler.CodeMap{
+	usecase_post_unlocks.PostNotBelongCreator: {
+		http.StatusBadRequest, handler_errors.PostNotBelongCreator, logrus.WarnLevel},
+	usecase_post_unlocks.PostNotForSale: {
+		http.StatusUnprocessableEntity, handler_errors.PostNotForSale, logrus.WarnLevel},
+	usecase_post_unlocks.PostAlreadyAvailable: {
+		http.StatusConflict, handler_errors.PostAlreadyAvailable, logrus.WarnLevel},
+	repository.NotFound: {
+		http.StatusNotFound, handler_errors.PostNotFound, logrus.WarnLevel},
+	repository_redis.SetError: {
+		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
+	repository.DefaultErrDB: {
+		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
+	app.UnknownError: {
+		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
+}
@@ -0,0 +1,83 @@
+package posts_unlock_handler
+
+import (
+	"net/http"
+	csrf_middleware "patreon/internal/app/csrf/middleware"
+	repository_jwt "patreon/internal/app/csrf/repository/jwt"
+	usecase_csrf "patreon/internal/app/csrf/usecase"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/delivery/http/models"
+	"patreon/internal/app/middleware"
+	db_models "patreon/internal/app/models"
+	usecase_post_unlocks "patreon/internal/app/usecase/post_unlocks"
+	usePosts "patreon/internal/app/usecase/posts"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/sirupsen/logrus"
+)
+
+type PostsUnlockHandler struct {
+	unlocksUsecase usecase_post_unlocks.Usecase
+	bh.BaseHandler
+}
+
+func NewPostsUnlockHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucUnlocks usecase_post_unlocks.Usecase, ucPosts usePosts.Usecase) *PostsUnlockHandler {
+	h := &PostsUnlockHandler{
+		unlocksUsecase: ucUnlocks,
+		BaseHandler:    *bh.NewBaseHandler(log),
+	}
+	h.AddMethod(http.MethodPost, h.POST, session_middleware.NewSessionMiddleware(sClient, log).CheckFunc,
+		csrf_middleware.NewCsrfMiddleware(log, usecase_csrf.NewCsrfUsecase(repository_jwt.NewJwtRepository())).CheckCsrfTokenFunc,
+		middleware.NewPostsMiddleware(log, ucPosts).CheckCorrectPostFunc,
+	)
+	return h
+}
+
+// POST UnlockPost
+// @Summary buy access to post
+// @tags posts
+// @Description one-time payment of unlock price of post, which opens post for user regardless of award.
+// @Description Unlock is paid by returned pay_token with /user/payments/checkout
+// @Produce json
+// @Param creator_id path int true "creator_id"
+// @Param post_id path int true "post_id"
+// @Success 201 {object} http_models.ResponsePostUnlock "Unlock created, waits for payment"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters", "post not belongs to creator"
+// @Failure 404 {object} http_models.ErrResponse "post with not found"
+// @Failure 409 {object} http_models.ErrResponse "post is already available for user"
+// @Failure 422 {object} http_models.ErrResponse "post can not be unlocked by payment"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 403 {object} http_models.ErrResponse "this post not belongs this creators", "csrf token is invalid, get new token"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/posts/{:post_id}/unlock [POST]
+func (h *PostsUnlockHandler) POST(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		h.Log(r).Error("can not get user_id from context")
+		h.Error(w, r, http.StatusInternalServerError, handler_errors.InternalError)
+		return
+	}
+	creatorID, ok := h.GetInt64FromParam(w, r, "creator_id")
+	if !ok {
+		return
+	}
+	postID, ok := h.GetInt64FromParam(w, r, "post_id")
+	if !ok {
+		return
+	}
+
+	unlock, err := h.unlocksUsecase.Create(&db_models.PostUnlock{
+		PostID:    postID,
+		UserID:    userID.(int64),
+		CreatorID: creatorID,
+	})
+	if err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsPOST)
+		return
+	}
+	h.Log(r).Debugf("unlock of post %d created by user %d", postID, unlock.UserID)
+	h.Respond(w, r, http.StatusCreated, http_models.ResponsePostUnlock{PostUnlock: *unlock})
+}
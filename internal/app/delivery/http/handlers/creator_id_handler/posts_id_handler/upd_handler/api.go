@@ -19,6 +19,12 @@ var codesByErrorsPUT = base_handler.CodeMap{
 		http.StatusUnprocessableEntity, handler_errors.IncorrectCreatorId, logrus.WarnLevel},
 	models.EmptyTitle: {
 		http.StatusUnprocessableEntity, handler_errors.EmptyTitle, logrus.WarnLevel},
+	models.InvalidUnlockPrice: {
+		http.StatusUnprocessableEntity, handler_errors.IncorrectUnlockPrice, logrus.WarnLevel},
+	models.IncorrectPublishAt: {
+		http.StatusUnprocessableEntity, handler_errors.IncorrectPublishAt, logrus.InfoLevel},
+	models.IncorrectTag: {
+		http.StatusUnprocessableEntity, handler_errors.IncorrectTag, logrus.InfoLevel},
 	repository.DefaultErrDB: {
 		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
 	app.UnknownError: {
@@ -45,13 +45,14 @@ func NewPostsUpdateHandler(log *logrus.Logger,
 // PUT Posts
 // @Summary update current posts
 // @tags posts
-// @Description update current posts from current creator
+// @Description update current posts from current creator, post with publish_at is kept as draft and published at this time.
+// @Description Tags from add_tags are added to post and tags from remove_tags are removed, other tags stay
 // @Param post body http_models.RequestPosts true "Request body for posts"
 // @Produce json
 // @Success 200
 // @Failure 400 {object} http_models.ErrResponse "invalid parameters"
 // @Failure 404 {object} http_models.ErrResponse "post with this id not found"
-// @Failure 422 {object} http_models.ErrResponse "empty title", "this awards id not know", "this creator id not know", "invalid body in request"
+// @Failure 422 {object} http_models.ErrResponse "empty title", "this awards id not know", "this creator id not know", "invalid body in request", "unlock price must not be negative", "publish time of scheduled post must be in future", "tag must be from 1 to 32 symbols without commas"
 // @Failure 500 {object} http_models.ErrResponse "can not do bd operation", "server error"
 // @Failure 403 {object} http_models.ErrResponse "for this user forbidden change creator", "this post not belongs this creators", "csrf token is invalid, get new token"
 // @Failure 401 "user are not authorized"
@@ -81,7 +82,9 @@ func (h *PostsUpdateHandler) PUT(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err = h.postsUsecase.Update(h.Log(r), &models_db.UpdatePost{ID: postId, Title: req.Title,
-		Description: req.Description, Awards: req.AwardsId, IsDraft: req.IsDraft}); err != nil {
+		Description: req.Description, Awards: req.AwardsId, IsDraft: req.IsDraft,
+		UnlockPrice: req.UnlockPrice, Preview: req.Preview, PublishAt: req.PublishAt,
+		AddTags: req.AddTags, RemoveTags: req.RemoveTags}); err != nil {
 		h.UsecaseError(w, r, err, codesByErrorsPUT)
 		return
 	}
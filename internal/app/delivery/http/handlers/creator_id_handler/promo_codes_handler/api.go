@@ -0,0 +1,40 @@
+package promo_codes_handler
+
+import (
+	"net/http"
+	"patreon/internal/app"
+	"patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_promo_codes "patreon/internal/app/repository/promo_codes"
+	usecase_promo_codes "patreon/internal/app/usecase/promo_codes"
+
+	"github.com/sirupsen/logrus"
+)
+
+var codesByErrorsGET = base_handler.CodeMap{
+	repository.DefaultErrDB: {
+		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
+}
+
+var codesByErrorsPOST = base_handler.CodeMap{
+	models.IncorrectPromoCode: {
+		http.StatusUnprocessableEntity, handler_errors.IncorrectPromoCode, logrus.WarnLevel},
+	models.IncorrectDiscountType: {
+		http.StatusUnprocessableEntity, handler_errors.IncorrectDiscountType, logrus.WarnLevel},
+	models.IncorrectDiscount: {
+		http.StatusUnprocessableEntity, handler_errors.IncorrectDiscount, logrus.WarnLevel},
+	models.IncorrectMaxUses: {
+		http.StatusUnprocessableEntity, handler_errors.IncorrectMaxUses, logrus.WarnLevel},
+	models.IncorrectPromoPeriod: {
+		http.StatusUnprocessableEntity, handler_errors.IncorrectPromoPeriod, logrus.WarnLevel},
+	usecase_promo_codes.AwardNotFound: {
+		http.StatusUnprocessableEntity, handler_errors.PromoCodeAwardNotFound, logrus.WarnLevel},
+	repository_promo_codes.PromoCodeAlreadyExists: {
+		http.StatusConflict, handler_errors.PromoCodeAlreadyExists, logrus.InfoLevel},
+	repository.DefaultErrDB: {
+		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
+	app.UnknownError: {
+		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
+}
@@ -0,0 +1,128 @@
+package promo_codes_handler
+
+import (
+	"net/http"
+	csrf_middleware "patreon/internal/app/csrf/middleware"
+	repository_jwt "patreon/internal/app/csrf/repository/jwt"
+	usecase_csrf "patreon/internal/app/csrf/usecase"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/delivery/http/models"
+	"patreon/internal/app/middleware"
+	db_models "patreon/internal/app/models"
+	usecase_promo_codes "patreon/internal/app/usecase/promo_codes"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/sirupsen/logrus"
+)
+
+type PromoCodesHandler struct {
+	promoCodesUsecase usecase_promo_codes.Usecase
+	bh.BaseHandler
+}
+
+func NewPromoCodesHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucPromoCodes usecase_promo_codes.Usecase) *PromoCodesHandler {
+	h := &PromoCodesHandler{
+		promoCodesUsecase: ucPromoCodes,
+		BaseHandler:       *bh.NewBaseHandler(log),
+	}
+	h.AddMethod(http.MethodGet, h.GET,
+		session_middleware.NewSessionMiddleware(sClient, log).CheckFunc,
+		middleware.NewCreatorsMiddleware(log).CheckAllowUserFunc,
+	)
+	h.AddMethod(http.MethodPost, h.POST,
+		session_middleware.NewSessionMiddleware(sClient, log).CheckFunc,
+		middleware.NewCreatorsMiddleware(log).CheckAllowUserFunc,
+		csrf_middleware.NewCsrfMiddleware(log, usecase_csrf.NewCsrfUsecase(repository_jwt.NewJwtRepository())).CheckCsrfTokenFunc,
+	)
+	return h
+}
+
+// GET PromoCodes
+// @Summary get creator promo codes
+// @tags promo_codes
+// @Description get all promo codes of creator with their uses, last created first
+// @Produce json
+// @Param creator_id path int true "creator_id"
+// @Success 200 {object} http_models.ResponsePromoCodes "Success"
+// @Failure 204 {object} http_models.OkResponse "creator promo codes not found"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters"
+// @Failure 403 {object} http_models.ErrResponse "this user not have permission for this creator"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/promo_codes [GET]
+func (h *PromoCodesHandler) GET(w http.ResponseWriter, r *http.Request) {
+	creatorID, ok := h.GetInt64FromParam(w, r, "creator_id")
+	if !ok {
+		return
+	}
+
+	codes, err := h.promoCodesUsecase.GetCreatorCodes(creatorID)
+	if err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsGET)
+		return
+	}
+	if len(codes) == 0 {
+		h.Respond(w, r, http.StatusNoContent, http_models.OkResponse{
+			Ok: handler_errors.PromoCodesNotFound.Error(),
+		})
+		return
+	}
+	h.Respond(w, r, http.StatusOK, http_models.ResponsePromoCodes{PromoCodes: codes})
+}
+
+// POST CreatePromoCode
+// @Summary create promo code
+// @tags promo_codes
+// @Description create promo code of creator. Code is case insensitive, without award_ids it works for any award.
+// @Description Without valid_from code works at once, max_uses = 0 means no limit of uses
+// @Accept json
+// @Produce json
+// @Param creator_id path int true "creator_id"
+// @Param promo_code body http_models.RequestPromoCode true "Request body"
+// @Success 201 {object} http_models.ResponsePromoCode "Promo code created"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters"
+// @Failure 409 {object} http_models.ErrResponse "creator already have promo code with this code"
+// @Failure 422 {object} http_models.ErrResponse "invalid body in request", "promo code must be from 3 to 32 latin letters, digits, '-' or '_'", "discount type must be percent or fixed", "discount must be positive, percent discount less than 100", "max uses must not be negative", "promo code valid_until must be after valid_from", "promo code award not found or not belongs to creator"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 403 {object} http_models.ErrResponse "csrf token is invalid, get new token", "this user not have permission for this creator"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/promo_codes [POST]
+func (h *PromoCodesHandler) POST(w http.ResponseWriter, r *http.Request) {
+	req := &http_models.RequestPromoCode{}
+
+	err := h.GetRequestBody(w, r, req, *bluemonday.UGCPolicy())
+	if err != nil || req.Validate() != nil {
+		h.Log(r).Warnf("can not parse request %s", err)
+		h.Error(w, r, http.StatusUnprocessableEntity, handler_errors.InvalidBody)
+		return
+	}
+	creatorID, ok := h.GetInt64FromParam(w, r, "creator_id")
+	if !ok {
+		return
+	}
+
+	code := &db_models.PromoCode{
+		CreatorID:    creatorID,
+		Code:         req.Code,
+		DiscountType: db_models.DiscountType(req.DiscountType),
+		Discount:     req.Discount,
+		AwardIDs:     req.AwardIDs,
+		MaxUses:      req.MaxUses,
+		ValidUntil:   req.ValidUntil,
+	}
+	if req.ValidFrom != nil {
+		code.ValidFrom = *req.ValidFrom
+	}
+
+	code, err = h.promoCodesUsecase.Create(code)
+	if err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsPOST)
+		return
+	}
+	h.Log(r).Debugf("promo code %d of creator %d created", code.ID, creatorID)
+	h.Respond(w, r, http.StatusCreated, http_models.ResponsePromoCode{PromoCode: *code})
+}
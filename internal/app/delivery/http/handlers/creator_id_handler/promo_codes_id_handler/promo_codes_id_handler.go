@@ -0,0 +1,66 @@
+package promo_codes_id_handler
+
+import (
+	"net/http"
+	csrf_middleware "patreon/internal/app/csrf/middleware"
+	repository_jwt "patreon/internal/app/csrf/repository/jwt"
+	usecase_csrf "patreon/internal/app/csrf/usecase"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/middleware"
+	usecase_promo_codes "patreon/internal/app/usecase/promo_codes"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/sirupsen/logrus"
+)
+
+type PromoCodesIdHandler struct {
+	promoCodesUsecase usecase_promo_codes.Usecase
+	bh.BaseHandler
+}
+
+func NewPromoCodesIdHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucPromoCodes usecase_promo_codes.Usecase) *PromoCodesIdHandler {
+	h := &PromoCodesIdHandler{
+		promoCodesUsecase: ucPromoCodes,
+		BaseHandler:       *bh.NewBaseHandler(log),
+	}
+	h.AddMethod(http.MethodDelete, h.DELETE,
+		session_middleware.NewSessionMiddleware(sClient, log).CheckFunc,
+		middleware.NewCreatorsMiddleware(log).CheckAllowUserFunc,
+		csrf_middleware.NewCsrfMiddleware(log, usecase_csrf.NewCsrfUsecase(repository_jwt.NewJwtRepository())).CheckCsrfTokenFunc,
+	)
+	return h
+}
+
+// DELETE DeactivatePromoCode
+// @Summary deactivate promo code
+// @tags promo_codes
+// @Description deactivate promo code of creator, it can not be applied to new pay tokens,
+// @Description payments already made with it keep their discount
+// @Param creator_id path int true "creator_id"
+// @Param promo_code_id path int true "promo_code_id"
+// @Success 200 "Promo code deactivated"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters"
+// @Failure 404 {object} http_models.ErrResponse "promo code with this code not found"
+// @Failure 500 {object} http_models.ErrResponse "can not do bd operation"
+// @Failure 403 {object} http_models.ErrResponse "csrf token is invalid, get new token", "this user not have permission for this creator"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/promo_codes/{:promo_code_id} [DELETE]
+func (h *PromoCodesIdHandler) DELETE(w http.ResponseWriter, r *http.Request) {
+	creatorID, ok := h.GetInt64FromParam(w, r, "creator_id")
+	if !ok {
+		return
+	}
+	codeID, ok := h.GetInt64FromParam(w, r, "promo_code_id")
+	if !ok {
+		return
+	}
+
+	if err := h.promoCodesUsecase.Deactivate(creatorID, codeID); err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsDELETE)
+		return
+	}
+	h.Log(r).Debugf("promo code %d of creator %d deactivated", codeID, creatorID)
+	w.WriteHeader(http.StatusOK)
+}
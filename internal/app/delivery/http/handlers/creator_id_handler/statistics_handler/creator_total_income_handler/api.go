@@ -3,6 +3,7 @@ package statistics_total_income_handler
 import (
 	"github.com/sirupsen/logrus"
 	"net/http"
+	"patreon/internal/app"
 	"patreon/internal/app/delivery/http/handlers/base_handler"
 	"patreon/internal/app/delivery/http/handlers/handler_errors"
 	"patreon/internal/app/repository"
@@ -14,4 +15,6 @@ var codeByErrorGet = base_handler.CodeMap{
 		http.StatusNotFound, handler_errors.CreatorNotFound, logrus.WarnLevel},
 	repository.DefaultErrDB: {
 		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
+	app.UnknownError: {
+		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
 }
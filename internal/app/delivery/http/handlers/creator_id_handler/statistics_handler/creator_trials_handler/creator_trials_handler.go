@@ -0,0 +1,73 @@
+package statistics_trials_handler
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/delivery/http/models"
+	statistics_usecase "patreon/internal/app/usecase/statistics"
+)
+
+type CreatorTrialsHandler struct {
+	statisticsUsecase statistics_usecase.Usecase
+	bh.BaseHandler
+}
+
+func NewCreatorTrialsHandler(log *logrus.Logger, ucStatistics statistics_usecase.Usecase) *CreatorTrialsHandler {
+	h := &CreatorTrialsHandler{
+		statisticsUsecase: ucStatistics,
+		BaseHandler:       *bh.NewBaseHandler(log),
+	}
+	h.AddMethod(http.MethodGet, h.GET)
+
+	return h
+}
+
+// GET CreatorTrials
+// @Summary get creator free trials statistics
+// @tags posts
+// @Description get count of started, active and converted to paid free trials and conversion rate in percent
+// @Produce json
+// @Param days query uint64 true "number of processing days"
+// @Success 200 {object} http_models.ResponseCreatorTrials
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters", "invalid parameters in query"
+// @Failure 404 {object} http_models.ErrResponse "creator not found"
+// @Failure 500 {object} http_models.ErrResponse "can not do bd operation", "server error"
+// @Router /creators/{:creator_id}/statistics/trials [GET]
+func (h *CreatorTrialsHandler) GET(w http.ResponseWriter, r *http.Request) {
+	days, ok := h.GetInt64FromQueries(w, r, "days")
+	if !ok {
+		if days == bh.EmptyQuery {
+			h.Log(r).Info("missing param days")
+			h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidParameters)
+		}
+		return
+	}
+	if days < 0 {
+		h.Log(r).Infof("query param days < 0; days from query =  %v)", days)
+		h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidParameters)
+		return
+	}
+
+	if len(mux.Vars(r)) > 1 {
+		h.Log(r).Warnf("Too many parametres %v", mux.Vars(r))
+		h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidParameters)
+		return
+	}
+
+	creatorId, ok := h.GetInt64FromParam(w, r, "creator_id")
+	if !ok {
+		return
+	}
+
+	stats, err := h.statisticsUsecase.GetTrialStats(creatorId, days)
+	if err != nil {
+		h.UsecaseError(w, r, err, codeByErrorGet)
+		return
+	}
+
+	h.Log(r).Debugf("trials of creator_id = %v with last %v days: %+v", creatorId, days, stats)
+	h.Respond(w, r, http.StatusOK, http_models.ResponseCreatorTrials{TrialStats: *stats})
+}
@@ -0,0 +1 @@
+package statistics_trials_handler
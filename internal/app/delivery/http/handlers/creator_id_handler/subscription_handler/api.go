@@ -0,0 +1,39 @@
+package subscription_handler
+
+import (
+	"net/http"
+	"patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/repository"
+	repository_redis "patreon/internal/app/repository/pay_token/redis"
+	repository_subscribers "patreon/internal/app/repository/subscribers"
+	usecase_subscribers "patreon/internal/app/usecase/subscribers"
+
+	"github.com/sirupsen/logrus"
+)
+
+var codesByErrorsGET = base_handler.CodeMap{
+	repository.DefaultErrDB: {
+		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
+}
+
+var codesByErrorsPUT = base_handler.CodeMap{
+	usecase_subscribers.SubscriptionsNotFound: {
+		http.StatusNotFound, handler_errors.SubscribesNotFound, logrus.WarnLevel},
+	usecase_subscribers.SubscriptionInGrace: {
+		http.StatusConflict, handler_errors.SubscriptionWaitsRenewal, logrus.WarnLevel},
+	usecase_subscribers.AwardAlreadySubscribed: {
+		http.StatusConflict, handler_errors.AwardAlreadySubscribed, logrus.WarnLevel},
+	repository_subscribers.AwardSoldOut: {
+		http.StatusConflict, handler_errors.AwardSoldOut, logrus.InfoLevel},
+	usecase_subscribers.AwardNotBelongCreator: {
+		http.StatusBadRequest, handler_errors.AwardNotBelongCreator, logrus.WarnLevel},
+	usecase_subscribers.AwardsNotRelated: {
+		http.StatusBadRequest, handler_errors.AwardsNotRelated, logrus.WarnLevel},
+	repository.NotFound: {
+		http.StatusNotFound, handler_errors.AwardNotFound, logrus.WarnLevel},
+	repository_redis.SetError: {
+		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
+	repository.DefaultErrDB: {
+		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
+}
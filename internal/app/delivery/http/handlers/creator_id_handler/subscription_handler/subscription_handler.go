@@ -0,0 +1,138 @@
+package subscription_handler
+
+import (
+	"github.com/microcosm-cc/bluemonday"
+	"net/http"
+	csrf_middleware "patreon/internal/app/csrf/middleware"
+	repository_jwt "patreon/internal/app/csrf/repository/jwt"
+	usecase_csrf "patreon/internal/app/csrf/usecase"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/delivery/http/models"
+	"patreon/internal/app/models"
+	usecase_subscribers "patreon/internal/app/usecase/subscribers"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+)
+
+type SubscriptionHandler struct {
+	subscriberUsecase usecase_subscribers.Usecase
+	bh.BaseHandler
+}
+
+func NewSubscriptionHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucSubscribers usecase_subscribers.Usecase) *SubscriptionHandler {
+	h := &SubscriptionHandler{
+		BaseHandler:       *bh.NewBaseHandler(log),
+		subscriberUsecase: ucSubscribers,
+	}
+	h.AddMethod(http.MethodGet, h.GET, session_middleware.NewSessionMiddleware(sClient, log).CheckFunc)
+	h.AddMethod(http.MethodPut, h.PUT, session_middleware.NewSessionMiddleware(sClient, log).CheckFunc,
+		csrf_middleware.NewCsrfMiddleware(log, usecase_csrf.NewCsrfUsecase(repository_jwt.NewJwtRepository())).CheckCsrfTokenFunc,
+	)
+	return h
+}
+
+// GET TierChanges
+// @Summary get tier changes history of subscription
+// @tags subscriptions
+// @Description get upgrades and downgrades of user subscriptions on the creator with id = creator_id, last first.
+// @Description Not paid upgrade has status pending and pay_token of its payment
+// @Produce json
+// @Param creator_id path int true "creator_id"
+// @Success 200 {object} http_models.ResponseTierChanges "Success"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/subscription [GET]
+func (h *SubscriptionHandler) GET(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		h.Log(r).Error("can not get user_id from context")
+		h.Error(w, r, http.StatusInternalServerError, handler_errors.InternalError)
+		return
+	}
+	creatorID, ok := h.GetInt64FromParam(w, r, "creator_id")
+	if !ok {
+		return
+	}
+	if len(mux.Vars(r)) > 1 {
+		h.Log(r).Warnf("Too many parametres %v", mux.Vars(r))
+		h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidParameters)
+		return
+	}
+
+	changes, err := h.subscriberUsecase.GetTierChanges(userID.(int64), creatorID)
+	if err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsGET)
+		return
+	}
+	h.Respond(w, r, http.StatusOK, http_models.ResponseTierChanges{Changes: changes})
+}
+
+// PUT ChangeTier
+// @Summary move subscription to other award of the creator
+// @tags subscriptions
+// @Description move subscription on the creator with id = creator_id to award with award_id.
+// @Description Upgrade to higher award must be paid by pay_token from response, amount is price difference
+// @Description for the rest of paid period, award is changed when payment succeeded (at once if nothing to pay).
+// @Description Downgrade to lower award is applied at effective_at - the end of paid period, renewal is paid by its price.
+// @Description Change to current award cancels scheduled downgrade and returns no body
+// @Accept json
+// @Produce json
+// @Param creator_id path int true "creator_id"
+// @Param award_id body http_models.RequestChangeTier true "Request body"
+// @Success 200 {object} http_models.ResponseTierChange "Successfully changed, or downgrade cancelled"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters", "award not belongs to creator", "awards are not in the same hierarchy"
+// @Failure 404 {object} http_models.ErrResponse "subscribes on the creator not found", "award with this id not found"
+// @Failure 409 {object} http_models.ErrResponse "subscription waits for renewal payment", "subscription already on this award", "all seats of award are taken, join its waitlist"
+// @Failure 422 {object} http_models.ErrResponse "invalid body in request"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 403 {object} http_models.ErrResponse "csrf token is invalid, get new token"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/subscription [PUT]
+func (h *SubscriptionHandler) PUT(w http.ResponseWriter, r *http.Request) {
+	req := &http_models.RequestChangeTier{}
+
+	err := h.GetRequestBody(w, r, req, *bluemonday.UGCPolicy())
+	if err != nil || req.Validate() != nil {
+		h.Log(r).Warnf("can not parse request %s", err)
+		h.Error(w, r, http.StatusUnprocessableEntity, handler_errors.InvalidBody)
+		return
+	}
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		h.Log(r).Error("can not get user_id from context")
+		h.Error(w, r, http.StatusInternalServerError, handler_errors.InternalError)
+		return
+	}
+	creatorID, ok := h.GetInt64FromParam(w, r, "creator_id")
+	if !ok {
+		return
+	}
+	if len(mux.Vars(r)) > 1 {
+		h.Log(r).Warnf("Too many parametres %v", mux.Vars(r))
+		h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidParameters)
+		return
+	}
+
+	change, err := h.subscriberUsecase.ChangeTier(&models.Subscriber{
+		UserID:    userID.(int64),
+		CreatorID: creatorID,
+		AwardID:   req.AwardID,
+	})
+	if err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsPUT)
+		return
+	}
+	if change == nil {
+		h.Log(r).Debugf("downgrade on creator_id = %v cancelled", creatorID)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	h.Log(r).Debugf("%s on creator_id = %v to award %v", change.Kind, creatorID, change.ToAwardID)
+	h.Respond(w, r, http.StatusOK, http_models.ResponseTierChange{TierChange: *change})
+}
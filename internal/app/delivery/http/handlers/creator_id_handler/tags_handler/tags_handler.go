@@ -0,0 +1,60 @@
+package tags_handler
+
+import (
+	"net/http"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/models"
+	usePosts "patreon/internal/app/usecase/posts"
+	"strconv"
+
+	"github.com/sirupsen/logrus"
+)
+
+type TagsHandler struct {
+	postsUsecase usePosts.Usecase
+	bh.BaseHandler
+}
+
+func NewTagsHandler(log *logrus.Logger, ucPosts usePosts.Usecase) *TagsHandler {
+	h := &TagsHandler{
+		postsUsecase: ucPosts,
+		BaseHandler:  *bh.NewBaseHandler(log),
+	}
+	h.AddMethod(http.MethodGet, h.GET)
+	return h
+}
+
+// GET CreatorTags
+// @Summary get tag cloud of creator
+// @tags creators
+// @Description get tags of creator with count of posts marked by them, the most used first.
+// @Description Tags without posts are not returned
+// @Produce json
+// @Param creator_id path int true "creator_id"
+// @Param with-draft query bool false "if need count draft posts"
+// @Success 200 {object} http_models.ResponseTags "Success"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Router /creators/{:creator_id}/tags [GET]
+func (h *TagsHandler) GET(w http.ResponseWriter, r *http.Request) {
+	creatorID, ok := h.GetInt64FromParam(w, r, "creator_id")
+	if !ok {
+		return
+	}
+
+	withDraft := false
+	if res := r.URL.Query().Get("with-draft"); res != "" {
+		var err error
+		if withDraft, err = strconv.ParseBool(res); err != nil {
+			withDraft = true
+		}
+	}
+
+	tags, err := h.postsUsecase.GetCreatorTags(creatorID, withDraft)
+	if err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsGET)
+		return
+	}
+	h.Log(r).Debugf("get %d tags of creator %d", len(tags), creatorID)
+	h.Respond(w, r, http.StatusOK, http_models.ResponseTags{Tags: tags})
+}
@@ -0,0 +1,86 @@
+package tips_handler
+
+import (
+	"net/http"
+	csrf_middleware "patreon/internal/app/csrf/middleware"
+	repository_jwt "patreon/internal/app/csrf/repository/jwt"
+	usecase_csrf "patreon/internal/app/csrf/usecase"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/delivery/http/models"
+	db_models "patreon/internal/app/models"
+	usecase_tips "patreon/internal/app/usecase/tips"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/sirupsen/logrus"
+)
+
+type TipsHandler struct {
+	tipsUsecase usecase_tips.Usecase
+	bh.BaseHandler
+}
+
+func NewTipsHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucTips usecase_tips.Usecase) *TipsHandler {
+	h := &TipsHandler{
+		tipsUsecase: ucTips,
+		BaseHandler: *bh.NewBaseHandler(log),
+	}
+	h.AddMethod(http.MethodPost, h.POST, session_middleware.NewSessionMiddleware(sClient, log).CheckFunc,
+		csrf_middleware.NewCsrfMiddleware(log, usecase_csrf.NewCsrfUsecase(repository_jwt.NewJwtRepository())).CheckCsrfTokenFunc,
+	)
+	return h
+}
+
+// POST TipCreator
+// @Summary send tip to creator
+// @tags tips
+// @Description one-time payment of any amount not less than minimal with optional message for creator.
+// @Description Tip is paid by returned pay_token with /user/payments/checkout, message is shown to creator in push after payment
+// @Accept json
+// @Produce json
+// @Param creator_id path int true "creator_id"
+// @Param tip body http_models.RequestTip true "Request body"
+// @Success 201 {object} http_models.ResponseTip "Tip created, waits for payment"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters"
+// @Failure 404 {object} http_models.ErrResponse "creator not found"
+// @Failure 422 {object} http_models.ErrResponse "invalid body in request", "tip amount must be positive", "tip message must be not longer 500 symbols", "tip amount is less than minimal", "creator can not tip himself"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 403 {object} http_models.ErrResponse "csrf token is invalid, get new token"
+// @Failure 401 "user are not authorized"
+// @Router /creators/{:creator_id}/tips [POST]
+func (h *TipsHandler) POST(w http.ResponseWriter, r *http.Request) {
+	req := &http_models.RequestTip{}
+
+	err := h.GetRequestBody(w, r, req, *bluemonday.UGCPolicy())
+	if err != nil || req.Validate() != nil {
+		h.Log(r).Warnf("can not parse request %s", err)
+		h.Error(w, r, http.StatusUnprocessableEntity, handler_errors.InvalidBody)
+		return
+	}
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		h.Log(r).Error("can not get user_id from context")
+		h.Error(w, r, http.StatusInternalServerError, handler_errors.InternalError)
+		return
+	}
+	creatorID, ok := h.GetInt64FromParam(w, r, "creator_id")
+	if !ok {
+		return
+	}
+
+	tip, err := h.tipsUsecase.Create(&db_models.Tip{
+		UserID:    userID.(int64),
+		CreatorID: creatorID,
+		Amount:    req.Amount,
+		Message:   req.Message,
+	})
+	if err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsPOST)
+		return
+	}
+	h.Log(r).Debugf("tip %d to creator %d created by user %d", tip.ID, creatorID, tip.UserID)
+	h.Respond(w, r, http.StatusCreated, http_models.ResponseTip{Tip: *tip})
+}
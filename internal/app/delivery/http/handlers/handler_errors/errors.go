@@ -7,7 +7,7 @@ import (
 	"patreon/internal/app/models"
 )
 
-/// NOT FOUND
+// / NOT FOUND
 var (
 	PayTokenNotFound         = errors.New("pay token not found")
 	CreatorNotFound          = errors.New("creator not found")
@@ -20,19 +20,23 @@ var (
 	CommentNotFound          = errors.New("comment with this id not found")
 	PaymentsNotFound         = errors.New("this user have not payment")
 	CreatorPaymentsNotFound  = errors.New("creator payments not found")
+	SubscribersNotFound      = errors.New("creator subscribers not found")
+	SubscrEventsNotFound     = errors.New("subscription events not found")
+	BlockedUsersNotFound     = errors.New("creator blocked users not found")
+	PostRevisionNotFound     = errors.New("post revision not found")
 )
 
-/// File parse error
+// / File parse error
 var (
 	IncorrectType = errors.New(
-		fmt.Sprintf("Not allow type, allowed type is: %s, %s, %s, %s, %s",
-			models.Music, models.Video, models.Files, models.Text, models.Image))
+		fmt.Sprintf("Not allow type, allowed type is: %s, %s, %s, %s, %s, %s",
+			models.Music, models.Video, models.Files, models.Text, models.Image, models.Poll))
 	IncorrectIdAttach = errors.New("Not valid attach id")
 	IncorrectStatus   = errors.New(fmt.Sprintf("Not allow status, allowed status is: %s, %s",
 		http_models.AddStatus, http_models.UpdateStatus))
 )
 
-/// Fields Incorrect
+// / Fields Incorrect
 var (
 	InvalidNickname          = errors.New("invalid creator nickname")
 	InvalidCategory          = errors.New("invalid creator category")
@@ -48,6 +52,34 @@ var (
 	IncorrectNewPassword     = errors.New("invalid new password")
 	IncorrectDataType        = errors.New("invalid data type")
 	InvalidOldNickname       = errors.New("old nickname not equal current user nickname")
+	IncorrectPromoCode       = errors.New("promo code must be from 3 to 32 latin letters, digits, '-' or '_'")
+	IncorrectDiscountType    = errors.New("discount type must be percent or fixed")
+	IncorrectDiscount        = errors.New("discount must be positive, percent discount less than 100")
+	IncorrectMaxUses         = errors.New("max uses must not be negative")
+	IncorrectPromoPeriod     = errors.New("promo code valid_until must be after valid_from")
+	IncorrectGiftPeriods     = errors.New(fmt.Sprintf("gift periods must be from 1 to %v", models.MaxGiftPeriods))
+	IncorrectTrialDays       = errors.New(fmt.Sprintf("trial days must be from 0 to %v", models.MaxTrialDays))
+	IncorrectMaxSubscribers  = errors.New("max subscribers must not be negative")
+	IncorrectTipAmount       = errors.New("tip amount must be positive")
+	IncorrectTipMessage      = errors.New(fmt.Sprintf("tip message must be not longer %v symbols", models.MaxTipMessageLength))
+	IncorrectUnlockPrice     = errors.New("unlock price must not be negative")
+	IncorrectCurrency        = errors.New("currency must be ISO 4217 code with known exchange rate")
+	IncorrectPaymentsPeriod  = errors.New("date to must not be before date from")
+	IncorrectPaymentState    = errors.New("unknown payment state in filter")
+	IncorrectExportFormat    = errors.New("export format must be csv or jsonl")
+	IncorrectPublishAt       = errors.New("publish time of scheduled post must be in future")
+	IncorrectTag             = errors.New("tag must be from 1 to 32 symbols without commas")
+	IncorrectPoll            = errors.New(fmt.Sprintf("poll must have question and from %v to %v not empty options",
+		models.MinPollOptions, models.MaxPollOptions))
+	IncorrectVote = errors.New("vote must contain different options of poll, single choice poll allows one option")
+
+	IncorrectSubscriptionStatus = errors.New("subscription status must be active or expired")
+	IncorrectSubscribersSort    = errors.New("subscribers sort must be newest, oldest, amount or nickname")
+
+	IncorrectCancelReason = errors.New(fmt.Sprintf("cancel reason must be not longer %v symbols",
+		models.MaxCancelReasonLength))
+	IncorrectSubscriptionEventKind = errors.New("subscription event kind must be subscribed, renewed, " +
+		"tier_changed, cancelled, expired or refunded")
 )
 
 // BD Error
@@ -56,6 +88,7 @@ var (
 	LikesAlreadyExists       = errors.New("this user already add like for this post")
 	AwardsAlreadyExists      = errors.New("awards with this name already exists")
 	AwardsPriceAlreadyExists = errors.New("awards with this price already exists")
+	PromoCodeAlreadyExists   = errors.New("creator already have promo code with this code")
 	UserAlreadyExist         = errors.New("user already exist")
 	NicknameAlreadyExist     = errors.New("nickname already exist")
 	CreatorAlreadyExist      = errors.New("creator already exist")
@@ -66,6 +99,7 @@ var (
 // AccessError
 var (
 	NotAllowAddComment = errors.New("this user can not add comment as creator")
+	UserBlocked        = errors.New("user is blocked by creator")
 )
 
 // Session Error
@@ -76,19 +110,72 @@ var (
 
 // Request Error
 var (
-	NotEqualPaymentAmount = errors.New("payment amount from request not equal amount from database")
-	InvalidBody           = errors.New("invalid body in request")
-	InvalidParameters     = errors.New("invalid parameters")
-	UserNotHaveAward      = errors.New("this user not have award for this post")
-	InvalidQueries        = errors.New("invalid parameters in query")
-	FileSizeError         = errors.New("size of file very big")
-	InvalidFormFieldName  = errors.New("invalid form field name for load file")
-	InvalidExt            = errors.New("please upload: ")
-	UserAlreadySubscribe  = errors.New("this user already have subscribe on creator")
-	SubscribesNotFound    = errors.New("subscribes on the creator not found")
-	InvalidUserNickname   = errors.New(fmt.Sprintf("invalid nickname in body len must be from %v to %v",
+	NotEqualPaymentAmount   = errors.New("payment amount from request not equal amount from database")
+	NotEqualPaymentCurrency = errors.New("payment currency from request not equal currency from database")
+	InvalidBody             = errors.New("invalid body in request")
+	InvalidParameters       = errors.New("invalid parameters")
+	UserNotHaveAward        = errors.New("this user not have award for this post")
+	InvalidQueries          = errors.New("invalid parameters in query")
+	FileSizeError           = errors.New("size of file very big")
+	InvalidFormFieldName    = errors.New("invalid form field name for load file")
+	InvalidExt              = errors.New("please upload: ")
+	UserAlreadySubscribe    = errors.New("this user already have subscribe on creator")
+	SubscribesNotFound      = errors.New("subscribes on the creator not found")
+	InvalidUserNickname     = errors.New(fmt.Sprintf("invalid nickname in body len must be from %v to %v",
 		models.MIN_NICKNAME_LENGTH, models.MAX_NICKNAME_LENGTH))
-	InvalidUserPayToken = errors.New("this user was not given this token")
+	InvalidUserPayToken          = errors.New("this user was not given this token")
+	InvalidNotificationHash      = errors.New("notification sha1_hash is invalid")
+	ProtectedPaymentNotSupported = errors.New("payments protected by code are not supported")
+	UnacceptedPayment            = errors.New("payment was not accepted by receiver")
+	PaymentAlreadyPaid           = errors.New("payment already paid")
+	InvalidPaymentState          = errors.New("payment can not be paid in current state")
+	PayTokenNotForAward          = errors.New("pay token was given for other award")
+	PayTokenAlreadyUsed          = errors.New("pay token already used")
+	AwardNotBelongCreator        = errors.New("award not belongs to creator")
+	PaymentNotMatchPayToken      = errors.New("payment amount not equal price fixed in pay token")
+	UnsupportedPaymentCurrency   = errors.New("payment provider does not accept currency of payment")
+	PaymentNotFound              = errors.New("payment with this id not found")
+	InvalidRefundAmount          = errors.New("refund amount must be positive and not greater than not refunded amount")
+	RefundNotSupported           = errors.New("payment provider does not support refunds")
+	SubscriptionWaitsRenewal     = errors.New("subscription waits for renewal payment")
+	AwardAlreadySubscribed       = errors.New("subscription already on this award")
+	AwardsNotRelated             = errors.New("awards are not in the same hierarchy")
+	NotEnoughBalance             = errors.New("creator balance less than payout amount")
+	PayoutNotFound               = errors.New("payout with this id not found")
+	PayoutsNotFound              = errors.New("creator payouts not found")
+	LedgerEntriesNotFound        = errors.New("creator ledger entries not found")
+	InvalidPayoutTransition      = errors.New("payout can not move to this state from current one")
+	PayoutStateChanged           = errors.New("payout state was changed by other request, try again")
+	NotPayoutAdmin               = errors.New("user is not allowed to manage payouts")
+	PromoCodeNotFound            = errors.New("promo code with this code not found")
+	PromoCodesNotFound           = errors.New("creator promo codes not found")
+	PromoCodeNotActive           = errors.New("promo code is expired, deactivated or exhausted")
+	PromoCodeNotForAward         = errors.New("promo code can not be applied to this award")
+	PromoCodeAlreadyUsed         = errors.New("user already used this promo code")
+	PromoCodeAwardNotFound       = errors.New("promo code award not found or not belongs to creator")
+	GiftRecipientNotFound        = errors.New("gift recipient not found")
+	GiftToSelf                   = errors.New("gift can not be bought for yourself")
+	GiftRecipientSubscribed      = errors.New("recipient is already subscribed on other award of this creator")
+	GiftNotFound                 = errors.New("gift with this code not found")
+	GiftNotRedeemable            = errors.New("gift is not paid or already redeemed")
+	GiftsNotFound                = errors.New("user gifts not found")
+	TrialNotAvailable            = errors.New("award has not free trial")
+	TrialNotAllowed              = errors.New("free trial is only for users never subscribed on this creator")
+	TrialAlreadyUsed             = errors.New("free trial on this creator already used")
+	SubscriptionInTrial          = errors.New("tier can not be changed during free trial")
+	TipAmountTooSmall            = errors.New("tip amount is less than minimal")
+	TipToSelf                    = errors.New("creator can not tip himself")
+	PostNotBelongCreator         = errors.New("post not belongs to creator")
+	PostNotForSale               = errors.New("post can not be unlocked by payment")
+	PostAlreadyAvailable         = errors.New("post is already available for user")
+	AwardSoldOut                 = errors.New("all seats of award are taken, join its waitlist")
+	AwardHasFreeSeats            = errors.New("award has free seats, subscribe on it")
+	BlockSelf                    = errors.New("creator can not block himself")
+	AttachNotPoll                = errors.New("attach is not poll")
+	PollNotEditable              = errors.New("poll can not be changed, remove it and add new one")
+	PollClosed                   = errors.New("poll is closed")
+	VoteAlreadyExist             = errors.New("user already voted in this poll")
+	NotVoted                     = errors.New("user has not voted in this poll")
 )
 
 var InternalError = errors.New("server error")
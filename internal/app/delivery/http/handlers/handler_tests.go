@@ -10,6 +10,8 @@ import (
 	mock_usecase_creator "patreon/internal/app/usecase/creator/mocks"
 	mock_usecase_info "patreon/internal/app/usecase/info/mocks"
 	mock_usecase_like "patreon/internal/app/usecase/likes/mocks"
+	mock_usecase_pay_token "patreon/internal/app/usecase/pay_token/mocks"
+	mock_usecase_payments "patreon/internal/app/usecase/payments/mocks"
 	mock_usecase_posts "patreon/internal/app/usecase/posts/mocks"
 	mock_subscribers "patreon/internal/app/usecase/subscribers/mocks"
 	mock_usecase_user "patreon/internal/app/usecase/user/mocks"
@@ -43,6 +45,8 @@ type SuiteHandler struct {
 	Logger                 *logrus.Logger
 	MockCsrfUsecase        *mock_usecase_csrf.CsrfUsecase
 	MockSubscribersUsecase *mock_subscribers.SubscribersUsecase
+	MockPaymentsUsecase    *mock_usecase_payments.PaymentsUsecase
+	MockPayTokenUsecase    *mock_usecase_pay_token.PayTokenUsecase
 }
 
 func (s *SuiteHandler) SetupSuite() {
@@ -58,6 +62,8 @@ func (s *SuiteHandler) SetupSuite() {
 	s.MockPostsUsecase = mock_usecase_posts.NewPostsUsecase(s.Mock)
 	s.MockAttachesUsecase = mock_usecase_attaches.NewAttachesUsecase(s.Mock)
 	s.MockInfoUsecase = mock_usecase_info.NewInfoUsecase(s.Mock)
+	s.MockPaymentsUsecase = mock_usecase_payments.NewPaymentsUsecase(s.Mock)
+	s.MockPayTokenUsecase = mock_usecase_pay_token.NewPayTokenUsecase(s.Mock)
 
 	s.Tb = TestTable{}
 	s.Logger = logrus.New()
@@ -0,0 +1,25 @@
+package payouts_handler
+
+import (
+	"net/http"
+	"patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/repository"
+	repository_ledger "patreon/internal/app/repository/ledger"
+	usecase_ledger "patreon/internal/app/usecase/ledger"
+
+	"github.com/sirupsen/logrus"
+)
+
+var codesByErrorsPUT = base_handler.CodeMap{
+	usecase_ledger.NotPayoutAdmin: {
+		http.StatusForbidden, handler_errors.NotPayoutAdmin, logrus.WarnLevel},
+	usecase_ledger.InvalidPayoutTransition: {
+		http.StatusConflict, handler_errors.InvalidPayoutTransition, logrus.WarnLevel},
+	repository_ledger.PayoutStateChanged: {
+		http.StatusConflict, handler_errors.PayoutStateChanged, logrus.WarnLevel},
+	repository.NotFound: {
+		http.StatusNotFound, handler_errors.PayoutNotFound, logrus.WarnLevel},
+	repository.DefaultErrDB: {
+		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
+}
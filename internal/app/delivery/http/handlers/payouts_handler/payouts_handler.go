@@ -0,0 +1,89 @@
+package payouts_handler
+
+import (
+	"net/http"
+	csrf_middleware "patreon/internal/app/csrf/middleware"
+	repository_jwt "patreon/internal/app/csrf/repository/jwt"
+	usecase_csrf "patreon/internal/app/csrf/usecase"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/delivery/http/models"
+	usecase_ledger "patreon/internal/app/usecase/ledger"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/gorilla/mux"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/sirupsen/logrus"
+)
+
+type PayoutIdHandler struct {
+	ledgerUsecase usecase_ledger.Usecase
+	bh.BaseHandler
+}
+
+func NewPayoutIdHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucLedger usecase_ledger.Usecase) *PayoutIdHandler {
+	h := &PayoutIdHandler{
+		ledgerUsecase: ucLedger,
+		BaseHandler:   *bh.NewBaseHandler(log),
+	}
+	h.AddMethod(http.MethodPut, h.PUT,
+		session_middleware.NewSessionMiddleware(sClient, log).CheckFunc,
+		csrf_middleware.NewCsrfMiddleware(log, usecase_csrf.NewCsrfUsecase(repository_jwt.NewJwtRepository())).CheckCsrfTokenFunc,
+	)
+	return h
+}
+
+// PUT ChangePayoutState
+// @Summary change payout state
+// @tags payouts
+// @Description move payout to state, allowed only for payout admins from config.
+// @Description Pending payout can be approved or rejected, approved - paid or rejected.
+// @Description Amount of rejected payout returns to creator balance
+// @Accept json
+// @Produce json
+// @Param payout_id path int true "payout_id"
+// @Param state body http_models.RequestPayoutState true "Request body"
+// @Success 200 {object} http_models.ResponsePayout "Success"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters"
+// @Failure 404 {object} http_models.ErrResponse "payout with this id not found"
+// @Failure 409 {object} http_models.ErrResponse "payout can not move to this state from current one", "payout state was changed by other request, try again"
+// @Failure 422 {object} http_models.ErrResponse "invalid body in request"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 403 {object} http_models.ErrResponse "csrf token is invalid, get new token", "user is not allowed to manage payouts"
+// @Failure 401 "user are not authorized"
+// @Router /payouts/{:payout_id} [PUT]
+func (h *PayoutIdHandler) PUT(w http.ResponseWriter, r *http.Request) {
+	req := &http_models.RequestPayoutState{}
+
+	err := h.GetRequestBody(w, r, req, *bluemonday.UGCPolicy())
+	if err != nil || req.Validate() != nil {
+		h.Log(r).Warnf("can not parse request %s", err)
+		h.Error(w, r, http.StatusUnprocessableEntity, handler_errors.InvalidBody)
+		return
+	}
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		h.Log(r).Error("can not get user_id from context")
+		h.Error(w, r, http.StatusInternalServerError, handler_errors.InternalError)
+		return
+	}
+	payoutID, ok := h.GetInt64FromParam(w, r, "payout_id")
+	if !ok {
+		return
+	}
+	if len(mux.Vars(r)) > 1 {
+		h.Log(r).Warnf("Too many parametres %v", mux.Vars(r))
+		h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidParameters)
+		return
+	}
+
+	payout, err := h.ledgerUsecase.ChangePayoutState(userID.(int64), payoutID, req.State)
+	if err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsPUT)
+		return
+	}
+	h.Log(r).Infof("payout %d moved to %s by user %d", payout.ID, payout.State, userID.(int64))
+	h.Respond(w, r, http.StatusOK, http_models.ResponsePayout{Payout: *payout})
+}
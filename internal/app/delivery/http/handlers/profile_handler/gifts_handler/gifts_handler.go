@@ -0,0 +1,61 @@
+package gifts_handler
+
+import (
+	"net/http"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/delivery/http/models"
+	usecase_gifts "patreon/internal/app/usecase/gifts"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/sirupsen/logrus"
+)
+
+type GiftsHandler struct {
+	giftsUsecase usecase_gifts.Usecase
+	bh.BaseHandler
+}
+
+func NewGiftsHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucGifts usecase_gifts.Usecase) *GiftsHandler {
+	h := &GiftsHandler{
+		giftsUsecase: ucGifts,
+		BaseHandler:  *bh.NewBaseHandler(log),
+	}
+	h.AddMethod(http.MethodGet, h.GET, session_middleware.NewSessionMiddleware(sClient, log).CheckFunc)
+	return h
+}
+
+// GET UserGifts
+// @Summary get user gifts
+// @tags gifts
+// @Description get gifts bought by user and gifts redeemed by user, last bought first.
+// @Description Gift code is returned only to payer
+// @Produce json
+// @Success 200 {object} http_models.ResponseGifts "Success"
+// @Failure 204 {object} http_models.OkResponse "user gifts not found"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 401 "user are not authorized"
+// @Router /user/gifts [GET]
+func (h *GiftsHandler) GET(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		h.Log(r).Error("can not get user_id from context")
+		h.Error(w, r, http.StatusInternalServerError, handler_errors.InternalError)
+		return
+	}
+
+	gifts, err := h.giftsUsecase.GetUserGifts(userID.(int64))
+	if err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsGET)
+		return
+	}
+	if len(gifts) == 0 {
+		h.Respond(w, r, http.StatusNoContent, http_models.OkResponse{
+			Ok: handler_errors.GiftsNotFound.Error(),
+		})
+		return
+	}
+	h.Respond(w, r, http.StatusOK, http_models.ResponseGifts{Gifts: gifts})
+}
@@ -0,0 +1,30 @@
+package redeem_handler
+
+import (
+	"net/http"
+	"patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/repository"
+	repository_gifts "patreon/internal/app/repository/gifts"
+	repository_subscribers "patreon/internal/app/repository/subscribers"
+	usecase_gifts "patreon/internal/app/usecase/gifts"
+
+	"github.com/sirupsen/logrus"
+)
+
+var codesByErrorsPOST = base_handler.CodeMap{
+	usecase_gifts.GiftNotFound: {
+		http.StatusNotFound, handler_errors.GiftNotFound, logrus.WarnLevel},
+	usecase_gifts.UserBlocked: {
+		http.StatusForbidden, handler_errors.UserBlocked, logrus.InfoLevel},
+	usecase_gifts.GiftToSelf: {
+		http.StatusUnprocessableEntity, handler_errors.GiftToSelf, logrus.WarnLevel},
+	usecase_gifts.RecipientSubscribed: {
+		http.StatusConflict, handler_errors.GiftRecipientSubscribed, logrus.WarnLevel},
+	repository_subscribers.AwardSoldOut: {
+		http.StatusConflict, handler_errors.AwardSoldOut, logrus.InfoLevel},
+	repository_gifts.GiftNotRedeemable: {
+		http.StatusConflict, handler_errors.GiftNotRedeemable, logrus.WarnLevel},
+	repository.DefaultErrDB: {
+		http.StatusInternalServerError, handler_errors.BDError, logrus.ErrorLevel},
+}
@@ -0,0 +1,75 @@
+package redeem_handler
+
+import (
+	"net/http"
+	csrf_middleware "patreon/internal/app/csrf/middleware"
+	repository_jwt "patreon/internal/app/csrf/repository/jwt"
+	usecase_csrf "patreon/internal/app/csrf/usecase"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/delivery/http/models"
+	usecase_gifts "patreon/internal/app/usecase/gifts"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/sirupsen/logrus"
+)
+
+type RedeemHandler struct {
+	giftsUsecase usecase_gifts.Usecase
+	bh.BaseHandler
+}
+
+func NewRedeemHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucGifts usecase_gifts.Usecase) *RedeemHandler {
+	h := &RedeemHandler{
+		giftsUsecase: ucGifts,
+		BaseHandler:  *bh.NewBaseHandler(log),
+	}
+	h.AddMethod(http.MethodPost, h.POST, session_middleware.NewSessionMiddleware(sClient, log).CheckFunc,
+		csrf_middleware.NewCsrfMiddleware(log, usecase_csrf.NewCsrfUsecase(repository_jwt.NewJwtRepository())).CheckCsrfTokenFunc,
+	)
+	return h
+}
+
+// POST RedeemGift
+// @Summary redeem gift by code
+// @tags gifts
+// @Description redeem paid gift, user is subscribed on gift award for gift periods months.
+// @Description Subscription on the same award is prolonged. Code is case insensitive
+// @Accept json
+// @Produce json
+// @Param gift body http_models.RequestRedeemGift true "Request body"
+// @Success 200 {object} http_models.ResponseGift "Gift redeemed"
+// @Failure 404 {object} http_models.ErrResponse "gift with this code not found"
+// @Failure 409 {object} http_models.ErrResponse "gift is not paid or already redeemed", "recipient is already subscribed on other award of this creator", "all seats of award are taken, join its waitlist"
+// @Failure 422 {object} http_models.ErrResponse "invalid body in request", "gift can not be bought for yourself"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 403 {object} http_models.ErrResponse "csrf token is invalid, get new token", "user is blocked by creator"
+// @Failure 401 "user are not authorized"
+// @Router /user/gifts/redeem [POST]
+func (h *RedeemHandler) POST(w http.ResponseWriter, r *http.Request) {
+	req := &http_models.RequestRedeemGift{}
+
+	err := h.GetRequestBody(w, r, req, *bluemonday.UGCPolicy())
+	if err != nil || req.Validate() != nil {
+		h.Log(r).Warnf("can not parse request %s", err)
+		h.Error(w, r, http.StatusUnprocessableEntity, handler_errors.InvalidBody)
+		return
+	}
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		h.Log(r).Error("can not get user_id from context")
+		h.Error(w, r, http.StatusInternalServerError, handler_errors.InternalError)
+		return
+	}
+
+	gift, err := h.giftsUsecase.Redeem(h.Log(r), req.Code, userID.(int64))
+	if err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsPOST)
+		return
+	}
+	h.Log(r).Debugf("gift %d redeemed by user %d", gift.ID, gift.RecipientID)
+	h.Respond(w, r, http.StatusOK, http_models.ResponseGift{Gift: *gift})
+}
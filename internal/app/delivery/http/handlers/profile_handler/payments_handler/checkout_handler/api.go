@@ -0,0 +1,32 @@
+package checkout_handler
+
+import (
+	"net/http"
+	"patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/payment_provider"
+	"patreon/internal/app/repository"
+	repository_payments "patreon/internal/app/repository/payments"
+	"patreon/internal/app/usecase/payments"
+
+	"github.com/sirupsen/logrus"
+)
+
+var codeByErrorGET = base_handler.CodeMap{
+	repository.NotFound: {
+		http.StatusNotFound, handler_errors.PayTokenNotFound, logrus.WarnLevel},
+	payments.PaymentNotBelongUser: {
+		http.StatusForbidden, handler_errors.InvalidUserPayToken, logrus.WarnLevel},
+	payments.PaymentAlreadyPaid: {
+		http.StatusConflict, handler_errors.PaymentAlreadyPaid, logrus.WarnLevel},
+	payments.InvalidStateTransition: {
+		http.StatusConflict, handler_errors.InvalidPaymentState, logrus.WarnLevel},
+	repository_payments.PaymentStateChanged: {
+		http.StatusConflict, handler_errors.InvalidPaymentState, logrus.WarnLevel},
+	payment_provider.InvalidCheckout: {
+		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
+	payment_provider.UnsupportedCurrency: {
+		http.StatusUnprocessableEntity, handler_errors.UnsupportedPaymentCurrency, logrus.WarnLevel},
+	repository.DefaultErrDB: {
+		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
+}
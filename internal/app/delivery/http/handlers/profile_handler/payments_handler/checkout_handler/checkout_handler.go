@@ -0,0 +1,71 @@
+package checkout_handler
+
+import (
+	"net/http"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/delivery/http/models"
+	"patreon/internal/app/usecase/payments"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/sirupsen/logrus"
+)
+
+type CheckoutHandler struct {
+	sessionClient   session_client.AuthCheckerClient
+	paymentsUsecase payments.Usecase
+	bh.BaseHandler
+}
+
+func NewCheckoutHandler(log *logrus.Logger,
+	sClient session_client.AuthCheckerClient, ucPayments payments.Usecase) *CheckoutHandler {
+	h := &CheckoutHandler{
+		sessionClient:   sClient,
+		paymentsUsecase: ucPayments,
+		BaseHandler:     *bh.NewBaseHandler(log),
+	}
+	h.AddMethod(http.MethodGet, h.GET,
+		session_middleware.NewSessionMiddleware(h.sessionClient, log).CheckFunc,
+	)
+	return h
+}
+
+// GET Checkout
+// @Summary get checkout url of payment provider
+// @tags payments
+// @Description get url where user can pay for payment with pay token
+// @Produce json
+// @Param token query string true "pay token of payment"
+// @Success 200 {object} http_models.ResponseCheckout "Success"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters"
+// @Failure 403 {object} http_models.ErrResponse "this user was not given this token"
+// @Failure 404 {object} http_models.ErrResponse "pay token not found"
+// @Failure 409 {object} http_models.ErrResponse "payment already paid", "payment can not be paid in current state"
+// @Failure 422 {object} http_models.ErrResponse "payment provider does not accept currency of payment"
+// @Failure 500 {object} http_models.ErrResponse "server error"
+// @Failure 401 "user are not authorized"
+// @Router /user/payments/checkout [GET]
+func (h *CheckoutHandler) GET(w http.ResponseWriter, r *http.Request) {
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		h.Log(r).Warn("checkout_handler: empty token in query")
+		h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidParameters)
+		return
+	}
+
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		h.Log(r).Error("can not get user_id from context")
+		h.Error(w, r, http.StatusInternalServerError, handler_errors.InternalError)
+		return
+	}
+
+	checkout, err := h.paymentsUsecase.CreateCheckout(userID.(int64), token)
+	if err != nil {
+		h.UsecaseError(w, r, err, codeByErrorGET)
+		return
+	}
+
+	h.Respond(w, r, http.StatusOK, http_models.ResponseCheckout{Provider: checkout.Provider, Url: checkout.Url})
+}
@@ -0,0 +1,15 @@
+package checkouts_handler
+
+import (
+	"net/http"
+	"patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	repository_redis "patreon/internal/app/repository/pay_token/redis"
+
+	"github.com/sirupsen/logrus"
+)
+
+var codeByErrorGET = base_handler.CodeMap{
+	repository_redis.InvalidStorageData: {
+		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
+}
@@ -0,0 +1,59 @@
+package checkouts_handler
+
+import (
+	"net/http"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/delivery/http/models"
+	usecase_pay_token "patreon/internal/app/usecase/pay_token"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/sirupsen/logrus"
+)
+
+type CheckoutsHandler struct {
+	sessionClient session_client.AuthCheckerClient
+	tokenUsecase  usecase_pay_token.Usecase
+	bh.BaseHandler
+}
+
+func NewCheckoutsHandler(log *logrus.Logger,
+	sClient session_client.AuthCheckerClient, ucPayToken usecase_pay_token.Usecase) *CheckoutsHandler {
+	h := &CheckoutsHandler{
+		sessionClient: sClient,
+		tokenUsecase:  ucPayToken,
+		BaseHandler:   *bh.NewBaseHandler(log),
+	}
+	h.AddMethod(http.MethodGet, h.GET,
+		session_middleware.NewSessionMiddleware(h.sessionClient, log).CheckFunc,
+	)
+	return h
+}
+
+// GET OutstandingCheckouts
+// @Summary get outstanding checkouts of user
+// @tags payments
+// @Description get not expired and not paid pay tokens of user with award and price fixed in them,
+// @Description used = true means that subscription was created and waits for payment
+// @Produce json
+// @Success 200 {object} http_models.ResponseCheckouts "Success"
+// @Failure 500 {object} http_models.ErrResponse "server error"
+// @Failure 401 "user are not authorized"
+// @Router /user/payments/checkouts [GET]
+func (h *CheckoutsHandler) GET(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		h.Log(r).Error("can not get user_id from context")
+		h.Error(w, r, http.StatusInternalServerError, handler_errors.InternalError)
+		return
+	}
+
+	checkouts, err := h.tokenUsecase.GetOutstandingTokens(userID.(int64))
+	if err != nil {
+		h.UsecaseError(w, r, err, codeByErrorGET)
+		return
+	}
+
+	h.Respond(w, r, http.StatusOK, http_models.ResponseCheckouts{Checkouts: checkouts})
+}
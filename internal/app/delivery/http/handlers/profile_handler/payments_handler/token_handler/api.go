@@ -4,14 +4,29 @@ import (
 	"net/http"
 	"patreon/internal/app/delivery/http/handlers/base_handler"
 	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/payment_provider"
 	"patreon/internal/app/repository"
 	repository_redis "patreon/internal/app/repository/pay_token/redis"
 	repository_payments "patreon/internal/app/repository/payments"
+	usecase_pay_token "patreon/internal/app/usecase/pay_token"
+	"patreon/internal/app/usecase/payments"
 
 	"github.com/sirupsen/logrus"
 )
 
 var codeByErrorGET = base_handler.CodeMap{
+	usecase_pay_token.AwardNotBelongCreator: {
+		http.StatusBadRequest, handler_errors.AwardNotBelongCreator, logrus.WarnLevel},
+	repository.NotFound: {
+		http.StatusNotFound, handler_errors.AwardNotFound, logrus.WarnLevel},
+	usecase_pay_token.PromoCodeNotFound: {
+		http.StatusNotFound, handler_errors.PromoCodeNotFound, logrus.WarnLevel},
+	usecase_pay_token.PromoCodeNotActive: {
+		http.StatusUnprocessableEntity, handler_errors.PromoCodeNotActive, logrus.WarnLevel},
+	usecase_pay_token.PromoCodeNotForAward: {
+		http.StatusUnprocessableEntity, handler_errors.PromoCodeNotForAward, logrus.WarnLevel},
+	usecase_pay_token.PromoCodeAlreadyUsed: {
+		http.StatusConflict, handler_errors.PromoCodeAlreadyUsed, logrus.WarnLevel},
 	repository_redis.SetError: {
 		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
 	repository.DefaultErrDB: {
@@ -19,14 +34,26 @@ var codeByErrorGET = base_handler.CodeMap{
 }
 
 var codeByErrorPOST = base_handler.CodeMap{
+	payment_provider.InvalidNotification: {
+		http.StatusBadRequest, handler_errors.InvalidBody, logrus.WarnLevel},
+	payment_provider.InvalidNotificationHash: {
+		http.StatusForbidden, handler_errors.InvalidNotificationHash, logrus.WarnLevel},
+	payment_provider.ProtectedPaymentNotSupported: {
+		http.StatusUnprocessableEntity, handler_errors.ProtectedPaymentNotSupported, logrus.WarnLevel},
+	payment_provider.UnacceptedPayment: {
+		http.StatusUnprocessableEntity, handler_errors.UnacceptedPayment, logrus.WarnLevel},
 	repository_payments.NotEqualPaymentAmount: {
 		http.StatusBadRequest, handler_errors.NotEqualPaymentAmount, logrus.ErrorLevel},
+	repository_payments.NotEqualPaymentCurrency: {
+		http.StatusBadRequest, handler_errors.NotEqualPaymentCurrency, logrus.ErrorLevel},
+	payments.InvalidStateTransition: {
+		http.StatusConflict, handler_errors.InvalidPaymentState, logrus.WarnLevel},
+	repository_payments.PaymentStateChanged: {
+		http.StatusConflict, handler_errors.InvalidPaymentState, logrus.WarnLevel},
+	repository.NotFound: {
+		http.StatusNotFound, handler_errors.PayTokenNotFound, logrus.WarnLevel},
 	repository_payments.CountPaymentsByTokenError: {
 		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
-	repository_redis.InvalidStorageData: {
-		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
 	repository.DefaultErrDB: {
 		http.StatusInternalServerError, handler_errors.InternalError, logrus.ErrorLevel},
-	repository_redis.NotFound: {
-		http.StatusNotFound, handler_errors.PayTokenNotFound, logrus.ErrorLevel},
 }
@@ -10,7 +10,6 @@ import (
 	"patreon/internal/app/usecase/payments"
 	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
 	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
-	"strconv"
 
 	"github.com/sirupsen/logrus"
 )
@@ -40,20 +39,43 @@ func NewTokenHandler(log *logrus.Logger,
 // GET PayToken
 // @Summary get token for payments
 // @tags payments
-// @Description get token for payments
+// @Description get token for payment of award, award price is fixed in token at this moment
 // @Produce json
+// @Param creator_id query int64 true "creator of award"
+// @Param award_id query int64 true "award to subscribe"
+// @Param promo_code query string false "promo code of creator, discount is fixed in token too"
 // @Success 200 {object} http_models.ResponsePayToken "Success"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters", "award not belongs to creator"
+// @Failure 404 {object} http_models.ErrResponse "award with this id not found", "promo code with this code not found"
+// @Failure 409 {object} http_models.ErrResponse "user already used this promo code"
+// @Failure 422 {object} http_models.ErrResponse "promo code is expired, deactivated or exhausted", "promo code can not be applied to this award"
 // @Failure 500 {object} http_models.ErrResponse "server error"
 // @Failure 401 "user are not authorized"
 // @Router /user/payments/token [GET]
 func (h *TokenHandler) GET(w http.ResponseWriter, r *http.Request) {
+	creatorID, ok := h.GetInt64FromQueries(w, r, "creator_id")
+	if !ok {
+		if creatorID == bh.EmptyQuery {
+			h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidQueries)
+		}
+		return
+	}
+	awardID, ok := h.GetInt64FromQueries(w, r, "award_id")
+	if !ok {
+		if awardID == bh.EmptyQuery {
+			h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidQueries)
+		}
+		return
+	}
+
 	userID := r.Context().Value("user_id")
 	if userID == nil {
 		h.Log(r).Error("can not get user_id from context")
 		h.Error(w, r, http.StatusInternalServerError, handler_errors.InternalError)
 		return
 	}
-	payToken, err := h.tokenUsecase.GetToken(userID.(int64))
+	promoCode := r.URL.Query().Get("promo_code")
+	payToken, err := h.tokenUsecase.GetToken(userID.(int64), creatorID, awardID, promoCode)
 	if err != nil {
 		h.UsecaseError(w, r, err, codeByErrorGET)
 		return
@@ -61,6 +83,22 @@ func (h *TokenHandler) GET(w http.ResponseWriter, r *http.Request) {
 
 	h.Respond(w, r, http.StatusOK, http_models.PayTokenResponse{Token: payToken.Token})
 }
+
+// POST PaymentNotification
+// @Summary apply payment by payment provider notification
+// @tags payments
+// @Description check notification by payment provider and mark payment with token from notification as paid.
+// @Description Repeated notification with already processed operation_id is ignored.
+// @Accept x-www-form-urlencoded
+// @Success 200 "Success or notification already processed"
+// @Failure 400 {object} http_models.ErrResponse "invalid body in request", "payment amount from request not equal amount from database", "payment currency from request not equal currency from database"
+// @Failure 403 {object} http_models.ErrResponse "notification sha1_hash is invalid"
+// @Failure 404 {object} http_models.ErrResponse "pay token not found"
+// @Failure 409 {object} http_models.ErrResponse "payment can not be paid in current state"
+// @Failure 415 "invalid content type"
+// @Failure 422 {object} http_models.ErrResponse "payments protected by code are not supported", "payment was not accepted by receiver"
+// @Failure 500 {object} http_models.ErrResponse "server error"
+// @Router /user/payments/token [POST]
 func (h *TokenHandler) POST(w http.ResponseWriter, r *http.Request) {
 	headerContentType := r.Header.Get("Content-Type")
 	if headerContentType != "application/x-www-form-urlencoded" {
@@ -73,26 +111,34 @@ func (h *TokenHandler) POST(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	h.Log(r).Infof("POST_FORM = %v", r.PostForm)
-	payToken := r.PostForm["label"][0]
-	amount := r.PostForm["withdraw_amount"][0]
 
-	amountFloat, err := strconv.ParseFloat(amount, 64)
+	notification, err := h.paymentsUsecase.ParseNotification(r.PostForm)
 	if err != nil {
-		h.Log(r).Error("token_handler: can not convert string to float - amount field, ", amount)
-		h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidBody)
+		h.UsecaseError(w, r, err, codeByErrorPOST)
 		return
 	}
 
-	exists, err := h.tokenUsecase.CheckToken(models.PayToken{Token: payToken})
-	if !exists {
-		h.Log(r).Errorf("token_handler: error check token err = %v", err)
-		h.UsecaseError(w, r, err, codeByErrorPOST)
+	// payment with snapshot of amount and currency is checked in database,
+	// so notification which came after pay token expired is still applied
+	err = h.paymentsUsecase.UpdateStatus(h.Log(r), notification)
+	if err == payments.NotificationAlreadyProcessed {
+		h.Log(r).Infof("token_handler: notification with operation_id %s already processed",
+			notification.OperationID)
+		w.WriteHeader(http.StatusOK)
 		return
 	}
-	if err = h.paymentsUsecase.UpdateStatus(h.Log(r), payToken, amountFloat); err != nil {
+	if err != nil {
 		h.Log(r).Errorf("token_handler: error update payment status = %v", err)
 		h.UsecaseError(w, r, err, codeByErrorPOST)
 		return
 	}
+
+	payToken, err := h.tokenUsecase.CheckToken(models.PayToken{Token: notification.Token}, notification.Amount,
+		notification.Currency)
+	if err != nil {
+		h.Log(r).Warnf("token_handler: paid token is not closed, error check token err = %v", err)
+	} else if err = h.tokenUsecase.CloseToken(payToken); err != nil {
+		h.Log(r).Errorf("token_handler: error close paid token err = %v", err)
+	}
 	w.WriteHeader(http.StatusOK)
 }
@@ -0,0 +1,115 @@
+package payments_handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"patreon/internal/app/delivery/http/handlers"
+	"patreon/internal/app/models"
+	repository_redis "patreon/internal/app/repository/pay_token/redis"
+	"patreon/internal/app/usecase/payments"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TokenTestSuite struct {
+	handlers.SuiteHandler
+	handler *TokenHandler
+}
+
+func (s *TokenTestSuite) SetupSuite() {
+	s.SuiteHandler.SetupSuite()
+	s.handler = NewTokenHandler(s.Logger, s.MockSessionsManager, s.MockPayTokenUsecase, s.MockPaymentsUsecase)
+}
+
+func (s *TokenTestSuite) notificationRequest(notification *models.PaymentNotification) *http.Request {
+	form := url.Values{"label": {notification.Token}, "operation_id": {notification.OperationID}}
+	reader, _ := http.NewRequest(http.MethodPost, "/user/payments/token", strings.NewReader(form.Encode()))
+	reader.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return reader
+}
+
+func (s *TokenTestSuite) TestTokenHandler_POST_OK() {
+	notification := models.TestPaymentNotification()
+	info := &models.PayTokenInfo{Token: notification.Token, UserID: 1}
+	recorder := httptest.NewRecorder()
+
+	s.MockPaymentsUsecase.EXPECT().
+		ParseNotification(gomock.Any()).
+		Times(1).
+		Return(notification, nil)
+	s.MockPaymentsUsecase.EXPECT().
+		UpdateStatus(gomock.Any(), notification).
+		Times(1).
+		Return(nil)
+	s.MockPayTokenUsecase.EXPECT().
+		CheckToken(models.PayToken{Token: notification.Token}, notification.Amount, notification.Currency).
+		Times(1).
+		Return(info, nil)
+	s.MockPayTokenUsecase.EXPECT().
+		CloseToken(info).
+		Times(1).
+		Return(nil)
+	s.handler.POST(recorder, s.notificationRequest(notification))
+	assert.Equal(s.T(), http.StatusOK, recorder.Code)
+}
+
+func (s *TokenTestSuite) TestTokenHandler_POST_TokenExpired() {
+	notification := models.TestPaymentNotification()
+	recorder := httptest.NewRecorder()
+
+	s.MockPaymentsUsecase.EXPECT().
+		ParseNotification(gomock.Any()).
+		Times(1).
+		Return(notification, nil)
+	s.MockPaymentsUsecase.EXPECT().
+		UpdateStatus(gomock.Any(), notification).
+		Times(1).
+		Return(nil)
+	s.MockPayTokenUsecase.EXPECT().
+		CheckToken(models.PayToken{Token: notification.Token}, notification.Amount, notification.Currency).
+		Times(1).
+		Return(nil, repository_redis.NotFound)
+	s.handler.POST(recorder, s.notificationRequest(notification))
+	assert.Equal(s.T(), http.StatusOK, recorder.Code)
+}
+
+func (s *TokenTestSuite) TestTokenHandler_POST_AlreadyProcessed() {
+	notification := models.TestPaymentNotification()
+	recorder := httptest.NewRecorder()
+
+	s.MockPaymentsUsecase.EXPECT().
+		ParseNotification(gomock.Any()).
+		Times(1).
+		Return(notification, nil)
+	s.MockPaymentsUsecase.EXPECT().
+		UpdateStatus(gomock.Any(), notification).
+		Times(1).
+		Return(payments.NotificationAlreadyProcessed)
+	s.handler.POST(recorder, s.notificationRequest(notification))
+	assert.Equal(s.T(), http.StatusOK, recorder.Code)
+}
+
+func (s *TokenTestSuite) TestTokenHandler_POST_InvalidState() {
+	notification := models.TestPaymentNotification()
+	recorder := httptest.NewRecorder()
+
+	s.MockPaymentsUsecase.EXPECT().
+		ParseNotification(gomock.Any()).
+		Times(1).
+		Return(notification, nil)
+	s.MockPaymentsUsecase.EXPECT().
+		UpdateStatus(gomock.Any(), notification).
+		Times(1).
+		Return(payments.InvalidStateTransition)
+	s.handler.POST(recorder, s.notificationRequest(notification))
+	assert.Equal(s.T(), http.StatusConflict, recorder.Code)
+}
+
+func TestTokenHandler(t *testing.T) {
+	suite.Run(t, new(TokenTestSuite))
+}
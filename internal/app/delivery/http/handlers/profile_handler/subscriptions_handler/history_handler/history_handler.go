@@ -0,0 +1,76 @@
+package subscriptions_history_handler
+
+import (
+	"net/http"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/delivery/http/models"
+	db_models "patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	usecase_subscribers "patreon/internal/app/usecase/subscribers"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/sirupsen/logrus"
+)
+
+type SubscriptionsHistoryHandler struct {
+	sessionClient     session_client.AuthCheckerClient
+	subscriberUsecase usecase_subscribers.Usecase
+	bh.BaseHandler
+}
+
+func NewSubscriptionsHistoryHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucSubscribers usecase_subscribers.Usecase) *SubscriptionsHistoryHandler {
+	h := &SubscriptionsHistoryHandler{
+		BaseHandler:       *bh.NewBaseHandler(log),
+		subscriberUsecase: ucSubscribers,
+		sessionClient:     sClient,
+	}
+	h.AddMethod(http.MethodGet, h.GET,
+		session_middleware.NewSessionMiddleware(h.sessionClient, log).CheckFunc,
+	)
+	return h
+}
+
+// GET SubscriptionsHistory
+// @Summary history of user subscriptions
+// @tags user
+// @Description get page of events of user subscriptions on all creators, the last first.
+// @Description Events stay in history after subscription is cancelled or expired
+// @Produce json
+// @Param page query uint64 true "start page number of events mutually exclusive with offset"
+// @Param offset query uint64 true "start number of events mutually exclusive with page"
+// @Param limit query uint64 true "events to return"
+// @Success 200 {object} http_models.ResponseSubscriptionEvents "Successfully get history of subscriptions"
+// @Failure 204 {object} http_models.OkResponse "subscription events not found"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters in query"
+// @Failure 500 {object} http_models.ErrResponse "server error", "can not do bd operation"
+// @Failure 401 "user are not authorized"
+// @Router /user/subscriptions/history [GET]
+func (h *SubscriptionsHistoryHandler) GET(w http.ResponseWriter, r *http.Request) {
+	limit, offset, ok := h.GetPaginationFromQuery(w, r)
+	if !ok {
+		return
+	}
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		h.Log(r).Error("can not get user_id from context")
+		h.Error(w, r, http.StatusInternalServerError, handler_errors.InternalError)
+		return
+	}
+
+	events, err := h.subscriberUsecase.GetUserEvents(userID.(int64), &db_models.Pagination{Limit: limit, Offset: offset})
+	if err != nil {
+		if err == repository.NotFound {
+			h.Respond(w, r, http.StatusNoContent, http_models.OkResponse{
+				Ok: handler_errors.SubscrEventsNotFound.Error(),
+			})
+		} else {
+			h.UsecaseError(w, r, err, codesByErrorsGET)
+		}
+		return
+	}
+	h.Log(r).Debugf("get %d subscription events of user %d", len(events), userID)
+	h.Respond(w, r, http.StatusOK, http_models.ResponseSubscriptionEvents{Events: events})
+}
@@ -4,12 +4,15 @@ import (
 	"net/http"
 	"patreon/internal/app/delivery/http/handlers/base_handler"
 	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/models"
 	"patreon/internal/app/repository"
 
 	"github.com/sirupsen/logrus"
 )
 
 var codesByErrors = base_handler.CodeMap{
+	models.IncorrectTag: {
+		http.StatusBadRequest, handler_errors.IncorrectTag, logrus.InfoLevel},
 	repository.NotFound: {
 		http.StatusNotFound, handler_errors.UserNotFound, logrus.WarnLevel},
 	repository.DefaultErrDB: {
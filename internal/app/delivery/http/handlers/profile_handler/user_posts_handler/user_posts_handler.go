@@ -39,10 +39,11 @@ func NewPostsHandler(log *logrus.Logger, sClient session_client.AuthCheckerClien
 // @Param page query uint64 true "start page number of posts mutually exclusive with offset"
 // @Param offset query uint64 true "start number of posts mutually exclusive with page"
 // @Param limit query uint64 true "posts to return"
+// @Param tag query string false "return only posts marked by this tag"
 // @Success 200 {object} http_models.ResponseAvailablePosts "Successfully get user available posts"
 // @Success 204  "No available posts"
 // @Failure 500 {object} http_models.ErrResponse "serverError"
-// @Failure 400 {object} http_models.ErrResponse "invalid parameters", "invalid parameters in query"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters", "invalid parameters in query", "tag must be from 1 to 32 symbols without commas"
 // @Failure 401 "user are not authorized"
 // @Router /user/posts [GET]
 func (h *PostsHandler) GET(w http.ResponseWriter, r *http.Request) {
@@ -59,7 +60,7 @@ func (h *PostsHandler) GET(w http.ResponseWriter, r *http.Request) {
 	posts, err := h.postsUsecase.GetAvailablePosts(userID.(int64), &app_models.Pagination{
 		Limit:  limit,
 		Offset: offset,
-	})
+	}, r.URL.Query().Get("tag"))
 
 	if err != nil {
 		h.UsecaseError(w, r, err, codesByErrors)
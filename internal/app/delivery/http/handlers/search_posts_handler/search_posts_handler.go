@@ -0,0 +1,83 @@
+package search_posts_handler
+
+import (
+	"net/http"
+	bh "patreon/internal/app/delivery/http/handlers/base_handler"
+	"patreon/internal/app/delivery/http/handlers/handler_errors"
+	"patreon/internal/app/delivery/http/models"
+	"patreon/internal/app/models"
+	usePosts "patreon/internal/app/usecase/posts"
+	session_client "patreon/internal/microservices/auth/delivery/grpc/client"
+	session_middleware "patreon/internal/microservices/auth/sessions/middleware"
+
+	"github.com/gorilla/mux"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/sirupsen/logrus"
+)
+
+// SearchPostsHandler serve both global search and search among posts of creator from path
+type SearchPostsHandler struct {
+	postsUsecase usePosts.Usecase
+	bh.BaseHandler
+}
+
+func NewSearchPostsHandler(log *logrus.Logger, sClient session_client.AuthCheckerClient,
+	ucPosts usePosts.Usecase) *SearchPostsHandler {
+	h := &SearchPostsHandler{
+		postsUsecase: ucPosts,
+		BaseHandler:  *bh.NewBaseHandler(log),
+	}
+	h.AddMethod(http.MethodGet, h.GET, session_middleware.NewSessionMiddleware(sClient, log).AddUserIdFunc)
+	return h
+}
+
+// GET SearchPosts
+// @Summary search posts
+// @tags posts
+// @Description search posts by title, description and text attaches in english and russian, the most relevant first.
+// @Description Only posts available for user are returned, drafts are found only for their creator
+// @Produce json
+// @Param creator_id path int false "creator_id, search among posts of all creators if not set"
+// @Param page query uint64 true "start page number of posts mutually exclusive with offset"
+// @Param offset query uint64 true "start number of posts mutually exclusive with page"
+// @Param limit query uint64 true "posts to return"
+// @Param search_string query string true "search query"
+// @Success 200 {object} http_models.ResponseSearchedPosts "Success"
+// @Failure 400 {object} http_models.ErrResponse "invalid parameters", "invalid parameters in query"
+// @Failure 500 {object} http_models.ErrResponse "can not do bd operation"
+// @Router /posts/search [GET]
+// @Router /creators/{:creator_id}/posts/search [GET]
+func (h *SearchPostsHandler) GET(w http.ResponseWriter, r *http.Request) {
+	limit, offset, ok := h.GetPaginationFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	creatorId := int64(usePosts.AllCreators)
+	if _, withCreator := mux.Vars(r)["creator_id"]; withCreator {
+		if creatorId, ok = h.GetInt64FromParam(w, r, "creator_id"); !ok {
+			return
+		}
+	}
+
+	searchString := bluemonday.NewPolicy().Sanitize(r.URL.Query().Get("search_string"))
+	if searchString == "" {
+		h.Error(w, r, http.StatusBadRequest, handler_errors.InvalidQueries)
+		return
+	}
+
+	userId, ok := r.Context().Value("user_id").(int64)
+	if !ok {
+		userId = usePosts.EmptyUser
+	}
+
+	posts, err := h.postsUsecase.SearchPosts(userId, creatorId, searchString,
+		&models.Pagination{Limit: limit, Offset: offset})
+	if err != nil {
+		h.UsecaseError(w, r, err, codesByErrorsGET)
+		return
+	}
+
+	h.Log(r).Debugf("found %d posts of creator %d with search query %s", len(posts), creatorId, searchString)
+	h.Respond(w, r, http.StatusOK, http_models.ResponseSearchedPosts{Posts: posts})
+}
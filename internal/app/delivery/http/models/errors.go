@@ -8,7 +8,16 @@ import (
 )
 
 var (
-	TokenValidateError    = errors.New("invalid pay_token")
-	NicknameValidateError = errors.New(fmt.Sprintf("invalid nickname in body len must be from %v to %v",
+	TokenValidateError        = errors.New("invalid pay_token")
+	AwardIDValidateError      = errors.New("invalid award_id")
+	PayoutAmountValidateError = errors.New("invalid payout amount")
+	PayoutStateValidateError  = errors.New("invalid payout state, expected approved, paid or rejected")
+	PromoCodeValidateError    = errors.New("invalid promo code, code, discount_type and discount are required")
+	GiftValidateError         = errors.New("invalid gift, periods are required")
+	GiftCodeValidateError     = errors.New("invalid gift code")
+	TipValidateError          = errors.New("invalid tip, amount is required")
+	RefundAmountValidateError = errors.New("invalid refund amount")
+	UserIDValidateError       = errors.New("invalid user_id")
+	NicknameValidateError     = errors.New(fmt.Sprintf("invalid nickname in body len must be from %v to %v",
 		models.MIN_NICKNAME_LENGTH, models.MAX_NICKNAME_LENGTH))
 )
@@ -7,6 +7,7 @@ import (
 	"patreon/internal/app/delivery/http/handlers/handler_errors"
 	"patreon/internal/app/models"
 	models_utilits "patreon/internal/app/utilits/models"
+	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation"
 )
@@ -17,6 +18,7 @@ import (
 type RequestCreator struct {
 	Category    string `json:"category"`
 	Description string `json:"description"`
+	Currency    string `json:"currency,omitempty"`
 }
 
 //easyjson:json
@@ -69,18 +71,31 @@ func NewColor(rgba color.RGBA) Color {
 
 //easyjson:json
 type RequestAwards struct {
-	Name        string `json:"name"`
-	Description string `json:"description,omitempty"`
-	Price       int64  `json:"price"`
-	Color       Color  `json:"color,omitempty"`
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Price       models.Decimal `json:"price"`
+	Color       Color          `json:"color,omitempty"`
+	TrialDays   int64          `json:"trial_days,omitempty"`
+
+	// MaxSubscribers 0 if count of subscribers is not limited
+	MaxSubscribers int64 `json:"max_subscribers,omitempty"`
 }
 
 //easyjson:json
 type RequestPosts struct {
-	Title       string `json:"title,omitempty"`
-	AwardsId    int64  `json:"awards_id,omitempty"`
-	Description string `json:"description,omitempty"`
-	IsDraft     bool   `json:"is_draft,omitempty"`
+	Title       string         `json:"title,omitempty"`
+	AwardsId    int64          `json:"awards_id,omitempty"`
+	Description string         `json:"description,omitempty"`
+	IsDraft     bool           `json:"is_draft,omitempty"`
+	UnlockPrice models.Decimal `json:"unlock_price,omitempty"`
+	// Preview excerpt shown to users without access to post
+	Preview string `json:"preview,omitempty"`
+	// PublishAt time of scheduled publication, post is kept as draft until it
+	PublishAt *time.Time `json:"publish_at,omitempty"`
+	// Tags tags of created post, AddTags and RemoveTags change tags of updated post
+	Tags       []string `json:"tags,omitempty"`
+	AddTags    []string `json:"add_tags,omitempty"`
+	RemoveTags []string `json:"remove_tags,omitempty"`
 }
 
 //easyjson:json
@@ -96,11 +111,30 @@ type RequestAttaches struct {
 	Attaches []RequestAttach `json:"attaches"`
 }
 
+// HaveStatus text and poll attaches are sent with value, so status tells if they are new or updated
+func (req *RequestAttach) HaveStatus() bool {
+	return req.Type == models.Text || req.Type == models.Poll
+}
+
 //easyjson:json
 type RequestText struct {
 	Text string `json:"text"`
 }
 
+//easyjson:json
+type RequestPoll struct {
+	Question          string     `json:"question"`
+	Options           []string   `json:"options"`
+	Multiple          bool       `json:"multiple"`
+	CloseAt           *time.Time `json:"close_at,omitempty"`
+	ResultsBeforeVote bool       `json:"results_before_vote"`
+}
+
+//easyjson:json
+type RequestPollVote struct {
+	Options []int64 `json:"options"`
+}
+
 type SubscribeRequest struct {
 	Token string `json:"pay_token"`
 }
@@ -115,6 +149,154 @@ func (req *SubscribeRequest) Validate() error {
 	return nil
 }
 
+//easyjson:json
+type RequestChangeTier struct {
+	AwardID int64 `json:"award_id"`
+}
+
+func (req *RequestChangeTier) Validate() error {
+	err := validation.Errors{
+		"award_id": validation.Validate(req.AwardID, validation.Required, validation.Min(1)),
+	}.Filter()
+	if err != nil {
+		return AwardIDValidateError
+	}
+	return nil
+}
+
+//easyjson:json
+type RequestPayout struct {
+	Amount models.Decimal `json:"amount"`
+}
+
+func (req *RequestPayout) Validate() error {
+	err := validation.Errors{
+		"amount": validation.Validate(int64(req.Amount), validation.Required, validation.Min(int64(1))),
+	}.Filter()
+	if err != nil {
+		return PayoutAmountValidateError
+	}
+	return nil
+}
+
+//easyjson:json
+type RequestPromoCode struct {
+	Code         string     `json:"code"`
+	DiscountType string     `json:"discount_type"`
+	Discount     int64      `json:"discount"`
+	AwardIDs     []int64    `json:"award_ids,omitempty"`
+	MaxUses      int64      `json:"max_uses,omitempty"`
+	ValidFrom    *time.Time `json:"valid_from,omitempty"`
+	ValidUntil   *time.Time `json:"valid_until,omitempty"`
+}
+
+func (req *RequestPromoCode) Validate() error {
+	err := validation.Errors{
+		"code":          validation.Validate(req.Code, validation.Required),
+		"discount_type": validation.Validate(req.DiscountType, validation.Required),
+		"discount":      validation.Validate(req.Discount, validation.Required),
+	}.Filter()
+	if err != nil {
+		return PromoCodeValidateError
+	}
+	return nil
+}
+
+//easyjson:json
+type RequestGift struct {
+	Periods           int64  `json:"periods"`
+	RecipientNickname string `json:"recipient_nickname,omitempty"`
+}
+
+func (req *RequestGift) Validate() error {
+	err := validation.Errors{
+		"periods": validation.Validate(req.Periods, validation.Required),
+	}.Filter()
+	if err != nil {
+		return GiftValidateError
+	}
+	return nil
+}
+
+//easyjson:json
+type RequestTip struct {
+	Amount  models.Decimal `json:"amount"`
+	Message string         `json:"message,omitempty"`
+}
+
+func (req *RequestTip) Validate() error {
+	err := validation.Errors{
+		"amount": validation.Validate(int64(req.Amount), validation.Required),
+	}.Filter()
+	if err != nil {
+		return TipValidateError
+	}
+	return nil
+}
+
+//easyjson:json
+type RequestRefund struct {
+	Amount models.Decimal `json:"amount"`
+	Reason string         `json:"reason,omitempty"`
+}
+
+func (req *RequestRefund) Validate() error {
+	err := validation.Errors{
+		"amount": validation.Validate(int64(req.Amount), validation.Required, validation.Min(int64(1))),
+	}.Filter()
+	if err != nil {
+		return RefundAmountValidateError
+	}
+	return nil
+}
+
+//easyjson:json
+type RequestBlockUser struct {
+	UserID             int64 `json:"user_id"`
+	CancelSubscription bool  `json:"cancel_subscription,omitempty"`
+}
+
+func (req *RequestBlockUser) Validate() error {
+	err := validation.Errors{
+		"user_id": validation.Validate(req.UserID, validation.Required, validation.Min(1)),
+	}.Filter()
+	if err != nil {
+		return UserIDValidateError
+	}
+	return nil
+}
+
+//easyjson:json
+type RequestRedeemGift struct {
+	Code string `json:"code"`
+}
+
+func (req *RequestRedeemGift) Validate() error {
+	err := validation.Errors{
+		"code": validation.Validate(req.Code, validation.Required),
+	}.Filter()
+	if err != nil {
+		return GiftCodeValidateError
+	}
+	return nil
+}
+
+//easyjson:json
+type RequestPayoutState struct {
+	State models.PayoutState `json:"state"`
+}
+
+func (req *RequestPayoutState) Validate() error {
+	err := validation.Errors{
+		"state": validation.Validate(string(req.State), validation.Required,
+			validation.In(string(models.PayoutApproved), string(models.PayoutPaid), string(models.PayoutRejected))),
+	}.Filter()
+	if err != nil {
+		return PayoutStateValidateError
+	}
+	return nil
+}
+
 func (req *RequestChangeNickname) Validate() error {
 	err := validation.Errors{
 		"old_nickname": validation.Validate(req.OldNickname, validation.Required,
@@ -129,9 +311,9 @@ func (req *RequestChangeNickname) Validate() error {
 }
 
 // requestAttachValidError Errors:
-//		handler_errors.IncorrectType
-//		handler_errors.IncorrectIdAttach
-//      handler_errors.IncorrectStatus
+//				handler_errors.IncorrectType
+//				handler_errors.IncorrectIdAttach
+//		     handler_errors.IncorrectStatus
 func requestAttachValidError() models_utilits.ExtractorErrorByName {
 	validMap := models_utilits.MapOfValidateError{
 		"type":   handler_errors.IncorrectType,
@@ -147,14 +329,15 @@ func requestAttachValidError() models_utilits.ExtractorErrorByName {
 }
 
 // Validate Errors:
-//		handler_errors.IncorrectType
-//		handler_errors.IncorrectIdAttach
-//      handler_errors.IncorrectStatus
+//				handler_errors.IncorrectType
+//				handler_errors.IncorrectIdAttach
+//		     handler_errors.IncorrectStatus
+//
 // can return not specify error
 func (req *RequestAttach) Validate() error {
 	err := validation.Errors{
 		"type": validation.Validate(req.Type, validation.In(models.Music, models.Video,
-			models.Files, models.Text, models.Image)),
+			models.Files, models.Text, models.Image, models.Poll)),
 		"id":     validation.Validate(req.Id, validation.Min(1)),
 		"status": validation.Validate(req.Status, validation.In(handlers.AddStatus, handlers.UpdateStatus)),
 	}.Filter()
@@ -170,13 +353,13 @@ func (req *RequestAttach) Validate() error {
 	_, haveIdError := mapOfErr["id"]
 	_, haveStatusError := mapOfErr["status"]
 	if !haveTypeError && haveIdError {
-		if haveStatusError && req.Type != models.Text {
+		if haveStatusError && !req.HaveStatus() {
 			return handler_errors.IncorrectStatus
 		}
 		return nil
 	}
 
-	if haveStatusError && req.Type != models.Text {
+	if haveStatusError && !req.HaveStatus() {
 		if haveIdError {
 			return handler_errors.IncorrectIdAttach
 		}
@@ -191,9 +374,10 @@ func (req *RequestAttach) Validate() error {
 }
 
 // Validate Errors:
-//		handler_errors.IncorrectType
-//		handler_errors.IncorrectIdAttach
-//      handler_errors.IncorrectStatus
+//				handler_errors.IncorrectType
+//				handler_errors.IncorrectIdAttach
+//		     handler_errors.IncorrectStatus
+//
 // can return not specify error
 func (req *RequestAttaches) Validate() error {
 	for _, attach := range req.Attaches {
@@ -8,6 +8,7 @@ import (
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
 	models "patreon/internal/app/models"
+	time "time"
 )
 
 // suppress unused package warning
@@ -88,7 +89,86 @@ func (v *SubscribeRequest) UnmarshalJSON(data []byte) error {
 func (v *SubscribeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels(l, v)
 }
-func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels1(in *jlexer.Lexer, out *RequestText) {
+func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels1(in *jlexer.Lexer, out *RequestTip) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		case "message":
+			out.Message = string(in.String())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels1(out *jwriter.Writer, in RequestTip) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.Amount).MarshalJSON())
+	}
+	if in.Message != "" {
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RequestTip) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RequestTip) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RequestTip) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RequestTip) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels1(l, v)
+}
+func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels2(in *jlexer.Lexer, out *RequestText) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -123,42 +203,1102 @@ func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels1(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels1(out *jwriter.Writer, in RequestText) {
+func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels2(out *jwriter.Writer, in RequestText) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RequestText) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RequestText) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RequestText) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RequestText) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels2(l, v)
+}
+func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels3(in *jlexer.Lexer, out *RequestRegistration) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "login":
+			out.Login = string(in.String())
+		case "nickname":
+			out.Nickname = string(in.String())
+		case "password":
+			out.Password = string(in.String())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels3(out *jwriter.Writer, in RequestRegistration) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"login\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Login))
+	}
+	{
+		const prefix string = ",\"nickname\":"
+		out.RawString(prefix)
+		out.String(string(in.Nickname))
+	}
+	{
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RequestRegistration) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RequestRegistration) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RequestRegistration) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RequestRegistration) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels3(l, v)
+}
+func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels4(in *jlexer.Lexer, out *RequestRefund) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		case "reason":
+			out.Reason = string(in.String())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels4(out *jwriter.Writer, in RequestRefund) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.Amount).MarshalJSON())
+	}
+	if in.Reason != "" {
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RequestRefund) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RequestRefund) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RequestRefund) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RequestRefund) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels4(l, v)
+}
+func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels5(in *jlexer.Lexer, out *RequestRedeemGift) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			out.Code = string(in.String())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels5(out *jwriter.Writer, in RequestRedeemGift) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Code))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RequestRedeemGift) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RequestRedeemGift) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RequestRedeemGift) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RequestRedeemGift) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels5(l, v)
+}
+func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels6(in *jlexer.Lexer, out *RequestPromoCode) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			out.Code = string(in.String())
+		case "discount_type":
+			out.DiscountType = string(in.String())
+		case "discount":
+			out.Discount = int64(in.Int64())
+		case "award_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.AwardIDs = nil
+			} else {
+				in.Delim('[')
+				if out.AwardIDs == nil {
+					if !in.IsDelim(']') {
+						out.AwardIDs = make([]int64, 0, 8)
+					} else {
+						out.AwardIDs = []int64{}
+					}
+				} else {
+					out.AwardIDs = (out.AwardIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 int64
+					v1 = int64(in.Int64())
+					out.AwardIDs = append(out.AwardIDs, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "max_uses":
+			out.MaxUses = int64(in.Int64())
+		case "valid_from":
+			if in.IsNull() {
+				in.Skip()
+				out.ValidFrom = nil
+			} else {
+				if out.ValidFrom == nil {
+					out.ValidFrom = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ValidFrom).UnmarshalJSON(data))
+				}
+			}
+		case "valid_until":
+			if in.IsNull() {
+				in.Skip()
+				out.ValidUntil = nil
+			} else {
+				if out.ValidUntil == nil {
+					out.ValidUntil = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ValidUntil).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels6(out *jwriter.Writer, in RequestPromoCode) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Code))
+	}
+	{
+		const prefix string = ",\"discount_type\":"
+		out.RawString(prefix)
+		out.String(string(in.DiscountType))
+	}
+	{
+		const prefix string = ",\"discount\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Discount))
+	}
+	if len(in.AwardIDs) != 0 {
+		const prefix string = ",\"award_ids\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v2, v3 := range in.AwardIDs {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.Int64(int64(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.MaxUses != 0 {
+		const prefix string = ",\"max_uses\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MaxUses))
+	}
+	if in.ValidFrom != nil {
+		const prefix string = ",\"valid_from\":"
+		out.RawString(prefix)
+		out.Raw((*in.ValidFrom).MarshalJSON())
+	}
+	if in.ValidUntil != nil {
+		const prefix string = ",\"valid_until\":"
+		out.RawString(prefix)
+		out.Raw((*in.ValidUntil).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RequestPromoCode) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RequestPromoCode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RequestPromoCode) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RequestPromoCode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels6(l, v)
+}
+func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels7(in *jlexer.Lexer, out *RequestPosts) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		case "awards_id":
+			out.AwardsId = int64(in.Int64())
+		case "description":
+			out.Description = string(in.String())
+		case "is_draft":
+			out.IsDraft = bool(in.Bool())
+		case "unlock_price":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UnlockPrice).UnmarshalJSON(data))
+			}
+		case "preview":
+			out.Preview = string(in.String())
+		case "publish_at":
+			if in.IsNull() {
+				in.Skip()
+				out.PublishAt = nil
+			} else {
+				if out.PublishAt == nil {
+					out.PublishAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.PublishAt).UnmarshalJSON(data))
+				}
+			}
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 string
+					v4 = string(in.String())
+					out.Tags = append(out.Tags, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "add_tags":
+			if in.IsNull() {
+				in.Skip()
+				out.AddTags = nil
+			} else {
+				in.Delim('[')
+				if out.AddTags == nil {
+					if !in.IsDelim(']') {
+						out.AddTags = make([]string, 0, 4)
+					} else {
+						out.AddTags = []string{}
+					}
+				} else {
+					out.AddTags = (out.AddTags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v5 string
+					v5 = string(in.String())
+					out.AddTags = append(out.AddTags, v5)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "remove_tags":
+			if in.IsNull() {
+				in.Skip()
+				out.RemoveTags = nil
+			} else {
+				in.Delim('[')
+				if out.RemoveTags == nil {
+					if !in.IsDelim(']') {
+						out.RemoveTags = make([]string, 0, 4)
+					} else {
+						out.RemoveTags = []string{}
+					}
+				} else {
+					out.RemoveTags = (out.RemoveTags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v6 string
+					v6 = string(in.String())
+					out.RemoveTags = append(out.RemoveTags, v6)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels7(out *jwriter.Writer, in RequestPosts) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Title != "" {
+		const prefix string = ",\"title\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Title))
+	}
+	if in.AwardsId != 0 {
+		const prefix string = ",\"awards_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.AwardsId))
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Description))
+	}
+	if in.IsDraft {
+		const prefix string = ",\"is_draft\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.IsDraft))
+	}
+	if in.UnlockPrice != 0 {
+		const prefix string = ",\"unlock_price\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.UnlockPrice).MarshalJSON())
+	}
+	if in.Preview != "" {
+		const prefix string = ",\"preview\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Preview))
+	}
+	if in.PublishAt != nil {
+		const prefix string = ",\"publish_at\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.PublishAt).MarshalJSON())
+	}
+	if len(in.Tags) != 0 {
+		const prefix string = ",\"tags\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v7, v8 := range in.Tags {
+				if v7 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v8))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.AddTags) != 0 {
+		const prefix string = ",\"add_tags\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v9, v10 := range in.AddTags {
+				if v9 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v10))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.RemoveTags) != 0 {
+		const prefix string = ",\"remove_tags\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v11, v12 := range in.RemoveTags {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v12))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RequestPosts) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RequestPosts) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RequestPosts) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RequestPosts) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels7(l, v)
+}
+func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels8(in *jlexer.Lexer, out *RequestPollVote) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "options":
+			if in.IsNull() {
+				in.Skip()
+				out.Options = nil
+			} else {
+				in.Delim('[')
+				if out.Options == nil {
+					if !in.IsDelim(']') {
+						out.Options = make([]int64, 0, 8)
+					} else {
+						out.Options = []int64{}
+					}
+				} else {
+					out.Options = (out.Options)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 int64
+					v13 = int64(in.Int64())
+					out.Options = append(out.Options, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels8(out *jwriter.Writer, in RequestPollVote) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"options\":"
+		out.RawString(prefix[1:])
+		if in.Options == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.Options {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				out.Int64(int64(v15))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RequestPollVote) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RequestPollVote) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RequestPollVote) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RequestPollVote) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels8(l, v)
+}
+func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels9(in *jlexer.Lexer, out *RequestPoll) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "question":
+			out.Question = string(in.String())
+		case "options":
+			if in.IsNull() {
+				in.Skip()
+				out.Options = nil
+			} else {
+				in.Delim('[')
+				if out.Options == nil {
+					if !in.IsDelim(']') {
+						out.Options = make([]string, 0, 4)
+					} else {
+						out.Options = []string{}
+					}
+				} else {
+					out.Options = (out.Options)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v16 string
+					v16 = string(in.String())
+					out.Options = append(out.Options, v16)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "multiple":
+			out.Multiple = bool(in.Bool())
+		case "close_at":
+			if in.IsNull() {
+				in.Skip()
+				out.CloseAt = nil
+			} else {
+				if out.CloseAt == nil {
+					out.CloseAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CloseAt).UnmarshalJSON(data))
+				}
+			}
+		case "results_before_vote":
+			out.ResultsBeforeVote = bool(in.Bool())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels9(out *jwriter.Writer, in RequestPoll) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"question\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Question))
+	}
+	{
+		const prefix string = ",\"options\":"
+		out.RawString(prefix)
+		if in.Options == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v17, v18 := range in.Options {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v18))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"multiple\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Multiple))
+	}
+	if in.CloseAt != nil {
+		const prefix string = ",\"close_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.CloseAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"results_before_vote\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ResultsBeforeVote))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RequestPoll) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RequestPoll) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RequestPoll) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RequestPoll) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels9(l, v)
+}
+func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels10(in *jlexer.Lexer, out *RequestPayoutState) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "state":
+			out.State = models.PayoutState(in.String())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels10(out *jwriter.Writer, in RequestPayoutState) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.State))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RequestPayoutState) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RequestPayoutState) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RequestPayoutState) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RequestPayoutState) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels10(l, v)
+}
+func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels11(in *jlexer.Lexer, out *RequestPayout) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels11(out *jwriter.Writer, in RequestPayout) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"text\":"
+		const prefix string = ",\"amount\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Text))
+		out.Raw((in.Amount).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v RequestText) MarshalJSON() ([]byte, error) {
+func (v RequestPayout) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels1(&w, v)
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v RequestText) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels1(w, v)
+func (v RequestPayout) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *RequestText) UnmarshalJSON(data []byte) error {
+func (v *RequestPayout) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels1(&r, v)
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *RequestText) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels1(l, v)
+func (v *RequestPayout) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels11(l, v)
 }
-func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels2(in *jlexer.Lexer, out *RequestRegistration) {
+func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels12(in *jlexer.Lexer, out *RequestLogin) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -179,8 +1319,6 @@ func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels2(in *jlexer.Lexe
 		switch key {
 		case "login":
 			out.Login = string(in.String())
-		case "nickname":
-			out.Nickname = string(in.String())
 		case "password":
 			out.Password = string(in.String())
 		default:
@@ -197,7 +1335,7 @@ func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels2(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels2(out *jwriter.Writer, in RequestRegistration) {
+func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels12(out *jwriter.Writer, in RequestLogin) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -206,11 +1344,6 @@ func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels2(out *jwriter.Wr
 		out.RawString(prefix[1:])
 		out.String(string(in.Login))
 	}
-	{
-		const prefix string = ",\"nickname\":"
-		out.RawString(prefix)
-		out.String(string(in.Nickname))
-	}
 	{
 		const prefix string = ",\"password\":"
 		out.RawString(prefix)
@@ -220,29 +1353,29 @@ func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels2(out *jwriter.Wr
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v RequestRegistration) MarshalJSON() ([]byte, error) {
+func (v RequestLogin) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels2(&w, v)
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels12(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v RequestRegistration) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels2(w, v)
+func (v RequestLogin) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels12(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *RequestRegistration) UnmarshalJSON(data []byte) error {
+func (v *RequestLogin) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels2(&r, v)
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels12(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *RequestRegistration) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels2(l, v)
+func (v *RequestLogin) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels12(l, v)
 }
-func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels3(in *jlexer.Lexer, out *RequestPosts) {
+func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels13(in *jlexer.Lexer, out *RequestGift) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -261,14 +1394,10 @@ func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels3(in *jlexer.Lexe
 			continue
 		}
 		switch key {
-		case "title":
-			out.Title = string(in.String())
-		case "awards_id":
-			out.AwardsId = int64(in.Int64())
-		case "description":
-			out.Description = string(in.String())
-		case "is_draft":
-			out.IsDraft = bool(in.Bool())
+		case "periods":
+			out.Periods = int64(in.Int64())
+		case "recipient_nickname":
+			out.RecipientNickname = string(in.String())
 		default:
 			in.AddError(&jlexer.LexerError{
 				Offset: in.GetPos(),
@@ -283,73 +1412,47 @@ func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels3(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels3(out *jwriter.Writer, in RequestPosts) {
+func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels13(out *jwriter.Writer, in RequestGift) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.Title != "" {
-		const prefix string = ",\"title\":"
-		first = false
+	{
+		const prefix string = ",\"periods\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Title))
-	}
-	if in.AwardsId != 0 {
-		const prefix string = ",\"awards_id\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.Int64(int64(in.AwardsId))
-	}
-	if in.Description != "" {
-		const prefix string = ",\"description\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.Description))
+		out.Int64(int64(in.Periods))
 	}
-	if in.IsDraft {
-		const prefix string = ",\"is_draft\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.Bool(bool(in.IsDraft))
+	if in.RecipientNickname != "" {
+		const prefix string = ",\"recipient_nickname\":"
+		out.RawString(prefix)
+		out.String(string(in.RecipientNickname))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v RequestPosts) MarshalJSON() ([]byte, error) {
+func (v RequestGift) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels3(&w, v)
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels13(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v RequestPosts) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels3(w, v)
+func (v RequestGift) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels13(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *RequestPosts) UnmarshalJSON(data []byte) error {
+func (v *RequestGift) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels3(&r, v)
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels13(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *RequestPosts) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels3(l, v)
+func (v *RequestGift) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels13(l, v)
 }
-func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels4(in *jlexer.Lexer, out *RequestLogin) {
+func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels14(in *jlexer.Lexer, out *RequestCreator) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -368,10 +1471,12 @@ func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels4(in *jlexer.Lexe
 			continue
 		}
 		switch key {
-		case "login":
-			out.Login = string(in.String())
-		case "password":
-			out.Password = string(in.String())
+		case "category":
+			out.Category = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "currency":
+			out.Currency = string(in.String())
 		default:
 			in.AddError(&jlexer.LexerError{
 				Offset: in.GetPos(),
@@ -386,47 +1491,52 @@ func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels4(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels4(out *jwriter.Writer, in RequestLogin) {
+func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels14(out *jwriter.Writer, in RequestCreator) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"login\":"
+		const prefix string = ",\"category\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Login))
+		out.String(string(in.Category))
 	}
 	{
-		const prefix string = ",\"password\":"
+		const prefix string = ",\"description\":"
 		out.RawString(prefix)
-		out.String(string(in.Password))
+		out.String(string(in.Description))
+	}
+	if in.Currency != "" {
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v RequestLogin) MarshalJSON() ([]byte, error) {
+func (v RequestCreator) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels4(&w, v)
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels14(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v RequestLogin) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels4(w, v)
+func (v RequestCreator) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels14(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *RequestLogin) UnmarshalJSON(data []byte) error {
+func (v *RequestCreator) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels4(&r, v)
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels14(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *RequestLogin) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels4(l, v)
+func (v *RequestCreator) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels14(l, v)
 }
-func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels5(in *jlexer.Lexer, out *RequestCreator) {
+func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels15(in *jlexer.Lexer, out *RequestComment) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -445,10 +1555,10 @@ func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels5(in *jlexer.Lexe
 			continue
 		}
 		switch key {
-		case "category":
-			out.Category = string(in.String())
-		case "description":
-			out.Description = string(in.String())
+		case "body":
+			out.Body = string(in.String())
+		case "as_creator":
+			out.AsCreator = bool(in.Bool())
 		default:
 			in.AddError(&jlexer.LexerError{
 				Offset: in.GetPos(),
@@ -463,47 +1573,47 @@ func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels5(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels5(out *jwriter.Writer, in RequestCreator) {
+func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels15(out *jwriter.Writer, in RequestComment) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"category\":"
+		const prefix string = ",\"body\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Category))
+		out.String(string(in.Body))
 	}
-	{
-		const prefix string = ",\"description\":"
+	if in.AsCreator {
+		const prefix string = ",\"as_creator\":"
 		out.RawString(prefix)
-		out.String(string(in.Description))
+		out.Bool(bool(in.AsCreator))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v RequestCreator) MarshalJSON() ([]byte, error) {
+func (v RequestComment) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels5(&w, v)
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels15(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v RequestCreator) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels5(w, v)
+func (v RequestComment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels15(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *RequestCreator) UnmarshalJSON(data []byte) error {
+func (v *RequestComment) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels5(&r, v)
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels15(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *RequestCreator) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels5(l, v)
+func (v *RequestComment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels15(l, v)
 }
-func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels6(in *jlexer.Lexer, out *RequestComment) {
+func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels16(in *jlexer.Lexer, out *RequestChangeTier) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -522,10 +1632,8 @@ func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels6(in *jlexer.Lexe
 			continue
 		}
 		switch key {
-		case "body":
-			out.Body = string(in.String())
-		case "as_creator":
-			out.AsCreator = bool(in.Bool())
+		case "award_id":
+			out.AwardID = int64(in.Int64())
 		default:
 			in.AddError(&jlexer.LexerError{
 				Offset: in.GetPos(),
@@ -540,47 +1648,42 @@ func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels6(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels6(out *jwriter.Writer, in RequestComment) {
+func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels16(out *jwriter.Writer, in RequestChangeTier) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"body\":"
+		const prefix string = ",\"award_id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Body))
-	}
-	if in.AsCreator {
-		const prefix string = ",\"as_creator\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.AsCreator))
+		out.Int64(int64(in.AwardID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v RequestComment) MarshalJSON() ([]byte, error) {
+func (v RequestChangeTier) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels6(&w, v)
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels16(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v RequestComment) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels6(w, v)
+func (v RequestChangeTier) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels16(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *RequestComment) UnmarshalJSON(data []byte) error {
+func (v *RequestChangeTier) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels6(&r, v)
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels16(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *RequestComment) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels6(l, v)
+func (v *RequestChangeTier) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels16(l, v)
 }
-func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels7(in *jlexer.Lexer, out *RequestChangePassword) {
+func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels17(in *jlexer.Lexer, out *RequestChangePassword) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -617,7 +1720,7 @@ func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels7(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels7(out *jwriter.Writer, in RequestChangePassword) {
+func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels17(out *jwriter.Writer, in RequestChangePassword) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -637,27 +1740,27 @@ func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels7(out *jwriter.Wr
 // MarshalJSON supports json.Marshaler interface
 func (v RequestChangePassword) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels7(&w, v)
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels17(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v RequestChangePassword) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels7(w, v)
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels17(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *RequestChangePassword) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels7(&r, v)
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels17(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *RequestChangePassword) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels7(l, v)
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels17(l, v)
 }
-func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels8(in *jlexer.Lexer, out *RequestChangeNickname) {
+func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels18(in *jlexer.Lexer, out *RequestChangeNickname) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -694,7 +1797,7 @@ func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels8(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels8(out *jwriter.Writer, in RequestChangeNickname) {
+func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels18(out *jwriter.Writer, in RequestChangeNickname) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -714,27 +1817,104 @@ func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels8(out *jwriter.Wr
 // MarshalJSON supports json.Marshaler interface
 func (v RequestChangeNickname) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels8(&w, v)
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels18(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v RequestChangeNickname) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels8(w, v)
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels18(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *RequestChangeNickname) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels8(&r, v)
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels18(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *RequestChangeNickname) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels8(l, v)
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels18(l, v)
+}
+func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels19(in *jlexer.Lexer, out *RequestBlockUser) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = int64(in.Int64())
+		case "cancel_subscription":
+			out.CancelSubscription = bool(in.Bool())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels19(out *jwriter.Writer, in RequestBlockUser) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.UserID))
+	}
+	if in.CancelSubscription {
+		const prefix string = ",\"cancel_subscription\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CancelSubscription))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RequestBlockUser) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RequestBlockUser) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RequestBlockUser) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RequestBlockUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels19(l, v)
 }
-func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels9(in *jlexer.Lexer, out *RequestAwards) {
+func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels20(in *jlexer.Lexer, out *RequestAwards) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -758,9 +1938,15 @@ func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels9(in *jlexer.Lexe
 		case "description":
 			out.Description = string(in.String())
 		case "price":
-			out.Price = int64(in.Int64())
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Price).UnmarshalJSON(data))
+			}
 		case "color":
 			(out.Color).UnmarshalEasyJSON(in)
+		case "trial_days":
+			out.TrialDays = int64(in.Int64())
+		case "max_subscribers":
+			out.MaxSubscribers = int64(in.Int64())
 		default:
 			in.AddError(&jlexer.LexerError{
 				Offset: in.GetPos(),
@@ -775,7 +1961,7 @@ func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels9(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels9(out *jwriter.Writer, in RequestAwards) {
+func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels20(out *jwriter.Writer, in RequestAwards) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -792,40 +1978,50 @@ func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels9(out *jwriter.Wr
 	{
 		const prefix string = ",\"price\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Price))
+		out.Raw((in.Price).MarshalJSON())
 	}
 	if true {
 		const prefix string = ",\"color\":"
 		out.RawString(prefix)
 		(in.Color).MarshalEasyJSON(out)
 	}
+	if in.TrialDays != 0 {
+		const prefix string = ",\"trial_days\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TrialDays))
+	}
+	if in.MaxSubscribers != 0 {
+		const prefix string = ",\"max_subscribers\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MaxSubscribers))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v RequestAwards) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels9(&w, v)
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels20(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v RequestAwards) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels9(w, v)
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels20(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *RequestAwards) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels9(&r, v)
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels20(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *RequestAwards) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels9(l, v)
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels20(l, v)
 }
-func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels10(in *jlexer.Lexer, out *RequestAttaches) {
+func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels21(in *jlexer.Lexer, out *RequestAttaches) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -860,9 +2056,9 @@ func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels10(in *jlexer.Lex
 					out.Attaches = (out.Attaches)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v1 RequestAttach
-					(v1).UnmarshalEasyJSON(in)
-					out.Attaches = append(out.Attaches, v1)
+					var v19 RequestAttach
+					(v19).UnmarshalEasyJSON(in)
+					out.Attaches = append(out.Attaches, v19)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -881,7 +2077,7 @@ func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels10(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels10(out *jwriter.Writer, in RequestAttaches) {
+func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels21(out *jwriter.Writer, in RequestAttaches) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -892,11 +2088,11 @@ func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels10(out *jwriter.W
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v2, v3 := range in.Attaches {
-				if v2 > 0 {
+			for v20, v21 := range in.Attaches {
+				if v20 > 0 {
 					out.RawByte(',')
 				}
-				(v3).MarshalEasyJSON(out)
+				(v21).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -907,27 +2103,27 @@ func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels10(out *jwriter.W
 // MarshalJSON supports json.Marshaler interface
 func (v RequestAttaches) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels10(&w, v)
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels21(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v RequestAttaches) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels10(w, v)
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels21(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *RequestAttaches) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels10(&r, v)
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels21(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *RequestAttaches) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels10(l, v)
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels21(l, v)
 }
-func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels11(in *jlexer.Lexer, out *RequestAttach) {
+func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels22(in *jlexer.Lexer, out *RequestAttach) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -968,7 +2164,7 @@ func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels11(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels11(out *jwriter.Writer, in RequestAttach) {
+func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels22(out *jwriter.Writer, in RequestAttach) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -998,27 +2194,27 @@ func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels11(out *jwriter.W
 // MarshalJSON supports json.Marshaler interface
 func (v RequestAttach) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels11(&w, v)
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels22(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v RequestAttach) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels11(w, v)
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels22(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *RequestAttach) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels11(&r, v)
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels22(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *RequestAttach) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels11(l, v)
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels22(l, v)
 }
-func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels12(in *jlexer.Lexer, out *Color) {
+func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels23(in *jlexer.Lexer, out *Color) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1059,7 +2255,7 @@ func easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels12(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels12(out *jwriter.Writer, in Color) {
+func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels23(out *jwriter.Writer, in Color) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1089,23 +2285,23 @@ func easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels12(out *jwriter.W
 // MarshalJSON supports json.Marshaler interface
 func (v Color) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels12(&w, v)
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels23(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Color) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels12(w, v)
+	easyjson7df0efccEncodePatreonInternalAppDeliveryHttpModels23(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Color) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels12(&r, v)
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels23(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Color) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels12(l, v)
+	easyjson7df0efccDecodePatreonInternalAppDeliveryHttpModels23(l, v)
 }
@@ -6,6 +6,7 @@ import (
 	"patreon/internal/app/csrf/csrf_models"
 	"patreon/internal/app/models"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -26,6 +27,82 @@ type PayAccountResponse struct {
 	Account string `json:"account_number"`
 }
 
+//easyjson:json
+type ResponseCheckout struct {
+	Provider string `json:"provider"`
+	Url      string `json:"url"`
+}
+
+//easyjson:json
+type ResponseCheckouts struct {
+	Checkouts []models.PayTokenInfo `json:"checkouts"`
+}
+
+//easyjson:json
+type ResponseTierChange struct {
+	models.TierChange
+}
+
+//easyjson:json
+type ResponseTierChanges struct {
+	Changes []models.TierChange `json:"changes"`
+}
+
+//easyjson:json
+type ResponseCreatorBalance struct {
+	models.CreatorBalance
+}
+
+//easyjson:json
+type ResponseLedgerEntries struct {
+	Entries []models.LedgerEntry `json:"entries"`
+}
+
+//easyjson:json
+type ResponsePayout struct {
+	models.Payout
+}
+
+//easyjson:json
+type ResponsePayouts struct {
+	Payouts []models.Payout `json:"payouts"`
+}
+
+//easyjson:json
+type ResponsePromoCode struct {
+	models.PromoCode
+}
+
+//easyjson:json
+type ResponsePromoCodes struct {
+	PromoCodes []models.PromoCode `json:"promo_codes"`
+}
+
+//easyjson:json
+type ResponseGift struct {
+	models.Gift
+}
+
+//easyjson:json
+type ResponseTip struct {
+	models.Tip
+}
+
+//easyjson:json
+type ResponsePostUnlock struct {
+	models.PostUnlock
+}
+
+//easyjson:json
+type ResponseTrial struct {
+	models.Trial
+}
+
+//easyjson:json
+type ResponseGifts struct {
+	Gifts []models.Gift `json:"gifts"`
+}
+
 //easyjson:json
 type ErrResponse struct {
 	Err string `json:"error"`
@@ -77,13 +154,19 @@ type ResponseCreatorSubscrube struct {
 
 //easyjson:json
 type ResponseAward struct {
-	ID          int64  `json:"awards_id"`
-	Name        string `json:"name"`
-	Description string `json:"description,omitempty"`
-	Price       int64  `json:"price,omitempty"`
-	Color       Color  `json:"color,omitempty"`
-	Cover       string `json:"cover"`
-	ChildAward  int64  `json:"child_award,omitempty"`
+	ID          int64          `json:"awards_id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Price       models.Decimal `json:"price,omitempty"`
+	Currency    string         `json:"currency,omitempty"`
+	Color       Color          `json:"color,omitempty"`
+	Cover       string         `json:"cover"`
+	ChildAward  int64          `json:"child_award,omitempty"`
+	TrialDays   int64          `json:"trial_days,omitempty"`
+
+	// SeatsLeft is set only for award with limited count of subscribers
+	MaxSubscribers int64  `json:"max_subscribers,omitempty"`
+	SeatsLeft      *int64 `json:"seats_left,omitempty"`
 }
 
 //easyjson:json
@@ -93,17 +176,23 @@ type ResponseAwards struct {
 
 //easyjson:json
 type ResponsePost struct {
-	ID          int64     `json:"posts_id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	Awards      int64     `json:"type_awards,omitempty"`
-	Likes       int64     `json:"likes"`
-	Cover       string    `json:"cover"`
-	AddLike     bool      `json:"add_like,omitempty"`
-	Views       int64     `json:"views"`
-	Comments    int64     `json:"comments"`
-	Date        time.Time `json:"date"`
-	IsDraft     bool      `json:"is_draft,omitempty"`
+	ID          int64          `json:"posts_id"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Awards      int64          `json:"type_awards,omitempty"`
+	Likes       int64          `json:"likes"`
+	Cover       string         `json:"cover"`
+	AddLike     bool           `json:"add_like,omitempty"`
+	Views       int64          `json:"views"`
+	Comments    int64          `json:"comments"`
+	Date        time.Time      `json:"date"`
+	IsDraft     bool           `json:"is_draft,omitempty"`
+	UnlockPrice models.Decimal `json:"unlock_price,omitempty"`
+	Unlocked    bool           `json:"unlocked,omitempty"`
+	PublishAt   *time.Time     `json:"publish_at,omitempty"`
+	Tags        []string       `json:"tags,omitempty"`
+	Preview     string         `json:"preview,omitempty"`
+	Locked      bool           `json:"locked"`
 }
 
 //easyjson:json
@@ -161,6 +250,27 @@ type ResponsePostWithAttaches struct {
 	Data []ResponseAttach `json:"attaches"`
 }
 
+//easyjson:json
+type ResponseLockedPostInfo struct {
+	ID          int64          `json:"posts_id"`
+	Title       string         `json:"title"`
+	Cover       string         `json:"cover"`
+	Preview     string         `json:"preview"`
+	Awards      int64          `json:"type_awards,omitempty"`
+	UnlockPrice models.Decimal `json:"unlock_price,omitempty"`
+	CreatorId   int64          `json:"creator_id"`
+	Date        time.Time      `json:"date"`
+	Tags        []string       `json:"tags,omitempty"`
+	Locked      bool           `json:"locked"`
+}
+
+//easyjson:json
+type ResponseLockedPost struct {
+	Post ResponseLockedPostInfo `json:"post"`
+	// Data attaches of locked post are only counted by types, their values are not available
+	Data []models.AttachCount `json:"attaches"`
+}
+
 //easyjson:json
 type ResponseBalance struct {
 	ID      int64        `json:"user_id"`
@@ -246,15 +356,22 @@ func ToResponseCreatorWithAwards(cr models.CreatorWithAwards) ResponseCreatorWit
 }
 
 func ToResponseAward(aw models.Award) ResponseAward {
-	return ResponseAward{
+	res := ResponseAward{
 		ID:          aw.ID,
 		Name:        aw.Name,
 		Price:       aw.Price,
+		Currency:    aw.Currency,
 		Description: aw.Description,
 		Color:       NewColor(aw.Color),
 		Cover:       aw.Cover,
 		ChildAward:  int64(math.Max(float64(aw.ChildAward), 0)),
+		TrialDays:   aw.TrialDays,
+	}
+	if aw.MaxSubscribers != 0 {
+		res.MaxSubscribers = aw.MaxSubscribers
+		res.SeatsLeft = &aw.SeatsLeft
 	}
+	return res
 }
 
 func ToResponsePost(ps models.Post) ResponsePost {
@@ -270,6 +387,12 @@ func ToResponsePost(ps models.Post) ResponsePost {
 		Views:       ps.Views,
 		IsDraft:     ps.IsDraft,
 		Comments:    ps.Comments,
+		UnlockPrice: ps.UnlockPrice,
+		Unlocked:    ps.Unlocked,
+		PublishAt:   ps.PublishAt,
+		Tags:        ps.Tags,
+		Preview:     ps.Preview,
+		Locked:      ps.Locked,
 	}
 }
 
@@ -281,6 +404,24 @@ func ToResponsePostWithAttaches(ps models.PostWithAttach) ResponsePostWithAttach
 	return res
 }
 
+func ToResponseLockedPost(ps models.PostWithAttach) ResponseLockedPost {
+	return ResponseLockedPost{
+		Post: ResponseLockedPostInfo{
+			ID:          ps.ID,
+			Title:       ps.Title,
+			Cover:       ps.Cover,
+			Preview:     ps.Preview,
+			Awards:      int64(math.Max(float64(ps.Awards), 0)),
+			UnlockPrice: ps.UnlockPrice,
+			CreatorId:   ps.CreatorId,
+			Date:        ps.Date,
+			Tags:        ps.Tags,
+			Locked:      true,
+		},
+		Data: models.CountAttaches(ps.Data),
+	}
+}
+
 func ToResponseAttach(ps models.AttachWithoutLevel) ResponseAttach {
 	return ResponseAttach{
 		ID:    ps.ID,
@@ -328,24 +469,40 @@ type ResponseUser struct {
 
 //easyjson:json
 type SubscribersCreatorResponse struct {
-	Users []ResponseUser `json:"users"`
-}
-
-func ToSubscribersCreatorResponse(users []models.User) SubscribersCreatorResponse {
-	res := make([]ResponseUser, len(users))
-	for i, user := range users {
-		res[i] = ResponseUser{
-			ID:       user.ID,
-			Login:    user.Login,
-			Nickname: user.Nickname,
-			Avatar:   user.Avatar,
-		}
-	}
+	Subscribers []models.CreatorSubscriber `json:"subscribers"`
+}
+
+func ToSubscribersCreatorResponse(subscribers []models.CreatorSubscriber) SubscribersCreatorResponse {
 	return SubscribersCreatorResponse{
-		Users: res,
+		Subscribers: subscribers,
 	}
 }
 
+//easyjson:json
+type ResponseSubscriptionEvents struct {
+	Events []models.SubscriptionEvent `json:"events"`
+}
+
+//easyjson:json
+type ResponseBlockedUsers struct {
+	BlockedUsers []models.BlockedUser `json:"blocked_users"`
+}
+
+//easyjson:json
+type ResponseTags struct {
+	Tags []models.Tag `json:"tags"`
+}
+
+//easyjson:json
+type ResponsePostRevisions struct {
+	Revisions []models.PostRevision `json:"revisions"`
+}
+
+//easyjson:json
+type ResponsePostRevisionsDiff struct {
+	Changes []models.PostRevisionChange `json:"changes"`
+}
+
 //easyjson:json
 type ResponseLike struct {
 	Likes int64 `json:"likes"`
@@ -365,13 +522,21 @@ func ToResponseUserPayments(payments []models.UserPayments) ResponseUserPayments
 		res = append(res, models.UserPayments{
 			Payments: models.Payments{
 				Amount:    payment.Amount,
+				Currency:  payment.Currency,
 				Date:      payment.Date,
 				CreatorID: payment.CreatorID,
-				Status:    payment.Status,
+				State:     payment.State,
+				Type:      payment.Type,
+				Events:    payment.Events,
+				PromoCode: payment.PromoCode,
+				Discount:  payment.Discount,
+				GiftID:    payment.GiftID,
 			},
 			CreatorNickname:    payment.CreatorNickname,
 			CreatorDescription: payment.CreatorDescription,
 			CreatorCategory:    payment.CreatorCategory,
+			GiftFrom:           payment.GiftFrom,
+			GiftTo:             payment.GiftTo,
 		})
 	}
 	return ResponseUserPayments{
@@ -383,12 +548,23 @@ func ToResponseCreatorPayments(payments []models.CreatorPayments) ResponseCreato
 	for _, payment := range payments {
 		res = append(res, models.CreatorPayments{
 			Payments: models.Payments{
-				Amount: payment.Amount,
-				Date:   payment.Date,
-				UserID: payment.UserID,
-				Status: payment.Status,
+				ID:             payment.ID,
+				Amount:         payment.Amount,
+				Currency:       payment.Currency,
+				Date:           payment.Date,
+				UserID:         payment.UserID,
+				State:          payment.State,
+				Type:           payment.Type,
+				Events:         payment.Events,
+				PromoCode:      payment.PromoCode,
+				Discount:       payment.Discount,
+				TipID:          payment.TipID,
+				RefundedAmount: payment.RefundedAmount,
 			},
 			UserNickname: payment.UserNickname,
+			TipMessage:   payment.TipMessage,
+			AwardID:      payment.AwardID,
+			AwardName:    payment.AwardName,
 		})
 	}
 	return ResponseCreatorPayments{
@@ -396,6 +572,80 @@ func ToResponseCreatorPayments(payments []models.CreatorPayments) ResponseCreato
 	}
 }
 
+// ExportPaymentsHeader names of columns in csv export of payments, they are the same as json fields
+var ExportPaymentsHeader = []string{"date", "type", "state", "amount", "refunded_amount", "currency",
+	"discount", "promo_code", "award_id", "award_name", "user_id", "user_nickname", "tip_message"}
+
+//easyjson:json
+type ResponseExportPayment struct {
+	Date           time.Time           `json:"date"`
+	Type           models.PaymentType  `json:"type"`
+	State          models.PaymentState `json:"state"`
+	Amount         models.Decimal      `json:"amount"`
+	RefundedAmount models.Decimal      `json:"refunded_amount"`
+	Currency       string              `json:"currency"`
+	Discount       models.Decimal      `json:"discount"`
+	PromoCode      string              `json:"promo_code"`
+	AwardID        int64               `json:"award_id"`
+	AwardName      string              `json:"award_name"`
+	UserID         int64               `json:"user_id"`
+	UserNickname   string              `json:"user_nickname"`
+	TipMessage     string              `json:"tip_message"`
+}
+
+func ToResponseExportPayment(payment *models.CreatorPayments) ResponseExportPayment {
+	return ResponseExportPayment{
+		Date:           payment.Date,
+		Type:           payment.Type,
+		State:          payment.State,
+		Amount:         payment.Amount,
+		RefundedAmount: payment.RefundedAmount,
+		Currency:       payment.Currency,
+		Discount:       payment.Discount,
+		PromoCode:      payment.PromoCode,
+		AwardID:        payment.AwardID,
+		AwardName:      payment.AwardName,
+		UserID:         payment.UserID,
+		UserNickname:   payment.UserNickname,
+		TipMessage:     payment.TipMessage,
+	}
+}
+
+// csvFormulaPrefixes first symbols of cell which spreadsheet editors interpret as formula
+const csvFormulaPrefixes = "=+-@\t\r"
+
+// csvCell escape user text, so it is shown in spreadsheet as text and not executed as formula
+func csvCell(value string) string {
+	if value != "" && strings.ContainsRune(csvFormulaPrefixes, rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// CSVRecord return values of payment in order of ExportPaymentsHeader, user text is escaped with csvCell
+func (payment *ResponseExportPayment) CSVRecord() []string {
+	return []string{
+		payment.Date.Format(time.RFC3339),
+		string(payment.Type),
+		string(payment.State),
+		payment.Amount.String(),
+		payment.RefundedAmount.String(),
+		payment.Currency,
+		payment.Discount.String(),
+		csvCell(payment.PromoCode),
+		strconv.FormatInt(payment.AwardID, 10),
+		csvCell(payment.AwardName),
+		strconv.FormatInt(payment.UserID, 10),
+		csvCell(payment.UserNickname),
+		csvCell(payment.TipMessage),
+	}
+}
+
+//easyjson:json
+type ResponsePaymentsTotals struct {
+	Totals []models.PaymentsMonthTotal `json:"totals"`
+}
+
 //easyjson:json
 type ResponseAvailablePosts struct {
 	AvailablePosts []models.AvailablePost `json:"available_posts"`
@@ -419,7 +669,17 @@ type ResponseCreatorCountSubscribers struct {
 
 //easyjson:json
 type ResponseCreatorTotalIncome struct {
-	TotalIncome float64 `json:"total_income"`
+	TotalIncome models.Money `json:"total_income"`
+}
+
+//easyjson:json
+type ResponseCreatorTrials struct {
+	models.TrialStats
+}
+
+//easyjson:json
+type ResponsePollResults struct {
+	models.PollResults
 }
 
 //easyjson:json
@@ -436,3 +696,8 @@ type ResponsePayToken struct {
 type ResponsePayAccount struct {
 	Account string `json:"account_number"`
 }
+
+//easyjson:json
+type ResponseSearchedPosts struct {
+	Posts []models.SearchedPost `json:"posts"`
+}
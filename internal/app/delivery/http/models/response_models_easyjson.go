@@ -9,6 +9,7 @@ import (
 	jwriter "github.com/mailru/easyjson/jwriter"
 	csrf_models "patreon/internal/app/csrf/csrf_models"
 	models "patreon/internal/app/models"
+	time "time"
 )
 
 // suppress unused package warning
@@ -210,25 +211,25 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels2(in *jlexer.Lexe
 			continue
 		}
 		switch key {
-		case "users":
+		case "subscribers":
 			if in.IsNull() {
 				in.Skip()
-				out.Users = nil
+				out.Subscribers = nil
 			} else {
 				in.Delim('[')
-				if out.Users == nil {
+				if out.Subscribers == nil {
 					if !in.IsDelim(']') {
-						out.Users = make([]ResponseUser, 0, 1)
+						out.Subscribers = make([]models.CreatorSubscriber, 0, 0)
 					} else {
-						out.Users = []ResponseUser{}
+						out.Subscribers = []models.CreatorSubscriber{}
 					}
 				} else {
-					out.Users = (out.Users)[:0]
+					out.Subscribers = (out.Subscribers)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v4 ResponseUser
-					(v4).UnmarshalEasyJSON(in)
-					out.Users = append(out.Users, v4)
+					var v4 models.CreatorSubscriber
+					easyjson316682a0DecodePatreonInternalAppModels(in, &v4)
+					out.Subscribers = append(out.Subscribers, v4)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -252,17 +253,17 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels2(out *jwriter.Wr
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"users\":"
+		const prefix string = ",\"subscribers\":"
 		out.RawString(prefix[1:])
-		if in.Users == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Subscribers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v5, v6 := range in.Users {
+			for v5, v6 := range in.Subscribers {
 				if v5 > 0 {
 					out.RawByte(',')
 				}
-				(v6).MarshalEasyJSON(out)
+				easyjson316682a0EncodePatreonInternalAppModels(out, v6)
 			}
 			out.RawByte(']')
 		}
@@ -293,6 +294,119 @@ func (v *SubscribersCreatorResponse) UnmarshalJSON(data []byte) error {
 func (v *SubscribersCreatorResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels2(l, v)
 }
+func easyjson316682a0DecodePatreonInternalAppModels(in *jlexer.Lexer, out *models.CreatorSubscriber) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = int64(in.Int64())
+		case "nickname":
+			out.Nickname = string(in.String())
+		case "avatar":
+			out.Avatar = string(in.String())
+		case "award_id":
+			out.AwardID = int64(in.Int64())
+		case "award_name":
+			out.AwardName = string(in.String())
+		case "status":
+			out.Status = models.SubscriptionStatus(in.String())
+		case "subscribed_since":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.SubscribedSince).UnmarshalJSON(data))
+			}
+		case "lifetime_amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.LifetimeAmount).UnmarshalJSON(data))
+			}
+		case "currency":
+			out.Currency = string(in.String())
+		case "months_subscribed":
+			out.MonthsSubscribed = int64(in.Int64())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppModels(out *jwriter.Writer, in models.CreatorSubscriber) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.UserID))
+	}
+	{
+		const prefix string = ",\"nickname\":"
+		out.RawString(prefix)
+		out.String(string(in.Nickname))
+	}
+	{
+		const prefix string = ",\"avatar\":"
+		out.RawString(prefix)
+		out.String(string(in.Avatar))
+	}
+	{
+		const prefix string = ",\"award_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AwardID))
+	}
+	{
+		const prefix string = ",\"award_name\":"
+		out.RawString(prefix)
+		out.String(string(in.AwardName))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"subscribed_since\":"
+		out.RawString(prefix)
+		out.Raw((in.SubscribedSince).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"lifetime_amount\":"
+		out.RawString(prefix)
+		out.Raw((in.LifetimeAmount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"months_subscribed\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MonthsSubscribed))
+	}
+	out.RawByte('}')
+}
 func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels3(in *jlexer.Lexer, out *ResponseUserPayments) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
@@ -329,7 +443,7 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels3(in *jlexer.Lexe
 				}
 				for !in.IsDelim(']') {
 					var v7 models.UserPayments
-					easyjson316682a0DecodePatreonInternalAppModels(in, &v7)
+					easyjson316682a0DecodePatreonInternalAppModels1(in, &v7)
 					out.Payments = append(out.Payments, v7)
 					in.WantComma()
 				}
@@ -364,7 +478,7 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels3(out *jwriter.Wr
 				if v8 > 0 {
 					out.RawByte(',')
 				}
-				easyjson316682a0EncodePatreonInternalAppModels(out, v9)
+				easyjson316682a0EncodePatreonInternalAppModels1(out, v9)
 			}
 			out.RawByte(']')
 		}
@@ -395,7 +509,7 @@ func (v *ResponseUserPayments) UnmarshalJSON(data []byte) error {
 func (v *ResponseUserPayments) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels3(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppModels(in *jlexer.Lexer, out *models.UserPayments) {
+func easyjson316682a0DecodePatreonInternalAppModels1(in *jlexer.Lexer, out *models.UserPayments) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -420,8 +534,18 @@ func easyjson316682a0DecodePatreonInternalAppModels(in *jlexer.Lexer, out *model
 			out.CreatorCategory = string(in.String())
 		case "creator_description":
 			out.CreatorDescription = string(in.String())
+		case "gift_from":
+			out.GiftFrom = string(in.String())
+		case "gift_to":
+			out.GiftTo = string(in.String())
+		case "id":
+			out.ID = int64(in.Int64())
 		case "amount":
-			out.Amount = float64(in.Float64())
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		case "currency":
+			out.Currency = string(in.String())
 		case "date":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.Date).UnmarshalJSON(data))
@@ -430,8 +554,47 @@ func easyjson316682a0DecodePatreonInternalAppModels(in *jlexer.Lexer, out *model
 			out.CreatorID = int64(in.Int64())
 		case "user_id":
 			out.UserID = int64(in.Int64())
-		case "status":
-			out.Status = bool(in.Bool())
+		case "state":
+			out.State = models.PaymentState(in.String())
+		case "type":
+			out.Type = models.PaymentType(in.String())
+		case "events":
+			if in.IsNull() {
+				in.Skip()
+				out.Events = nil
+			} else {
+				in.Delim('[')
+				if out.Events == nil {
+					if !in.IsDelim(']') {
+						out.Events = make([]models.PaymentEvent, 0, 0)
+					} else {
+						out.Events = []models.PaymentEvent{}
+					}
+				} else {
+					out.Events = (out.Events)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 models.PaymentEvent
+					easyjson316682a0DecodePatreonInternalAppModels2(in, &v10)
+					out.Events = append(out.Events, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "refunded_amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.RefundedAmount).UnmarshalJSON(data))
+			}
+		case "promo_code":
+			out.PromoCode = string(in.String())
+		case "discount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Discount).UnmarshalJSON(data))
+			}
+		case "gift_id":
+			out.GiftID = int64(in.Int64())
+		case "tip_id":
+			out.TipID = int64(in.Int64())
 		default:
 			in.AddError(&jlexer.LexerError{
 				Offset: in.GetPos(),
@@ -446,7 +609,7 @@ func easyjson316682a0DecodePatreonInternalAppModels(in *jlexer.Lexer, out *model
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppModels(out *jwriter.Writer, in models.UserPayments) {
+func easyjson316682a0EncodePatreonInternalAppModels1(out *jwriter.Writer, in models.UserPayments) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -465,10 +628,30 @@ func easyjson316682a0EncodePatreonInternalAppModels(out *jwriter.Writer, in mode
 		out.RawString(prefix)
 		out.String(string(in.CreatorDescription))
 	}
+	if in.GiftFrom != "" {
+		const prefix string = ",\"gift_from\":"
+		out.RawString(prefix)
+		out.String(string(in.GiftFrom))
+	}
+	if in.GiftTo != "" {
+		const prefix string = ",\"gift_to\":"
+		out.RawString(prefix)
+		out.String(string(in.GiftTo))
+	}
+	if in.ID != 0 {
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ID))
+	}
 	{
 		const prefix string = ",\"amount\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.Amount))
+		out.Raw((in.Amount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
 	}
 	{
 		const prefix string = ",\"date\":"
@@ -486,9 +669,124 @@ func easyjson316682a0EncodePatreonInternalAppModels(out *jwriter.Writer, in mode
 		out.Int64(int64(in.UserID))
 	}
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"state\":"
+		out.RawString(prefix)
+		out.String(string(in.State))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"events\":"
+		out.RawString(prefix)
+		if in.Events == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.Events {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				easyjson316682a0EncodePatreonInternalAppModels2(out, v12)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.RefundedAmount != 0 {
+		const prefix string = ",\"refunded_amount\":"
+		out.RawString(prefix)
+		out.Raw((in.RefundedAmount).MarshalJSON())
+	}
+	if in.PromoCode != "" {
+		const prefix string = ",\"promo_code\":"
+		out.RawString(prefix)
+		out.String(string(in.PromoCode))
+	}
+	if in.Discount != 0 {
+		const prefix string = ",\"discount\":"
+		out.RawString(prefix)
+		out.Raw((in.Discount).MarshalJSON())
+	}
+	if in.GiftID != 0 {
+		const prefix string = ",\"gift_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.GiftID))
+	}
+	if in.TipID != 0 {
+		const prefix string = ",\"tip_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TipID))
+	}
+	out.RawByte('}')
+}
+func easyjson316682a0DecodePatreonInternalAppModels2(in *jlexer.Lexer, out *models.PaymentEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "from_state":
+			out.FromState = models.PaymentState(in.String())
+		case "to_state":
+			out.ToState = models.PaymentState(in.String())
+		case "reason":
+			out.Reason = string(in.String())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppModels2(out *jwriter.Writer, in models.PaymentEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"from_state\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.FromState))
+	}
+	{
+		const prefix string = ",\"to_state\":"
+		out.RawString(prefix)
+		out.String(string(in.ToState))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	{
+		const prefix string = ",\"date\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Status))
+		out.Raw((in.Date).MarshalJSON())
 	}
 	out.RawByte('}')
 }
@@ -527,9 +825,9 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels4(in *jlexer.Lexe
 					out.Comments = (out.Comments)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v10 ResponseUserComment
-					(v10).UnmarshalEasyJSON(in)
-					out.Comments = append(out.Comments, v10)
+					var v13 ResponseUserComment
+					(v13).UnmarshalEasyJSON(in)
+					out.Comments = append(out.Comments, v13)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -559,11 +857,11 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels4(out *jwriter.Wr
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v11, v12 := range in.Comments {
-				if v11 > 0 {
+			for v14, v15 := range in.Comments {
+				if v14 > 0 {
 					out.RawByte(',')
 				}
-				(v12).MarshalEasyJSON(out)
+				(v15).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -799,7 +1097,7 @@ func (v *ResponseUser) UnmarshalJSON(data []byte) error {
 func (v *ResponseUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels6(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels7(in *jlexer.Lexer, out *ResponsePosts) {
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels7(in *jlexer.Lexer, out *ResponseTrial) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -818,28 +1116,19 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels7(in *jlexer.Lexe
 			continue
 		}
 		switch key {
-		case "posts":
-			if in.IsNull() {
-				in.Skip()
-				out.Posts = nil
-			} else {
-				in.Delim('[')
-				if out.Posts == nil {
-					if !in.IsDelim(']') {
-						out.Posts = make([]ResponsePost, 0, 0)
-					} else {
-						out.Posts = []ResponsePost{}
-					}
-				} else {
-					out.Posts = (out.Posts)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v13 ResponsePost
-					(v13).UnmarshalEasyJSON(in)
-					out.Posts = append(out.Posts, v13)
-					in.WantComma()
-				}
-				in.Delim(']')
+		case "id":
+			out.ID = int64(in.Int64())
+		case "creator_id":
+			out.CreatorID = int64(in.Int64())
+		case "award_id":
+			out.AwardID = int64(in.Int64())
+		case "ends_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.EndsAt).UnmarshalJSON(data))
+			}
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
 			}
 		default:
 			in.AddError(&jlexer.LexerError{
@@ -855,53 +1144,62 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels7(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels7(out *jwriter.Writer, in ResponsePosts) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels7(out *jwriter.Writer, in ResponseTrial) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"posts\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		if in.Posts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v14, v15 := range in.Posts {
-				if v14 > 0 {
-					out.RawByte(',')
-				}
-				(v15).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.Int64(int64(in.ID))
 	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v ResponsePosts) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
+	{
+		const prefix string = ",\"creator_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CreatorID))
+	}
+	{
+		const prefix string = ",\"award_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AwardID))
+	}
+	{
+		const prefix string = ",\"ends_at\":"
+		out.RawString(prefix)
+		out.Raw((in.EndsAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseTrial) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
 	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ResponsePosts) MarshalEasyJSON(w *jwriter.Writer) {
+func (v ResponseTrial) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ResponsePosts) UnmarshalJSON(data []byte) error {
+func (v *ResponseTrial) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ResponsePosts) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *ResponseTrial) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels7(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels8(in *jlexer.Lexer, out *ResponsePostWithAttaches) {
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels8(in *jlexer.Lexer, out *ResponseTip) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -920,30 +1218,27 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels8(in *jlexer.Lexe
 			continue
 		}
 		switch key {
-		case "post":
-			(out.Post).UnmarshalEasyJSON(in)
-		case "attaches":
-			if in.IsNull() {
-				in.Skip()
-				out.Data = nil
-			} else {
-				in.Delim('[')
-				if out.Data == nil {
-					if !in.IsDelim(']') {
-						out.Data = make([]ResponseAttach, 0, 1)
-					} else {
-						out.Data = []ResponseAttach{}
-					}
-				} else {
-					out.Data = (out.Data)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v16 ResponseAttach
-					(v16).UnmarshalEasyJSON(in)
-					out.Data = append(out.Data, v16)
-					in.WantComma()
-				}
-				in.Delim(']')
+		case "id":
+			out.ID = int64(in.Int64())
+		case "user_id":
+			out.UserID = int64(in.Int64())
+		case "creator_id":
+			out.CreatorID = int64(in.Int64())
+		case "post_id":
+			out.PostID = int64(in.Int64())
+		case "amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		case "currency":
+			out.Currency = string(in.String())
+		case "message":
+			out.Message = string(in.String())
+		case "pay_token":
+			out.PayToken = string(in.String())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
 			}
 		default:
 			in.AddError(&jlexer.LexerError{
@@ -959,58 +1254,82 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels8(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels8(out *jwriter.Writer, in ResponsePostWithAttaches) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels8(out *jwriter.Writer, in ResponseTip) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"post\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		(in.Post).MarshalEasyJSON(out)
+		out.Int64(int64(in.ID))
 	}
 	{
-		const prefix string = ",\"attaches\":"
+		const prefix string = ",\"user_id\":"
 		out.RawString(prefix)
-		if in.Data == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v17, v18 := range in.Data {
-				if v17 > 0 {
-					out.RawByte(',')
-				}
-				(v18).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.Int64(int64(in.UserID))
+	}
+	{
+		const prefix string = ",\"creator_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CreatorID))
+	}
+	if in.PostID != 0 {
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PostID))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.Raw((in.Amount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	if in.Message != "" {
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	if in.PayToken != "" {
+		const prefix string = ",\"pay_token\":"
+		out.RawString(prefix)
+		out.String(string(in.PayToken))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ResponsePostWithAttaches) MarshalJSON() ([]byte, error) {
+func (v ResponseTip) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ResponsePostWithAttaches) MarshalEasyJSON(w *jwriter.Writer) {
+func (v ResponseTip) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ResponsePostWithAttaches) UnmarshalJSON(data []byte) error {
+func (v *ResponseTip) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ResponsePostWithAttaches) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *ResponseTip) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels8(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels9(in *jlexer.Lexer, out *ResponsePostComments) {
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels9(in *jlexer.Lexer, out *ResponseTierChanges) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1029,25 +1348,25 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels9(in *jlexer.Lexe
 			continue
 		}
 		switch key {
-		case "comments":
+		case "changes":
 			if in.IsNull() {
 				in.Skip()
-				out.Comments = nil
+				out.Changes = nil
 			} else {
 				in.Delim('[')
-				if out.Comments == nil {
+				if out.Changes == nil {
 					if !in.IsDelim(']') {
-						out.Comments = make([]ResponsePostComment, 0, 0)
+						out.Changes = make([]models.TierChange, 0, 0)
 					} else {
-						out.Comments = []ResponsePostComment{}
+						out.Changes = []models.TierChange{}
 					}
 				} else {
-					out.Comments = (out.Comments)[:0]
+					out.Changes = (out.Changes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v19 ResponsePostComment
-					(v19).UnmarshalEasyJSON(in)
-					out.Comments = append(out.Comments, v19)
+					var v16 models.TierChange
+					easyjson316682a0DecodePatreonInternalAppModels3(in, &v16)
+					out.Changes = append(out.Changes, v16)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1066,22 +1385,22 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels9(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels9(out *jwriter.Writer, in ResponsePostComments) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels9(out *jwriter.Writer, in ResponseTierChanges) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"comments\":"
+		const prefix string = ",\"changes\":"
 		out.RawString(prefix[1:])
-		if in.Comments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Changes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v20, v21 := range in.Comments {
-				if v20 > 0 {
+			for v17, v18 := range in.Changes {
+				if v17 > 0 {
 					out.RawByte(',')
 				}
-				(v21).MarshalEasyJSON(out)
+				easyjson316682a0EncodePatreonInternalAppModels3(out, v18)
 			}
 			out.RawByte(']')
 		}
@@ -1090,29 +1409,29 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels9(out *jwriter.Wr
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ResponsePostComments) MarshalJSON() ([]byte, error) {
+func (v ResponseTierChanges) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ResponsePostComments) MarshalEasyJSON(w *jwriter.Writer) {
+func (v ResponseTierChanges) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ResponsePostComments) UnmarshalJSON(data []byte) error {
+func (v *ResponseTierChanges) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ResponsePostComments) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *ResponseTierChanges) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels9(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels10(in *jlexer.Lexer, out *ResponsePostComment) {
+func easyjson316682a0DecodePatreonInternalAppModels3(in *jlexer.Lexer, out *models.TierChange) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1131,22 +1450,34 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels10(in *jlexer.Lex
 			continue
 		}
 		switch key {
-		case "comment_id":
+		case "id":
 			out.ID = int64(in.Int64())
-		case "body":
-			out.Body = string(in.String())
-		case "as_creator":
-			out.AsCreator = bool(in.Bool())
-		case "author_id":
-			out.AuthorId = int64(in.Int64())
+		case "creator_id":
+			out.CreatorID = int64(in.Int64())
+		case "from_award_id":
+			out.FromAwardID = int64(in.Int64())
+		case "to_award_id":
+			out.ToAwardID = int64(in.Int64())
+		case "kind":
+			out.Kind = models.TierChangeKind(in.String())
+		case "status":
+			out.Status = models.TierChangeStatus(in.String())
+		case "amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		case "currency":
+			out.Currency = string(in.String())
+		case "pay_token":
+			out.PayToken = string(in.String())
+		case "effective_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.EffectiveAt).UnmarshalJSON(data))
+			}
 		case "date":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.Date).UnmarshalJSON(data))
 			}
-		case "author_nickname":
-			out.AuthorNickname = string(in.String())
-		case "author_avatar":
-			out.AuthorAvatar = string(in.String())
 		default:
 			in.AddError(&jlexer.LexerError{
 				Offset: in.GetPos(),
@@ -1161,72 +1492,68 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels10(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels10(out *jwriter.Writer, in ResponsePostComment) {
+func easyjson316682a0EncodePatreonInternalAppModels3(out *jwriter.Writer, in models.TierChange) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"comment_id\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
 		out.Int64(int64(in.ID))
 	}
 	{
-		const prefix string = ",\"body\":"
+		const prefix string = ",\"creator_id\":"
 		out.RawString(prefix)
-		out.String(string(in.Body))
+		out.Int64(int64(in.CreatorID))
 	}
-	if in.AsCreator {
-		const prefix string = ",\"as_creator\":"
+	{
+		const prefix string = ",\"from_award_id\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.AsCreator))
+		out.Int64(int64(in.FromAwardID))
 	}
 	{
-		const prefix string = ",\"author_id\":"
+		const prefix string = ",\"to_award_id\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.AuthorId))
+		out.Int64(int64(in.ToAwardID))
 	}
 	{
-		const prefix string = ",\"date\":"
+		const prefix string = ",\"kind\":"
 		out.RawString(prefix)
-		out.Raw((in.Date).MarshalJSON())
+		out.String(string(in.Kind))
 	}
 	{
-		const prefix string = ",\"author_nickname\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix)
-		out.String(string(in.AuthorNickname))
+		out.String(string(in.Status))
 	}
 	{
-		const prefix string = ",\"author_avatar\":"
+		const prefix string = ",\"amount\":"
 		out.RawString(prefix)
-		out.String(string(in.AuthorAvatar))
+		out.Raw((in.Amount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	if in.PayToken != "" {
+		const prefix string = ",\"pay_token\":"
+		out.RawString(prefix)
+		out.String(string(in.PayToken))
+	}
+	{
+		const prefix string = ",\"effective_at\":"
+		out.RawString(prefix)
+		out.Raw((in.EffectiveAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v ResponsePostComment) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels10(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ResponsePostComment) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels10(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *ResponsePostComment) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels10(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ResponsePostComment) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels10(l, v)
-}
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels11(in *jlexer.Lexer, out *ResponsePost) {
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels10(in *jlexer.Lexer, out *ResponseTierChange) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1245,30 +1572,34 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels11(in *jlexer.Lex
 			continue
 		}
 		switch key {
-		case "posts_id":
+		case "id":
 			out.ID = int64(in.Int64())
-		case "title":
-			out.Title = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "type_awards":
-			out.Awards = int64(in.Int64())
-		case "likes":
-			out.Likes = int64(in.Int64())
-		case "cover":
-			out.Cover = string(in.String())
-		case "add_like":
-			out.AddLike = bool(in.Bool())
-		case "views":
-			out.Views = int64(in.Int64())
-		case "comments":
-			out.Comments = int64(in.Int64())
+		case "creator_id":
+			out.CreatorID = int64(in.Int64())
+		case "from_award_id":
+			out.FromAwardID = int64(in.Int64())
+		case "to_award_id":
+			out.ToAwardID = int64(in.Int64())
+		case "kind":
+			out.Kind = models.TierChangeKind(in.String())
+		case "status":
+			out.Status = models.TierChangeStatus(in.String())
+		case "amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		case "currency":
+			out.Currency = string(in.String())
+		case "pay_token":
+			out.PayToken = string(in.String())
+		case "effective_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.EffectiveAt).UnmarshalJSON(data))
+			}
 		case "date":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.Date).UnmarshalJSON(data))
 			}
-		case "is_draft":
-			out.IsDraft = bool(in.Bool())
 		default:
 			in.AddError(&jlexer.LexerError{
 				Offset: in.GetPos(),
@@ -1283,92 +1614,92 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels11(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels11(out *jwriter.Writer, in ResponsePost) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels10(out *jwriter.Writer, in ResponseTierChange) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"posts_id\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
 		out.Int64(int64(in.ID))
 	}
 	{
-		const prefix string = ",\"title\":"
+		const prefix string = ",\"creator_id\":"
 		out.RawString(prefix)
-		out.String(string(in.Title))
+		out.Int64(int64(in.CreatorID))
 	}
 	{
-		const prefix string = ",\"description\":"
+		const prefix string = ",\"from_award_id\":"
 		out.RawString(prefix)
-		out.String(string(in.Description))
+		out.Int64(int64(in.FromAwardID))
 	}
-	if in.Awards != 0 {
-		const prefix string = ",\"type_awards\":"
+	{
+		const prefix string = ",\"to_award_id\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Awards))
+		out.Int64(int64(in.ToAwardID))
 	}
 	{
-		const prefix string = ",\"likes\":"
+		const prefix string = ",\"kind\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Likes))
+		out.String(string(in.Kind))
 	}
 	{
-		const prefix string = ",\"cover\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix)
-		out.String(string(in.Cover))
+		out.String(string(in.Status))
 	}
-	if in.AddLike {
-		const prefix string = ",\"add_like\":"
+	{
+		const prefix string = ",\"amount\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.AddLike))
+		out.Raw((in.Amount).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"views\":"
+		const prefix string = ",\"currency\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Views))
+		out.String(string(in.Currency))
+	}
+	if in.PayToken != "" {
+		const prefix string = ",\"pay_token\":"
+		out.RawString(prefix)
+		out.String(string(in.PayToken))
 	}
 	{
-		const prefix string = ",\"comments\":"
+		const prefix string = ",\"effective_at\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Comments))
+		out.Raw((in.EffectiveAt).MarshalJSON())
 	}
 	{
 		const prefix string = ",\"date\":"
 		out.RawString(prefix)
 		out.Raw((in.Date).MarshalJSON())
 	}
-	if in.IsDraft {
-		const prefix string = ",\"is_draft\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsDraft))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ResponsePost) MarshalJSON() ([]byte, error) {
+func (v ResponseTierChange) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels11(&w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ResponsePost) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels11(w, v)
+func (v ResponseTierChange) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ResponsePost) UnmarshalJSON(data []byte) error {
+func (v *ResponseTierChange) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels11(&r, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ResponsePost) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels11(l, v)
+func (v *ResponseTierChange) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels10(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels12(in *jlexer.Lexer, out *ResponsePayToken) {
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels11(in *jlexer.Lexer, out *ResponseTags) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1387,8 +1718,29 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels12(in *jlexer.Lex
 			continue
 		}
 		switch key {
-		case "token":
-			out.PayToken = string(in.String())
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]models.Tag, 0, 2)
+					} else {
+						out.Tags = []models.Tag{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 models.Tag
+					easyjson316682a0DecodePatreonInternalAppModels4(in, &v19)
+					out.Tags = append(out.Tags, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.AddError(&jlexer.LexerError{
 				Offset: in.GetPos(),
@@ -1403,42 +1755,53 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels12(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels12(out *jwriter.Writer, in ResponsePayToken) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels11(out *jwriter.Writer, in ResponseTags) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"token\":"
+		const prefix string = ",\"tags\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.PayToken))
+		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v20, v21 := range in.Tags {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				easyjson316682a0EncodePatreonInternalAppModels4(out, v21)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ResponsePayToken) MarshalJSON() ([]byte, error) {
+func (v ResponseTags) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels12(&w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ResponsePayToken) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels12(w, v)
+func (v ResponseTags) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ResponsePayToken) UnmarshalJSON(data []byte) error {
+func (v *ResponseTags) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels12(&r, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ResponsePayToken) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels12(l, v)
+func (v *ResponseTags) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels11(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels13(in *jlexer.Lexer, out *ResponsePayAccount) {
+func easyjson316682a0DecodePatreonInternalAppModels4(in *jlexer.Lexer, out *models.Tag) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1457,8 +1820,10 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels13(in *jlexer.Lex
 			continue
 		}
 		switch key {
-		case "account_number":
-			out.Account = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "posts":
+			out.Posts = int64(in.Int64())
 		default:
 			in.AddError(&jlexer.LexerError{
 				Offset: in.GetPos(),
@@ -1473,42 +1838,23 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels13(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels13(out *jwriter.Writer, in ResponsePayAccount) {
+func easyjson316682a0EncodePatreonInternalAppModels4(out *jwriter.Writer, in models.Tag) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"account_number\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Account))
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"posts\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Posts))
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v ResponsePayAccount) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels13(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ResponsePayAccount) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels13(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *ResponsePayAccount) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels13(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ResponsePayAccount) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels13(l, v)
-}
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels14(in *jlexer.Lexer, out *ResponseLike) {
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels12(in *jlexer.Lexer, out *ResponseSubscriptionEvents) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1527,8 +1873,29 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels14(in *jlexer.Lex
 			continue
 		}
 		switch key {
-		case "likes":
-			out.Likes = int64(in.Int64())
+		case "events":
+			if in.IsNull() {
+				in.Skip()
+				out.Events = nil
+			} else {
+				in.Delim('[')
+				if out.Events == nil {
+					if !in.IsDelim(']') {
+						out.Events = make([]models.SubscriptionEvent, 0, 0)
+					} else {
+						out.Events = []models.SubscriptionEvent{}
+					}
+				} else {
+					out.Events = (out.Events)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v22 models.SubscriptionEvent
+					easyjson316682a0DecodePatreonInternalAppModels5(in, &v22)
+					out.Events = append(out.Events, v22)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.AddError(&jlexer.LexerError{
 				Offset: in.GetPos(),
@@ -1543,42 +1910,53 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels14(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels14(out *jwriter.Writer, in ResponseLike) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels12(out *jwriter.Writer, in ResponseSubscriptionEvents) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"likes\":"
+		const prefix string = ",\"events\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.Likes))
+		if in.Events == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v23, v24 := range in.Events {
+				if v23 > 0 {
+					out.RawByte(',')
+				}
+				easyjson316682a0EncodePatreonInternalAppModels5(out, v24)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ResponseLike) MarshalJSON() ([]byte, error) {
+func (v ResponseSubscriptionEvents) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels14(&w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels12(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ResponseLike) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels14(w, v)
+func (v ResponseSubscriptionEvents) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels12(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ResponseLike) UnmarshalJSON(data []byte) error {
+func (v *ResponseSubscriptionEvents) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels14(&r, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels12(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ResponseLike) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels14(l, v)
+func (v *ResponseSubscriptionEvents) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels12(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels15(in *jlexer.Lexer, out *ResponseInfo) {
+func easyjson316682a0DecodePatreonInternalAppModels5(in *jlexer.Lexer, out *models.SubscriptionEvent) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1597,48 +1975,136 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels15(in *jlexer.Lex
 			continue
 		}
 		switch key {
-		case "category":
-			if in.IsNull() {
-				in.Skip()
-				out.Category = nil
-			} else {
-				in.Delim('[')
-				if out.Category == nil {
-					if !in.IsDelim(']') {
-						out.Category = make([]string, 0, 4)
-					} else {
-						out.Category = []string{}
-					}
-				} else {
-					out.Category = (out.Category)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v22 string
-					v22 = string(in.String())
-					out.Category = append(out.Category, v22)
-					in.WantComma()
-				}
-				in.Delim(']')
+		case "id":
+			out.ID = int64(in.Int64())
+		case "user_id":
+			out.UserID = int64(in.Int64())
+		case "nickname":
+			out.Nickname = string(in.String())
+		case "creator_id":
+			out.CreatorID = int64(in.Int64())
+		case "creator_nickname":
+			out.CreatorNickname = string(in.String())
+		case "award_id":
+			out.AwardID = int64(in.Int64())
+		case "award_name":
+			out.AwardName = string(in.String())
+		case "kind":
+			out.Kind = models.SubscriptionEventKind(in.String())
+		case "reason":
+			out.Reason = string(in.String())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
 			}
-		case "type_post_data":
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppModels5(out *jwriter.Writer, in models.SubscriptionEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UserID))
+	}
+	if in.Nickname != "" {
+		const prefix string = ",\"nickname\":"
+		out.RawString(prefix)
+		out.String(string(in.Nickname))
+	}
+	{
+		const prefix string = ",\"creator_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CreatorID))
+	}
+	if in.CreatorNickname != "" {
+		const prefix string = ",\"creator_nickname\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatorNickname))
+	}
+	if in.AwardID != 0 {
+		const prefix string = ",\"award_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AwardID))
+	}
+	if in.AwardName != "" {
+		const prefix string = ",\"award_name\":"
+		out.RawString(prefix)
+		out.String(string(in.AwardName))
+	}
+	{
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix)
+		out.String(string(in.Kind))
+	}
+	if in.Reason != "" {
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels13(in *jlexer.Lexer, out *ResponseSearchedPosts) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "posts":
 			if in.IsNull() {
 				in.Skip()
-				out.TypePostData = nil
+				out.Posts = nil
 			} else {
 				in.Delim('[')
-				if out.TypePostData == nil {
+				if out.Posts == nil {
 					if !in.IsDelim(']') {
-						out.TypePostData = make([]string, 0, 4)
+						out.Posts = make([]models.SearchedPost, 0, 0)
 					} else {
-						out.TypePostData = []string{}
+						out.Posts = []models.SearchedPost{}
 					}
 				} else {
-					out.TypePostData = (out.TypePostData)[:0]
+					out.Posts = (out.Posts)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v23 string
-					v23 = string(in.String())
-					out.TypePostData = append(out.TypePostData, v23)
+					var v25 models.SearchedPost
+					easyjson316682a0DecodePatreonInternalAppModels6(in, &v25)
+					out.Posts = append(out.Posts, v25)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1657,38 +2123,4926 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels15(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels15(out *jwriter.Writer, in ResponseInfo) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels13(out *jwriter.Writer, in ResponseSearchedPosts) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"category\":"
+		const prefix string = ",\"posts\":"
 		out.RawString(prefix[1:])
-		if in.Category == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Posts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v24, v25 := range in.Category {
-				if v24 > 0 {
+			for v26, v27 := range in.Posts {
+				if v26 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v25))
+				easyjson316682a0EncodePatreonInternalAppModels6(out, v27)
 			}
 			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"type_post_data\":"
-		out.RawString(prefix)
-		if in.TypePostData == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseSearchedPosts) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseSearchedPosts) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseSearchedPosts) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseSearchedPosts) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels13(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppModels6(in *jlexer.Lexer, out *models.SearchedPost) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "snippet":
+			out.Snippet = string(in.String())
+		case "creator_nickname":
+			out.CreatorNickname = string(in.String())
+		case "posts_id":
+			out.ID = int64(in.Int64())
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "type_awards":
+			out.Awards = int64(in.Int64())
+		case "likes":
+			out.Likes = int64(in.Int64())
+		case "cover":
+			out.Cover = string(in.String())
+		case "creator_id":
+			out.CreatorId = int64(in.Int64())
+		case "views":
+			out.Views = int64(in.Int64())
+		case "comments":
+			out.Comments = int64(in.Int64())
+		case "add_like":
+			out.AddLike = bool(in.Bool())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		case "is_draft":
+			out.IsDraft = bool(in.Bool())
+		case "unlock_price":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UnlockPrice).UnmarshalJSON(data))
+			}
+		case "unlocked":
+			out.Unlocked = bool(in.Bool())
+		case "publish_at":
+			if in.IsNull() {
+				in.Skip()
+				out.PublishAt = nil
+			} else {
+				if out.PublishAt == nil {
+					out.PublishAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.PublishAt).UnmarshalJSON(data))
+				}
+			}
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v28 string
+					v28 = string(in.String())
+					out.Tags = append(out.Tags, v28)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "preview":
+			out.Preview = string(in.String())
+		case "locked":
+			out.Locked = bool(in.Bool())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppModels6(out *jwriter.Writer, in models.SearchedPost) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"snippet\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Snippet))
+	}
+	{
+		const prefix string = ",\"creator_nickname\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatorNickname))
+	}
+	{
+		const prefix string = ",\"posts_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"type_awards\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Awards))
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Likes))
+	}
+	{
+		const prefix string = ",\"cover\":"
+		out.RawString(prefix)
+		out.String(string(in.Cover))
+	}
+	{
+		const prefix string = ",\"creator_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CreatorId))
+	}
+	{
+		const prefix string = ",\"views\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Views))
+	}
+	{
+		const prefix string = ",\"comments\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Comments))
+	}
+	{
+		const prefix string = ",\"add_like\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AddLike))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"is_draft\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsDraft))
+	}
+	{
+		const prefix string = ",\"unlock_price\":"
+		out.RawString(prefix)
+		out.Raw((in.UnlockPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"unlocked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Unlocked))
+	}
+	if in.PublishAt != nil {
+		const prefix string = ",\"publish_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.PublishAt).MarshalJSON())
+	}
+	if len(in.Tags) != 0 {
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v29, v30 := range in.Tags {
+				if v29 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v30))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"preview\":"
+		out.RawString(prefix)
+		out.String(string(in.Preview))
+	}
+	{
+		const prefix string = ",\"locked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Locked))
+	}
+	out.RawByte('}')
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels14(in *jlexer.Lexer, out *ResponsePromoCodes) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "promo_codes":
+			if in.IsNull() {
+				in.Skip()
+				out.PromoCodes = nil
+			} else {
+				in.Delim('[')
+				if out.PromoCodes == nil {
+					if !in.IsDelim(']') {
+						out.PromoCodes = make([]models.PromoCode, 0, 0)
+					} else {
+						out.PromoCodes = []models.PromoCode{}
+					}
+				} else {
+					out.PromoCodes = (out.PromoCodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v31 models.PromoCode
+					easyjson316682a0DecodePatreonInternalAppModels7(in, &v31)
+					out.PromoCodes = append(out.PromoCodes, v31)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels14(out *jwriter.Writer, in ResponsePromoCodes) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"promo_codes\":"
+		out.RawString(prefix[1:])
+		if in.PromoCodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v32, v33 := range in.PromoCodes {
+				if v32 > 0 {
+					out.RawByte(',')
+				}
+				easyjson316682a0EncodePatreonInternalAppModels7(out, v33)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponsePromoCodes) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponsePromoCodes) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponsePromoCodes) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponsePromoCodes) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels14(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppModels7(in *jlexer.Lexer, out *models.PromoCode) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "creator_id":
+			out.CreatorID = int64(in.Int64())
+		case "code":
+			out.Code = string(in.String())
+		case "discount_type":
+			out.DiscountType = models.DiscountType(in.String())
+		case "discount":
+			out.Discount = int64(in.Int64())
+		case "award_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.AwardIDs = nil
+			} else {
+				in.Delim('[')
+				if out.AwardIDs == nil {
+					if !in.IsDelim(']') {
+						out.AwardIDs = make([]int64, 0, 8)
+					} else {
+						out.AwardIDs = []int64{}
+					}
+				} else {
+					out.AwardIDs = (out.AwardIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v34 int64
+					v34 = int64(in.Int64())
+					out.AwardIDs = append(out.AwardIDs, v34)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "max_uses":
+			out.MaxUses = int64(in.Int64())
+		case "uses":
+			out.Uses = int64(in.Int64())
+		case "valid_from":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ValidFrom).UnmarshalJSON(data))
+			}
+		case "valid_until":
+			if in.IsNull() {
+				in.Skip()
+				out.ValidUntil = nil
+			} else {
+				if out.ValidUntil == nil {
+					out.ValidUntil = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ValidUntil).UnmarshalJSON(data))
+				}
+			}
+		case "active":
+			out.Active = bool(in.Bool())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppModels7(out *jwriter.Writer, in models.PromoCode) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"creator_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CreatorID))
+	}
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix)
+		out.String(string(in.Code))
+	}
+	{
+		const prefix string = ",\"discount_type\":"
+		out.RawString(prefix)
+		out.String(string(in.DiscountType))
+	}
+	{
+		const prefix string = ",\"discount\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Discount))
+	}
+	{
+		const prefix string = ",\"award_ids\":"
+		out.RawString(prefix)
+		if in.AwardIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v35, v36 := range in.AwardIDs {
+				if v35 > 0 {
+					out.RawByte(',')
+				}
+				out.Int64(int64(v36))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"max_uses\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MaxUses))
+	}
+	{
+		const prefix string = ",\"uses\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Uses))
+	}
+	{
+		const prefix string = ",\"valid_from\":"
+		out.RawString(prefix)
+		out.Raw((in.ValidFrom).MarshalJSON())
+	}
+	if in.ValidUntil != nil {
+		const prefix string = ",\"valid_until\":"
+		out.RawString(prefix)
+		out.Raw((*in.ValidUntil).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"active\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Active))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels15(in *jlexer.Lexer, out *ResponsePromoCode) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "creator_id":
+			out.CreatorID = int64(in.Int64())
+		case "code":
+			out.Code = string(in.String())
+		case "discount_type":
+			out.DiscountType = models.DiscountType(in.String())
+		case "discount":
+			out.Discount = int64(in.Int64())
+		case "award_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.AwardIDs = nil
+			} else {
+				in.Delim('[')
+				if out.AwardIDs == nil {
+					if !in.IsDelim(']') {
+						out.AwardIDs = make([]int64, 0, 8)
+					} else {
+						out.AwardIDs = []int64{}
+					}
+				} else {
+					out.AwardIDs = (out.AwardIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v37 int64
+					v37 = int64(in.Int64())
+					out.AwardIDs = append(out.AwardIDs, v37)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "max_uses":
+			out.MaxUses = int64(in.Int64())
+		case "uses":
+			out.Uses = int64(in.Int64())
+		case "valid_from":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ValidFrom).UnmarshalJSON(data))
+			}
+		case "valid_until":
+			if in.IsNull() {
+				in.Skip()
+				out.ValidUntil = nil
+			} else {
+				if out.ValidUntil == nil {
+					out.ValidUntil = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ValidUntil).UnmarshalJSON(data))
+				}
+			}
+		case "active":
+			out.Active = bool(in.Bool())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels15(out *jwriter.Writer, in ResponsePromoCode) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"creator_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CreatorID))
+	}
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix)
+		out.String(string(in.Code))
+	}
+	{
+		const prefix string = ",\"discount_type\":"
+		out.RawString(prefix)
+		out.String(string(in.DiscountType))
+	}
+	{
+		const prefix string = ",\"discount\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Discount))
+	}
+	{
+		const prefix string = ",\"award_ids\":"
+		out.RawString(prefix)
+		if in.AwardIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v38, v39 := range in.AwardIDs {
+				if v38 > 0 {
+					out.RawByte(',')
+				}
+				out.Int64(int64(v39))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"max_uses\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MaxUses))
+	}
+	{
+		const prefix string = ",\"uses\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Uses))
+	}
+	{
+		const prefix string = ",\"valid_from\":"
+		out.RawString(prefix)
+		out.Raw((in.ValidFrom).MarshalJSON())
+	}
+	if in.ValidUntil != nil {
+		const prefix string = ",\"valid_until\":"
+		out.RawString(prefix)
+		out.Raw((*in.ValidUntil).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"active\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Active))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponsePromoCode) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponsePromoCode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponsePromoCode) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponsePromoCode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels15(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels16(in *jlexer.Lexer, out *ResponsePosts) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "posts":
+			if in.IsNull() {
+				in.Skip()
+				out.Posts = nil
+			} else {
+				in.Delim('[')
+				if out.Posts == nil {
+					if !in.IsDelim(']') {
+						out.Posts = make([]ResponsePost, 0, 0)
+					} else {
+						out.Posts = []ResponsePost{}
+					}
+				} else {
+					out.Posts = (out.Posts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v40 ResponsePost
+					(v40).UnmarshalEasyJSON(in)
+					out.Posts = append(out.Posts, v40)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels16(out *jwriter.Writer, in ResponsePosts) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"posts\":"
+		out.RawString(prefix[1:])
+		if in.Posts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v41, v42 := range in.Posts {
+				if v41 > 0 {
+					out.RawByte(',')
+				}
+				(v42).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponsePosts) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponsePosts) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponsePosts) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponsePosts) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels16(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels17(in *jlexer.Lexer, out *ResponsePostWithAttaches) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "post":
+			(out.Post).UnmarshalEasyJSON(in)
+		case "attaches":
+			if in.IsNull() {
+				in.Skip()
+				out.Data = nil
+			} else {
+				in.Delim('[')
+				if out.Data == nil {
+					if !in.IsDelim(']') {
+						out.Data = make([]ResponseAttach, 0, 1)
+					} else {
+						out.Data = []ResponseAttach{}
+					}
+				} else {
+					out.Data = (out.Data)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v43 ResponseAttach
+					(v43).UnmarshalEasyJSON(in)
+					out.Data = append(out.Data, v43)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels17(out *jwriter.Writer, in ResponsePostWithAttaches) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"post\":"
+		out.RawString(prefix[1:])
+		(in.Post).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"attaches\":"
+		out.RawString(prefix)
+		if in.Data == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v44, v45 := range in.Data {
+				if v44 > 0 {
+					out.RawByte(',')
+				}
+				(v45).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponsePostWithAttaches) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponsePostWithAttaches) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponsePostWithAttaches) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponsePostWithAttaches) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels17(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels18(in *jlexer.Lexer, out *ResponsePostUnlock) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "post_id":
+			out.PostID = int64(in.Int64())
+		case "user_id":
+			out.UserID = int64(in.Int64())
+		case "creator_id":
+			out.CreatorID = int64(in.Int64())
+		case "amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		case "currency":
+			out.Currency = string(in.String())
+		case "pay_token":
+			out.PayToken = string(in.String())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels18(out *jwriter.Writer, in ResponsePostUnlock) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.PostID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UserID))
+	}
+	{
+		const prefix string = ",\"creator_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CreatorID))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.Raw((in.Amount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	if in.PayToken != "" {
+		const prefix string = ",\"pay_token\":"
+		out.RawString(prefix)
+		out.String(string(in.PayToken))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponsePostUnlock) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponsePostUnlock) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponsePostUnlock) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponsePostUnlock) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels18(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels19(in *jlexer.Lexer, out *ResponsePostRevisionsDiff) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "changes":
+			if in.IsNull() {
+				in.Skip()
+				out.Changes = nil
+			} else {
+				in.Delim('[')
+				if out.Changes == nil {
+					if !in.IsDelim(']') {
+						out.Changes = make([]models.PostRevisionChange, 0, 1)
+					} else {
+						out.Changes = []models.PostRevisionChange{}
+					}
+				} else {
+					out.Changes = (out.Changes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v46 models.PostRevisionChange
+					easyjson316682a0DecodePatreonInternalAppModels8(in, &v46)
+					out.Changes = append(out.Changes, v46)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels19(out *jwriter.Writer, in ResponsePostRevisionsDiff) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"changes\":"
+		out.RawString(prefix[1:])
+		if in.Changes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v47, v48 := range in.Changes {
+				if v47 > 0 {
+					out.RawByte(',')
+				}
+				easyjson316682a0EncodePatreonInternalAppModels8(out, v48)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponsePostRevisionsDiff) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponsePostRevisionsDiff) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponsePostRevisionsDiff) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponsePostRevisionsDiff) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels19(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppModels8(in *jlexer.Lexer, out *models.PostRevisionChange) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "field":
+			out.Field = string(in.String())
+		case "attach_id":
+			out.AttachID = int64(in.Int64())
+		case "old":
+			out.Old = string(in.String())
+		case "new":
+			out.New = string(in.String())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppModels8(out *jwriter.Writer, in models.PostRevisionChange) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"field\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Field))
+	}
+	if in.AttachID != 0 {
+		const prefix string = ",\"attach_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AttachID))
+	}
+	if in.Old != "" {
+		const prefix string = ",\"old\":"
+		out.RawString(prefix)
+		out.String(string(in.Old))
+	}
+	if in.New != "" {
+		const prefix string = ",\"new\":"
+		out.RawString(prefix)
+		out.String(string(in.New))
+	}
+	out.RawByte('}')
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels20(in *jlexer.Lexer, out *ResponsePostRevisions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "revisions":
+			if in.IsNull() {
+				in.Skip()
+				out.Revisions = nil
+			} else {
+				in.Delim('[')
+				if out.Revisions == nil {
+					if !in.IsDelim(']') {
+						out.Revisions = make([]models.PostRevision, 0, 0)
+					} else {
+						out.Revisions = []models.PostRevision{}
+					}
+				} else {
+					out.Revisions = (out.Revisions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v49 models.PostRevision
+					easyjson316682a0DecodePatreonInternalAppModels9(in, &v49)
+					out.Revisions = append(out.Revisions, v49)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels20(out *jwriter.Writer, in ResponsePostRevisions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"revisions\":"
+		out.RawString(prefix[1:])
+		if in.Revisions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v50, v51 := range in.Revisions {
+				if v50 > 0 {
+					out.RawByte(',')
+				}
+				easyjson316682a0EncodePatreonInternalAppModels9(out, v51)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponsePostRevisions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponsePostRevisions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponsePostRevisions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponsePostRevisions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels20(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppModels9(in *jlexer.Lexer, out *models.PostRevision) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "revision_id":
+			out.ID = int64(in.Int64())
+		case "posts_id":
+			out.PostID = int64(in.Int64())
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "type_awards":
+			out.Awards = int64(in.Int64())
+		case "attaches":
+			if in.IsNull() {
+				in.Skip()
+				out.Attaches = nil
+			} else {
+				in.Delim('[')
+				if out.Attaches == nil {
+					if !in.IsDelim(']') {
+						out.Attaches = make([]models.Attach, 0, 1)
+					} else {
+						out.Attaches = []models.Attach{}
+					}
+				} else {
+					out.Attaches = (out.Attaches)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v52 models.Attach
+					easyjson316682a0DecodePatreonInternalAppModels10(in, &v52)
+					out.Attaches = append(out.Attaches, v52)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppModels9(out *jwriter.Writer, in models.PostRevision) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"revision_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"posts_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PostID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"type_awards\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Awards))
+	}
+	{
+		const prefix string = ",\"attaches\":"
+		out.RawString(prefix)
+		if in.Attaches == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v53, v54 := range in.Attaches {
+				if v53 > 0 {
+					out.RawByte(',')
+				}
+				easyjson316682a0EncodePatreonInternalAppModels10(out, v54)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+func easyjson316682a0DecodePatreonInternalAppModels10(in *jlexer.Lexer, out *models.Attach) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = int64(in.Int64())
+		case "value":
+			out.Value = string(in.String())
+		case "type":
+			out.Type = models.DataType(in.String())
+		case "level":
+			out.Level = int64(in.Int64())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppModels10(out *jwriter.Writer, in models.Attach) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Id))
+	}
+	{
+		const prefix string = ",\"value\":"
+		out.RawString(prefix)
+		out.String(string(in.Value))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"level\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Level))
+	}
+	out.RawByte('}')
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels21(in *jlexer.Lexer, out *ResponsePostComments) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "comments":
+			if in.IsNull() {
+				in.Skip()
+				out.Comments = nil
+			} else {
+				in.Delim('[')
+				if out.Comments == nil {
+					if !in.IsDelim(']') {
+						out.Comments = make([]ResponsePostComment, 0, 0)
+					} else {
+						out.Comments = []ResponsePostComment{}
+					}
+				} else {
+					out.Comments = (out.Comments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v55 ResponsePostComment
+					(v55).UnmarshalEasyJSON(in)
+					out.Comments = append(out.Comments, v55)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels21(out *jwriter.Writer, in ResponsePostComments) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"comments\":"
+		out.RawString(prefix[1:])
+		if in.Comments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v56, v57 := range in.Comments {
+				if v56 > 0 {
+					out.RawByte(',')
+				}
+				(v57).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponsePostComments) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponsePostComments) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels21(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponsePostComments) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels21(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponsePostComments) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels21(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels22(in *jlexer.Lexer, out *ResponsePostComment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "comment_id":
+			out.ID = int64(in.Int64())
+		case "body":
+			out.Body = string(in.String())
+		case "as_creator":
+			out.AsCreator = bool(in.Bool())
+		case "author_id":
+			out.AuthorId = int64(in.Int64())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		case "author_nickname":
+			out.AuthorNickname = string(in.String())
+		case "author_avatar":
+			out.AuthorAvatar = string(in.String())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels22(out *jwriter.Writer, in ResponsePostComment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"comment_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"body\":"
+		out.RawString(prefix)
+		out.String(string(in.Body))
+	}
+	if in.AsCreator {
+		const prefix string = ",\"as_creator\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AsCreator))
+	}
+	{
+		const prefix string = ",\"author_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AuthorId))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"author_nickname\":"
+		out.RawString(prefix)
+		out.String(string(in.AuthorNickname))
+	}
+	{
+		const prefix string = ",\"author_avatar\":"
+		out.RawString(prefix)
+		out.String(string(in.AuthorAvatar))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponsePostComment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponsePostComment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponsePostComment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponsePostComment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels22(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels23(in *jlexer.Lexer, out *ResponsePost) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "posts_id":
+			out.ID = int64(in.Int64())
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "type_awards":
+			out.Awards = int64(in.Int64())
+		case "likes":
+			out.Likes = int64(in.Int64())
+		case "cover":
+			out.Cover = string(in.String())
+		case "add_like":
+			out.AddLike = bool(in.Bool())
+		case "views":
+			out.Views = int64(in.Int64())
+		case "comments":
+			out.Comments = int64(in.Int64())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		case "is_draft":
+			out.IsDraft = bool(in.Bool())
+		case "unlock_price":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UnlockPrice).UnmarshalJSON(data))
+			}
+		case "unlocked":
+			out.Unlocked = bool(in.Bool())
+		case "publish_at":
+			if in.IsNull() {
+				in.Skip()
+				out.PublishAt = nil
+			} else {
+				if out.PublishAt == nil {
+					out.PublishAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.PublishAt).UnmarshalJSON(data))
+				}
+			}
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v58 string
+					v58 = string(in.String())
+					out.Tags = append(out.Tags, v58)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "preview":
+			out.Preview = string(in.String())
+		case "locked":
+			out.Locked = bool(in.Bool())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels23(out *jwriter.Writer, in ResponsePost) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"posts_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	if in.Awards != 0 {
+		const prefix string = ",\"type_awards\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Awards))
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Likes))
+	}
+	{
+		const prefix string = ",\"cover\":"
+		out.RawString(prefix)
+		out.String(string(in.Cover))
+	}
+	if in.AddLike {
+		const prefix string = ",\"add_like\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AddLike))
+	}
+	{
+		const prefix string = ",\"views\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Views))
+	}
+	{
+		const prefix string = ",\"comments\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Comments))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	if in.IsDraft {
+		const prefix string = ",\"is_draft\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsDraft))
+	}
+	if in.UnlockPrice != 0 {
+		const prefix string = ",\"unlock_price\":"
+		out.RawString(prefix)
+		out.Raw((in.UnlockPrice).MarshalJSON())
+	}
+	if in.Unlocked {
+		const prefix string = ",\"unlocked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Unlocked))
+	}
+	if in.PublishAt != nil {
+		const prefix string = ",\"publish_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.PublishAt).MarshalJSON())
+	}
+	if len(in.Tags) != 0 {
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v59, v60 := range in.Tags {
+				if v59 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v60))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Preview != "" {
+		const prefix string = ",\"preview\":"
+		out.RawString(prefix)
+		out.String(string(in.Preview))
+	}
+	{
+		const prefix string = ",\"locked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Locked))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponsePost) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels23(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponsePost) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels23(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponsePost) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels23(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponsePost) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels23(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels24(in *jlexer.Lexer, out *ResponsePollResults) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attach_id":
+			out.AttachID = int64(in.Int64())
+		case "poll":
+			if in.IsNull() {
+				in.Skip()
+				out.Poll = nil
+			} else {
+				if out.Poll == nil {
+					out.Poll = new(models.PollAttach)
+				}
+				easyjson316682a0DecodePatreonInternalAppModels11(in, out.Poll)
+			}
+		case "counts":
+			if in.IsNull() {
+				in.Skip()
+				out.Counts = nil
+			} else {
+				in.Delim('[')
+				if out.Counts == nil {
+					if !in.IsDelim(']') {
+						out.Counts = make([]int64, 0, 8)
+					} else {
+						out.Counts = []int64{}
+					}
+				} else {
+					out.Counts = (out.Counts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v61 int64
+					v61 = int64(in.Int64())
+					out.Counts = append(out.Counts, v61)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "voters":
+			out.Voters = int64(in.Int64())
+		case "voted":
+			if in.IsNull() {
+				in.Skip()
+				out.Voted = nil
+			} else {
+				in.Delim('[')
+				if out.Voted == nil {
+					if !in.IsDelim(']') {
+						out.Voted = make([]int64, 0, 8)
+					} else {
+						out.Voted = []int64{}
+					}
+				} else {
+					out.Voted = (out.Voted)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v62 int64
+					v62 = int64(in.Int64())
+					out.Voted = append(out.Voted, v62)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "closed":
+			out.Closed = bool(in.Bool())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels24(out *jwriter.Writer, in ResponsePollResults) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attach_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.AttachID))
+	}
+	{
+		const prefix string = ",\"poll\":"
+		out.RawString(prefix)
+		if in.Poll == nil {
+			out.RawString("null")
+		} else {
+			easyjson316682a0EncodePatreonInternalAppModels11(out, *in.Poll)
+		}
+	}
+	if len(in.Counts) != 0 {
+		const prefix string = ",\"counts\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v63, v64 := range in.Counts {
+				if v63 > 0 {
+					out.RawByte(',')
+				}
+				out.Int64(int64(v64))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"voters\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Voters))
+	}
+	if len(in.Voted) != 0 {
+		const prefix string = ",\"voted\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v65, v66 := range in.Voted {
+				if v65 > 0 {
+					out.RawByte(',')
+				}
+				out.Int64(int64(v66))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"closed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Closed))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponsePollResults) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponsePollResults) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels24(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponsePollResults) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels24(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponsePollResults) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels24(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppModels11(in *jlexer.Lexer, out *models.PollAttach) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "question":
+			out.Question = string(in.String())
+		case "options":
+			if in.IsNull() {
+				in.Skip()
+				out.Options = nil
+			} else {
+				in.Delim('[')
+				if out.Options == nil {
+					if !in.IsDelim(']') {
+						out.Options = make([]string, 0, 4)
+					} else {
+						out.Options = []string{}
+					}
+				} else {
+					out.Options = (out.Options)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v67 string
+					v67 = string(in.String())
+					out.Options = append(out.Options, v67)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "multiple":
+			out.Multiple = bool(in.Bool())
+		case "close_at":
+			if in.IsNull() {
+				in.Skip()
+				out.CloseAt = nil
+			} else {
+				if out.CloseAt == nil {
+					out.CloseAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CloseAt).UnmarshalJSON(data))
+				}
+			}
+		case "results_before_vote":
+			out.ResultsBeforeVote = bool(in.Bool())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppModels11(out *jwriter.Writer, in models.PollAttach) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"question\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Question))
+	}
+	{
+		const prefix string = ",\"options\":"
+		out.RawString(prefix)
+		if in.Options == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v68, v69 := range in.Options {
+				if v68 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v69))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"multiple\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Multiple))
+	}
+	if in.CloseAt != nil {
+		const prefix string = ",\"close_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.CloseAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"results_before_vote\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ResultsBeforeVote))
+	}
+	out.RawByte('}')
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels25(in *jlexer.Lexer, out *ResponsePayouts) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "payouts":
+			if in.IsNull() {
+				in.Skip()
+				out.Payouts = nil
+			} else {
+				in.Delim('[')
+				if out.Payouts == nil {
+					if !in.IsDelim(']') {
+						out.Payouts = make([]models.Payout, 0, 0)
+					} else {
+						out.Payouts = []models.Payout{}
+					}
+				} else {
+					out.Payouts = (out.Payouts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v70 models.Payout
+					easyjson316682a0DecodePatreonInternalAppModels12(in, &v70)
+					out.Payouts = append(out.Payouts, v70)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels25(out *jwriter.Writer, in ResponsePayouts) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"payouts\":"
+		out.RawString(prefix[1:])
+		if in.Payouts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v71, v72 := range in.Payouts {
+				if v71 > 0 {
+					out.RawByte(',')
+				}
+				easyjson316682a0EncodePatreonInternalAppModels12(out, v72)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponsePayouts) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels25(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponsePayouts) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels25(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponsePayouts) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels25(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponsePayouts) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels25(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppModels12(in *jlexer.Lexer, out *models.Payout) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "creator_id":
+			out.CreatorID = int64(in.Int64())
+		case "amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		case "state":
+			out.State = models.PayoutState(in.String())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		case "updated":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Updated).UnmarshalJSON(data))
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppModels12(out *jwriter.Writer, in models.Payout) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"creator_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CreatorID))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.Raw((in.Amount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix)
+		out.String(string(in.State))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated\":"
+		out.RawString(prefix)
+		out.Raw((in.Updated).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels26(in *jlexer.Lexer, out *ResponsePayout) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "creator_id":
+			out.CreatorID = int64(in.Int64())
+		case "amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		case "state":
+			out.State = models.PayoutState(in.String())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		case "updated":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Updated).UnmarshalJSON(data))
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels26(out *jwriter.Writer, in ResponsePayout) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"creator_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CreatorID))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.Raw((in.Amount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix)
+		out.String(string(in.State))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated\":"
+		out.RawString(prefix)
+		out.Raw((in.Updated).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponsePayout) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels26(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponsePayout) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels26(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponsePayout) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels26(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponsePayout) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels26(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels27(in *jlexer.Lexer, out *ResponsePaymentsTotals) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "totals":
+			if in.IsNull() {
+				in.Skip()
+				out.Totals = nil
+			} else {
+				in.Delim('[')
+				if out.Totals == nil {
+					if !in.IsDelim(']') {
+						out.Totals = make([]models.PaymentsMonthTotal, 0, 0)
+					} else {
+						out.Totals = []models.PaymentsMonthTotal{}
+					}
+				} else {
+					out.Totals = (out.Totals)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v73 models.PaymentsMonthTotal
+					easyjson316682a0DecodePatreonInternalAppModels13(in, &v73)
+					out.Totals = append(out.Totals, v73)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels27(out *jwriter.Writer, in ResponsePaymentsTotals) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"totals\":"
+		out.RawString(prefix[1:])
+		if in.Totals == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v74, v75 := range in.Totals {
+				if v74 > 0 {
+					out.RawByte(',')
+				}
+				easyjson316682a0EncodePatreonInternalAppModels13(out, v75)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponsePaymentsTotals) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels27(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponsePaymentsTotals) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels27(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponsePaymentsTotals) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels27(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponsePaymentsTotals) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels27(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppModels13(in *jlexer.Lexer, out *models.PaymentsMonthTotal) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "month":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Month).UnmarshalJSON(data))
+			}
+		case "award_id":
+			out.AwardID = int64(in.Int64())
+		case "award_name":
+			out.AwardName = string(in.String())
+		case "currency":
+			out.Currency = string(in.String())
+		case "count":
+			out.Count = int64(in.Int64())
+		case "amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		case "refunded":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Refunded).UnmarshalJSON(data))
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppModels13(out *jwriter.Writer, in models.PaymentsMonthTotal) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"month\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.Month).MarshalJSON())
+	}
+	if in.AwardID != 0 {
+		const prefix string = ",\"award_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AwardID))
+	}
+	if in.AwardName != "" {
+		const prefix string = ",\"award_name\":"
+		out.RawString(prefix)
+		out.String(string(in.AwardName))
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Count))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.Raw((in.Amount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"refunded\":"
+		out.RawString(prefix)
+		out.Raw((in.Refunded).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels28(in *jlexer.Lexer, out *ResponsePayToken) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "token":
+			out.PayToken = string(in.String())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels28(out *jwriter.Writer, in ResponsePayToken) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.PayToken))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponsePayToken) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels28(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponsePayToken) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels28(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponsePayToken) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels28(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponsePayToken) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels28(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels29(in *jlexer.Lexer, out *ResponsePayAccount) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "account_number":
+			out.Account = string(in.String())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels29(out *jwriter.Writer, in ResponsePayAccount) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"account_number\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Account))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponsePayAccount) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels29(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponsePayAccount) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels29(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponsePayAccount) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels29(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponsePayAccount) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels29(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels30(in *jlexer.Lexer, out *ResponseLockedPostInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "posts_id":
+			out.ID = int64(in.Int64())
+		case "title":
+			out.Title = string(in.String())
+		case "cover":
+			out.Cover = string(in.String())
+		case "preview":
+			out.Preview = string(in.String())
+		case "type_awards":
+			out.Awards = int64(in.Int64())
+		case "unlock_price":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UnlockPrice).UnmarshalJSON(data))
+			}
+		case "creator_id":
+			out.CreatorId = int64(in.Int64())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v76 string
+					v76 = string(in.String())
+					out.Tags = append(out.Tags, v76)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "locked":
+			out.Locked = bool(in.Bool())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels30(out *jwriter.Writer, in ResponseLockedPostInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"posts_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"cover\":"
+		out.RawString(prefix)
+		out.String(string(in.Cover))
+	}
+	{
+		const prefix string = ",\"preview\":"
+		out.RawString(prefix)
+		out.String(string(in.Preview))
+	}
+	if in.Awards != 0 {
+		const prefix string = ",\"type_awards\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Awards))
+	}
+	if in.UnlockPrice != 0 {
+		const prefix string = ",\"unlock_price\":"
+		out.RawString(prefix)
+		out.Raw((in.UnlockPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"creator_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CreatorId))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	if len(in.Tags) != 0 {
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v77, v78 := range in.Tags {
+				if v77 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v78))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"locked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Locked))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseLockedPostInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels30(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseLockedPostInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels30(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseLockedPostInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels30(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseLockedPostInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels30(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels31(in *jlexer.Lexer, out *ResponseLockedPost) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "post":
+			(out.Post).UnmarshalEasyJSON(in)
+		case "attaches":
+			if in.IsNull() {
+				in.Skip()
+				out.Data = nil
+			} else {
+				in.Delim('[')
+				if out.Data == nil {
+					if !in.IsDelim(']') {
+						out.Data = make([]models.AttachCount, 0, 2)
+					} else {
+						out.Data = []models.AttachCount{}
+					}
+				} else {
+					out.Data = (out.Data)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v79 models.AttachCount
+					easyjson316682a0DecodePatreonInternalAppModels14(in, &v79)
+					out.Data = append(out.Data, v79)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels31(out *jwriter.Writer, in ResponseLockedPost) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"post\":"
+		out.RawString(prefix[1:])
+		(in.Post).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"attaches\":"
+		out.RawString(prefix)
+		if in.Data == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v80, v81 := range in.Data {
+				if v80 > 0 {
+					out.RawByte(',')
+				}
+				easyjson316682a0EncodePatreonInternalAppModels14(out, v81)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseLockedPost) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels31(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseLockedPost) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels31(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseLockedPost) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels31(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseLockedPost) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels31(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppModels14(in *jlexer.Lexer, out *models.AttachCount) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = models.DataType(in.String())
+		case "count":
+			out.Count = int64(in.Int64())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppModels14(out *jwriter.Writer, in models.AttachCount) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Count))
+	}
+	out.RawByte('}')
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels32(in *jlexer.Lexer, out *ResponseLike) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "likes":
+			out.Likes = int64(in.Int64())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels32(out *jwriter.Writer, in ResponseLike) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Likes))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseLike) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels32(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseLike) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels32(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseLike) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels32(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseLike) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels32(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels33(in *jlexer.Lexer, out *ResponseLedgerEntries) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "entries":
+			if in.IsNull() {
+				in.Skip()
+				out.Entries = nil
+			} else {
+				in.Delim('[')
+				if out.Entries == nil {
+					if !in.IsDelim(']') {
+						out.Entries = make([]models.LedgerEntry, 0, 0)
+					} else {
+						out.Entries = []models.LedgerEntry{}
+					}
+				} else {
+					out.Entries = (out.Entries)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v82 models.LedgerEntry
+					easyjson316682a0DecodePatreonInternalAppModels15(in, &v82)
+					out.Entries = append(out.Entries, v82)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels33(out *jwriter.Writer, in ResponseLedgerEntries) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"entries\":"
+		out.RawString(prefix[1:])
+		if in.Entries == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v83, v84 := range in.Entries {
+				if v83 > 0 {
+					out.RawByte(',')
+				}
+				easyjson316682a0EncodePatreonInternalAppModels15(out, v84)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseLedgerEntries) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels33(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseLedgerEntries) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels33(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseLedgerEntries) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels33(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseLedgerEntries) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels33(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppModels15(in *jlexer.Lexer, out *models.LedgerEntry) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "operation":
+			out.Operation = models.LedgerOperation(in.String())
+		case "account":
+			out.Account = models.LedgerAccount(in.String())
+		case "amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		case "payment_id":
+			out.PaymentID = int64(in.Int64())
+		case "payout_id":
+			out.PayoutID = int64(in.Int64())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppModels15(out *jwriter.Writer, in models.LedgerEntry) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"operation\":"
+		out.RawString(prefix)
+		out.String(string(in.Operation))
+	}
+	{
+		const prefix string = ",\"account\":"
+		out.RawString(prefix)
+		out.String(string(in.Account))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.Raw((in.Amount).MarshalJSON())
+	}
+	if in.PaymentID != 0 {
+		const prefix string = ",\"payment_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PaymentID))
+	}
+	if in.PayoutID != 0 {
+		const prefix string = ",\"payout_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PayoutID))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels34(in *jlexer.Lexer, out *ResponseInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "category":
+			if in.IsNull() {
+				in.Skip()
+				out.Category = nil
+			} else {
+				in.Delim('[')
+				if out.Category == nil {
+					if !in.IsDelim(']') {
+						out.Category = make([]string, 0, 4)
+					} else {
+						out.Category = []string{}
+					}
+				} else {
+					out.Category = (out.Category)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v85 string
+					v85 = string(in.String())
+					out.Category = append(out.Category, v85)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "type_post_data":
+			if in.IsNull() {
+				in.Skip()
+				out.TypePostData = nil
+			} else {
+				in.Delim('[')
+				if out.TypePostData == nil {
+					if !in.IsDelim(']') {
+						out.TypePostData = make([]string, 0, 4)
+					} else {
+						out.TypePostData = []string{}
+					}
+				} else {
+					out.TypePostData = (out.TypePostData)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v86 string
+					v86 = string(in.String())
+					out.TypePostData = append(out.TypePostData, v86)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels34(out *jwriter.Writer, in ResponseInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"category\":"
+		out.RawString(prefix[1:])
+		if in.Category == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v87, v88 := range in.Category {
+				if v87 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v88))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"type_post_data\":"
+		out.RawString(prefix)
+		if in.TypePostData == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v89, v90 := range in.TypePostData {
+				if v89 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v90))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels34(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels34(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels34(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels34(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels35(in *jlexer.Lexer, out *ResponseGifts) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "gifts":
+			if in.IsNull() {
+				in.Skip()
+				out.Gifts = nil
+			} else {
+				in.Delim('[')
+				if out.Gifts == nil {
+					if !in.IsDelim(']') {
+						out.Gifts = make([]models.Gift, 0, 0)
+					} else {
+						out.Gifts = []models.Gift{}
+					}
+				} else {
+					out.Gifts = (out.Gifts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v91 models.Gift
+					easyjson316682a0DecodePatreonInternalAppModels16(in, &v91)
+					out.Gifts = append(out.Gifts, v91)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels35(out *jwriter.Writer, in ResponseGifts) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"gifts\":"
+		out.RawString(prefix[1:])
+		if in.Gifts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v92, v93 := range in.Gifts {
+				if v92 > 0 {
+					out.RawByte(',')
+				}
+				easyjson316682a0EncodePatreonInternalAppModels16(out, v93)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseGifts) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels35(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseGifts) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels35(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseGifts) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels35(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseGifts) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels35(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppModels16(in *jlexer.Lexer, out *models.Gift) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "code":
+			out.Code = string(in.String())
+		case "payer_id":
+			out.PayerID = int64(in.Int64())
+		case "payer_nickname":
+			out.PayerNickname = string(in.String())
+		case "recipient_id":
+			out.RecipientID = int64(in.Int64())
+		case "recipient_nickname":
+			out.RecipientNickname = string(in.String())
+		case "creator_id":
+			out.CreatorID = int64(in.Int64())
+		case "award_id":
+			out.AwardID = int64(in.Int64())
+		case "periods":
+			out.Periods = int64(in.Int64())
+		case "amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		case "currency":
+			out.Currency = string(in.String())
+		case "status":
+			out.Status = models.GiftStatus(in.String())
+		case "pay_token":
+			out.PayToken = string(in.String())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		case "redeemed_at":
+			if in.IsNull() {
+				in.Skip()
+				out.RedeemedAt = nil
+			} else {
+				if out.RedeemedAt == nil {
+					out.RedeemedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.RedeemedAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppModels16(out *jwriter.Writer, in models.Gift) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	if in.Code != "" {
+		const prefix string = ",\"code\":"
+		out.RawString(prefix)
+		out.String(string(in.Code))
+	}
+	{
+		const prefix string = ",\"payer_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PayerID))
+	}
+	if in.PayerNickname != "" {
+		const prefix string = ",\"payer_nickname\":"
+		out.RawString(prefix)
+		out.String(string(in.PayerNickname))
+	}
+	if in.RecipientID != 0 {
+		const prefix string = ",\"recipient_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.RecipientID))
+	}
+	if in.RecipientNickname != "" {
+		const prefix string = ",\"recipient_nickname\":"
+		out.RawString(prefix)
+		out.String(string(in.RecipientNickname))
+	}
+	{
+		const prefix string = ",\"creator_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CreatorID))
+	}
+	{
+		const prefix string = ",\"award_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AwardID))
+	}
+	{
+		const prefix string = ",\"periods\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Periods))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.Raw((in.Amount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.PayToken != "" {
+		const prefix string = ",\"pay_token\":"
+		out.RawString(prefix)
+		out.String(string(in.PayToken))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	if in.RedeemedAt != nil {
+		const prefix string = ",\"redeemed_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.RedeemedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels36(in *jlexer.Lexer, out *ResponseGift) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "code":
+			out.Code = string(in.String())
+		case "payer_id":
+			out.PayerID = int64(in.Int64())
+		case "payer_nickname":
+			out.PayerNickname = string(in.String())
+		case "recipient_id":
+			out.RecipientID = int64(in.Int64())
+		case "recipient_nickname":
+			out.RecipientNickname = string(in.String())
+		case "creator_id":
+			out.CreatorID = int64(in.Int64())
+		case "award_id":
+			out.AwardID = int64(in.Int64())
+		case "periods":
+			out.Periods = int64(in.Int64())
+		case "amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		case "currency":
+			out.Currency = string(in.String())
+		case "status":
+			out.Status = models.GiftStatus(in.String())
+		case "pay_token":
+			out.PayToken = string(in.String())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		case "redeemed_at":
+			if in.IsNull() {
+				in.Skip()
+				out.RedeemedAt = nil
+			} else {
+				if out.RedeemedAt == nil {
+					out.RedeemedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.RedeemedAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels36(out *jwriter.Writer, in ResponseGift) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	if in.Code != "" {
+		const prefix string = ",\"code\":"
+		out.RawString(prefix)
+		out.String(string(in.Code))
+	}
+	{
+		const prefix string = ",\"payer_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PayerID))
+	}
+	if in.PayerNickname != "" {
+		const prefix string = ",\"payer_nickname\":"
+		out.RawString(prefix)
+		out.String(string(in.PayerNickname))
+	}
+	if in.RecipientID != 0 {
+		const prefix string = ",\"recipient_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.RecipientID))
+	}
+	if in.RecipientNickname != "" {
+		const prefix string = ",\"recipient_nickname\":"
+		out.RawString(prefix)
+		out.String(string(in.RecipientNickname))
+	}
+	{
+		const prefix string = ",\"creator_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CreatorID))
+	}
+	{
+		const prefix string = ",\"award_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AwardID))
+	}
+	{
+		const prefix string = ",\"periods\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Periods))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.Raw((in.Amount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.PayToken != "" {
+		const prefix string = ",\"pay_token\":"
+		out.RawString(prefix)
+		out.String(string(in.PayToken))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	if in.RedeemedAt != nil {
+		const prefix string = ",\"redeemed_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.RedeemedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseGift) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels36(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseGift) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels36(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseGift) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels36(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseGift) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels36(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels37(in *jlexer.Lexer, out *ResponseExportPayment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		case "type":
+			out.Type = models.PaymentType(in.String())
+		case "state":
+			out.State = models.PaymentState(in.String())
+		case "amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		case "refunded_amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.RefundedAmount).UnmarshalJSON(data))
+			}
+		case "currency":
+			out.Currency = string(in.String())
+		case "discount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Discount).UnmarshalJSON(data))
+			}
+		case "promo_code":
+			out.PromoCode = string(in.String())
+		case "award_id":
+			out.AwardID = int64(in.Int64())
+		case "award_name":
+			out.AwardName = string(in.String())
+		case "user_id":
+			out.UserID = int64(in.Int64())
+		case "user_nickname":
+			out.UserNickname = string(in.String())
+		case "tip_message":
+			out.TipMessage = string(in.String())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels37(out *jwriter.Writer, in ResponseExportPayment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.Date).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix)
+		out.String(string(in.State))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.Raw((in.Amount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"refunded_amount\":"
+		out.RawString(prefix)
+		out.Raw((in.RefundedAmount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"discount\":"
+		out.RawString(prefix)
+		out.Raw((in.Discount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"promo_code\":"
+		out.RawString(prefix)
+		out.String(string(in.PromoCode))
+	}
+	{
+		const prefix string = ",\"award_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AwardID))
+	}
+	{
+		const prefix string = ",\"award_name\":"
+		out.RawString(prefix)
+		out.String(string(in.AwardName))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UserID))
+	}
+	{
+		const prefix string = ",\"user_nickname\":"
+		out.RawString(prefix)
+		out.String(string(in.UserNickname))
+	}
+	{
+		const prefix string = ",\"tip_message\":"
+		out.RawString(prefix)
+		out.String(string(in.TipMessage))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseExportPayment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels37(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseExportPayment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels37(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseExportPayment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels37(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseExportPayment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels37(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels38(in *jlexer.Lexer, out *ResponseCreators) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "creators":
+			if in.IsNull() {
+				in.Skip()
+				out.Creators = nil
+			} else {
+				in.Delim('[')
+				if out.Creators == nil {
+					if !in.IsDelim(']') {
+						out.Creators = make([]ResponseCreator, 0, 0)
+					} else {
+						out.Creators = []ResponseCreator{}
+					}
+				} else {
+					out.Creators = (out.Creators)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v94 ResponseCreator
+					(v94).UnmarshalEasyJSON(in)
+					out.Creators = append(out.Creators, v94)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels38(out *jwriter.Writer, in ResponseCreators) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"creators\":"
+		out.RawString(prefix[1:])
+		if in.Creators == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v95, v96 := range in.Creators {
+				if v95 > 0 {
+					out.RawByte(',')
+				}
+				(v96).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseCreators) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels38(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseCreators) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels38(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseCreators) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels38(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseCreators) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels38(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels39(in *jlexer.Lexer, out *ResponseCreatorWithAwards) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "category":
+			out.Category = string(in.String())
+		case "nickname":
+			out.Nickname = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "avatar":
+			out.Avatar = string(in.String())
+		case "cover":
+			out.Cover = string(in.String())
+		case "awards_id":
+			out.AwardsId = int64(in.Int64())
+		case "currency":
+			out.Currency = string(in.String())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels39(out *jwriter.Writer, in ResponseCreatorWithAwards) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"category\":"
+		out.RawString(prefix)
+		out.String(string(in.Category))
+	}
+	{
+		const prefix string = ",\"nickname\":"
+		out.RawString(prefix)
+		out.String(string(in.Nickname))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	if in.Avatar != "" {
+		const prefix string = ",\"avatar\":"
+		out.RawString(prefix)
+		out.String(string(in.Avatar))
+	}
+	if in.Cover != "" {
+		const prefix string = ",\"cover\":"
+		out.RawString(prefix)
+		out.String(string(in.Cover))
+	}
+	{
+		const prefix string = ",\"awards_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AwardsId))
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseCreatorWithAwards) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels39(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseCreatorWithAwards) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels39(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseCreatorWithAwards) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels39(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseCreatorWithAwards) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels39(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels40(in *jlexer.Lexer, out *ResponseCreatorTrials) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "started":
+			out.Started = int64(in.Int64())
+		case "active":
+			out.Active = int64(in.Int64())
+		case "converted":
+			out.Converted = int64(in.Int64())
+		case "conversion_rate":
+			out.ConversionRate = float64(in.Float64())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels40(out *jwriter.Writer, in ResponseCreatorTrials) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"started\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Started))
+	}
+	{
+		const prefix string = ",\"active\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Active))
+	}
+	{
+		const prefix string = ",\"converted\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Converted))
+	}
+	{
+		const prefix string = ",\"conversion_rate\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.ConversionRate))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseCreatorTrials) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels40(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseCreatorTrials) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels40(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseCreatorTrials) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels40(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseCreatorTrials) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels40(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels41(in *jlexer.Lexer, out *ResponseCreatorTotalIncome) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "total_income":
+			easyjson316682a0DecodePatreonInternalAppModels17(in, &out.TotalIncome)
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels41(out *jwriter.Writer, in ResponseCreatorTotalIncome) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"total_income\":"
+		out.RawString(prefix[1:])
+		easyjson316682a0EncodePatreonInternalAppModels17(out, in.TotalIncome)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseCreatorTotalIncome) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels41(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseCreatorTotalIncome) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels41(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseCreatorTotalIncome) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels41(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseCreatorTotalIncome) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels41(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppModels17(in *jlexer.Lexer, out *models.Money) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		case "currency":
+			out.Currency = string(in.String())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppModels17(out *jwriter.Writer, in models.Money) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.Amount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	out.RawByte('}')
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels42(in *jlexer.Lexer, out *ResponseCreatorSubscrube) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "category":
+			out.Category = string(in.String())
+		case "nickname":
+			out.Nickname = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "avatar":
+			out.Avatar = string(in.String())
+		case "cover":
+			out.Cover = string(in.String())
+		case "awards_id":
+			out.AwardsId = int64(in.Int64())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels42(out *jwriter.Writer, in ResponseCreatorSubscrube) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"category\":"
+		out.RawString(prefix)
+		out.String(string(in.Category))
+	}
+	{
+		const prefix string = ",\"nickname\":"
+		out.RawString(prefix)
+		out.String(string(in.Nickname))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	if in.Avatar != "" {
+		const prefix string = ",\"avatar\":"
+		out.RawString(prefix)
+		out.String(string(in.Avatar))
+	}
+	if in.Cover != "" {
+		const prefix string = ",\"cover\":"
+		out.RawString(prefix)
+		out.String(string(in.Cover))
+	}
+	{
+		const prefix string = ",\"awards_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AwardsId))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseCreatorSubscrube) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels42(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseCreatorSubscrube) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels42(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseCreatorSubscrube) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels42(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseCreatorSubscrube) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels42(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels43(in *jlexer.Lexer, out *ResponseCreatorPostsViews) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "count_posts_views":
+			out.CountPostsViews = int64(in.Int64())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels43(out *jwriter.Writer, in ResponseCreatorPostsViews) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"count_posts_views\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.CountPostsViews))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseCreatorPostsViews) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels43(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseCreatorPostsViews) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels43(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseCreatorPostsViews) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels43(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseCreatorPostsViews) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels43(l, v)
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels44(in *jlexer.Lexer, out *ResponseCreatorPayments) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "payments":
+			if in.IsNull() {
+				in.Skip()
+				out.Payments = nil
+			} else {
+				in.Delim('[')
+				if out.Payments == nil {
+					if !in.IsDelim(']') {
+						out.Payments = make([]models.CreatorPayments, 0, 0)
+					} else {
+						out.Payments = []models.CreatorPayments{}
+					}
+				} else {
+					out.Payments = (out.Payments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v97 models.CreatorPayments
+					easyjson316682a0DecodePatreonInternalAppModels18(in, &v97)
+					out.Payments = append(out.Payments, v97)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels44(out *jwriter.Writer, in ResponseCreatorPayments) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"payments\":"
+		out.RawString(prefix[1:])
+		if in.Payments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v26, v27 := range in.TypePostData {
-				if v26 > 0 {
+			for v98, v99 := range in.Payments {
+				if v98 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v27))
+				easyjson316682a0EncodePatreonInternalAppModels18(out, v99)
 			}
 			out.RawByte(']')
 		}
@@ -1697,29 +7051,29 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels15(out *jwriter.W
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ResponseInfo) MarshalJSON() ([]byte, error) {
+func (v ResponseCreatorPayments) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels15(&w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels44(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ResponseInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels15(w, v)
+func (v ResponseCreatorPayments) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels44(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ResponseInfo) UnmarshalJSON(data []byte) error {
+func (v *ResponseCreatorPayments) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels15(&r, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels44(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ResponseInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels15(l, v)
+func (v *ResponseCreatorPayments) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels44(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels16(in *jlexer.Lexer, out *ResponseCreators) {
+func easyjson316682a0DecodePatreonInternalAppModels18(in *jlexer.Lexer, out *models.CreatorPayments) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1738,29 +7092,213 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels16(in *jlexer.Lex
 			continue
 		}
 		switch key {
-		case "creators":
+		case "user_nickname":
+			out.UserNickname = string(in.String())
+		case "tip_message":
+			out.TipMessage = string(in.String())
+		case "award_id":
+			out.AwardID = int64(in.Int64())
+		case "award_name":
+			out.AwardName = string(in.String())
+		case "id":
+			out.ID = int64(in.Int64())
+		case "amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		case "currency":
+			out.Currency = string(in.String())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		case "creator_id":
+			out.CreatorID = int64(in.Int64())
+		case "user_id":
+			out.UserID = int64(in.Int64())
+		case "state":
+			out.State = models.PaymentState(in.String())
+		case "type":
+			out.Type = models.PaymentType(in.String())
+		case "events":
 			if in.IsNull() {
 				in.Skip()
-				out.Creators = nil
+				out.Events = nil
 			} else {
 				in.Delim('[')
-				if out.Creators == nil {
+				if out.Events == nil {
 					if !in.IsDelim(']') {
-						out.Creators = make([]ResponseCreator, 0, 0)
+						out.Events = make([]models.PaymentEvent, 0, 0)
 					} else {
-						out.Creators = []ResponseCreator{}
+						out.Events = []models.PaymentEvent{}
 					}
 				} else {
-					out.Creators = (out.Creators)[:0]
+					out.Events = (out.Events)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v28 ResponseCreator
-					(v28).UnmarshalEasyJSON(in)
-					out.Creators = append(out.Creators, v28)
+					var v100 models.PaymentEvent
+					easyjson316682a0DecodePatreonInternalAppModels2(in, &v100)
+					out.Events = append(out.Events, v100)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "refunded_amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.RefundedAmount).UnmarshalJSON(data))
+			}
+		case "promo_code":
+			out.PromoCode = string(in.String())
+		case "discount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Discount).UnmarshalJSON(data))
+			}
+		case "gift_id":
+			out.GiftID = int64(in.Int64())
+		case "tip_id":
+			out.TipID = int64(in.Int64())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson316682a0EncodePatreonInternalAppModels18(out *jwriter.Writer, in models.CreatorPayments) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_nickname\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UserNickname))
+	}
+	if in.TipMessage != "" {
+		const prefix string = ",\"tip_message\":"
+		out.RawString(prefix)
+		out.String(string(in.TipMessage))
+	}
+	if in.AwardID != 0 {
+		const prefix string = ",\"award_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AwardID))
+	}
+	if in.AwardName != "" {
+		const prefix string = ",\"award_name\":"
+		out.RawString(prefix)
+		out.String(string(in.AwardName))
+	}
+	if in.ID != 0 {
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.Raw((in.Amount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	if in.CreatorID != 0 {
+		const prefix string = ",\"creator_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CreatorID))
+	}
+	if in.UserID != 0 {
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UserID))
+	}
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix)
+		out.String(string(in.State))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"events\":"
+		out.RawString(prefix)
+		if in.Events == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v101, v102 := range in.Events {
+				if v101 > 0 {
+					out.RawByte(',')
+				}
+				easyjson316682a0EncodePatreonInternalAppModels2(out, v102)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.RefundedAmount != 0 {
+		const prefix string = ",\"refunded_amount\":"
+		out.RawString(prefix)
+		out.Raw((in.RefundedAmount).MarshalJSON())
+	}
+	if in.PromoCode != "" {
+		const prefix string = ",\"promo_code\":"
+		out.RawString(prefix)
+		out.String(string(in.PromoCode))
+	}
+	if in.Discount != 0 {
+		const prefix string = ",\"discount\":"
+		out.RawString(prefix)
+		out.Raw((in.Discount).MarshalJSON())
+	}
+	if in.GiftID != 0 {
+		const prefix string = ",\"gift_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.GiftID))
+	}
+	if in.TipID != 0 {
+		const prefix string = ",\"tip_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TipID))
+	}
+	out.RawByte('}')
+}
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels45(in *jlexer.Lexer, out *ResponseCreatorCountSubscribers) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "count_subscribers":
+			out.CountSubscribers = int64(in.Int64())
 		default:
 			in.AddError(&jlexer.LexerError{
 				Offset: in.GetPos(),
@@ -1775,53 +7313,42 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels16(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels16(out *jwriter.Writer, in ResponseCreators) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels45(out *jwriter.Writer, in ResponseCreatorCountSubscribers) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"creators\":"
+		const prefix string = ",\"count_subscribers\":"
 		out.RawString(prefix[1:])
-		if in.Creators == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v29, v30 := range in.Creators {
-				if v29 > 0 {
-					out.RawByte(',')
-				}
-				(v30).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.Int64(int64(in.CountSubscribers))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ResponseCreators) MarshalJSON() ([]byte, error) {
+func (v ResponseCreatorCountSubscribers) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels16(&w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels45(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ResponseCreators) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels16(w, v)
+func (v ResponseCreatorCountSubscribers) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels45(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ResponseCreators) UnmarshalJSON(data []byte) error {
+func (v *ResponseCreatorCountSubscribers) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels16(&r, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels45(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ResponseCreators) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels16(l, v)
+func (v *ResponseCreatorCountSubscribers) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels45(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels17(in *jlexer.Lexer, out *ResponseCreatorWithAwards) {
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels46(in *jlexer.Lexer, out *ResponseCreatorCountPosts) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1840,20 +7367,8 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels17(in *jlexer.Lex
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = int64(in.Int64())
-		case "category":
-			out.Category = string(in.String())
-		case "nickname":
-			out.Nickname = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "avatar":
-			out.Avatar = string(in.String())
-		case "cover":
-			out.Cover = string(in.String())
-		case "awards_id":
-			out.AwardsId = int64(in.Int64())
+		case "count_posts":
+			out.CountPosts = int64(in.Int64())
 		default:
 			in.AddError(&jlexer.LexerError{
 				Offset: in.GetPos(),
@@ -1868,72 +7383,42 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels17(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels17(out *jwriter.Writer, in ResponseCreatorWithAwards) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels46(out *jwriter.Writer, in ResponseCreatorCountPosts) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"count_posts\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.ID))
-	}
-	{
-		const prefix string = ",\"category\":"
-		out.RawString(prefix)
-		out.String(string(in.Category))
-	}
-	{
-		const prefix string = ",\"nickname\":"
-		out.RawString(prefix)
-		out.String(string(in.Nickname))
-	}
-	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
-	}
-	if in.Avatar != "" {
-		const prefix string = ",\"avatar\":"
-		out.RawString(prefix)
-		out.String(string(in.Avatar))
-	}
-	if in.Cover != "" {
-		const prefix string = ",\"cover\":"
-		out.RawString(prefix)
-		out.String(string(in.Cover))
-	}
-	{
-		const prefix string = ",\"awards_id\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.AwardsId))
+		out.Int64(int64(in.CountPosts))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ResponseCreatorWithAwards) MarshalJSON() ([]byte, error) {
+func (v ResponseCreatorCountPosts) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels17(&w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels46(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ResponseCreatorWithAwards) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels17(w, v)
+func (v ResponseCreatorCountPosts) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels46(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ResponseCreatorWithAwards) UnmarshalJSON(data []byte) error {
+func (v *ResponseCreatorCountPosts) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels17(&r, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels46(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ResponseCreatorWithAwards) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels17(l, v)
+func (v *ResponseCreatorCountPosts) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels46(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels18(in *jlexer.Lexer, out *ResponseCreatorTotalIncome) {
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels47(in *jlexer.Lexer, out *ResponseCreatorBalance) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1952,8 +7437,34 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels18(in *jlexer.Lex
 			continue
 		}
 		switch key {
-		case "total_income":
-			out.TotalIncome = float64(in.Float64())
+		case "balance":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Balance).UnmarshalJSON(data))
+			}
+		case "in_payout":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.InPayout).UnmarshalJSON(data))
+			}
+		case "paid_out":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PaidOut).UnmarshalJSON(data))
+			}
+		case "fees":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Fees).UnmarshalJSON(data))
+			}
+		case "received":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Received).UnmarshalJSON(data))
+			}
+		case "payments_total":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PaymentsTotal).UnmarshalJSON(data))
+			}
+		case "currency":
+			out.Currency = string(in.String())
+		case "reconciled":
+			out.Reconciled = bool(in.Bool())
 		default:
 			in.AddError(&jlexer.LexerError{
 				Offset: in.GetPos(),
@@ -1968,42 +7479,77 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels18(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels18(out *jwriter.Writer, in ResponseCreatorTotalIncome) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels47(out *jwriter.Writer, in ResponseCreatorBalance) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"total_income\":"
+		const prefix string = ",\"balance\":"
 		out.RawString(prefix[1:])
-		out.Float64(float64(in.TotalIncome))
+		out.Raw((in.Balance).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"in_payout\":"
+		out.RawString(prefix)
+		out.Raw((in.InPayout).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"paid_out\":"
+		out.RawString(prefix)
+		out.Raw((in.PaidOut).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"fees\":"
+		out.RawString(prefix)
+		out.Raw((in.Fees).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"received\":"
+		out.RawString(prefix)
+		out.Raw((in.Received).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"payments_total\":"
+		out.RawString(prefix)
+		out.Raw((in.PaymentsTotal).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"reconciled\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Reconciled))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ResponseCreatorTotalIncome) MarshalJSON() ([]byte, error) {
+func (v ResponseCreatorBalance) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels18(&w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels47(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ResponseCreatorTotalIncome) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels18(w, v)
+func (v ResponseCreatorBalance) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels47(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ResponseCreatorTotalIncome) UnmarshalJSON(data []byte) error {
+func (v *ResponseCreatorBalance) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels18(&r, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels47(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ResponseCreatorTotalIncome) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels18(l, v)
+func (v *ResponseCreatorBalance) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels47(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels19(in *jlexer.Lexer, out *ResponseCreatorSubscrube) {
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels48(in *jlexer.Lexer, out *ResponseCreator) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2034,8 +7580,8 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels19(in *jlexer.Lex
 			out.Avatar = string(in.String())
 		case "cover":
 			out.Cover = string(in.String())
-		case "awards_id":
-			out.AwardsId = int64(in.Int64())
+		case "currency":
+			out.Currency = string(in.String())
 		default:
 			in.AddError(&jlexer.LexerError{
 				Offset: in.GetPos(),
@@ -2050,7 +7596,7 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels19(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels19(out *jwriter.Writer, in ResponseCreatorSubscrube) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels48(out *jwriter.Writer, in ResponseCreator) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2084,108 +7630,38 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels19(out *jwriter.W
 		out.RawString(prefix)
 		out.String(string(in.Cover))
 	}
-	{
-		const prefix string = ",\"awards_id\":"
+	if in.Currency != "" {
+		const prefix string = ",\"currency\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.AwardsId))
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v ResponseCreatorSubscrube) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels19(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ResponseCreatorSubscrube) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels19(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *ResponseCreatorSubscrube) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels19(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ResponseCreatorSubscrube) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels19(l, v)
-}
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels20(in *jlexer.Lexer, out *ResponseCreatorPostsViews) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "count_posts_views":
-			out.CountPostsViews = int64(in.Int64())
-		default:
-			in.AddError(&jlexer.LexerError{
-				Offset: in.GetPos(),
-				Reason: "unknown field",
-				Data:   key,
-			})
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels20(out *jwriter.Writer, in ResponseCreatorPostsViews) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"count_posts_views\":"
-		out.RawString(prefix[1:])
-		out.Int64(int64(in.CountPostsViews))
+		out.String(string(in.Currency))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ResponseCreatorPostsViews) MarshalJSON() ([]byte, error) {
+func (v ResponseCreator) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels20(&w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels48(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ResponseCreatorPostsViews) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels20(w, v)
+func (v ResponseCreator) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels48(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ResponseCreatorPostsViews) UnmarshalJSON(data []byte) error {
+func (v *ResponseCreator) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels20(&r, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels48(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ResponseCreatorPostsViews) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels20(l, v)
+func (v *ResponseCreator) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels48(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels21(in *jlexer.Lexer, out *ResponseCreatorPayments) {
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels49(in *jlexer.Lexer, out *ResponseCheckouts) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2204,25 +7680,25 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels21(in *jlexer.Lex
 			continue
 		}
 		switch key {
-		case "payments":
+		case "checkouts":
 			if in.IsNull() {
 				in.Skip()
-				out.Payments = nil
+				out.Checkouts = nil
 			} else {
 				in.Delim('[')
-				if out.Payments == nil {
+				if out.Checkouts == nil {
 					if !in.IsDelim(']') {
-						out.Payments = make([]models.CreatorPayments, 0, 0)
+						out.Checkouts = make([]models.PayTokenInfo, 0, 0)
 					} else {
-						out.Payments = []models.CreatorPayments{}
+						out.Checkouts = []models.PayTokenInfo{}
 					}
 				} else {
-					out.Payments = (out.Payments)[:0]
+					out.Checkouts = (out.Checkouts)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v31 models.CreatorPayments
-					easyjson316682a0DecodePatreonInternalAppModels1(in, &v31)
-					out.Payments = append(out.Payments, v31)
+					var v103 models.PayTokenInfo
+					easyjson316682a0DecodePatreonInternalAppModels19(in, &v103)
+					out.Checkouts = append(out.Checkouts, v103)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -2241,22 +7717,22 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels21(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels21(out *jwriter.Writer, in ResponseCreatorPayments) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels49(out *jwriter.Writer, in ResponseCheckouts) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"payments\":"
+		const prefix string = ",\"checkouts\":"
 		out.RawString(prefix[1:])
-		if in.Payments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Checkouts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v32, v33 := range in.Payments {
-				if v32 > 0 {
+			for v104, v105 := range in.Checkouts {
+				if v104 > 0 {
 					out.RawByte(',')
 				}
-				easyjson316682a0EncodePatreonInternalAppModels1(out, v33)
+				easyjson316682a0EncodePatreonInternalAppModels19(out, v105)
 			}
 			out.RawByte(']')
 		}
@@ -2265,29 +7741,29 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels21(out *jwriter.W
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ResponseCreatorPayments) MarshalJSON() ([]byte, error) {
+func (v ResponseCheckouts) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels21(&w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels49(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ResponseCreatorPayments) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels21(w, v)
+func (v ResponseCheckouts) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels49(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ResponseCreatorPayments) UnmarshalJSON(data []byte) error {
+func (v *ResponseCheckouts) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels21(&r, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels49(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ResponseCreatorPayments) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels21(l, v)
+func (v *ResponseCheckouts) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels49(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppModels1(in *jlexer.Lexer, out *models.CreatorPayments) {
+func easyjson316682a0DecodePatreonInternalAppModels19(in *jlexer.Lexer, out *models.PayTokenInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2306,20 +7782,34 @@ func easyjson316682a0DecodePatreonInternalAppModels1(in *jlexer.Lexer, out *mode
 			continue
 		}
 		switch key {
-		case "user_nickname":
-			out.UserNickname = string(in.String())
-		case "amount":
-			out.Amount = float64(in.Float64())
-		case "date":
+		case "token":
+			out.Token = string(in.String())
+		case "user_id":
+			out.UserID = int64(in.Int64())
+		case "creator_id":
+			out.CreatorID = int64(in.Int64())
+		case "award_id":
+			out.AwardID = int64(in.Int64())
+		case "price":
 			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Date).UnmarshalJSON(data))
+				in.AddError((out.Price).UnmarshalJSON(data))
+			}
+		case "currency":
+			out.Currency = string(in.String())
+		case "expires_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ExpiresAt).UnmarshalJSON(data))
+			}
+		case "used":
+			out.Used = bool(in.Bool())
+		case "promo_code_id":
+			out.PromoCodeID = int64(in.Int64())
+		case "promo_code":
+			out.PromoCode = string(in.String())
+		case "discount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Discount).UnmarshalJSON(data))
 			}
-		case "creator_id":
-			out.CreatorID = int64(in.Int64())
-		case "user_id":
-			out.UserID = int64(in.Int64())
-		case "status":
-			out.Status = bool(in.Bool())
 		default:
 			in.AddError(&jlexer.LexerError{
 				Offset: in.GetPos(),
@@ -2334,43 +7824,68 @@ func easyjson316682a0DecodePatreonInternalAppModels1(in *jlexer.Lexer, out *mode
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppModels1(out *jwriter.Writer, in models.CreatorPayments) {
+func easyjson316682a0EncodePatreonInternalAppModels19(out *jwriter.Writer, in models.PayTokenInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user_nickname\":"
+		const prefix string = ",\"token\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.UserNickname))
+		out.String(string(in.Token))
 	}
 	{
-		const prefix string = ",\"amount\":"
+		const prefix string = ",\"user_id\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.Amount))
+		out.Int64(int64(in.UserID))
 	}
 	{
-		const prefix string = ",\"date\":"
-		out.RawString(prefix)
-		out.Raw((in.Date).MarshalJSON())
-	}
-	if in.CreatorID != 0 {
 		const prefix string = ",\"creator_id\":"
 		out.RawString(prefix)
 		out.Int64(int64(in.CreatorID))
 	}
-	if in.UserID != 0 {
-		const prefix string = ",\"user_id\":"
+	{
+		const prefix string = ",\"award_id\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.UserID))
+		out.Int64(int64(in.AwardID))
 	}
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Raw((in.Price).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"expires_at\":"
+		out.RawString(prefix)
+		out.Raw((in.ExpiresAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"used\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Status))
+		out.Bool(bool(in.Used))
+	}
+	if in.PromoCodeID != 0 {
+		const prefix string = ",\"promo_code_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PromoCodeID))
+	}
+	if in.PromoCode != "" {
+		const prefix string = ",\"promo_code\":"
+		out.RawString(prefix)
+		out.String(string(in.PromoCode))
+	}
+	if in.Discount != 0 {
+		const prefix string = ",\"discount\":"
+		out.RawString(prefix)
+		out.Raw((in.Discount).MarshalJSON())
 	}
 	out.RawByte('}')
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels22(in *jlexer.Lexer, out *ResponseCreatorCountSubscribers) {
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels50(in *jlexer.Lexer, out *ResponseCheckout) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2389,8 +7904,10 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels22(in *jlexer.Lex
 			continue
 		}
 		switch key {
-		case "count_subscribers":
-			out.CountSubscribers = int64(in.Int64())
+		case "provider":
+			out.Provider = string(in.String())
+		case "url":
+			out.Url = string(in.String())
 		default:
 			in.AddError(&jlexer.LexerError{
 				Offset: in.GetPos(),
@@ -2405,42 +7922,47 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels22(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels22(out *jwriter.Writer, in ResponseCreatorCountSubscribers) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels50(out *jwriter.Writer, in ResponseCheckout) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"count_subscribers\":"
+		const prefix string = ",\"provider\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.CountSubscribers))
+		out.String(string(in.Provider))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.Url))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ResponseCreatorCountSubscribers) MarshalJSON() ([]byte, error) {
+func (v ResponseCheckout) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels22(&w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels50(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ResponseCreatorCountSubscribers) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels22(w, v)
+func (v ResponseCheckout) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels50(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ResponseCreatorCountSubscribers) UnmarshalJSON(data []byte) error {
+func (v *ResponseCheckout) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels22(&r, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels50(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ResponseCreatorCountSubscribers) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels22(l, v)
+func (v *ResponseCheckout) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels50(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels23(in *jlexer.Lexer, out *ResponseCreatorCountPosts) {
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels51(in *jlexer.Lexer, out *ResponseBlockedUsers) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2459,8 +7981,29 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels23(in *jlexer.Lex
 			continue
 		}
 		switch key {
-		case "count_posts":
-			out.CountPosts = int64(in.Int64())
+		case "blocked_users":
+			if in.IsNull() {
+				in.Skip()
+				out.BlockedUsers = nil
+			} else {
+				in.Delim('[')
+				if out.BlockedUsers == nil {
+					if !in.IsDelim(']') {
+						out.BlockedUsers = make([]models.BlockedUser, 0, 1)
+					} else {
+						out.BlockedUsers = []models.BlockedUser{}
+					}
+				} else {
+					out.BlockedUsers = (out.BlockedUsers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v106 models.BlockedUser
+					easyjson316682a0DecodePatreonInternalAppModels20(in, &v106)
+					out.BlockedUsers = append(out.BlockedUsers, v106)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.AddError(&jlexer.LexerError{
 				Offset: in.GetPos(),
@@ -2475,42 +8018,53 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels23(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels23(out *jwriter.Writer, in ResponseCreatorCountPosts) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels51(out *jwriter.Writer, in ResponseBlockedUsers) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"count_posts\":"
+		const prefix string = ",\"blocked_users\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.CountPosts))
+		if in.BlockedUsers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v107, v108 := range in.BlockedUsers {
+				if v107 > 0 {
+					out.RawByte(',')
+				}
+				easyjson316682a0EncodePatreonInternalAppModels20(out, v108)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ResponseCreatorCountPosts) MarshalJSON() ([]byte, error) {
+func (v ResponseBlockedUsers) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels23(&w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels51(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ResponseCreatorCountPosts) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels23(w, v)
+func (v ResponseBlockedUsers) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels51(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ResponseCreatorCountPosts) UnmarshalJSON(data []byte) error {
+func (v *ResponseBlockedUsers) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels23(&r, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels51(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ResponseCreatorCountPosts) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels23(l, v)
+func (v *ResponseBlockedUsers) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels51(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels24(in *jlexer.Lexer, out *ResponseCreator) {
+func easyjson316682a0DecodePatreonInternalAppModels20(in *jlexer.Lexer, out *models.BlockedUser) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2529,18 +8083,16 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels24(in *jlexer.Lex
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = int64(in.Int64())
-		case "category":
-			out.Category = string(in.String())
+		case "user_id":
+			out.UserID = int64(in.Int64())
 		case "nickname":
 			out.Nickname = string(in.String())
-		case "description":
-			out.Description = string(in.String())
 		case "avatar":
 			out.Avatar = string(in.String())
-		case "cover":
-			out.Cover = string(in.String())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
 		default:
 			in.AddError(&jlexer.LexerError{
 				Offset: in.GetPos(),
@@ -2555,19 +8107,14 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels24(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels24(out *jwriter.Writer, in ResponseCreator) {
+func easyjson316682a0EncodePatreonInternalAppModels20(out *jwriter.Writer, in models.BlockedUser) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"user_id\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.ID))
-	}
-	{
-		const prefix string = ",\"category\":"
-		out.RawString(prefix)
-		out.String(string(in.Category))
+		out.Int64(int64(in.UserID))
 	}
 	{
 		const prefix string = ",\"nickname\":"
@@ -2575,47 +8122,18 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels24(out *jwriter.W
 		out.String(string(in.Nickname))
 	}
 	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
-	}
-	if in.Avatar != "" {
 		const prefix string = ",\"avatar\":"
 		out.RawString(prefix)
 		out.String(string(in.Avatar))
 	}
-	if in.Cover != "" {
-		const prefix string = ",\"cover\":"
+	{
+		const prefix string = ",\"date\":"
 		out.RawString(prefix)
-		out.String(string(in.Cover))
+		out.Raw((in.Date).MarshalJSON())
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v ResponseCreator) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels24(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ResponseCreator) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels24(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *ResponseCreator) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels24(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ResponseCreator) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels24(l, v)
-}
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels25(in *jlexer.Lexer, out *ResponseBalance) {
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels52(in *jlexer.Lexer, out *ResponseBalance) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2637,7 +8155,7 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels25(in *jlexer.Lex
 		case "user_id":
 			out.ID = int64(in.Int64())
 		case "balance":
-			out.Balance = models.Money(in.Float64())
+			easyjson316682a0DecodePatreonInternalAppModels17(in, &out.Balance)
 		default:
 			in.AddError(&jlexer.LexerError{
 				Offset: in.GetPos(),
@@ -2652,7 +8170,7 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels25(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels25(out *jwriter.Writer, in ResponseBalance) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels52(out *jwriter.Writer, in ResponseBalance) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2664,7 +8182,7 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels25(out *jwriter.W
 	{
 		const prefix string = ",\"balance\":"
 		out.RawString(prefix)
-		out.Raw((in.Balance).MarshalJSON())
+		easyjson316682a0EncodePatreonInternalAppModels17(out, in.Balance)
 	}
 	out.RawByte('}')
 }
@@ -2672,27 +8190,27 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels25(out *jwriter.W
 // MarshalJSON supports json.Marshaler interface
 func (v ResponseBalance) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels25(&w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels52(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ResponseBalance) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels25(w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels52(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ResponseBalance) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels25(&r, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels52(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ResponseBalance) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels25(l, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels52(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels26(in *jlexer.Lexer, out *ResponseAwards) {
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels53(in *jlexer.Lexer, out *ResponseAwards) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2727,9 +8245,9 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels26(in *jlexer.Lex
 					out.Awards = (out.Awards)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v34 ResponseAward
-					(v34).UnmarshalEasyJSON(in)
-					out.Awards = append(out.Awards, v34)
+					var v109 ResponseAward
+					(v109).UnmarshalEasyJSON(in)
+					out.Awards = append(out.Awards, v109)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -2748,7 +8266,7 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels26(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels26(out *jwriter.Writer, in ResponseAwards) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels53(out *jwriter.Writer, in ResponseAwards) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2759,11 +8277,11 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels26(out *jwriter.W
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v35, v36 := range in.Awards {
-				if v35 > 0 {
+			for v110, v111 := range in.Awards {
+				if v110 > 0 {
 					out.RawByte(',')
 				}
-				(v36).MarshalEasyJSON(out)
+				(v111).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -2774,27 +8292,27 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels26(out *jwriter.W
 // MarshalJSON supports json.Marshaler interface
 func (v ResponseAwards) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels26(&w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels53(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ResponseAwards) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels26(w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels53(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ResponseAwards) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels26(&r, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels53(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ResponseAwards) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels26(l, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels53(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels27(in *jlexer.Lexer, out *ResponseAward) {
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels54(in *jlexer.Lexer, out *ResponseAward) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2820,13 +8338,31 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels27(in *jlexer.Lex
 		case "description":
 			out.Description = string(in.String())
 		case "price":
-			out.Price = int64(in.Int64())
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Price).UnmarshalJSON(data))
+			}
+		case "currency":
+			out.Currency = string(in.String())
 		case "color":
 			(out.Color).UnmarshalEasyJSON(in)
 		case "cover":
 			out.Cover = string(in.String())
 		case "child_award":
 			out.ChildAward = int64(in.Int64())
+		case "trial_days":
+			out.TrialDays = int64(in.Int64())
+		case "max_subscribers":
+			out.MaxSubscribers = int64(in.Int64())
+		case "seats_left":
+			if in.IsNull() {
+				in.Skip()
+				out.SeatsLeft = nil
+			} else {
+				if out.SeatsLeft == nil {
+					out.SeatsLeft = new(int64)
+				}
+				*out.SeatsLeft = int64(in.Int64())
+			}
 		default:
 			in.AddError(&jlexer.LexerError{
 				Offset: in.GetPos(),
@@ -2841,7 +8377,7 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels27(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels27(out *jwriter.Writer, in ResponseAward) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels54(out *jwriter.Writer, in ResponseAward) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2863,7 +8399,12 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels27(out *jwriter.W
 	if in.Price != 0 {
 		const prefix string = ",\"price\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Price))
+		out.Raw((in.Price).MarshalJSON())
+	}
+	if in.Currency != "" {
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
 	}
 	if true {
 		const prefix string = ",\"color\":"
@@ -2880,33 +8421,48 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels27(out *jwriter.W
 		out.RawString(prefix)
 		out.Int64(int64(in.ChildAward))
 	}
+	if in.TrialDays != 0 {
+		const prefix string = ",\"trial_days\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TrialDays))
+	}
+	if in.MaxSubscribers != 0 {
+		const prefix string = ",\"max_subscribers\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MaxSubscribers))
+	}
+	if in.SeatsLeft != nil {
+		const prefix string = ",\"seats_left\":"
+		out.RawString(prefix)
+		out.Int64(int64(*in.SeatsLeft))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v ResponseAward) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels27(&w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels54(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ResponseAward) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels27(w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels54(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ResponseAward) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels27(&r, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels54(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ResponseAward) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels27(l, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels54(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels28(in *jlexer.Lexer, out *ResponseAvailablePosts) {
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels55(in *jlexer.Lexer, out *ResponseAvailablePosts) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2941,9 +8497,9 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels28(in *jlexer.Lex
 					out.AvailablePosts = (out.AvailablePosts)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v37 models.AvailablePost
-					easyjson316682a0DecodePatreonInternalAppModels2(in, &v37)
-					out.AvailablePosts = append(out.AvailablePosts, v37)
+					var v112 models.AvailablePost
+					easyjson316682a0DecodePatreonInternalAppModels21(in, &v112)
+					out.AvailablePosts = append(out.AvailablePosts, v112)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -2962,7 +8518,7 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels28(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels28(out *jwriter.Writer, in ResponseAvailablePosts) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels55(out *jwriter.Writer, in ResponseAvailablePosts) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2973,11 +8529,11 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels28(out *jwriter.W
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v38, v39 := range in.AvailablePosts {
-				if v38 > 0 {
+			for v113, v114 := range in.AvailablePosts {
+				if v113 > 0 {
 					out.RawByte(',')
 				}
-				easyjson316682a0EncodePatreonInternalAppModels2(out, v39)
+				easyjson316682a0EncodePatreonInternalAppModels21(out, v114)
 			}
 			out.RawByte(']')
 		}
@@ -2988,27 +8544,27 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels28(out *jwriter.W
 // MarshalJSON supports json.Marshaler interface
 func (v ResponseAvailablePosts) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels28(&w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels55(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ResponseAvailablePosts) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels28(w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels55(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ResponseAvailablePosts) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels28(&r, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels55(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ResponseAvailablePosts) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels28(l, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels55(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppModels2(in *jlexer.Lexer, out *models.AvailablePost) {
+func easyjson316682a0DecodePatreonInternalAppModels21(in *jlexer.Lexer, out *models.AvailablePost) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3055,6 +8611,51 @@ func easyjson316682a0DecodePatreonInternalAppModels2(in *jlexer.Lexer, out *mode
 			}
 		case "is_draft":
 			out.IsDraft = bool(in.Bool())
+		case "unlock_price":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UnlockPrice).UnmarshalJSON(data))
+			}
+		case "unlocked":
+			out.Unlocked = bool(in.Bool())
+		case "publish_at":
+			if in.IsNull() {
+				in.Skip()
+				out.PublishAt = nil
+			} else {
+				if out.PublishAt == nil {
+					out.PublishAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.PublishAt).UnmarshalJSON(data))
+				}
+			}
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v115 string
+					v115 = string(in.String())
+					out.Tags = append(out.Tags, v115)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "preview":
+			out.Preview = string(in.String())
+		case "locked":
+			out.Locked = bool(in.Bool())
 		default:
 			in.AddError(&jlexer.LexerError{
 				Offset: in.GetPos(),
@@ -3069,7 +8670,7 @@ func easyjson316682a0DecodePatreonInternalAppModels2(in *jlexer.Lexer, out *mode
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppModels2(out *jwriter.Writer, in models.AvailablePost) {
+func easyjson316682a0EncodePatreonInternalAppModels21(out *jwriter.Writer, in models.AvailablePost) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3138,9 +8739,48 @@ func easyjson316682a0EncodePatreonInternalAppModels2(out *jwriter.Writer, in mod
 		out.RawString(prefix)
 		out.Bool(bool(in.IsDraft))
 	}
+	{
+		const prefix string = ",\"unlock_price\":"
+		out.RawString(prefix)
+		out.Raw((in.UnlockPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"unlocked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Unlocked))
+	}
+	if in.PublishAt != nil {
+		const prefix string = ",\"publish_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.PublishAt).MarshalJSON())
+	}
+	if len(in.Tags) != 0 {
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v116, v117 := range in.Tags {
+				if v116 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v117))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"preview\":"
+		out.RawString(prefix)
+		out.String(string(in.Preview))
+	}
+	{
+		const prefix string = ",\"locked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Locked))
+	}
 	out.RawByte('}')
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels29(in *jlexer.Lexer, out *ResponseAttach) {
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels56(in *jlexer.Lexer, out *ResponseAttach) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3179,7 +8819,7 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels29(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels29(out *jwriter.Writer, in ResponseAttach) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels56(out *jwriter.Writer, in ResponseAttach) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3204,27 +8844,27 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels29(out *jwriter.W
 // MarshalJSON supports json.Marshaler interface
 func (v ResponseAttach) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels29(&w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels56(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ResponseAttach) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels29(w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels56(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ResponseAttach) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels29(&r, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels56(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ResponseAttach) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels29(l, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels56(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels30(in *jlexer.Lexer, out *ResponseApplyAttach) {
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels57(in *jlexer.Lexer, out *ResponseApplyAttach) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3259,9 +8899,9 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels30(in *jlexer.Lex
 					out.IDs = (out.IDs)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v40 int64
-					v40 = int64(in.Int64())
-					out.IDs = append(out.IDs, v40)
+					var v118 int64
+					v118 = int64(in.Int64())
+					out.IDs = append(out.IDs, v118)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -3280,7 +8920,7 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels30(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels30(out *jwriter.Writer, in ResponseApplyAttach) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels57(out *jwriter.Writer, in ResponseApplyAttach) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3291,11 +8931,11 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels30(out *jwriter.W
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v41, v42 := range in.IDs {
-				if v41 > 0 {
+			for v119, v120 := range in.IDs {
+				if v119 > 0 {
 					out.RawByte(',')
 				}
-				out.Int64(int64(v42))
+				out.Int64(int64(v120))
 			}
 			out.RawByte(']')
 		}
@@ -3306,27 +8946,27 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels30(out *jwriter.W
 // MarshalJSON supports json.Marshaler interface
 func (v ResponseApplyAttach) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels30(&w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels57(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ResponseApplyAttach) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels30(w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels57(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ResponseApplyAttach) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels30(&r, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels57(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ResponseApplyAttach) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels30(l, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels57(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels31(in *jlexer.Lexer, out *ProfileResponse) {
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels58(in *jlexer.Lexer, out *ProfileResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3369,7 +9009,7 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels31(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels31(out *jwriter.Writer, in ProfileResponse) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels58(out *jwriter.Writer, in ProfileResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3404,27 +9044,27 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels31(out *jwriter.W
 // MarshalJSON supports json.Marshaler interface
 func (v ProfileResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels31(&w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels58(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ProfileResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels31(w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels58(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ProfileResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels31(&r, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels58(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ProfileResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels31(l, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels58(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels32(in *jlexer.Lexer, out *PayTokenResponse) {
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels59(in *jlexer.Lexer, out *PayTokenResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3459,7 +9099,7 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels32(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels32(out *jwriter.Writer, in PayTokenResponse) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels59(out *jwriter.Writer, in PayTokenResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3474,27 +9114,27 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels32(out *jwriter.W
 // MarshalJSON supports json.Marshaler interface
 func (v PayTokenResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels32(&w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels59(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PayTokenResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels32(w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels59(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PayTokenResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels32(&r, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels59(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PayTokenResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels32(l, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels59(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels33(in *jlexer.Lexer, out *PayAccountResponse) {
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels60(in *jlexer.Lexer, out *PayAccountResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3529,7 +9169,7 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels33(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels33(out *jwriter.Writer, in PayAccountResponse) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels60(out *jwriter.Writer, in PayAccountResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3544,27 +9184,27 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels33(out *jwriter.W
 // MarshalJSON supports json.Marshaler interface
 func (v PayAccountResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels33(&w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels60(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PayAccountResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels33(w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels60(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PayAccountResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels33(&r, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels60(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PayAccountResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels33(l, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels60(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels34(in *jlexer.Lexer, out *OkResponse) {
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels61(in *jlexer.Lexer, out *OkResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3599,7 +9239,7 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels34(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels34(out *jwriter.Writer, in OkResponse) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels61(out *jwriter.Writer, in OkResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3614,27 +9254,27 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels34(out *jwriter.W
 // MarshalJSON supports json.Marshaler interface
 func (v OkResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels34(&w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels61(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v OkResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels34(w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels61(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *OkResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels34(&r, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels61(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *OkResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels34(l, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels61(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels35(in *jlexer.Lexer, out *IdResponse) {
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels62(in *jlexer.Lexer, out *IdResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3669,7 +9309,7 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels35(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels35(out *jwriter.Writer, in IdResponse) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels62(out *jwriter.Writer, in IdResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3684,27 +9324,27 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels35(out *jwriter.W
 // MarshalJSON supports json.Marshaler interface
 func (v IdResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels35(&w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels62(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v IdResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels35(w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels62(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *IdResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels35(&r, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels62(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *IdResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels35(l, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels62(l, v)
 }
-func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels36(in *jlexer.Lexer, out *ErrResponse) {
+func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels63(in *jlexer.Lexer, out *ErrResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3739,7 +9379,7 @@ func easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels36(in *jlexer.Lex
 		in.Consumed()
 	}
 }
-func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels36(out *jwriter.Writer, in ErrResponse) {
+func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels63(out *jwriter.Writer, in ErrResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3754,23 +9394,23 @@ func easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels36(out *jwriter.W
 // MarshalJSON supports json.Marshaler interface
 func (v ErrResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels36(&w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels63(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ErrResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels36(w, v)
+	easyjson316682a0EncodePatreonInternalAppDeliveryHttpModels63(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ErrResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels36(&r, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels63(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ErrResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels36(l, v)
+	easyjson316682a0DecodePatreonInternalAppDeliveryHttpModels63(l, v)
 }
@@ -0,0 +1,38 @@
+package http_models
+
+import (
+	"patreon/internal/app/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponseExportPayment_CSVRecord(t *testing.T) {
+	payment := ResponseExportPayment{
+		Date:         time.Date(2021, 12, 1, 12, 0, 0, 0, time.UTC),
+		Type:         models.PaymentTip,
+		State:        models.PaymentSucceeded,
+		Currency:     "RUB",
+		PromoCode:    "+PROMO",
+		AwardName:    "@award",
+		UserNickname: "-nickname",
+		TipMessage:   "=HYPERLINK(\"http://example.com\")",
+	}
+	record := payment.CSVRecord()
+	assert.Len(t, record, len(ExportPaymentsHeader))
+	assert.Equal(t, "'+PROMO", record[7])
+	assert.Equal(t, "'@award", record[9])
+	assert.Equal(t, "'-nickname", record[11])
+	assert.Equal(t, "'=HYPERLINK(\"http://example.com\")", record[12])
+
+	payment.TipMessage = "\tmessage"
+	payment.UserNickname = "\rnickname"
+	payment.PromoCode = "promo=code"
+	payment.AwardName = ""
+	record = payment.CSVRecord()
+	assert.Equal(t, "'\tmessage", record[12])
+	assert.Equal(t, "'\rnickname", record[11])
+	assert.Equal(t, "promo=code", record[7])
+	assert.Equal(t, "", record[9])
+}
@@ -1,6 +1,7 @@
 package http_models
 
 import (
+	"encoding/json"
 	"github.com/microcosm-cc/bluemonday"
 	"patreon/internal/app/models"
 )
@@ -38,22 +39,84 @@ func (req *RequestAwards) Sanitize(sanitizer bluemonday.Policy) {
 func (req *RequestPosts) Sanitize(sanitizer bluemonday.Policy) {
 	req.Title = sanitizer.Sanitize(req.Title)
 	req.Description = sanitizer.Sanitize(req.Description)
+	req.Preview = sanitizer.Sanitize(req.Preview)
+	for _, tags := range [][]string{req.Tags, req.AddTags, req.RemoveTags} {
+		for i := range tags {
+			tags[i] = sanitizer.Sanitize(tags[i])
+		}
+	}
 }
 
 func (req *RequestText) Sanitize(sanitizer bluemonday.Policy) {
 	req.Text = sanitizer.Sanitize(req.Text)
 }
 
+func (req *RequestPoll) Sanitize(sanitizer bluemonday.Policy) {
+	req.Question = sanitizer.Sanitize(req.Question)
+	for i := range req.Options {
+		req.Options[i] = sanitizer.Sanitize(req.Options[i])
+	}
+}
+
+// sanitizePollValue sanitize question and options of poll in attach value without escaping of json,
+// value which is not poll is checked later
+func sanitizePollValue(value string, sanitizer bluemonday.Policy) string {
+	poll := &models.PollAttach{}
+	if err := json.Unmarshal([]byte(value), poll); err != nil {
+		return value
+	}
+	req := &RequestPoll{Question: poll.Question, Options: poll.Options}
+	req.Sanitize(sanitizer)
+	poll.Question, poll.Options = req.Question, req.Options
+	return poll.Value()
+}
+
+func (req *RequestPollVote) Sanitize(_ bluemonday.Policy) {}
+
 func (req *SubscribeRequest) Sanitize(sanitizer bluemonday.Policy) {
 	req.Token = sanitizer.Sanitize(req.Token)
 }
+
+func (req *RequestChangeTier) Sanitize(_ bluemonday.Policy) {}
+
+func (req *RequestPayout) Sanitize(_ bluemonday.Policy) {}
+
+func (req *RequestPayoutState) Sanitize(_ bluemonday.Policy) {}
+
+func (req *RequestPromoCode) Sanitize(sanitizer bluemonday.Policy) {
+	req.Code = sanitizer.Sanitize(req.Code)
+	req.DiscountType = sanitizer.Sanitize(req.DiscountType)
+}
+
+func (req *RequestGift) Sanitize(sanitizer bluemonday.Policy) {
+	req.RecipientNickname = sanitizer.Sanitize(req.RecipientNickname)
+}
+
+func (req *RequestTip) Sanitize(sanitizer bluemonday.Policy) {
+	req.Message = sanitizer.Sanitize(req.Message)
+}
+
+func (req *RequestRefund) Sanitize(sanitizer bluemonday.Policy) {
+	req.Reason = sanitizer.Sanitize(req.Reason)
+}
+
+func (req *RequestRedeemGift) Sanitize(sanitizer bluemonday.Policy) {
+	req.Code = sanitizer.Sanitize(req.Code)
+}
+
+func (req *RequestBlockUser) Sanitize(_ bluemonday.Policy) {}
+
 func (req *RequestChangeNickname) Sanitize(sanitizer bluemonday.Policy) {
 	req.OldNickname = sanitizer.Sanitize(req.OldNickname)
 	req.NewNickname = sanitizer.Sanitize(req.NewNickname)
 }
 
 func (req *RequestAttach) Sanitize(sanitizer bluemonday.Policy) {
-	req.Value = sanitizer.Sanitize(req.Value)
+	if req.Type == models.Poll {
+		req.Value = sanitizePollValue(req.Value, sanitizer)
+	} else {
+		req.Value = sanitizer.Sanitize(req.Value)
+	}
 	req.Status = sanitizer.Sanitize(req.Status)
 	req.Type = models.DataType(sanitizer.Sanitize(string(req.Type)))
 }
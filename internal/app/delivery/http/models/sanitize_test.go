@@ -40,10 +40,32 @@ func TestSanitize(t *testing.T) {
 	_ = ToResponseCreator(models.Creator{})
 	_ = ToResponsePostWithAttaches(models.PostWithAttach{Post: &models.Post{}, Data: []models.AttachWithoutLevel{{Value: "sdsd"}}})
 	_ = ToRProfileResponse(models.User{})
-	_ = ToSubscribersCreatorResponse([]models.User{{Nickname: ""}})
+	_ = ToSubscribersCreatorResponse([]models.CreatorSubscriber{{Nickname: ""}})
 	_ = ToSubscriptionsUser([]models.CreatorSubscribe{{ID: 3}})
 	_ = ToResponseAttach(models.AttachWithoutLevel{})
 	_ = ToResponseUserPayments([]models.UserPayments{{Payments: models.Payments{Amount: 0}}})
 	_ = ToResponseInfo(models.Info{})
 	_ = ToResponseCreators([]models.Creator{{Nickname: ""}})
 }
+
+func TestToResponseLockedPost(t *testing.T) {
+	res := ToResponseLockedPost(models.PostWithAttach{
+		Post: &models.Post{ID: 2, Title: "title", Description: "full text", Awards: 3, Preview: "first lines"},
+		Data: []models.AttachWithoutLevel{{ID: 1, Type: models.Text, Value: "secret"}, {ID: 2, Type: models.Text}}})
+	require.Equal(t, ResponseLockedPost{
+		Post: ResponseLockedPostInfo{ID: 2, Title: "title", Preview: "first lines", Awards: 3, Locked: true},
+		Data: []models.AttachCount{{Type: models.Text, Count: 2}},
+	}, res)
+}
+
+func TestRequestAttach_SanitizePoll(t *testing.T) {
+	att := RequestAttach{Type: models.Poll, Status: "add",
+		Value: `{"question": "Next <script>alert(1)</script>episode?", "options": ["cats", "dogs<script>alert(2)</script>"]}`}
+	att.Sanitize(*bluemonday.UGCPolicy())
+	require.Equal(t, `{"question":"Next episode?","options":["cats","dogs"],"multiple":false,`+
+		`"results_before_vote":false}`, att.Value)
+
+	att = RequestAttach{Type: models.Poll, Value: "not poll"}
+	att.Sanitize(*bluemonday.UGCPolicy())
+	require.Equal(t, "not poll", att.Value)
+}
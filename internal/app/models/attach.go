@@ -46,7 +46,7 @@ func AttachValidError() models_utilits.ExtractorErrorByName {
 func (att *Attach) Validate() error {
 	err := validation.Errors{
 		"type": validation.Validate(att.Type, validation.In(Music, Video,
-			Files, Text, Image)),
+			Files, Text, Image, Poll)),
 		"id":    validation.Validate(att.Id, validation.Min(0)),
 		"level": validation.Validate(att.Level, validation.Min(1)),
 	}.Filter()
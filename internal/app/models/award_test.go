@@ -18,6 +18,18 @@ func TestAward_ValidateIncorrectPrice(t *testing.T) {
 	expErr := IncorrectAwardsPrice
 	assert.Equal(t, expErr, aw.Validate())
 }
+func TestAward_ValidateIncorrectTrialDays(t *testing.T) {
+	aw := TestAward()
+	aw.TrialDays = -1
+	assert.Equal(t, IncorrectTrialDays, aw.Validate())
+	aw.TrialDays = MaxTrialDays + 1
+	assert.Equal(t, IncorrectTrialDays, aw.Validate())
+}
+func TestAward_ValidateIncorrectMaxSubscribers(t *testing.T) {
+	aw := TestAward()
+	aw.MaxSubscribers = -1
+	assert.Equal(t, IncorrectMaxSubscribers, aw.Validate())
+}
 func TestAward_Validate_OK(t *testing.T) {
 	aw := TestAward()
 	assert.NoError(t, aw.Validate())
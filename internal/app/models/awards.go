@@ -9,30 +9,45 @@ import (
 	"strconv"
 )
 
+// MaxTrialDays max length of free trial on award
+const MaxTrialDays = 90
+
 type Award struct {
 	ID          int64      `json:"awards_id"`
 	Name        string     `json:"name"`
 	Description string     `json:"description,omitempty"`
-	Price       int64      `json:"price,omitempty"`
+	Price       Decimal    `json:"price,omitempty"`
+	Currency    string     `json:"currency,omitempty"` // settlement currency of creator
 	CreatorId   int64      `json:"creator_id"`
 	Color       color.RGBA `json:"color.omitempty"`
 	ChildAward  int64      `json:"child_award"`
 	Cover       string     `json:"cover"`
+	TrialDays   int64      `json:"trial_days,omitempty"` // 0 if award has not free trial
+
+	// MaxSubscribers 0 if count of subscribers is not limited, SeatsLeft is set only for limited award
+	MaxSubscribers int64 `json:"max_subscribers,omitempty"`
+	SeatsLeft      int64 `json:"seats_left,omitempty"`
 }
 
 func (aw *Award) String() string {
 	return fmt.Sprintf("{ID: %s, Name: %s Price: %s}", strconv.Itoa(int(aw.ID)),
-		aw.Name, strconv.Itoa(int(aw.Price)))
+		aw.Name, aw.Price)
 }
 
 // Validate Errors:
 //		EmptyName
 //		IncorrectAwardsPrice
+//		IncorrectTrialDays
+//		IncorrectMaxSubscribers
+//
 // Important can return some other error
 func (aw *Award) Validate() error {
 	err := validation.Errors{
 		"name":  validation.Validate(aw.Name, validation.Required),
-		"price": validation.Validate(aw.Price, validation.Min(0)),
+		"price": validation.Validate(int64(aw.Price), validation.Min(int64(0))),
+		"trial_days": validation.Validate(aw.TrialDays,
+			validation.Min(int64(0)), validation.Max(int64(MaxTrialDays))),
+		"max_subscribers": validation.Validate(aw.MaxSubscribers, validation.Min(int64(0))),
 	}.Filter()
 	if err == nil {
 		return nil
@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+// BlockedUser user in block list of creator, Date is when user was blocked
+type BlockedUser struct {
+	UserID   int64     `json:"user_id"`
+	Nickname string    `json:"nickname"`
+	Avatar   string    `json:"avatar"`
+	Date     time.Time `json:"date"`
+}
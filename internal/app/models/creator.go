@@ -16,6 +16,8 @@ type Creator struct {
 	Description string `json:"description"`
 	Avatar      string `json:"avatar,omitempty"`
 	Cover       string `json:"cover,omitempty"`
+	// Currency settlement currency, prices and balance of creator are in it
+	Currency string `json:"currency,omitempty"`
 }
 
 type CreatorWithAwards struct {
@@ -26,6 +28,7 @@ type CreatorWithAwards struct {
 	Avatar      string `json:"avatar,omitempty"`
 	Cover       string `json:"cover,omitempty"`
 	AwardsId    int64  `json:"awards_id"`
+	Currency    string `json:"currency"`
 }
 
 type CreatorSubscribe struct {
@@ -46,12 +49,14 @@ func (cr *Creator) String() string {
 //		IncorrectCreatorNickname
 //		IncorrectCreatorCategory
 //		IncorrectCreatorDescription
+//		IncorrectCurrency
 // Important can return some other error
 func (cr *Creator) Validate() error {
 	err := validation.Errors{
 		"nickname":    validation.Validate(cr.Nickname, validation.Required),
 		"category":    validation.Validate(cr.Category, validation.Required),
 		"description": validation.Validate(cr.Description, validation.Required),
+		"currency":    validation.Validate(cr.Currency, validation.Match(currencyRegexp)),
 	}.Filter()
 	if err == nil {
 		return nil
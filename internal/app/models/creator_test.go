@@ -37,6 +37,14 @@ func TestCreator_ValidateIncorrectDescription(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, expErr, err)
 }
+func TestCreator_ValidateIncorrectCurrency(t *testing.T) {
+	cr := TestCreator()
+	cr.Currency = "usd"
+
+	err := cr.Validate()
+
+	assert.Equal(t, IncorrectCurrency, err)
+}
 func TestCreator_ValidateCombineIncorrectField(t *testing.T) {
 	cr := TestCreator()
 	cr.Nickname = ""
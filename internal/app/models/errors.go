@@ -23,6 +23,38 @@ var (
 	InvalidPostId               = errors.New("not positive posts id")
 	InvalidUserId               = errors.New("not positive user id")
 	InvalidType                 = errors.New("not positive data type")
+	IncorrectPromoCode          = errors.New("promo code must be from 3 to 32 latin letters, digits, '-' or '_'")
+	IncorrectDiscountType       = errors.New("discount type must be percent or fixed")
+	IncorrectDiscount           = errors.New("discount must be positive, percent discount less than 100")
+	IncorrectMaxUses            = errors.New("max uses must not be negative")
+	IncorrectPromoPeriod        = errors.New("promo code valid_until must be after valid_from")
+	IncorrectGiftPeriods        = errors.New(fmt.Sprintf("gift periods must be from 1 to %v", MaxGiftPeriods))
+	IncorrectTrialDays          = errors.New(fmt.Sprintf("trial days must be from 0 to %v", MaxTrialDays))
+	IncorrectTipAmount          = errors.New("tip amount must be positive")
+	IncorrectTipMessage         = errors.New(fmt.Sprintf("tip message must be not longer %v symbols", MaxTipMessageLength))
+	InvalidUnlockPrice          = errors.New("unlock price must not be negative")
+	InvalidDecimal              = errors.New("amount must be decimal number with at most two fractional digits")
+	IncorrectCurrency           = errors.New("currency must be ISO 4217 code of three capital letters")
+	UnknownCurrency             = errors.New("currency has not exchange rate")
+	InvalidExchangeRate         = errors.New("exchange rate must be positive number")
+	IncorrectPaymentsPeriod     = errors.New("end of payments period must be after its start")
+	IncorrectPaymentState       = errors.New("unknown payment state")
+	IncorrectSubscriptionStatus = errors.New("subscription status must be active or expired")
+	IncorrectSubscribersSort    = errors.New("subscribers sort must be newest, oldest, amount or nickname")
+
+	IncorrectCancelReason = errors.New(fmt.Sprintf("cancel reason must be not longer %v symbols",
+		MaxCancelReasonLength))
+	IncorrectSubscriptionEventKind = errors.New("unknown subscription event kind")
+
+	IncorrectMaxSubscribers = errors.New("max subscribers must not be negative")
+
+	IncorrectPublishAt = errors.New("publish time of scheduled post must be in future")
+
+	IncorrectTag = errors.New("tag must be from 1 to 32 symbols without commas")
+
+	IncorrectPoll = errors.New(fmt.Sprintf("poll must have question and from %v to %v not empty options",
+		MinPollOptions, MaxPollOptions))
+	IncorrectVote = errors.New("vote must contain different options of poll, single choice poll allows one option")
 )
 
 // userValidError Errors:
@@ -46,11 +78,13 @@ func userValidError() models_utilits.ExtractorErrorByName {
 //		IncorrectCreatorNickname
 //		IncorrectCreatorCategory
 //		IncorrectCreatorDescription
+//		IncorrectCurrency
 func creatorValidError() models_utilits.ExtractorErrorByName {
 	validMap := models_utilits.MapOfValidateError{
 		"nickname":    IncorrectCreatorNickname,
 		"category":    IncorrectCreatorCategory,
 		"description": IncorrectCreatorDescription,
+		"currency":    IncorrectCurrency,
 	}
 	return func(key string) error {
 		if val, ok := validMap[key]; ok {
@@ -63,10 +97,14 @@ func creatorValidError() models_utilits.ExtractorErrorByName {
 // awardsValidError Errors:
 //		EmptyName
 //		IncorrectAwardsPrice
+//		IncorrectTrialDays
+//		IncorrectMaxSubscribers
 func awardsValidError() models_utilits.ExtractorErrorByName {
 	validMap := models_utilits.MapOfValidateError{
-		"name":  EmptyName,
-		"price": IncorrectAwardsPrice,
+		"name":            EmptyName,
+		"price":           IncorrectAwardsPrice,
+		"trial_days":      IncorrectTrialDays,
+		"max_subscribers": IncorrectMaxSubscribers,
 	}
 	return func(key string) error {
 		if val, ok := validMap[key]; ok {
@@ -111,11 +149,33 @@ func likeValidError() models_utilits.ExtractorErrorByName {
 //		EmptyTitle
 //		InvalidCreatorId
 //		InvalidAwardsId
+//		InvalidUnlockPrice
 func postValidError() models_utilits.ExtractorErrorByName {
 	validMap := models_utilits.MapOfValidateError{
-		"title":   EmptyTitle,
-		"creator": InvalidCreatorId,
-		"awards":  InvalidAwardsId,
+		"title":        EmptyTitle,
+		"creator":      InvalidCreatorId,
+		"awards":       InvalidAwardsId,
+		"unlock_price": InvalidUnlockPrice,
+	}
+	return func(key string) error {
+		if val, ok := validMap[key]; ok {
+			return val
+		}
+		return nil
+	}
+}
+
+// promoCodeValidError Errors:
+//		IncorrectPromoCode
+//		IncorrectDiscountType
+//		IncorrectDiscount
+//		IncorrectMaxUses
+func promoCodeValidError() models_utilits.ExtractorErrorByName {
+	validMap := models_utilits.MapOfValidateError{
+		"code":          IncorrectPromoCode,
+		"discount_type": IncorrectDiscountType,
+		"discount":      IncorrectDiscount,
+		"max_uses":      IncorrectMaxUses,
 	}
 	return func(key string) error {
 		if val, ok := validMap[key]; ok {
@@ -140,3 +200,33 @@ func attachWithoutLevelValidError() models_utilits.ExtractorErrorByName {
 		return nil
 	}
 }
+
+// giftValidError Errors:
+//		IncorrectGiftPeriods
+func giftValidError() models_utilits.ExtractorErrorByName {
+	validMap := models_utilits.MapOfValidateError{
+		"periods": IncorrectGiftPeriods,
+	}
+	return func(key string) error {
+		if val, ok := validMap[key]; ok {
+			return val
+		}
+		return nil
+	}
+}
+
+// tipValidError Errors:
+//		IncorrectTipAmount
+//		IncorrectTipMessage
+func tipValidError() models_utilits.ExtractorErrorByName {
+	validMap := models_utilits.MapOfValidateError{
+		"amount":  IncorrectTipAmount,
+		"message": IncorrectTipMessage,
+	}
+	return func(key string) error {
+		if val, ok := validMap[key]; ok {
+			return val
+		}
+		return nil
+	}
+}
@@ -0,0 +1,95 @@
+package models
+
+import (
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ExchangeRates prices of one unit of currency in DefaultCurrency, for example USD = "74.35".
+// Rates have arbitrary precision, result of conversion is rounded to hundredths half away from zero
+type ExchangeRates struct {
+	rates map[string]*big.Rat
+}
+
+// NewExchangeRates DefaultCurrency is always supported with rate 1
+// Errors:
+//		IncorrectCurrency
+//		InvalidExchangeRate
+func NewExchangeRates(rates map[string]string) (*ExchangeRates, error) {
+	res := &ExchangeRates{rates: map[string]*big.Rat{DefaultCurrency: big.NewRat(1, 1)}}
+	for currency, value := range rates {
+		currency = strings.ToUpper(currency)
+		if !IsCurrencyCode(currency) {
+			return nil, errors.Wrapf(IncorrectCurrency, "currency %s", currency)
+		}
+		rate, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+		if !ok || rate.Sign() <= 0 {
+			return nil, errors.Wrapf(InvalidExchangeRate, "rate of %s: %s", currency, value)
+		}
+		if currency == DefaultCurrency && rate.Cmp(big.NewRat(1, 1)) != 0 {
+			return nil, errors.Wrapf(InvalidExchangeRate, "rate of %s must be 1", currency)
+		}
+		res.rates[currency] = rate
+	}
+	return res, nil
+}
+
+func (r *ExchangeRates) Supports(currency string) bool {
+	_, ok := r.rates[currency]
+	return ok
+}
+
+// Currencies sorted codes of supported currencies
+func (r *ExchangeRates) Currencies() []string {
+	res := make([]string, 0, len(r.rates))
+	for currency := range r.rates {
+		res = append(res, currency)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// Convert Errors:
+//		UnknownCurrency
+func (r *ExchangeRates) Convert(m Money, to string) (Money, error) {
+	return r.Sum([]Money{m}, to)
+}
+
+// Sum total of amounts in different currencies converted to currency to,
+// total is rounded once, so it does not depend on order of amounts
+// Errors:
+//		UnknownCurrency
+func (r *ExchangeRates) Sum(amounts []Money, to string) (Money, error) {
+	toRate, ok := r.rates[to]
+	if !ok {
+		return Money{}, errors.Wrapf(UnknownCurrency, "currency %s", to)
+	}
+	total := new(big.Rat)
+	for _, m := range amounts {
+		if m.Currency == to {
+			total.Add(total, big.NewRat(int64(m.Amount), decimalScale))
+			continue
+		}
+		rate, ok := r.rates[m.Currency]
+		if !ok {
+			return Money{}, errors.Wrapf(UnknownCurrency, "currency %s", m.Currency)
+		}
+		converted := new(big.Rat).Mul(big.NewRat(int64(m.Amount), decimalScale), rate)
+		total.Add(total, converted.Quo(converted, toRate))
+	}
+	return NewMoney(roundToDecimal(total), to), nil
+}
+
+// roundToDecimal round value to hundredths half away from zero
+func roundToDecimal(value *big.Rat) Decimal {
+	scaled := new(big.Rat).Mul(value, big.NewRat(decimalScale, 1))
+	quo, rem := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+	// |rem| / denom >= 1/2 means rounding away from zero
+	if new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(scaled.Denom()) >= 0 {
+		quo.Add(quo, big.NewInt(int64(scaled.Sign())))
+	}
+	return Decimal(quo.Int64())
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExchangeRates(t *testing.T) {
+	rates, err := NewExchangeRates(map[string]string{"usd": "74.35", "EUR": "84"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"EUR", "RUB", "USD"}, rates.Currencies())
+	assert.True(t, rates.Supports(DefaultCurrency))
+	assert.False(t, rates.Supports("GBP"))
+
+	_, err = NewExchangeRates(map[string]string{"USD": "-1"})
+	assert.True(t, errors.Is(err, InvalidExchangeRate))
+	_, err = NewExchangeRates(map[string]string{"RUB": "2"})
+	assert.True(t, errors.Is(err, InvalidExchangeRate))
+	_, err = NewExchangeRates(map[string]string{"dollar": "70"})
+	assert.True(t, errors.Is(err, IncorrectCurrency))
+}
+
+func TestExchangeRates_Convert(t *testing.T) {
+	rates, err := NewExchangeRates(map[string]string{"USD": "74.35", "EUR": "84.1"})
+	require.NoError(t, err)
+
+	res, err := rates.Convert(NewMoney(NewDecimal(10), "USD"), DefaultCurrency)
+	require.NoError(t, err)
+	assert.Equal(t, NewMoney(Decimal(74350), DefaultCurrency), res)
+
+	// 100 RUB = 1.34499... USD
+	res, err = rates.Convert(NewMoney(NewDecimal(100), DefaultCurrency), "USD")
+	require.NoError(t, err)
+	assert.Equal(t, NewMoney(Decimal(134), "USD"), res)
+
+	// 1 EUR = 1.13113... USD
+	res, err = rates.Convert(NewMoney(NewDecimal(-1), "EUR"), "USD")
+	require.NoError(t, err)
+	assert.Equal(t, NewMoney(Decimal(-113), "USD"), res)
+
+	_, err = rates.Convert(NewMoney(NewDecimal(1), "GBP"), "USD")
+	assert.True(t, errors.Is(err, UnknownCurrency))
+	_, err = rates.Convert(NewMoney(NewDecimal(1), "USD"), "GBP")
+	assert.True(t, errors.Is(err, UnknownCurrency))
+}
+
+func TestExchangeRates_Sum(t *testing.T) {
+	rates, err := NewExchangeRates(map[string]string{"USD": "2"})
+	require.NoError(t, err)
+
+	// each of amounts is 0.005 USD, total is rounded only once
+	res, err := rates.Sum([]Money{
+		NewMoney(Decimal(1), DefaultCurrency),
+		NewMoney(Decimal(1), DefaultCurrency),
+		NewMoney(Decimal(100), "USD"),
+	}, "USD")
+	require.NoError(t, err)
+	assert.Equal(t, NewMoney(Decimal(101), "USD"), res)
+
+	res, err = rates.Sum(nil, DefaultCurrency)
+	require.NoError(t, err)
+	assert.Equal(t, NewMoney(0, DefaultCurrency), res)
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	models_utilits "patreon/internal/app/utilits/models"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+)
+
+type GiftStatus string
+
+const (
+	GiftPending  GiftStatus = "pending"
+	GiftPaid     GiftStatus = "paid"
+	GiftRedeemed GiftStatus = "redeemed"
+)
+
+const MaxGiftPeriods = 12
+
+// Gift award subscription for Periods months paid by one user for other one.
+// Code is known only to payer, RecipientID is 0 until gift is redeemed if it was not bought for nickname
+type Gift struct {
+	ID                int64      `json:"id"`
+	Code              string     `json:"code,omitempty"`
+	PayerID           int64      `json:"payer_id"`
+	PayerNickname     string     `json:"payer_nickname,omitempty"`
+	RecipientID       int64      `json:"recipient_id,omitempty"`
+	RecipientNickname string     `json:"recipient_nickname,omitempty"`
+	CreatorID         int64      `json:"creator_id"`
+	AwardID           int64      `json:"award_id"`
+	Periods           int64      `json:"periods"`
+	Amount            Decimal    `json:"amount"`
+	Currency          string     `json:"currency"`
+	Status            GiftStatus `json:"status"`
+	PayToken          string     `json:"pay_token,omitempty"`
+	Date              time.Time  `json:"date"`
+	RedeemedAt        *time.Time `json:"redeemed_at,omitempty"`
+}
+
+// Validate Errors:
+//		IncorrectGiftPeriods
+//		Error of validation with not known field
+func (gift *Gift) Validate() error {
+	err := validation.Errors{
+		"periods": validation.Validate(gift.Periods, validation.Required,
+			validation.Min(int64(1)), validation.Max(int64(MaxGiftPeriods))),
+	}.Filter()
+	if err == nil {
+		return nil
+	}
+
+	mapOfErr, knowError := models_utilits.ParseErrorToMap(err)
+	if knowError != nil {
+		return errors.Wrap(knowError, "failed error getting in validate gift")
+	}
+
+	if knowError = models_utilits.ExtractValidateError(giftValidError(), mapOfErr); knowError != nil {
+		return knowError
+	}
+
+	return err
+}
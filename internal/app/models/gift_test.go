@@ -0,0 +1,22 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGift_Validate_OK(t *testing.T) {
+	gift := TestGift()
+	assert.NoError(t, gift.Validate())
+	gift.Periods = MaxGiftPeriods
+	assert.NoError(t, gift.Validate())
+}
+
+func TestGift_ValidateIncorrectPeriods(t *testing.T) {
+	gift := TestGift()
+	gift.Periods = 0
+	assert.Equal(t, IncorrectGiftPeriods, gift.Validate())
+	gift.Periods = MaxGiftPeriods + 1
+	assert.Equal(t, IncorrectGiftPeriods, gift.Validate())
+}
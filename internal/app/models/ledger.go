@@ -0,0 +1,63 @@
+package models
+
+import "time"
+
+type LedgerAccount string
+
+const (
+	AccountPayer    LedgerAccount = "payer"
+	AccountCreator  LedgerAccount = "creator"
+	AccountPlatform LedgerAccount = "platform"
+	AccountPayout   LedgerAccount = "payout"
+)
+
+type LedgerOperation string
+
+const (
+	OperationPayment        LedgerOperation = "payment"
+	OperationRefund         LedgerOperation = "refund"
+	OperationPayout         LedgerOperation = "payout"
+	OperationPayoutRejected LedgerOperation = "payout_rejected"
+)
+
+// LedgerEntry one posting of operation, sum of operation postings is zero
+type LedgerEntry struct {
+	ID        int64           `json:"id"`
+	Operation LedgerOperation `json:"operation"`
+	Account   LedgerAccount   `json:"account"`
+	Amount    Decimal         `json:"amount"`
+	PaymentID int64           `json:"payment_id,omitempty"`
+	PayoutID  int64           `json:"payout_id,omitempty"`
+	Date      time.Time       `json:"date"`
+}
+
+type PayoutState string
+
+const (
+	PayoutPending  PayoutState = "pending"
+	PayoutApproved PayoutState = "approved"
+	PayoutPaid     PayoutState = "paid"
+	PayoutRejected PayoutState = "rejected"
+)
+
+type Payout struct {
+	ID        int64       `json:"id"`
+	CreatorID int64       `json:"creator_id"`
+	Amount    Decimal     `json:"amount"`
+	State     PayoutState `json:"state"`
+	Date      time.Time   `json:"date"`
+	Updated   time.Time   `json:"updated"`
+}
+
+// CreatorBalance totals of creator ledger accounts in settlement currency of creator.
+// Requested payouts are already taken from Balance, InPayout is part of them not paid yet
+type CreatorBalance struct {
+	Balance       Decimal `json:"balance"`
+	InPayout      Decimal `json:"in_payout"`
+	PaidOut       Decimal `json:"paid_out"`
+	Fees          Decimal `json:"fees"`
+	Received      Decimal `json:"received"`
+	PaymentsTotal Decimal `json:"payments_total"`
+	Currency      string  `json:"currency"`
+	Reconciled    bool    `json:"reconciled"`
+}
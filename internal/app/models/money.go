@@ -1,12 +1,146 @@
 package models
 
-import "strconv"
+import (
+	"database/sql/driver"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
 
-type Money float64
+// decimalScale count of hundredths in one unit of currency
+const decimalScale = 100
 
-func (m Money) MarshalJSON() ([]byte, error) {
-	if float64(m) == float64(int(m)) {
-		return []byte(strconv.FormatFloat(float64(m), 'f', 1, 32)), nil
+// maxDecimalUnits bound of whole units, so amount in hundredths never overflows int64
+const maxDecimalUnits = 1e15
+
+var currencyRegexp = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// Decimal exact amount of money with two fractional digits, stored in hundredths of unit.
+// Sum and difference of decimals are exact, so they can be compared with ==
+type Decimal int64
+
+// NewDecimal amount of whole units
+func NewDecimal(units int64) Decimal {
+	return Decimal(units * decimalScale)
+}
+
+// ParseDecimal parse amount like "100", "-5.5" or "99.99" without losing precision,
+// fractional digits after hundredths are allowed only if they are zeros
+// Errors:
+//		InvalidDecimal
+func ParseDecimal(s string) (Decimal, error) {
+	s = strings.TrimSpace(s)
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+
+	intPart, fracPart := s, ""
+	if dot := strings.IndexByte(s, '.'); dot >= 0 {
+		intPart, fracPart = s[:dot], s[dot+1:]
+	}
+	if intPart == "" || !isDigits(intPart) || !isDigits(fracPart) {
+		return 0, InvalidDecimal
+	}
+	if len(fracPart) > 2 {
+		if strings.Trim(fracPart[2:], "0") != "" {
+			return 0, InvalidDecimal
+		}
+		fracPart = fracPart[:2]
+	}
+	fracPart += strings.Repeat("0", 2-len(fracPart))
+
+	units, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil || units >= maxDecimalUnits {
+		return 0, InvalidDecimal
 	}
-	return []byte(strconv.FormatFloat(float64(m), 'f', -1, 32)), nil
+	hundredths, _ := strconv.ParseInt(fracPart, 10, 64)
+	res := Decimal(units*decimalScale + hundredths)
+	if negative {
+		res = -res
+	}
+	return res, nil
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Mul amount multiplied by count, for example price of several periods
+func (d Decimal) Mul(count int64) Decimal {
+	return d * Decimal(count)
+}
+
+// Percent part of amount, rounded down to hundredths
+func (d Decimal) Percent(percent int64) Decimal {
+	return d * Decimal(percent) / 100
+}
+
+func (d Decimal) String() string {
+	sign := ""
+	value := int64(d)
+	if value < 0 {
+		sign = "-"
+		value = -value
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, value/decimalScale, value%decimalScale)
+}
+
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON accept amount as json number or string
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	res, err := ParseDecimal(strings.Trim(string(data), `"`))
+	if err != nil {
+		return err
+	}
+	*d = res
+	return nil
+}
+
+// Scan read numeric column as exact amount, integer column is amount of whole units
+func (d *Decimal) Scan(src interface{}) error {
+	var err error
+	switch value := src.(type) {
+	case []byte:
+		*d, err = ParseDecimal(string(value))
+	case string:
+		*d, err = ParseDecimal(value)
+	case int64:
+		*d = NewDecimal(value)
+	case nil:
+		*d = 0
+	default:
+		return fmt.Errorf("can not scan %T into decimal", src)
+	}
+	return err
+}
+
+func (d Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Money amount in currency with ISO 4217 code
+type Money struct {
+	Amount   Decimal `json:"amount"`
+	Currency string  `json:"currency"`
+}
+
+func NewMoney(amount Decimal, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+func (m Money) String() string {
+	return m.Amount.String() + " " + m.Currency
+}
+
+// IsCurrencyCode check that code looks like ISO 4217 code, it does not mean currency is supported
+func IsCurrencyCode(code string) bool {
+	return currencyRegexp.MatchString(code)
 }
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDecimal(t *testing.T) {
+	for input, expected := range map[string]Decimal{
+		"100":      NewDecimal(100),
+		"0.1":      Decimal(10),
+		"99.99":    Decimal(9999),
+		"-5.05":    Decimal(-505),
+		" 12.500 ": Decimal(1250),
+	} {
+		res, err := ParseDecimal(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expected, res, input)
+	}
+
+	for _, input := range []string{"", ".5", "1.005", "1e3", "12,5", "abc", "1000000000000000"} {
+		_, err := ParseDecimal(input)
+		assert.Equal(t, InvalidDecimal, err, input)
+	}
+}
+
+func TestDecimal_String(t *testing.T) {
+	assert.Equal(t, "100.00", NewDecimal(100).String())
+	assert.Equal(t, "0.05", Decimal(5).String())
+	assert.Equal(t, "-1.50", Decimal(-150).String())
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	// 0.1 + 0.2 is not 0.3 in float64
+	assert.Equal(t, Decimal(30), Decimal(10)+Decimal(20))
+	assert.Equal(t, NewDecimal(300), NewDecimal(100).Mul(3))
+	assert.Equal(t, Decimal(1), Decimal(19).Percent(10))
+}
+
+func TestDecimal_JSON(t *testing.T) {
+	data, err := json.Marshal(NewMoney(Decimal(1999), DefaultCurrency))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"amount": 19.99, "currency": "RUB"}`, string(data))
+
+	res := struct {
+		Amount Decimal `json:"amount"`
+	}{}
+	require.NoError(t, json.Unmarshal([]byte(`{"amount": 19.9}`), &res))
+	assert.Equal(t, Decimal(1990), res.Amount)
+	require.NoError(t, json.Unmarshal([]byte(`{"amount": "5"}`), &res))
+	assert.Equal(t, NewDecimal(5), res.Amount)
+	assert.Error(t, json.Unmarshal([]byte(`{"amount": 0.001}`), &res))
+}
+
+func TestDecimal_Scan(t *testing.T) {
+	var d Decimal
+	require.NoError(t, d.Scan([]byte("150.50")))
+	assert.Equal(t, Decimal(15050), d)
+	require.NoError(t, d.Scan(int64(3)))
+	assert.Equal(t, NewDecimal(3), d)
+	assert.Error(t, d.Scan(1.5))
+
+	value, err := Decimal(15050).Value()
+	require.NoError(t, err)
+	assert.Equal(t, "150.50", value)
+}
@@ -1,8 +1,30 @@
 package models
 
+import "time"
+
+const DefaultCurrency = "RUB"
+
 type PayToken struct {
 	Token string `json:"token"`
 }
+
+// PayTokenInfo terms of payment fixed when pay token was issued,
+// award price change after that does not affect payment with this token.
+// Price is already reduced by Discount of promo code
+type PayTokenInfo struct {
+	Token       string    `json:"token"`
+	UserID      int64     `json:"user_id"`
+	CreatorID   int64     `json:"creator_id"`
+	AwardID     int64     `json:"award_id"`
+	Price       Decimal   `json:"price"`
+	Currency    string    `json:"currency"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	Used        bool      `json:"used"`
+	PromoCodeID int64     `json:"promo_code_id,omitempty"`
+	PromoCode   string    `json:"promo_code,omitempty"`
+	Discount    Decimal   `json:"discount,omitempty"`
+}
+
 type PayAccount struct {
 	Account string `json:"account_number"`
 }
@@ -0,0 +1,22 @@
+package models
+
+// PaymentNotification notification from payment provider about paid payment,
+// already parsed and verified by provider
+type PaymentNotification struct {
+	OperationID string
+	Token       string
+	Amount      Decimal
+	Currency    string
+}
+
+type Checkout struct {
+	Token       string
+	Amount      Decimal
+	Currency    string
+	Description string
+}
+
+type CheckoutInfo struct {
+	Provider string `json:"provider"`
+	Url      string `json:"url"`
+}
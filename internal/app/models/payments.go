@@ -2,12 +2,70 @@ package models
 
 import "time"
 
+type PaymentState string
+
+const (
+	PaymentCreated           PaymentState = "created"
+	PaymentPending           PaymentState = "pending"
+	PaymentSucceeded         PaymentState = "succeeded"
+	PaymentFailed            PaymentState = "failed"
+	PaymentExpired           PaymentState = "expired"
+	PaymentRefunded          PaymentState = "refunded"
+	PaymentPartiallyRefunded PaymentState = "partially_refunded"
+)
+
+// IsPaid payment money was received, even if it was refunded later
+func (state PaymentState) IsPaid() bool {
+	return state == PaymentSucceeded || state == PaymentRefunded || state == PaymentPartiallyRefunded
+}
+
+// IsValid state is one of known payment states
+func (state PaymentState) IsValid() bool {
+	switch state {
+	case PaymentCreated, PaymentPending, PaymentSucceeded, PaymentFailed, PaymentExpired,
+		PaymentRefunded, PaymentPartiallyRefunded:
+		return true
+	}
+	return false
+}
+
+// PaymentType what payment was made for
+type PaymentType string
+
+const (
+	PaymentSubscription PaymentType = "subscription"
+	PaymentGift         PaymentType = "gift"
+	PaymentTip          PaymentType = "tip"
+	PaymentUnlock       PaymentType = "unlock"
+)
+
+type PaymentEvent struct {
+	FromState PaymentState `json:"from_state"`
+	ToState   PaymentState `json:"to_state"`
+	Reason    string       `json:"reason"`
+	Date      time.Time    `json:"date"`
+}
+
 type Payments struct {
-	Amount    float64   `json:"amount"`
-	Date      time.Time `json:"date"`
-	CreatorID int64     `json:"creator_id,omitempty"`
-	UserID    int64     `json:"user_id,omitempty"`
-	Status    bool      `json:"status"`
+	ID        int64          `json:"id,omitempty"`
+	Token     string         `json:"-"`
+	Amount    Decimal        `json:"amount"`
+	Currency  string         `json:"currency"`
+	Date      time.Time      `json:"date"`
+	CreatorID int64          `json:"creator_id,omitempty"`
+	UserID    int64          `json:"user_id,omitempty"`
+	State     PaymentState   `json:"state"`
+	Type      PaymentType    `json:"type"`
+	Events    []PaymentEvent `json:"events"`
+	// RefundedAmount part of amount already returned to user
+	RefundedAmount Decimal `json:"refunded_amount,omitempty"`
+	// PromoCode used for payment, amount is already reduced by Discount
+	PromoCode string  `json:"promo_code,omitempty"`
+	Discount  Decimal `json:"discount,omitempty"`
+	// GiftID of gift bought with payment
+	GiftID int64 `json:"gift_id,omitempty"`
+	// TipID of tip paid with payment
+	TipID int64 `json:"tip_id,omitempty"`
 }
 
 type UserPayments struct {
@@ -15,9 +73,71 @@ type UserPayments struct {
 	CreatorNickname    string `json:"creator_nickname"`
 	CreatorCategory    string `json:"creator_category"`
 	CreatorDescription string `json:"creator_description"`
+	// GiftFrom nickname of payer if payment is gift received by user, GiftTo nickname of gift recipient
+	GiftFrom string `json:"gift_from,omitempty"`
+	GiftTo   string `json:"gift_to,omitempty"`
 }
 
 type CreatorPayments struct {
 	Payments
 	UserNickname string `json:"user_nickname"`
+	TipMessage   string `json:"tip_message,omitempty"`
+	// AwardID award paid by subscription or gift payment
+	AwardID   int64  `json:"award_id,omitempty"`
+	AwardName string `json:"award_name,omitempty"`
+}
+
+// PaymentsFilter restricts listed payments, zero fields do not restrict them.
+// Payment date must be in [From, To)
+type PaymentsFilter struct {
+	From    time.Time
+	To      time.Time
+	AwardID int64
+	States  []PaymentState
+}
+
+// Validate Errors:
+//		IncorrectPaymentsPeriod
+//		IncorrectPaymentState
+func (filter *PaymentsFilter) Validate() error {
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.To.After(filter.From) {
+		return IncorrectPaymentsPeriod
+	}
+	for _, state := range filter.States {
+		if !state.IsValid() {
+			return IncorrectPaymentState
+		}
+	}
+	return nil
+}
+
+// PaymentsMonthTotal paid payments of award in one month, payments without award have zero AwardID
+type PaymentsMonthTotal struct {
+	Month     time.Time `json:"month"`
+	AwardID   int64     `json:"award_id,omitempty"`
+	AwardName string    `json:"award_name,omitempty"`
+	Currency  string    `json:"currency"`
+	Count     int64     `json:"count"`
+	Amount    Decimal   `json:"amount"`
+	Refunded  Decimal   `json:"refunded"`
+}
+
+// AbandonedCheckout payment which was not paid while its pay token lived
+type AbandonedCheckout struct {
+	PaymentID int64
+	Token     string
+	State     PaymentState
+	Type      PaymentType
+	UserID    int64
+	CreatorID int64
+	AwardID   int64
+	Date      time.Time
+	// HasPendingSubscription not paid subscription was created together with payment
+	HasPendingSubscription bool
+}
+
+// CheckoutsSweep result of expiring abandoned checkouts, in dry run it is what would be expired
+type CheckoutsSweep struct {
+	Expired              int64
+	RemovedSubscriptions int64
 }
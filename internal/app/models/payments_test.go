@@ -0,0 +1,32 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaymentsFilter_Validate_OK(t *testing.T) {
+	filter := &PaymentsFilter{}
+	assert.NoError(t, filter.Validate())
+
+	filter.From = time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	assert.NoError(t, filter.Validate())
+	filter.To = filter.From.AddDate(0, 1, 0)
+	filter.States = []PaymentState{PaymentSucceeded, PaymentPartiallyRefunded}
+	assert.NoError(t, filter.Validate())
+}
+
+func TestPaymentsFilter_ValidateIncorrectPeriod(t *testing.T) {
+	from := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	filter := &PaymentsFilter{From: from, To: from}
+	assert.Equal(t, IncorrectPaymentsPeriod, filter.Validate())
+	filter.To = from.AddDate(0, 0, -1)
+	assert.Equal(t, IncorrectPaymentsPeriod, filter.Validate())
+}
+
+func TestPaymentsFilter_ValidateIncorrectState(t *testing.T) {
+	filter := &PaymentsFilter{States: []PaymentState{PaymentSucceeded, "paid"}}
+	assert.Equal(t, IncorrectPaymentState, filter.Validate())
+}
@@ -0,0 +1,91 @@
+package models
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	MinPollOptions        = 2
+	MaxPollOptions        = 10
+	MaxPollQuestionLength = 256
+	MaxPollOptionLength   = 128
+)
+
+// PollAttach definition of attach with poll type, it is stored as json in attach value
+// Options are numbered from zero in order of their definition
+type PollAttach struct {
+	Question          string     `json:"question"`
+	Options           []string   `json:"options"`
+	Multiple          bool       `json:"multiple"`
+	CloseAt           *time.Time `json:"close_at,omitempty"`
+	ResultsBeforeVote bool       `json:"results_before_vote"`
+}
+
+// ParsePoll parse poll from attach value and return it with trimmed question and options
+// Errors:
+//		IncorrectPoll
+func ParsePoll(value string) (*PollAttach, error) {
+	poll := &PollAttach{}
+	if err := json.Unmarshal([]byte(value), poll); err != nil {
+		return nil, IncorrectPoll
+	}
+
+	poll.Question = strings.TrimSpace(poll.Question)
+	if poll.Question == "" || utf8.RuneCountInString(poll.Question) > MaxPollQuestionLength {
+		return nil, IncorrectPoll
+	}
+	if len(poll.Options) < MinPollOptions || len(poll.Options) > MaxPollOptions {
+		return nil, IncorrectPoll
+	}
+	for i, option := range poll.Options {
+		option = strings.TrimSpace(option)
+		if option == "" || utf8.RuneCountInString(option) > MaxPollOptionLength {
+			return nil, IncorrectPoll
+		}
+		poll.Options[i] = option
+	}
+	return poll, nil
+}
+
+// Value return poll in format of attach value
+func (poll *PollAttach) Value() string {
+	res, _ := json.Marshal(poll)
+	return string(res)
+}
+
+// IsClosed poll without close time is never closed
+func (poll *PollAttach) IsClosed(now time.Time) bool {
+	return poll.CloseAt != nil && !now.Before(*poll.CloseAt)
+}
+
+// CheckVote check that options are different numbers of poll options
+// and single choice poll is voted by one option
+// Errors:
+//		IncorrectVote
+func (poll *PollAttach) CheckVote(options []int64) error {
+	if len(options) == 0 || (!poll.Multiple && len(options) > 1) {
+		return IncorrectVote
+	}
+	seen := make(map[int64]bool, len(options))
+	for _, option := range options {
+		if option < 0 || option >= int64(len(poll.Options)) || seen[option] {
+			return IncorrectVote
+		}
+		seen[option] = true
+	}
+	return nil
+}
+
+// PollResults poll with count of votes for every option and options chosen by user
+// Counts are nil if they are hidden from user
+type PollResults struct {
+	AttachID int64       `json:"attach_id"`
+	Poll     *PollAttach `json:"poll"`
+	Counts   []int64     `json:"counts,omitempty"`
+	Voters   int64       `json:"voters"`
+	Voted    []int64     `json:"voted,omitempty"`
+	Closed   bool        `json:"closed"`
+}
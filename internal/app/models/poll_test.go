@@ -0,0 +1,52 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePoll(t *testing.T) {
+	poll, err := ParsePoll(`{"question": " Next episode? ", "options": ["cats", " dogs "], "multiple": true}`)
+	assert.NoError(t, err)
+	assert.Equal(t, &PollAttach{Question: "Next episode?", Options: []string{"cats", "dogs"}, Multiple: true}, poll)
+	assert.Equal(t, `{"question":"Next episode?","options":["cats","dogs"],"multiple":true,"results_before_vote":false}`,
+		poll.Value())
+
+	_, err = ParsePoll("not json")
+	assert.Equal(t, IncorrectPoll, err)
+
+	_, err = ParsePoll(`{"question": "  ", "options": ["cats", "dogs"]}`)
+	assert.Equal(t, IncorrectPoll, err)
+
+	_, err = ParsePoll(`{"question": "Next episode?", "options": ["cats"]}`)
+	assert.Equal(t, IncorrectPoll, err)
+
+	_, err = ParsePoll(`{"question": "Next episode?", "options": ["cats", " "]}`)
+	assert.Equal(t, IncorrectPoll, err)
+}
+
+func TestPollAttach_IsClosed(t *testing.T) {
+	now := time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)
+	poll := &PollAttach{}
+	assert.False(t, poll.IsClosed(now))
+
+	closeAt := now.Add(time.Hour)
+	poll.CloseAt = &closeAt
+	assert.False(t, poll.IsClosed(now))
+	assert.True(t, poll.IsClosed(closeAt))
+}
+
+func TestPollAttach_CheckVote(t *testing.T) {
+	poll := &PollAttach{Options: []string{"cats", "dogs", "birds"}}
+	assert.NoError(t, poll.CheckVote([]int64{2}))
+	assert.Equal(t, IncorrectVote, poll.CheckVote(nil))
+	assert.Equal(t, IncorrectVote, poll.CheckVote([]int64{0, 1}))
+	assert.Equal(t, IncorrectVote, poll.CheckVote([]int64{3}))
+	assert.Equal(t, IncorrectVote, poll.CheckVote([]int64{-1}))
+
+	poll.Multiple = true
+	assert.NoError(t, poll.CheckVote([]int64{0, 2}))
+	assert.Equal(t, IncorrectVote, poll.CheckVote([]int64{1, 1}))
+}
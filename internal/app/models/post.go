@@ -10,20 +10,34 @@ import (
 )
 
 type UpdatePost struct {
-	ID          int64  `json:"posts_id"`
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Awards      int64  `json:"type_awards"`
-	IsDraft     bool   `json:"is_draft"`
+	ID          int64   `json:"posts_id"`
+	Title       string  `json:"title"`
+	Description string  `json:"description"`
+	Awards      int64   `json:"type_awards"`
+	IsDraft     bool    `json:"is_draft"`
+	UnlockPrice Decimal `json:"unlock_price"`
+	// Preview excerpt shown instead of post to users without access to it
+	Preview string `json:"preview"`
+	// PublishAt time of scheduled publication, post stays draft until it, nil if post is not scheduled
+	PublishAt *time.Time `json:"publish_at,omitempty"`
+	// AddTags and RemoveTags change tags of post, other tags of post stay
+	AddTags    []string `json:"add_tags,omitempty"`
+	RemoveTags []string `json:"remove_tags,omitempty"`
 }
 
 type CreatePost struct {
-	ID          int64  `json:"posts_id"`
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Awards      int64  `json:"type_awards"`
-	CreatorId   int64  `json:"creator_id"`
-	IsDraft     bool   `json:"is_draft"`
+	ID          int64   `json:"posts_id"`
+	Title       string  `json:"title"`
+	Description string  `json:"description"`
+	Awards      int64   `json:"type_awards"`
+	CreatorId   int64   `json:"creator_id"`
+	IsDraft     bool    `json:"is_draft"`
+	UnlockPrice Decimal `json:"unlock_price"`
+	// Preview excerpt shown instead of post to users without access to it
+	Preview string `json:"preview"`
+	// PublishAt time of scheduled publication, post stays draft until it, nil if post is not scheduled
+	PublishAt *time.Time `json:"publish_at,omitempty"`
+	Tags      []string   `json:"tags,omitempty"`
 }
 
 type Post struct {
@@ -39,12 +53,28 @@ type Post struct {
 	AddLike     bool      `json:"add_like"`
 	Date        time.Time `json:"date"`
 	IsDraft     bool      `json:"is_draft"`
+	// UnlockPrice price of buying single post without subscription, 0 if post can not be bought
+	UnlockPrice Decimal `json:"unlock_price"`
+	// Unlocked post was bought by user, so it is available regardless of award
+	Unlocked bool `json:"unlocked"`
+	// PublishAt time of scheduled publication of draft, nil if post is not scheduled
+	PublishAt *time.Time `json:"publish_at,omitempty"`
+	Tags      []string   `json:"tags,omitempty"`
+	Preview   string     `json:"preview"`
+	// Locked user has neither award nor unlock of post, so only its preview may be shown
+	Locked bool `json:"locked"`
 }
 type AvailablePost struct {
 	CreatorNickname string `json:"creator_nickname"`
 	Post
 }
 
+type SearchedPost struct {
+	AvailablePost
+	// Snippet fragments of post text with matched words highlighted by <b></b>
+	Snippet string `json:"snippet"`
+}
+
 func (ps *UpdatePost) String() string {
 	return fmt.Sprintf("{ID: %d, Title: %s, Likes: %d}", ps.ID,
 		ps.Title, ps.Awards)
@@ -53,11 +83,14 @@ func (ps *UpdatePost) String() string {
 // Validate Errors:
 //		EmptyTitle
 //		InvalidAwardsId
+//		InvalidUnlockPrice
+//
 // Important can return some other error
 func (ps *UpdatePost) Validate() error {
 	err := validation.Errors{
-		"title":  validation.Validate(ps.Title, validation.Required),
-		"awards": validation.Validate(ps.Awards, validation.Min(-1)),
+		"title":        validation.Validate(ps.Title, validation.Required),
+		"awards":       validation.Validate(ps.Awards, validation.Min(-1)),
+		"unlock_price": validation.Validate(int64(ps.UnlockPrice), validation.Min(int64(0))),
 	}.Filter()
 	if err == nil {
 		return nil
@@ -84,12 +117,15 @@ func (ps *CreatePost) String() string {
 //		EmptyTitle
 //		InvalidCreatorId
 //		InvalidAwardsId
+//		InvalidUnlockPrice
+//
 // Important can return some other error
 func (ps *CreatePost) Validate() error {
 	err := validation.Errors{
-		"title":   validation.Validate(ps.Title, validation.Required),
-		"creator": validation.Validate(ps.CreatorId, validation.Min(0)),
-		"awards":  validation.Validate(ps.Awards, validation.Min(-1)),
+		"title":        validation.Validate(ps.Title, validation.Required),
+		"creator":      validation.Validate(ps.CreatorId, validation.Min(0)),
+		"awards":       validation.Validate(ps.Awards, validation.Min(-1)),
+		"unlock_price": validation.Validate(int64(ps.UnlockPrice), validation.Min(int64(0))),
 	}.Filter()
 	if err == nil {
 		return nil
@@ -107,6 +143,15 @@ func (ps *CreatePost) Validate() error {
 	return err
 }
 
+// ValidatePublishAt Errors:
+//		IncorrectPublishAt
+func ValidatePublishAt(publishAt *time.Time, now time.Time) error {
+	if publishAt != nil && !publishAt.After(now) {
+		return IncorrectPublishAt
+	}
+	return nil
+}
+
 type DataType string
 
 type AttachWithoutLevel struct {
@@ -122,16 +167,18 @@ const (
 	Files DataType = "files"
 	Text  DataType = "text"
 	Image DataType = "image"
+	Poll  DataType = "poll"
 )
 
 // Validate Errors:
 //		InvalidType
 //		InvalidPostId
+//
 // Important can return some other error
 func (ps *AttachWithoutLevel) Validate() error {
 	err := validation.Errors{
 		"post": validation.Validate(ps.PostId, validation.Min(0)),
-		"type": validation.Validate(ps.Type, validation.In(Music, Video, Files, Text, Image)),
+		"type": validation.Validate(ps.Type, validation.In(Music, Video, Files, Text, Image, Poll)),
 	}.Filter()
 	if err == nil {
 		return nil
@@ -153,3 +200,23 @@ type PostWithAttach struct {
 	*Post
 	Data []AttachWithoutLevel
 }
+
+type AttachCount struct {
+	Type  DataType `json:"type"`
+	Count int64    `json:"count"`
+}
+
+// CountAttaches return count of attaches of every type in order of first occurrence of type
+func CountAttaches(attaches []AttachWithoutLevel) []AttachCount {
+	res := make([]AttachCount, 0)
+	indexes := map[DataType]int{}
+	for _, attach := range attaches {
+		if i, ok := indexes[attach.Type]; ok {
+			res[i].Count++
+			continue
+		}
+		indexes[attach.Type] = len(res)
+		res = append(res, AttachCount{Type: attach.Type, Count: 1})
+	}
+	return res
+}
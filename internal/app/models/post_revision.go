@@ -0,0 +1,94 @@
+package models
+
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	RevisionFieldTitle       = "title"
+	RevisionFieldDescription = "description"
+	RevisionFieldAwards      = "type_awards"
+	// RevisionFieldAttach attach was added or removed, value of attach is in New or Old
+	RevisionFieldAttach      = "attach"
+	RevisionFieldAttachValue = "attach_value"
+	RevisionFieldAttachType  = "attach_type"
+	RevisionFieldAttachLevel = "attach_level"
+)
+
+// PostRevision snapshot of post content and its attaches ordered by level.
+// Awards is -1 if post was available without award
+type PostRevision struct {
+	ID          int64     `json:"revision_id"`
+	PostID      int64     `json:"posts_id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Awards      int64     `json:"type_awards"`
+	Attaches    []Attach  `json:"attaches"`
+	Date        time.Time `json:"date"`
+}
+
+// PostRevisionChange change of one field between two revisions,
+// AttachID is set for changes of attaches
+type PostRevisionChange struct {
+	Field    string `json:"field"`
+	AttachID int64  `json:"attach_id,omitempty"`
+	Old      string `json:"old,omitempty"`
+	New      string `json:"new,omitempty"`
+}
+
+func awardsToString(awards int64) string {
+	if awards <= 0 {
+		return ""
+	}
+	return strconv.FormatInt(awards, 10)
+}
+
+// DiffPostRevisions return field level changes which turn revision from into revision to.
+// Attaches are matched by id, so edited attach is changed, not removed and added again
+func DiffPostRevisions(from *PostRevision, to *PostRevision) []PostRevisionChange {
+	res := make([]PostRevisionChange, 0)
+	if from.Title != to.Title {
+		res = append(res, PostRevisionChange{Field: RevisionFieldTitle, Old: from.Title, New: to.Title})
+	}
+	if from.Description != to.Description {
+		res = append(res, PostRevisionChange{Field: RevisionFieldDescription,
+			Old: from.Description, New: to.Description})
+	}
+	if oldAwards, newAwards := awardsToString(from.Awards), awardsToString(to.Awards); oldAwards != newAwards {
+		res = append(res, PostRevisionChange{Field: RevisionFieldAwards, Old: oldAwards, New: newAwards})
+	}
+
+	oldAttaches := make(map[int64]Attach, len(from.Attaches))
+	for _, attach := range from.Attaches {
+		oldAttaches[attach.Id] = attach
+	}
+	for _, attach := range to.Attaches {
+		old, ok := oldAttaches[attach.Id]
+		if !ok {
+			res = append(res, PostRevisionChange{Field: RevisionFieldAttach, AttachID: attach.Id, New: attach.Value})
+			continue
+		}
+		delete(oldAttaches, attach.Id)
+
+		if old.Value != attach.Value {
+			res = append(res, PostRevisionChange{Field: RevisionFieldAttachValue, AttachID: attach.Id,
+				Old: old.Value, New: attach.Value})
+		}
+		if old.Type != attach.Type {
+			res = append(res, PostRevisionChange{Field: RevisionFieldAttachType, AttachID: attach.Id,
+				Old: string(old.Type), New: string(attach.Type)})
+		}
+		if old.Level != attach.Level {
+			res = append(res, PostRevisionChange{Field: RevisionFieldAttachLevel, AttachID: attach.Id,
+				Old: strconv.FormatInt(old.Level, 10), New: strconv.FormatInt(attach.Level, 10)})
+		}
+	}
+
+	for _, attach := range from.Attaches {
+		if _, removed := oldAttaches[attach.Id]; removed {
+			res = append(res, PostRevisionChange{Field: RevisionFieldAttach, AttachID: attach.Id, Old: attach.Value})
+		}
+	}
+	return res
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffPostRevisions_Equal(t *testing.T) {
+	rev := &PostRevision{Title: "title", Description: "desc", Awards: 1,
+		Attaches: []Attach{{Id: 1, Value: "text", Type: Text, Level: 1}}}
+	assert.Empty(t, DiffPostRevisions(rev, rev))
+}
+
+func TestDiffPostRevisions_Fields(t *testing.T) {
+	from := &PostRevision{Title: "old", Description: "desc", Awards: -1}
+	to := &PostRevision{Title: "new", Description: "new desc", Awards: 3}
+	assert.Equal(t, []PostRevisionChange{
+		{Field: RevisionFieldTitle, Old: "old", New: "new"},
+		{Field: RevisionFieldDescription, Old: "desc", New: "new desc"},
+		{Field: RevisionFieldAwards, Old: "", New: "3"},
+	}, DiffPostRevisions(from, to))
+}
+
+func TestDiffPostRevisions_Attaches(t *testing.T) {
+	from := &PostRevision{Attaches: []Attach{
+		{Id: 1, Value: "text", Type: Text, Level: 1},
+		{Id: 2, Value: "media/img.webp", Type: Image, Level: 2},
+		{Id: 3, Value: "removed", Type: Text, Level: 3},
+	}}
+	to := &PostRevision{Attaches: []Attach{
+		{Id: 2, Value: "media/img.webp", Type: Image, Level: 1},
+		{Id: 1, Value: "edited", Type: Text, Level: 2},
+		{Id: 4, Value: "added", Type: Text, Level: 3},
+	}}
+	assert.Equal(t, []PostRevisionChange{
+		{Field: RevisionFieldAttachLevel, AttachID: 2, Old: "2", New: "1"},
+		{Field: RevisionFieldAttachValue, AttachID: 1, Old: "text", New: "edited"},
+		{Field: RevisionFieldAttachLevel, AttachID: 1, Old: "1", New: "2"},
+		{Field: RevisionFieldAttach, AttachID: 4, New: "added"},
+		{Field: RevisionFieldAttach, AttachID: 3, Old: "removed"},
+	}, DiffPostRevisions(from, to))
+}
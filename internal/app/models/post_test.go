@@ -22,6 +22,14 @@ func TestUpdatePost_ValidateIncorrectAwards(t *testing.T) {
 	res := post.Validate()
 	assert.Equal(t, expErr, res)
 }
+func TestUpdatePost_ValidateIncorrectUnlockPrice(t *testing.T) {
+	post := TestUpdatePost()
+	post.UnlockPrice = -1
+	expErr := InvalidUnlockPrice
+
+	res := post.Validate()
+	assert.Equal(t, expErr, res)
+}
 func TestUpdatePost_ValidateOk(t *testing.T) {
 	post := TestUpdatePost()
 
@@ -52,6 +60,14 @@ func TestCreatePost_ValidateInvalidAwardId(t *testing.T) {
 	res := post.Validate()
 	assert.Equal(t, expErr, res)
 }
+func TestCreatePost_ValidateInvalidUnlockPrice(t *testing.T) {
+	post := TestCreatePost()
+	post.UnlockPrice = -10
+	expErr := InvalidUnlockPrice
+
+	res := post.Validate()
+	assert.Equal(t, expErr, res)
+}
 func TestCreatePost_ValidateInvalidACombinatinon(t *testing.T) {
 	post := TestCreatePost()
 	post.Awards = -2
@@ -96,3 +112,11 @@ func TestAttachWithoutLevel_ValidateIncorrectAttachWithoutLevelOk(t *testing.T)
 	res := post.Validate()
 	assert.NoError(t, res)
 }
+
+func TestCountAttaches(t *testing.T) {
+	res := CountAttaches([]AttachWithoutLevel{{ID: 1, Type: Text, Value: "secret"},
+		{ID: 2, Type: Image}, {ID: 3, Type: Text}})
+	assert.Equal(t, []AttachCount{{Type: Text, Count: 2}, {Type: Image, Count: 1}}, res)
+
+	assert.Empty(t, CountAttaches(nil))
+}
@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// PostUnlock purchase of single post by user, it is paid by PayToken for Amount equal to post unlock price
+type PostUnlock struct {
+	PostID    int64     `json:"post_id"`
+	UserID    int64     `json:"user_id"`
+	CreatorID int64     `json:"creator_id"`
+	Amount    Decimal   `json:"amount"`
+	Currency  string    `json:"currency"`
+	PayToken  string    `json:"pay_token,omitempty"`
+	Date      time.Time `json:"date"`
+}
@@ -0,0 +1,116 @@
+package models
+
+import (
+	models_utilits "patreon/internal/app/utilits/models"
+	"regexp"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+)
+
+type DiscountType string
+
+const (
+	DiscountPercent = DiscountType("percent")
+	DiscountFixed   = DiscountType("fixed")
+)
+
+// MinPaymentAmount payment provider does not accept zero payments, so discount never makes price lower
+const MinPaymentAmount = Decimal(1 * decimalScale)
+
+// PromoCode discount of creator on the first payment of subscription.
+// Code without AwardIDs can be used for any award of creator, MaxUses = 0 means no limit
+type PromoCode struct {
+	ID           int64        `json:"id"`
+	CreatorID    int64        `json:"creator_id"`
+	Code         string       `json:"code"`
+	DiscountType DiscountType `json:"discount_type"`
+	Discount     int64        `json:"discount"`
+	AwardIDs     []int64      `json:"award_ids"`
+	MaxUses      int64        `json:"max_uses"`
+	Uses         int64        `json:"uses"`
+	ValidFrom    time.Time    `json:"valid_from"`
+	ValidUntil   *time.Time   `json:"valid_until,omitempty"`
+	Active       bool         `json:"active"`
+	Date         time.Time    `json:"date"`
+}
+
+var promoCodeRegexp = regexp.MustCompile(`^[A-Z0-9_-]{3,32}$`)
+
+// Validate Errors:
+//		IncorrectPromoCode
+//		IncorrectDiscountType
+//		IncorrectDiscount
+//		IncorrectMaxUses
+//		IncorrectPromoPeriod
+//		Error of validation with not known field
+func (code *PromoCode) Validate() error {
+	discountRules := []validation.Rule{validation.Required, validation.Min(int64(1))}
+	if code.DiscountType == DiscountPercent {
+		discountRules = append(discountRules, validation.Max(int64(99)))
+	}
+	err := validation.Errors{
+		"code": validation.Validate(code.Code, validation.Required, validation.Match(promoCodeRegexp)),
+		"discount_type": validation.Validate(string(code.DiscountType), validation.Required,
+			validation.In(string(DiscountPercent), string(DiscountFixed))),
+		"discount": validation.Validate(code.Discount, discountRules...),
+		"max_uses": validation.Validate(code.MaxUses, validation.Min(int64(0))),
+	}.Filter()
+	if err == nil {
+		if code.ValidUntil != nil && !code.ValidUntil.After(code.ValidFrom) {
+			return IncorrectPromoPeriod
+		}
+		return nil
+	}
+
+	mapOfErr, knowError := models_utilits.ParseErrorToMap(err)
+	if knowError != nil {
+		return errors.Wrap(knowError, "failed error getting in validate promo code")
+	}
+
+	if knowError = models_utilits.ExtractValidateError(promoCodeValidError(), mapOfErr); knowError != nil {
+		return knowError
+	}
+
+	return err
+}
+
+// IsValid check that code is not deactivated, exhausted or out of its validity window
+func (code *PromoCode) IsValid(now time.Time) bool {
+	if !code.Active || now.Before(code.ValidFrom) {
+		return false
+	}
+	if code.ValidUntil != nil && !now.Before(*code.ValidUntil) {
+		return false
+	}
+	return code.MaxUses == 0 || code.Uses < code.MaxUses
+}
+
+func (code *PromoCode) AllowsAward(awardID int64) bool {
+	if len(code.AwardIDs) == 0 {
+		return true
+	}
+	for _, id := range code.AwardIDs {
+		if id == awardID {
+			return true
+		}
+	}
+	return false
+}
+
+// DiscountFor return discount of code for price, rounded down to hundredths.
+// Fixed discount is amount of whole units in currency of price
+func (code *PromoCode) DiscountFor(price Decimal) Decimal {
+	discount := NewDecimal(code.Discount)
+	if code.DiscountType == DiscountPercent {
+		discount = price.Percent(code.Discount)
+	}
+	if price-discount < MinPaymentAmount {
+		discount = price - MinPaymentAmount
+	}
+	if discount < 0 {
+		return 0
+	}
+	return discount
+}
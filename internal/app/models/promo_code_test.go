@@ -0,0 +1,78 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromoCode_Validate_OK(t *testing.T) {
+	code := TestPromoCode()
+	assert.NoError(t, code.Validate())
+	code.DiscountType = DiscountFixed
+	code.Discount = 100
+	assert.NoError(t, code.Validate())
+}
+
+func TestPromoCode_ValidateIncorrectCode(t *testing.T) {
+	code := TestPromoCode()
+	code.Code = "gold 50"
+	assert.Equal(t, IncorrectPromoCode, code.Validate())
+}
+
+func TestPromoCode_ValidateIncorrectDiscount(t *testing.T) {
+	code := TestPromoCode()
+	code.Discount = 100
+	assert.Equal(t, IncorrectDiscount, code.Validate())
+	code.Discount = 0
+	assert.Equal(t, IncorrectDiscount, code.Validate())
+	code.DiscountType = "gift"
+	code.Discount = 10
+	assert.Equal(t, IncorrectDiscountType, code.Validate())
+}
+
+func TestPromoCode_ValidateIncorrectPeriod(t *testing.T) {
+	code := TestPromoCode()
+	until := code.ValidFrom.Add(-time.Minute)
+	code.ValidUntil = &until
+	assert.Equal(t, IncorrectPromoPeriod, code.Validate())
+}
+
+func TestPromoCode_IsValid(t *testing.T) {
+	now := time.Now()
+	code := TestPromoCode()
+	assert.True(t, code.IsValid(now))
+
+	code.MaxUses = 50
+	code.Uses = 50
+	assert.False(t, code.IsValid(now))
+
+	code.Uses = 0
+	until := now.Add(-time.Minute)
+	code.ValidUntil = &until
+	assert.False(t, code.IsValid(now))
+
+	code.ValidUntil = nil
+	code.Active = false
+	assert.False(t, code.IsValid(now))
+}
+
+func TestPromoCode_AllowsAward(t *testing.T) {
+	code := TestPromoCode()
+	assert.True(t, code.AllowsAward(5))
+	code.AwardIDs = []int64{3, 4}
+	assert.True(t, code.AllowsAward(4))
+	assert.False(t, code.AllowsAward(5))
+}
+
+func TestPromoCode_DiscountFor(t *testing.T) {
+	code := TestPromoCode()
+	assert.Equal(t, Decimal(4950), code.DiscountFor(NewDecimal(99)))
+	assert.Equal(t, Decimal(5016), code.DiscountFor(Decimal(10033)))
+
+	code.DiscountType = DiscountFixed
+	code.Discount = 100
+	assert.Equal(t, NewDecimal(100), code.DiscountFor(NewDecimal(300)))
+	assert.Equal(t, NewDecimal(99), code.DiscountFor(NewDecimal(100)))
+}
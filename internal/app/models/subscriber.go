@@ -1,8 +1,117 @@
 package models
 
+import "time"
+
 type Subscriber struct {
 	ID        int64 `json:"id"`
 	UserID    int64 `json:"users_id"`
 	CreatorID int64 `json:"creator_id"`
 	AwardID   int64 `json:"award_id"`
 }
+
+type SubscriptionStatus string
+
+const (
+	SubscriptionActive  = SubscriptionStatus("active")
+	SubscriptionExpired = SubscriptionStatus("expired")
+)
+
+type SubscribersSort string
+
+const (
+	SortSubscribersNewest   = SubscribersSort("newest")
+	SortSubscribersOldest   = SubscribersSort("oldest")
+	SortSubscribersAmount   = SubscribersSort("amount")
+	SortSubscribersNickname = SubscribersSort("nickname")
+)
+
+// CreatorSubscriber subscriber in list of creator, user who was subscribed and did not subscribe again
+// is listed with expired status. LifetimeAmount is sum of all user payments to creator without refunds
+// in settlement Currency of creator, MonthsSubscribed counts months of paid subscription periods
+type CreatorSubscriber struct {
+	UserID           int64              `json:"user_id"`
+	Nickname         string             `json:"nickname"`
+	Avatar           string             `json:"avatar"`
+	AwardID          int64              `json:"award_id"`
+	AwardName        string             `json:"award_name"`
+	Status           SubscriptionStatus `json:"status"`
+	SubscribedSince  time.Time          `json:"subscribed_since"`
+	LifetimeAmount   Decimal            `json:"lifetime_amount"`
+	Currency         string             `json:"currency"`
+	MonthsSubscribed int64              `json:"months_subscribed"`
+}
+
+// SubscribersFilter restricts listed subscribers, zero fields do not restrict them.
+// Since is the earliest start of subscription, Nickname is searched as case insensitive substring
+type SubscribersFilter struct {
+	AwardID  int64
+	Status   SubscriptionStatus
+	Since    time.Time
+	Nickname string
+	Sort     SubscribersSort
+}
+
+// Validate Errors:
+//		IncorrectSubscriptionStatus
+//		IncorrectSubscribersSort
+func (filter *SubscribersFilter) Validate() error {
+	switch filter.Status {
+	case "", SubscriptionActive, SubscriptionExpired:
+	default:
+		return IncorrectSubscriptionStatus
+	}
+	switch filter.Sort {
+	case "", SortSubscribersNewest, SortSubscribersOldest, SortSubscribersAmount, SortSubscribersNickname:
+	default:
+		return IncorrectSubscribersSort
+	}
+	return nil
+}
+
+// BillingSubscription active subscription with billing period info, used for renewals
+type BillingSubscription struct {
+	ID          int64
+	UserID      int64
+	CreatorID   int64
+	AwardID     int64
+	Price       Decimal
+	Currency    string
+	Period      int64 // in months
+	PaidUntil   time.Time
+	NextAwardID int64 // award scheduled by downgrade, 0 if not any
+	InGrace     bool
+	InTrial     bool // paid period is free trial, subscription was not paid yet
+}
+
+type TierChangeKind string
+
+const (
+	TierUpgrade   = TierChangeKind("upgrade")
+	TierDowngrade = TierChangeKind("downgrade")
+)
+
+type TierChangeStatus string
+
+const (
+	TierChangePending   = TierChangeStatus("pending")
+	TierChangeApplied   = TierChangeStatus("applied")
+	TierChangeCancelled = TierChangeStatus("cancelled")
+)
+
+// TierChange move of subscription to other award of the same creator.
+// Upgrade is pending until prorated payment with PayToken succeeded,
+// downgrade is pending until EffectiveAt - the end of paid period
+type TierChange struct {
+	ID             int64            `json:"id"`
+	SubscriptionID int64            `json:"-"`
+	CreatorID      int64            `json:"creator_id"`
+	FromAwardID    int64            `json:"from_award_id"`
+	ToAwardID      int64            `json:"to_award_id"`
+	Kind           TierChangeKind   `json:"kind"`
+	Status         TierChangeStatus `json:"status"`
+	Amount         Decimal          `json:"amount"`
+	Currency       string           `json:"currency"`
+	PayToken       string           `json:"pay_token,omitempty"`
+	EffectiveAt    time.Time        `json:"effective_at"`
+	Date           time.Time        `json:"date"`
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscribersFilter_Validate_OK(t *testing.T) {
+	filter := &SubscribersFilter{}
+	assert.NoError(t, filter.Validate())
+
+	filter.Status = SubscriptionExpired
+	filter.Sort = SortSubscribersAmount
+	assert.NoError(t, filter.Validate())
+}
+
+func TestSubscribersFilter_Validate_Incorrect(t *testing.T) {
+	filter := &SubscribersFilter{Status: "paused"}
+	assert.Equal(t, IncorrectSubscriptionStatus, filter.Validate())
+
+	filter = &SubscribersFilter{Sort: "date"}
+	assert.Equal(t, IncorrectSubscribersSort, filter.Validate())
+}
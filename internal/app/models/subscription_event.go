@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+	"unicode/utf8"
+)
+
+const MaxCancelReasonLength = 500
+
+type SubscriptionEventKind string
+
+const (
+	SubscriptionEventSubscribed  = SubscriptionEventKind("subscribed")
+	SubscriptionEventRenewed     = SubscriptionEventKind("renewed")
+	SubscriptionEventTierChanged = SubscriptionEventKind("tier_changed")
+	SubscriptionEventCancelled   = SubscriptionEventKind("cancelled")
+	SubscriptionEventExpired     = SubscriptionEventKind("expired")
+	SubscriptionEventRefunded    = SubscriptionEventKind("refunded")
+)
+
+// Validate Errors:
+//		IncorrectSubscriptionEventKind
+func (kind SubscriptionEventKind) Validate() error {
+	switch kind {
+	case SubscriptionEventSubscribed, SubscriptionEventRenewed, SubscriptionEventTierChanged,
+		SubscriptionEventCancelled, SubscriptionEventExpired, SubscriptionEventRefunded:
+		return nil
+	}
+	return IncorrectSubscriptionEventKind
+}
+
+// SubscriptionEvent entry of append-only history of user subscription on creator.
+// AwardID is award of subscription after event, 0 if it is not known or award was removed.
+// Reason is cancellation reason given by user or note of system about event
+type SubscriptionEvent struct {
+	ID              int64                 `json:"id"`
+	UserID          int64                 `json:"user_id"`
+	Nickname        string                `json:"nickname,omitempty"`
+	CreatorID       int64                 `json:"creator_id"`
+	CreatorNickname string                `json:"creator_nickname,omitempty"`
+	AwardID         int64                 `json:"award_id,omitempty"`
+	AwardName       string                `json:"award_name,omitempty"`
+	Kind            SubscriptionEventKind `json:"kind"`
+	Reason          string                `json:"reason,omitempty"`
+	Date            time.Time             `json:"date"`
+}
+
+// ValidateCancelReason Errors:
+//		IncorrectCancelReason
+func ValidateCancelReason(reason string) error {
+	if utf8.RuneCountInString(reason) > MaxCancelReasonLength {
+		return IncorrectCancelReason
+	}
+	return nil
+}
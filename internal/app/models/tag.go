@@ -0,0 +1,33 @@
+package models
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const MaxTagLength = 32
+
+// Tag tag of creator with count of his posts marked by it
+type Tag struct {
+	Name  string `json:"name"`
+	Posts int64  `json:"posts"`
+}
+
+// NormalizeTags return trimmed lower case tags without duplicates in order of first occurrence
+// Errors:
+//		IncorrectTag
+func NormalizeTags(tags []string) ([]string, error) {
+	res := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || utf8.RuneCountInString(tag) > MaxTagLength || strings.Contains(tag, ",") {
+			return nil, IncorrectTag
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			res = append(res, tag)
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	res, err := NormalizeTags([]string{" Tutorial", "wip", "tutorial ", "Эпизод"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"tutorial", "wip", "эпизод"}, res)
+
+	res, err = NormalizeTags(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, res)
+
+	_, err = NormalizeTags([]string{"wip", "  "})
+	assert.Equal(t, IncorrectTag, err)
+
+	_, err = NormalizeTags([]string{"a,b"})
+	assert.Equal(t, IncorrectTag, err)
+
+	_, err = NormalizeTags([]string{strings.Repeat("a", MaxTagLength+1)})
+	assert.Equal(t, IncorrectTag, err)
+}
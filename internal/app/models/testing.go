@@ -245,6 +245,7 @@ func TestCreator() *Creator {
 		Nickname:    "doggy2005",
 		Category:    "podcasts",
 		Description: "i love podcasts",
+		Currency:    DefaultCurrency,
 	}
 }
 
@@ -255,6 +256,7 @@ func TestCreatorWithAwards() *CreatorWithAwards {
 		Category:    "podcasts",
 		Description: "i love podcasts",
 		AwardsId:    1,
+		Currency:    DefaultCurrency,
 	}
 }
 
@@ -310,7 +312,8 @@ func TestAward() *Award {
 		ID:          1,
 		Name:        "award",
 		Description: "description",
-		Price:       100,
+		Price:       NewDecimal(100),
+		Currency:    DefaultCurrency,
 		CreatorId:   1,
 		Cover:       "not found",
 	}
@@ -340,6 +343,15 @@ func TestCreatePost() *CreatePost {
 		CreatorId:   1,
 	}
 }
+func TestPostUnlock() *PostUnlock {
+	return &PostUnlock{
+		PostID:    2,
+		UserID:    3,
+		CreatorID: 1,
+		Amount:    NewDecimal(200),
+		Currency:  DefaultCurrency,
+	}
+}
 func TestAttachWithoutLevel() *AttachWithoutLevel {
 	return &AttachWithoutLevel{
 		ID:     1,
@@ -351,10 +363,13 @@ func TestAttachWithoutLevel() *AttachWithoutLevel {
 
 func TestPayment() *Payments {
 	return &Payments{
-		Amount:    100,
+		Amount:    NewDecimal(100),
+		Currency:  DefaultCurrency,
 		Date:      time.Now(),
 		CreatorID: 1,
 		UserID:    11,
+		State:     PaymentCreated,
+		Type:      PaymentSubscription,
 	}
 }
 
@@ -366,3 +381,49 @@ func TestAttach() *Attach {
 		Type:  Image,
 	}
 }
+
+func TestPaymentNotification() *PaymentNotification {
+	return &PaymentNotification{
+		OperationID: "1234567",
+		Token:       "pay_token",
+		Amount:      NewDecimal(100),
+		Currency:    DefaultCurrency,
+	}
+}
+
+func TestPromoCode() *PromoCode {
+	return &PromoCode{
+		ID:           1,
+		CreatorID:    1,
+		Code:         "GOLD50",
+		DiscountType: DiscountPercent,
+		Discount:     50,
+		ValidFrom:    time.Now().Add(-time.Hour),
+		Active:       true,
+	}
+}
+
+func TestGift() *Gift {
+	return &Gift{
+		ID:        1,
+		Code:      "A1B2C3D4E5F6A7B8",
+		PayerID:   2,
+		CreatorID: 1,
+		AwardID:   1,
+		Periods:   3,
+		Amount:    NewDecimal(300),
+		Currency:  DefaultCurrency,
+		Status:    GiftPending,
+	}
+}
+
+func TestTip() *Tip {
+	return &Tip{
+		ID:        1,
+		UserID:    2,
+		CreatorID: 1,
+		Amount:    NewDecimal(150),
+		Currency:  DefaultCurrency,
+		Message:   "thanks for your work",
+	}
+}
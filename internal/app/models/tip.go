@@ -0,0 +1,50 @@
+package models
+
+import (
+	models_utilits "patreon/internal/app/utilits/models"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+)
+
+const MaxTipMessageLength = 500
+
+// Tip one-time payment of user to creator or to post of creator, PostID is 0 for tip to creator.
+// Message is shown to creator when tip is paid
+type Tip struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	CreatorID int64     `json:"creator_id"`
+	PostID    int64     `json:"post_id,omitempty"`
+	Amount    Decimal   `json:"amount"`
+	Currency  string    `json:"currency"`
+	Message   string    `json:"message,omitempty"`
+	PayToken  string    `json:"pay_token,omitempty"`
+	Date      time.Time `json:"date"`
+}
+
+// Validate Errors:
+//		IncorrectTipAmount
+//		IncorrectTipMessage
+//		Error of validation with not known field
+func (tip *Tip) Validate() error {
+	err := validation.Errors{
+		"amount":  validation.Validate(int64(tip.Amount), validation.Required, validation.Min(int64(1))),
+		"message": validation.Validate(tip.Message, validation.RuneLength(0, MaxTipMessageLength)),
+	}.Filter()
+	if err == nil {
+		return nil
+	}
+
+	mapOfErr, knowError := models_utilits.ParseErrorToMap(err)
+	if knowError != nil {
+		return errors.Wrap(knowError, "failed error getting in validate tip")
+	}
+
+	if knowError = models_utilits.ExtractValidateError(tipValidError(), mapOfErr); knowError != nil {
+		return knowError
+	}
+
+	return err
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTip_Validate_OK(t *testing.T) {
+	tip := TestTip()
+	assert.NoError(t, tip.Validate())
+	tip.Message = strings.Repeat("я", MaxTipMessageLength)
+	assert.NoError(t, tip.Validate())
+	tip.Message = ""
+	assert.NoError(t, tip.Validate())
+}
+
+func TestTip_ValidateIncorrectAmount(t *testing.T) {
+	tip := TestTip()
+	tip.Amount = 0
+	assert.Equal(t, IncorrectTipAmount, tip.Validate())
+	tip.Amount = -10
+	assert.Equal(t, IncorrectTipAmount, tip.Validate())
+}
+
+func TestTip_ValidateIncorrectMessage(t *testing.T) {
+	tip := TestTip()
+	tip.Message = strings.Repeat("a", MaxTipMessageLength+1)
+	assert.Equal(t, IncorrectTipMessage, tip.Validate())
+}
@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// Trial free trial of award. It is allowed once for user on creator
+// and converted when the first paid period after trial is paid
+type Trial struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"-"`
+	CreatorID int64     `json:"creator_id"`
+	AwardID   int64     `json:"award_id"`
+	EndsAt    time.Time `json:"ends_at"`
+	Date      time.Time `json:"date"`
+}
+
+// TrialStats trials of creator started in statistics period. Active trials are not ended
+// and not converted yet, ConversionRate is percent of converted trials among ended ones
+type TrialStats struct {
+	Started        int64   `json:"started"`
+	Active         int64   `json:"active"`
+	Converted      int64   `json:"converted"`
+	ConversionRate float64 `json:"conversion_rate"`
+}
@@ -0,0 +1,27 @@
+package payment_provider
+
+import (
+	"errors"
+	"patreon/internal/app"
+)
+
+var (
+	InvalidNotification          = errors.New("notification have not required fields")
+	InvalidNotificationHash      = errors.New("notification sha1_hash not equal calculated hash")
+	ProtectedPaymentNotSupported = errors.New("payments protected by code are not supported")
+	UnacceptedPayment            = errors.New("payment was not accepted by receiver")
+	NotSupported                 = errors.New("operation is not supported by payment provider")
+	PaymentNotFound              = errors.New("payment not found in payment provider")
+	InvalidRefund                = errors.New("refund amount more than paid amount or payment not paid")
+	InvalidCheckout              = errors.New("checkout must have token and positive amount")
+	UnsupportedCurrency          = errors.New("payment provider does not accept currency of payment")
+	EmptyNotificationSecret      = errors.New("notification secret must be set for payment provider")
+	ProviderError                = errors.New("payment provider return error")
+)
+
+func NewProviderError(err error) *app.GeneralError {
+	return &app.GeneralError{
+		Err:         ProviderError,
+		ExternalErr: err,
+	}
+}
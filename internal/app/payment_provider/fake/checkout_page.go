@@ -0,0 +1,88 @@
+package fake_provider
+
+import (
+	"html/template"
+	"net/http"
+	"patreon/internal/app/models"
+	"patreon/internal/app/payment_provider"
+)
+
+var checkoutPage = template.Must(template.New("checkout").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Fake checkout</title></head>
+<body>
+	<h1>Fake checkout</h1>
+	<p>Payment: {{.Description}}</p>
+	<p>Amount: {{.Amount}}</p>
+	<p>Status: {{.Status}}</p>
+	{{if .Message}}<p>{{.Message}}</p>{{end}}
+	<form method="POST">
+		<input type="hidden" name="token" value="{{.Token}}">
+		<button type="submit">Pay</button>
+	</form>
+</body>
+</html>
+`))
+
+type checkoutPageData struct {
+	Token       string
+	Description string
+	Amount      models.Decimal
+	Status      payment_provider.Status
+	Message     string
+}
+
+// ServeHTTP serve checkout page of fake provider:
+//		GET checkout?token=... - show payment
+//		POST checkout with form field token - pay payment and send notification to webhook,
+//			repeated POST send the same notification again
+func (p *FakeProvider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != CheckoutUrl {
+		http.NotFound(w, r)
+		return
+	}
+
+	message := ""
+	var token string
+	switch r.Method {
+	case http.MethodGet:
+		token = r.URL.Query().Get("token")
+	case http.MethodPost:
+		token = r.FormValue("token")
+		code, err := p.Pay(token)
+		if err == payment_provider.PaymentNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			message = "Notification was not accepted: " + err.Error()
+		} else {
+			message = "Notification was accepted with status " + http.StatusText(code)
+		}
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	p.mutex.Lock()
+	payment, ok := p.payments[token]
+	var data checkoutPageData
+	if ok {
+		data = checkoutPageData{
+			Token:       token,
+			Description: payment.checkout.Description,
+			Amount:      payment.checkout.Amount,
+			Status:      payment.status,
+			Message:     message,
+		}
+	}
+	p.mutex.Unlock()
+
+	if !ok {
+		http.Error(w, payment_provider.PaymentNotFound.Error(), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_ = checkoutPage.Execute(w, data)
+}
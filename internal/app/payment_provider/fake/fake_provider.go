@@ -0,0 +1,197 @@
+package fake_provider
+
+import (
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"patreon/internal/app/models"
+	"patreon/internal/app/payment_provider"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const CheckoutUrl = "/api/v1/payments/fake/checkout"
+
+type fakePayment struct {
+	checkout    models.Checkout
+	status      payment_provider.Status
+	operationID string
+	refunded    models.Decimal
+}
+
+// FakeProvider in-process payment provider without network access.
+// Checkout page and webhook simulator are served by ServeHTTP, payment is marked
+// as paid by "pay" button on checkout page and notification is sent to webhookUrl
+type FakeProvider struct {
+	webhookUrl         string
+	notificationSecret string
+	client             *http.Client
+	mutex              sync.Mutex
+	payments           map[string]*fakePayment
+	lastOperationID    int64
+}
+
+func NewFakeProvider(webhookUrl string, notificationSecret string, client *http.Client) *FakeProvider {
+	return &FakeProvider{
+		webhookUrl:         webhookUrl,
+		notificationSecret: notificationSecret,
+		client:             client,
+		payments:           map[string]*fakePayment{},
+	}
+}
+
+func (p *FakeProvider) Name() string {
+	return payment_provider.Fake
+}
+
+// calculateHash sha1 of operation_id&amount&currency&label&notification_secret
+func (p *FakeProvider) calculateHash(operationID string, amount string, currency string, label string) string {
+	hash := sha1.Sum([]byte(strings.Join([]string{operationID, amount, currency, label, p.notificationSecret}, "&")))
+	return hex.EncodeToString(hash[:])
+}
+
+// CreateCheckout Errors:
+//		payment_provider.InvalidCheckout
+func (p *FakeProvider) CreateCheckout(checkout *models.Checkout) (*models.CheckoutInfo, error) {
+	if checkout.Token == "" || checkout.Amount <= 0 {
+		return nil, payment_provider.InvalidCheckout
+	}
+
+	p.mutex.Lock()
+	if _, ok := p.payments[checkout.Token]; !ok {
+		p.payments[checkout.Token] = &fakePayment{
+			checkout: *checkout,
+			status:   payment_provider.StatusPending,
+		}
+	}
+	p.mutex.Unlock()
+
+	return &models.CheckoutInfo{
+		Provider: p.Name(),
+		Url:      CheckoutUrl + "?" + url.Values{"token": {checkout.Token}}.Encode(),
+	}, nil
+}
+
+// ParseNotification Errors:
+//		payment_provider.InvalidNotification
+//		payment_provider.InvalidNotificationHash
+func (p *FakeProvider) ParseNotification(form url.Values) (*models.PaymentNotification, error) {
+	operationID := form.Get("operation_id")
+	label := form.Get("label")
+	amountStr := form.Get("amount")
+	currency := form.Get("currency")
+	if operationID == "" || label == "" || currency == "" {
+		return nil, payment_provider.InvalidNotification
+	}
+	amount, err := models.ParseDecimal(amountStr)
+	if err != nil {
+		return nil, payment_provider.InvalidNotification
+	}
+
+	hash := p.calculateHash(operationID, amountStr, currency, label)
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(form.Get("sha1_hash"))) != 1 {
+		return nil, payment_provider.InvalidNotificationHash
+	}
+
+	return &models.PaymentNotification{
+		OperationID: operationID,
+		Token:       label,
+		Amount:      amount,
+		Currency:    currency,
+	}, nil
+}
+
+// GetStatus Errors:
+//		payment_provider.PaymentNotFound
+func (p *FakeProvider) GetStatus(token string) (payment_provider.Status, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	payment, ok := p.payments[token]
+	if !ok {
+		return "", payment_provider.PaymentNotFound
+	}
+	return payment.status, nil
+}
+
+// Refund Errors:
+//		payment_provider.PaymentNotFound
+//		payment_provider.InvalidRefund
+func (p *FakeProvider) Refund(token string, amount models.Decimal) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	payment, ok := p.payments[token]
+	if !ok {
+		return payment_provider.PaymentNotFound
+	}
+	if payment.status != payment_provider.StatusSucceeded && payment.status != payment_provider.StatusPartiallyRefunded ||
+		amount <= 0 || payment.refunded+amount > payment.checkout.Amount {
+		return payment_provider.InvalidRefund
+	}
+
+	payment.refunded += amount
+	payment.status = payment_provider.StatusPartiallyRefunded
+	if payment.refunded == payment.checkout.Amount {
+		payment.status = payment_provider.StatusRefunded
+	}
+	return nil
+}
+
+// Pay mark payment as paid and send notification to webhook, as real provider do after payment.
+// Repeated call send the same notification again
+// Errors:
+//		payment_provider.PaymentNotFound
+//		app.GeneralError with Errors
+//			payment_provider.ProviderError
+func (p *FakeProvider) Pay(token string) (int, error) {
+	p.mutex.Lock()
+	payment, ok := p.payments[token]
+	if !ok {
+		p.mutex.Unlock()
+		return 0, payment_provider.PaymentNotFound
+	}
+	if payment.operationID == "" {
+		p.lastOperationID++
+		payment.operationID = "fake-" + strconv.FormatInt(p.lastOperationID, 10)
+		payment.status = payment_provider.StatusSucceeded
+	}
+	form := p.notificationForm(payment)
+	p.mutex.Unlock()
+
+	return p.SendNotification(form)
+}
+
+func (p *FakeProvider) notificationForm(payment *fakePayment) url.Values {
+	amount := payment.checkout.Amount.String()
+	form := url.Values{}
+	form.Set("operation_id", payment.operationID)
+	form.Set("amount", amount)
+	form.Set("currency", payment.checkout.Currency)
+	form.Set("label", payment.checkout.Token)
+	form.Set("sha1_hash", p.calculateHash(payment.operationID, amount, payment.checkout.Currency,
+		payment.checkout.Token))
+	return form
+}
+
+// SendNotification post notification form to webhook and return its response status code
+// Errors:
+//		app.GeneralError with Errors
+//			payment_provider.ProviderError
+func (p *FakeProvider) SendNotification(form url.Values) (int, error) {
+	resp, err := p.client.PostForm(p.webhookUrl, form)
+	if err != nil {
+		return 0, payment_provider.NewProviderError(err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return resp.StatusCode, payment_provider.NewProviderError(
+			fmt.Errorf("webhook return status %d", resp.StatusCode))
+	}
+	return resp.StatusCode, nil
+}
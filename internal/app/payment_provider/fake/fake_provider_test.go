@@ -0,0 +1,98 @@
+package fake_provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"patreon/internal/app/models"
+	"patreon/internal/app/payment_provider"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "notification_secret"
+
+func TestFakeProvider_PayFlow(t *testing.T) {
+	var provider *FakeProvider
+	var received []*models.PaymentNotification
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		notification, err := provider.ParseNotification(r.PostForm)
+		if err != nil {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		received = append(received, notification)
+	}))
+	defer webhook.Close()
+	provider = NewFakeProvider(webhook.URL, testSecret, webhook.Client())
+
+	_, err := provider.Pay("pay_token")
+	assert.Equal(t, payment_provider.PaymentNotFound, err)
+
+	info, err := provider.CreateCheckout(&models.Checkout{Token: "pay_token", Amount: models.NewDecimal(100),
+		Currency: models.DefaultCurrency})
+	require.NoError(t, err)
+	assert.Equal(t, payment_provider.Fake, info.Provider)
+	assert.Equal(t, CheckoutUrl+"?token=pay_token", info.Url)
+
+	status, err := provider.GetStatus("pay_token")
+	require.NoError(t, err)
+	assert.Equal(t, payment_provider.StatusPending, status)
+
+	code, err := provider.Pay("pay_token")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, code)
+	_, err = provider.Pay("pay_token")
+	require.NoError(t, err)
+
+	require.Len(t, received, 2)
+	assert.Equal(t, received[0], received[1])
+	assert.Equal(t, "pay_token", received[0].Token)
+	assert.Equal(t, models.NewDecimal(100), received[0].Amount)
+	assert.Equal(t, models.DefaultCurrency, received[0].Currency)
+
+	status, err = provider.GetStatus("pay_token")
+	require.NoError(t, err)
+	assert.Equal(t, payment_provider.StatusSucceeded, status)
+}
+
+func TestFakeProvider_ParseNotification(t *testing.T) {
+	provider := NewFakeProvider("", testSecret, http.DefaultClient)
+	other := NewFakeProvider("", "other_secret", http.DefaultClient)
+
+	form := url.Values{"operation_id": {"fake-1"}, "amount": {"100.00"}, "currency": {"RUB"}, "label": {"pay_token"}}
+	form.Set("sha1_hash", other.calculateHash("fake-1", "100.00", "RUB", "pay_token"))
+	_, err := provider.ParseNotification(form)
+	assert.Equal(t, payment_provider.InvalidNotificationHash, err)
+
+	form.Del("label")
+	_, err = provider.ParseNotification(form)
+	assert.Equal(t, payment_provider.InvalidNotification, err)
+}
+
+func TestFakeProvider_Refund(t *testing.T) {
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer webhook.Close()
+	provider := NewFakeProvider(webhook.URL, testSecret, webhook.Client())
+
+	_, err := provider.CreateCheckout(&models.Checkout{Token: "pay_token", Amount: models.NewDecimal(100),
+		Currency: models.DefaultCurrency})
+	require.NoError(t, err)
+	assert.Equal(t, payment_provider.InvalidRefund, provider.Refund("pay_token", models.NewDecimal(10)))
+	assert.Equal(t, payment_provider.PaymentNotFound, provider.Refund("other_token", models.NewDecimal(10)))
+
+	_, err = provider.Pay("pay_token")
+	require.NoError(t, err)
+
+	require.NoError(t, provider.Refund("pay_token", models.NewDecimal(40)))
+	status, _ := provider.GetStatus("pay_token")
+	assert.Equal(t, payment_provider.StatusPartiallyRefunded, status)
+
+	assert.Equal(t, payment_provider.InvalidRefund, provider.Refund("pay_token", models.NewDecimal(70)))
+	require.NoError(t, provider.Refund("pay_token", models.NewDecimal(60)))
+	status, _ = provider.GetStatus("pay_token")
+	assert.Equal(t, payment_provider.StatusRefunded, status)
+}
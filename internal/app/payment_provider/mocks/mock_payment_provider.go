@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: patreon/internal/app/payment_provider (interfaces: PaymentProvider)
+
+// Package mock_payment_provider is a generated GoMock package.
+package mock_payment_provider
+
+import (
+	url "net/url"
+	models "patreon/internal/app/models"
+	payment_provider "patreon/internal/app/payment_provider"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPaymentProvider is a mock of PaymentProvider interface.
+type MockPaymentProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentProviderMockRecorder
+}
+
+// MockPaymentProviderMockRecorder is the mock recorder for MockPaymentProvider.
+type MockPaymentProviderMockRecorder struct {
+	mock *MockPaymentProvider
+}
+
+// NewMockPaymentProvider creates a new mock instance.
+func NewMockPaymentProvider(ctrl *gomock.Controller) *MockPaymentProvider {
+	mock := &MockPaymentProvider{ctrl: ctrl}
+	mock.recorder = &MockPaymentProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentProvider) EXPECT() *MockPaymentProviderMockRecorder {
+	return m.recorder
+}
+
+// CreateCheckout mocks base method.
+func (m *MockPaymentProvider) CreateCheckout(arg0 *models.Checkout) (*models.CheckoutInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCheckout", arg0)
+	ret0, _ := ret[0].(*models.CheckoutInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCheckout indicates an expected call of CreateCheckout.
+func (mr *MockPaymentProviderMockRecorder) CreateCheckout(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCheckout", reflect.TypeOf((*MockPaymentProvider)(nil).CreateCheckout), arg0)
+}
+
+// GetStatus mocks base method.
+func (m *MockPaymentProvider) GetStatus(arg0 string) (payment_provider.Status, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatus", arg0)
+	ret0, _ := ret[0].(payment_provider.Status)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatus indicates an expected call of GetStatus.
+func (mr *MockPaymentProviderMockRecorder) GetStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockPaymentProvider)(nil).GetStatus), arg0)
+}
+
+// Name mocks base method.
+func (m *MockPaymentProvider) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockPaymentProviderMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockPaymentProvider)(nil).Name))
+}
+
+// ParseNotification mocks base method.
+func (m *MockPaymentProvider) ParseNotification(arg0 url.Values) (*models.PaymentNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseNotification", arg0)
+	ret0, _ := ret[0].(*models.PaymentNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseNotification indicates an expected call of ParseNotification.
+func (mr *MockPaymentProviderMockRecorder) ParseNotification(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseNotification", reflect.TypeOf((*MockPaymentProvider)(nil).ParseNotification), arg0)
+}
+
+// Refund mocks base method.
+func (m *MockPaymentProvider) Refund(arg0 string, arg1 models.Decimal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockPaymentProviderMockRecorder) Refund(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockPaymentProvider)(nil).Refund), arg0, arg1)
+}
@@ -0,0 +1,64 @@
+package payment_provider
+
+import (
+	"net/url"
+	"patreon/internal/app"
+	"patreon/internal/app/models"
+)
+
+const (
+	YooMoney = "yoomoney"
+	Fake     = "fake"
+)
+
+// CheckConfig notification of real provider can be forged without secret, only fake provider works without it
+// Errors:
+//		EmptyNotificationSecret
+func CheckConfig(config *app.Payments) error {
+	if config.Provider != Fake && config.NotificationSecret == "" {
+		return EmptyNotificationSecret
+	}
+	return nil
+}
+
+type Status string
+
+const (
+	StatusPending           Status = "pending"
+	StatusSucceeded         Status = "succeeded"
+	StatusRefunded          Status = "refunded"
+	StatusPartiallyRefunded Status = "partially_refunded"
+)
+
+//go:generate mockgen -destination=mocks/mock_payment_provider.go -package=mock_payment_provider . PaymentProvider
+
+type PaymentProvider interface {
+	// Name return name of provider, used in checkout info
+	Name() string
+	// CreateCheckout return info how user can pay payment with token
+	// Errors:
+	//		InvalidCheckout
+	//		UnsupportedCurrency
+	CreateCheckout(checkout *models.Checkout) (*models.CheckoutInfo, error)
+	// ParseNotification parse and verify notification form sent by provider,
+	// currency of notification is letter code as in payments
+	// Errors:
+	//		InvalidNotification
+	//		InvalidNotificationHash
+	//		ProtectedPaymentNotSupported
+	//		UnacceptedPayment
+	ParseNotification(form url.Values) (*models.PaymentNotification, error)
+	// GetStatus Errors:
+	//		NotSupported
+	//		PaymentNotFound
+	//		app.GeneralError with Errors
+	//			ProviderError
+	GetStatus(token string) (Status, error)
+	// Refund Errors:
+	//		NotSupported
+	//		PaymentNotFound
+	//		InvalidRefund
+	//		app.GeneralError with Errors
+	//			ProviderError
+	Refund(token string, amount models.Decimal) error
+}
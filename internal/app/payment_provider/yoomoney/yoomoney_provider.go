@@ -0,0 +1,219 @@
+package yoomoney_provider
+
+import (
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"patreon/internal/app/models"
+	"patreon/internal/app/payment_provider"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	quickpayUrl         = "https://yoomoney.ru/quickpay/confirm.xml"
+	operationHistoryUrl = "https://yoomoney.ru/api/operation-history"
+
+	operationSuccess = "success"
+
+	// walletCurrency wallet accepts only rubles
+	walletCurrency = "RUB"
+)
+
+// currencyCodes letter codes of ISO 4217 numeric codes sent in notifications
+var currencyCodes = map[string]string{
+	"643": walletCurrency,
+}
+
+// notification http notification from YooMoney about incoming transfer.
+// Fields used in sha1_hash are stored as raw strings as they were received
+type notification struct {
+	NotificationType string
+	OperationID      string
+	Amount           string
+	WithdrawAmount   models.Decimal
+	Currency         string
+	Datetime         string
+	Sender           string
+	Codepro          string
+	Label            string
+	Sha1Hash         string
+	Unaccepted       string
+}
+
+// calculateHash build sha1 hash of notification by YooMoney rules:
+// notification_type&operation_id&amount&currency&datetime&sender&codepro&notification_secret&label
+func (n *notification) calculateHash(secret string) string {
+	params := strings.Join([]string{n.NotificationType, n.OperationID, n.Amount, n.Currency,
+		n.Datetime, n.Sender, n.Codepro, secret, n.Label}, "&")
+	hash := sha1.Sum([]byte(params))
+	return hex.EncodeToString(hash[:])
+}
+
+type operationHistory struct {
+	Error      string `json:"error"`
+	Operations []struct {
+		OperationID string `json:"operation_id"`
+		Status      string `json:"status"`
+	} `json:"operations"`
+}
+
+type YooMoneyProvider struct {
+	accountNumber      string
+	notificationSecret string
+	accessToken        string
+	client             *http.Client
+	historyUrl         string
+}
+
+// NewYooMoneyProvider accessToken is OAuth token of wallet with operation-history scope,
+// if it is empty status lookup is not supported
+func NewYooMoneyProvider(accountNumber string, notificationSecret string, accessToken string,
+	client *http.Client) *YooMoneyProvider {
+	return &YooMoneyProvider{
+		accountNumber:      accountNumber,
+		notificationSecret: notificationSecret,
+		accessToken:        accessToken,
+		client:             client,
+		historyUrl:         operationHistoryUrl,
+	}
+}
+
+func (p *YooMoneyProvider) Name() string {
+	return payment_provider.YooMoney
+}
+
+// CreateCheckout Errors:
+//		payment_provider.InvalidCheckout
+//		payment_provider.UnsupportedCurrency
+func (p *YooMoneyProvider) CreateCheckout(checkout *models.Checkout) (*models.CheckoutInfo, error) {
+	if checkout.Token == "" || checkout.Amount <= 0 {
+		return nil, payment_provider.InvalidCheckout
+	}
+	if checkout.Currency != walletCurrency {
+		return nil, payment_provider.UnsupportedCurrency
+	}
+	params := url.Values{}
+	params.Set("receiver", p.accountNumber)
+	params.Set("quickpay-form", "shop")
+	params.Set("targets", checkout.Description)
+	params.Set("paymentType", "AC")
+	params.Set("sum", checkout.Amount.String())
+	params.Set("label", checkout.Token)
+
+	return &models.CheckoutInfo{
+		Provider: p.Name(),
+		Url:      quickpayUrl + "?" + params.Encode(),
+	}, nil
+}
+
+// ParseNotification Errors:
+//		payment_provider.InvalidNotification
+//		payment_provider.InvalidNotificationHash
+//		payment_provider.ProtectedPaymentNotSupported
+//		payment_provider.UnacceptedPayment
+func (p *YooMoneyProvider) ParseNotification(form url.Values) (*models.PaymentNotification, error) {
+	n := &notification{
+		NotificationType: form.Get("notification_type"),
+		OperationID:      form.Get("operation_id"),
+		Amount:           form.Get("amount"),
+		Currency:         form.Get("currency"),
+		Datetime:         form.Get("datetime"),
+		Sender:           form.Get("sender"),
+		Codepro:          form.Get("codepro"),
+		Label:            form.Get("label"),
+		Sha1Hash:         form.Get("sha1_hash"),
+		Unaccepted:       form.Get("unaccepted"),
+	}
+	if n.OperationID == "" || n.Label == "" || n.Sha1Hash == "" {
+		return nil, payment_provider.InvalidNotification
+	}
+	withdrawAmount, err := models.ParseDecimal(form.Get("withdraw_amount"))
+	if err != nil {
+		return nil, payment_provider.InvalidNotification
+	}
+	n.WithdrawAmount = withdrawAmount
+
+	hash := n.calculateHash(p.notificationSecret)
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(strings.ToLower(n.Sha1Hash))) != 1 {
+		return nil, payment_provider.InvalidNotificationHash
+	}
+	if n.Codepro == "true" {
+		return nil, payment_provider.ProtectedPaymentNotSupported
+	}
+	if n.Unaccepted == "true" {
+		return nil, payment_provider.UnacceptedPayment
+	}
+
+	// unknown currency code is kept as is, so it never matches currency of payment
+	currency, ok := currencyCodes[n.Currency]
+	if !ok {
+		currency = n.Currency
+	}
+	return &models.PaymentNotification{
+		OperationID: n.OperationID,
+		Token:       n.Label,
+		Amount:      n.WithdrawAmount,
+		Currency:    currency,
+	}, nil
+}
+
+// GetStatus find incoming transfer with label equal token in wallet history
+// Errors:
+//		payment_provider.NotSupported
+//		app.GeneralError with Errors
+//			payment_provider.ProviderError
+func (p *YooMoneyProvider) GetStatus(token string) (payment_provider.Status, error) {
+	if p.accessToken == "" {
+		return "", payment_provider.NotSupported
+	}
+
+	params := url.Values{}
+	params.Set("type", "deposition")
+	params.Set("label", token)
+	req, err := http.NewRequest(http.MethodPost, p.historyUrl, strings.NewReader(params.Encode()))
+	if err != nil {
+		return "", payment_provider.NewProviderError(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", "Bearer "+p.accessToken)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", payment_provider.NewProviderError(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", payment_provider.NewProviderError(
+			fmt.Errorf("operation-history return status %d", resp.StatusCode))
+	}
+
+	history := &operationHistory{}
+	if err = json.NewDecoder(resp.Body).Decode(history); err != nil {
+		return "", payment_provider.NewProviderError(errors.Wrap(err, "invalid operation-history response"))
+	}
+	if history.Error != "" {
+		return "", payment_provider.NewProviderError(
+			fmt.Errorf("operation-history return error %s", history.Error))
+	}
+
+	for _, operation := range history.Operations {
+		if operation.Status == operationSuccess {
+			return payment_provider.StatusSucceeded, nil
+		}
+	}
+	return payment_provider.StatusPending, nil
+}
+
+// Refund transfers to YooMoney wallet can not be refunded by API
+// Errors:
+//		payment_provider.NotSupported
+func (p *YooMoneyProvider) Refund(_ string, _ models.Decimal) error {
+	return payment_provider.NotSupported
+}
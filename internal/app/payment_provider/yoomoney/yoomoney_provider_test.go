@@ -0,0 +1,144 @@
+package yoomoney_provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"patreon/internal/app/models"
+	"patreon/internal/app/payment_provider"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSecret  = "notification_secret"
+	testAccount = "4100000000000"
+)
+
+func signedForm(secret string) url.Values {
+	form := url.Values{}
+	form.Set("notification_type", "card-incoming")
+	form.Set("operation_id", "1234567")
+	form.Set("amount", "98.00")
+	form.Set("withdraw_amount", "100.00")
+	form.Set("currency", "643")
+	form.Set("datetime", "2021-11-20T12:00:00Z")
+	form.Set("sender", "")
+	form.Set("codepro", "false")
+	form.Set("label", "pay_token")
+	form.Set("unaccepted", "false")
+	sign(form, secret)
+	return form
+}
+
+func sign(form url.Values, secret string) {
+	n := &notification{
+		NotificationType: form.Get("notification_type"),
+		OperationID:      form.Get("operation_id"),
+		Amount:           form.Get("amount"),
+		Currency:         form.Get("currency"),
+		Datetime:         form.Get("datetime"),
+		Sender:           form.Get("sender"),
+		Codepro:          form.Get("codepro"),
+		Label:            form.Get("label"),
+	}
+	form.Set("sha1_hash", n.calculateHash(secret))
+}
+
+func TestYooMoneyProvider_ParseNotification(t *testing.T) {
+	provider := NewYooMoneyProvider(testAccount, testSecret, "", http.DefaultClient)
+
+	res, err := provider.ParseNotification(signedForm(testSecret))
+	require.NoError(t, err)
+	assert.Equal(t, &models.PaymentNotification{OperationID: "1234567", Token: "pay_token", Amount: models.NewDecimal(100),
+		Currency: "RUB"}, res)
+
+	form := signedForm(testSecret)
+	form.Set("currency", "840")
+	sign(form, testSecret)
+	res, err = provider.ParseNotification(form)
+	require.NoError(t, err)
+	assert.Equal(t, "840", res.Currency)
+
+	form = signedForm(testSecret)
+	form.Set("amount", "1000.00")
+	_, err = provider.ParseNotification(form)
+	assert.Equal(t, payment_provider.InvalidNotificationHash, err)
+
+	_, err = provider.ParseNotification(signedForm("other_secret"))
+	assert.Equal(t, payment_provider.InvalidNotificationHash, err)
+
+	form = signedForm(testSecret)
+	form.Del("label")
+	_, err = provider.ParseNotification(form)
+	assert.Equal(t, payment_provider.InvalidNotification, err)
+
+	form = signedForm(testSecret)
+	form.Set("withdraw_amount", "abc")
+	_, err = provider.ParseNotification(form)
+	assert.Equal(t, payment_provider.InvalidNotification, err)
+
+	form = signedForm(testSecret)
+	form.Set("codepro", "true")
+	sign(form, testSecret)
+	_, err = provider.ParseNotification(form)
+	assert.Equal(t, payment_provider.ProtectedPaymentNotSupported, err)
+
+	form = signedForm(testSecret)
+	form.Set("unaccepted", "true")
+	_, err = provider.ParseNotification(form)
+	assert.Equal(t, payment_provider.UnacceptedPayment, err)
+}
+
+func TestYooMoneyProvider_CreateCheckout(t *testing.T) {
+	provider := NewYooMoneyProvider(testAccount, testSecret, "", http.DefaultClient)
+
+	res, err := provider.CreateCheckout(&models.Checkout{Token: "pay_token", Amount: models.NewDecimal(100),
+		Currency: "RUB", Description: "test"})
+	require.NoError(t, err)
+	assert.Equal(t, payment_provider.YooMoney, res.Provider)
+	checkoutUrl, err := url.Parse(res.Url)
+	require.NoError(t, err)
+	assert.Equal(t, testAccount, checkoutUrl.Query().Get("receiver"))
+	assert.Equal(t, "pay_token", checkoutUrl.Query().Get("label"))
+	assert.Equal(t, "100.00", checkoutUrl.Query().Get("sum"))
+
+	_, err = provider.CreateCheckout(&models.Checkout{Token: "pay_token"})
+	assert.Equal(t, payment_provider.InvalidCheckout, err)
+
+	_, err = provider.CreateCheckout(&models.Checkout{Token: "pay_token", Amount: models.NewDecimal(100), Currency: "USD"})
+	assert.Equal(t, payment_provider.UnsupportedCurrency, err)
+}
+
+func TestYooMoneyProvider_GetStatus(t *testing.T) {
+	provider := NewYooMoneyProvider(testAccount, testSecret, "", http.DefaultClient)
+	_, err := provider.GetStatus("pay_token")
+	assert.Equal(t, payment_provider.NotSupported, err)
+
+	responseBody := `{"operations":[{"operation_id":"1234567","status":"success"}]}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer access_token", r.Header.Get("Authorization"))
+		assert.Equal(t, "pay_token", r.FormValue("label"))
+		_, _ = w.Write([]byte(responseBody))
+	}))
+	defer server.Close()
+
+	provider = NewYooMoneyProvider(testAccount, testSecret, "access_token", server.Client())
+	provider.historyUrl = server.URL
+	status, err := provider.GetStatus("pay_token")
+	require.NoError(t, err)
+	assert.Equal(t, payment_provider.StatusSucceeded, status)
+
+	responseBody = `{"operations":[]}`
+	status, err = provider.GetStatus("pay_token")
+	require.NoError(t, err)
+	assert.Equal(t, payment_provider.StatusPending, status)
+
+	responseBody = `{"error":"invalid_token"}`
+	_, err = provider.GetStatus("pay_token")
+	assert.Error(t, err)
+
+	assert.Equal(t, payment_provider.NotSupported, provider.Refund("pay_token", models.NewDecimal(100)))
+}
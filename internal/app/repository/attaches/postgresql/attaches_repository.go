@@ -22,7 +22,7 @@ const (
 	createQuery = `INSERT INTO posts_data (type, data, post_id) VALUES ($1, $2, $3) 
 		RETURNING data_id`
 
-	getQuery = `SELECT post_id, data, type FROM posts_data WHERE data_id = $1`
+	getQuery = `SELECT post_id, data, type FROM posts_data WHERE data_id = $1 AND level != -1`
 
 	existsAttachQuery = `SELECT post_id FROM posts_data WHERE data_id in (?)`
 
@@ -95,14 +95,23 @@ func (repo *AttachesRepository) Create(postData *models.AttachWithoutLevel) (int
 	return postData.ID, nil
 }
 
-// Get Errors:
+// Get return attach only if it is placed in its post, so uploaded or removed attaches are not found
+// Errors:
 //		repository.NotFound
 // 		app.GeneralError with Errors:
 // 			repository.DefaultErrDB
 func (repo *AttachesRepository) Get(attachId int64) (*models.AttachWithoutLevel, error) {
+	return repo.get(getQuery, attachId)
+}
+
+// get Errors:
+//		repository.NotFound
+// 		app.GeneralError with Errors:
+// 			repository.DefaultErrDB
+func (repo *AttachesRepository) get(query string, attachId int64) (*models.AttachWithoutLevel, error) {
 	data := &models.AttachWithoutLevel{ID: attachId}
 	var typeId int64
-	if err := repo.store.QueryRow(getQuery, attachId).Scan(&data.PostId, &data.Value,
+	if err := repo.store.QueryRow(query, attachId).Scan(&data.PostId, &data.Value,
 		&typeId); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, repository.NotFound
@@ -14,7 +14,10 @@ import (
 const UnusedAttach = -1 //value stored in level field
 
 const (
-	deleteUnusedQuery = `DELETE FROM posts_data WHERE level = $1 and post_id = $2`
+	// deleteUnusedQuery attaches saved in post revisions are kept, so restore of revision does not change their ids
+	deleteUnusedQuery = `DELETE FROM posts_data WHERE level = $1 and post_id = $2 and NOT EXISTS (
+			SELECT 1 FROM post_revisions rev, jsonb_array_elements(rev.attaches) att 
+			WHERE rev.posts_id = $2 and (att->>'id')::bigint = posts_data.data_id)`
 
 	makeUnusedAttachQuery = `UPDATE posts_data SET level = $1 WHERE post_id = $2`
 
@@ -29,8 +32,8 @@ const (
 
 // getAttachTypeAndId Errors:
 //		UnknownDataFormat
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
 func (repo *AttachesRepository) getAttachTypeAndId() (map[models.DataType]int64, error) {
 	if repo.dataTypes != nil && repo.lastUpdate.Add(reloadDataType).After(time.Now()) {
 		return repo.dataTypes, nil
@@ -63,8 +66,8 @@ func (repo *AttachesRepository) getAttachTypeAndId() (map[models.DataType]int64,
 
 // createAttaches Errors:
 //		UnknownDataFormat
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
 func (repo *AttachesRepository) createAttaches(trans *sqlx.Tx, postId int64,
 	newAttachs []models.Attach) ([]models.Attach, error) {
 	dataTypes, err := repo.getAttachTypeAndId()
@@ -107,8 +110,8 @@ func (repo *AttachesRepository) createAttaches(trans *sqlx.Tx, postId int64,
 // ApplyChangeAttaches Errors:
 //		UnknownDataFormat
 //		repository.NotFound
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *AttachesRepository) ApplyChangeAttaches(postId int64,
 	newAttaches []models.Attach, updatedAttaches []models.Attach) ([]int64, error) {
 	res := make([]int64, len(newAttaches)+len(updatedAttaches))
@@ -161,8 +164,8 @@ func (repo *AttachesRepository) ApplyChangeAttaches(postId int64,
 // updateAttach Errors:
 //		UnknownDataFormat
 //		repository.NotFound
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *AttachesRepository) updateAttach(trans *sqlx.Tx, attach *models.Attach) error {
 	dataTypes, err := repo.getAttachTypeAndId()
 	if err != nil {
@@ -190,8 +193,8 @@ func (repo *AttachesRepository) updateAttach(trans *sqlx.Tx, attach *models.Atta
 }
 
 // DeleteUnused Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *AttachesRepository) deleteUnused(trans *sqlx.Tx, postId int64) error {
 	_, err := trans.Exec(deleteUnusedQuery, UnusedAttach, postId)
 	if err != nil {
@@ -202,8 +205,8 @@ func (repo *AttachesRepository) deleteUnused(trans *sqlx.Tx, postId int64) error
 }
 
 // markUnusedAttach Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *AttachesRepository) markUnusedAttach(trans *sqlx.Tx, postId int64) error {
 	_, err := trans.Exec(makeUnusedAttachQuery, UnusedAttach, postId)
 	if err != nil {
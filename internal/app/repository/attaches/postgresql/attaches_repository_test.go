@@ -172,6 +172,16 @@ func (s *SuiteAttachesRepository) TestAttachesRepository_Get() {
 	assert.Error(s.T(), err, repository.NotFound)
 }
 
+func (s *SuiteAttachesRepository) TestAttachesRepository_Get_Detached() {
+	data := s.data
+	// detached attach has level -1 and is filtered by query
+	s.Mock.ExpectQuery(regexp.QuoteMeta(getQuery)).
+		WithArgs(data.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"post_id", "data", "type"}))
+	_, err := s.repo.Get(data.ID)
+	assert.Equal(s.T(), repository.NotFound, err)
+}
+
 func (s *SuiteAttachesRepository) TestAttachesRepository_ExistsAttach() {
 	attachId := int64(1)
 	postId := int64(2)
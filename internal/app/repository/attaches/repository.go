@@ -13,7 +13,8 @@ type Repository interface {
 	// 			repository.DefaultErrDB
 	Create(postData *models.AttachWithoutLevel) (int64, error)
 
-	// Get Errors:
+	// Get return attach only if it is placed in its post
+	// Errors:
 	//		repository.NotFound
 	// 		app.GeneralError with Errors:
 	// 			repository.DefaultErrDB
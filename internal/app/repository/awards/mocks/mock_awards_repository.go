@@ -123,6 +123,21 @@ func (mr *AwardsRepositoryMockRecorder) GetByID(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*AwardsRepository)(nil).GetByID), arg0)
 }
 
+// IsParent mocks base method.
+func (m *AwardsRepository) IsParent(arg0, arg1 int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsParent", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsParent indicates an expected call of IsParent.
+func (mr *AwardsRepositoryMockRecorder) IsParent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsParent", reflect.TypeOf((*AwardsRepository)(nil).IsParent), arg0, arg1)
+}
+
 // Update mocks base method.
 func (m *AwardsRepository) Update(arg0 *models.Award) error {
 	m.ctrl.T.Helper()
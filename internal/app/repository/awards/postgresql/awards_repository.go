@@ -24,20 +24,30 @@ const (
 
 	deleteLevelQuery = `DELETE FROM parents_awards WHERE awards_id = $1 OR parent_id = $1`
 
-	createQuery = `INSERT INTO awards (name, description, price, color, creator_id, cover) VALUES ($1, $2, $3, $4, $5, $6) 
-				RETURNING awards_id`
+	createQuery = `INSERT INTO awards (name, description, price, color, creator_id, cover, trial_days, max_subscribers,
+				currency)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, (SELECT currency FROM creator_profile WHERE creator_id = $5))
+				RETURNING awards_id, currency`
 
 	queryGetCreatorId = "SELECT creator_id from awards where awards.awards_id = $1"
-	updateQueryUpdate = "UPDATE awards SET name = $1, description = $2, price = $3, color = $4 WHERE awards_id = $5"
+	updateQueryUpdate = "UPDATE awards SET name = $1, description = $2, price = $3, color = $4, trial_days = $5, " +
+		"max_subscribers = $6 WHERE awards_id = $7"
 
 	updateCoverQuery = `UPDATE awards SET cover = $1 WHERE awards_id = $2`
 
+	// seats of limited award are taken by active subscriptions and by not paid ones while their checkout lives
+	seatsLeftColumn = `CASE WHEN aw.max_subscribers = 0 THEN 0 ELSE GREATEST(aw.max_subscribers -
+						(SELECT count(*) FROM subscribers s WHERE s.awards_id = aw.awards_id
+						 AND (s.status = true OR (s.paid_until IS NULL AND s.hold_until > now()))), 0) END`
+
 	getByIdQuery = `with frist_child AS (
 						SELECT parents_awards.awards_id as award_id, parents_awards.parent_id as parent_id, price FROM awards a
 						JOIN parents_awards ON parents_awards.awards_id = a.awards_id and parents_awards.parent_id = $1
 						ORDER BY price DESC LIMIT 1
 					)
-					SELECT aw.name, aw.description, aw.price, aw.color, aw.creator_id, aw.cover, ch.award_id as child_id FROM awards AS aw
+					SELECT aw.name, aw.description, aw.price, aw.currency, aw.color, aw.creator_id, aw.cover,
+					       aw.trial_days, aw.max_subscribers, ` + seatsLeftColumn + `, ch.award_id as child_id
+					FROM awards AS aw
     				LEFT JOIN frist_child as ch on ch.parent_id = $1 WHERE aw.awards_id = $1`
 
 	checkAwardsQuery = `SELECT awards_id FROM awards where awards_id = $1`
@@ -53,7 +63,8 @@ const (
 								 JOIN parents_awards ON parents_awards.awards_id = a.awards_id
 						WHERE a.creator_id = $1
 					)
-					SELECT aw.awards_id, aw.name, aw.description, aw.price, aw.color, aw.cover, pa.award_id as child_id
+					SELECT aw.awards_id, aw.name, aw.description, aw.price, aw.currency, aw.color, aw.cover,
+					       aw.trial_days, aw.max_subscribers, ` + seatsLeftColumn + `, pa.award_id as child_id
 					FROM awards AS aw
 							 LEFT JOIN frist_child as ch on ch.parent_id = aw.awards_id
 							 LEFT JOIN child_with_price pa on ch.parent_id = pa.parent_id and ch.mx_price = pa.price
@@ -63,6 +74,8 @@ const (
 	deleteQueryDelete = "DELETE FROM awards WHERE awards_id = $1"
 
 	findByNameQuery = "SELECT count(*) as cnt from awards where creator_id = $1 and name = $2"
+
+	isParentQuery = "SELECT count(*) as cnt from parents_awards where awards_id = $1 and parent_id = $2"
 )
 
 type AwardsRepository struct {
@@ -79,9 +92,9 @@ func NewAwardsRepository(st *sqlx.DB) *AwardsRepository {
 
 // Create Errors:
 //		repository_postgresql.NameAlreadyExist
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
-func (repo *AwardsRepository) checkUniq(name string, creatorId int64, skipAwardsid int64, price int64) error {
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (repo *AwardsRepository) checkUniq(name string, creatorId int64, skipAwardsid int64, price models.Decimal) error {
 	count := 0
 	if err := repo.store.QueryRow(checkUniqQueryName, creatorId, name, skipAwardsid).Scan(&count); err != nil {
 		return repository.NewDBError(err)
@@ -106,8 +119,8 @@ func (repo *AwardsRepository) checkUniq(name string, creatorId int64, skipAwards
 // Create Errors:
 //		repository_postgresql.NameAlreadyExist
 //		repository_postgresql.PriceAlreadyExist
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
 func (repo *AwardsRepository) Create(aw *models.Award) (int64, error) {
 	if err := repo.checkUniq(aw.Name, aw.CreatorId, NotSkipAwards, aw.Price); err != nil {
 		return -1, err
@@ -119,8 +132,8 @@ func (repo *AwardsRepository) Create(aw *models.Award) (int64, error) {
 	}
 
 	if err = trans.QueryRow(createQuery, aw.Name, aw.Description, aw.Price, convertRGBAToUint64(aw.Color),
-		aw.CreatorId, app.DefaultImage).
-		Scan(&aw.ID); err != nil {
+		aw.CreatorId, app.DefaultImage, aw.TrialDays, aw.MaxSubscribers).
+		Scan(&aw.ID, &aw.Currency); err != nil {
 		_ = trans.Rollback()
 		return app.InvalidInt, repository.NewDBError(err)
 	}
@@ -139,14 +152,15 @@ func (repo *AwardsRepository) Create(aw *models.Award) (int64, error) {
 
 // GetByID Errors:
 //		repository.NotFound
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
 func (repo *AwardsRepository) GetByID(awardsID int64) (*models.Award, error) {
 	aw := &models.Award{ID: awardsID}
 	var clr uint64
 	var childId sql.NullInt64
 	if err := repo.store.QueryRow(getByIdQuery, awardsID).
-		Scan(&aw.Name, &aw.Description, &aw.Price, &clr, &aw.CreatorId, &aw.Cover, &childId); err != nil {
+		Scan(&aw.Name, &aw.Description, &aw.Price, &aw.Currency, &clr, &aw.CreatorId, &aw.Cover, &aw.TrialDays,
+			&aw.MaxSubscribers, &aw.SeatsLeft, &childId); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, repository.NotFound
 		}
@@ -162,8 +176,8 @@ func (repo *AwardsRepository) GetByID(awardsID int64) (*models.Award, error) {
 
 // CheckAwards Errors:
 //		repository.NotFound
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
 func (repo *AwardsRepository) CheckAwards(awardsID int64) (bool, error) {
 	if err := repo.store.QueryRow(checkAwardsQuery, awardsID).Scan(&awardsID); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -179,8 +193,8 @@ func (repo *AwardsRepository) CheckAwards(awardsID int64) (bool, error) {
 //		repository.NotFound
 //		repository_postgresql.NameAlreadyExist
 //		repository_postgresql.PriceAlreadyExist
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
 func (repo *AwardsRepository) Update(aw *models.Award) error {
 	creatorId := int64(0)
 
@@ -201,7 +215,7 @@ func (repo *AwardsRepository) Update(aw *models.Award) error {
 	}
 
 	if _, err = trans.Exec(updateQueryUpdate, aw.Name, aw.Description, aw.Price,
-		convertRGBAToUint64(aw.Color), aw.ID); err != nil {
+		convertRGBAToUint64(aw.Color), aw.TrialDays, aw.MaxSubscribers, aw.ID); err != nil {
 		_ = trans.Rollback()
 		return repository.NewDBError(err)
 	}
@@ -224,8 +238,8 @@ func (repo *AwardsRepository) Update(aw *models.Award) error {
 }
 
 // GetAwards Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *AwardsRepository) GetAwards(creatorId int64) ([]models.Award, error) {
 	var res []models.Award
 
@@ -238,8 +252,8 @@ func (repo *AwardsRepository) GetAwards(creatorId int64) ([]models.Award, error)
 		var awards models.Award
 		var clr uint64
 		var childId sql.NullInt64
-		if err = rows.Scan(&awards.ID, &awards.Name, &awards.Description, &awards.Price, &clr,
-			&awards.Cover, &childId); err != nil {
+		if err = rows.Scan(&awards.ID, &awards.Name, &awards.Description, &awards.Price, &awards.Currency, &clr,
+			&awards.Cover, &awards.TrialDays, &awards.MaxSubscribers, &awards.SeatsLeft, &childId); err != nil {
 			_ = rows.Close()
 			return nil, repository.NewDBError(err)
 		}
@@ -260,8 +274,8 @@ func (repo *AwardsRepository) GetAwards(creatorId int64) ([]models.Award, error)
 }
 
 // Delete Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *AwardsRepository) Delete(awardsId int64) error {
 	if _, err := repo.store.Exec(deleteQueryUpdate, awardsId); err != nil {
 		return repository.NewDBError(err)
@@ -275,8 +289,8 @@ func (repo *AwardsRepository) Delete(awardsId int64) error {
 }
 
 // FindByName Errors:
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
 func (repo *AwardsRepository) FindByName(creatorID int64, awardName string) (bool, error) {
 	cnt := 0
 	res := repo.store.QueryRow(findByNameQuery, creatorID, awardName).Scan(&cnt)
@@ -289,10 +303,22 @@ func (repo *AwardsRepository) FindByName(creatorID int64, awardName string) (boo
 	return true, nil
 }
 
+// IsParent check that award with parentID includes award with awardID
+// Errors:
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (repo *AwardsRepository) IsParent(awardID int64, parentID int64) (bool, error) {
+	cnt := 0
+	if err := repo.store.QueryRow(isParentQuery, awardID, parentID).Scan(&cnt); err != nil {
+		return false, repository.NewDBError(err)
+	}
+	return cnt != 0, nil
+}
+
 // UpdateCover Errors:
 //		repository.NotFound
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
 func (repo *AwardsRepository) UpdateCover(awardsId int64, cover string) error {
 	creatorId := int64(0)
 
@@ -311,9 +337,9 @@ func (repo *AwardsRepository) UpdateCover(awardsId int64, cover string) error {
 }
 
 // setLevel Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
-func (repo *AwardsRepository) setLevel(trans *sql.Tx, awardsId int64, price int64, creatorId int64) error {
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *AwardsRepository) setLevel(trans *sql.Tx, awardsId int64, price models.Decimal, creatorId int64) error {
 	if _, err := trans.Exec(setLevelQueryInsertParent, awardsId, price, creatorId); err != nil {
 		return repository.NewDBError(err)
 	}
@@ -326,8 +352,8 @@ func (repo *AwardsRepository) setLevel(trans *sql.Tx, awardsId int64, price int6
 }
 
 // deleteLevel Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *AwardsRepository) deleteLevel(trans *sql.Tx, awardsId int64) error {
 	if _, err := trans.Exec(deleteLevelQuery, awardsId); err != nil {
 		return repository.NewDBError(err)
@@ -29,7 +29,7 @@ func (s *SuiteAwardsRepository) AfterTest(_, _ string) {
 	require.NoError(s.T(), s.Mock.ExpectationsWereMet())
 }
 
-func (s *SuiteAwardsRepository) checkUniqCorrect(name string, creatorId int64, skipAwardsid int64, price int64) {
+func (s *SuiteAwardsRepository) checkUniqCorrect(name string, creatorId int64, skipAwardsid int64, price models.Decimal) {
 	s.Mock.ExpectQuery(regexp.QuoteMeta(checkUniqQueryName)).
 		WithArgs(creatorId, name, skipAwardsid).
 		WillReturnRows(sqlmock.NewRows([]string{"cnt"}).AddRow(0))
@@ -39,13 +39,13 @@ func (s *SuiteAwardsRepository) checkUniqCorrect(name string, creatorId int64, s
 }
 
 func (s *SuiteAwardsRepository) checkUniqError(name string, creatorId int64,
-	skipAwardsid int64, _ int64, err error) {
+	skipAwardsid int64, _ models.Decimal, err error) {
 	s.Mock.ExpectQuery(regexp.QuoteMeta(checkUniqQueryName)).
 		WithArgs(creatorId, name, skipAwardsid).
 		WillReturnError(err)
 }
 
-func (s *SuiteAwardsRepository) setLevelCorrect(awardsId int64, creatorId int64, price int64) {
+func (s *SuiteAwardsRepository) setLevelCorrect(awardsId int64, creatorId int64, price models.Decimal) {
 	s.Mock.ExpectExec(regexp.QuoteMeta(setLevelQueryInsertParent)).
 		WithArgs(awardsId, price, creatorId).
 		WillReturnResult(driver.RowsAffected(1))
@@ -54,7 +54,7 @@ func (s *SuiteAwardsRepository) setLevelCorrect(awardsId int64, creatorId int64,
 		WillReturnResult(driver.RowsAffected(1))
 }
 
-func (s *SuiteAwardsRepository) setLevelError(awardsId int64, creatorId int64, price int64, err error) {
+func (s *SuiteAwardsRepository) setLevelError(awardsId int64, creatorId int64, price models.Decimal, err error) {
 	s.Mock.ExpectExec(regexp.QuoteMeta(setLevelQueryInsertParent)).
 		WithArgs(awardsId, price, creatorId).
 		WillReturnError(err)
@@ -76,7 +76,7 @@ func (s *SuiteAwardsRepository) TestAwardsRepository_checkUniqName() {
 	name := "sda"
 	creatorId := int64(1)
 	skipAwardsid := int64(1)
-	price := int64(3)
+	price := models.NewDecimal(3)
 
 	s.Mock.ExpectQuery(regexp.QuoteMeta(checkUniqQueryName)).
 		WithArgs(creatorId, name, skipAwardsid).
@@ -121,7 +121,7 @@ func (s *SuiteAwardsRepository) TestAwardsRepository_checkUniqName() {
 func (s *SuiteAwardsRepository) TestAwardsRepository_setLevel() {
 	creatorId := int64(1)
 	awardsId := int64(1)
-	price := int64(3)
+	price := models.NewDecimal(3)
 
 	s.Mock.ExpectBegin()
 	trans, err := s.DB.Begin()
@@ -184,14 +184,14 @@ func (s *SuiteAwardsRepository) TestAwardsRepository_Create() {
 	name := "sda"
 	creatorId := int64(1)
 	Id := int64(1)
-	awards := models.Award{CreatorId: creatorId, Name: name}
+	awards := models.Award{CreatorId: creatorId, Name: name, TrialDays: 7}
 
 	s.checkUniqCorrect(name, creatorId, NotSkipAwards, awards.Price)
 	s.Mock.ExpectBegin()
 	s.Mock.ExpectQuery(regexp.QuoteMeta(createQuery)).
 		WithArgs(awards.Name, awards.Description, awards.Price, convertRGBAToUint64(awards.Color),
-			awards.CreatorId, app.DefaultImage).
-		WillReturnRows(sqlmock.NewRows([]string{"awards_id"}).AddRow(Id))
+			awards.CreatorId, app.DefaultImage, awards.TrialDays, awards.MaxSubscribers).
+		WillReturnRows(sqlmock.NewRows([]string{"awards_id", "currency"}).AddRow(Id, models.DefaultCurrency))
 	s.setLevelCorrect(Id, awards.CreatorId, awards.Price)
 	s.Mock.ExpectCommit()
 	res, err := s.repo.Create(&awards)
@@ -207,8 +207,8 @@ func (s *SuiteAwardsRepository) TestAwardsRepository_Create() {
 	s.Mock.ExpectBegin()
 	s.Mock.ExpectQuery(regexp.QuoteMeta(createQuery)).
 		WithArgs(awards.Name, awards.Description, awards.Price, convertRGBAToUint64(awards.Color),
-			awards.CreatorId, app.DefaultImage).
-		WillReturnRows(sqlmock.NewRows([]string{"awards_id"}).AddRow(Id))
+			awards.CreatorId, app.DefaultImage, awards.TrialDays, awards.MaxSubscribers).
+		WillReturnRows(sqlmock.NewRows([]string{"awards_id", "currency"}).AddRow(Id, models.DefaultCurrency))
 	s.setLevelCorrect(Id, awards.CreatorId, awards.Price)
 	s.Mock.ExpectCommit().WillReturnError(repository.DefaultErrDB)
 	_, err = s.repo.Create(&awards)
@@ -221,7 +221,7 @@ func (s *SuiteAwardsRepository) TestAwardsRepository_Create() {
 	s.checkUniqCorrect(name, creatorId, NotSkipAwards, awards.Price)
 	s.Mock.ExpectBegin()
 	s.Mock.ExpectQuery(regexp.QuoteMeta(createQuery)).
-		WithArgs(awards.Name, awards.Description, awards.Price, convertRGBAToUint64(awards.Color), awards.CreatorId, app.DefaultImage).
+		WithArgs(awards.Name, awards.Description, awards.Price, convertRGBAToUint64(awards.Color), awards.CreatorId, app.DefaultImage, awards.TrialDays, awards.MaxSubscribers).
 		WillReturnError(models.BDError)
 	s.Mock.ExpectRollback()
 	_, err = s.repo.Create(&awards)
@@ -230,8 +230,8 @@ func (s *SuiteAwardsRepository) TestAwardsRepository_Create() {
 	s.checkUniqCorrect(name, creatorId, NotSkipAwards, awards.Price)
 	s.Mock.ExpectBegin()
 	s.Mock.ExpectQuery(regexp.QuoteMeta(createQuery)).
-		WithArgs(awards.Name, awards.Description, awards.Price, convertRGBAToUint64(awards.Color), awards.CreatorId, app.DefaultImage).
-		WillReturnRows(sqlmock.NewRows([]string{"awards_id"}).AddRow(Id))
+		WithArgs(awards.Name, awards.Description, awards.Price, convertRGBAToUint64(awards.Color), awards.CreatorId, app.DefaultImage, awards.TrialDays, awards.MaxSubscribers).
+		WillReturnRows(sqlmock.NewRows([]string{"awards_id", "currency"}).AddRow(Id, models.DefaultCurrency))
 	s.setLevelError(Id, awards.CreatorId, awards.Price, models.BDError)
 	s.Mock.ExpectRollback()
 	_, err = s.repo.Create(&awards)
@@ -250,7 +250,7 @@ func (s *SuiteAwardsRepository) TestAwardsRepository_Update() {
 	s.checkUniqCorrect(name, creatorId, Id, awards.Price)
 	s.Mock.ExpectBegin()
 	s.Mock.ExpectExec(regexp.QuoteMeta(updateQueryUpdate)).
-		WithArgs(awards.Name, awards.Description, awards.Price, convertRGBAToUint64(awards.Color), awards.ID).
+		WithArgs(awards.Name, awards.Description, awards.Price, convertRGBAToUint64(awards.Color), awards.TrialDays, awards.MaxSubscribers, awards.ID).
 		WillReturnResult(driver.RowsAffected(1))
 	s.deleteLevelCorrect(Id)
 	s.setLevelCorrect(Id, creatorId, awards.Price)
@@ -264,7 +264,7 @@ func (s *SuiteAwardsRepository) TestAwardsRepository_Update() {
 	s.checkUniqCorrect(name, creatorId, Id, awards.Price)
 	s.Mock.ExpectBegin()
 	s.Mock.ExpectExec(regexp.QuoteMeta(updateQueryUpdate)).
-		WithArgs(awards.Name, awards.Description, awards.Price, convertRGBAToUint64(awards.Color), awards.ID).
+		WithArgs(awards.Name, awards.Description, awards.Price, convertRGBAToUint64(awards.Color), awards.TrialDays, awards.MaxSubscribers, awards.ID).
 		WillReturnResult(driver.RowsAffected(1))
 	s.deleteLevelCorrect(Id)
 	s.setLevelCorrect(Id, creatorId, awards.Price)
@@ -305,7 +305,7 @@ func (s *SuiteAwardsRepository) TestAwardsRepository_Update() {
 	s.checkUniqCorrect(name, creatorId, Id, awards.Price)
 	s.Mock.ExpectBegin()
 	s.Mock.ExpectExec(regexp.QuoteMeta(updateQueryUpdate)).
-		WithArgs(awards.Name, awards.Description, awards.Price, convertRGBAToUint64(awards.Color), awards.ID).
+		WithArgs(awards.Name, awards.Description, awards.Price, convertRGBAToUint64(awards.Color), awards.TrialDays, awards.MaxSubscribers, awards.ID).
 		WillReturnError(models.BDError)
 	s.Mock.ExpectRollback()
 	err = s.repo.Update(&awards)
@@ -317,7 +317,7 @@ func (s *SuiteAwardsRepository) TestAwardsRepository_Update() {
 	s.checkUniqCorrect(name, creatorId, Id, awards.Price)
 	s.Mock.ExpectBegin()
 	s.Mock.ExpectExec(regexp.QuoteMeta(updateQueryUpdate)).
-		WithArgs(awards.Name, awards.Description, awards.Price, convertRGBAToUint64(awards.Color), awards.ID).
+		WithArgs(awards.Name, awards.Description, awards.Price, convertRGBAToUint64(awards.Color), awards.TrialDays, awards.MaxSubscribers, awards.ID).
 		WillReturnResult(driver.RowsAffected(1))
 	s.deleteLevelError(Id, models.BDError)
 	s.Mock.ExpectRollback()
@@ -330,7 +330,7 @@ func (s *SuiteAwardsRepository) TestAwardsRepository_Update() {
 	s.checkUniqCorrect(name, creatorId, Id, awards.Price)
 	s.Mock.ExpectBegin()
 	s.Mock.ExpectExec(regexp.QuoteMeta(updateQueryUpdate)).
-		WithArgs(awards.Name, awards.Description, awards.Price, convertRGBAToUint64(awards.Color), awards.ID).
+		WithArgs(awards.Name, awards.Description, awards.Price, convertRGBAToUint64(awards.Color), awards.TrialDays, awards.MaxSubscribers, awards.ID).
 		WillReturnResult(driver.RowsAffected(1))
 	s.deleteLevelCorrect(Id)
 	s.setLevelError(Id, creatorId, awards.Price, models.BDError)
@@ -422,18 +422,45 @@ func (s *SuiteAwardsRepository) TestAwardsRepository_FindByName() {
 	assert.False(s.T(), res)
 }
 
+func (s *SuiteAwardsRepository) TestAwardsRepository_IsParent() {
+	awardID := int64(1)
+	parentID := int64(2)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(isParentQuery)).
+		WithArgs(awardID, parentID).
+		WillReturnRows(sqlmock.NewRows([]string{"cnt"}).AddRow(1))
+	res, err := s.repo.IsParent(awardID, parentID)
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), res)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(isParentQuery)).
+		WithArgs(awardID, parentID).
+		WillReturnRows(sqlmock.NewRows([]string{"cnt"}).AddRow(0))
+	res, err = s.repo.IsParent(awardID, parentID)
+	assert.NoError(s.T(), err)
+	assert.False(s.T(), res)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(isParentQuery)).
+		WithArgs(awardID, parentID).
+		WillReturnError(models.BDError)
+	res, err = s.repo.IsParent(awardID, parentID)
+	assert.Error(s.T(), err, repository.NewDBError(models.BDError))
+	assert.False(s.T(), res)
+}
+
 func (s *SuiteAwardsRepository) TestAwardsRepository_GetAwards() {
 	creatorId := int64(1)
 	Id := int64(1)
 	name := "sad"
-	awards := models.Award{Name: name, ID: Id}
+	awards := models.Award{Name: name, ID: Id, TrialDays: 7, Price: models.Decimal(9950),
+		Currency: models.DefaultCurrency, MaxSubscribers: 20, SeatsLeft: 3}
 
 	s.Mock.ExpectQuery(regexp.QuoteMeta(getAwardsQuery)).
 		WithArgs(creatorId).
 		WillReturnRows(sqlmock.NewRows([]string{"awards_id", "name", "description", "price",
-			"color", "cover", "child_id"}).
-			AddRow(awards.ID, awards.Name, awards.Description, awards.Price,
-				convertRGBAToUint64(awards.Color), awards.Cover, awards.ChildAward))
+			"currency", "color", "cover", "trial_days", "max_subscribers", "seats_left", "child_id"}).
+			AddRow(awards.ID, awards.Name, awards.Description, awards.Price, awards.Currency,
+				convertRGBAToUint64(awards.Color), awards.Cover, awards.TrialDays, awards.MaxSubscribers, awards.SeatsLeft,
+				awards.ChildAward))
 	res, err := s.repo.GetAwards(creatorId)
 	assert.NoError(s.T(), err)
 	assert.Equal(s.T(), res[0], awards)
@@ -441,18 +468,20 @@ func (s *SuiteAwardsRepository) TestAwardsRepository_GetAwards() {
 	s.Mock.ExpectQuery(regexp.QuoteMeta(getAwardsQuery)).
 		WithArgs(creatorId).
 		WillReturnRows(sqlmock.NewRows([]string{"awards_id", "name", "description", "price",
-			"color", "cover", "child_id"}).
-			AddRow(awards.ID, awards.Name, awards.Description, awards.Price,
-				awards.Cover, awards.Cover, awards.ChildAward))
+			"currency", "color", "cover", "trial_days", "max_subscribers", "seats_left", "child_id"}).
+			AddRow(awards.ID, awards.Name, awards.Description, awards.Price, awards.Currency,
+				awards.Cover, awards.Cover, awards.TrialDays, awards.MaxSubscribers, awards.SeatsLeft,
+				awards.ChildAward))
 	_, err = s.repo.GetAwards(creatorId)
 	assert.Error(s.T(), err)
 
 	s.Mock.ExpectQuery(regexp.QuoteMeta(getAwardsQuery)).
 		WithArgs(creatorId).
 		WillReturnRows(sqlmock.NewRows([]string{"awards_id", "name", "description", "price",
-			"color", "cover", "child_id"}).
-			AddRow(awards.ID, awards.Name, awards.Description, awards.Price,
-				convertRGBAToUint64(awards.Color), awards.Cover, awards.ChildAward).
+			"currency", "color", "cover", "trial_days", "max_subscribers", "seats_left", "child_id"}).
+			AddRow(awards.ID, awards.Name, awards.Description, awards.Price, awards.Currency,
+				convertRGBAToUint64(awards.Color), awards.Cover, awards.TrialDays, awards.MaxSubscribers, awards.SeatsLeft,
+				awards.ChildAward).
 			RowError(0, models.BDError))
 	_, err = s.repo.GetAwards(creatorId)
 	assert.Error(s.T(), err, repository.NewDBError(models.BDError))
@@ -468,13 +497,15 @@ func (s *SuiteAwardsRepository) TestAwardsRepository_GetById() {
 	creatorId := int64(1)
 	Id := int64(2)
 	name := "sad"
-	awards := &models.Award{Name: name, ID: Id, CreatorId: creatorId}
+	awards := &models.Award{Name: name, ID: Id, CreatorId: creatorId, Currency: models.DefaultCurrency}
 
 	s.Mock.ExpectQuery(regexp.QuoteMeta(getByIdQuery)).
 		WithArgs(Id).
-		WillReturnRows(sqlmock.NewRows([]string{"name", "description", "price", "color", "creator_id", "cover", "child_id"}).
-			AddRow(awards.Name, awards.Description, awards.Price,
-				convertRGBAToUint64(awards.Color), awards.CreatorId, awards.Cover, awards.ChildAward))
+		WillReturnRows(sqlmock.NewRows([]string{"name", "description", "price", "currency", "color", "creator_id", "cover",
+			"trial_days", "max_subscribers", "seats_left", "child_id"}).
+			AddRow(awards.Name, awards.Description, awards.Price, awards.Currency,
+				convertRGBAToUint64(awards.Color), awards.CreatorId, awards.Cover, awards.TrialDays,
+				awards.MaxSubscribers, awards.SeatsLeft, awards.ChildAward))
 	res, err := s.repo.GetByID(Id)
 	assert.NoError(s.T(), err)
 	assert.Equal(s.T(), res, awards)
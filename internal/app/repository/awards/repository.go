@@ -47,6 +47,11 @@ type Repository interface {
 	// 			repository.DefaultErrDB
 	FindByName(creatorID int64, awardName string) (bool, error)
 
+	// IsParent Errors:
+	// 		app.GeneralError with Errors
+	// 			repository.DefaultErrDB
+	IsParent(awardID int64, parentID int64) (bool, error)
+
 	// UpdateCover Errors:
 	//		repository.NotFound
 	// 		app.GeneralError with Errors
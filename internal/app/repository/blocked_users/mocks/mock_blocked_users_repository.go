@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: patreon/internal/app/repository/blocked_users (interfaces: Repository)
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	models "patreon/internal/app/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// BlockedUsersRepository is a mock of Repository interface.
+type BlockedUsersRepository struct {
+	ctrl     *gomock.Controller
+	recorder *BlockedUsersRepositoryMockRecorder
+}
+
+// BlockedUsersRepositoryMockRecorder is the mock recorder for BlockedUsersRepository.
+type BlockedUsersRepositoryMockRecorder struct {
+	mock *BlockedUsersRepository
+}
+
+// NewBlockedUsersRepository creates a new mock instance.
+func NewBlockedUsersRepository(ctrl *gomock.Controller) *BlockedUsersRepository {
+	mock := &BlockedUsersRepository{ctrl: ctrl}
+	mock.recorder = &BlockedUsersRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *BlockedUsersRepository) EXPECT() *BlockedUsersRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *BlockedUsersRepository) Add(arg0, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *BlockedUsersRepositoryMockRecorder) Add(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*BlockedUsersRepository)(nil).Add), arg0, arg1)
+}
+
+// GetBlocked mocks base method.
+func (m *BlockedUsersRepository) GetBlocked(arg0 int64, arg1 *models.Pagination) ([]models.BlockedUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlocked", arg0, arg1)
+	ret0, _ := ret[0].([]models.BlockedUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlocked indicates an expected call of GetBlocked.
+func (mr *BlockedUsersRepositoryMockRecorder) GetBlocked(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocked", reflect.TypeOf((*BlockedUsersRepository)(nil).GetBlocked), arg0, arg1)
+}
+
+// IsBlocked mocks base method.
+func (m *BlockedUsersRepository) IsBlocked(arg0, arg1 int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBlocked", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBlocked indicates an expected call of IsBlocked.
+func (mr *BlockedUsersRepositoryMockRecorder) IsBlocked(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlocked", reflect.TypeOf((*BlockedUsersRepository)(nil).IsBlocked), arg0, arg1)
+}
+
+// Remove mocks base method.
+func (m *BlockedUsersRepository) Remove(arg0, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *BlockedUsersRepositoryMockRecorder) Remove(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*BlockedUsersRepository)(nil).Remove), arg0, arg1)
+}
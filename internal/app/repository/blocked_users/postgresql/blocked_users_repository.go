@@ -0,0 +1,115 @@
+package repository_postgresql
+
+import (
+	"fmt"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_blocked_users "patreon/internal/app/repository/blocked_users"
+	putilits "patreon/internal/app/utilits/postgresql"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const (
+	codeForeignKeyViolation = "23503"
+
+	queryAdd = "INSERT INTO blocked_users (creator_id, users_id) VALUES ($1, $2) " +
+		"ON CONFLICT DO NOTHING"
+	queryRemove     = "DELETE FROM blocked_users WHERE creator_id = $1 AND users_id = $2"
+	queryIsBlocked  = "SELECT count(*) FROM blocked_users WHERE creator_id = $1 AND users_id = $2"
+	queryGetBlocked = "SELECT b.users_id, u.nickname, u.avatar, b.date FROM blocked_users b " +
+		"JOIN users u on b.users_id = u.users_id WHERE b.creator_id = $1 ORDER BY b.date DESC, b.id DESC "
+)
+
+type BlockedUsersRepository struct {
+	store *sqlx.DB
+}
+
+var _ = repository_blocked_users.Repository(&BlockedUsersRepository{})
+
+func NewBlockedUsersRepository(store *sqlx.DB) *BlockedUsersRepository {
+	return &BlockedUsersRepository{
+		store: store,
+	}
+}
+
+// Add user to block list of creator, nothing happens if user is already blocked
+// Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *BlockedUsersRepository) Add(creatorID int64, userID int64) error {
+	if _, err := repo.store.Exec(queryAdd, creatorID, userID); err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == codeForeignKeyViolation {
+			return repository.NotFound
+		}
+		return repository.NewDBError(err)
+	}
+	return nil
+}
+
+// Remove Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *BlockedUsersRepository) Remove(creatorID int64, userID int64) error {
+	res, err := repo.store.Exec(queryRemove, creatorID, userID)
+	if err != nil {
+		return repository.NewDBError(err)
+	}
+	removed, err := res.RowsAffected()
+	if err != nil {
+		return repository.NewDBError(err)
+	}
+	if removed == 0 {
+		return repository.NotFound
+	}
+	return nil
+}
+
+// IsBlocked Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *BlockedUsersRepository) IsBlocked(creatorID int64, userID int64) (bool, error) {
+	cnt := 0
+	if err := repo.store.QueryRow(queryIsBlocked, creatorID, userID).Scan(&cnt); err != nil {
+		return false, repository.NewDBError(err)
+	}
+	return cnt != 0, nil
+}
+
+// GetBlocked return page of users blocked by creator, the last blocked first
+// Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *BlockedUsersRepository) GetBlocked(creatorID int64, pag *models.Pagination) ([]models.BlockedUser, error) {
+	limit, offset, err := putilits.AddPagination("blocked_users", pag, repo.store)
+	if err != nil {
+		return nil, err
+	}
+	if limit == 0 {
+		return nil, repository.NotFound
+	}
+
+	rows, err := repo.store.Query(queryGetBlocked+fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset), creatorID)
+	if err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	res := make([]models.BlockedUser, 0, limit)
+	for rows.Next() {
+		cur := models.BlockedUser{}
+		if err = rows.Scan(&cur.UserID, &cur.Nickname, &cur.Avatar, &cur.Date); err != nil {
+			_ = rows.Close()
+			return nil, repository.NewDBError(err)
+		}
+		res = append(res, cur)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, repository.NewDBError(err)
+	}
+	return res, nil
+}
@@ -0,0 +1,128 @@
+package repository_postgresql
+
+import (
+	"fmt"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	putilits "patreon/internal/app/utilits/postgresql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+)
+
+type SuiteBlockedUsersRepository struct {
+	models.Suite
+	repo *BlockedUsersRepository
+}
+
+func (s *SuiteBlockedUsersRepository) SetupSuite() {
+	s.InitBD()
+	s.repo = NewBlockedUsersRepository(s.DB)
+}
+
+func (s *SuiteBlockedUsersRepository) AfterTest(_, _ string) {
+	require.NoError(s.T(), s.Mock.ExpectationsWereMet())
+}
+
+func (s *SuiteBlockedUsersRepository) TestBlockedUsersRepository_Add_OK() {
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAdd)).
+		WithArgs(int64(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	err := s.repo.Add(1, 2)
+	assert.NoError(s.T(), err)
+}
+
+func (s *SuiteBlockedUsersRepository) TestBlockedUsersRepository_Add_UserNotFound() {
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAdd)).
+		WithArgs(int64(1), int64(2)).
+		WillReturnError(&pq.Error{Code: codeForeignKeyViolation})
+	err := s.repo.Add(1, 2)
+	assert.Equal(s.T(), repository.NotFound, err)
+
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAdd)).
+		WithArgs(int64(1), int64(2)).
+		WillReturnError(repository.DefaultErrDB)
+	err = s.repo.Add(1, 2)
+	assert.Equal(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
+}
+
+func (s *SuiteBlockedUsersRepository) TestBlockedUsersRepository_Remove() {
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryRemove)).
+		WithArgs(int64(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	err := s.repo.Remove(1, 2)
+	assert.NoError(s.T(), err)
+
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryRemove)).
+		WithArgs(int64(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	err = s.repo.Remove(1, 2)
+	assert.Equal(s.T(), repository.NotFound, err)
+}
+
+func (s *SuiteBlockedUsersRepository) TestBlockedUsersRepository_IsBlocked() {
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryIsBlocked)).
+		WithArgs(int64(1), int64(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	blocked, err := s.repo.IsBlocked(1, 2)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), blocked)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryIsBlocked)).
+		WithArgs(int64(1), int64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	blocked, err = s.repo.IsBlocked(1, 3)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), blocked)
+}
+
+func (s *SuiteBlockedUsersRepository) TestBlockedUsersRepository_GetBlocked_OK() {
+	creatorID := int64(1)
+	pag := &models.Pagination{Limit: 10, Offset: 0}
+	expected := []models.BlockedUser{
+		{UserID: 2, Nickname: "troll", Avatar: "avatar", Date: time.Now()},
+		{UserID: 3, Nickname: "spammer", Date: time.Now()},
+	}
+
+	queryStat := "SELECT n_live_tup FROM pg_stat_all_tables WHERE relname = $1"
+	for i := 0; i < 2; i++ {
+		s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
+			WithArgs("blocked_users").
+			WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).AddRow(int64(100)))
+	}
+	limit, offset, err := putilits.AddPagination("blocked_users", pag, s.DB)
+	require.NoError(s.T(), err)
+
+	rows := sqlmock.NewRows([]string{"users_id", "nickname", "avatar", "date"})
+	for _, cur := range expected {
+		rows.AddRow(cur.UserID, cur.Nickname, cur.Avatar, cur.Date)
+	}
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetBlocked + fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset))).
+		WithArgs(creatorID).
+		WillReturnRows(rows)
+
+	res, err := s.repo.GetBlocked(creatorID, pag)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), expected, res)
+}
+
+func (s *SuiteBlockedUsersRepository) TestBlockedUsersRepository_GetBlocked_NotFound() {
+	queryStat := "SELECT n_live_tup FROM pg_stat_all_tables WHERE relname = $1"
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
+		WithArgs("blocked_users").
+		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).AddRow(int64(10)))
+
+	res, err := s.repo.GetBlocked(1, &models.Pagination{Limit: 0, Offset: 20})
+	assert.Equal(s.T(), repository.NotFound, err)
+	assert.Nil(s.T(), res)
+}
+
+func TestBlockedUsersRepository(t *testing.T) {
+	suite.Run(t, new(SuiteBlockedUsersRepository))
+}
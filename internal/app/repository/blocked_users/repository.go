@@ -0,0 +1,30 @@
+package repository_blocked_users
+
+import "patreon/internal/app/models"
+
+//go:generate mockgen -destination=mocks/mock_blocked_users_repository.go -package=mock_repository -mock_names=Repository=BlockedUsersRepository . Repository
+
+type Repository interface {
+	// Add Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	Add(creatorID int64, userID int64) error
+
+	// Remove Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	Remove(creatorID int64, userID int64) error
+
+	// IsBlocked Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	IsBlocked(creatorID int64, userID int64) (bool, error)
+
+	// GetBlocked Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetBlocked(creatorID int64, pag *models.Pagination) ([]models.BlockedUser, error)
+}
@@ -33,7 +33,9 @@ const (
 					FROM comments AS cm
 					JOIN users as usr on usr.users_id = cm.users_id
 					LEFT JOIN creator_profile as cp on cp.creator_id = cm.users_id
-					WHERE cm.post_id = $1
+					WHERE cm.post_id = $1 AND NOT EXISTS (
+						SELECT 1 FROM blocked_users AS bl JOIN posts AS ps on ps.creator_id = bl.creator_id
+						WHERE ps.posts_id = cm.post_id AND bl.users_id = cm.users_id)
 					ORDER BY cm.date DESC LIMIT $2 OFFSET $3;`
 
 	getCommentsUserQuery = `
@@ -60,8 +62,8 @@ func NewCommentsRepository(st *sqlx.DB) *CommentsRepository {
 }
 
 // Create Errors:
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
+//	app.GeneralError with Errors
+//		repository.DefaultErrDB
 func (repo *CommentsRepository) Create(cm *models.Comment) (int64, error) {
 	trans, err := repo.store.Begin()
 	if err != nil {
@@ -90,9 +92,9 @@ func (repo *CommentsRepository) Create(cm *models.Comment) (int64, error) {
 }
 
 // Update Errors:
-//		repository.NotFound
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
+//	repository.NotFound
+//	app.GeneralError with Errors
+//		repository.DefaultErrDB
 func (repo *CommentsRepository) Update(cm *models.Comment) error {
 	if err := repo.CheckExists(cm.ID); !errors.Is(err, CommentAlreadyExist) {
 		return err
@@ -107,8 +109,8 @@ func (repo *CommentsRepository) Update(cm *models.Comment) error {
 }
 
 // GetUserComments Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//	app.GeneralError with Errors:
+//		repository.DefaultErrDB
 func (repo *CommentsRepository) GetUserComments(userId int64, pag *models.Pagination) ([]models.UserComment, error) {
 	limit, offset, er := postgresql_utilits.AddPagination("comments", pag, repo.store)
 	if er != nil {
@@ -140,9 +142,10 @@ func (repo *CommentsRepository) GetUserComments(userId int64, pag *models.Pagina
 	return comments, nil
 }
 
-// GetPostComments Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+// GetPostComments return page of post comments, comments of users blocked by creator of post are hidden
+// Errors:
+//	app.GeneralError with Errors:
+//		repository.DefaultErrDB
 func (repo *CommentsRepository) GetPostComments(postId int64, pag *models.Pagination) ([]models.PostComment, error) {
 	limit, offset, er := postgresql_utilits.AddPagination("comments", pag, repo.store)
 	if er != nil {
@@ -178,10 +181,10 @@ func (repo *CommentsRepository) GetPostComments(postId int64, pag *models.Pagina
 }
 
 // CheckExists Errors:
-//		repository_postgresql.CommentAlreadyExist
-//		repository.NotFound
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
+//	repository_postgresql.CommentAlreadyExist
+//	repository.NotFound
+//	app.GeneralError with Errors
+//		repository.DefaultErrDB
 func (repo *CommentsRepository) CheckExists(commentId int64) error {
 	cnt := int64(0)
 	if err := repo.store.Get(&cnt, checkExistsQuery, commentId); err != nil {
@@ -200,9 +203,9 @@ func (repo *CommentsRepository) CheckExists(commentId int64) error {
 }
 
 // Get Errors:
-//		repository.NotFound
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
+//	repository.NotFound
+//	app.GeneralError with Errors
+//		repository.DefaultErrDB
 func (repo *CommentsRepository) Get(commentsId int64) (*models.Comment, error) {
 	cm := &models.Comment{ID: commentsId}
 	if err := repo.store.QueryRowx(getQuery, commentsId).
@@ -218,10 +221,10 @@ func (repo *CommentsRepository) Get(commentsId int64) (*models.Comment, error) {
 }
 
 // CheckExists Errors:
-//		repository_postgresql.CommentAlreadyExist
-//		repository.NotFound
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
+//	repository_postgresql.CommentAlreadyExist
+//	repository.NotFound
+//	app.GeneralError with Errors
+//		repository.DefaultErrDB
 func (repo *CommentsRepository) checkExistsWithPost(authorId int64, postId int64, asCreator bool) error {
 	cnt := int64(0)
 	if err := repo.store.Get(&cnt, checkExistsWithPostQuery, asCreator, postId, authorId); err != nil {
@@ -240,8 +243,8 @@ func (repo *CommentsRepository) checkExistsWithPost(authorId int64, postId int64
 }
 
 // Delete Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//	app.GeneralError with Errors:
+//		repository.DefaultErrDB
 func (repo *CommentsRepository) Delete(commentId int64) error {
 	tx, err := repo.store.Beginx()
 	if err != nil {
@@ -94,6 +94,21 @@ func (mr *CreatorRepositoryMockRecorder) GetCreators() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCreators", reflect.TypeOf((*CreatorRepository)(nil).GetCreators))
 }
 
+// GetCurrency mocks base method.
+func (m *CreatorRepository) GetCurrency(arg0 int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrency", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrency indicates an expected call of GetCurrency.
+func (mr *CreatorRepositoryMockRecorder) GetCurrency(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrency", reflect.TypeOf((*CreatorRepository)(nil).GetCurrency), arg0)
+}
+
 // SearchCreators mocks base method.
 func (m *CreatorRepository) SearchCreators(arg0 *models.Pagination, arg1 string, arg2 ...string) ([]models.Creator, error) {
 	m.ctrl.T.Helper()
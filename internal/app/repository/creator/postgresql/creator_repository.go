@@ -15,7 +15,7 @@ import (
 const (
 	// Create
 	queryCreate = `INSERT INTO creator_profile (creator_id, category,
-		description, avatar, cover) VALUES ($1, $2, $3, $4, $5)
+		description, avatar, cover, currency) VALUES ($1, $2, $3, $4, $5, $6)
 		RETURNING creator_id
 	`
 	queryCategoryCreate = `SELECT category_id FROM creator_category WHERE lower(name) = lower($1)`
@@ -46,7 +46,8 @@ const (
 				WHERE lower(cc.name) IN (?)`
 
 	// GetCreator
-	queryGetCreator = `SELECT cp.creator_id, cc.name, cp.description, cp.avatar, cp.cover, usr.nickname, sb.awards_id
+	queryGetCreator = `SELECT cp.creator_id, cc.name, cp.description, cp.avatar, cp.cover, usr.nickname, sb.awards_id,
+			cp.currency
 			FROM creator_profile as cp JOIN users AS usr ON usr.users_id = cp.creator_id 
 			JOIN creator_category As cc ON cp.category = cc.category_id 
 			LEFT JOIN subscribers AS sb on (cp.creator_id = sb.creator_id and sb.users_id = $1 and sb.status = true)
@@ -55,6 +56,9 @@ const (
 	// ExistsCreator
 	queryExistsCreator = `SELECT creator_id from creator_profile where creator_id=$1`
 
+	// GetCurrency
+	queryGetCurrency = `SELECT currency from creator_profile where creator_id=$1`
+
 	// UpdateAvatar
 	queryUpdateAvatar = `UPDATE creator_profile SET avatar = $1 WHERE creator_id = $2 RETURNING creator_id`
 
@@ -74,8 +78,8 @@ func NewCreatorRepository(st *sqlx.DB) *CreatorRepository {
 
 // Create Errors:
 //		IncorrectCategory
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
 func (repo *CreatorRepository) Create(cr *models.Creator) (int64, error) {
 	category := int64(0)
 	if err := repo.store.QueryRow(queryCategoryCreate, cr.Category).Scan(&category); err != nil {
@@ -86,15 +90,15 @@ func (repo *CreatorRepository) Create(cr *models.Creator) (int64, error) {
 	}
 
 	if err := repo.store.QueryRow(queryCreate, cr.ID, category, cr.Description,
-		app.DefaultImage, app.DefaultImage).Scan(&cr.ID); err != nil {
+		app.DefaultImage, app.DefaultImage, cr.Currency).Scan(&cr.ID); err != nil {
 		return app.InvalidInt, repository.NewDBError(err)
 	}
 	return cr.ID, nil
 }
 
 // GetCreators Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *CreatorRepository) GetCreators() ([]models.Creator, error) {
 	count := 0
 
@@ -128,8 +132,8 @@ func (repo *CreatorRepository) GetCreators() ([]models.Creator, error) {
 }
 
 // SearchCreators Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *CreatorRepository) SearchCreators(pag *models.Pagination,
 	searchString string, categories ...string) ([]models.Creator, error) {
 	limit, offset, err := postgresql_utilits.AddPagination("search_creators", pag, repo.store)
@@ -182,16 +186,16 @@ func (repo *CreatorRepository) SearchCreators(pag *models.Pagination,
 }
 
 // GetCreator Errors:
-// 		repository.NotFound
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *CreatorRepository) GetCreator(creatorId int64, userId int64) (*models.CreatorWithAwards, error) {
 	creator := &models.CreatorWithAwards{}
 
 	var awardsId sql.NullInt64
 	if err := repo.store.QueryRow(queryGetCreator, userId, creatorId).
 		Scan(&creator.ID, &creator.Category, &creator.Description, &creator.Avatar,
-			&creator.Cover, &creator.Nickname, &awardsId); err != nil {
+			&creator.Cover, &creator.Nickname, &awardsId, &creator.Currency); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, repository.NotFound
 		}
@@ -208,8 +212,8 @@ func (repo *CreatorRepository) GetCreator(creatorId int64, userId int64) (*model
 }
 
 // ExistsCreator Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *CreatorRepository) ExistsCreator(creatorId int64) (bool, error) {
 	creator := &models.Creator{}
 
@@ -223,10 +227,26 @@ func (repo *CreatorRepository) ExistsCreator(creatorId int64) (bool, error) {
 	return true, nil
 }
 
+// GetCurrency Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *CreatorRepository) GetCurrency(creatorId int64) (string, error) {
+	currency := ""
+	if err := repo.store.QueryRow(queryGetCurrency, creatorId).Scan(&currency); err != nil {
+		if err == sql.ErrNoRows {
+			return "", repository.NotFound
+		}
+		return "", repository.NewDBError(err)
+	}
+
+	return currency, nil
+}
+
 // UpdateAvatar Errors:
-// 		repository.NotFound
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *CreatorRepository) UpdateAvatar(creatorId int64, avatar string) error {
 	if err := repo.store.QueryRow(queryUpdateAvatar, avatar, creatorId).
 		Scan(&creatorId); err != nil {
@@ -240,9 +260,9 @@ func (repo *CreatorRepository) UpdateAvatar(creatorId int64, avatar string) erro
 }
 
 // UpdateCover Errors:
-// 		repository.NotFound
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *CreatorRepository) UpdateCover(creatorId int64, cover string) error {
 	if err := repo.store.QueryRow(queryUpdateCover, cover, creatorId).
 		Scan(&creatorId); err != nil {
@@ -51,7 +51,7 @@ func (s *SuiteCreatorRepository) TestCreatorRepository_Create() {
 		WithArgs(cr.Category).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(strconv.Itoa(int(categoryId))))
 	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCreate)).
-		WithArgs(cr.ID, categoryId, cr.Description, app.DefaultImage, app.DefaultImage).
+		WithArgs(cr.ID, categoryId, cr.Description, app.DefaultImage, app.DefaultImage, cr.Currency).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(strconv.Itoa(int(cr.ID))))
 	id, err := s.repo.Create(cr)
 	assert.Equal(s.T(), id, cr.ID)
@@ -61,7 +61,7 @@ func (s *SuiteCreatorRepository) TestCreatorRepository_Create() {
 		WithArgs(cr.Category).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(strconv.Itoa(int(categoryId))))
 	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCreate)).
-		WithArgs(cr.ID, categoryId, cr.Description, app.DefaultImage, app.DefaultImage).WillReturnError(models.BDError)
+		WithArgs(cr.ID, categoryId, cr.Description, app.DefaultImage, app.DefaultImage, cr.Currency).WillReturnError(models.BDError)
 	_, err = s.repo.Create(cr)
 	assert.Error(s.T(), err)
 	assert.Equal(s.T(), repository.NewDBError(models.BDError), err)
@@ -91,8 +91,9 @@ func (s *SuiteCreatorRepository) TestCreatorRepository_GetCreator() {
 	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetCreator)).
 		WithArgs(userId, cr.ID).
 		WillReturnRows(sqlmock.
-			NewRows([]string{"id", "category", "description", "avatar", "cover", "nickname", "awards_id"}).
-			AddRow(strconv.Itoa(int(cr.ID)), cr.Category, cr.Description, cr.Avatar, cr.Cover, cr.Nickname, awardsId))
+			NewRows([]string{"id", "category", "description", "avatar", "cover", "nickname", "awards_id", "currency"}).
+			AddRow(strconv.Itoa(int(cr.ID)), cr.Category, cr.Description, cr.Avatar, cr.Cover, cr.Nickname, awardsId,
+				cr.Currency))
 	get, err := s.repo.GetCreator(userId, expected.ID)
 	assert.NoError(s.T(), err)
 	assert.Equal(s.T(), expected, *get)
@@ -101,8 +102,9 @@ func (s *SuiteCreatorRepository) TestCreatorRepository_GetCreator() {
 	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetCreator)).
 		WithArgs(userId, cr.ID).
 		WillReturnRows(sqlmock.
-			NewRows([]string{"id", "category", "description", "avatar", "cover", "nickname", "awards_id"}).
-			AddRow(strconv.Itoa(int(cr.ID)), cr.Category, cr.Description, cr.Avatar, cr.Cover, cr.Nickname, awardsId))
+			NewRows([]string{"id", "category", "description", "avatar", "cover", "nickname", "awards_id", "currency"}).
+			AddRow(strconv.Itoa(int(cr.ID)), cr.Category, cr.Description, cr.Avatar, cr.Cover, cr.Nickname, awardsId,
+				cr.Currency))
 	expected.AwardsId = rp.NoAwards
 	get, err = s.repo.GetCreator(userId, expected.ID)
 	assert.NoError(s.T(), err)
@@ -488,6 +490,26 @@ func (s *SuiteCreatorRepository) TestCreatorRepository_ExistCreator() {
 	assert.False(s.T(), check)
 }
 
+func (s *SuiteCreatorRepository) TestCreatorRepository_GetCurrency() {
+	creatorId := int64(1)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetCurrency)).WithArgs(creatorId).
+		WillReturnRows(sqlmock.NewRows([]string{"currency"}).AddRow(models.DefaultCurrency))
+
+	currency, err := s.repo.GetCurrency(creatorId)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), models.DefaultCurrency, currency)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetCurrency)).WithArgs(creatorId).WillReturnError(sql.ErrNoRows)
+
+	_, err = s.repo.GetCurrency(creatorId)
+	assert.Equal(s.T(), repository.NotFound, err)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetCurrency)).WithArgs(creatorId).WillReturnError(models.BDError)
+
+	_, err = s.repo.GetCurrency(creatorId)
+	assert.Equal(s.T(), repository.NewDBError(models.BDError), err)
+}
+
 func TestCreatorRepository(t *testing.T) {
 	suite.Run(t, new(SuiteCreatorRepository))
 }
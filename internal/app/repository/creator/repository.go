@@ -34,6 +34,12 @@ type Repository interface {
 	// 			repository.DefaultErrDB
 	ExistsCreator(creatorId int64) (bool, error)
 
+	// GetCurrency Errors:
+	// 		repository.NotFound
+	// 		app.GeneralError with Errors:
+	// 			repository.DefaultErrDB
+	GetCurrency(creatorId int64) (string, error)
+
 	// UpdateAvatar Errors:
 	// 		repository.NotFound
 	// 		app.GeneralError with Errors:
@@ -0,0 +1,7 @@
+package repository_gifts
+
+import "github.com/pkg/errors"
+
+var (
+	GiftNotRedeemable = errors.New("gift is not paid or already redeemed")
+)
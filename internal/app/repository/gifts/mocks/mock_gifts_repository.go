@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: patreon/internal/app/repository/gifts (interfaces: Repository)
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	models "patreon/internal/app/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// GiftsRepository is a mock of Repository interface.
+type GiftsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *GiftsRepositoryMockRecorder
+}
+
+// GiftsRepositoryMockRecorder is the mock recorder for GiftsRepository.
+type GiftsRepositoryMockRecorder struct {
+	mock *GiftsRepository
+}
+
+// NewGiftsRepository creates a new mock instance.
+func NewGiftsRepository(ctrl *gomock.Controller) *GiftsRepository {
+	mock := &GiftsRepository{ctrl: ctrl}
+	mock.recorder = &GiftsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *GiftsRepository) EXPECT() *GiftsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *GiftsRepository) Create(arg0 *models.Gift) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *GiftsRepositoryMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*GiftsRepository)(nil).Create), arg0)
+}
+
+// GetByCode mocks base method.
+func (m *GiftsRepository) GetByCode(arg0 string) (*models.Gift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCode", arg0)
+	ret0, _ := ret[0].(*models.Gift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCode indicates an expected call of GetByCode.
+func (mr *GiftsRepositoryMockRecorder) GetByCode(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCode", reflect.TypeOf((*GiftsRepository)(nil).GetByCode), arg0)
+}
+
+// GetUserGifts mocks base method.
+func (m *GiftsRepository) GetUserGifts(arg0 int64) ([]models.Gift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserGifts", arg0)
+	ret0, _ := ret[0].([]models.Gift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserGifts indicates an expected call of GetUserGifts.
+func (mr *GiftsRepositoryMockRecorder) GetUserGifts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserGifts", reflect.TypeOf((*GiftsRepository)(nil).GetUserGifts), arg0)
+}
+
+// Redeem mocks base method.
+func (m *GiftsRepository) Redeem(arg0 *models.Gift, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *GiftsRepositoryMockRecorder) Redeem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*GiftsRepository)(nil).Redeem), arg0, arg1)
+}
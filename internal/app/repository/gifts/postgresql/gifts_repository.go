@@ -0,0 +1,207 @@
+package repository_postgresql
+
+import (
+	"database/sql"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_gifts "patreon/internal/app/repository/gifts"
+	repository_subscribers "patreon/internal/app/repository/subscribers"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+const (
+	queryAddPayment = "INSERT INTO payments(amount, creator_id, users_id, awards_id, pay_token, type, currency) " +
+		"VALUES($1, $2, $3, $4, $5, 'gift', $6) RETURNING payments_id"
+	queryCreate = "INSERT INTO gifts (code, payer_id, recipient_id, creator_id, awards_id, periods, payments_id) " +
+		"VALUES ($1, $2, NULLIF($3, 0), $4, $5, $6, $7) RETURNING gifts_id, status, date"
+
+	querySelectGifts = `
+	SELECT g.gifts_id, g.code, g.payer_id, pu.nickname, COALESCE(g.recipient_id, 0), COALESCE(ru.nickname, ''),
+	       g.creator_id, g.awards_id, g.periods, p.amount, p.currency, g.status, g.date, g.redeemed_at
+	FROM gifts g JOIN payments p ON g.payments_id = p.payments_id
+	JOIN users pu ON g.payer_id = pu.users_id LEFT JOIN users ru ON g.recipient_id = ru.users_id `
+	queryGetByCode    = querySelectGifts + "WHERE g.code = $1"
+	queryGetUserGifts = querySelectGifts +
+		"WHERE g.payer_id = $1 OR (g.recipient_id = $1 AND g.status = 'redeemed') ORDER BY g.date DESC"
+
+	queryRedeem = "UPDATE gifts SET status = 'redeemed', recipient_id = $2, redeemed_at = now() " +
+		"WHERE gifts_id = $1 and status = 'paid' RETURNING redeemed_at"
+	// gift for award recipient already subscribed on prolongs the subscription
+	queryExtendSubscription = "UPDATE subscribers SET grace_until = null, " +
+		"paid_until = GREATEST(paid_until, now()) + make_interval(months => $4) " +
+		"WHERE id = (SELECT id FROM subscribers WHERE users_id = $1 and creator_id = $2 and awards_id = $3 " +
+		"and status = true ORDER BY id DESC LIMIT 1)"
+	queryAddSubscription = "INSERT INTO subscribers(users_id, creator_id, awards_id, status, paid_until) " +
+		"VALUES ($1, $2, $3, true, now() + make_interval(months => $4))"
+)
+
+type GiftsRepository struct {
+	store *sqlx.DB
+}
+
+var _ = repository_gifts.Repository(&GiftsRepository{})
+
+func NewGiftsRepository(store *sqlx.DB) *GiftsRepository {
+	return &GiftsRepository{
+		store: store,
+	}
+}
+
+// Create not paid payment of payer with gift.PayToken and gift bound to it.
+// Gift award must have free seat for recipient, seat is taken when gift is granted
+// Errors:
+//		repository_subscribers.AwardSoldOut
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *GiftsRepository) Create(gift *models.Gift) error {
+	begin, err := repo.store.Begin()
+	if err != nil {
+		return repository.NewDBError(err)
+	}
+
+	if err = repository_subscribers.TakeSeat(begin, gift.RecipientID, gift.AwardID, time.Now()); err != nil {
+		_ = begin.Rollback()
+		return err
+	}
+
+	var paymentID int64
+	if err = begin.QueryRow(queryAddPayment, gift.Amount, gift.CreatorID, gift.PayerID, gift.AwardID,
+		gift.PayToken, gift.Currency).Scan(&paymentID); err != nil {
+		_ = begin.Rollback()
+		return repository.NewDBError(err)
+	}
+	if err = begin.QueryRow(queryCreate, gift.Code, gift.PayerID, gift.RecipientID, gift.CreatorID, gift.AwardID,
+		gift.Periods, paymentID).Scan(&gift.ID, &gift.Status, &gift.Date); err != nil {
+		_ = begin.Rollback()
+		return repository.NewDBError(err)
+	}
+
+	if err = begin.Commit(); err != nil {
+		return repository.NewDBError(err)
+	}
+	return nil
+}
+
+// GetByCode Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *GiftsRepository) GetByCode(code string) (*models.Gift, error) {
+	res := &models.Gift{}
+	if err := scanGift(repo.store.QueryRow(queryGetByCode, code), res); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.NotFound
+		}
+		return nil, repository.NewDBError(err)
+	}
+	return res, nil
+}
+
+// Redeem mark paid gift as redeemed by recipient and grant subscription on gift award to recipient
+// Errors:
+//		repository_gifts.GiftNotRedeemable
+//		repository_subscribers.AwardSoldOut
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *GiftsRepository) Redeem(gift *models.Gift, recipientID int64) error {
+	begin, err := repo.store.Begin()
+	if err != nil {
+		return repository.NewDBError(err)
+	}
+
+	redeemedAt := sql.NullTime{}
+	if err = begin.QueryRow(queryRedeem, gift.ID, recipientID).Scan(&redeemedAt); err != nil {
+		_ = begin.Rollback()
+		if errors.Is(err, sql.ErrNoRows) {
+			return repository_gifts.GiftNotRedeemable
+		}
+		return repository.NewDBError(err)
+	}
+	if err = grantSubscription(begin, recipientID, gift); err != nil {
+		_ = begin.Rollback()
+		return err
+	}
+
+	if err = begin.Commit(); err != nil {
+		return repository.NewDBError(err)
+	}
+	gift.RecipientID = recipientID
+	gift.Status = models.GiftRedeemed
+	gift.RedeemedAt = &redeemedAt.Time
+	return nil
+}
+
+// GetUserGifts return gifts bought by user and redeemed by him, code is returned only to payer
+// Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *GiftsRepository) GetUserGifts(userID int64) ([]models.Gift, error) {
+	rows, err := repo.store.Query(queryGetUserGifts, userID)
+	if err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	res := make([]models.Gift, 0)
+	for rows.Next() {
+		cur := models.Gift{}
+		if err = scanGift(rows, &cur); err != nil {
+			_ = rows.Close()
+			return nil, repository.NewDBError(errors.Wrapf(err, "method - GetUserGifts"+
+				"invalid data in db: table gifts"))
+		}
+		if cur.PayerID != userID {
+			cur.Code = ""
+		}
+		res = append(res, cur)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, repository.NewDBError(err)
+	}
+	return res, nil
+}
+
+// grantSubscription prolong subscription of recipient on gift award or add new one, which takes seat of award
+// Errors:
+//		repository_subscribers.AwardSoldOut
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func grantSubscription(tx *sql.Tx, recipientID int64, gift *models.Gift) error {
+	res, err := tx.Exec(queryExtendSubscription, recipientID, gift.CreatorID, gift.AwardID, gift.Periods)
+	if err != nil {
+		return repository.NewDBError(err)
+	}
+	if cnt, err := res.RowsAffected(); err != nil {
+		return repository.NewDBError(err)
+	} else if cnt != 0 {
+		return nil
+	}
+
+	if err = repository_subscribers.TakeSeat(tx, recipientID, gift.AwardID, time.Now()); err != nil {
+		return err
+	}
+	if _, err = tx.Exec(queryAddSubscription, recipientID, gift.CreatorID, gift.AwardID, gift.Periods); err != nil {
+		return repository.NewDBError(err)
+	}
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanGift(row scanner, gift *models.Gift) error {
+	redeemedAt := sql.NullTime{}
+	if err := row.Scan(&gift.ID, &gift.Code, &gift.PayerID, &gift.PayerNickname, &gift.RecipientID,
+		&gift.RecipientNickname, &gift.CreatorID, &gift.AwardID, &gift.Periods, &gift.Amount, &gift.Currency,
+		&gift.Status, &gift.Date, &redeemedAt); err != nil {
+		return err
+	}
+	if redeemedAt.Valid {
+		gift.RedeemedAt = &redeemedAt.Time
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package repository_postgresql
+
+import (
+	"database/sql"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_gifts "patreon/internal/app/repository/gifts"
+	repository_subscribers "patreon/internal/app/repository/subscribers"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+)
+
+type SuiteGiftsRepository struct {
+	models.Suite
+	repo *GiftsRepository
+}
+
+func (s *SuiteGiftsRepository) SetupSuite() {
+	s.InitBD()
+	s.repo = NewGiftsRepository(s.DB)
+}
+
+func (s *SuiteGiftsRepository) AfterTest(_, _ string) {
+	require.NoError(s.T(), s.Mock.ExpectationsWereMet())
+}
+
+func (s *SuiteGiftsRepository) giftRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"gifts_id", "code", "payer_id", "nickname", "recipient_id", "nickname",
+		"creator_id", "awards_id", "periods", "amount", "currency", "status", "date", "redeemed_at"})
+}
+
+func (s *SuiteGiftsRepository) TestGiftsRepository_Create_OK() {
+	gift := models.TestGift()
+	gift.PayToken = "token"
+	now := time.Now()
+	s.Mock.ExpectBegin()
+	repository_subscribers.ExpectTakeSeat(s.Mock, gift.RecipientID, gift.AwardID, 0, 0)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddPayment)).
+		WithArgs(gift.Amount, gift.CreatorID, gift.PayerID, gift.AwardID, gift.PayToken, gift.Currency).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id"}).AddRow(5))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCreate)).
+		WithArgs(gift.Code, gift.PayerID, gift.RecipientID, gift.CreatorID, gift.AwardID, gift.Periods, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"gifts_id", "status", "date"}).AddRow(9, models.GiftPending, now))
+	s.Mock.ExpectCommit()
+	err := s.repo.Create(gift)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(9), gift.ID)
+	assert.Equal(s.T(), now, gift.Date)
+}
+
+func (s *SuiteGiftsRepository) TestGiftsRepository_Create_DbError() {
+	gift := models.TestGift()
+	s.Mock.ExpectBegin()
+	repository_subscribers.ExpectTakeSeat(s.Mock, gift.RecipientID, gift.AwardID, 0, 0)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddPayment)).
+		WithArgs(gift.Amount, gift.CreatorID, gift.PayerID, gift.AwardID, gift.PayToken, gift.Currency).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id"}).AddRow(5))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCreate)).
+		WithArgs(gift.Code, gift.PayerID, gift.RecipientID, gift.CreatorID, gift.AwardID, gift.Periods, 5).
+		WillReturnError(models.BDError)
+	s.Mock.ExpectRollback()
+	err := s.repo.Create(gift)
+	assert.Equal(s.T(), repository.NewDBError(models.BDError), err)
+}
+
+func (s *SuiteGiftsRepository) TestGiftsRepository_Create_SoldOut() {
+	gift := models.TestGift()
+	s.Mock.ExpectBegin()
+	repository_subscribers.ExpectTakeSeat(s.Mock, gift.RecipientID, gift.AwardID, 1, 1)
+	s.Mock.ExpectRollback()
+	err := s.repo.Create(gift)
+	assert.Equal(s.T(), repository_subscribers.AwardSoldOut, err)
+}
+
+func (s *SuiteGiftsRepository) TestGiftsRepository_GetByCode() {
+	gift := models.TestGift()
+	gift.PayerNickname = "payer"
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetByCode)).
+		WithArgs(gift.Code).
+		WillReturnRows(s.giftRows().AddRow(gift.ID, gift.Code, gift.PayerID, gift.PayerNickname, 0, "",
+			gift.CreatorID, gift.AwardID, gift.Periods, gift.Amount, gift.Currency, gift.Status, gift.Date, nil))
+	res, err := s.repo.GetByCode(gift.Code)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), gift, res)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetByCode)).
+		WithArgs("OTHER").
+		WillReturnError(sql.ErrNoRows)
+	_, err = s.repo.GetByCode("OTHER")
+	assert.Equal(s.T(), repository.NotFound, err)
+}
+
+func (s *SuiteGiftsRepository) TestGiftsRepository_Redeem_ExtendSubscription() {
+	gift := models.TestGift()
+	gift.Status = models.GiftPaid
+	now := time.Now()
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryRedeem)).
+		WithArgs(gift.ID, 4).
+		WillReturnRows(sqlmock.NewRows([]string{"redeemed_at"}).AddRow(now))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryExtendSubscription)).
+		WithArgs(4, gift.CreatorID, gift.AwardID, gift.Periods).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectCommit()
+	err := s.repo.Redeem(gift, 4)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(4), gift.RecipientID)
+	assert.Equal(s.T(), models.GiftRedeemed, gift.Status)
+	assert.Equal(s.T(), now, *gift.RedeemedAt)
+}
+
+func (s *SuiteGiftsRepository) TestGiftsRepository_Redeem_NewSubscription() {
+	gift := models.TestGift()
+	gift.Status = models.GiftPaid
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryRedeem)).
+		WithArgs(gift.ID, 4).
+		WillReturnRows(sqlmock.NewRows([]string{"redeemed_at"}).AddRow(time.Now()))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryExtendSubscription)).
+		WithArgs(4, gift.CreatorID, gift.AwardID, gift.Periods).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	repository_subscribers.ExpectTakeSeat(s.Mock, 4, gift.AwardID, 0, 0)
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddSubscription)).
+		WithArgs(4, gift.CreatorID, gift.AwardID, gift.Periods).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.Mock.ExpectCommit()
+	err := s.repo.Redeem(gift, 4)
+	require.NoError(s.T(), err)
+}
+
+func (s *SuiteGiftsRepository) TestGiftsRepository_Redeem_NotRedeemable() {
+	gift := models.TestGift()
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryRedeem)).
+		WithArgs(gift.ID, 4).
+		WillReturnError(sql.ErrNoRows)
+	s.Mock.ExpectRollback()
+	err := s.repo.Redeem(gift, 4)
+	assert.Equal(s.T(), repository_gifts.GiftNotRedeemable, err)
+	assert.Equal(s.T(), int64(0), gift.RecipientID)
+}
+
+func (s *SuiteGiftsRepository) TestGiftsRepository_GetUserGifts() {
+	bought := models.TestGift()
+	bought.PayerNickname = "me"
+	received := models.TestGift()
+	received.ID = 2
+	received.PayerID = 5
+	received.PayerNickname = "friend"
+	received.RecipientID = bought.PayerID
+	received.RecipientNickname = "me"
+	received.Status = models.GiftRedeemed
+	redeemedAt := time.Now()
+	received.RedeemedAt = &redeemedAt
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetUserGifts)).
+		WithArgs(bought.PayerID).
+		WillReturnRows(s.giftRows().
+			AddRow(bought.ID, bought.Code, bought.PayerID, bought.PayerNickname, 0, "",
+				bought.CreatorID, bought.AwardID, bought.Periods, bought.Amount, bought.Currency, bought.Status, bought.Date, nil).
+			AddRow(received.ID, received.Code, received.PayerID, received.PayerNickname, received.RecipientID,
+				received.RecipientNickname, received.CreatorID, received.AwardID, received.Periods, received.Amount,
+				received.Currency, received.Status, received.Date, redeemedAt))
+	res, err := s.repo.GetUserGifts(bought.PayerID)
+	require.NoError(s.T(), err)
+	received.Code = ""
+	assert.Equal(s.T(), []models.Gift{*bought, *received}, res)
+}
+
+func TestGiftsRepository(t *testing.T) {
+	suite.Run(t, new(SuiteGiftsRepository))
+}
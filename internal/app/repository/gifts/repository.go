@@ -0,0 +1,28 @@
+package repository_gifts
+
+import "patreon/internal/app/models"
+
+//go:generate mockgen -destination=mocks/mock_gifts_repository.go -package=mock_repository -mock_names=Repository=GiftsRepository . Repository
+
+type Repository interface {
+	// Create Errors:
+	//		repository_subscribers.AwardSoldOut
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	Create(gift *models.Gift) error
+	// GetByCode Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetByCode(code string) (*models.Gift, error)
+	// Redeem Errors:
+	//		repository_gifts.GiftNotRedeemable
+	//		repository_subscribers.AwardSoldOut
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	Redeem(gift *models.Gift, recipientID int64) error
+	// GetUserGifts Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetUserGifts(userID int64) ([]models.Gift, error)
+}
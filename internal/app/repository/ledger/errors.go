@@ -0,0 +1,8 @@
+package repository_ledger
+
+import "github.com/pkg/errors"
+
+var (
+	NotEnoughBalance   = errors.New("creator balance less than payout amount")
+	PayoutStateChanged = errors.New("payout state was changed by other request")
+)
@@ -0,0 +1,123 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: patreon/internal/app/repository/ledger (interfaces: Repository)
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	models "patreon/internal/app/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// LedgerRepository is a mock of Repository interface.
+type LedgerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *LedgerRepositoryMockRecorder
+}
+
+// LedgerRepositoryMockRecorder is the mock recorder for LedgerRepository.
+type LedgerRepositoryMockRecorder struct {
+	mock *LedgerRepository
+}
+
+// NewLedgerRepository creates a new mock instance.
+func NewLedgerRepository(ctrl *gomock.Controller) *LedgerRepository {
+	mock := &LedgerRepository{ctrl: ctrl}
+	mock.recorder = &LedgerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *LedgerRepository) EXPECT() *LedgerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreatePayout mocks base method.
+func (m *LedgerRepository) CreatePayout(arg0 *models.Payout) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePayout", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePayout indicates an expected call of CreatePayout.
+func (mr *LedgerRepositoryMockRecorder) CreatePayout(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayout", reflect.TypeOf((*LedgerRepository)(nil).CreatePayout), arg0)
+}
+
+// GetBalance mocks base method.
+func (m *LedgerRepository) GetBalance(arg0 int64) (*models.CreatorBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", arg0)
+	ret0, _ := ret[0].(*models.CreatorBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *LedgerRepositoryMockRecorder) GetBalance(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*LedgerRepository)(nil).GetBalance), arg0)
+}
+
+// GetEntries mocks base method.
+func (m *LedgerRepository) GetEntries(arg0 int64, arg1 *models.Pagination) ([]models.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntries", arg0, arg1)
+	ret0, _ := ret[0].([]models.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntries indicates an expected call of GetEntries.
+func (mr *LedgerRepositoryMockRecorder) GetEntries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntries", reflect.TypeOf((*LedgerRepository)(nil).GetEntries), arg0, arg1)
+}
+
+// GetPayout mocks base method.
+func (m *LedgerRepository) GetPayout(arg0 int64) (*models.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayout", arg0)
+	ret0, _ := ret[0].(*models.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayout indicates an expected call of GetPayout.
+func (mr *LedgerRepositoryMockRecorder) GetPayout(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayout", reflect.TypeOf((*LedgerRepository)(nil).GetPayout), arg0)
+}
+
+// GetPayouts mocks base method.
+func (m *LedgerRepository) GetPayouts(arg0 int64, arg1 *models.Pagination) ([]models.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayouts", arg0, arg1)
+	ret0, _ := ret[0].([]models.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayouts indicates an expected call of GetPayouts.
+func (mr *LedgerRepositoryMockRecorder) GetPayouts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayouts", reflect.TypeOf((*LedgerRepository)(nil).GetPayouts), arg0, arg1)
+}
+
+// UpdatePayoutState mocks base method.
+func (m *LedgerRepository) UpdatePayoutState(arg0 *models.Payout, arg1 models.PayoutState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePayoutState", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePayoutState indicates an expected call of UpdatePayoutState.
+func (mr *LedgerRepositoryMockRecorder) UpdatePayoutState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePayoutState", reflect.TypeOf((*LedgerRepository)(nil).UpdatePayoutState), arg0, arg1)
+}
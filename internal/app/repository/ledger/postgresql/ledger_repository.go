@@ -0,0 +1,248 @@
+package repository_postgresql
+
+import (
+	"database/sql"
+	"fmt"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_ledger "patreon/internal/app/repository/ledger"
+	putilits "patreon/internal/app/utilits/postgresql"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+const (
+	queryGetAccounts = `
+	SELECT COALESCE(SUM(amount) FILTER (WHERE account = 'creator'), 0),
+	       COALESCE(SUM(amount) FILTER (WHERE account = 'platform'), 0),
+	       COALESCE(SUM(amount) FILTER (WHERE account = 'payout'), 0),
+	       COALESCE(-SUM(amount) FILTER (WHERE account = 'payer'), 0)
+	FROM ledger_entries WHERE creator_id = $1`
+	queryGetPayoutsTotals = `
+	SELECT COALESCE(SUM(amount) FILTER (WHERE state in ('pending', 'approved')), 0),
+	       COALESCE(SUM(amount) FILTER (WHERE state = 'paid'), 0)
+	FROM payouts WHERE creator_id = $1`
+	queryGetPaymentsTotal = "SELECT COALESCE(SUM(amount - refunded_amount), 0), " +
+		"COALESCE((SELECT currency FROM creator_profile WHERE creator_id = $1), '') FROM payments " +
+		"WHERE creator_id = $1 and state in ('succeeded', 'refunded', 'partially_refunded')"
+
+	querySelectEntries = "SELECT id, operation, account, amount, COALESCE(payments_id, 0), COALESCE(payouts_id, 0), date " +
+		"FROM ledger_entries WHERE creator_id = $1 ORDER BY date DESC, id DESC "
+
+	queryLockCreator   = "SELECT creator_id FROM creator_profile WHERE creator_id = $1 FOR UPDATE"
+	queryCreatorAmount = "SELECT COALESCE(SUM(amount), 0) FROM ledger_entries WHERE creator_id = $1 and account = 'creator'"
+	queryAddPayout     = "INSERT INTO payouts (creator_id, amount) VALUES ($1, $2) RETURNING payouts_id, state, date, updated"
+	queryAddPostings   = "INSERT INTO ledger_entries (creator_id, operation, account, amount, payouts_id) " +
+		"VALUES ($1, $2, 'creator', $3, $4), ($1, $2, 'payout', $5, $4)"
+
+	querySelectPayouts = "SELECT payouts_id, creator_id, amount, state, date, updated FROM payouts " +
+		"WHERE creator_id = $1 ORDER BY date DESC, payouts_id DESC "
+	queryGetPayout         = "SELECT payouts_id, creator_id, amount, state, date, updated FROM payouts WHERE payouts_id = $1"
+	queryUpdatePayoutState = "UPDATE payouts SET state = $3, updated = now() WHERE payouts_id = $1 and state = $2 " +
+		"RETURNING updated"
+)
+
+type LedgerRepository struct {
+	store *sqlx.DB
+}
+
+var _ = repository_ledger.Repository(&LedgerRepository{})
+
+func NewLedgerRepository(store *sqlx.DB) *LedgerRepository {
+	return &LedgerRepository{
+		store: store,
+	}
+}
+
+// GetBalance return totals of creator accounts. Balance is reconciled when money received from payers
+// equal to paid payments minus refunds and all of it is distributed between creator, platform and payouts
+// Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *LedgerRepository) GetBalance(creatorID int64) (*models.CreatorBalance, error) {
+	res := &models.CreatorBalance{}
+	var payoutAccount models.Decimal
+	if err := repo.store.QueryRow(queryGetAccounts, creatorID).
+		Scan(&res.Balance, &res.Fees, &payoutAccount, &res.Received); err != nil {
+		return nil, repository.NewDBError(err)
+	}
+	if err := repo.store.QueryRow(queryGetPayoutsTotals, creatorID).Scan(&res.InPayout, &res.PaidOut); err != nil {
+		return nil, repository.NewDBError(err)
+	}
+	if err := repo.store.QueryRow(queryGetPaymentsTotal, creatorID).Scan(&res.PaymentsTotal, &res.Currency); err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	res.Reconciled = res.Received == res.PaymentsTotal &&
+		res.Balance+res.Fees+payoutAccount == res.Received &&
+		payoutAccount == res.InPayout+res.PaidOut
+	return res, nil
+}
+
+// GetEntries Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *LedgerRepository) GetEntries(creatorID int64, pag *models.Pagination) ([]models.LedgerEntry, error) {
+	limit, offset, err := putilits.AddPagination("ledger_entries", pag, repo.store)
+	if err != nil {
+		return nil, err
+	}
+	if limit == 0 {
+		return nil, repository.NotFound
+	}
+	query := querySelectEntries + fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := repo.store.Query(query, creatorID)
+	if err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	res := make([]models.LedgerEntry, 0, limit)
+	for rows.Next() {
+		cur := models.LedgerEntry{}
+		if err = rows.Scan(&cur.ID, &cur.Operation, &cur.Account, &cur.Amount, &cur.PaymentID,
+			&cur.PayoutID, &cur.Date); err != nil {
+			_ = rows.Close()
+			return nil, repository.NewDBError(errors.Wrapf(err, "method - GetEntries"+
+				"invalid data in db: table ledger_entries"))
+		}
+		res = append(res, cur)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, repository.NewDBError(err)
+	}
+	return res, nil
+}
+
+// CreatePayout take payout amount from creator balance and create pending payout
+// Errors:
+//		repository_ledger.NotEnoughBalance
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *LedgerRepository) CreatePayout(payout *models.Payout) error {
+	begin, err := repo.store.Begin()
+	if err != nil {
+		return repository.NewDBError(err)
+	}
+
+	// concurrent payouts of one creator must not take the same money
+	var lockedID int64
+	var balance models.Decimal
+	if err = begin.QueryRow(queryLockCreator, payout.CreatorID).Scan(&lockedID); err != nil {
+		_ = begin.Rollback()
+		return repository.NewDBError(err)
+	}
+	if err = begin.QueryRow(queryCreatorAmount, payout.CreatorID).Scan(&balance); err != nil {
+		_ = begin.Rollback()
+		return repository.NewDBError(err)
+	}
+	if balance < payout.Amount {
+		_ = begin.Rollback()
+		return repository_ledger.NotEnoughBalance
+	}
+
+	if err = begin.QueryRow(queryAddPayout, payout.CreatorID, payout.Amount).
+		Scan(&payout.ID, &payout.State, &payout.Date, &payout.Updated); err != nil {
+		_ = begin.Rollback()
+		return repository.NewDBError(err)
+	}
+	if _, err = begin.Exec(queryAddPostings, payout.CreatorID, models.OperationPayout,
+		-payout.Amount, payout.ID, payout.Amount); err != nil {
+		_ = begin.Rollback()
+		return repository.NewDBError(err)
+	}
+
+	if err = begin.Commit(); err != nil {
+		return repository.NewDBError(err)
+	}
+	return nil
+}
+
+// GetPayouts Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *LedgerRepository) GetPayouts(creatorID int64, pag *models.Pagination) ([]models.Payout, error) {
+	limit, offset, err := putilits.AddPagination("payouts", pag, repo.store)
+	if err != nil {
+		return nil, err
+	}
+	if limit == 0 {
+		return nil, repository.NotFound
+	}
+	query := querySelectPayouts + fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := repo.store.Query(query, creatorID)
+	if err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	res := make([]models.Payout, 0, limit)
+	for rows.Next() {
+		cur := models.Payout{}
+		if err = rows.Scan(&cur.ID, &cur.CreatorID, &cur.Amount, &cur.State, &cur.Date, &cur.Updated); err != nil {
+			_ = rows.Close()
+			return nil, repository.NewDBError(errors.Wrapf(err, "method - GetPayouts"+
+				"invalid data in db: table payouts"))
+		}
+		res = append(res, cur)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, repository.NewDBError(err)
+	}
+	return res, nil
+}
+
+// GetPayout Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *LedgerRepository) GetPayout(payoutID int64) (*models.Payout, error) {
+	res := &models.Payout{}
+	if err := repo.store.QueryRow(queryGetPayout, payoutID).
+		Scan(&res.ID, &res.CreatorID, &res.Amount, &res.State, &res.Date, &res.Updated); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.NotFound
+		}
+		return nil, repository.NewDBError(err)
+	}
+	return res, nil
+}
+
+// UpdatePayoutState move payout from its current state to state to,
+// money of rejected payout is returned to creator balance
+// Errors:
+//		repository_ledger.PayoutStateChanged
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *LedgerRepository) UpdatePayoutState(payout *models.Payout, to models.PayoutState) error {
+	begin, err := repo.store.Begin()
+	if err != nil {
+		return repository.NewDBError(err)
+	}
+
+	if err = begin.QueryRow(queryUpdatePayoutState, payout.ID, payout.State, to).Scan(&payout.Updated); err != nil {
+		_ = begin.Rollback()
+		if errors.Is(err, sql.ErrNoRows) {
+			return repository_ledger.PayoutStateChanged
+		}
+		return repository.NewDBError(err)
+	}
+	if to == models.PayoutRejected {
+		if _, err = begin.Exec(queryAddPostings, payout.CreatorID, models.OperationPayoutRejected,
+			payout.Amount, payout.ID, -payout.Amount); err != nil {
+			_ = begin.Rollback()
+			return repository.NewDBError(err)
+		}
+	}
+
+	if err = begin.Commit(); err != nil {
+		return repository.NewDBError(err)
+	}
+	payout.State = to
+	return nil
+}
@@ -0,0 +1,175 @@
+package repository_postgresql
+
+import (
+	"database/sql"
+	"fmt"
+	"patreon/internal/app/models"
+	repository_ledger "patreon/internal/app/repository/ledger"
+	putilits "patreon/internal/app/utilits/postgresql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+)
+
+type SuiteLedgerRepository struct {
+	models.Suite
+	repo *LedgerRepository
+}
+
+func (s *SuiteLedgerRepository) SetupSuite() {
+	s.InitBD()
+	s.repo = NewLedgerRepository(s.DB)
+}
+
+func (s *SuiteLedgerRepository) AfterTest(_, _ string) {
+	require.NoError(s.T(), s.Mock.ExpectationsWereMet())
+}
+
+func (s *SuiteLedgerRepository) expectBalance(creatorID int64, accounts []int64, payouts []int64, payments int64) {
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetAccounts)).
+		WithArgs(creatorID).
+		WillReturnRows(sqlmock.NewRows([]string{"creator", "platform", "payout", "payer"}).
+			AddRow(accounts[0], accounts[1], accounts[2], accounts[3]))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetPayoutsTotals)).
+		WithArgs(creatorID).
+		WillReturnRows(sqlmock.NewRows([]string{"in_payout", "paid_out"}).AddRow(payouts[0], payouts[1]))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetPaymentsTotal)).
+		WithArgs(creatorID).
+		WillReturnRows(sqlmock.NewRows([]string{"total", "currency"}).AddRow(payments, models.DefaultCurrency))
+}
+
+func (s *SuiteLedgerRepository) TestLedgerRepository_GetBalance_Reconciled() {
+	creatorID := int64(2)
+	s.expectBalance(creatorID, []int64{600, 100, 300, 1000}, []int64{100, 200}, 1000)
+	res, err := s.repo.GetBalance(creatorID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), &models.CreatorBalance{Balance: models.NewDecimal(600),
+		InPayout: models.NewDecimal(100), PaidOut: models.NewDecimal(200), Fees: models.NewDecimal(100),
+		Received: models.NewDecimal(1000), PaymentsTotal: models.NewDecimal(1000),
+		Currency: models.DefaultCurrency, Reconciled: true}, res)
+}
+
+func (s *SuiteLedgerRepository) TestLedgerRepository_GetBalance_NotReconciled() {
+	creatorID := int64(2)
+	s.expectBalance(creatorID, []int64{900, 100, 0, 1000}, []int64{0, 0}, 1200)
+	res, err := s.repo.GetBalance(creatorID)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), res.Reconciled)
+}
+
+func (s *SuiteLedgerRepository) TestLedgerRepository_GetBalance_DBError() {
+	creatorID := int64(2)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetAccounts)).
+		WithArgs(creatorID).
+		WillReturnError(models.BDError)
+	_, err := s.repo.GetBalance(creatorID)
+	assert.Error(s.T(), err)
+}
+
+func (s *SuiteLedgerRepository) TestLedgerRepository_GetEntries() {
+	queryStat := "SELECT n_live_tup FROM pg_stat_all_tables WHERE relname = $1"
+	tableName := "ledger_entries"
+	creatorID := int64(2)
+	pag := &models.Pagination{Limit: 10, Offset: 0}
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
+		WithArgs(tableName).
+		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).AddRow(int64(5000)))
+	limit, offset, err := putilits.AddPagination(tableName, pag, s.DB)
+	require.NoError(s.T(), err)
+	query := querySelectEntries + fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
+
+	entry := models.LedgerEntry{ID: 1, Operation: models.OperationPayment, Account: models.AccountCreator,
+		Amount: 90, PaymentID: 4, Date: time.Now()}
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
+		WithArgs(tableName).
+		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).AddRow(int64(5000)))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(creatorID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "operation", "account", "amount", "payments_id", "payouts_id", "date"}).
+			AddRow(entry.ID, entry.Operation, entry.Account, entry.Amount, entry.PaymentID, entry.PayoutID, entry.Date))
+	res, err := s.repo.GetEntries(creatorID, pag)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []models.LedgerEntry{entry}, res)
+}
+
+func (s *SuiteLedgerRepository) TestLedgerRepository_CreatePayout_OK() {
+	payout := &models.Payout{CreatorID: 2, Amount: models.NewDecimal(300)}
+	now := time.Now()
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryLockCreator)).
+		WithArgs(payout.CreatorID).
+		WillReturnRows(sqlmock.NewRows([]string{"creator_id"}).AddRow(payout.CreatorID))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCreatorAmount)).
+		WithArgs(payout.CreatorID).
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(500))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddPayout)).
+		WithArgs(payout.CreatorID, payout.Amount).
+		WillReturnRows(sqlmock.NewRows([]string{"payouts_id", "state", "date", "updated"}).
+			AddRow(7, models.PayoutPending, now, now))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddPostings)).
+		WithArgs(payout.CreatorID, models.OperationPayout, -payout.Amount, 7, payout.Amount).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	s.Mock.ExpectCommit()
+	err := s.repo.CreatePayout(payout)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(7), payout.ID)
+	assert.Equal(s.T(), models.PayoutPending, payout.State)
+}
+
+func (s *SuiteLedgerRepository) TestLedgerRepository_CreatePayout_NotEnoughBalance() {
+	payout := &models.Payout{CreatorID: 2, Amount: models.NewDecimal(300)}
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryLockCreator)).
+		WithArgs(payout.CreatorID).
+		WillReturnRows(sqlmock.NewRows([]string{"creator_id"}).AddRow(payout.CreatorID))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCreatorAmount)).
+		WithArgs(payout.CreatorID).
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(200))
+	s.Mock.ExpectRollback()
+	err := s.repo.CreatePayout(payout)
+	assert.Equal(s.T(), repository_ledger.NotEnoughBalance, err)
+}
+
+func (s *SuiteLedgerRepository) TestLedgerRepository_GetPayout_NotFound() {
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetPayout)).
+		WithArgs(7).
+		WillReturnError(sql.ErrNoRows)
+	_, err := s.repo.GetPayout(7)
+	assert.Error(s.T(), err)
+}
+
+func (s *SuiteLedgerRepository) TestLedgerRepository_UpdatePayoutState_Rejected() {
+	payout := &models.Payout{ID: 7, CreatorID: 2, Amount: 300, State: models.PayoutApproved}
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryUpdatePayoutState)).
+		WithArgs(payout.ID, models.PayoutApproved, models.PayoutRejected).
+		WillReturnRows(sqlmock.NewRows([]string{"updated"}).AddRow(time.Now()))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddPostings)).
+		WithArgs(payout.CreatorID, models.OperationPayoutRejected, payout.Amount, payout.ID, -payout.Amount).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	s.Mock.ExpectCommit()
+	err := s.repo.UpdatePayoutState(payout, models.PayoutRejected)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.PayoutRejected, payout.State)
+}
+
+func (s *SuiteLedgerRepository) TestLedgerRepository_UpdatePayoutState_Changed() {
+	payout := &models.Payout{ID: 7, CreatorID: 2, Amount: 300, State: models.PayoutPending}
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryUpdatePayoutState)).
+		WithArgs(payout.ID, models.PayoutPending, models.PayoutApproved).
+		WillReturnError(sql.ErrNoRows)
+	s.Mock.ExpectRollback()
+	err := s.repo.UpdatePayoutState(payout, models.PayoutApproved)
+	assert.Equal(s.T(), repository_ledger.PayoutStateChanged, err)
+}
+
+func TestLedgerRepository(t *testing.T) {
+	suite.Run(t, new(SuiteLedgerRepository))
+}
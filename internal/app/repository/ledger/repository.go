@@ -0,0 +1,39 @@
+package repository_ledger
+
+import (
+	"patreon/internal/app/models"
+)
+
+//go:generate mockgen -destination=mocks/mock_ledger_repository.go -package=mock_repository -mock_names=Repository=LedgerRepository . Repository
+
+type Repository interface {
+	// GetBalance Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetBalance(creatorID int64) (*models.CreatorBalance, error)
+	// GetEntries Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetEntries(creatorID int64, pag *models.Pagination) ([]models.LedgerEntry, error)
+	// CreatePayout Errors:
+	//		repository_ledger.NotEnoughBalance
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	CreatePayout(payout *models.Payout) error
+	// GetPayouts Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetPayouts(creatorID int64, pag *models.Pagination) ([]models.Payout, error)
+	// GetPayout Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetPayout(payoutID int64) (*models.Payout, error)
+	// UpdatePayoutState Errors:
+	//		repository_ledger.PayoutStateChanged
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	UpdatePayoutState(payout *models.Payout, to models.PayoutState) error
+}
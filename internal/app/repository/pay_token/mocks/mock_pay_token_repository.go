@@ -0,0 +1,123 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: patreon/internal/app/repository/pay_token (interfaces: Repository)
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	models "patreon/internal/app/models"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// PayTokenRepository is a mock of Repository interface.
+type PayTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *PayTokenRepositoryMockRecorder
+}
+
+// PayTokenRepositoryMockRecorder is the mock recorder for PayTokenRepository.
+type PayTokenRepositoryMockRecorder struct {
+	mock *PayTokenRepository
+}
+
+// NewPayTokenRepository creates a new mock instance.
+func NewPayTokenRepository(ctrl *gomock.Controller) *PayTokenRepository {
+	mock := &PayTokenRepository{ctrl: ctrl}
+	mock.recorder = &PayTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *PayTokenRepository) EXPECT() *PayTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetToken mocks base method.
+func (m *PayTokenRepository) GetToken(arg0 string) (*models.PayTokenInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetToken", arg0)
+	ret0, _ := ret[0].(*models.PayTokenInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetToken indicates an expected call of GetToken.
+func (mr *PayTokenRepositoryMockRecorder) GetToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetToken", reflect.TypeOf((*PayTokenRepository)(nil).GetToken), arg0)
+}
+
+// GetUserTokens mocks base method.
+func (m *PayTokenRepository) GetUserTokens(arg0 int64, arg1 time.Time) ([]models.PayTokenInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserTokens", arg0, arg1)
+	ret0, _ := ret[0].([]models.PayTokenInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserTokens indicates an expected call of GetUserTokens.
+func (mr *PayTokenRepositoryMockRecorder) GetUserTokens(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTokens", reflect.TypeOf((*PayTokenRepository)(nil).GetUserTokens), arg0, arg1)
+}
+
+// MarkUsed mocks base method.
+func (m *PayTokenRepository) MarkUsed(arg0 string, arg1 int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUsed", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkUsed indicates an expected call of MarkUsed.
+func (mr *PayTokenRepositoryMockRecorder) MarkUsed(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUsed", reflect.TypeOf((*PayTokenRepository)(nil).MarkUsed), arg0, arg1)
+}
+
+// RemoveUserToken mocks base method.
+func (m *PayTokenRepository) RemoveUserToken(arg0 int64, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUserToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveUserToken indicates an expected call of RemoveUserToken.
+func (mr *PayTokenRepositoryMockRecorder) RemoveUserToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserToken", reflect.TypeOf((*PayTokenRepository)(nil).RemoveUserToken), arg0, arg1)
+}
+
+// SetToken mocks base method.
+func (m *PayTokenRepository) SetToken(arg0 *models.PayTokenInfo, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetToken indicates an expected call of SetToken.
+func (mr *PayTokenRepositoryMockRecorder) SetToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetToken", reflect.TypeOf((*PayTokenRepository)(nil).SetToken), arg0, arg1)
+}
+
+// UnmarkUsed mocks base method.
+func (m *PayTokenRepository) UnmarkUsed(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnmarkUsed", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnmarkUsed indicates an expected call of UnmarkUsed.
+func (mr *PayTokenRepositoryMockRecorder) UnmarkUsed(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnmarkUsed", reflect.TypeOf((*PayTokenRepository)(nil).UnmarkUsed), arg0)
+}
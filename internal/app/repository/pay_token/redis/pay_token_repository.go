@@ -1,10 +1,19 @@
 package repository_redis
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/gomodule/redigo/redis"
 	"github.com/pkg/errors"
 	"patreon/internal/app"
+	"patreon/internal/app/models"
+	"strconv"
+	"time"
+)
+
+const (
+	usedTokenPrefix  = "pay_token_used:"
+	userTokensPrefix = "pay_tokens:"
 )
 
 type PayTokenRepository struct {
@@ -17,52 +26,187 @@ func NewPayTokenRepository(pool *redis.Pool) *PayTokenRepository {
 	}
 }
 
-// Set Errors:
+func userTokensKey(userID int64) string {
+	return userTokensPrefix + strconv.FormatInt(userID, 10)
+}
+
+func closeConnection(con redis.Conn, key string) {
+	err := con.Close()
+	if err != nil {
+		panic(fmt.Sprintf("Unsuccessful close connection to redis with error: %s, with key: %s", err.Error(), key))
+	}
+}
+
+// SetToken save token info and add token to index of user tokens, index is ordered by expiration time
+// Errors:
 // 		app.GeneralError with Errors
 // 			SetError
-func (repo *PayTokenRepository) Set(key string, value string, timeExp int) error {
+func (repo *PayTokenRepository) SetToken(info *models.PayTokenInfo, timeExp int) error {
 	con := repo.redisPool.Get()
-	defer func(con redis.Conn) {
-		err := con.Close()
-		if err != nil {
-			panic(fmt.Sprintf("Unsuccessful close connection to redis with error: %s, with key: %s value: %s", err.Error(), key, value))
+	defer closeConnection(con, info.Token)
+
+	value, err := json.Marshal(info)
+	if err != nil {
+		return &app.GeneralError{
+			Err:         errors.Wrapf(SetError, "error when try marshal token info with key: %s", info.Token),
+			ExternalErr: err,
+		}
+	}
+
+	indexKey := userTokensKey(info.UserID)
+	indexTTL, err := redis.Int(con.Do("TTL", indexKey))
+	if err != nil {
+		return &app.GeneralError{
+			Err:         errors.Wrapf(SetError, "error when try get ttl of index with key: %s", indexKey),
+			ExternalErr: err,
 		}
-	}(con)
-	res, err := redis.String(con.Do("SET", key, value, "EX", timeExp))
-	if res != "OK" {
-		return app.GeneralError{
-			Err: errors.Wrapf(SetError,
-				"error when try set with key: %s value: %s", key, value),
+	}
+
+	_ = con.Send("MULTI")
+	_ = con.Send("SET", info.Token, value, "EX", timeExp)
+	_ = con.Send("ZADD", indexKey, info.ExpiresAt.Unix(), info.Token)
+	// index must live as long as the longest token in it
+	if indexTTL < timeExp {
+		_ = con.Send("EXPIRE", indexKey, timeExp)
+	}
+	if _, err = con.Do("EXEC"); err != nil {
+		return &app.GeneralError{
+			Err:         errors.Wrapf(SetError, "error when try set with key: %s value: %s", info.Token, value),
 			ExternalErr: err,
 		}
 	}
 	return nil
 }
 
-// Get Errors:
+// GetToken Errors:
 //		NotFound
 // 		app.GeneralError with Errors
 // 			InvalidStorageData
-func (repo *PayTokenRepository) Get(key string) (string, error) {
+func (repo *PayTokenRepository) GetToken(token string) (*models.PayTokenInfo, error) {
 	con := repo.redisPool.Get()
-	defer func(con redis.Conn) {
-		err := con.Close()
-		if err != nil {
-			panic(errors.Errorf("Unsuccessful close connection to redis with error: %s, with key: %s",
-				err.Error(), key))
+	defer closeConnection(con, token)
+
+	return repo.getToken(con, token)
+}
+
+func (repo *PayTokenRepository) getToken(con redis.Conn, token string) (*models.PayTokenInfo, error) {
+	values, err := redis.Values(con.Do("MGET", token, usedTokenPrefix+token))
+	if err != nil || len(values) != 2 {
+		return nil, &app.GeneralError{
+			Err: errors.Wrapf(InvalidStorageData,
+				"error when try get from PayTokenRepository with key: %s", token),
+			ExternalErr: err,
 		}
-	}(con)
-	res, err := redis.String(con.Do("GET", key))
-	if err == redis.ErrNil {
-		return "", NotFound
+	}
+	if values[0] == nil {
+		return nil, NotFound
+	}
+
+	data, err := redis.Bytes(values[0], nil)
+	info := &models.PayTokenInfo{}
+	if err == nil {
+		err = json.Unmarshal(data, info)
 	}
 	if err != nil {
-		return "", app.GeneralError{
+		return nil, &app.GeneralError{
 			Err: errors.Wrapf(InvalidStorageData,
-				"error when try get from PayTokenRepository with key: %s", key),
+				"error when try parse token info from PayTokenRepository with key: %s", token),
+			ExternalErr: err,
+		}
+	}
+	info.Used = values[1] != nil
+	return info, nil
+}
+
+// MarkUsed mark token as used if it was not used before, return false if token already used
+// Errors:
+// 		app.GeneralError with Errors
+// 			SetError
+func (repo *PayTokenRepository) MarkUsed(token string, timeExp int) (bool, error) {
+	con := repo.redisPool.Get()
+	defer closeConnection(con, token)
+
+	res, err := redis.String(con.Do("SET", usedTokenPrefix+token, "1", "EX", timeExp, "NX"))
+	if err == redis.ErrNil {
+		return false, nil
+	}
+	if err != nil || res != "OK" {
+		return false, &app.GeneralError{
+			Err:         errors.Wrapf(SetError, "error when try mark used with key: %s", token),
+			ExternalErr: err,
+		}
+	}
+	return true, nil
+}
+
+// UnmarkUsed mark token as not used, so it can be used again
+// Errors:
+// 		app.GeneralError with Errors
+// 			SetError
+func (repo *PayTokenRepository) UnmarkUsed(token string) error {
+	con := repo.redisPool.Get()
+	defer closeConnection(con, token)
+
+	if _, err := con.Do("DEL", usedTokenPrefix+token); err != nil {
+		return &app.GeneralError{
+			Err:         errors.Wrapf(SetError, "error when try unmark used with key: %s", token),
 			ExternalErr: err,
 		}
 	}
+	return nil
+}
+
+// GetUserTokens return not expired tokens of user ordered by expiration time
+// Errors:
+// 		app.GeneralError with Errors
+// 			InvalidStorageData
+func (repo *PayTokenRepository) GetUserTokens(userID int64, now time.Time) ([]models.PayTokenInfo, error) {
+	indexKey := userTokensKey(userID)
+	con := repo.redisPool.Get()
+	defer closeConnection(con, indexKey)
 
+	if _, err := con.Do("ZREMRANGEBYSCORE", indexKey, "-inf", now.Unix()); err != nil {
+		return nil, &app.GeneralError{
+			Err:         errors.Wrapf(InvalidStorageData, "error when try clean index with key: %s", indexKey),
+			ExternalErr: err,
+		}
+	}
+	tokens, err := redis.Strings(con.Do("ZRANGE", indexKey, 0, -1))
+	if err != nil {
+		return nil, &app.GeneralError{
+			Err:         errors.Wrapf(InvalidStorageData, "error when try get index with key: %s", indexKey),
+			ExternalErr: err,
+		}
+	}
+
+	res := make([]models.PayTokenInfo, 0, len(tokens))
+	for _, token := range tokens {
+		info, err := repo.getToken(con, token)
+		if err == NotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *info)
+	}
 	return res, nil
 }
+
+// RemoveUserToken remove token from index of user tokens, token itself stays until expiration
+// Errors:
+// 		app.GeneralError with Errors
+// 			SetError
+func (repo *PayTokenRepository) RemoveUserToken(userID int64, token string) error {
+	indexKey := userTokensKey(userID)
+	con := repo.redisPool.Get()
+	defer closeConnection(con, indexKey)
+
+	if _, err := con.Do("ZREM", indexKey, token); err != nil {
+		return &app.GeneralError{
+			Err:         errors.Wrapf(SetError, "error when try remove %s from index with key: %s", token, indexKey),
+			ExternalErr: err,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package repository_redis
+
+import (
+	"patreon/internal/app/models"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type SuitePayTokenRepository struct {
+	suite.Suite
+	redisServer *miniredis.Miniredis
+	repo        *PayTokenRepository
+	now         time.Time
+}
+
+func (s *SuitePayTokenRepository) SetupTest() {
+	var err error
+	s.redisServer, err = miniredis.Run()
+	require.NoError(s.T(), err)
+
+	addr := s.redisServer.Addr()
+	s.repo = NewPayTokenRepository(&redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", addr)
+		},
+	})
+	s.now = time.Date(2021, 12, 1, 12, 0, 0, 0, time.UTC)
+}
+
+func (s *SuitePayTokenRepository) TearDownTest() {
+	s.redisServer.Close()
+}
+
+func (s *SuitePayTokenRepository) testInfo(token string, exp time.Duration) *models.PayTokenInfo {
+	return &models.PayTokenInfo{
+		Token:     token,
+		UserID:    1,
+		CreatorID: 2,
+		AwardID:   3,
+		Price:     100,
+		Currency:  models.DefaultCurrency,
+		ExpiresAt: s.now.Add(exp),
+	}
+}
+
+func (s *SuitePayTokenRepository) TestSetAndGetToken() {
+	info := s.testInfo("pay_token", time.Hour)
+	require.NoError(s.T(), s.repo.SetToken(info, 3600))
+
+	res, err := s.repo.GetToken(info.Token)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), info, res)
+	assert.Equal(s.T(), time.Hour, s.redisServer.TTL(info.Token))
+
+	_, err = s.repo.GetToken("other_token")
+	assert.Equal(s.T(), NotFound, err)
+}
+
+func (s *SuitePayTokenRepository) TestGetToken_InvalidData() {
+	require.NoError(s.T(), s.redisServer.Set("pay_token", "1"))
+	_, err := s.repo.GetToken("pay_token")
+	assert.Error(s.T(), err)
+	assert.NotEqual(s.T(), NotFound, err)
+}
+
+func (s *SuitePayTokenRepository) TestMarkUsed() {
+	info := s.testInfo("pay_token", time.Hour)
+	require.NoError(s.T(), s.repo.SetToken(info, 3600))
+
+	marked, err := s.repo.MarkUsed(info.Token, 3600)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), marked)
+
+	marked, err = s.repo.MarkUsed(info.Token, 3600)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), marked)
+
+	res, err := s.repo.GetToken(info.Token)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), res.Used)
+}
+
+func (s *SuitePayTokenRepository) TestUnmarkUsed() {
+	info := s.testInfo("pay_token", time.Hour)
+	require.NoError(s.T(), s.repo.SetToken(info, 3600))
+	_, err := s.repo.MarkUsed(info.Token, 3600)
+	require.NoError(s.T(), err)
+
+	require.NoError(s.T(), s.repo.UnmarkUsed(info.Token))
+	res, err := s.repo.GetToken(info.Token)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), res.Used)
+
+	marked, err := s.repo.MarkUsed(info.Token, 3600)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), marked)
+}
+
+func (s *SuitePayTokenRepository) TestGetUserTokens() {
+	expired := s.testInfo("expired_token", -time.Minute)
+	long := s.testInfo("long_token", 3*time.Hour)
+	short := s.testInfo("short_token", time.Hour)
+	require.NoError(s.T(), s.repo.SetToken(expired, 60))
+	require.NoError(s.T(), s.repo.SetToken(long, 3*3600))
+	require.NoError(s.T(), s.repo.SetToken(short, 3600))
+	assert.Equal(s.T(), 3*time.Hour, s.redisServer.TTL(userTokensKey(long.UserID)))
+
+	res, err := s.repo.GetUserTokens(long.UserID, s.now)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []models.PayTokenInfo{*short, *long}, res)
+
+	require.NoError(s.T(), s.repo.RemoveUserToken(short.UserID, short.Token))
+	s.redisServer.Del(long.Token)
+	res, err = s.repo.GetUserTokens(long.UserID, s.now)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), res)
+
+	res, err = s.repo.GetUserTokens(long.UserID+1, s.now)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), res)
+}
+
+func TestPayTokenRepository(t *testing.T) {
+	suite.Run(t, new(SuitePayTokenRepository))
+}
@@ -1,15 +1,36 @@
 package repository_pay_token
 
+import (
+	"patreon/internal/app/models"
+	"time"
+)
+
 //go:generate mockgen -destination=mocks/mock_pay_token_repository.go -package=mock_repository -mock_names=Repository=PayTokenRepository . Repository
 
 type Repository interface {
-	// Set Errors:
+	// SetToken Errors:
 	// 		app.GeneralError with Errors
 	// 			repository_redis.SetError
-	Set(key string, value string, timeExp int) error
-	// Get Errors:
+	SetToken(info *models.PayTokenInfo, timeExp int) error
+	// GetToken Errors:
 	//		repository_redis.NotFound
 	// 		app.GeneralError with Errors
 	// 			repository_redis.InvalidStorageData
-	Get(key string) (string, error)
+	GetToken(token string) (*models.PayTokenInfo, error)
+	// MarkUsed Errors:
+	// 		app.GeneralError with Errors
+	// 			repository_redis.SetError
+	MarkUsed(token string, timeExp int) (bool, error)
+	// UnmarkUsed Errors:
+	// 		app.GeneralError with Errors
+	// 			repository_redis.SetError
+	UnmarkUsed(token string) error
+	// GetUserTokens Errors:
+	// 		app.GeneralError with Errors
+	// 			repository_redis.InvalidStorageData
+	GetUserTokens(userID int64, now time.Time) ([]models.PayTokenInfo, error)
+	// RemoveUserToken Errors:
+	// 		app.GeneralError with Errors
+	// 			repository_redis.SetError
+	RemoveUserToken(userID int64, token string) error
 }
@@ -5,4 +5,8 @@ import "github.com/pkg/errors"
 var (
 	CountPaymentsByTokenError = errors.New("payment by token must be once")
 	NotEqualPaymentAmount     = errors.New("payment amount from request not equal amount from database")
+	NotEqualPaymentCurrency   = errors.New("payment currency from request not equal currency from database")
+	PaymentStateChanged       = errors.New("payment state was changed by other request")
+	OperationAlreadyProcessed = errors.New("operation of payment provider was already processed")
+	TierChangeCancelled       = errors.New("paid tier change was cancelled, payment must be refunded")
 )
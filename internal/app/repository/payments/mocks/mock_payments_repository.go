@@ -7,6 +7,7 @@ package mock_repository
 import (
 	models "patreon/internal/app/models"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -34,32 +35,193 @@ func (m *PaymentsRepository) EXPECT() *PaymentsRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ChangeState mocks base method.
+func (m *PaymentsRepository) ChangeState(arg0 string, arg1 *models.PaymentEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeState", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeState indicates an expected call of ChangeState.
+func (mr *PaymentsRepositoryMockRecorder) ChangeState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeState", reflect.TypeOf((*PaymentsRepository)(nil).ChangeState), arg0, arg1)
+}
+
+// CheckCountPaymentsByToken mocks base method.
+func (m *PaymentsRepository) CheckCountPaymentsByToken(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckCountPaymentsByToken", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckCountPaymentsByToken indicates an expected call of CheckCountPaymentsByToken.
+func (mr *PaymentsRepositoryMockRecorder) CheckCountPaymentsByToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckCountPaymentsByToken", reflect.TypeOf((*PaymentsRepository)(nil).CheckCountPaymentsByToken), arg0)
+}
+
+// CheckOperationProcessed mocks base method.
+func (m *PaymentsRepository) CheckOperationProcessed(arg0 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckOperationProcessed", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckOperationProcessed indicates an expected call of CheckOperationProcessed.
+func (mr *PaymentsRepositoryMockRecorder) CheckOperationProcessed(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckOperationProcessed", reflect.TypeOf((*PaymentsRepository)(nil).CheckOperationProcessed), arg0)
+}
+
+// ExpireCheckout mocks base method.
+func (m *PaymentsRepository) ExpireCheckout(arg0 *models.AbandonedCheckout, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireCheckout", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireCheckout indicates an expected call of ExpireCheckout.
+func (mr *PaymentsRepositoryMockRecorder) ExpireCheckout(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireCheckout", reflect.TypeOf((*PaymentsRepository)(nil).ExpireCheckout), arg0, arg1)
+}
+
+// ExportCreatorPayments mocks base method.
+func (m *PaymentsRepository) ExportCreatorPayments(arg0 int64, arg1 *models.PaymentsFilter, arg2 func(*models.CreatorPayments) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportCreatorPayments", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportCreatorPayments indicates an expected call of ExportCreatorPayments.
+func (mr *PaymentsRepositoryMockRecorder) ExportCreatorPayments(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCreatorPayments", reflect.TypeOf((*PaymentsRepository)(nil).ExportCreatorPayments), arg0, arg1, arg2)
+}
+
+// GetAbandonedCheckouts mocks base method.
+func (m *PaymentsRepository) GetAbandonedCheckouts(arg0 time.Time) ([]models.AbandonedCheckout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAbandonedCheckouts", arg0)
+	ret0, _ := ret[0].([]models.AbandonedCheckout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAbandonedCheckouts indicates an expected call of GetAbandonedCheckouts.
+func (mr *PaymentsRepositoryMockRecorder) GetAbandonedCheckouts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAbandonedCheckouts", reflect.TypeOf((*PaymentsRepository)(nil).GetAbandonedCheckouts), arg0)
+}
+
+// GetCreatorMonthlyTotals mocks base method.
+func (m *PaymentsRepository) GetCreatorMonthlyTotals(arg0 int64, arg1 *models.PaymentsFilter) ([]models.PaymentsMonthTotal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCreatorMonthlyTotals", arg0, arg1)
+	ret0, _ := ret[0].([]models.PaymentsMonthTotal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCreatorMonthlyTotals indicates an expected call of GetCreatorMonthlyTotals.
+func (mr *PaymentsRepositoryMockRecorder) GetCreatorMonthlyTotals(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCreatorMonthlyTotals", reflect.TypeOf((*PaymentsRepository)(nil).GetCreatorMonthlyTotals), arg0, arg1)
+}
+
 // GetCreatorPayments mocks base method.
-func (m *PaymentsRepository) GetCreatorPayments(arg0 int64, arg1 *models.Pagination) ([]models.CreatorPayments, error) {
+func (m *PaymentsRepository) GetCreatorPayments(arg0 int64, arg1 *models.Pagination, arg2 *models.PaymentsFilter) ([]models.CreatorPayments, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCreatorPayments", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetCreatorPayments", arg0, arg1, arg2)
 	ret0, _ := ret[0].([]models.CreatorPayments)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCreatorPayments indicates an expected call of GetCreatorPayments.
-func (mr *PaymentsRepositoryMockRecorder) GetCreatorPayments(arg0, arg1 interface{}) *gomock.Call {
+func (mr *PaymentsRepositoryMockRecorder) GetCreatorPayments(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCreatorPayments", reflect.TypeOf((*PaymentsRepository)(nil).GetCreatorPayments), arg0, arg1, arg2)
+}
+
+// GetPaymentByID mocks base method.
+func (m *PaymentsRepository) GetPaymentByID(arg0 int64) (models.Payments, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentByID", arg0)
+	ret0, _ := ret[0].(models.Payments)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentByID indicates an expected call of GetPaymentByID.
+func (mr *PaymentsRepositoryMockRecorder) GetPaymentByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentByID", reflect.TypeOf((*PaymentsRepository)(nil).GetPaymentByID), arg0)
+}
+
+// GetPaymentByToken mocks base method.
+func (m *PaymentsRepository) GetPaymentByToken(arg0 string) (models.Payments, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentByToken", arg0)
+	ret0, _ := ret[0].(models.Payments)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentByToken indicates an expected call of GetPaymentByToken.
+func (mr *PaymentsRepositoryMockRecorder) GetPaymentByToken(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCreatorPayments", reflect.TypeOf((*PaymentsRepository)(nil).GetCreatorPayments), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentByToken", reflect.TypeOf((*PaymentsRepository)(nil).GetPaymentByToken), arg0)
 }
 
 // GetUserPayments mocks base method.
-func (m *PaymentsRepository) GetUserPayments(arg0 int64, arg1 *models.Pagination) ([]models.UserPayments, error) {
+func (m *PaymentsRepository) GetUserPayments(arg0 int64, arg1 *models.Pagination, arg2 *models.PaymentsFilter) ([]models.UserPayments, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserPayments", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetUserPayments", arg0, arg1, arg2)
 	ret0, _ := ret[0].([]models.UserPayments)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserPayments indicates an expected call of GetUserPayments.
-func (mr *PaymentsRepositoryMockRecorder) GetUserPayments(arg0, arg1 interface{}) *gomock.Call {
+func (mr *PaymentsRepositoryMockRecorder) GetUserPayments(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPayments", reflect.TypeOf((*PaymentsRepository)(nil).GetUserPayments), arg0, arg1, arg2)
+}
+
+// Refund mocks base method.
+func (m *PaymentsRepository) Refund(arg0 string, arg1 *models.PaymentEvent, arg2 models.Decimal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *PaymentsRepositoryMockRecorder) Refund(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*PaymentsRepository)(nil).Refund), arg0, arg1, arg2)
+}
+
+// UpdateStatus mocks base method.
+func (m *PaymentsRepository) UpdateStatus(arg0, arg1 string, arg2 *models.PaymentEvent, arg3 models.Decimal) (*models.SubscriptionEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.SubscriptionEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *PaymentsRepositoryMockRecorder) UpdateStatus(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPayments", reflect.TypeOf((*PaymentsRepository)(nil).GetUserPayments), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*PaymentsRepository)(nil).UpdateStatus), arg0, arg1, arg2, arg3)
 }
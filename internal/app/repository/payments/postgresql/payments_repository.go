@@ -1,31 +1,128 @@
 package repository_postgresql
 
 import (
+	"database/sql"
 	"fmt"
 	"patreon/internal/app/models"
 	db_models "patreon/internal/app/models"
 	"patreon/internal/app/repository"
 	repository_payments "patreon/internal/app/repository/payments"
+	repository_subscribers "patreon/internal/app/repository/subscribers"
 	putilits "patreon/internal/app/utilits/postgresql"
+	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 
 	"github.com/pkg/errors"
 )
 
 const (
-	querySelectUserPayments = "SELECT p.amount, p.date, p.creator_id, u.nickname, cp.category, cp.description, p.status FROM payments p " +
+	querySelectUserPayments = "SELECT p.payments_id, p.amount, p.currency, p.date, p.creator_id, u.nickname, cp.category, cp.description, p.state, " +
+		"p.type, COALESCE(pc.code, ''), p.discount, COALESCE(g.gifts_id, 0), " +
+		"(CASE WHEN g.recipient_id = $1 THEN gp.nickname ELSE '' END), " +
+		"(CASE WHEN g.payer_id = $1 THEN COALESCE(gr.nickname, '') ELSE '' END) FROM payments p " +
 		"JOIN creator_profile cp on p.creator_id = cp.creator_id " +
-		"JOIN users u on cp.creator_id = u.users_id where p.users_id = $1 " +
-		"ORDER BY p.date DESC "
+		"JOIN users u on cp.creator_id = u.users_id " +
+		"LEFT JOIN promo_codes pc on p.promo_codes_id = pc.promo_codes_id " +
+		"LEFT JOIN gifts g on g.payments_id = p.payments_id " +
+		"LEFT JOIN users gp on g.payer_id = gp.users_id " +
+		"LEFT JOIN users gr on g.recipient_id = gr.users_id " +
+		"where (p.users_id = $1 or (g.recipient_id = $1 and g.status = 'redeemed')) "
 
-	querySelectCreatorPayments = "SELECT p.amount, p.date, p.users_id, u.nickname, p.status FROM payments p " +
-		"JOIN users u on p.users_id = u.users_id where p.creator_id = $1 and p.status = true " +
-		"ORDER BY p.date DESC "
-	queryUpdateStatus    = "UPDATE payments SET status = true WHERE pay_token = $1 RETURNING users_id, creator_id, awards_id;"
+	querySelectCreatorPayments = "SELECT p.payments_id, p.amount, p.currency, p.date, p.users_id, u.nickname, p.state, " +
+		"p.type, COALESCE(pc.code, ''), p.discount, COALESCE(t.tips_id, 0), COALESCE(t.message, ''), " +
+		"p.refunded_amount, COALESCE(p.awards_id, 0), COALESCE(a.name, '') FROM payments p " +
+		"JOIN users u on p.users_id = u.users_id " +
+		"LEFT JOIN promo_codes pc on p.promo_codes_id = pc.promo_codes_id " +
+		"LEFT JOIN awards a on p.awards_id = a.awards_id " +
+		"LEFT JOIN tips t on t.payments_id = p.payments_id where p.creator_id = $1 " +
+		"and p.state in ('succeeded', 'refunded', 'partially_refunded') "
+	queryOrderPayments = "ORDER BY p.date DESC "
+
+	querySelectMonthlyTotals = "SELECT date_trunc('month', p.date), COALESCE(p.awards_id, 0), COALESCE(a.name, ''), " +
+		"p.currency, count(*), sum(p.amount), sum(p.refunded_amount) FROM payments p " +
+		"LEFT JOIN awards a on p.awards_id = a.awards_id where p.creator_id = $1 " +
+		"and p.state in ('succeeded', 'refunded', 'partially_refunded') "
+	queryGroupMonthlyTotals = "GROUP BY 1, 2, 3, 4 ORDER BY 1 DESC, 2, 4"
+
+	queryUpdateStatus = "UPDATE payments SET state = $4, operation_id = $2 WHERE pay_token = $1 and state = $3 " +
+		"RETURNING payments_id, users_id, creator_id, COALESCE(awards_id, 0), amount, type;"
+	queryChangeState = "UPDATE payments SET state = $3 WHERE pay_token = $1 and state = $2 RETURNING payments_id;"
+	queryAddEvent    = "INSERT INTO payment_events (payments_id, from_state, to_state, reason) VALUES ($1, $2, $3, $4);"
+	queryGetEvents   = "SELECT payments_id, from_state, to_state, reason, date FROM payment_events " +
+		"WHERE payments_id = ANY($1) ORDER BY date, id;"
 	queryCountPayments   = "SELECT count(*) from payments where pay_token = $1;"
-	queryGetPayment      = "SELECT amount, date, creator_id, users_id, status from payments where pay_token = $1;"
-	queryUpdateSubscribe = "UPDATE subscribers set status = true where users_id = $1 and creator_id = $2 and awards_id = $3;"
+	queryCountOperations = "SELECT count(*) from payments where operation_id = $1;"
+	queryGetPayment      = "SELECT p.payments_id, p.amount, p.currency, p.date, p.creator_id, p.users_id, p.state, " +
+		"p.refunded_amount, p.type, COALESCE(g.gifts_id, 0), COALESCE(t.tips_id, 0) from payments p " +
+		"LEFT JOIN gifts g on g.payments_id = p.payments_id " +
+		"LEFT JOIN tips t on t.payments_id = p.payments_id where p.pay_token = $1;"
+	queryGetPaymentByID = "SELECT p.payments_id, p.pay_token, p.amount, p.currency, p.date, p.creator_id, p.users_id, " +
+		"p.state, p.refunded_amount, p.type, COALESCE(g.gifts_id, 0), COALESCE(t.tips_id, 0) from payments p " +
+		"LEFT JOIN gifts g on g.payments_id = p.payments_id " +
+		"LEFT JOIN tips t on t.payments_id = p.payments_id where p.payments_id = $1;"
+	// return true if active paid subscription was renewed, false if subscription starts with payment
+	queryUpdateSubscribe = "UPDATE subscribers s SET status = true, grace_until = null, " +
+		"paid_until = (CASE WHEN old.status AND old.paid_until IS NOT NULL THEN old.paid_until ELSE now() END) + " +
+		"make_interval(months => s.period) " +
+		"FROM (SELECT id, status, paid_until FROM subscribers WHERE users_id = $1 and creator_id = $2 " +
+		"and (awards_id = $3 or next_awards_id = $3) ORDER BY id DESC LIMIT 1) old WHERE s.id = old.id " +
+		"RETURNING COALESCE(old.status AND old.paid_until IS NOT NULL, false);"
+	queryUnlockPost = "INSERT INTO post_unlocks (posts_id, users_id, payments_id) " +
+		"SELECT posts_id, users_id, payments_id FROM payments WHERE payments_id = $1 and posts_id IS NOT NULL " +
+		"ON CONFLICT (posts_id, users_id) DO NOTHING;"
+	queryConvertTrial = "UPDATE subscription_trials SET converted_at = now() " +
+		"WHERE users_id = $1 and creator_id = $2 and converted_at IS NULL;"
+	queryGetTierChange = "SELECT id, subscribers_id, from_awards_id, to_awards_id FROM subscription_changes " +
+		"WHERE payments_id = $1 and status = 'pending';"
+	queryApplyUpgrade = "UPDATE subscribers SET awards_id = $3, next_awards_id = NULL " +
+		"WHERE id = $1 and awards_id = $2 and status = true;"
+	queryCloseTierChange  = "UPDATE subscription_changes SET status = $2 WHERE id = $1;"
+	queryCancelDowngrades = "UPDATE subscription_changes SET status = 'cancelled' " +
+		"WHERE subscribers_id = $1 and kind = 'downgrade' and status = 'pending';"
+	queryAddPostings = "INSERT INTO ledger_entries (creator_id, operation, account, amount, payments_id) " +
+		"VALUES ($1, $2, 'payer', $3, $6), ($1, $2, 'creator', $4, $6), ($1, $2, 'platform', $5, $6);"
+	queryRefund = "UPDATE payments SET state = $3, refunded_amount = refunded_amount + $4 " +
+		"WHERE pay_token = $1 and state = $2 and refunded_amount + $4 <= amount " +
+		"RETURNING payments_id, creator_id, amount, refunded_amount;"
+	queryPayGift = "UPDATE gifts SET status = (CASE WHEN recipient_id IS NULL THEN 'paid' ELSE 'redeemed' END), " +
+		"redeemed_at = (CASE WHEN recipient_id IS NULL THEN NULL ELSE now() END) " +
+		"WHERE payments_id = $1 and status = 'pending' " +
+		"RETURNING COALESCE(recipient_id, 0), creator_id, awards_id, periods;"
+	queryExtendGiftSubscription = "UPDATE subscribers SET grace_until = null, " +
+		"paid_until = GREATEST(paid_until, now()) + make_interval(months => $4) " +
+		"WHERE id = (SELECT id FROM subscribers WHERE users_id = $1 and creator_id = $2 and awards_id = $3 " +
+		"and status = true ORDER BY id DESC LIMIT 1);"
+	queryAddGiftSubscription = "INSERT INTO subscribers(users_id, creator_id, awards_id, status, paid_until) " +
+		"VALUES ($1, $2, $3, true, now() + make_interval(months => $4));"
+	// gift which can not be granted stays paid, so it can be redeemed by its code later
+	queryUnredeemGift  = "UPDATE gifts SET status = 'paid', redeemed_at = NULL WHERE payments_id = $1;"
+	queryIsBlocked     = "SELECT count(*) FROM blocked_users WHERE creator_id = $1 AND users_id = $2"
+	queryGetPaymentFee = "SELECT COALESCE(SUM(amount) FILTER (WHERE operation = 'payment'), 0), COALESCE(SUM(amount), 0) " +
+		"FROM ledger_entries WHERE payments_id = $1 and account = 'platform';"
+	// subscription of checkout expired by sweeper is removed, so late payment of it subscribes again
+	queryRestoreSubscribe = "INSERT INTO subscribers(users_id, creator_id, awards_id, status, period, paid_until) " +
+		"SELECT $1, $2, $3, true, period, now() + make_interval(months => period) FROM payments WHERE payments_id = $4;"
+
+	// renewals and upgrades are paid while subscription is active, they must not be expired as checkouts
+	queryGetAbandonedCheckouts = "SELECT p.payments_id, p.pay_token, p.state, p.type, p.users_id, p.creator_id, " +
+		"COALESCE(p.awards_id, 0), p.date, p.type = 'subscription' and EXISTS (SELECT 1 FROM subscribers s " +
+		"WHERE s.users_id = p.users_id and s.creator_id = p.creator_id and s.awards_id = p.awards_id " +
+		"and s.status = false and s.paid_until IS NULL) FROM payments p " +
+		"WHERE p.state in ('created', 'pending', 'failed') and p.date <= $1 and p.pay_token IS NOT NULL " +
+		"and NOT (p.type = 'subscription' and EXISTS (SELECT 1 FROM subscribers s " +
+		"WHERE s.users_id = p.users_id and s.creator_id = p.creator_id and s.status = true)) " +
+		"ORDER BY p.date, p.payments_id;"
+	queryExpireCheckout = "UPDATE payments SET state = $3 WHERE payments_id = $1 and state = $2;"
+	// the oldest one is removed, because newer subscription could belong to checkout which is not expired yet
+	queryRemovePendingSubscribe = "DELETE FROM subscribers WHERE id = (SELECT id FROM subscribers " +
+		"WHERE users_id = $1 and creator_id = $2 and awards_id = $3 and status = false and paid_until IS NULL " +
+		"ORDER BY id LIMIT 1);"
+
+	giftEventReason = "gift"
+
+	codeDuplicateVal = "23505"
 )
 
 type PaymentsRepository struct {
@@ -38,15 +135,44 @@ func NewPaymentsRepository(store *sqlx.DB) *PaymentsRepository {
 	}
 }
 
+// addPaymentsFilter append conditions of filter to query of payments p, their args are added after args
+func addPaymentsFilter(query string, args []interface{}, filter *models.PaymentsFilter) (string, []interface{}) {
+	if filter == nil {
+		return query, args
+	}
+	if !filter.From.IsZero() {
+		args = append(args, filter.From)
+		query += fmt.Sprintf("and p.date >= $%d ", len(args))
+	}
+	if !filter.To.IsZero() {
+		args = append(args, filter.To)
+		query += fmt.Sprintf("and p.date < $%d ", len(args))
+	}
+	if filter.AwardID != 0 {
+		args = append(args, filter.AwardID)
+		query += fmt.Sprintf("and p.awards_id = $%d ", len(args))
+	}
+	if len(filter.States) != 0 {
+		states := make([]string, 0, len(filter.States))
+		for _, state := range filter.States {
+			states = append(states, string(state))
+		}
+		args = append(args, pq.Array(states))
+		query += fmt.Sprintf("and p.state = ANY($%d) ", len(args))
+	}
+	return query, args
+}
+
 // GetUserPayments Errors:
 //		repository.NotFound
 //		app.GeneralError with Errors:
 //			repository.DefaultErrDB
-func (repo *PaymentsRepository) GetUserPayments(userID int64, pag *db_models.Pagination) ([]models.UserPayments, error) {
-	query := querySelectUserPayments
+func (repo *PaymentsRepository) GetUserPayments(userID int64, pag *db_models.Pagination,
+	filter *models.PaymentsFilter) ([]models.UserPayments, error) {
+	query, args := addPaymentsFilter(querySelectUserPayments, []interface{}{userID}, filter)
 
 	limit, offset, err := putilits.AddPagination("payments", pag, repo.store)
-	query = query + fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
+	query = query + queryOrderPayments + fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
 
 	if err != nil {
 		return nil, err
@@ -55,7 +181,7 @@ func (repo *PaymentsRepository) GetUserPayments(userID int64, pag *db_models.Pag
 		return nil, repository.NotFound
 	}
 
-	rows, err := repo.store.Query(query, userID)
+	rows, err := repo.store.Query(query, args...)
 	if err != nil {
 		return nil, repository.NewDBError(err)
 	}
@@ -64,8 +190,9 @@ func (repo *PaymentsRepository) GetUserPayments(userID int64, pag *db_models.Pag
 
 	for rows.Next() {
 		cur := models.UserPayments{}
-		if err = rows.Scan(&cur.Amount, &cur.Date, &cur.CreatorID,
-			&cur.CreatorNickname, &cur.CreatorCategory, &cur.CreatorDescription, &cur.Status); err != nil {
+		if err = rows.Scan(&cur.ID, &cur.Amount, &cur.Currency, &cur.Date, &cur.CreatorID,
+			&cur.CreatorNickname, &cur.CreatorCategory, &cur.CreatorDescription, &cur.State, &cur.Type,
+			&cur.PromoCode, &cur.Discount, &cur.GiftID, &cur.GiftFrom, &cur.GiftTo); err != nil {
 
 			_ = rows.Close()
 			return nil, repository.NewDBError(errors.Wrapf(err, "method - GetUserPayments"+
@@ -78,6 +205,18 @@ func (repo *PaymentsRepository) GetUserPayments(userID int64, pag *db_models.Pag
 		return nil, repository.NewDBError(err)
 	}
 
+	ids := make([]int64, 0, len(paymentsRes))
+	for _, payment := range paymentsRes {
+		ids = append(ids, payment.ID)
+	}
+	events, err := repo.getEvents(ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range paymentsRes {
+		paymentsRes[i].Events = events[paymentsRes[i].ID]
+	}
+
 	return paymentsRes, nil
 }
 
@@ -85,11 +224,12 @@ func (repo *PaymentsRepository) GetUserPayments(userID int64, pag *db_models.Pag
 //		repository.NotFound
 //		app.GeneralError with Errors:
 //			repository.DefaultErrDB
-func (repo *PaymentsRepository) GetCreatorPayments(creatorID int64, pag *db_models.Pagination) ([]models.CreatorPayments, error) {
-	query := querySelectCreatorPayments
+func (repo *PaymentsRepository) GetCreatorPayments(creatorID int64, pag *db_models.Pagination,
+	filter *models.PaymentsFilter) ([]models.CreatorPayments, error) {
+	query, args := addPaymentsFilter(querySelectCreatorPayments, []interface{}{creatorID}, filter)
 
 	limit, offset, err := putilits.AddPagination("payments", pag, repo.store)
-	query = query + fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
+	query = query + queryOrderPayments + fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
 
 	if err != nil {
 		return nil, err
@@ -98,7 +238,7 @@ func (repo *PaymentsRepository) GetCreatorPayments(creatorID int64, pag *db_mode
 		return nil, repository.NotFound
 	}
 
-	rows, err := repo.store.Query(query, creatorID)
+	rows, err := repo.store.Query(query, args...)
 	if err != nil {
 		return nil, repository.NewDBError(err)
 	}
@@ -107,10 +247,9 @@ func (repo *PaymentsRepository) GetCreatorPayments(creatorID int64, pag *db_mode
 
 	for rows.Next() {
 		cur := models.CreatorPayments{}
-		if err = rows.Scan(&cur.Amount, &cur.Date, &cur.UserID, &cur.UserNickname, &cur.Status); err != nil {
+		if err = scanCreatorPayment(rows, &cur); err != nil {
 			_ = rows.Close()
-			return nil, repository.NewDBError(errors.Wrapf(err, "method - GetUserPayments"+
-				"invalid data in db: table payments"))
+			return nil, err
 		}
 		paymentsRes = append(paymentsRes, cur)
 	}
@@ -119,24 +258,386 @@ func (repo *PaymentsRepository) GetCreatorPayments(creatorID int64, pag *db_mode
 		return nil, repository.NewDBError(err)
 	}
 
+	ids := make([]int64, 0, len(paymentsRes))
+	for _, payment := range paymentsRes {
+		ids = append(ids, payment.ID)
+	}
+	events, err := repo.getEvents(ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range paymentsRes {
+		paymentsRes[i].Events = events[paymentsRes[i].ID]
+	}
+
 	return paymentsRes, nil
 }
 
-// UpdateStatus Errors:
+// ExportCreatorPayments call handle for each creator payment from filter, the last first.
+// Payments are read one by one without history of states, so whole result is never kept in memory.
+// Export is stopped by the first error of handle, it is returned as is
+// Errors:
 //		app.GeneralError with Errors:
 //			repository.DefaultErrDB
-func (repo *PaymentsRepository) UpdateStatus(token string) error {
-	begin, err := repo.store.Begin()
+func (repo *PaymentsRepository) ExportCreatorPayments(creatorID int64, filter *models.PaymentsFilter,
+	handle func(payment *models.CreatorPayments) error) error {
+	query, args := addPaymentsFilter(querySelectCreatorPayments, []interface{}{creatorID}, filter)
+
+	rows, err := repo.store.Query(query+queryOrderPayments, args...)
 	if err != nil {
 		return repository.NewDBError(err)
 	}
+
+	cur := &models.CreatorPayments{}
+	for rows.Next() {
+		if err = scanCreatorPayment(rows, cur); err != nil {
+			_ = rows.Close()
+			return err
+		}
+		if err = handle(cur); err != nil {
+			_ = rows.Close()
+			return err
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return repository.NewDBError(err)
+	}
+	return nil
+}
+
+// scanCreatorPayment Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func scanCreatorPayment(rows *sql.Rows, cur *models.CreatorPayments) error {
+	if err := rows.Scan(&cur.ID, &cur.Amount, &cur.Currency, &cur.Date, &cur.UserID, &cur.UserNickname,
+		&cur.State, &cur.Type, &cur.PromoCode, &cur.Discount, &cur.TipID, &cur.TipMessage, &cur.RefundedAmount,
+		&cur.AwardID, &cur.AwardName); err != nil {
+		return repository.NewDBError(errors.Wrapf(err, "method - scanCreatorPayment"+
+			"invalid data in db: table payments"))
+	}
+	return nil
+}
+
+// GetCreatorMonthlyTotals return sums of paid creator payments from filter by months and awards, the last month first
+// Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PaymentsRepository) GetCreatorMonthlyTotals(creatorID int64,
+	filter *models.PaymentsFilter) ([]models.PaymentsMonthTotal, error) {
+	query, args := addPaymentsFilter(querySelectMonthlyTotals, []interface{}{creatorID}, filter)
+
+	rows, err := repo.store.Query(query+queryGroupMonthlyTotals, args...)
+	if err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	res := make([]models.PaymentsMonthTotal, 0)
+	for rows.Next() {
+		cur := models.PaymentsMonthTotal{}
+		if err = rows.Scan(&cur.Month, &cur.AwardID, &cur.AwardName, &cur.Currency, &cur.Count,
+			&cur.Amount, &cur.Refunded); err != nil {
+			_ = rows.Close()
+			return nil, repository.NewDBError(err)
+		}
+		res = append(res, cur)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, repository.NewDBError(err)
+	}
+	return res, nil
+}
+
+// getEvents return state history of each payment from ids
+// Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PaymentsRepository) getEvents(ids []int64) (map[int64][]models.PaymentEvent, error) {
+	res := map[int64][]models.PaymentEvent{}
+	if len(ids) == 0 {
+		return res, nil
+	}
+
+	rows, err := repo.store.Query(queryGetEvents, pq.Array(ids))
+	if err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	for rows.Next() {
+		var paymentID int64
+		cur := models.PaymentEvent{}
+		if err = rows.Scan(&paymentID, &cur.FromState, &cur.ToState, &cur.Reason, &cur.Date); err != nil {
+			_ = rows.Close()
+			return nil, repository.NewDBError(errors.Wrapf(err, "method - getEvents"+
+				"invalid data in db: table payment_events"))
+		}
+		res[paymentID] = append(res[paymentID], cur)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, repository.NewDBError(err)
+	}
+	return res, nil
+}
+
+// UpdateStatus move payment with token from event.FromState to event.ToState, save operationID,
+// credit creator balance with payment amount minus platform fee
+// and renew subscription of payment, apply tier upgrade or gift paid by it.
+// Tip changes only balance, unlock opens post for payer.
+// Return event of subscription changed by payment, nil if payment did not change any subscription
+// Operation id is unique, so the same operation can not be applied to two payments.
+// If paid tier change is cancelled, payment is saved as succeeded and TierChangeCancelled is returned
+// Errors:
+//		repository_payments.PaymentStateChanged
+//		repository_payments.OperationAlreadyProcessed
+//		repository_payments.TierChangeCancelled
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PaymentsRepository) UpdateStatus(token string, operationID string, event *models.PaymentEvent,
+	fee models.Decimal) (*models.SubscriptionEvent, error) {
+	begin, err := repo.store.Begin()
+	if err != nil {
+		return nil, repository.NewDBError(err)
+	}
+	var paymentID int64
+	var amount models.Decimal
+	var paymentType models.PaymentType
 	awardsID, usersID, creatorID := 0, 0, 0
-	err = repo.store.QueryRow(queryUpdateStatus, token).Scan(&usersID, &creatorID, &awardsID)
+	err = begin.QueryRow(queryUpdateStatus, token, operationID, event.FromState, event.ToState).
+		Scan(&paymentID, &usersID, &creatorID, &awardsID, &amount, &paymentType)
+	if err != nil {
+		_ = begin.Rollback()
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository_payments.PaymentStateChanged
+		}
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == codeDuplicateVal {
+			return nil, repository_payments.OperationAlreadyProcessed
+		}
+		return nil, repository.NewDBError(err)
+	}
+	_, err = begin.Exec(queryAddEvent, paymentID, event.FromState, event.ToState, event.Reason)
+	if err != nil {
+		_ = begin.Rollback()
+		return nil, repository.NewDBError(err)
+	}
+	_, err = begin.Exec(queryAddPostings, creatorID, models.OperationPayment, -amount, amount-fee, fee, paymentID)
+	if err != nil {
+		_ = begin.Rollback()
+		return nil, repository.NewDBError(err)
+	}
+	// tip and unlock have not award, so they do not change any subscription
+	if paymentType == models.PaymentUnlock {
+		if _, err = begin.Exec(queryUnlockPost, paymentID); err != nil {
+			_ = begin.Rollback()
+			return nil, repository.NewDBError(err)
+		}
+	}
+	if paymentType == models.PaymentTip || paymentType == models.PaymentUnlock {
+		if err = begin.Commit(); err != nil {
+			return nil, repository.NewDBError(err)
+		}
+		return nil, nil
+	}
+
+	subscrEvent := &models.SubscriptionEvent{UserID: int64(usersID), CreatorID: int64(creatorID),
+		AwardID: int64(awardsID)}
+	isTierChange, err := repo.applyTierChange(begin, paymentID, subscrEvent)
+	if err != nil {
+		_ = begin.Rollback()
+		return nil, err
+	}
+	isGift, err := repo.applyGift(begin, paymentID, subscrEvent)
+	if err != nil {
+		_ = begin.Rollback()
+		return nil, err
+	}
+	if !isTierChange && !isGift {
+		renewed, subscribed := false, true
+		err = begin.QueryRow(queryUpdateSubscribe, usersID, creatorID, awardsID).Scan(&renewed)
+		if errors.Is(err, sql.ErrNoRows) {
+			subscribed, err = repo.restoreSubscription(begin, paymentID, int64(usersID), int64(creatorID),
+				int64(awardsID))
+		} else if err != nil {
+			err = repository.NewDBError(err)
+		}
+		if err != nil {
+			_ = begin.Rollback()
+			return nil, err
+		}
+		if subscribed {
+			subscrEvent.Kind = models.SubscriptionEventSubscribed
+		}
+		if renewed {
+			subscrEvent.Kind = models.SubscriptionEventRenewed
+		}
+		// the first payment of subscriber after free trial converts it
+		_, err = begin.Exec(queryConvertTrial, usersID, creatorID)
+		if err != nil {
+			_ = begin.Rollback()
+			return nil, repository.NewDBError(err)
+		}
+	}
+
+	if err = begin.Commit(); err != nil {
+		return nil, repository.NewDBError(err)
+	}
+	// stale or sold out tier change is closed, but its payment is already received
+	if isTierChange && subscrEvent.Kind == "" {
+		return nil, repository_payments.TierChangeCancelled
+	}
+	if subscrEvent.Kind == "" {
+		return nil, nil
+	}
+	return subscrEvent, nil
+}
+
+// ChangeState move payment with token from event.FromState to event.ToState
+// Errors:
+//		repository_payments.PaymentStateChanged
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PaymentsRepository) ChangeState(token string, event *models.PaymentEvent) error {
+	begin, err := repo.store.Begin()
+	if err != nil {
+		return repository.NewDBError(err)
+	}
+	var paymentID int64
+	err = begin.QueryRow(queryChangeState, token, event.FromState, event.ToState).Scan(&paymentID)
 	if err != nil {
+		_ = begin.Rollback()
+		if errors.Is(err, sql.ErrNoRows) {
+			return repository_payments.PaymentStateChanged
+		}
+		return repository.NewDBError(err)
+	}
+	_, err = begin.Exec(queryAddEvent, paymentID, event.FromState, event.ToState, event.Reason)
+	if err != nil {
+		_ = begin.Rollback()
+		return repository.NewDBError(err)
+	}
+
+	if err = begin.Commit(); err != nil {
+		return repository.NewDBError(err)
+	}
+	return nil
+}
+
+// GetAbandonedCheckouts return not paid payments created before createdBefore, the oldest first
+// Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PaymentsRepository) GetAbandonedCheckouts(createdBefore time.Time) ([]models.AbandonedCheckout, error) {
+	rows, err := repo.store.Query(queryGetAbandonedCheckouts, createdBefore)
+	if err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	res := make([]models.AbandonedCheckout, 0)
+	for rows.Next() {
+		cur := models.AbandonedCheckout{}
+		if err = rows.Scan(&cur.PaymentID, &cur.Token, &cur.State, &cur.Type, &cur.UserID, &cur.CreatorID,
+			&cur.AwardID, &cur.Date, &cur.HasPendingSubscription); err != nil {
+			_ = rows.Close()
+			return nil, repository.NewDBError(err)
+		}
+		res = append(res, cur)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, repository.NewDBError(err)
+	}
+	return res, nil
+}
+
+// ExpireCheckout move abandoned payment to expired state and remove not paid subscription created with it.
+// Return count of removed subscriptions
+// Errors:
+//		repository_payments.PaymentStateChanged
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PaymentsRepository) ExpireCheckout(checkout *models.AbandonedCheckout, reason string) (int64, error) {
+	begin, err := repo.store.Begin()
+	if err != nil {
+		return 0, repository.NewDBError(err)
+	}
+	res, err := begin.Exec(queryExpireCheckout, checkout.PaymentID, checkout.State, models.PaymentExpired)
+	if err != nil {
+		_ = begin.Rollback()
+		return 0, repository.NewDBError(err)
+	}
+	if cnt, err := res.RowsAffected(); err != nil || cnt != 1 {
+		_ = begin.Rollback()
+		if err != nil {
+			return 0, repository.NewDBError(err)
+		}
+		return 0, repository_payments.PaymentStateChanged
+	}
+	_, err = begin.Exec(queryAddEvent, checkout.PaymentID, checkout.State, models.PaymentExpired, reason)
+	if err != nil {
+		_ = begin.Rollback()
+		return 0, repository.NewDBError(err)
+	}
+
+	var removed int64
+	if checkout.Type == models.PaymentSubscription {
+		res, err = begin.Exec(queryRemovePendingSubscribe, checkout.UserID, checkout.CreatorID, checkout.AwardID)
+		if err != nil {
+			_ = begin.Rollback()
+			return 0, repository.NewDBError(err)
+		}
+		if removed, err = res.RowsAffected(); err != nil {
+			_ = begin.Rollback()
+			return 0, repository.NewDBError(err)
+		}
+	}
+
+	if err = begin.Commit(); err != nil {
+		return 0, repository.NewDBError(err)
+	}
+	return removed, nil
+}
+
+// Refund move payment with token from event.FromState to event.ToState and return amount to payer.
+// Platform fee is returned in proportion to refunded amount, the rest is debited from creator balance
+// Errors:
+//		repository_payments.PaymentStateChanged
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PaymentsRepository) Refund(token string, event *models.PaymentEvent, amount models.Decimal) error {
+	begin, err := repo.store.Begin()
+	if err != nil {
+		return repository.NewDBError(err)
+	}
+	var paymentID, creatorID int64
+	var paymentAmount, refunded models.Decimal
+	err = begin.QueryRow(queryRefund, token, event.FromState, event.ToState, amount).
+		Scan(&paymentID, &creatorID, &paymentAmount, &refunded)
+	if err != nil {
+		_ = begin.Rollback()
+		if errors.Is(err, sql.ErrNoRows) {
+			return repository_payments.PaymentStateChanged
+		}
+		return repository.NewDBError(err)
+	}
+	_, err = begin.Exec(queryAddEvent, paymentID, event.FromState, event.ToState, event.Reason)
+	if err != nil {
+		_ = begin.Rollback()
+		return repository.NewDBError(err)
+	}
+
+	var fee, feeLeft models.Decimal
+	if err = begin.QueryRow(queryGetPaymentFee, paymentID).Scan(&fee, &feeLeft); err != nil {
 		_ = begin.Rollback()
 		return repository.NewDBError(err)
 	}
-	_, err = repo.store.Exec(queryUpdateSubscribe, usersID, creatorID, awardsID)
+	// last refund takes all fee left, so rounding of partial refunds does not stay on platform account
+	refundFee := feeLeft
+	if refunded < paymentAmount && paymentAmount != 0 {
+		refundFee = fee * amount / paymentAmount
+	}
+	_, err = begin.Exec(queryAddPostings, creatorID, models.OperationRefund, amount, refundFee-amount,
+		-refundFee, paymentID)
 	if err != nil {
 		_ = begin.Rollback()
 		return repository.NewDBError(err)
@@ -149,6 +650,7 @@ func (repo *PaymentsRepository) UpdateStatus(token string) error {
 }
 
 // CheckCountPaymentsByToken Errors:
+//		repository.NotFound
 //		repository_payments.CountPaymentsByTokenError
 //		app.GeneralError with Errors:
 //			repository.DefaultErrDB
@@ -158,21 +660,191 @@ func (repo *PaymentsRepository) CheckCountPaymentsByToken(token string) error {
 	if err != nil {
 		return repository.NewDBError(err)
 	}
+	if count == 0 {
+		return repository.NotFound
+	}
 	if count != 1 {
 		return repository_payments.CountPaymentsByTokenError
 	}
 	return nil
 }
 
+// CheckOperationProcessed Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PaymentsRepository) CheckOperationProcessed(operationID string) (bool, error) {
+	count := 0
+	err := repo.store.QueryRow(queryCountOperations, operationID).Scan(&count)
+	if err != nil {
+		return false, repository.NewDBError(err)
+	}
+	return count != 0, nil
+}
+
 // GetPaymentByToken Errors:
+//		repository.NotFound
 //		app.GeneralError with Errors:
 //			repository.DefaultErrDB
 func (repo *PaymentsRepository) GetPaymentByToken(token string) (models.Payments, error) {
+	res := models.Payments{Token: token}
+	err := repo.store.QueryRow(queryGetPayment, token).Scan(&res.ID, &res.Amount, &res.Currency, &res.Date, &res.CreatorID,
+		&res.UserID, &res.State, &res.RefundedAmount, &res.Type, &res.GiftID, &res.TipID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return res, repository.NotFound
+		}
+		return res, repository.NewDBError(err)
+	}
+	return res, nil
+}
+
+// GetPaymentByID Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PaymentsRepository) GetPaymentByID(paymentID int64) (models.Payments, error) {
 	res := models.Payments{}
-	err := repo.store.QueryRow(queryGetPayment, token).Scan(&res.Amount, &res.Date, &res.CreatorID, &res.UserID,
-		&res.Status)
+	err := repo.store.QueryRow(queryGetPaymentByID, paymentID).Scan(&res.ID, &res.Token, &res.Amount, &res.Currency,
+		&res.Date, &res.CreatorID, &res.UserID, &res.State, &res.RefundedAmount, &res.Type, &res.GiftID, &res.TipID)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return res, repository.NotFound
+		}
 		return res, repository.NewDBError(err)
 	}
 	return res, nil
 }
+
+// restoreSubscription subscribe user again for period of payment if subscription was removed with expired checkout,
+// return false if award has no free seat for it
+// Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PaymentsRepository) restoreSubscription(tx *sql.Tx, paymentID int64, userID int64, creatorID int64,
+	awardID int64) (bool, error) {
+	if err := repository_subscribers.TakeSeat(tx, userID, awardID, time.Now()); err != nil {
+		if err == repository_subscribers.AwardSoldOut {
+			return false, nil
+		}
+		return false, err
+	}
+	if _, err := tx.Exec(queryRestoreSubscribe, userID, creatorID, awardID, paymentID); err != nil {
+		return false, repository.NewDBError(err)
+	}
+	return true, nil
+}
+
+// applyTierChange move subscription to upgraded award if payment was created for tier upgrade
+// and fill subscrEvent by it. Upgrade is cancelled if subscription award was changed after payment creation
+// or upgraded award has no free seat, subscrEvent is not filled then.
+// Return false if payment is not for tier change
+// Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PaymentsRepository) applyTierChange(tx *sql.Tx, paymentID int64,
+	subscrEvent *models.SubscriptionEvent) (bool, error) {
+	var changeID, subscriptionID, fromAwardID, toAwardID int64
+	err := tx.QueryRow(queryGetTierChange, paymentID).Scan(&changeID, &subscriptionID, &fromAwardID, &toAwardID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, repository.NewDBError(err)
+	}
+
+	var cnt int64
+	err = repository_subscribers.TakeSeat(tx, subscrEvent.UserID, toAwardID, time.Now())
+	if err != nil && err != repository_subscribers.AwardSoldOut {
+		return false, err
+	}
+	if err == nil {
+		res, err := tx.Exec(queryApplyUpgrade, subscriptionID, fromAwardID, toAwardID)
+		if err != nil {
+			return false, repository.NewDBError(err)
+		}
+		if cnt, err = res.RowsAffected(); err != nil {
+			return false, repository.NewDBError(err)
+		}
+	}
+
+	status := models.TierChangeCancelled
+	if cnt != 0 {
+		status = models.TierChangeApplied
+		if _, err = tx.Exec(queryCancelDowngrades, subscriptionID); err != nil {
+			return false, repository.NewDBError(err)
+		}
+		subscrEvent.AwardID = toAwardID
+		subscrEvent.Kind = models.SubscriptionEventTierChanged
+		subscrEvent.Reason = string(models.TierUpgrade)
+	}
+	if _, err = tx.Exec(queryCloseTierChange, changeID, status); err != nil {
+		return false, repository.NewDBError(err)
+	}
+	return true, nil
+}
+
+// applyGift mark gift bought with payment as paid. Gift bought for nickname is redeemed at once,
+// subscription on gift award is granted to recipient and subscrEvent is filled by it.
+// If recipient was blocked by creator or award has no free seat for new subscription of recipient,
+// gift stays paid and can be redeemed by code.
+// Return false if payment is not for gift
+// Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PaymentsRepository) applyGift(tx *sql.Tx, paymentID int64,
+	subscrEvent *models.SubscriptionEvent) (bool, error) {
+	var recipientID, creatorID, awardID, periods int64
+	err := tx.QueryRow(queryPayGift, paymentID).Scan(&recipientID, &creatorID, &awardID, &periods)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, repository.NewDBError(err)
+	}
+	if recipientID == 0 {
+		return true, nil
+	}
+
+	var blocked int64
+	if err = tx.QueryRow(queryIsBlocked, creatorID, recipientID).Scan(&blocked); err != nil {
+		return false, repository.NewDBError(err)
+	}
+	if blocked != 0 {
+		if _, err = tx.Exec(queryUnredeemGift, paymentID); err != nil {
+			return false, repository.NewDBError(err)
+		}
+		return true, nil
+	}
+
+	res, err := tx.Exec(queryExtendGiftSubscription, recipientID, creatorID, awardID, periods)
+	if err != nil {
+		return false, repository.NewDBError(err)
+	}
+	cnt, err := res.RowsAffected()
+	if err != nil {
+		return false, repository.NewDBError(err)
+	}
+	kind := models.SubscriptionEventRenewed
+	if cnt == 0 {
+		err = repository_subscribers.TakeSeat(tx, recipientID, awardID, time.Now())
+		if err == repository_subscribers.AwardSoldOut {
+			if _, err = tx.Exec(queryUnredeemGift, paymentID); err != nil {
+				return false, repository.NewDBError(err)
+			}
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if _, err = tx.Exec(queryAddGiftSubscription, recipientID, creatorID, awardID, periods); err != nil {
+			return false, repository.NewDBError(err)
+		}
+		kind = models.SubscriptionEventSubscribed
+	}
+	subscrEvent.Kind = kind
+	subscrEvent.UserID = recipientID
+	subscrEvent.CreatorID = creatorID
+	subscrEvent.AwardID = awardID
+	subscrEvent.Reason = giftEventReason
+	return true, nil
+}
@@ -1,12 +1,18 @@
 package repository_postgresql
 
 import (
+	"database/sql"
 	"fmt"
 	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_payments "patreon/internal/app/repository/payments"
+	repository_subscribers "patreon/internal/app/repository/subscribers"
 	putilits "patreon/internal/app/utilits/postgresql"
 	"regexp"
 	"testing"
+	"time"
 
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	sqlmock "github.com/zhashkevych/go-sqlxmock"
 
@@ -34,6 +40,13 @@ func (s *SuitePaymentsRepository) TestPaymentsRepository_GetUserPayments_OK() {
 
 	payment := models.TestPayment()
 	payment.UserID = 0
+	payment.ID = 3
+	payment.State = models.PaymentSucceeded
+	payment.PromoCode = "GOLD50"
+	payment.Discount = 50
+	event := models.PaymentEvent{FromState: models.PaymentPending, ToState: models.PaymentSucceeded,
+		Reason: "payment notification", Date: payment.Date}
+	payment.Events = []models.PaymentEvent{event}
 	creator := models.TestCreator()
 	userId := int64(5)
 
@@ -43,7 +56,7 @@ func (s *SuitePaymentsRepository) TestPaymentsRepository_GetUserPayments_OK() {
 		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).
 			AddRow(int64(5000)))
 	limit, offset, err := putilits.AddPagination(tableName, pag, s.DB)
-	query := querySelectUserPayments + fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
+	query := querySelectUserPayments + queryOrderPayments + fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
 
 	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
 		WithArgs(tableName).
@@ -52,8 +65,13 @@ func (s *SuitePaymentsRepository) TestPaymentsRepository_GetUserPayments_OK() {
 
 	s.Mock.ExpectQuery(regexp.QuoteMeta(query)).
 		WithArgs(userId).
-		WillReturnRows(sqlmock.NewRows([]string{"p.amount", "p.date", "p.creator_id", "u.nickname", "cp.category", "cp.description", "status"}).
-			AddRow(payment.Amount, payment.Date, payment.CreatorID, creator.Nickname, creator.Category, creator.Description, payment.Status))
+		WillReturnRows(sqlmock.NewRows([]string{"p.payments_id", "p.amount", "p.currency", "p.date", "p.creator_id", "u.nickname", "cp.category", "cp.description", "state", "type", "code", "p.discount", "gifts_id", "gift_from", "gift_to"}).
+			AddRow(payment.ID, payment.Amount, payment.Currency, payment.Date, payment.CreatorID, creator.Nickname, creator.Category, creator.Description, payment.State,
+				payment.Type, payment.PromoCode, payment.Discount, 0, "", ""))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetEvents)).
+		WithArgs(pq.Array([]int64{payment.ID})).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id", "from_state", "to_state", "reason", "date"}).
+			AddRow(payment.ID, event.FromState, event.ToState, event.Reason, event.Date))
 	expRes := []models.UserPayments{
 		{
 			Payments:           *payment,
@@ -62,7 +80,7 @@ func (s *SuitePaymentsRepository) TestPaymentsRepository_GetUserPayments_OK() {
 			CreatorDescription: creator.Description,
 		},
 	}
-	res, err := s.repo.GetUserPayments(userId, pag)
+	res, err := s.repo.GetUserPayments(userId, pag, nil)
 
 	require.NoError(s.T(), err)
 	assert.Equal(s.T(), expRes[0].Payments, res[0].Payments)
@@ -75,6 +93,11 @@ func (s *SuitePaymentsRepository) TestPaymentsRepository_GetCreatorPayments_OK()
 
 	payment := models.TestPayment()
 	payment.CreatorID = 0
+	payment.ID = 3
+	payment.State = models.PaymentSucceeded
+	event := models.PaymentEvent{FromState: models.PaymentPending, ToState: models.PaymentSucceeded,
+		Reason: "payment notification", Date: payment.Date}
+	payment.Events = []models.PaymentEvent{event}
 	user := models.TestUser()
 	creatorId := int64(5)
 
@@ -84,7 +107,7 @@ func (s *SuitePaymentsRepository) TestPaymentsRepository_GetCreatorPayments_OK()
 		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).
 			AddRow(int64(5000)))
 	limit, offset, err := putilits.AddPagination(tableName, pag, s.DB)
-	query := querySelectCreatorPayments + fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
+	query := querySelectCreatorPayments + queryOrderPayments + fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
 
 	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
 		WithArgs(tableName).
@@ -93,20 +116,714 @@ func (s *SuitePaymentsRepository) TestPaymentsRepository_GetCreatorPayments_OK()
 
 	s.Mock.ExpectQuery(regexp.QuoteMeta(query)).
 		WithArgs(creatorId).
-		WillReturnRows(sqlmock.NewRows([]string{"p.amount", "p.date", "p.users_id", "u.nickname", "status"}).
-			AddRow(payment.Amount, payment.Date, payment.UserID, user.Nickname, payment.Status))
+		WillReturnRows(sqlmock.NewRows([]string{"p.payments_id", "p.amount", "p.currency", "p.date", "p.users_id", "u.nickname", "state", "type", "code", "p.discount", "tips_id", "message", "p.refunded_amount", "awards_id", "a.name"}).
+			AddRow(payment.ID, payment.Amount, payment.Currency, payment.Date, payment.UserID, user.Nickname, payment.State,
+				payment.Type, "", 0, payment.TipID, "", payment.RefundedAmount, int64(0), ""))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetEvents)).
+		WithArgs(pq.Array([]int64{payment.ID})).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id", "from_state", "to_state", "reason", "date"}).
+			AddRow(payment.ID, event.FromState, event.ToState, event.Reason, event.Date))
 	expRes := []models.CreatorPayments{
 		{
 			Payments:     *payment,
 			UserNickname: user.Nickname,
 		},
 	}
-	res, err := s.repo.GetCreatorPayments(creatorId, pag)
+	res, err := s.repo.GetCreatorPayments(creatorId, pag, nil)
 
 	require.NoError(s.T(), err)
 	assert.Equal(s.T(), expRes[0].Payments, res[0].Payments)
 
 }
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_GetCreatorPayments_Filter() {
+	queryStat := "SELECT n_live_tup FROM pg_stat_all_tables WHERE relname = $1"
+	tableName := "payments"
+	creatorId := int64(5)
+	from := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	filter := &models.PaymentsFilter{From: from, To: from.AddDate(0, 1, 0), AwardID: 2,
+		States: []models.PaymentState{models.PaymentRefunded}}
+
+	pag := &models.Pagination{Limit: 10, Offset: 20}
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
+		WithArgs(tableName).
+		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).
+			AddRow(int64(5000)))
+	limit, offset, err := putilits.AddPagination(tableName, pag, s.DB)
+	require.NoError(s.T(), err)
+	query := querySelectCreatorPayments + "and p.date >= $2 and p.date < $3 and p.awards_id = $4 " +
+		"and p.state = ANY($5) " + queryOrderPayments + fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
+		WithArgs(tableName).
+		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).
+			AddRow(int64(5000)))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(creatorId, filter.From, filter.To, filter.AwardID, pq.Array([]string{string(models.PaymentRefunded)})).
+		WillReturnRows(sqlmock.NewRows([]string{"p.payments_id"}))
+
+	res, err := s.repo.GetCreatorPayments(creatorId, pag, filter)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), res)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_ExportCreatorPayments() {
+	creatorId := int64(5)
+	from := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	filter := &models.PaymentsFilter{From: from}
+	query := querySelectCreatorPayments + "and p.date >= $2 " + queryOrderPayments
+	columns := []string{"p.payments_id", "p.amount", "p.currency", "p.date", "p.users_id", "u.nickname", "state",
+		"type", "code", "p.discount", "tips_id", "message", "p.refunded_amount", "awards_id", "a.name"}
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(creatorId, from).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(int64(2), models.NewDecimal(100), models.DefaultCurrency, from.AddDate(0, 0, 2), int64(11), "user",
+				models.PaymentSucceeded, models.PaymentSubscription, "", int64(0), int64(0), "", models.Decimal(0),
+				int64(3), "gold").
+			AddRow(int64(1), models.NewDecimal(50), models.DefaultCurrency, from.AddDate(0, 0, 1), int64(12), "user2",
+				models.PaymentPartiallyRefunded, models.PaymentTip, "", int64(0), int64(4), "thanks",
+				models.NewDecimal(10), int64(0), ""))
+
+	var ids []int64
+	var awards []string
+	err := s.repo.ExportCreatorPayments(creatorId, filter, func(payment *models.CreatorPayments) error {
+		ids = append(ids, payment.ID)
+		awards = append(awards, payment.AwardName)
+		return nil
+	})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []int64{2, 1}, ids)
+	assert.Equal(s.T(), []string{"gold", ""}, awards)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_ExportCreatorPayments_HandleError() {
+	creatorId := int64(5)
+	query := querySelectCreatorPayments + queryOrderPayments
+	columns := []string{"p.payments_id", "p.amount", "p.currency", "p.date", "p.users_id", "u.nickname", "state",
+		"type", "code", "p.discount", "tips_id", "message", "p.refunded_amount", "awards_id", "a.name"}
+	date := time.Now()
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(creatorId).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(int64(2), models.NewDecimal(100), models.DefaultCurrency, date, int64(11), "user",
+				models.PaymentSucceeded, models.PaymentSubscription, "", int64(0), int64(0), "", models.Decimal(0),
+				int64(3), "gold").
+			AddRow(int64(1), models.NewDecimal(50), models.DefaultCurrency, date, int64(12), "user2",
+				models.PaymentSucceeded, models.PaymentSubscription, "", int64(0), int64(0), "", models.Decimal(0),
+				int64(3), "gold"))
+
+	calls := 0
+	err := s.repo.ExportCreatorPayments(creatorId, nil, func(payment *models.CreatorPayments) error {
+		calls++
+		return models.BDError
+	})
+	assert.Equal(s.T(), models.BDError, err)
+	assert.Equal(s.T(), 1, calls)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_GetCreatorMonthlyTotals() {
+	creatorId := int64(5)
+	month := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	filter := &models.PaymentsFilter{AwardID: 3}
+	query := querySelectMonthlyTotals + "and p.awards_id = $2 " + queryGroupMonthlyTotals
+	expected := []models.PaymentsMonthTotal{
+		{Month: month, AwardID: 3, AwardName: "gold", Currency: models.DefaultCurrency, Count: 2,
+			Amount: models.NewDecimal(200), Refunded: models.NewDecimal(10)},
+	}
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(creatorId, filter.AwardID).
+		WillReturnRows(sqlmock.NewRows([]string{"month", "awards_id", "name", "currency", "count", "sum", "sum"}).
+			AddRow(month, int64(3), "gold", models.DefaultCurrency, int64(2), models.NewDecimal(200),
+				models.NewDecimal(10)))
+
+	res, err := s.repo.GetCreatorMonthlyTotals(creatorId, filter)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), expected, res)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(creatorId, filter.AwardID).
+		WillReturnError(models.BDError)
+	_, err = s.repo.GetCreatorMonthlyTotals(creatorId, filter)
+	assert.Error(s.T(), err)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_CheckOperationProcessed() {
+	operationID := "1234567"
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCountOperations)).
+		WithArgs(operationID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	processed, err := s.repo.CheckOperationProcessed(operationID)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), processed)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCountOperations)).
+		WithArgs(operationID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	processed, err = s.repo.CheckOperationProcessed(operationID)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), processed)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_GetPaymentByID() {
+	date := time.Date(2021, 11, 20, 12, 0, 0, 0, time.UTC)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetPaymentByID)).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id", "pay_token", "amount", "currency", "date", "creator_id",
+			"users_id", "state", "refunded_amount", "type", "gifts_id", "tips_id"}).
+			AddRow(4, "pay_token", "100.00", models.DefaultCurrency, date, 2, 1, models.PaymentSucceeded, "0.00",
+				models.PaymentSubscription, 0, 0))
+	res, err := s.repo.GetPaymentByID(4)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.Payments{ID: 4, Token: "pay_token", Amount: models.NewDecimal(100),
+		Currency: models.DefaultCurrency, Date: date, CreatorID: 2, UserID: 1, State: models.PaymentSucceeded,
+		Type: models.PaymentSubscription}, res)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetPaymentByID)).
+		WithArgs(5).
+		WillReturnError(sql.ErrNoRows)
+	_, err = s.repo.GetPaymentByID(5)
+	assert.Equal(s.T(), repository.NotFound, err)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_CheckCountPaymentsByToken() {
+	token := "pay_token"
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCountPayments)).
+		WithArgs(token).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	assert.NoError(s.T(), s.repo.CheckCountPaymentsByToken(token))
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCountPayments)).
+		WithArgs(token).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	assert.Equal(s.T(), repository.NotFound, s.repo.CheckCountPaymentsByToken(token))
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCountPayments)).
+		WithArgs(token).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	assert.Equal(s.T(), repository_payments.CountPaymentsByTokenError, s.repo.CheckCountPaymentsByToken(token))
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_UpdateStatus_OK() {
+	token := "pay_token"
+	operationID := "1234567"
+	event := &models.PaymentEvent{FromState: models.PaymentPending, ToState: models.PaymentSucceeded,
+		Reason: "payment notification"}
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryUpdateStatus)).
+		WithArgs(token, operationID, event.FromState, event.ToState).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id", "users_id", "creator_id", "awards_id", "amount", "type"}).
+			AddRow(4, 1, 2, 3, "1.00", models.PaymentSubscription))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddEvent)).
+		WithArgs(4, event.FromState, event.ToState, event.Reason).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddPostings)).
+		WithArgs(2, models.OperationPayment, models.Decimal(-100), models.Decimal(90), models.Decimal(10), 4).
+		WillReturnResult(sqlmock.NewResult(1, 3))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetTierChange)).
+		WithArgs(4).
+		WillReturnError(sql.ErrNoRows)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryPayGift)).
+		WithArgs(4).
+		WillReturnError(sql.ErrNoRows)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryUpdateSubscribe)).
+		WithArgs(1, 2, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"renewed"}).AddRow(true))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryConvertTrial)).
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectCommit()
+	subscrEvent, err := s.repo.UpdateStatus(token, operationID, event, 10)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), &models.SubscriptionEvent{UserID: 1, CreatorID: 2, AwardID: 3,
+		Kind: models.SubscriptionEventRenewed}, subscrEvent)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_UpdateStatus_SubscriptionExpired() {
+	token := "pay_token"
+	operationID := "1234567"
+	event := &models.PaymentEvent{FromState: models.PaymentExpired, ToState: models.PaymentSucceeded,
+		Reason: "payment notification"}
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryUpdateStatus)).
+		WithArgs(token, operationID, event.FromState, event.ToState).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id", "users_id", "creator_id", "awards_id", "amount", "type"}).
+			AddRow(4, 1, 2, 3, "1.00", models.PaymentSubscription))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddEvent)).
+		WithArgs(4, event.FromState, event.ToState, event.Reason).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddPostings)).
+		WithArgs(2, models.OperationPayment, models.Decimal(-100), models.Decimal(90), models.Decimal(10), 4).
+		WillReturnResult(sqlmock.NewResult(1, 3))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetTierChange)).
+		WithArgs(4).
+		WillReturnError(sql.ErrNoRows)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryPayGift)).
+		WithArgs(4).
+		WillReturnError(sql.ErrNoRows)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryUpdateSubscribe)).
+		WithArgs(1, 2, 3).
+		WillReturnError(sql.ErrNoRows)
+	repository_subscribers.ExpectTakeSeat(s.Mock, 1, 3, 0, 0)
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryRestoreSubscribe)).
+		WithArgs(1, 2, 3, 4).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryConvertTrial)).
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.Mock.ExpectCommit()
+	subscrEvent, err := s.repo.UpdateStatus(token, operationID, event, 10)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), &models.SubscriptionEvent{UserID: 1, CreatorID: 2, AwardID: 3,
+		Kind: models.SubscriptionEventSubscribed}, subscrEvent)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_UpdateStatus_TierUpgrade() {
+	token := "pay_token"
+	operationID := "1234567"
+	event := &models.PaymentEvent{FromState: models.PaymentPending, ToState: models.PaymentSucceeded,
+		Reason: "payment notification"}
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryUpdateStatus)).
+		WithArgs(token, operationID, event.FromState, event.ToState).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id", "users_id", "creator_id", "awards_id", "amount", "type"}).
+			AddRow(4, 1, 2, 3, "1.00", models.PaymentSubscription))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddEvent)).
+		WithArgs(4, event.FromState, event.ToState, event.Reason).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddPostings)).
+		WithArgs(2, models.OperationPayment, models.Decimal(-100), models.Decimal(90), models.Decimal(10), 4).
+		WillReturnResult(sqlmock.NewResult(1, 3))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetTierChange)).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "subscribers_id", "from_awards_id", "to_awards_id"}).
+			AddRow(7, 5, 6, 3))
+	repository_subscribers.ExpectTakeSeat(s.Mock, 1, 3, 0, 0)
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryApplyUpgrade)).
+		WithArgs(5, 6, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryCancelDowngrades)).
+		WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryCloseTierChange)).
+		WithArgs(7, models.TierChangeApplied).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryPayGift)).
+		WithArgs(4).
+		WillReturnError(sql.ErrNoRows)
+	s.Mock.ExpectCommit()
+	subscrEvent, err := s.repo.UpdateStatus(token, operationID, event, 10)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), &models.SubscriptionEvent{UserID: 1, CreatorID: 2, AwardID: 3,
+		Kind: models.SubscriptionEventTierChanged, Reason: string(models.TierUpgrade)}, subscrEvent)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_UpdateStatus_TierUpgradeOutdated() {
+	token := "pay_token"
+	operationID := "1234567"
+	event := &models.PaymentEvent{FromState: models.PaymentPending, ToState: models.PaymentSucceeded}
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryUpdateStatus)).
+		WithArgs(token, operationID, event.FromState, event.ToState).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id", "users_id", "creator_id", "awards_id", "amount", "type"}).
+			AddRow(4, 1, 2, 3, "1.00", models.PaymentSubscription))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddEvent)).
+		WithArgs(4, event.FromState, event.ToState, event.Reason).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddPostings)).
+		WithArgs(2, models.OperationPayment, models.Decimal(-100), models.Decimal(90), models.Decimal(10), 4).
+		WillReturnResult(sqlmock.NewResult(1, 3))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetTierChange)).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "subscribers_id", "from_awards_id", "to_awards_id"}).
+			AddRow(7, 5, 6, 3))
+	repository_subscribers.ExpectTakeSeat(s.Mock, 1, 3, 0, 0)
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryApplyUpgrade)).
+		WithArgs(5, 6, 3).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryCloseTierChange)).
+		WithArgs(7, models.TierChangeCancelled).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryPayGift)).
+		WithArgs(4).
+		WillReturnError(sql.ErrNoRows)
+	s.Mock.ExpectCommit()
+	subscrEvent, err := s.repo.UpdateStatus(token, operationID, event, 10)
+	assert.Equal(s.T(), repository_payments.TierChangeCancelled, err)
+	assert.Nil(s.T(), subscrEvent)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_UpdateStatus_GiftByCode() {
+	token := "pay_token"
+	operationID := "1234567"
+	event := &models.PaymentEvent{FromState: models.PaymentPending, ToState: models.PaymentSucceeded}
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryUpdateStatus)).
+		WithArgs(token, operationID, event.FromState, event.ToState).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id", "users_id", "creator_id", "awards_id", "amount", "type"}).
+			AddRow(4, 1, 2, 3, "3.00", models.PaymentGift))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddEvent)).
+		WithArgs(4, event.FromState, event.ToState, event.Reason).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddPostings)).
+		WithArgs(2, models.OperationPayment, models.Decimal(-300), models.Decimal(270), models.Decimal(30), 4).
+		WillReturnResult(sqlmock.NewResult(1, 3))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetTierChange)).
+		WithArgs(4).
+		WillReturnError(sql.ErrNoRows)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryPayGift)).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"recipient_id", "creator_id", "awards_id", "periods"}).
+			AddRow(0, 2, 3, 3))
+	s.Mock.ExpectCommit()
+	subscrEvent, err := s.repo.UpdateStatus(token, operationID, event, 30)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), subscrEvent)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_UpdateStatus_GiftForRecipient() {
+	token := "pay_token"
+	operationID := "1234567"
+	event := &models.PaymentEvent{FromState: models.PaymentPending, ToState: models.PaymentSucceeded}
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryUpdateStatus)).
+		WithArgs(token, operationID, event.FromState, event.ToState).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id", "users_id", "creator_id", "awards_id", "amount", "type"}).
+			AddRow(4, 1, 2, 3, "3.00", models.PaymentGift))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddEvent)).
+		WithArgs(4, event.FromState, event.ToState, event.Reason).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddPostings)).
+		WithArgs(2, models.OperationPayment, models.Decimal(-300), models.Decimal(270), models.Decimal(30), 4).
+		WillReturnResult(sqlmock.NewResult(1, 3))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetTierChange)).
+		WithArgs(4).
+		WillReturnError(sql.ErrNoRows)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryPayGift)).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"recipient_id", "creator_id", "awards_id", "periods"}).
+			AddRow(6, 2, 3, 3))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryIsBlocked)).
+		WithArgs(2, 6).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryExtendGiftSubscription)).
+		WithArgs(6, 2, 3, 3).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	repository_subscribers.ExpectTakeSeat(s.Mock, 6, 3, 0, 0)
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddGiftSubscription)).
+		WithArgs(6, 2, 3, 3).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.Mock.ExpectCommit()
+	subscrEvent, err := s.repo.UpdateStatus(token, operationID, event, 30)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), &models.SubscriptionEvent{UserID: 6, CreatorID: 2, AwardID: 3,
+		Kind: models.SubscriptionEventSubscribed, Reason: giftEventReason}, subscrEvent)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_UpdateStatus_GiftForRecipientSoldOut() {
+	token := "pay_token"
+	operationID := "1234567"
+	event := &models.PaymentEvent{FromState: models.PaymentPending, ToState: models.PaymentSucceeded}
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryUpdateStatus)).
+		WithArgs(token, operationID, event.FromState, event.ToState).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id", "users_id", "creator_id", "awards_id", "amount", "type"}).
+			AddRow(4, 1, 2, 3, "3.00", models.PaymentGift))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddEvent)).
+		WithArgs(4, event.FromState, event.ToState, event.Reason).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddPostings)).
+		WithArgs(2, models.OperationPayment, models.Decimal(-300), models.Decimal(270), models.Decimal(30), 4).
+		WillReturnResult(sqlmock.NewResult(1, 3))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetTierChange)).
+		WithArgs(4).
+		WillReturnError(sql.ErrNoRows)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryPayGift)).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"recipient_id", "creator_id", "awards_id", "periods"}).
+			AddRow(6, 2, 3, 3))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryIsBlocked)).
+		WithArgs(2, 6).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryExtendGiftSubscription)).
+		WithArgs(6, 2, 3, 3).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	repository_subscribers.ExpectTakeSeat(s.Mock, 6, 3, 1, 1)
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryUnredeemGift)).
+		WithArgs(4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectCommit()
+	subscrEvent, err := s.repo.UpdateStatus(token, operationID, event, 30)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), subscrEvent)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_UpdateStatus_GiftForBlockedRecipient() {
+	token := "pay_token"
+	operationID := "1234567"
+	event := &models.PaymentEvent{FromState: models.PaymentPending, ToState: models.PaymentSucceeded}
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryUpdateStatus)).
+		WithArgs(token, operationID, event.FromState, event.ToState).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id", "users_id", "creator_id", "awards_id", "amount", "type"}).
+			AddRow(4, 1, 2, 3, "3.00", models.PaymentGift))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddEvent)).
+		WithArgs(4, event.FromState, event.ToState, event.Reason).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddPostings)).
+		WithArgs(2, models.OperationPayment, models.Decimal(-300), models.Decimal(270), models.Decimal(30), 4).
+		WillReturnResult(sqlmock.NewResult(1, 3))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetTierChange)).
+		WithArgs(4).
+		WillReturnError(sql.ErrNoRows)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryPayGift)).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"recipient_id", "creator_id", "awards_id", "periods"}).
+			AddRow(6, 2, 3, 3))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryIsBlocked)).
+		WithArgs(2, 6).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryUnredeemGift)).
+		WithArgs(4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectCommit()
+	subscrEvent, err := s.repo.UpdateStatus(token, operationID, event, 30)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), subscrEvent)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_UpdateStatus_Tip() {
+	token := "pay_token"
+	operationID := "1234567"
+	event := &models.PaymentEvent{FromState: models.PaymentPending, ToState: models.PaymentSucceeded}
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryUpdateStatus)).
+		WithArgs(token, operationID, event.FromState, event.ToState).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id", "users_id", "creator_id", "awards_id", "amount", "type"}).
+			AddRow(4, 1, 2, 0, "1.50", models.PaymentTip))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddEvent)).
+		WithArgs(4, event.FromState, event.ToState, event.Reason).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddPostings)).
+		WithArgs(2, models.OperationPayment, models.Decimal(-150), models.Decimal(135), models.Decimal(15), 4).
+		WillReturnResult(sqlmock.NewResult(1, 3))
+	s.Mock.ExpectCommit()
+	subscrEvent, err := s.repo.UpdateStatus(token, operationID, event, 15)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), subscrEvent)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_UpdateStatus_Unlock() {
+	token := "pay_token"
+	operationID := "1234567"
+	event := &models.PaymentEvent{FromState: models.PaymentPending, ToState: models.PaymentSucceeded}
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryUpdateStatus)).
+		WithArgs(token, operationID, event.FromState, event.ToState).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id", "users_id", "creator_id", "awards_id", "amount", "type"}).
+			AddRow(4, 1, 2, 0, "1.50", models.PaymentUnlock))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddEvent)).
+		WithArgs(4, event.FromState, event.ToState, event.Reason).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddPostings)).
+		WithArgs(2, models.OperationPayment, models.Decimal(-150), models.Decimal(135), models.Decimal(15), 4).
+		WillReturnResult(sqlmock.NewResult(1, 3))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryUnlockPost)).
+		WithArgs(4).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.Mock.ExpectCommit()
+	subscrEvent, err := s.repo.UpdateStatus(token, operationID, event, 15)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), subscrEvent)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_UpdateStatus_StateChanged() {
+	token := "pay_token"
+	operationID := "1234567"
+	event := &models.PaymentEvent{FromState: models.PaymentPending, ToState: models.PaymentSucceeded}
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryUpdateStatus)).
+		WithArgs(token, operationID, event.FromState, event.ToState).
+		WillReturnError(sql.ErrNoRows)
+	s.Mock.ExpectRollback()
+	_, err := s.repo.UpdateStatus(token, operationID, event, 10)
+	assert.Equal(s.T(), repository_payments.PaymentStateChanged, err)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_UpdateStatus_DuplicateOperation() {
+	token := "pay_token"
+	operationID := "1234567"
+	event := &models.PaymentEvent{FromState: models.PaymentPending, ToState: models.PaymentSucceeded}
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryUpdateStatus)).
+		WithArgs(token, operationID, event.FromState, event.ToState).
+		WillReturnError(&pq.Error{Code: codeDuplicateVal})
+	s.Mock.ExpectRollback()
+	_, err := s.repo.UpdateStatus(token, operationID, event, 10)
+	assert.Equal(s.T(), repository_payments.OperationAlreadyProcessed, err)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_Refund_Partial() {
+	token := "pay_token"
+	event := &models.PaymentEvent{FromState: models.PaymentSucceeded, ToState: models.PaymentPartiallyRefunded,
+		Reason: "refund"}
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryRefund)).
+		WithArgs(token, event.FromState, event.ToState, models.Decimal(50)).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id", "creator_id", "amount", "refunded_amount"}).
+			AddRow(4, 2, "1.00", "0.50"))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddEvent)).
+		WithArgs(4, event.FromState, event.ToState, event.Reason).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetPaymentFee)).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"fee", "fee_left"}).AddRow("0.15", "0.15"))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddPostings)).
+		WithArgs(2, models.OperationRefund, models.Decimal(50), models.Decimal(-43), models.Decimal(-7), 4).
+		WillReturnResult(sqlmock.NewResult(1, 3))
+	s.Mock.ExpectCommit()
+	err := s.repo.Refund(token, event, 50)
+	require.NoError(s.T(), err)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_Refund_Last() {
+	token := "pay_token"
+	event := &models.PaymentEvent{FromState: models.PaymentPartiallyRefunded, ToState: models.PaymentRefunded}
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryRefund)).
+		WithArgs(token, event.FromState, event.ToState, models.Decimal(50)).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id", "creator_id", "amount", "refunded_amount"}).
+			AddRow(4, 2, "1.00", "1.00"))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddEvent)).
+		WithArgs(4, event.FromState, event.ToState, event.Reason).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetPaymentFee)).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"fee", "fee_left"}).AddRow("0.15", "0.08"))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddPostings)).
+		WithArgs(2, models.OperationRefund, models.Decimal(50), models.Decimal(-42), models.Decimal(-8), 4).
+		WillReturnResult(sqlmock.NewResult(1, 3))
+	s.Mock.ExpectCommit()
+	err := s.repo.Refund(token, event, 50)
+	require.NoError(s.T(), err)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_Refund_TooMuch() {
+	token := "pay_token"
+	event := &models.PaymentEvent{FromState: models.PaymentSucceeded, ToState: models.PaymentRefunded}
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryRefund)).
+		WithArgs(token, event.FromState, event.ToState, models.Decimal(150)).
+		WillReturnError(sql.ErrNoRows)
+	s.Mock.ExpectRollback()
+	err := s.repo.Refund(token, event, 150)
+	assert.Equal(s.T(), repository_payments.PaymentStateChanged, err)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_ChangeState_OK() {
+	token := "pay_token"
+	event := &models.PaymentEvent{FromState: models.PaymentCreated, ToState: models.PaymentPending,
+		Reason: "checkout created by fake"}
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryChangeState)).
+		WithArgs(token, event.FromState, event.ToState).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id"}).AddRow(4))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddEvent)).
+		WithArgs(4, event.FromState, event.ToState, event.Reason).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.Mock.ExpectCommit()
+	err := s.repo.ChangeState(token, event)
+	require.NoError(s.T(), err)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_ChangeState_StateChanged() {
+	token := "pay_token"
+	event := &models.PaymentEvent{FromState: models.PaymentCreated, ToState: models.PaymentPending}
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryChangeState)).
+		WithArgs(token, event.FromState, event.ToState).
+		WillReturnError(sql.ErrNoRows)
+	s.Mock.ExpectRollback()
+	err := s.repo.ChangeState(token, event)
+	assert.Equal(s.T(), repository_payments.PaymentStateChanged, err)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_GetAbandonedCheckouts() {
+	createdBefore := time.Date(2021, 12, 1, 9, 0, 0, 0, time.UTC)
+	expected := []models.AbandonedCheckout{
+		{PaymentID: 4, Token: "pay_token", State: models.PaymentCreated, Type: models.PaymentSubscription,
+			UserID: 1, CreatorID: 2, AwardID: 3, Date: createdBefore.Add(-time.Hour), HasPendingSubscription: true},
+		{PaymentID: 5, Token: "tip_token", State: models.PaymentPending, Type: models.PaymentTip,
+			UserID: 1, CreatorID: 2, Date: createdBefore},
+	}
+	rows := sqlmock.NewRows([]string{"payments_id", "pay_token", "state", "type", "users_id", "creator_id",
+		"awards_id", "date", "pending"})
+	for _, cur := range expected {
+		rows.AddRow(cur.PaymentID, cur.Token, cur.State, cur.Type, cur.UserID, cur.CreatorID, cur.AwardID,
+			cur.Date, cur.HasPendingSubscription)
+	}
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetAbandonedCheckouts)).
+		WithArgs(createdBefore).
+		WillReturnRows(rows)
+	res, err := s.repo.GetAbandonedCheckouts(createdBefore)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), expected, res)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetAbandonedCheckouts)).
+		WithArgs(createdBefore).
+		WillReturnError(models.BDError)
+	_, err = s.repo.GetAbandonedCheckouts(createdBefore)
+	assert.Error(s.T(), err)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_ExpireCheckout_Subscription() {
+	checkout := &models.AbandonedCheckout{PaymentID: 4, Token: "pay_token", State: models.PaymentPending,
+		Type: models.PaymentSubscription, UserID: 1, CreatorID: 2, AwardID: 3, HasPendingSubscription: true}
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryExpireCheckout)).
+		WithArgs(checkout.PaymentID, checkout.State, models.PaymentExpired).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddEvent)).
+		WithArgs(checkout.PaymentID, checkout.State, models.PaymentExpired, "checkout abandoned").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryRemovePendingSubscribe)).
+		WithArgs(checkout.UserID, checkout.CreatorID, checkout.AwardID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectCommit()
+	removed, err := s.repo.ExpireCheckout(checkout, "checkout abandoned")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(1), removed)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_ExpireCheckout_Tip() {
+	checkout := &models.AbandonedCheckout{PaymentID: 5, Token: "tip_token", State: models.PaymentCreated,
+		Type: models.PaymentTip, UserID: 1, CreatorID: 2}
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryExpireCheckout)).
+		WithArgs(checkout.PaymentID, checkout.State, models.PaymentExpired).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddEvent)).
+		WithArgs(checkout.PaymentID, checkout.State, models.PaymentExpired, "checkout abandoned").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.Mock.ExpectCommit()
+	removed, err := s.repo.ExpireCheckout(checkout, "checkout abandoned")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(0), removed)
+}
+
+func (s *SuitePaymentsRepository) TestPaymentsRepository_ExpireCheckout_StateChanged() {
+	checkout := &models.AbandonedCheckout{PaymentID: 4, State: models.PaymentPending, Type: models.PaymentSubscription}
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryExpireCheckout)).
+		WithArgs(checkout.PaymentID, checkout.State, models.PaymentExpired).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.Mock.ExpectRollback()
+	_, err := s.repo.ExpireCheckout(checkout, "checkout abandoned")
+	assert.Equal(s.T(), repository_payments.PaymentStateChanged, err)
+}
+
 func TestPaymentsRepository(t *testing.T) {
 	suite.Run(t, new(SuitePaymentsRepository))
 }
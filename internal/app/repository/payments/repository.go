@@ -3,6 +3,7 @@ package repository_payments
 import (
 	"patreon/internal/app/models"
 	db_models "patreon/internal/app/models"
+	"time"
 )
 
 //go:generate mockgen -destination=mocks/mock_payments_repository.go -package=mock_repository -mock_names=Repository=PaymentsRepository . Repository
@@ -12,23 +13,67 @@ type Repository interface {
 	//		repository.NotFound
 	//		app.GeneralError with Errors:
 	//			repository.DefaultErrDB
-	GetUserPayments(userID int64, pag *db_models.Pagination) ([]models.UserPayments, error)
+	GetUserPayments(userID int64, pag *db_models.Pagination, filter *models.PaymentsFilter) ([]models.UserPayments, error)
 	// GetCreatorPayments Errors:
 	//		repository.NotFound
 	//		app.GeneralError with Errors:
 	//			repository.DefaultErrDB
-	GetCreatorPayments(creatorID int64, pag *db_models.Pagination) ([]models.CreatorPayments, error)
+	GetCreatorPayments(creatorID int64, pag *db_models.Pagination,
+		filter *models.PaymentsFilter) ([]models.CreatorPayments, error)
+	// ExportCreatorPayments Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	ExportCreatorPayments(creatorID int64, filter *models.PaymentsFilter,
+		handle func(payment *models.CreatorPayments) error) error
+	// GetCreatorMonthlyTotals Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetCreatorMonthlyTotals(creatorID int64, filter *models.PaymentsFilter) ([]models.PaymentsMonthTotal, error)
 	// CheckCountPaymentsByToken Errors:
+	//		repository.NotFound
 	//		repository_payments.CountPaymentsByTokenError
 	//		app.GeneralError with Errors:
 	//			repository.DefaultErrDB
 	CheckCountPaymentsByToken(token string) error
 	// UpdateStatus Errors:
+	//		repository_payments.PaymentStateChanged
+	//		repository_payments.OperationAlreadyProcessed
+	//		repository_payments.TierChangeCancelled
 	//		app.GeneralError with Errors:
 	//			repository.DefaultErrDB
-	UpdateStatus(token string) error
+	UpdateStatus(token string, operationID string, event *models.PaymentEvent,
+		fee models.Decimal) (*models.SubscriptionEvent, error)
+	// Refund Errors:
+	//		repository_payments.PaymentStateChanged
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	Refund(token string, event *models.PaymentEvent, amount models.Decimal) error
+	// ChangeState Errors:
+	//		repository_payments.PaymentStateChanged
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	ChangeState(token string, event *models.PaymentEvent) error
+	// GetAbandonedCheckouts Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetAbandonedCheckouts(createdBefore time.Time) ([]models.AbandonedCheckout, error)
+	// ExpireCheckout Errors:
+	//		repository_payments.PaymentStateChanged
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	ExpireCheckout(checkout *models.AbandonedCheckout, reason string) (int64, error)
+	// CheckOperationProcessed Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	CheckOperationProcessed(operationID string) (bool, error)
 	// GetPaymentByToken Errors:
+	//		repository.NotFound
 	//		app.GeneralError with Errors:
 	//			repository.DefaultErrDB
 	GetPaymentByToken(token string) (models.Payments, error)
+	// GetPaymentByID Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetPaymentByID(paymentID int64) (models.Payments, error)
 }
@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: patreon/internal/app/repository/polls (interfaces: Repository)
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// PollsRepository is a mock of Repository interface.
+type PollsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *PollsRepositoryMockRecorder
+}
+
+// PollsRepositoryMockRecorder is the mock recorder for PollsRepository.
+type PollsRepositoryMockRecorder struct {
+	mock *PollsRepository
+}
+
+// NewPollsRepository creates a new mock instance.
+func NewPollsRepository(ctrl *gomock.Controller) *PollsRepository {
+	mock := &PollsRepository{ctrl: ctrl}
+	mock.recorder = &PollsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *PollsRepository) EXPECT() *PollsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetCounts mocks base method.
+func (m *PollsRepository) GetCounts(arg0 int64) (map[int64]int64, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCounts", arg0)
+	ret0, _ := ret[0].(map[int64]int64)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCounts indicates an expected call of GetCounts.
+func (mr *PollsRepositoryMockRecorder) GetCounts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCounts", reflect.TypeOf((*PollsRepository)(nil).GetCounts), arg0)
+}
+
+// GetVote mocks base method.
+func (m *PollsRepository) GetVote(arg0, arg1 int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVote", arg0, arg1)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVote indicates an expected call of GetVote.
+func (mr *PollsRepositoryMockRecorder) GetVote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVote", reflect.TypeOf((*PollsRepository)(nil).GetVote), arg0, arg1)
+}
+
+// Retract mocks base method.
+func (m *PollsRepository) Retract(arg0, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retract", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Retract indicates an expected call of Retract.
+func (mr *PollsRepositoryMockRecorder) Retract(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retract", reflect.TypeOf((*PollsRepository)(nil).Retract), arg0, arg1)
+}
+
+// Vote mocks base method.
+func (m *PollsRepository) Vote(arg0, arg1 int64, arg2 []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Vote", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Vote indicates an expected call of Vote.
+func (mr *PollsRepositoryMockRecorder) Vote(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Vote", reflect.TypeOf((*PollsRepository)(nil).Vote), arg0, arg1, arg2)
+}
@@ -0,0 +1,5 @@
+package repository_postgresql
+
+import "github.com/pkg/errors"
+
+var VoteAlreadyExist = errors.New("user already voted in this poll")
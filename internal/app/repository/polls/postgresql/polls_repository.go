@@ -0,0 +1,120 @@
+package repository_postgresql
+
+import (
+	"database/sql"
+	"patreon/internal/app/repository"
+	repository_polls "patreon/internal/app/repository/polls"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const (
+	codeForeignKeyViolation = "23503"
+
+	queryVote = "INSERT INTO poll_votes (data_id, users_id, options) VALUES ($1, $2, $3) " +
+		"ON CONFLICT DO NOTHING"
+	queryRetract = "DELETE FROM poll_votes WHERE data_id = $1 AND users_id = $2"
+	queryGetVote = "SELECT options FROM poll_votes WHERE data_id = $1 AND users_id = $2"
+	queryCounts  = "SELECT opt, count(*), (SELECT count(*) FROM poll_votes WHERE data_id = $1) " +
+		"FROM poll_votes, unnest(options) AS opt WHERE data_id = $1 GROUP BY opt"
+)
+
+type PollsRepository struct {
+	store *sqlx.DB
+}
+
+var _ = repository_polls.Repository(&PollsRepository{})
+
+func NewPollsRepository(store *sqlx.DB) *PollsRepository {
+	return &PollsRepository{
+		store: store,
+	}
+}
+
+// Vote save options chosen by user, every user can vote in poll only once
+// Errors:
+//		VoteAlreadyExist
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PollsRepository) Vote(attachId int64, userId int64, options []int64) error {
+	res, err := repo.store.Exec(queryVote, attachId, userId, pq.Array(options))
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == codeForeignKeyViolation {
+			return repository.NotFound
+		}
+		return repository.NewDBError(err)
+	}
+	added, err := res.RowsAffected()
+	if err != nil {
+		return repository.NewDBError(err)
+	}
+	if added == 0 {
+		return VoteAlreadyExist
+	}
+	return nil
+}
+
+// Retract Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PollsRepository) Retract(attachId int64, userId int64) error {
+	res, err := repo.store.Exec(queryRetract, attachId, userId)
+	if err != nil {
+		return repository.NewDBError(err)
+	}
+	removed, err := res.RowsAffected()
+	if err != nil {
+		return repository.NewDBError(err)
+	}
+	if removed == 0 {
+		return repository.NotFound
+	}
+	return nil
+}
+
+// GetVote return options chosen by user
+// Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PollsRepository) GetVote(attachId int64, userId int64) ([]int64, error) {
+	options := pq.Int64Array{}
+	if err := repo.store.QueryRow(queryGetVote, attachId, userId).Scan(&options); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, repository.NotFound
+		}
+		return nil, repository.NewDBError(err)
+	}
+	return options, nil
+}
+
+// GetCounts return count of votes by number of option and count of voted users,
+// options without votes are absent in result
+// Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PollsRepository) GetCounts(attachId int64) (map[int64]int64, int64, error) {
+	rows, err := repo.store.Query(queryCounts, attachId)
+	if err != nil {
+		return nil, 0, repository.NewDBError(err)
+	}
+
+	counts := map[int64]int64{}
+	voters := int64(0)
+	for rows.Next() {
+		var option, count int64
+		if err = rows.Scan(&option, &count, &voters); err != nil {
+			_ = rows.Close()
+			return nil, 0, repository.NewDBError(err)
+		}
+		counts[option] = count
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, repository.NewDBError(err)
+	}
+	return counts, voters, nil
+}
@@ -0,0 +1,107 @@
+package repository_postgresql
+
+import (
+	"database/sql"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	"regexp"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+)
+
+type SuitePollsRepository struct {
+	models.Suite
+	repo *PollsRepository
+}
+
+func (s *SuitePollsRepository) SetupSuite() {
+	s.InitBD()
+	s.repo = NewPollsRepository(s.DB)
+}
+
+func (s *SuitePollsRepository) AfterTest(_, _ string) {
+	require.NoError(s.T(), s.Mock.ExpectationsWereMet())
+}
+
+func (s *SuitePollsRepository) TestPollsRepository_Vote() {
+	options := []int64{0, 2}
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryVote)).
+		WithArgs(int64(1), int64(2), pq.Array(options)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	err := s.repo.Vote(1, 2, options)
+	assert.NoError(s.T(), err)
+
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryVote)).
+		WithArgs(int64(1), int64(2), pq.Array(options)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	err = s.repo.Vote(1, 2, options)
+	assert.Equal(s.T(), VoteAlreadyExist, err)
+
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryVote)).
+		WithArgs(int64(1), int64(2), pq.Array(options)).
+		WillReturnError(&pq.Error{Code: codeForeignKeyViolation})
+	err = s.repo.Vote(1, 2, options)
+	assert.Equal(s.T(), repository.NotFound, err)
+
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryVote)).
+		WithArgs(int64(1), int64(2), pq.Array(options)).
+		WillReturnError(repository.DefaultErrDB)
+	err = s.repo.Vote(1, 2, options)
+	assert.Equal(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
+}
+
+func (s *SuitePollsRepository) TestPollsRepository_Retract() {
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryRetract)).
+		WithArgs(int64(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	err := s.repo.Retract(1, 2)
+	assert.NoError(s.T(), err)
+
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryRetract)).
+		WithArgs(int64(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	err = s.repo.Retract(1, 2)
+	assert.Equal(s.T(), repository.NotFound, err)
+}
+
+func (s *SuitePollsRepository) TestPollsRepository_GetVote() {
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetVote)).
+		WithArgs(int64(1), int64(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"options"}).AddRow("{0,2}"))
+	options, err := s.repo.GetVote(1, 2)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []int64{0, 2}, options)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetVote)).
+		WithArgs(int64(1), int64(3)).
+		WillReturnError(sql.ErrNoRows)
+	_, err = s.repo.GetVote(1, 3)
+	assert.Equal(s.T(), repository.NotFound, err)
+}
+
+func (s *SuitePollsRepository) TestPollsRepository_GetCounts() {
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCounts)).
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"opt", "count", "count"}).
+			AddRow(int64(0), int64(3), int64(4)).
+			AddRow(int64(2), int64(2), int64(4)))
+	counts, voters, err := s.repo.GetCounts(1)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), map[int64]int64{0: 3, 2: 2}, counts)
+	assert.Equal(s.T(), int64(4), voters)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCounts)).
+		WithArgs(int64(1)).
+		WillReturnError(repository.DefaultErrDB)
+	_, _, err = s.repo.GetCounts(1)
+	assert.Equal(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
+}
+
+func TestPollsRepository(t *testing.T) {
+	suite.Run(t, new(SuitePollsRepository))
+}
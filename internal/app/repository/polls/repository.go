@@ -0,0 +1,29 @@
+package repository_polls
+
+//go:generate mockgen -destination=mocks/mock_polls_repository.go -package=mock_repository -mock_names=Repository=PollsRepository . Repository
+
+type Repository interface {
+	// Vote Errors:
+	//		repository_postgresql.VoteAlreadyExist
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	Vote(attachId int64, userId int64, options []int64) error
+
+	// Retract Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	Retract(attachId int64, userId int64) error
+
+	// GetVote Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetVote(attachId int64, userId int64) ([]int64, error)
+
+	// GetCounts Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetCounts(attachId int64) (map[int64]int64, int64, error)
+}
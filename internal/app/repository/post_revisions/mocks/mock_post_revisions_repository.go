@@ -0,0 +1,122 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: patreon/internal/app/repository/post_revisions (interfaces: Repository)
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	models "patreon/internal/app/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// PostRevisionsRepository is a mock of Repository interface.
+type PostRevisionsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *PostRevisionsRepositoryMockRecorder
+}
+
+// PostRevisionsRepositoryMockRecorder is the mock recorder for PostRevisionsRepository.
+type PostRevisionsRepositoryMockRecorder struct {
+	mock *PostRevisionsRepository
+}
+
+// NewPostRevisionsRepository creates a new mock instance.
+func NewPostRevisionsRepository(ctrl *gomock.Controller) *PostRevisionsRepository {
+	mock := &PostRevisionsRepository{ctrl: ctrl}
+	mock.recorder = &PostRevisionsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *PostRevisionsRepository) EXPECT() *PostRevisionsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *PostRevisionsRepository) Get(arg0 int64) (*models.PostRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0)
+	ret0, _ := ret[0].(*models.PostRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *PostRevisionsRepositoryMockRecorder) Get(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*PostRevisionsRepository)(nil).Get), arg0)
+}
+
+// GetCurrent mocks base method.
+func (m *PostRevisionsRepository) GetCurrent(arg0 int64) (*models.PostRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrent", arg0)
+	ret0, _ := ret[0].(*models.PostRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrent indicates an expected call of GetCurrent.
+func (mr *PostRevisionsRepositoryMockRecorder) GetCurrent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrent", reflect.TypeOf((*PostRevisionsRepository)(nil).GetCurrent), arg0)
+}
+
+// GetRevisions mocks base method.
+func (m *PostRevisionsRepository) GetRevisions(arg0 int64, arg1 *models.Pagination) ([]models.PostRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", arg0, arg1)
+	ret0, _ := ret[0].([]models.PostRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *PostRevisionsRepositoryMockRecorder) GetRevisions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*PostRevisionsRepository)(nil).GetRevisions), arg0, arg1)
+}
+
+// Restore mocks base method.
+func (m *PostRevisionsRepository) Restore(arg0 *models.PostRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *PostRevisionsRepositoryMockRecorder) Restore(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*PostRevisionsRepository)(nil).Restore), arg0)
+}
+
+// Save mocks base method.
+func (m *PostRevisionsRepository) Save(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *PostRevisionsRepositoryMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*PostRevisionsRepository)(nil).Save), arg0)
+}
+
+// SaveByAttach mocks base method.
+func (m *PostRevisionsRepository) SaveByAttach(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveByAttach", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveByAttach indicates an expected call of SaveByAttach.
+func (mr *PostRevisionsRepositoryMockRecorder) SaveByAttach(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveByAttach", reflect.TypeOf((*PostRevisionsRepository)(nil).SaveByAttach), arg0)
+}
@@ -0,0 +1,234 @@
+package repository_postgresql
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_post_revisions "patreon/internal/app/repository/post_revisions"
+	putilits "patreon/internal/app/utilits/postgresql"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// unusedAttachLevel level of attach which is not shown in post, such attaches are kept for restore of revisions
+const unusedAttachLevel = -1
+
+const (
+	querySnapshot = "SELECT p.posts_id, p.title, p.description, p.type_awards, " +
+		"coalesce((SELECT jsonb_agg(jsonb_build_object('id', pd.data_id, 'type', pt.type, 'value', pd.data, " +
+		"'level', pd.level) ORDER BY pd.level) FROM posts_data pd JOIN posts_type pt ON pt.posts_type_id = pd.type " +
+		"WHERE pd.post_id = p.posts_id AND pd.level != -1), '[]'::jsonb) AS attaches FROM posts p "
+	querySaveSnapshot = "INSERT INTO post_revisions (posts_id, title, description, type_awards, attaches) " +
+		"SELECT * FROM snapshot s WHERE NOT EXISTS (" +
+		"SELECT 1 FROM (SELECT title, description, type_awards, attaches FROM post_revisions " +
+		"WHERE posts_id = s.posts_id ORDER BY id DESC LIMIT 1) last " +
+		"WHERE last.title = s.title AND last.description = s.description " +
+		"AND last.type_awards IS NOT DISTINCT FROM s.type_awards AND last.attaches = s.attaches)"
+
+	querySave         = "WITH snapshot AS (" + querySnapshot + "WHERE p.posts_id = $1) " + querySaveSnapshot
+	querySaveByAttach = "WITH snapshot AS (" + querySnapshot +
+		"WHERE p.posts_id = (SELECT post_id FROM posts_data WHERE data_id = $1)) " + querySaveSnapshot
+	queryGetCurrent = querySnapshot + "WHERE p.posts_id = $1"
+
+	queryGet = "SELECT id, posts_id, title, description, type_awards, attaches, date FROM post_revisions " +
+		"WHERE id = $1"
+	queryGetRevisions = "SELECT id, posts_id, title, description, type_awards, attaches, date FROM post_revisions " +
+		"WHERE posts_id = $1 ORDER BY id DESC "
+
+	queryRestorePost = "UPDATE posts SET title = $1, description = $2, " +
+		"type_awards = (SELECT awards_id FROM awards WHERE awards_id = $3) WHERE posts_id = $4"
+	queryDetachAttaches = "UPDATE posts_data SET level = $1 WHERE post_id = $2"
+	queryRestoreAttach  = "UPDATE posts_data SET type = (SELECT posts_type_id FROM posts_type WHERE type = $1), " +
+		"data = $2, level = $3 WHERE data_id = $4 AND post_id = $5"
+	queryRecreateAttach = "INSERT INTO posts_data (post_id, type, data, level) " +
+		"VALUES ($1, (SELECT posts_type_id FROM posts_type WHERE type = $2), $3, $4)"
+)
+
+type PostRevisionsRepository struct {
+	store *sqlx.DB
+}
+
+var _ = repository_post_revisions.Repository(&PostRevisionsRepository{})
+
+func NewPostRevisionsRepository(store *sqlx.DB) *PostRevisionsRepository {
+	return &PostRevisionsRepository{
+		store: store,
+	}
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanRevision(row scanner, withID bool) (*models.PostRevision, error) {
+	rev := &models.PostRevision{}
+	var awardsId sql.NullInt64
+	var attaches []byte
+	var err error
+	if withID {
+		err = row.Scan(&rev.ID, &rev.PostID, &rev.Title, &rev.Description, &awardsId, &attaches, &rev.Date)
+	} else {
+		err = row.Scan(&rev.PostID, &rev.Title, &rev.Description, &awardsId, &attaches)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(attaches, &rev.Attaches); err != nil {
+		return nil, errors.Wrap(err, "failed unmarshal attaches of post revision")
+	}
+	rev.Awards = repository.NoAwards
+	if awardsId.Valid {
+		rev.Awards = awardsId.Int64
+	}
+	return rev, nil
+}
+
+// Save Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PostRevisionsRepository) Save(postID int64) error {
+	if _, err := repo.store.Exec(querySave, postID); err != nil {
+		return repository.NewDBError(err)
+	}
+	return nil
+}
+
+// SaveByAttach Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PostRevisionsRepository) SaveByAttach(attachID int64) error {
+	if _, err := repo.store.Exec(querySaveByAttach, attachID); err != nil {
+		return repository.NewDBError(err)
+	}
+	return nil
+}
+
+// GetCurrent Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PostRevisionsRepository) GetCurrent(postID int64) (*models.PostRevision, error) {
+	rev, err := scanRevision(repo.store.QueryRow(queryGetCurrent, postID), false)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.NotFound
+		}
+		return nil, repository.NewDBError(err)
+	}
+	return rev, nil
+}
+
+// Get Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PostRevisionsRepository) Get(revisionID int64) (*models.PostRevision, error) {
+	rev, err := scanRevision(repo.store.QueryRow(queryGet, revisionID), true)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.NotFound
+		}
+		return nil, repository.NewDBError(err)
+	}
+	return rev, nil
+}
+
+// GetRevisions Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PostRevisionsRepository) GetRevisions(postID int64, pag *models.Pagination) ([]models.PostRevision, error) {
+	limit, offset, err := putilits.AddPagination("post_revisions", pag, repo.store)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := repo.store.Query(queryGetRevisions+fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset), postID)
+	if err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	res := make([]models.PostRevision, 0, limit)
+	for rows.Next() {
+		rev, err := scanRevision(rows, true)
+		if err != nil {
+			_ = rows.Close()
+			return nil, repository.NewDBError(err)
+		}
+		res = append(res, *rev)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, repository.NewDBError(err)
+	}
+	return res, nil
+}
+
+// Restore Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PostRevisionsRepository) Restore(revision *models.PostRevision) error {
+	begin, err := repo.store.Begin()
+	if err != nil {
+		return repository.NewDBError(err)
+	}
+
+	res, err := begin.Exec(queryRestorePost, revision.Title, revision.Description, revision.Awards, revision.PostID)
+	if err != nil {
+		_ = begin.Rollback()
+		return repository.NewDBError(err)
+	}
+	updated, err := res.RowsAffected()
+	if err != nil {
+		_ = begin.Rollback()
+		return repository.NewDBError(err)
+	}
+	if updated == 0 {
+		_ = begin.Rollback()
+		return repository.NotFound
+	}
+
+	if _, err = begin.Exec(queryDetachAttaches, unusedAttachLevel, revision.PostID); err != nil {
+		_ = begin.Rollback()
+		return repository.NewDBError(err)
+	}
+
+	for _, attach := range revision.Attaches {
+		if err = repo.restoreAttach(begin, revision.PostID, attach); err != nil {
+			_ = begin.Rollback()
+			return err
+		}
+	}
+
+	if err = begin.Commit(); err != nil {
+		return repository.NewDBError(err)
+	}
+	return nil
+}
+
+// restoreAttach return attach to post with the same id, attach is created again only if it was deleted
+// Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PostRevisionsRepository) restoreAttach(trans *sql.Tx, postID int64, attach models.Attach) error {
+	res, err := trans.Exec(queryRestoreAttach, attach.Type, attach.Value, attach.Level, attach.Id, postID)
+	if err != nil {
+		return repository.NewDBError(err)
+	}
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return repository.NewDBError(err)
+	}
+	if updated != 0 {
+		return nil
+	}
+
+	if _, err = trans.Exec(queryRecreateAttach, postID, attach.Type, attach.Value, attach.Level); err != nil {
+		return repository.NewDBError(err)
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package repository_postgresql
+
+import (
+	"database/sql"
+	"fmt"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	putilits "patreon/internal/app/utilits/postgresql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+)
+
+type SuitePostRevisionsRepository struct {
+	models.Suite
+	repo *PostRevisionsRepository
+}
+
+func (s *SuitePostRevisionsRepository) SetupSuite() {
+	s.InitBD()
+	s.repo = NewPostRevisionsRepository(s.DB)
+}
+
+func (s *SuitePostRevisionsRepository) AfterTest(_, _ string) {
+	require.NoError(s.T(), s.Mock.ExpectationsWereMet())
+}
+
+func (s *SuitePostRevisionsRepository) TestPostRevisionsRepository_Save() {
+	s.Mock.ExpectExec(regexp.QuoteMeta(querySave)).
+		WithArgs(int64(2)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	err := s.repo.Save(2)
+	assert.NoError(s.T(), err)
+
+	s.Mock.ExpectExec(regexp.QuoteMeta(querySaveByAttach)).
+		WithArgs(int64(5)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	err = s.repo.SaveByAttach(5)
+	assert.NoError(s.T(), err)
+
+	s.Mock.ExpectExec(regexp.QuoteMeta(querySave)).
+		WithArgs(int64(2)).
+		WillReturnError(repository.DefaultErrDB)
+	err = s.repo.Save(2)
+	assert.Equal(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
+}
+
+func (s *SuitePostRevisionsRepository) TestPostRevisionsRepository_Get() {
+	date := time.Date(2021, 12, 1, 10, 0, 0, 0, time.UTC)
+	expected := &models.PostRevision{ID: 1, PostID: 2, Title: "title", Description: "desc", Awards: 3,
+		Attaches: []models.Attach{{Id: 4, Value: "text", Type: models.Text, Level: 1}}, Date: date}
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGet)).
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "posts_id", "title", "description", "type_awards",
+			"attaches", "date"}).
+			AddRow(int64(1), int64(2), "title", "desc", int64(3),
+				[]byte(`[{"id": 4, "type": "text", "value": "text", "level": 1}]`), date))
+	res, err := s.repo.Get(1)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), expected, res)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGet)).
+		WithArgs(int64(1)).
+		WillReturnError(sql.ErrNoRows)
+	_, err = s.repo.Get(1)
+	assert.Equal(s.T(), repository.NotFound, err)
+}
+
+func (s *SuitePostRevisionsRepository) TestPostRevisionsRepository_GetCurrent() {
+	expected := &models.PostRevision{PostID: 2, Title: "title", Description: "desc", Awards: repository.NoAwards,
+		Attaches: []models.Attach{}}
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetCurrent)).
+		WithArgs(int64(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"posts_id", "title", "description", "type_awards", "attaches"}).
+			AddRow(int64(2), "title", "desc", nil, []byte(`[]`)))
+	res, err := s.repo.GetCurrent(2)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), expected, res)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetCurrent)).
+		WithArgs(int64(2)).
+		WillReturnError(repository.DefaultErrDB)
+	_, err = s.repo.GetCurrent(2)
+	assert.Equal(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
+}
+
+func (s *SuitePostRevisionsRepository) TestPostRevisionsRepository_GetRevisions() {
+	queryStat := "SELECT n_live_tup FROM pg_stat_all_tables WHERE relname = $1"
+	tableName := "post_revisions"
+	pag := &models.Pagination{Limit: 10, Offset: 0}
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
+		WithArgs(tableName).
+		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).AddRow(int64(5000)))
+	limit, offset, err := putilits.AddPagination(tableName, pag, s.DB)
+	assert.NoError(s.T(), err)
+
+	date := time.Date(2021, 12, 1, 10, 0, 0, 0, time.UTC)
+	expected := []models.PostRevision{{ID: 1, PostID: 2, Title: "title", Awards: repository.NoAwards,
+		Attaches: []models.Attach{}, Date: date}}
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
+		WithArgs(tableName).
+		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).AddRow(int64(5000)))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetRevisions + fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset))).
+		WithArgs(int64(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "posts_id", "title", "description", "type_awards",
+			"attaches", "date"}).
+			AddRow(int64(1), int64(2), "title", "", nil, []byte(`[]`), date))
+	res, err := s.repo.GetRevisions(2, pag)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), expected, res)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
+		WithArgs(tableName).
+		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).AddRow(int64(5000)))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetRevisions + fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset))).
+		WithArgs(int64(2)).
+		WillReturnError(repository.DefaultErrDB)
+	_, err = s.repo.GetRevisions(2, pag)
+	assert.Equal(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
+}
+
+func (s *SuitePostRevisionsRepository) TestPostRevisionsRepository_Restore() {
+	rev := &models.PostRevision{ID: 1, PostID: 2, Title: "title", Description: "desc", Awards: 3,
+		Attaches: []models.Attach{
+			{Id: 4, Value: "text", Type: models.Text, Level: 1},
+			{Id: 5, Value: "media/img.webp", Type: models.Image, Level: 2},
+		}}
+
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryRestorePost)).
+		WithArgs(rev.Title, rev.Description, rev.Awards, rev.PostID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryDetachAttaches)).
+		WithArgs(unusedAttachLevel, rev.PostID).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryRestoreAttach)).
+		WithArgs(models.Text, "text", int64(1), int64(4), rev.PostID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryRestoreAttach)).
+		WithArgs(models.Image, "media/img.webp", int64(2), int64(5), rev.PostID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryRecreateAttach)).
+		WithArgs(rev.PostID, models.Image, "media/img.webp", int64(2)).
+		WillReturnResult(sqlmock.NewResult(6, 1))
+	s.Mock.ExpectCommit()
+	err := s.repo.Restore(rev)
+	assert.NoError(s.T(), err)
+}
+
+func (s *SuitePostRevisionsRepository) TestPostRevisionsRepository_Restore_Error() {
+	rev := &models.PostRevision{ID: 1, PostID: 2, Title: "title", Awards: repository.NoAwards,
+		Attaches: []models.Attach{{Id: 4, Value: "text", Type: models.Text, Level: 1}}}
+
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryRestorePost)).
+		WithArgs(rev.Title, rev.Description, rev.Awards, rev.PostID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.Mock.ExpectRollback()
+	err := s.repo.Restore(rev)
+	assert.Equal(s.T(), repository.NotFound, err)
+
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryRestorePost)).
+		WithArgs(rev.Title, rev.Description, rev.Awards, rev.PostID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryDetachAttaches)).
+		WithArgs(unusedAttachLevel, rev.PostID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryRestoreAttach)).
+		WithArgs(models.Text, "text", int64(1), int64(4), rev.PostID).
+		WillReturnError(repository.DefaultErrDB)
+	s.Mock.ExpectRollback()
+	err = s.repo.Restore(rev)
+	assert.Equal(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
+}
+
+func TestPostRevisionsRepository(t *testing.T) {
+	suite.Run(t, new(SuitePostRevisionsRepository))
+}
@@ -0,0 +1,41 @@
+package repository_post_revisions
+
+import "patreon/internal/app/models"
+
+//go:generate mockgen -destination=mocks/mock_post_revisions_repository.go -package=mock_repository -mock_names=Repository=PostRevisionsRepository . Repository
+
+type Repository interface {
+	// Save snapshot of current post content as revision, nothing is saved if post
+	// did not change since the last revision
+	// Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	Save(postID int64) error
+	// SaveByAttach save snapshot of post which attach belongs to
+	// Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	SaveByAttach(attachID int64) error
+	// GetCurrent return current post content in form of revision with zero id
+	// Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetCurrent(postID int64) (*models.PostRevision, error)
+	// Get Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	Get(revisionID int64) (*models.PostRevision, error)
+	// GetRevisions return page of post revisions, the last first
+	// Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetRevisions(postID int64, pag *models.Pagination) ([]models.PostRevision, error)
+	// Restore set post content and attaches from revision, attaches which still exist keep their ids
+	// Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	Restore(revision *models.PostRevision) error
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: patreon/internal/app/repository/post_unlocks (interfaces: Repository)
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	models "patreon/internal/app/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// PostUnlocksRepository is a mock of Repository interface.
+type PostUnlocksRepository struct {
+	ctrl     *gomock.Controller
+	recorder *PostUnlocksRepositoryMockRecorder
+}
+
+// PostUnlocksRepositoryMockRecorder is the mock recorder for PostUnlocksRepository.
+type PostUnlocksRepositoryMockRecorder struct {
+	mock *PostUnlocksRepository
+}
+
+// NewPostUnlocksRepository creates a new mock instance.
+func NewPostUnlocksRepository(ctrl *gomock.Controller) *PostUnlocksRepository {
+	mock := &PostUnlocksRepository{ctrl: ctrl}
+	mock.recorder = &PostUnlocksRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *PostUnlocksRepository) EXPECT() *PostUnlocksRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *PostUnlocksRepository) Create(arg0 *models.PostUnlock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *PostUnlocksRepositoryMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*PostUnlocksRepository)(nil).Create), arg0)
+}
@@ -0,0 +1,38 @@
+package repository_postgresql
+
+import (
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_post_unlocks "patreon/internal/app/repository/post_unlocks"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	queryCreate = "INSERT INTO payments(amount, creator_id, users_id, pay_token, type, posts_id, currency) " +
+		"VALUES($1, $2, $3, $4, 'unlock', $5, $6) RETURNING date"
+)
+
+type PostUnlocksRepository struct {
+	store *sqlx.DB
+}
+
+var _ = repository_post_unlocks.Repository(&PostUnlocksRepository{})
+
+func NewPostUnlocksRepository(store *sqlx.DB) *PostUnlocksRepository {
+	return &PostUnlocksRepository{
+		store: store,
+	}
+}
+
+// Create not paid payment of user with unlock.PayToken, post is unlocked when payment succeeded
+// Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PostUnlocksRepository) Create(unlock *models.PostUnlock) error {
+	if err := repo.store.QueryRow(queryCreate, unlock.Amount, unlock.CreatorID, unlock.UserID,
+		unlock.PayToken, unlock.PostID, unlock.Currency).Scan(&unlock.Date); err != nil {
+		return repository.NewDBError(err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package repository_postgresql
+
+import (
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+)
+
+type SuitePostUnlocksRepository struct {
+	models.Suite
+	repo *PostUnlocksRepository
+}
+
+func (s *SuitePostUnlocksRepository) SetupSuite() {
+	s.InitBD()
+	s.repo = NewPostUnlocksRepository(s.DB)
+}
+
+func (s *SuitePostUnlocksRepository) AfterTest(_, _ string) {
+	require.NoError(s.T(), s.Mock.ExpectationsWereMet())
+}
+
+func (s *SuitePostUnlocksRepository) TestPostUnlocksRepository_Create() {
+	unlock := models.TestPostUnlock()
+	unlock.PayToken = "token"
+	now := time.Now()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCreate)).
+		WithArgs(unlock.Amount, unlock.CreatorID, unlock.UserID, unlock.PayToken, unlock.PostID, unlock.Currency).
+		WillReturnRows(sqlmock.NewRows([]string{"date"}).AddRow(now))
+	err := s.repo.Create(unlock)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), now, unlock.Date)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCreate)).
+		WithArgs(unlock.Amount, unlock.CreatorID, unlock.UserID, unlock.PayToken, unlock.PostID, unlock.Currency).
+		WillReturnError(models.BDError)
+	err = s.repo.Create(unlock)
+	assert.Equal(s.T(), repository.NewDBError(models.BDError), err)
+}
+
+func TestPostUnlocksRepository(t *testing.T) {
+	suite.Run(t, new(SuitePostUnlocksRepository))
+}
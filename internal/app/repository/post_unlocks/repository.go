@@ -0,0 +1,12 @@
+package repository_post_unlocks
+
+import "patreon/internal/app/models"
+
+//go:generate mockgen -destination=mocks/mock_post_unlocks_repository.go -package=mock_repository -mock_names=Repository=PostUnlocksRepository . Repository
+
+type Repository interface {
+	// Create Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	Create(unlock *models.PostUnlock) error
+}
@@ -7,6 +7,7 @@ package mock_repository
 import (
 	models "patreon/internal/app/models"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -64,18 +65,18 @@ func (mr *PostsRepositoryMockRecorder) Delete(arg0 interface{}) *gomock.Call {
 }
 
 // GetAvailablePosts mocks base method.
-func (m *PostsRepository) GetAvailablePosts(arg0 int64, arg1 *models.Pagination) ([]models.AvailablePost, error) {
+func (m *PostsRepository) GetAvailablePosts(arg0 int64, arg1 *models.Pagination, arg2 string) ([]models.AvailablePost, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAvailablePosts", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetAvailablePosts", arg0, arg1, arg2)
 	ret0, _ := ret[0].([]models.AvailablePost)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAvailablePosts indicates an expected call of GetAvailablePosts.
-func (mr *PostsRepositoryMockRecorder) GetAvailablePosts(arg0, arg1 interface{}) *gomock.Call {
+func (mr *PostsRepositoryMockRecorder) GetAvailablePosts(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailablePosts", reflect.TypeOf((*PostsRepository)(nil).GetAvailablePosts), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailablePosts", reflect.TypeOf((*PostsRepository)(nil).GetAvailablePosts), arg0, arg1, arg2)
 }
 
 // GetPost mocks base method.
@@ -109,18 +110,48 @@ func (mr *PostsRepositoryMockRecorder) GetPostCreator(arg0 interface{}) *gomock.
 }
 
 // GetPosts mocks base method.
-func (m *PostsRepository) GetPosts(arg0, arg1 int64, arg2 *models.Pagination, arg3 bool) ([]models.Post, error) {
+func (m *PostsRepository) GetPosts(arg0, arg1 int64, arg2 *models.Pagination, arg3 bool, arg4 string) ([]models.Post, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPosts", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "GetPosts", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].([]models.Post)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPosts indicates an expected call of GetPosts.
-func (mr *PostsRepositoryMockRecorder) GetPosts(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *PostsRepositoryMockRecorder) GetPosts(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPosts", reflect.TypeOf((*PostsRepository)(nil).GetPosts), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPosts", reflect.TypeOf((*PostsRepository)(nil).GetPosts), arg0, arg1, arg2, arg3, arg4)
+}
+
+// PublishDuePosts mocks base method.
+func (m *PostsRepository) PublishDuePosts(arg0 time.Time) ([]models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishDuePosts", arg0)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishDuePosts indicates an expected call of PublishDuePosts.
+func (mr *PostsRepositoryMockRecorder) PublishDuePosts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishDuePosts", reflect.TypeOf((*PostsRepository)(nil).PublishDuePosts), arg0)
+}
+
+// SearchPosts mocks base method.
+func (m *PostsRepository) SearchPosts(arg0, arg1 int64, arg2 string, arg3 *models.Pagination) ([]models.SearchedPost, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchPosts", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.SearchedPost)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchPosts indicates an expected call of SearchPosts.
+func (mr *PostsRepositoryMockRecorder) SearchPosts(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPosts", reflect.TypeOf((*PostsRepository)(nil).SearchPosts), arg0, arg1, arg2, arg3)
 }
 
 // UpdateCoverPost mocks base method.
@@ -9,6 +9,7 @@ import (
 	rp "patreon/internal/app/repository"
 	repository_posts "patreon/internal/app/repository/posts"
 	putilits "patreon/internal/app/utilits/postgresql"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 
@@ -26,30 +27,47 @@ const (
 			u.nickname,
 			lk.likes_id IS NOT NULL,
 			views,
-			p.number_comments
+			p.number_comments,
+			p.unlock_price,
+			pu.post_unlocks_id IS NOT NULL
 	FROM posts p
-			 JOIN subscribers s on s.users_id = $1 and s.creator_id = p.creator_id and s.status = true
+			 LEFT JOIN subscribers s on s.users_id = $1 and s.creator_id = p.creator_id and s.status = true
+					and greatest(s.paid_until, s.grace_until) > now()
+			 LEFT JOIN post_unlocks pu on pu.posts_id = p.posts_id and pu.users_id = $1
 			 LEFT JOIN likes AS lk ON (lk.post_id = p.posts_id and lk.users_id = $1)
 			 JOIN users u on p.creator_id = u.users_id
-	WHERE p.is_draft = false and (p.type_awards is null OR p.type_awards = s.awards_id or p.type_awards in
-			 (select awa.awards_id from restapi_dev.public.parents_awards as awa where awa.parent_id = s.awards_id))
+	WHERE p.is_draft = false and (pu.post_unlocks_id is not null or s.id is not null and
+			(p.type_awards is null OR p.type_awards = s.awards_id or p.type_awards in
+			 (select awa.awards_id from restapi_dev.public.parents_awards as awa where awa.parent_id = s.awards_id)))
+		and ($4 = '' or exists (select 1 from posts_tags pt join tags t on t.tags_id = pt.tags_id
+			where pt.posts_id = p.posts_id and t.name = $4))
 	ORDER BY p.date desc LIMIT $2 OFFSET $3;`
 
 	createQuery = `INSERT INTO posts (title, description,
-		type_awards, creator_id, cover, is_draft) VALUES ($1, $2, $3, $4, $5, $6) 
-		RETURNING posts_id`
+		type_awards, creator_id, cover, is_draft, unlock_price, publish_at, preview)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING posts_id`
 
 	getPostCreatorQuery = `SELECT creator_id FROM posts WHERE posts_id = $1`
 
+	// lockedPostColumn expects posts without alias, post_unlocks of user as pu and user id as $1.
+	// Post is open for its creator, if it is free, unlocked by user or available by award of user subscription
+	lockedPostColumn = `NOT (posts.creator_id = $1 OR posts.type_awards IS NULL OR pu.post_unlocks_id IS NOT NULL
+			OR EXISTS (SELECT 1 FROM subscribers AS s WHERE s.users_id = $1 AND s.creator_id = posts.creator_id
+				AND s.status = true AND greatest(s.paid_until, s.grace_until) > now()
+				AND (s.awards_id = posts.type_awards OR posts.type_awards IN
+					(SELECT pa.awards_id FROM parents_awards AS pa WHERE pa.parent_id = s.awards_id))))`
+
 	getPostQuery = `
 			SELECT title, description, likes, posts.date, cover, type_awards, 
-			       creator_id, lk.likes_id IS NOT NULL, views, is_draft, number_comments FROM posts
+			       creator_id, lk.likes_id IS NOT NULL, views, is_draft, number_comments, 
+			       unlock_price, pu.post_unlocks_id IS NOT NULL, publish_at, preview, ` + lockedPostColumn + ` FROM posts
 				LEFT OUTER JOIN likes AS lk ON (lk.post_id = posts.posts_id and lk.users_id = $1)
-				WHERE posts.posts_id = $2;`
+				LEFT JOIN post_unlocks AS pu ON (pu.posts_id = posts.posts_id and pu.users_id = $1)
+				WHERE posts.posts_id = $2 AND (NOT is_draft OR creator_id = $1);`
 	getPostQueryUpdate = `UPDATE posts SET views = views + 1 WHERE posts_id = $1`
 
-	updateQuery = `UPDATE posts SET title = $1, description = $2, type_awards = $3, is_draft = $4
-					WHERE posts_id = $5 RETURNING posts_id`
+	updateQuery = `UPDATE posts SET title = $1, description = $2, type_awards = $3, is_draft = $4, 
+					unlock_price = $5, publish_at = $6, preview = $7 WHERE posts_id = $8 RETURNING posts_id`
 
 	updateCoverQuery = `UPDATE posts SET cover = $1 WHERE posts_id = $2 RETURNING posts_id`
 
@@ -57,18 +75,62 @@ const (
 
 	getPostsQueryWithDraft = `
 			SELECT posts_id, title, description, likes, type_awards, posts.date, cover, 
-					lk.likes_id IS NOT NULL, views, is_draft, number_comments
+					lk.likes_id IS NOT NULL, views, is_draft, number_comments, 
+					unlock_price, pu.post_unlocks_id IS NOT NULL, publish_at, preview, ` + lockedPostColumn + `
 			FROM posts
 			LEFT JOIN likes AS lk ON (lk.post_id = posts.posts_id and lk.users_id = $1)
-			WHERE creator_id = $2 ORDER BY posts.date DESC
+			LEFT JOIN post_unlocks AS pu ON (pu.posts_id = posts.posts_id and pu.users_id = $1)
+			WHERE creator_id = $2 AND ($3 = '' OR EXISTS (SELECT 1 FROM posts_tags pt JOIN tags t ON t.tags_id = pt.tags_id
+					WHERE pt.posts_id = posts.posts_id AND t.name = $3))
+			ORDER BY posts.date DESC
 	`
 	getPostsQueryWithoutDraft = `
 			SELECT posts_id, title, description, likes, type_awards, posts.date, cover, 
-					lk.likes_id IS NOT NULL, views, number_comments
+					lk.likes_id IS NOT NULL, views, number_comments, 
+					unlock_price, pu.post_unlocks_id IS NOT NULL, preview, ` + lockedPostColumn + `
 			FROM posts
 			LEFT JOIN likes AS lk ON (lk.post_id = posts.posts_id and lk.users_id = $1)
-			WHERE creator_id = $2 AND NOT is_draft ORDER BY posts.date DESC
+			LEFT JOIN post_unlocks AS pu ON (pu.posts_id = posts.posts_id and pu.users_id = $1)
+			WHERE creator_id = $2 AND NOT is_draft AND ($3 = '' OR EXISTS (SELECT 1 FROM posts_tags pt
+					JOIN tags t ON t.tags_id = pt.tags_id WHERE pt.posts_id = posts.posts_id AND t.name = $3))
+			ORDER BY posts.date DESC
 	`
+
+	// searchPostsQuery post is found by english or russian content, snippet is built with config which matched it.
+	// Creator sees all own posts, other users see free posts, unlocked posts and posts of their awards
+	searchPostsQuery = `
+			WITH searched_posts AS (
+				SELECT sp.posts_id, sp.content, sp.content_en @@ plainto_tsquery('english', $2) AS is_en,
+					least(sp.content_en <=> plainto_tsquery('english', $2),
+						sp.content_ru <=> plainto_tsquery('russian_hunspell', $2)) AS rank
+				FROM search_posts AS sp
+				WHERE sp.content_en @@ plainto_tsquery('english', $2)
+					OR sp.content_ru @@ plainto_tsquery('russian_hunspell', $2)
+			)
+			SELECT p.posts_id, p.title, p.description, p.likes, p.date, p.cover, p.type_awards, p.creator_id,
+				u.nickname, lk.likes_id IS NOT NULL, p.views, p.is_draft, p.number_comments, p.unlock_price,
+				pu.post_unlocks_id IS NOT NULL,
+				CASE WHEN sp.is_en THEN ts_headline('english', sp.content, plainto_tsquery('english', $2))
+					ELSE ts_headline('russian_hunspell', sp.content, plainto_tsquery('russian_hunspell', $2)) END
+			FROM searched_posts AS sp
+				JOIN posts AS p ON p.posts_id = sp.posts_id
+				JOIN users AS u ON u.users_id = p.creator_id
+				LEFT JOIN subscribers AS s ON s.users_id = $1 AND s.creator_id = p.creator_id AND s.status = true
+					AND greatest(s.paid_until, s.grace_until) > now()
+				LEFT JOIN post_unlocks AS pu ON pu.posts_id = p.posts_id AND pu.users_id = $1
+				LEFT JOIN likes AS lk ON lk.post_id = p.posts_id AND lk.users_id = $1
+			WHERE ($3 = -1 OR p.creator_id = $3) AND (p.creator_id = $1 OR NOT p.is_draft AND
+				(p.type_awards IS NULL OR pu.post_unlocks_id IS NOT NULL OR s.id IS NOT NULL AND
+				(p.type_awards = s.awards_id OR p.type_awards IN
+					(SELECT pa.awards_id FROM parents_awards AS pa WHERE pa.parent_id = s.awards_id))))
+			ORDER BY sp.rank LIMIT $4 OFFSET $5`
+
+	// publishDuePostsQuery row lock of update lets only one of concurrent schedulers publish post,
+	// others see it already published and skip it
+	publishDuePostsQuery = `
+			UPDATE posts SET is_draft = false, date = publish_at, publish_at = NULL
+			WHERE is_draft AND publish_at <= $1
+			RETURNING posts_id, creator_id, title`
 )
 
 type PostsRepository struct {
@@ -84,8 +146,8 @@ func NewPostsRepository(st *sqlx.DB) *PostsRepository {
 }
 
 // Create Errors:
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
 func (repo *PostsRepository) Create(post *models.CreatePost) (int64, error) {
 	var awardsId sql.NullInt64
 	awardsId.Int64 = post.Awards
@@ -96,7 +158,7 @@ func (repo *PostsRepository) Create(post *models.CreatePost) (int64, error) {
 	}
 
 	if err := repo.store.QueryRowx(createQuery, post.Title, post.Description, awardsId, post.CreatorId,
-		app.DefaultImage, post.IsDraft).
+		app.DefaultImage, post.IsDraft, post.UnlockPrice, post.PublishAt, post.Preview).
 		Scan(&post.ID); err != nil {
 		return app.InvalidInt, repository.NewDBError(err)
 	}
@@ -105,8 +167,8 @@ func (repo *PostsRepository) Create(post *models.CreatePost) (int64, error) {
 
 // GetPostCreator Errors:
 //		repository.NotFound
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *PostsRepository) GetPostCreator(postID int64) (int64, error) {
 	creatorId := int64(0)
 	if err := repo.store.QueryRowx(getPostCreatorQuery, postID).Scan(&creatorId); err != nil {
@@ -119,16 +181,19 @@ func (repo *PostsRepository) GetPostCreator(postID int64) (int64, error) {
 	return creatorId, nil
 }
 
-// GetPost Errors:
+// GetPost draft is found only for its creator
+// Errors:
 //		repository.NotFound
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *PostsRepository) GetPost(postID int64, userId int64, addView bool) (*models.Post, error) {
 	post := &models.Post{ID: postID}
 	var awardsId sql.NullInt64
+	var publishAt sql.NullTime
 	if err := repo.store.QueryRow(getPostQuery, userId, postID).Scan(&post.Title, &post.Description,
 		&post.Likes, &post.Date, &post.Cover, &awardsId,
-		&post.CreatorId, &post.AddLike, &post.Views, &post.IsDraft, &post.Comments); err != nil {
+		&post.CreatorId, &post.AddLike, &post.Views, &post.IsDraft, &post.Comments,
+		&post.UnlockPrice, &post.Unlocked, &publishAt, &post.Preview, &post.Locked); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, repository.NotFound
 		}
@@ -150,14 +215,18 @@ func (repo *PostsRepository) GetPost(postID int64, userId int64, addView bool) (
 	} else {
 		post.Awards = awardsId.Int64
 	}
+	if publishAt.Valid {
+		post.PublishAt = &publishAt.Time
+	}
 
 	return post, nil
 }
 
 // GetAvailablePosts Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
-func (repo *PostsRepository) GetAvailablePosts(userID int64, pag *models.Pagination) ([]models.AvailablePost, error) {
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PostsRepository) GetAvailablePosts(userID int64, pag *models.Pagination,
+	tag string) ([]models.AvailablePost, error) {
 	limit, offset, err := putilits.AddPagination("posts", pag, repo.store)
 
 	if err != nil {
@@ -166,7 +235,7 @@ func (repo *PostsRepository) GetAvailablePosts(userID int64, pag *models.Paginat
 
 	var res []models.AvailablePost
 
-	rows, err := repo.store.Query(getAvailablePosts, userID, limit, offset)
+	rows, err := repo.store.Query(getAvailablePosts, userID, limit, offset, tag)
 	if err != nil {
 		return nil, repository.NewDBError(err)
 	}
@@ -176,7 +245,7 @@ func (repo *PostsRepository) GetAvailablePosts(userID int64, pag *models.Paginat
 		err = rows.Scan(
 			&post.ID, &post.Title, &post.Description, &post.Likes, &post.Date,
 			&post.Cover, &awardsId, &post.CreatorId, &post.CreatorNickname,
-			&post.AddLike, &post.Views, &post.Comments)
+			&post.AddLike, &post.Views, &post.Comments, &post.UnlockPrice, &post.Unlocked)
 
 		if err != nil {
 			_ = rows.Close()
@@ -200,10 +269,10 @@ func (repo *PostsRepository) GetAvailablePosts(userID int64, pag *models.Paginat
 }
 
 // GetPosts Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *PostsRepository) GetPosts(creatorsId int64, userId int64,
-	pag *models.Pagination, withDraft bool) ([]models.Post, error) {
+	pag *models.Pagination, withDraft bool, tag string) ([]models.Post, error) {
 
 	query := getPostsQueryWithoutDraft
 	if withDraft {
@@ -217,7 +286,7 @@ func (repo *PostsRepository) GetPosts(creatorsId int64, userId int64,
 	}
 	res := make([]models.Post, 0, limit)
 
-	rows, err := repo.store.Query(query, userId, creatorsId)
+	rows, err := repo.store.Query(query, userId, creatorsId, tag)
 	if err != nil {
 		return nil, repository.NewDBError(err)
 	}
@@ -225,13 +294,16 @@ func (repo *PostsRepository) GetPosts(creatorsId int64, userId int64,
 	for rows.Next() {
 		var post models.Post
 		var awardsId sql.NullInt64
+		var publishAt sql.NullTime
 
 		if withDraft {
 			err = rows.Scan(&post.ID, &post.Title, &post.Description, &post.Likes,
-				&awardsId, &post.Date, &post.Cover, &post.AddLike, &post.Views, &post.IsDraft, &post.Comments)
+				&awardsId, &post.Date, &post.Cover, &post.AddLike, &post.Views, &post.IsDraft, &post.Comments,
+				&post.UnlockPrice, &post.Unlocked, &publishAt, &post.Preview, &post.Locked)
 		} else {
 			err = rows.Scan(&post.ID, &post.Title, &post.Description, &post.Likes,
-				&awardsId, &post.Date, &post.Cover, &post.AddLike, &post.Views, &post.Comments)
+				&awardsId, &post.Date, &post.Cover, &post.AddLike, &post.Views, &post.Comments,
+				&post.UnlockPrice, &post.Unlocked, &post.Preview, &post.Locked)
 		}
 
 		if err != nil {
@@ -244,6 +316,9 @@ func (repo *PostsRepository) GetPosts(creatorsId int64, userId int64,
 		} else {
 			post.Awards = awardsId.Int64
 		}
+		if publishAt.Valid {
+			post.PublishAt = &publishAt.Time
+		}
 		post.CreatorId = creatorsId
 
 		res = append(res, post)
@@ -256,10 +331,52 @@ func (repo *PostsRepository) GetPosts(creatorsId int64, userId int64,
 	return res, nil
 }
 
+// SearchPosts Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PostsRepository) SearchPosts(userId int64, creatorId int64, searchString string,
+	pag *models.Pagination) ([]models.SearchedPost, error) {
+	limit, offset, err := putilits.AddPagination("search_posts", pag, repo.store)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := repo.store.Query(searchPostsQuery, userId, searchString, creatorId, limit, offset)
+	if err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	res := make([]models.SearchedPost, 0, limit)
+	for rows.Next() {
+		var post models.SearchedPost
+		var awardsId sql.NullInt64
+		if err = rows.Scan(&post.ID, &post.Title, &post.Description, &post.Likes, &post.Date,
+			&post.Cover, &awardsId, &post.CreatorId, &post.CreatorNickname, &post.AddLike, &post.Views,
+			&post.IsDraft, &post.Comments, &post.UnlockPrice, &post.Unlocked, &post.Snippet); err != nil {
+			_ = rows.Close()
+			return nil, repository.NewDBError(err)
+		}
+
+		if !awardsId.Valid {
+			post.Awards = rp.NoAwards
+		} else {
+			post.Awards = awardsId.Int64
+		}
+
+		res = append(res, post)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	return res, nil
+}
+
 // UpdatePost Errors:
 //		repository.NotFound
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *PostsRepository) UpdatePost(post *models.UpdatePost) error {
 	var awardsId sql.NullInt64
 	awardsId.Int64 = post.Awards
@@ -271,7 +388,7 @@ func (repo *PostsRepository) UpdatePost(post *models.UpdatePost) error {
 
 	var postsId int64
 	if err := repo.store.QueryRow(updateQuery, post.Title, post.Description,
-		awardsId, post.IsDraft, post.ID).Scan(&postsId); err != nil {
+		awardsId, post.IsDraft, post.UnlockPrice, post.PublishAt, post.Preview, post.ID).Scan(&postsId); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return repository.NotFound
 		}
@@ -282,8 +399,8 @@ func (repo *PostsRepository) UpdatePost(post *models.UpdatePost) error {
 }
 
 // UpdateCoverPost Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *PostsRepository) UpdateCoverPost(postId int64, cover string) error {
 	var postsId int64
 	if err := repo.store.QueryRow(updateCoverQuery, cover, postId).Scan(&postsId); err != nil {
@@ -297,8 +414,8 @@ func (repo *PostsRepository) UpdateCoverPost(postId int64, cover string) error {
 }
 
 // Delete Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *PostsRepository) Delete(postId int64) error {
 	row, err := repo.store.Query(deleteQuery, postId)
 	if err != nil {
@@ -311,3 +428,29 @@ func (repo *PostsRepository) Delete(postId int64) error {
 
 	return nil
 }
+
+// PublishDuePosts Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PostsRepository) PublishDuePosts(now time.Time) ([]models.Post, error) {
+	rows, err := repo.store.Query(publishDuePostsQuery, now)
+	if err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	var res []models.Post
+	for rows.Next() {
+		var post models.Post
+		if err = rows.Scan(&post.ID, &post.CreatorId, &post.Title); err != nil {
+			_ = rows.Close()
+			return nil, repository.NewDBError(err)
+		}
+		res = append(res, post)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	return res, nil
+}
@@ -6,9 +6,11 @@ import (
 	"patreon/internal/app"
 	"patreon/internal/app/models"
 	"patreon/internal/app/repository"
+	repository_posts "patreon/internal/app/repository/posts"
 	putilits "patreon/internal/app/utilits/postgresql"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -32,11 +34,12 @@ func (s *SuitePostsRepository) AfterTest(_, _ string) {
 }
 
 func (s *SuitePostsRepository) TestPostsRepository_Create() {
-	post := &models.CreatePost{ID: 2, Title: "sad", Description: "asdasd", Awards: 1, CreatorId: 2}
+	post := &models.CreatePost{ID: 2, Title: "sad", Description: "asdasd", Awards: 1, CreatorId: 2,
+		Preview: "first lines"}
 
 	s.Mock.ExpectQuery(regexp.QuoteMeta(createQuery)).
 		WithArgs(post.Title, post.Description, post.Awards,
-			post.CreatorId, app.DefaultImage, post.IsDraft).
+			post.CreatorId, app.DefaultImage, post.IsDraft, post.UnlockPrice, post.PublishAt, post.Preview).
 		WillReturnRows(sqlmock.NewRows([]string{"posts_id"}).AddRow(post.ID))
 	id, err := s.repo.Create(post)
 	assert.Equal(s.T(), post.ID, id)
@@ -48,7 +51,7 @@ func (s *SuitePostsRepository) TestPostsRepository_Create() {
 	awardsId.Valid = false
 
 	s.Mock.ExpectQuery(regexp.QuoteMeta(createQuery)).
-		WithArgs(post.Title, post.Description, awardsId, post.CreatorId, app.DefaultImage, post.IsDraft).
+		WithArgs(post.Title, post.Description, awardsId, post.CreatorId, app.DefaultImage, post.IsDraft, post.UnlockPrice, post.PublishAt, post.Preview).
 		WillReturnRows(sqlmock.NewRows([]string{"posts_id"}).AddRow(post.ID))
 	id, err = s.repo.Create(post)
 	assert.Equal(s.T(), post.ID, id)
@@ -56,7 +59,7 @@ func (s *SuitePostsRepository) TestPostsRepository_Create() {
 
 	post.Awards = 1
 	s.Mock.ExpectQuery(regexp.QuoteMeta(createQuery)).
-		WithArgs(post.Title, post.Description, post.Awards, post.CreatorId, app.DefaultImage, post.IsDraft).
+		WithArgs(post.Title, post.Description, post.Awards, post.CreatorId, app.DefaultImage, post.IsDraft, post.UnlockPrice, post.PublishAt, post.Preview).
 		WillReturnError(repository.DefaultErrDB)
 	_, err = s.repo.Create(post)
 	assert.Error(s.T(), err, repository.NewDBError(repository.DefaultErrDB))
@@ -87,14 +90,16 @@ func (s *SuitePostsRepository) TestPostsRepository_GetPostCreator() {
 }
 
 func (s *SuitePostsRepository) TestPostsRepository_GetPost() {
-	post := &models.Post{ID: 2, Title: "sad", Description: "asdasd", Awards: 1, CreatorId: 2}
+	post := &models.Post{ID: 2, Title: "sad", Description: "asdasd", Awards: 1, CreatorId: 2,
+		UnlockPrice: 50, Preview: "first lines", Locked: true}
 	userId := int64(5)
 	s.Mock.ExpectQuery(regexp.QuoteMeta(getPostQuery)).
 		WithArgs(userId, post.ID).
 		WillReturnRows(sqlmock.NewRows([]string{"title", "description", "likes",
-			"posts.date", "cover", "type_awards", "creator_id", "have_like", "views", "is_draft", "comments"}).
+			"posts.date", "cover", "type_awards", "creator_id", "have_like", "views", "is_draft", "comments", "unlock_price", "unlocked", "publish_at", "preview", "locked"}).
 			AddRow(post.Title, post.Description, post.Likes, post.Date, post.Cover,
-				post.Awards, post.CreatorId, post.AddLike, post.Views, post.IsDraft, post.Comments))
+				post.Awards, post.CreatorId, post.AddLike, post.Views, post.IsDraft, post.Comments,
+				post.UnlockPrice, post.Unlocked, post.PublishAt, post.Preview, post.Locked))
 	s.Mock.ExpectQuery(regexp.QuoteMeta(getPostQueryUpdate)).
 		WithArgs(post.ID).
 		WillReturnRows(sqlmock.NewRows([]string{}).AddRow())
@@ -105,9 +110,10 @@ func (s *SuitePostsRepository) TestPostsRepository_GetPost() {
 	s.Mock.ExpectQuery(regexp.QuoteMeta(getPostQuery)).
 		WithArgs(userId, post.ID).
 		WillReturnRows(sqlmock.NewRows([]string{"title", "description", "likes",
-			"posts.date", "cover", "type_awards", "creator_id", "have_like", "views", "is_draft", "comments"}).
+			"posts.date", "cover", "type_awards", "creator_id", "have_like", "views", "is_draft", "comments", "unlock_price", "unlocked", "publish_at", "preview", "locked"}).
 			AddRow(post.Title, post.Description, post.Likes, post.Date, post.Cover,
-				post.Awards, post.CreatorId, post.AddLike, post.Views, post.IsDraft, post.Comments))
+				post.Awards, post.CreatorId, post.AddLike, post.Views, post.IsDraft, post.Comments,
+				post.UnlockPrice, post.Unlocked, post.PublishAt, post.Preview, post.Locked))
 	res, err = s.repo.GetPost(post.ID, userId, false)
 	assert.Equal(s.T(), res, post)
 	assert.NoError(s.T(), err)
@@ -119,9 +125,10 @@ func (s *SuitePostsRepository) TestPostsRepository_GetPost() {
 	s.Mock.ExpectQuery(regexp.QuoteMeta(getPostQuery)).
 		WithArgs(userId, post.ID).
 		WillReturnRows(sqlmock.NewRows([]string{"title", "description", "likes",
-			"posts.date", "cover", "type_awards", "creator_id", "have_like", "views", "is_draft", "comments"}).
+			"posts.date", "cover", "type_awards", "creator_id", "have_like", "views", "is_draft", "comments", "unlock_price", "unlocked", "publish_at", "preview", "locked"}).
 			AddRow(post.Title, post.Description, post.Likes, post.Date, post.Cover,
-				awardsId, post.CreatorId, post.AddLike, post.Views, post.IsDraft, post.Comments))
+				awardsId, post.CreatorId, post.AddLike, post.Views, post.IsDraft, post.Comments,
+				post.UnlockPrice, post.Unlocked, post.PublishAt, post.Preview, post.Locked))
 	s.Mock.ExpectQuery(regexp.QuoteMeta(getPostQueryUpdate)).
 		WithArgs(post.ID).
 		WillReturnRows(sqlmock.NewRows([]string{}).AddRow())
@@ -134,9 +141,10 @@ func (s *SuitePostsRepository) TestPostsRepository_GetPost() {
 	s.Mock.ExpectQuery(regexp.QuoteMeta(getPostQuery)).
 		WithArgs(userId, post.ID).
 		WillReturnRows(sqlmock.NewRows([]string{"title", "description", "likes",
-			"posts.date", "cover", "type_awards", "creator_id", "have_like", "views", "is_draft", "comments"}).
+			"posts.date", "cover", "type_awards", "creator_id", "have_like", "views", "is_draft", "comments", "unlock_price", "unlocked", "publish_at", "preview", "locked"}).
 			AddRow(post.Title, post.Description, post.Likes, post.Date, post.Cover,
-				post.Awards, post.CreatorId, post.AddLike, post.Views, post.IsDraft, post.Comments))
+				post.Awards, post.CreatorId, post.AddLike, post.Views, post.IsDraft, post.Comments,
+				post.UnlockPrice, post.Unlocked, post.PublishAt, post.Preview, post.Locked))
 	s.Mock.ExpectQuery(regexp.QuoteMeta(getPostQueryUpdate)).
 		WithArgs(post.ID).
 		WillReturnRows(sqlmock.NewRows([]string{}).AddRow().CloseError(models.BDError))
@@ -146,9 +154,10 @@ func (s *SuitePostsRepository) TestPostsRepository_GetPost() {
 	s.Mock.ExpectQuery(regexp.QuoteMeta(getPostQuery)).
 		WithArgs(userId, post.ID).
 		WillReturnRows(sqlmock.NewRows([]string{"title", "description", "likes",
-			"posts.date", "cover", "type_awards", "creator_id", "have_like", "views", "is_draft", "comments"}).
+			"posts.date", "cover", "type_awards", "creator_id", "have_like", "views", "is_draft", "comments", "unlock_price", "unlocked", "publish_at", "preview", "locked"}).
 			AddRow(post.Title, post.Description, post.Likes, post.Date, post.Cover,
-				post.Awards, post.CreatorId, post.AddLike, post.Views, post.IsDraft, post.Comments))
+				post.Awards, post.CreatorId, post.AddLike, post.Views, post.IsDraft, post.Comments,
+				post.UnlockPrice, post.Unlocked, post.PublishAt, post.Preview, post.Locked))
 	s.Mock.ExpectQuery(regexp.QuoteMeta(getPostQueryUpdate)).
 		WithArgs(post.ID).
 		WillReturnError(models.BDError)
@@ -180,40 +189,71 @@ func (s *SuitePostsRepository) TestPostsRepository_GetPosts() {
 	assert.NoError(s.T(), err)
 	query := getPostsQueryWithoutDraft + fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
 
-	post := models.Post{ID: 2, Title: "sad", Description: "asdasd", Awards: 1, CreatorId: 2}
+	post := models.Post{ID: 2, Title: "sad", Description: "asdasd", Awards: 1, CreatorId: 2,
+		Preview: "first lines", Locked: true}
 	userId := int64(5)
 	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
 		WithArgs(tableName).
 		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).AddRow(int64(5000)))
 	s.Mock.ExpectQuery(regexp.QuoteMeta(query)).
-		WithArgs(userId, post.CreatorId).
+		WithArgs(userId, post.CreatorId, "").
 		WillReturnRows(sqlmock.NewRows([]string{"post_id", "title", "description", "likes",
-			"type_awards", "posts.date", "cover", "have_like", "views", "comments"}).
+			"type_awards", "posts.date", "cover", "have_like", "views", "comments", "unlock_price", "unlocked", "preview", "locked"}).
 			AddRow(post.ID, post.Title, post.Description, post.Likes, post.Awards, post.Date, post.Cover,
-				post.AddLike, post.Views, post.Comments))
-	res, err := s.repo.GetPosts(post.CreatorId, userId, pag, false)
+				post.AddLike, post.Views, post.Comments, post.UnlockPrice, post.Unlocked, post.Preview, post.Locked))
+	res, err := s.repo.GetPosts(post.CreatorId, userId, pag, false, "")
 	assert.Equal(s.T(), res[0], post)
 	assert.NoError(s.T(), err)
 
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
+		WithArgs(tableName).
+		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).AddRow(int64(5000)))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(userId, post.CreatorId, "tutorial").
+		WillReturnRows(sqlmock.NewRows([]string{"post_id", "title", "description", "likes",
+			"type_awards", "posts.date", "cover", "have_like", "views", "comments", "unlock_price", "unlocked", "preview", "locked"}))
+	res, err = s.repo.GetPosts(post.CreatorId, userId, pag, false, "tutorial")
+	assert.Empty(s.T(), res)
+	assert.NoError(s.T(), err)
+
 	queryWithDraft := getPostsQueryWithDraft + fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
 
 	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
 		WithArgs(tableName).
 		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).AddRow(int64(5000)))
 	s.Mock.ExpectQuery(regexp.QuoteMeta(queryWithDraft)).
-		WithArgs(userId, post.CreatorId).
+		WithArgs(userId, post.CreatorId, "").
 		WillReturnRows(sqlmock.NewRows([]string{"post_id", "title", "description", "likes",
-			"type_awards", "posts.date", "cover", "have_like", "views", "is_draft", "comments"}).
+			"type_awards", "posts.date", "cover", "have_like", "views", "is_draft", "comments",
+			"unlock_price", "unlocked", "publish_at", "preview", "locked"}).
 			AddRow(post.ID, post.Title, post.Description, post.Likes, post.Awards, post.Date, post.Cover,
-				post.AddLike, post.Views, post.IsDraft, post.Comments))
-	res, err = s.repo.GetPosts(post.CreatorId, userId, pag, true)
+				post.AddLike, post.Views, post.IsDraft, post.Comments, post.UnlockPrice, post.Unlocked, post.PublishAt, post.Preview, post.Locked))
+	res, err = s.repo.GetPosts(post.CreatorId, userId, pag, true, "")
 	assert.Equal(s.T(), res[0], post)
 	assert.NoError(s.T(), err)
 
+	publishAt := time.Date(2021, 12, 1, 10, 0, 0, 0, time.UTC)
+	scheduled := post
+	scheduled.IsDraft = true
+	scheduled.PublishAt = &publishAt
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
+		WithArgs(tableName).
+		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).AddRow(int64(5000)))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryWithDraft)).
+		WithArgs(userId, post.CreatorId, "").
+		WillReturnRows(sqlmock.NewRows([]string{"post_id", "title", "description", "likes",
+			"type_awards", "posts.date", "cover", "have_like", "views", "is_draft", "comments",
+			"unlock_price", "unlocked", "publish_at", "preview", "locked"}).
+			AddRow(post.ID, post.Title, post.Description, post.Likes, post.Awards, post.Date, post.Cover,
+				post.AddLike, post.Views, scheduled.IsDraft, post.Comments, post.UnlockPrice, post.Unlocked, publishAt, post.Preview, post.Locked))
+	res, err = s.repo.GetPosts(post.CreatorId, userId, pag, true, "")
+	assert.Equal(s.T(), scheduled, res[0])
+	assert.NoError(s.T(), err)
+
 	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
 		WithArgs(tableName).
 		WillReturnError(repository.DefaultErrDB)
-	_, err = s.repo.GetPosts(post.CreatorId, userId, pag, false)
+	_, err = s.repo.GetPosts(post.CreatorId, userId, pag, false, "")
 	assert.Error(s.T(), err, repository.NewDBError(repository.DefaultErrDB))
 
 	var awardsId sql.NullInt64
@@ -224,12 +264,12 @@ func (s *SuitePostsRepository) TestPostsRepository_GetPosts() {
 		WithArgs(tableName).
 		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).AddRow(int64(5000)))
 	s.Mock.ExpectQuery(regexp.QuoteMeta(query)).
-		WithArgs(userId, post.CreatorId).
+		WithArgs(userId, post.CreatorId, "").
 		WillReturnRows(sqlmock.NewRows([]string{"post_id", "title", "description", "likes",
-			"type_awards", "posts.date", "cover", "have_like", "views", "comments"}).
+			"type_awards", "posts.date", "cover", "have_like", "views", "comments", "unlock_price", "unlocked", "preview", "locked"}).
 			AddRow(post.ID, post.Title, post.Description, post.Likes, awardsId, post.Date, post.Cover,
-				post.AddLike, post.Views, post.Comments))
-	res, err = s.repo.GetPosts(post.CreatorId, userId, pag, false)
+				post.AddLike, post.Views, post.Comments, post.UnlockPrice, post.Unlocked, post.Preview, post.Locked))
+	res, err = s.repo.GetPosts(post.CreatorId, userId, pag, false, "")
 	post.Awards = repository.NoAwards
 	assert.Equal(s.T(), res[0], post)
 	post.Awards = 1
@@ -239,41 +279,41 @@ func (s *SuitePostsRepository) TestPostsRepository_GetPosts() {
 		WithArgs(tableName).
 		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).AddRow(int64(5000)))
 	s.Mock.ExpectQuery(regexp.QuoteMeta(query)).
-		WithArgs(userId, post.CreatorId).
+		WithArgs(userId, post.CreatorId, "").
 		WillReturnRows(sqlmock.NewRows([]string{"title", "description", "likes",
 			"posts.date", "cover", "type_awards", "creator_id", "have_like", "views", "comments"}).
 			AddRow(post.Title, post.Description, post.Likes, post.Date, post.Cover,
 				post.Awards, post.CreatorId, post.Description, post.Views, post.Comments))
-	_, err = s.repo.GetPosts(post.CreatorId, userId, pag, false)
+	_, err = s.repo.GetPosts(post.CreatorId, userId, pag, false, "")
 	assert.Error(s.T(), err)
 
 	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
 		WithArgs(tableName).
 		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).AddRow(int64(5000)))
 	s.Mock.ExpectQuery(regexp.QuoteMeta(query)).
-		WithArgs(userId, post.CreatorId).
+		WithArgs(userId, post.CreatorId, "").
 		WillReturnRows(sqlmock.NewRows([]string{"post_id", "title", "description", "likes",
-			"type_awards", "posts.date", "cover", "have_like", "views", "comments"}).
+			"type_awards", "posts.date", "cover", "have_like", "views", "comments", "unlock_price", "unlocked", "preview", "locked"}).
 			AddRow(post.ID, post.Title, post.Description, post.Likes, post.Awards, post.Date, post.Cover,
-				post.AddLike, post.Views, post.Comments).RowError(0, models.BDError))
-	_, err = s.repo.GetPosts(post.CreatorId, userId, pag, false)
+				post.AddLike, post.Views, post.Comments, post.UnlockPrice, post.Unlocked, post.Preview, post.Locked).RowError(0, models.BDError))
+	_, err = s.repo.GetPosts(post.CreatorId, userId, pag, false, "")
 	assert.Error(s.T(), err, repository.NewDBError(models.BDError))
 
 	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
 		WithArgs(tableName).
 		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).AddRow(int64(5000)))
 	s.Mock.ExpectQuery(regexp.QuoteMeta(query)).
-		WithArgs(userId, post.CreatorId).
+		WithArgs(userId, post.CreatorId, "").
 		WillReturnError(models.BDError)
-	_, err = s.repo.GetPosts(post.CreatorId, userId, pag, false)
+	_, err = s.repo.GetPosts(post.CreatorId, userId, pag, false, "")
 	assert.Error(s.T(), err, repository.NewDBError(models.BDError))
 }
 
 func (s *SuitePostsRepository) TestPostsRepository_Update() {
-	post := &models.UpdatePost{ID: 2, Title: "sad", Description: "asdasd", Awards: 1}
+	post := &models.UpdatePost{ID: 2, Title: "sad", Description: "asdasd", Awards: 1, Preview: "first lines"}
 
 	s.Mock.ExpectQuery(regexp.QuoteMeta(updateQuery)).
-		WithArgs(post.Title, post.Description, post.Awards, post.IsDraft, post.ID).
+		WithArgs(post.Title, post.Description, post.Awards, post.IsDraft, post.UnlockPrice, post.PublishAt, post.Preview, post.ID).
 		WillReturnRows(sqlmock.NewRows([]string{"posts_id"}).AddRow(post.ID))
 	err := s.repo.UpdatePost(post)
 	assert.NoError(s.T(), err)
@@ -284,20 +324,20 @@ func (s *SuitePostsRepository) TestPostsRepository_Update() {
 	awardsId.Valid = false
 
 	s.Mock.ExpectQuery(regexp.QuoteMeta(updateQuery)).
-		WithArgs(post.Title, post.Description, awardsId, post.IsDraft, post.ID).
+		WithArgs(post.Title, post.Description, awardsId, post.IsDraft, post.UnlockPrice, post.PublishAt, post.Preview, post.ID).
 		WillReturnRows(sqlmock.NewRows([]string{"posts_id"}).AddRow(post.ID))
 	err = s.repo.UpdatePost(post)
 	assert.NoError(s.T(), err)
 
 	post.Awards = 1
 	s.Mock.ExpectQuery(regexp.QuoteMeta(updateQuery)).
-		WithArgs(post.Title, post.Description, post.Awards, post.IsDraft, post.ID).
+		WithArgs(post.Title, post.Description, post.Awards, post.IsDraft, post.UnlockPrice, post.PublishAt, post.Preview, post.ID).
 		WillReturnError(repository.DefaultErrDB)
 	err = s.repo.UpdatePost(post)
 	assert.Error(s.T(), err, repository.NewDBError(repository.DefaultErrDB))
 
 	s.Mock.ExpectQuery(regexp.QuoteMeta(updateQuery)).
-		WithArgs(post.Title, post.Description, post.Awards, post.IsDraft, post.ID).
+		WithArgs(post.Title, post.Description, post.Awards, post.IsDraft, post.UnlockPrice, post.PublishAt, post.Preview, post.ID).
 		WillReturnError(sql.ErrNoRows)
 	err = s.repo.UpdatePost(post)
 	assert.Error(s.T(), err, repository.NotFound)
@@ -346,6 +386,101 @@ func (s *SuitePostsRepository) TestPostsRepository_Delete() {
 	assert.Error(s.T(), err, repository.NewDBError(repository.DefaultErrDB))
 }
 
+func (s *SuitePostsRepository) TestPostsRepository_PublishDuePosts() {
+	now := time.Date(2021, 12, 1, 10, 0, 0, 0, time.UTC)
+	expected := []models.Post{
+		{ID: 2, CreatorId: 3, Title: "first"},
+		{ID: 4, CreatorId: 5, Title: "second"},
+	}
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(publishDuePostsQuery)).
+		WithArgs(now).
+		WillReturnRows(sqlmock.NewRows([]string{"posts_id", "creator_id", "title"}).
+			AddRow(expected[0].ID, expected[0].CreatorId, expected[0].Title).
+			AddRow(expected[1].ID, expected[1].CreatorId, expected[1].Title))
+	res, err := s.repo.PublishDuePosts(now)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), expected, res)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(publishDuePostsQuery)).
+		WithArgs(now).
+		WillReturnRows(sqlmock.NewRows([]string{"posts_id", "creator_id", "title"}))
+	res, err = s.repo.PublishDuePosts(now)
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), res)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(publishDuePostsQuery)).
+		WithArgs(now).
+		WillReturnError(repository.DefaultErrDB)
+	_, err = s.repo.PublishDuePosts(now)
+	assert.Error(s.T(), err, repository.NewDBError(repository.DefaultErrDB))
+}
+
+func (s *SuitePostsRepository) TestPostsRepository_SearchPosts() {
+	queryStat := "SELECT n_live_tup FROM pg_stat_all_tables WHERE relname = $1"
+	tableName := "search_posts"
+	pag := &models.Pagination{Limit: 10, Offset: 20}
+	userId, searchString := int64(5), "guitar"
+	columns := []string{"posts_id", "title", "description", "likes", "date", "cover", "type_awards",
+		"creator_id", "nickname", "add_like", "views", "is_draft", "comments", "unlock_price", "unlocked", "snippet"}
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
+		WithArgs(tableName).
+		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).AddRow(int64(5000)))
+	limit, offset, err := putilits.AddPagination(tableName, pag, s.DB)
+	assert.NoError(s.T(), err)
+
+	post := models.SearchedPost{AvailablePost: models.AvailablePost{CreatorNickname: "musician",
+		Post: models.Post{ID: 2, Title: "Lesson", Description: "first", Awards: repository.NoAwards, CreatorId: 3}},
+		Snippet: "playing <b>guitar</b>"}
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
+		WithArgs(tableName).
+		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).AddRow(int64(5000)))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(searchPostsQuery)).
+		WithArgs(userId, searchString, post.CreatorId, limit, offset).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(post.ID, post.Title, post.Description, post.Likes, post.Date, post.Cover, sql.NullInt64{},
+				post.CreatorId, post.CreatorNickname, post.AddLike, post.Views, post.IsDraft, post.Comments,
+				post.UnlockPrice, post.Unlocked, post.Snippet))
+	res, err := s.repo.SearchPosts(userId, post.CreatorId, searchString, pag)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []models.SearchedPost{post}, res)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
+		WithArgs(tableName).
+		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).AddRow(int64(5000)))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(searchPostsQuery)).
+		WithArgs(userId, searchString, int64(repository_posts.AllCreators), limit, offset).
+		WillReturnRows(sqlmock.NewRows(columns))
+	res, err = s.repo.SearchPosts(userId, repository_posts.AllCreators, searchString, pag)
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), res)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
+		WithArgs(tableName).
+		WillReturnError(repository.DefaultErrDB)
+	_, err = s.repo.SearchPosts(userId, post.CreatorId, searchString, pag)
+	assert.Error(s.T(), err, repository.NewDBError(repository.DefaultErrDB))
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
+		WithArgs(tableName).
+		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).AddRow(int64(5000)))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(searchPostsQuery)).
+		WithArgs(userId, searchString, post.CreatorId, limit, offset).
+		WillReturnRows(sqlmock.NewRows([]string{"posts_id", "title"}).AddRow(post.ID, post.Title))
+	_, err = s.repo.SearchPosts(userId, post.CreatorId, searchString, pag)
+	assert.Error(s.T(), err)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
+		WithArgs(tableName).
+		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).AddRow(int64(5000)))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(searchPostsQuery)).
+		WithArgs(userId, searchString, post.CreatorId, limit, offset).
+		WillReturnError(models.BDError)
+	_, err = s.repo.SearchPosts(userId, post.CreatorId, searchString, pag)
+	assert.Error(s.T(), err, repository.NewDBError(models.BDError))
+}
+
 func TestPostsRepository(t *testing.T) {
 	suite.Run(t, new(SuitePostsRepository))
 }
@@ -2,8 +2,12 @@ package repository_posts
 
 import (
 	"patreon/internal/app/models"
+	"time"
 )
 
+// AllCreators used in SearchPosts instead of creator id for searching among posts of all creators
+const AllCreators = -1
+
 //go:generate mockgen -destination=mocks/mock_posts_repository.go -package=mock_repository -mock_names=Repository=PostsRepository . Repository
 
 type Repository interface {
@@ -18,10 +22,11 @@ type Repository interface {
 	// 			repository.DefaultErrDB
 	GetPost(postID int64, userId int64, addView bool) (*models.Post, error)
 
-	// GetAvailablePosts Errors:
+	// GetAvailablePosts return posts available for user, only posts marked by tag if it is not empty
+	// Errors:
 	// 		app.GeneralError with Errors:
 	// 			repository.DefaultErrDB
-	GetAvailablePosts(userId int64, pag *models.Pagination) ([]models.AvailablePost, error)
+	GetAvailablePosts(userId int64, pag *models.Pagination, tag string) ([]models.AvailablePost, error)
 
 	// GetPostCreator Errors:
 	//		repository.NotFound
@@ -29,10 +34,18 @@ type Repository interface {
 	// 			repository.DefaultErrDB
 	GetPostCreator(postID int64) (int64, error)
 
-	// GetPosts Errors:
+	// GetPosts return posts of creator, only posts marked by tag if it is not empty
+	// Errors:
+	// 		app.GeneralError with Errors:
+	// 			repository.DefaultErrDB
+	GetPosts(creatorsId int64, userId int64, pag *models.Pagination, withDraft bool, tag string) ([]models.Post, error)
+
+	// SearchPosts return posts of creator or of all creators if creatorId is AllCreators, matched searchString
+	// and available for user, ordered by rank of match. Drafts are searched only for their creator
+	// Errors:
 	// 		app.GeneralError with Errors:
 	// 			repository.DefaultErrDB
-	GetPosts(creatorsId int64, userId int64, pag *models.Pagination, withDraft bool) ([]models.Post, error)
+	SearchPosts(userId int64, creatorId int64, searchString string, pag *models.Pagination) ([]models.SearchedPost, error)
 
 	// UpdatePost Errors:
 	//		repository.NotFound
@@ -50,4 +63,11 @@ type Repository interface {
 	// 		app.GeneralError with Errors:
 	// 			repository.DefaultErrDB
 	Delete(postId int64) error
+
+	// PublishDuePosts publish scheduled posts with publish_at not after now and return them,
+	// every post is returned only once even with concurrent calls
+	// Errors:
+	// 		app.GeneralError with Errors:
+	// 			repository.DefaultErrDB
+	PublishDuePosts(now time.Time) ([]models.Post, error)
 }
@@ -0,0 +1,9 @@
+package repository_promo_codes
+
+import "github.com/pkg/errors"
+
+var (
+	PromoCodeAlreadyExists = errors.New("creator already have promo code with this code")
+	PromoCodeExhausted     = errors.New("promo code reached its max uses")
+	PromoCodeAlreadyUsed   = errors.New("user already used this promo code")
+)
@@ -0,0 +1,108 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: patreon/internal/app/repository/promo_codes (interfaces: Repository)
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	models "patreon/internal/app/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// PromoCodesRepository is a mock of Repository interface.
+type PromoCodesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *PromoCodesRepositoryMockRecorder
+}
+
+// PromoCodesRepositoryMockRecorder is the mock recorder for PromoCodesRepository.
+type PromoCodesRepositoryMockRecorder struct {
+	mock *PromoCodesRepository
+}
+
+// NewPromoCodesRepository creates a new mock instance.
+func NewPromoCodesRepository(ctrl *gomock.Controller) *PromoCodesRepository {
+	mock := &PromoCodesRepository{ctrl: ctrl}
+	mock.recorder = &PromoCodesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *PromoCodesRepository) EXPECT() *PromoCodesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *PromoCodesRepository) Create(arg0 *models.PromoCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *PromoCodesRepositoryMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*PromoCodesRepository)(nil).Create), arg0)
+}
+
+// Deactivate mocks base method.
+func (m *PromoCodesRepository) Deactivate(arg0, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deactivate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Deactivate indicates an expected call of Deactivate.
+func (mr *PromoCodesRepositoryMockRecorder) Deactivate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deactivate", reflect.TypeOf((*PromoCodesRepository)(nil).Deactivate), arg0, arg1)
+}
+
+// GetByCode mocks base method.
+func (m *PromoCodesRepository) GetByCode(arg0 int64, arg1 string) (*models.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCode", arg0, arg1)
+	ret0, _ := ret[0].(*models.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCode indicates an expected call of GetByCode.
+func (mr *PromoCodesRepositoryMockRecorder) GetByCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCode", reflect.TypeOf((*PromoCodesRepository)(nil).GetByCode), arg0, arg1)
+}
+
+// GetByCreator mocks base method.
+func (m *PromoCodesRepository) GetByCreator(arg0 int64) ([]models.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCreator", arg0)
+	ret0, _ := ret[0].([]models.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCreator indicates an expected call of GetByCreator.
+func (mr *PromoCodesRepositoryMockRecorder) GetByCreator(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCreator", reflect.TypeOf((*PromoCodesRepository)(nil).GetByCreator), arg0)
+}
+
+// IsUsed mocks base method.
+func (m *PromoCodesRepository) IsUsed(arg0, arg1 int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsUsed", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsUsed indicates an expected call of IsUsed.
+func (mr *PromoCodesRepositoryMockRecorder) IsUsed(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUsed", reflect.TypeOf((*PromoCodesRepository)(nil).IsUsed), arg0, arg1)
+}
@@ -0,0 +1,163 @@
+package repository_postgresql
+
+import (
+	"database/sql"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_promo_codes "patreon/internal/app/repository/promo_codes"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+const (
+	codeDuplicateVal = "23505"
+
+	queryCreate = "INSERT INTO promo_codes (creator_id, code, discount_type, discount, max_uses, valid_from, valid_until) " +
+		"VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING promo_codes_id, active, date"
+	queryAddAwards = "INSERT INTO promo_code_awards (promo_codes_id, awards_id) SELECT $1, unnest($2::bigint[])"
+
+	querySelectCodes = `
+	SELECT p.promo_codes_id, p.creator_id, p.code, p.discount_type, p.discount, p.max_uses, p.uses,
+	       p.valid_from, p.valid_until, p.active, p.date,
+	       COALESCE(array_agg(a.awards_id) FILTER (WHERE a.awards_id IS NOT NULL), '{}')
+	FROM promo_codes p LEFT JOIN promo_code_awards a ON p.promo_codes_id = a.promo_codes_id `
+	queryGetByCreator = querySelectCodes + "WHERE p.creator_id = $1 GROUP BY p.promo_codes_id ORDER BY p.date DESC"
+	queryGetByCode    = querySelectCodes + "WHERE p.creator_id = $1 and p.code = $2 GROUP BY p.promo_codes_id"
+
+	queryDeactivate = "UPDATE promo_codes SET active = false WHERE promo_codes_id = $2 and creator_id = $1"
+	queryIsUsed     = "SELECT count(*) FROM promo_code_uses WHERE promo_codes_id = $1 and users_id = $2"
+)
+
+type PromoCodesRepository struct {
+	store *sqlx.DB
+}
+
+var _ = repository_promo_codes.Repository(&PromoCodesRepository{})
+
+func NewPromoCodesRepository(store *sqlx.DB) *PromoCodesRepository {
+	return &PromoCodesRepository{
+		store: store,
+	}
+}
+
+// Create Errors:
+//		repository_promo_codes.PromoCodeAlreadyExists
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PromoCodesRepository) Create(code *models.PromoCode) error {
+	begin, err := repo.store.Begin()
+	if err != nil {
+		return repository.NewDBError(err)
+	}
+
+	if err = begin.QueryRow(queryCreate, code.CreatorID, code.Code, code.DiscountType, code.Discount,
+		code.MaxUses, code.ValidFrom, code.ValidUntil).Scan(&code.ID, &code.Active, &code.Date); err != nil {
+		_ = begin.Rollback()
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == codeDuplicateVal {
+			return repository_promo_codes.PromoCodeAlreadyExists
+		}
+		return repository.NewDBError(err)
+	}
+	if len(code.AwardIDs) != 0 {
+		if _, err = begin.Exec(queryAddAwards, code.ID, pq.Array(code.AwardIDs)); err != nil {
+			_ = begin.Rollback()
+			return repository.NewDBError(err)
+		}
+	}
+
+	if err = begin.Commit(); err != nil {
+		return repository.NewDBError(err)
+	}
+	return nil
+}
+
+// GetByCreator Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PromoCodesRepository) GetByCreator(creatorID int64) ([]models.PromoCode, error) {
+	rows, err := repo.store.Query(queryGetByCreator, creatorID)
+	if err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	res := make([]models.PromoCode, 0)
+	for rows.Next() {
+		cur := models.PromoCode{}
+		if err = scanCode(rows, &cur); err != nil {
+			_ = rows.Close()
+			return nil, repository.NewDBError(errors.Wrapf(err, "method - GetByCreator"+
+				"invalid data in db: table promo_codes"))
+		}
+		res = append(res, cur)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, repository.NewDBError(err)
+	}
+	return res, nil
+}
+
+// GetByCode Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PromoCodesRepository) GetByCode(creatorID int64, code string) (*models.PromoCode, error) {
+	res := &models.PromoCode{}
+	if err := scanCode(repo.store.QueryRow(queryGetByCode, creatorID, code), res); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.NotFound
+		}
+		return nil, repository.NewDBError(err)
+	}
+	return res, nil
+}
+
+// Deactivate Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PromoCodesRepository) Deactivate(creatorID int64, codeID int64) error {
+	res, err := repo.store.Exec(queryDeactivate, creatorID, codeID)
+	if err != nil {
+		return repository.NewDBError(err)
+	}
+	cnt, err := res.RowsAffected()
+	if err != nil {
+		return repository.NewDBError(err)
+	}
+	if cnt == 0 {
+		return repository.NotFound
+	}
+	return nil
+}
+
+// IsUsed Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PromoCodesRepository) IsUsed(codeID int64, userID int64) (bool, error) {
+	count := 0
+	if err := repo.store.QueryRow(queryIsUsed, codeID, userID).Scan(&count); err != nil {
+		return false, repository.NewDBError(err)
+	}
+	return count != 0, nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanCode(row scanner, code *models.PromoCode) error {
+	awards := pq.Int64Array{}
+	validUntil := sql.NullTime{}
+	if err := row.Scan(&code.ID, &code.CreatorID, &code.Code, &code.DiscountType, &code.Discount, &code.MaxUses,
+		&code.Uses, &code.ValidFrom, &validUntil, &code.Active, &code.Date, &awards); err != nil {
+		return err
+	}
+	code.AwardIDs = awards
+	if validUntil.Valid {
+		code.ValidUntil = &validUntil.Time
+	}
+	return nil
+}
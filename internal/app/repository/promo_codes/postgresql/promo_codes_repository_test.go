@@ -0,0 +1,120 @@
+package repository_postgresql
+
+import (
+	"database/sql"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_promo_codes "patreon/internal/app/repository/promo_codes"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+)
+
+type SuitePromoCodesRepository struct {
+	models.Suite
+	repo *PromoCodesRepository
+}
+
+func (s *SuitePromoCodesRepository) SetupSuite() {
+	s.InitBD()
+	s.repo = NewPromoCodesRepository(s.DB)
+}
+
+func (s *SuitePromoCodesRepository) AfterTest(_, _ string) {
+	require.NoError(s.T(), s.Mock.ExpectationsWereMet())
+}
+
+func (s *SuitePromoCodesRepository) TestPromoCodesRepository_Create_OK() {
+	code := models.TestPromoCode()
+	code.AwardIDs = []int64{3, 4}
+	now := time.Now()
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCreate)).
+		WithArgs(code.CreatorID, code.Code, code.DiscountType, code.Discount, code.MaxUses, code.ValidFrom, code.ValidUntil).
+		WillReturnRows(sqlmock.NewRows([]string{"promo_codes_id", "active", "date"}).AddRow(7, true, now))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddAwards)).
+		WithArgs(7, pq.Array(code.AwardIDs)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	s.Mock.ExpectCommit()
+	err := s.repo.Create(code)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(7), code.ID)
+}
+
+func (s *SuitePromoCodesRepository) TestPromoCodesRepository_Create_AlreadyExists() {
+	code := models.TestPromoCode()
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCreate)).
+		WithArgs(code.CreatorID, code.Code, code.DiscountType, code.Discount, code.MaxUses, code.ValidFrom, code.ValidUntil).
+		WillReturnError(&pq.Error{Code: codeDuplicateVal})
+	s.Mock.ExpectRollback()
+	err := s.repo.Create(code)
+	assert.Equal(s.T(), repository_promo_codes.PromoCodeAlreadyExists, err)
+}
+
+func (s *SuitePromoCodesRepository) TestPromoCodesRepository_GetByCode() {
+	code := models.TestPromoCode()
+	code.AwardIDs = []int64{3}
+	until := code.ValidFrom.Add(time.Hour)
+	code.ValidUntil = &until
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetByCode)).
+		WithArgs(code.CreatorID, code.Code).
+		WillReturnRows(sqlmock.NewRows([]string{"promo_codes_id", "creator_id", "code", "discount_type", "discount",
+			"max_uses", "uses", "valid_from", "valid_until", "active", "date", "awards"}).
+			AddRow(code.ID, code.CreatorID, code.Code, code.DiscountType, code.Discount, code.MaxUses, code.Uses,
+				code.ValidFrom, until, code.Active, code.Date, "{3}"))
+	res, err := s.repo.GetByCode(code.CreatorID, code.Code)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), code, res)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetByCode)).
+		WithArgs(code.CreatorID, "OTHER").
+		WillReturnError(sql.ErrNoRows)
+	_, err = s.repo.GetByCode(code.CreatorID, "OTHER")
+	assert.Equal(s.T(), repository.NotFound, err)
+}
+
+func (s *SuitePromoCodesRepository) TestPromoCodesRepository_GetByCreator() {
+	code := models.TestPromoCode()
+	code.AwardIDs = []int64{}
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetByCreator)).
+		WithArgs(code.CreatorID).
+		WillReturnRows(sqlmock.NewRows([]string{"promo_codes_id", "creator_id", "code", "discount_type", "discount",
+			"max_uses", "uses", "valid_from", "valid_until", "active", "date", "awards"}).
+			AddRow(code.ID, code.CreatorID, code.Code, code.DiscountType, code.Discount, code.MaxUses, code.Uses,
+				code.ValidFrom, nil, code.Active, code.Date, "{}"))
+	res, err := s.repo.GetByCreator(code.CreatorID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []models.PromoCode{*code}, res)
+}
+
+func (s *SuitePromoCodesRepository) TestPromoCodesRepository_Deactivate() {
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryDeactivate)).
+		WithArgs(1, 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(s.T(), s.repo.Deactivate(1, 7))
+
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryDeactivate)).
+		WithArgs(1, 8).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.Equal(s.T(), repository.NotFound, s.repo.Deactivate(1, 8))
+}
+
+func (s *SuitePromoCodesRepository) TestPromoCodesRepository_IsUsed() {
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryIsUsed)).
+		WithArgs(7, 11).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	used, err := s.repo.IsUsed(7, 11)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), used)
+}
+
+func TestPromoCodesRepository(t *testing.T) {
+	suite.Run(t, new(SuitePromoCodesRepository))
+}
@@ -0,0 +1,31 @@
+package repository_promo_codes
+
+import "patreon/internal/app/models"
+
+//go:generate mockgen -destination=mocks/mock_promo_codes_repository.go -package=mock_repository -mock_names=Repository=PromoCodesRepository . Repository
+
+type Repository interface {
+	// Create Errors:
+	//		repository_promo_codes.PromoCodeAlreadyExists
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	Create(code *models.PromoCode) error
+	// GetByCreator Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetByCreator(creatorID int64) ([]models.PromoCode, error)
+	// GetByCode Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetByCode(creatorID int64, code string) (*models.PromoCode, error)
+	// Deactivate Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	Deactivate(creatorID int64, codeID int64) error
+	// IsUsed Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	IsUsed(codeID int64, userID int64) (bool, error)
+}
@@ -8,23 +8,43 @@ import (
 	repoAttachesPsql "patreon/internal/app/repository/attaches/postgresql"
 	repoAwrds "patreon/internal/app/repository/awards"
 	repAwardsPsql "patreon/internal/app/repository/awards/postgresql"
+	repoBlocked "patreon/internal/app/repository/blocked_users"
+	repoBlockedPsql "patreon/internal/app/repository/blocked_users/postgresql"
 	repoComments "patreon/internal/app/repository/comments"
 	repCommentsPsql "patreon/internal/app/repository/comments/postgresql"
 	repCreator "patreon/internal/app/repository/creator"
 	repCreatorPsql "patreon/internal/app/repository/creator/postgresql"
+	repoGifts "patreon/internal/app/repository/gifts"
+	repoGiftsPsql "patreon/internal/app/repository/gifts/postgresql"
 	repoInfo "patreon/internal/app/repository/info"
 	repInfoPsql "patreon/internal/app/repository/info/postgresql"
+	repoLedger "patreon/internal/app/repository/ledger"
+	repoLedgerPsql "patreon/internal/app/repository/ledger/postgresql"
 	repoLikes "patreon/internal/app/repository/likes"
 	repLikesPsql "patreon/internal/app/repository/likes/postgresql"
 	repoPayToken "patreon/internal/app/repository/pay_token"
 	repoPayTokenRedis "patreon/internal/app/repository/pay_token/redis"
 	repoPayments "patreon/internal/app/repository/payments"
 	repoPaymentsPsql "patreon/internal/app/repository/payments/postgresql"
+	repoPolls "patreon/internal/app/repository/polls"
+	repoPollsPsql "patreon/internal/app/repository/polls/postgresql"
+	repoPostRevisions "patreon/internal/app/repository/post_revisions"
+	repoPostRevisionsPsql "patreon/internal/app/repository/post_revisions/postgresql"
+	repoPostUnlocks "patreon/internal/app/repository/post_unlocks"
+	repoPostUnlocksPsql "patreon/internal/app/repository/post_unlocks/postgresql"
 	repoPosts "patreon/internal/app/repository/posts"
 	repPostsPsql "patreon/internal/app/repository/posts/postgresql"
+	repoPromoCodes "patreon/internal/app/repository/promo_codes"
+	repoPromoCodesPsql "patreon/internal/app/repository/promo_codes/postgresql"
 	repStats "patreon/internal/app/repository/statistics"
 	repStatsPsql "patreon/internal/app/repository/statistics/postgresql"
 	repoSubscribers "patreon/internal/app/repository/subscribers"
+	repoSubscrEvents "patreon/internal/app/repository/subscription_events"
+	repoSubscrEventsPsql "patreon/internal/app/repository/subscription_events/postgresql"
+	repoTags "patreon/internal/app/repository/tags"
+	repoTagsPsql "patreon/internal/app/repository/tags/postgresql"
+	repoTips "patreon/internal/app/repository/tips"
+	repoTipsPsql "patreon/internal/app/repository/tips/postgresql"
 	repUser "patreon/internal/app/repository/user"
 	repUserPsql "patreon/internal/app/repository/user/postgresql"
 	push_client "patreon/internal/microservices/push/delivery/client"
@@ -50,6 +70,16 @@ type RepositoryFactory struct {
 	statsRepository       repStats.Repository
 	payTokenRepository    repoPayToken.Repository
 	commentsRepository    repoComments.Repository
+	ledgerRepository      repoLedger.Repository
+	promoCodesRepository  repoPromoCodes.Repository
+	giftsRepository       repoGifts.Repository
+	tipsRepository        repoTips.Repository
+	postUnlocksRepository repoPostUnlocks.Repository
+	eventsRepository      repoSubscrEvents.Repository
+	blockedRepository     repoBlocked.Repository
+	revisionsRepository   repoPostRevisions.Repository
+	tagsRepository        repoTags.Repository
+	pollsRepository       repoPolls.Repository
 	pusher                push_client.Pusher
 }
 
@@ -160,3 +190,73 @@ func (f *RepositoryFactory) GetPayTokenRepository() repoPayToken.Repository {
 	}
 	return f.payTokenRepository
 }
+
+func (f *RepositoryFactory) GetLedgerRepository() repoLedger.Repository {
+	if f.ledgerRepository == nil {
+		f.ledgerRepository = repoLedgerPsql.NewLedgerRepository(f.expectedConnections.SqlConnection)
+	}
+	return f.ledgerRepository
+}
+
+func (f *RepositoryFactory) GetPromoCodesRepository() repoPromoCodes.Repository {
+	if f.promoCodesRepository == nil {
+		f.promoCodesRepository = repoPromoCodesPsql.NewPromoCodesRepository(f.expectedConnections.SqlConnection)
+	}
+	return f.promoCodesRepository
+}
+
+func (f *RepositoryFactory) GetGiftsRepository() repoGifts.Repository {
+	if f.giftsRepository == nil {
+		f.giftsRepository = repoGiftsPsql.NewGiftsRepository(f.expectedConnections.SqlConnection)
+	}
+	return f.giftsRepository
+}
+
+func (f *RepositoryFactory) GetTipsRepository() repoTips.Repository {
+	if f.tipsRepository == nil {
+		f.tipsRepository = repoTipsPsql.NewTipsRepository(f.expectedConnections.SqlConnection)
+	}
+	return f.tipsRepository
+}
+
+func (f *RepositoryFactory) GetPostUnlocksRepository() repoPostUnlocks.Repository {
+	if f.postUnlocksRepository == nil {
+		f.postUnlocksRepository = repoPostUnlocksPsql.NewPostUnlocksRepository(f.expectedConnections.SqlConnection)
+	}
+	return f.postUnlocksRepository
+}
+
+func (f *RepositoryFactory) GetSubscriptionEventsRepository() repoSubscrEvents.Repository {
+	if f.eventsRepository == nil {
+		f.eventsRepository = repoSubscrEventsPsql.NewSubscriptionEventsRepository(f.expectedConnections.SqlConnection)
+	}
+	return f.eventsRepository
+}
+
+func (f *RepositoryFactory) GetBlockedUsersRepository() repoBlocked.Repository {
+	if f.blockedRepository == nil {
+		f.blockedRepository = repoBlockedPsql.NewBlockedUsersRepository(f.expectedConnections.SqlConnection)
+	}
+	return f.blockedRepository
+}
+
+func (f *RepositoryFactory) GetPostRevisionsRepository() repoPostRevisions.Repository {
+	if f.revisionsRepository == nil {
+		f.revisionsRepository = repoPostRevisionsPsql.NewPostRevisionsRepository(f.expectedConnections.SqlConnection)
+	}
+	return f.revisionsRepository
+}
+
+func (f *RepositoryFactory) GetTagsRepository() repoTags.Repository {
+	if f.tagsRepository == nil {
+		f.tagsRepository = repoTagsPsql.NewTagsRepository(f.expectedConnections.SqlConnection)
+	}
+	return f.tagsRepository
+}
+
+func (f *RepositoryFactory) GetPollsRepository() repoPolls.Repository {
+	if f.pollsRepository == nil {
+		f.pollsRepository = repoPollsPsql.NewPollsRepository(f.expectedConnections.SqlConnection)
+	}
+	return f.pollsRepository
+}
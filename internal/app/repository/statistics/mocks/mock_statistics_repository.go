@@ -5,6 +5,7 @@
 package mock_repository
 
 import (
+	models "patreon/internal/app/models"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -94,10 +95,10 @@ func (mr *StatisticsRepositoryMockRecorder) GetCountCreatorViews(arg0, arg1 inte
 }
 
 // GetTotalIncome mocks base method.
-func (m *StatisticsRepository) GetTotalIncome(arg0, arg1 int64) (float64, error) {
+func (m *StatisticsRepository) GetTotalIncome(arg0, arg1 int64) ([]models.Money, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetTotalIncome", arg0, arg1)
-	ret0, _ := ret[0].(float64)
+	ret0, _ := ret[0].([]models.Money)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -107,3 +108,18 @@ func (mr *StatisticsRepositoryMockRecorder) GetTotalIncome(arg0, arg1 interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalIncome", reflect.TypeOf((*StatisticsRepository)(nil).GetTotalIncome), arg0, arg1)
 }
+
+// GetTrialStats mocks base method.
+func (m *StatisticsRepository) GetTrialStats(arg0, arg1 int64) (*models.TrialStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrialStats", arg0, arg1)
+	ret0, _ := ret[0].(*models.TrialStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrialStats indicates an expected call of GetTrialStats.
+func (mr *StatisticsRepositoryMockRecorder) GetTrialStats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrialStats", reflect.TypeOf((*StatisticsRepository)(nil).GetTrialStats), arg0, arg1)
+}
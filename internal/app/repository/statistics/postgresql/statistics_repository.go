@@ -3,6 +3,7 @@ package repository_postgresql
 import (
 	"github.com/jmoiron/sqlx"
 	"patreon/internal/app"
+	"patreon/internal/app/models"
 	"patreon/internal/app/repository"
 )
 
@@ -15,10 +16,12 @@ const (
 		"where date_part('day', current_date::timestamptz - posts.date) < $2) as sum_viewes " +
 		"group by sum_viewes.creator_id " +
 		"having sum_viewes.creator_id = $1), 0);"
-	totalCreatorIncomes = "select coalesce(" +
-		"(select sum(amount) from (select amount, creator_id from payments " +
-		"where date_part('day', current_date::timestamptz - payments.date) < $2) as last_payments " +
-		"group by last_payments.creator_id having last_payments.creator_id = $1), 0) as sum_payments;"
+	totalCreatorIncomes = "select sum(amount), currency from payments " +
+		"where creator_id = $1 and date_part('day', current_date::timestamptz - payments.date) < $2 " +
+		"group by currency;"
+	creatorTrials = "select count(*), count(*) filter (where converted_at is null and ends_at > now()), " +
+		"count(converted_at) from subscription_trials " +
+		"where creator_id = $1 and date_part('day', current_date::timestamptz - date) < $2;"
 )
 
 type StatisticsRepository struct {
@@ -32,8 +35,8 @@ func NewStatisticsRepository(st *sqlx.DB) *StatisticsRepository {
 }
 
 // CreatorExists Errors:
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
 func (r *StatisticsRepository) CreatorExists(creatorID int64) (bool, error) {
 	var exists bool
 	err := r.store.QueryRow(checkCreator, creatorID).Scan(&exists)
@@ -45,8 +48,8 @@ func (r *StatisticsRepository) CreatorExists(creatorID int64) (bool, error) {
 }
 
 // GetCountCreatorPosts Errors:
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
 func (r *StatisticsRepository) GetCountCreatorPosts(creatorID int64) (int64, error) {
 	var cnt int64
 	err := r.store.QueryRow(countCreatorPosts, creatorID).Scan(&cnt)
@@ -59,8 +62,8 @@ func (r *StatisticsRepository) GetCountCreatorPosts(creatorID int64) (int64, err
 }
 
 // GetCountCreatorSubscribers Errors:
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
 func (r *StatisticsRepository) GetCountCreatorSubscribers(creatorID int64) (int64, error) {
 	var cnt int64
 	err := r.store.QueryRow(countCreatorSubscribers, creatorID).Scan(&cnt)
@@ -73,8 +76,8 @@ func (r *StatisticsRepository) GetCountCreatorSubscribers(creatorID int64) (int6
 }
 
 // GetCountCreatorViews Errors:
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
 func (r *StatisticsRepository) GetCountCreatorViews(creatorID int64, days int64) (int64, error) {
 	var cnt int64
 	err := r.store.QueryRow(countCreatorPostsLastViews, creatorID, days).Scan(&cnt)
@@ -86,17 +89,44 @@ func (r *StatisticsRepository) GetCountCreatorViews(creatorID int64, days int64)
 	return cnt, nil
 }
 
-// GetTotalIncome Errors:
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
-func (r *StatisticsRepository) GetTotalIncome(creatorID int64, days int64) (float64, error) {
-	var sum float64
+// GetTotalIncome sums of payments for last days, one for each currency of payments
+// Errors:
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (r *StatisticsRepository) GetTotalIncome(creatorID int64, days int64) ([]models.Money, error) {
+	rows, err := r.store.Query(totalCreatorIncomes, creatorID, days)
+	if err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	res := make([]models.Money, 0)
+	for rows.Next() {
+		var cur models.Money
+		if err = rows.Scan(&cur.Amount, &cur.Currency); err != nil {
+			_ = rows.Close()
+			return nil, repository.NewDBError(err)
+		}
+		res = append(res, cur)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	return res, nil
+}
 
-	err := r.store.QueryRow(totalCreatorIncomes, creatorID, days).Scan(&sum)
+// GetTrialStats Errors:
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (r *StatisticsRepository) GetTrialStats(creatorID int64, days int64) (*models.TrialStats, error) {
+	res := &models.TrialStats{}
+
+	err := r.store.QueryRow(creatorTrials, creatorID, days).Scan(&res.Started, &res.Active, &res.Converted)
 
 	if err != nil {
-		return app.InvalidInt, repository.NewDBError(err)
+		return nil, repository.NewDBError(err)
 	}
 
-	return sum, nil
+	return res, nil
 }
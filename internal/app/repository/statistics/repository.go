@@ -1,5 +1,7 @@
 package repository_statistics
 
+import "patreon/internal/app/models"
+
 //go:generate mockgen -destination=mocks/mock_statistics_repository.go -package=mock_repository -mock_names=Repository=StatisticsRepository . Repository
 
 type Repository interface {
@@ -26,5 +28,10 @@ type Repository interface {
 	// GetTotalIncome Errors:
 	// 		app.GeneralError with Errors
 	// 			repository.DefaultErrDB
-	GetTotalIncome(creatorID int64, days int64) (float64, error)
+	GetTotalIncome(creatorID int64, days int64) ([]models.Money, error)
+
+	// GetTrialStats Errors:
+	// 		app.GeneralError with Errors
+	// 			repository.DefaultErrDB
+	GetTrialStats(creatorID int64, days int64) (*models.TrialStats, error)
 }
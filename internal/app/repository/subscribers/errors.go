@@ -0,0 +1,11 @@
+package repository_subscribers
+
+import "github.com/pkg/errors"
+
+const codeDuplicateVal = "23505"
+
+var (
+	TrialNotAllowed  = errors.New("user already was subscribed on creator")
+	TrialAlreadyUsed = errors.New("user already used trial on creator")
+	AwardSoldOut     = errors.New("all seats of award are taken")
+)
@@ -7,6 +7,7 @@ package mock_repository
 import (
 	models "patreon/internal/app/models"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -34,8 +35,51 @@ func (m *SubscribersRepository) EXPECT() *SubscribersRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddToWaitlist mocks base method.
+func (m *SubscribersRepository) AddToWaitlist(arg0, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddToWaitlist", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddToWaitlist indicates an expected call of AddToWaitlist.
+func (mr *SubscribersRepositoryMockRecorder) AddToWaitlist(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToWaitlist", reflect.TypeOf((*SubscribersRepository)(nil).AddToWaitlist), arg0, arg1)
+}
+
+// ApplyDowngrades mocks base method.
+func (m *SubscribersRepository) ApplyDowngrades(arg0 time.Time) ([]models.BillingSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyDowngrades", arg0)
+	ret0, _ := ret[0].([]models.BillingSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyDowngrades indicates an expected call of ApplyDowngrades.
+func (mr *SubscribersRepositoryMockRecorder) ApplyDowngrades(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyDowngrades", reflect.TypeOf((*SubscribersRepository)(nil).ApplyDowngrades), arg0)
+}
+
+// CancelDowngrade mocks base method.
+func (m *SubscribersRepository) CancelDowngrade(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelDowngrade", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelDowngrade indicates an expected call of CancelDowngrade.
+func (mr *SubscribersRepositoryMockRecorder) CancelDowngrade(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDowngrade", reflect.TypeOf((*SubscribersRepository)(nil).CancelDowngrade), arg0)
+}
+
 // Create mocks base method.
-func (m *SubscribersRepository) Create(arg0 *models.Subscriber, arg1 string) error {
+func (m *SubscribersRepository) Create(arg0 *models.Subscriber, arg1 *models.PayTokenInfo) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Create", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -48,6 +92,35 @@ func (mr *SubscribersRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*SubscribersRepository)(nil).Create), arg0, arg1)
 }
 
+// CreateRenewal mocks base method.
+func (m *SubscribersRepository) CreateRenewal(arg0 *models.BillingSubscription, arg1 string, arg2 time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRenewal", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRenewal indicates an expected call of CreateRenewal.
+func (mr *SubscribersRepositoryMockRecorder) CreateRenewal(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRenewal", reflect.TypeOf((*SubscribersRepository)(nil).CreateRenewal), arg0, arg1, arg2)
+}
+
+// CreateUpgrade mocks base method.
+func (m *SubscribersRepository) CreateUpgrade(arg0 *models.BillingSubscription, arg1 *models.TierChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUpgrade", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUpgrade indicates an expected call of CreateUpgrade.
+func (mr *SubscribersRepositoryMockRecorder) CreateUpgrade(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUpgrade", reflect.TypeOf((*SubscribersRepository)(nil).CreateUpgrade), arg0, arg1)
+}
+
 // Delete mocks base method.
 func (m *SubscribersRepository) Delete(arg0 *models.Subscriber) error {
 	m.ctrl.T.Helper()
@@ -62,6 +135,21 @@ func (mr *SubscribersRepositoryMockRecorder) Delete(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*SubscribersRepository)(nil).Delete), arg0)
 }
 
+// ExpireSubscriptions mocks base method.
+func (m *SubscribersRepository) ExpireSubscriptions(arg0 time.Time) ([]models.BillingSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireSubscriptions", arg0)
+	ret0, _ := ret[0].([]models.BillingSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireSubscriptions indicates an expected call of ExpireSubscriptions.
+func (mr *SubscribersRepositoryMockRecorder) ExpireSubscriptions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireSubscriptions", reflect.TypeOf((*SubscribersRepository)(nil).ExpireSubscriptions), arg0)
+}
+
 // Get mocks base method.
 func (m *SubscribersRepository) Get(arg0 *models.Subscriber) (bool, error) {
 	m.ctrl.T.Helper()
@@ -77,6 +165,21 @@ func (mr *SubscribersRepositoryMockRecorder) Get(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*SubscribersRepository)(nil).Get), arg0)
 }
 
+// GetActive mocks base method.
+func (m *SubscribersRepository) GetActive(arg0, arg1 int64) (*models.BillingSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActive", arg0, arg1)
+	ret0, _ := ret[0].(*models.BillingSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActive indicates an expected call of GetActive.
+func (mr *SubscribersRepositoryMockRecorder) GetActive(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActive", reflect.TypeOf((*SubscribersRepository)(nil).GetActive), arg0, arg1)
+}
+
 // GetCreators mocks base method.
 func (m *SubscribersRepository) GetCreators(arg0 int64) ([]models.CreatorSubscribe, error) {
 	m.ctrl.T.Helper()
@@ -92,17 +195,103 @@ func (mr *SubscribersRepositoryMockRecorder) GetCreators(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCreators", reflect.TypeOf((*SubscribersRepository)(nil).GetCreators), arg0)
 }
 
+// GetRenewalsDue mocks base method.
+func (m *SubscribersRepository) GetRenewalsDue(arg0 time.Time) ([]models.BillingSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRenewalsDue", arg0)
+	ret0, _ := ret[0].([]models.BillingSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRenewalsDue indicates an expected call of GetRenewalsDue.
+func (mr *SubscribersRepositoryMockRecorder) GetRenewalsDue(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRenewalsDue", reflect.TypeOf((*SubscribersRepository)(nil).GetRenewalsDue), arg0)
+}
+
 // GetSubscribers mocks base method.
-func (m *SubscribersRepository) GetSubscribers(arg0 int64) ([]models.User, error) {
+func (m *SubscribersRepository) GetSubscribers(arg0 int64, arg1 *models.Pagination, arg2 *models.SubscribersFilter) ([]models.CreatorSubscriber, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSubscribers", arg0)
-	ret0, _ := ret[0].([]models.User)
+	ret := m.ctrl.Call(m, "GetSubscribers", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.CreatorSubscriber)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSubscribers indicates an expected call of GetSubscribers.
-func (mr *SubscribersRepositoryMockRecorder) GetSubscribers(arg0 interface{}) *gomock.Call {
+func (mr *SubscribersRepositoryMockRecorder) GetSubscribers(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscribers", reflect.TypeOf((*SubscribersRepository)(nil).GetSubscribers), arg0, arg1, arg2)
+}
+
+// GetTierChanges mocks base method.
+func (m *SubscribersRepository) GetTierChanges(arg0, arg1 int64) ([]models.TierChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTierChanges", arg0, arg1)
+	ret0, _ := ret[0].([]models.TierChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTierChanges indicates an expected call of GetTierChanges.
+func (mr *SubscribersRepositoryMockRecorder) GetTierChanges(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTierChanges", reflect.TypeOf((*SubscribersRepository)(nil).GetTierChanges), arg0, arg1)
+}
+
+// RemoveFromWaitlist mocks base method.
+func (m *SubscribersRepository) RemoveFromWaitlist(arg0, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFromWaitlist", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFromWaitlist indicates an expected call of RemoveFromWaitlist.
+func (mr *SubscribersRepositoryMockRecorder) RemoveFromWaitlist(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromWaitlist", reflect.TypeOf((*SubscribersRepository)(nil).RemoveFromWaitlist), arg0, arg1)
+}
+
+// ScheduleDowngrade mocks base method.
+func (m *SubscribersRepository) ScheduleDowngrade(arg0 *models.TierChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleDowngrade", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScheduleDowngrade indicates an expected call of ScheduleDowngrade.
+func (mr *SubscribersRepositoryMockRecorder) ScheduleDowngrade(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleDowngrade", reflect.TypeOf((*SubscribersRepository)(nil).ScheduleDowngrade), arg0)
+}
+
+// StartTrial mocks base method.
+func (m *SubscribersRepository) StartTrial(arg0 *models.Trial) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTrial", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartTrial indicates an expected call of StartTrial.
+func (mr *SubscribersRepositoryMockRecorder) StartTrial(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTrial", reflect.TypeOf((*SubscribersRepository)(nil).StartTrial), arg0)
+}
+
+// StopRenewal mocks base method.
+func (m *SubscribersRepository) StopRenewal(arg0, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopRenewal", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopRenewal indicates an expected call of StopRenewal.
+func (mr *SubscribersRepositoryMockRecorder) StopRenewal(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscribers", reflect.TypeOf((*SubscribersRepository)(nil).GetSubscribers), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopRenewal", reflect.TypeOf((*SubscribersRepository)(nil).StopRenewal), arg0, arg1)
 }
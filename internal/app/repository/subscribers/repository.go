@@ -1,14 +1,20 @@
 package repository_subscribers
 
-import "patreon/internal/app/models"
+import (
+	"patreon/internal/app/models"
+	"time"
+)
 
 //go:generate mockgen -destination=mocks/mock_subscribers_repository.go -package=mock_repository -mock_names=Repository=SubscribersRepository . Repository
 
 type Repository interface {
 	// Create Errors:
+	//		AwardSoldOut
+	//		repository_promo_codes.PromoCodeExhausted
+	//		repository_promo_codes.PromoCodeAlreadyUsed
 	//		app.GeneralError with Errors
 	//			repository.DefaultErrDB
-	Create(subscriber *models.Subscriber, payToken string) error
+	Create(subscriber *models.Subscriber, payToken *models.PayTokenInfo) error
 	// Delete Errors:
 	//		app.GeneralError with Errors
 	//			repository.DefaultErrDB
@@ -18,11 +24,70 @@ type Repository interface {
 	//			repository.DefaultErrDB
 	GetCreators(userID int64) ([]models.CreatorSubscribe, error)
 	// GetSubscribers Errors:
+	//		repository.NotFound
 	//		app.GeneralError with Errors
 	//			repository.DefaultErrDB
-	GetSubscribers(creatorID int64) ([]models.User, error)
+	GetSubscribers(creatorID int64, pag *models.Pagination,
+		filter *models.SubscribersFilter) ([]models.CreatorSubscriber, error)
 	// Get Errors:
 	//		app.GeneralError with Errors
 	//			repository.DefaultErrDB
 	Get(subscriber *models.Subscriber) (bool, error)
+	// GetRenewalsDue Errors:
+	//		app.GeneralError with Errors
+	//			repository.DefaultErrDB
+	GetRenewalsDue(dueTo time.Time) ([]models.BillingSubscription, error)
+	// CreateRenewal Errors:
+	//		app.GeneralError with Errors
+	//			repository.DefaultErrDB
+	CreateRenewal(subscription *models.BillingSubscription, payToken string, graceUntil time.Time) (bool, error)
+	// ExpireSubscriptions Errors:
+	//		app.GeneralError with Errors
+	//			repository.DefaultErrDB
+	ExpireSubscriptions(now time.Time) ([]models.BillingSubscription, error)
+	// GetActive Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors
+	//			repository.DefaultErrDB
+	GetActive(userID int64, creatorID int64) (*models.BillingSubscription, error)
+	// CreateUpgrade Errors:
+	//		AwardSoldOut
+	//		app.GeneralError with Errors
+	//			repository.DefaultErrDB
+	CreateUpgrade(subscription *models.BillingSubscription, change *models.TierChange) error
+	// ScheduleDowngrade Errors:
+	//		app.GeneralError with Errors
+	//			repository.DefaultErrDB
+	ScheduleDowngrade(change *models.TierChange) error
+	// CancelDowngrade Errors:
+	//		app.GeneralError with Errors
+	//			repository.DefaultErrDB
+	CancelDowngrade(subscriptionID int64) error
+	// ApplyDowngrades Errors:
+	//		app.GeneralError with Errors
+	//			repository.DefaultErrDB
+	ApplyDowngrades(now time.Time) ([]models.BillingSubscription, error)
+	// GetTierChanges Errors:
+	//		app.GeneralError with Errors
+	//			repository.DefaultErrDB
+	GetTierChanges(userID int64, creatorID int64) ([]models.TierChange, error)
+	// StartTrial Errors:
+	//		TrialNotAllowed
+	//		TrialAlreadyUsed
+	//		AwardSoldOut
+	//		app.GeneralError with Errors
+	//			repository.DefaultErrDB
+	StartTrial(trial *models.Trial) error
+	// AddToWaitlist Errors:
+	//		app.GeneralError with Errors
+	//			repository.DefaultErrDB
+	AddToWaitlist(awardID int64, userID int64) error
+	// RemoveFromWaitlist Errors:
+	//		app.GeneralError with Errors
+	//			repository.DefaultErrDB
+	RemoveFromWaitlist(awardID int64, userID int64) error
+	// StopRenewal Errors:
+	//		app.GeneralError with Errors
+	//			repository.DefaultErrDB
+	StopRenewal(userID int64, creatorID int64) error
 }
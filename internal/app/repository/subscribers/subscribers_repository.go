@@ -1,9 +1,105 @@
 package repository_subscribers
 
 import (
+	"database/sql"
+	"fmt"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"patreon/internal/app/models"
 	"patreon/internal/app/repository"
+	repository_promo_codes "patreon/internal/app/repository/promo_codes"
+	putilits "patreon/internal/app/utilits/postgresql"
+	"time"
+)
+
+const (
+	// renewal is paid for award scheduled by downgrade, because it starts from the next period
+	queryAddPayment = "INSERT INTO payments(amount, creator_id, users_id, awards_id, pay_token, promo_codes_id, discount, " +
+		"currency) VALUES($1, $2, $3, $4, $5, NULLIF($6, 0), $7, $8) RETURNING payments_id"
+	queryAddSubscribe = "INSERT INTO subscribers(users_id, creator_id, awards_id, hold_until) VALUES ($1, $2, $3, $4)"
+	queryUsePromoCode = "UPDATE promo_codes SET uses = uses + 1 " +
+		"WHERE promo_codes_id = $1 AND (max_uses = 0 OR uses < max_uses)"
+	queryAddPromoCodeUse = "INSERT INTO promo_code_uses (promo_codes_id, users_id, payments_id) VALUES ($1, $2, $3) " +
+		"ON CONFLICT DO NOTHING"
+
+	// award row is locked, so concurrent checkouts count seats one by one
+	queryLockAward = "SELECT max_subscribers FROM awards WHERE awards_id = $1 FOR NO KEY UPDATE"
+	// not paid subscription of the same user is not counted, user can start checkout again
+	queryCountTakenSeats = "SELECT count(*) FROM subscribers WHERE awards_id = $1 AND users_id != $2 " +
+		"AND (status = true OR (paid_until IS NULL AND hold_until > $3))"
+	queryAddToWaitlist = "INSERT INTO award_waitlist (awards_id, users_id) VALUES ($1, $2) " +
+		"ON CONFLICT DO NOTHING"
+	queryRemoveFromWaitlist = "DELETE FROM award_waitlist WHERE awards_id = $1 AND users_id = $2"
+
+	queryGetRenewalsDue = `
+	SELECT s.id, s.users_id, s.creator_id, a.awards_id, a.price, a.currency, s.period, s.paid_until,
+	       COALESCE(s.trial_until = s.paid_until, false)
+	FROM subscribers s JOIN awards a ON COALESCE(s.next_awards_id, s.awards_id) = a.awards_id
+	WHERE s.status = true AND s.grace_until IS NULL AND s.paid_until <= $1`
+	queryStartGrace      = "UPDATE subscribers SET grace_until = $2 WHERE id = $1 AND grace_until IS NULL"
+	queryAddRenewPayment = "INSERT INTO payments(amount, creator_id, users_id, awards_id, pay_token, currency, period) " +
+		"VALUES($1, $2, $3, $4, $5, $6, $7)"
+	queryExpire = "UPDATE subscribers SET status = false WHERE status = true AND grace_until <= $1 " +
+		"RETURNING id, users_id, creator_id, awards_id"
+	// grace ending with paid period skips renewal, so subscription expires when it is not paid
+	queryStopRenewal = "UPDATE subscribers SET grace_until = paid_until " +
+		"WHERE users_id = $1 AND creator_id = $2 AND status = true AND paid_until IS NOT NULL"
+
+	queryGetActive = `
+	SELECT s.id, s.users_id, s.creator_id, s.awards_id, a.price, a.currency, s.period, s.paid_until,
+	       COALESCE(s.next_awards_id, 0), s.grace_until IS NOT NULL, COALESCE(s.trial_until = s.paid_until, false)
+	FROM subscribers s JOIN awards a ON s.awards_id = a.awards_id
+	WHERE s.users_id = $1 AND s.creator_id = $2 AND s.status = true ORDER BY s.id DESC LIMIT 1`
+	queryAddTierChange = `
+	INSERT INTO subscription_changes (subscribers_id, from_awards_id, to_awards_id, kind, status, amount,
+	                                  payments_id, effective_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id, date`
+	queryAddUpgradePayment = "INSERT INTO payments(amount, creator_id, users_id, awards_id, pay_token, currency) " +
+		"VALUES($1, $2, $3, $4, $5, $6) RETURNING payments_id"
+	queryChangeAward      = "UPDATE subscribers SET awards_id = $2, next_awards_id = NULL WHERE id = $1"
+	querySetNextAward     = "UPDATE subscribers SET next_awards_id = $2 WHERE id = $1"
+	queryCancelDowngrades = "UPDATE subscription_changes SET status = 'cancelled' " +
+		"WHERE subscribers_id = $1 AND kind = 'downgrade' AND status = 'pending'"
+	queryGetDueDowngrades = `
+	SELECT c.id, s.id, s.users_id, s.creator_id, c.to_awards_id
+	FROM subscription_changes c JOIN subscribers s ON c.subscribers_id = s.id
+	WHERE s.next_awards_id = c.to_awards_id AND c.kind = 'downgrade' AND c.status = 'pending' AND c.effective_at <= $1`
+	queryCloseDowngrade = "UPDATE subscription_changes SET status = $2 WHERE id = $1 AND status = 'pending'"
+	queryGetTierChanges = `
+	SELECT c.id, s.creator_id, c.from_awards_id, c.to_awards_id, c.kind, c.status, c.amount, a.currency,
+	       COALESCE(p.pay_token, ''), c.effective_at, c.date
+	FROM subscription_changes c JOIN subscribers s ON c.subscribers_id = s.id
+	JOIN awards a ON c.to_awards_id = a.awards_id
+	LEFT JOIN payments p ON c.payments_id = p.payments_id
+	WHERE s.users_id = $1 AND s.creator_id = $2 ORDER BY c.date DESC, c.id DESC`
+
+	// subscription without paid_until was never paid, it is not listed
+	querySelectSubscribers = `
+	SELECT users_id, nickname, avatar, awards_id, name, status, date, lifetime_amount, currency, months FROM (
+		SELECT DISTINCT ON (s.users_id) s.users_id, u.nickname, u.avatar, s.awards_id, a.name, s.status, s.date,
+		       (SELECT COALESCE(sum(p.amount - p.refunded_amount), 0) FROM payments p
+		        WHERE p.users_id = s.users_id AND p.creator_id = s.creator_id AND p.currency = cp.currency
+		          AND p.state IN ('succeeded', 'refunded', 'partially_refunded')) AS lifetime_amount,
+		       cp.currency,
+		       s.period * (SELECT count(*) FROM payments p
+		                   WHERE p.users_id = s.users_id AND p.creator_id = s.creator_id
+		                     AND p.type = 'subscription' AND p.state IN ('succeeded', 'partially_refunded')) AS months
+		FROM subscribers s JOIN users u ON s.users_id = u.users_id JOIN awards a ON s.awards_id = a.awards_id
+		     JOIN creator_profile cp ON s.creator_id = cp.creator_id
+		WHERE s.creator_id = $1 AND (s.status = true OR s.paid_until IS NOT NULL)
+		ORDER BY s.users_id, s.status DESC, s.id DESC
+	) sub WHERE true `
+
+	// queryCountUserSubscriptions subscription row is removed by unsubscribe,
+	// so former subscription is found by paid subscription payments and subscription history too
+	queryCountUserSubscriptions = "SELECT (SELECT count(*) FROM subscribers WHERE users_id = $1 AND creator_id = $2) + " +
+		"(SELECT count(*) FROM payments WHERE users_id = $1 AND creator_id = $2 AND type = 'subscription' " +
+		"AND state IN ('succeeded', 'refunded', 'partially_refunded')) + " +
+		"(SELECT count(*) FROM subscription_events WHERE users_id = $1 AND creator_id = $2 AND kind = 'subscribed')"
+	queryAddTrialSubscribe = "INSERT INTO subscribers(users_id, creator_id, awards_id, status, paid_until, trial_until) " +
+		"VALUES ($1, $2, $3, true, $4, $4) RETURNING id"
+	queryAddTrial = "INSERT INTO subscription_trials(users_id, creator_id, awards_id, subscribers_id, ends_at) " +
+		"VALUES ($1, $2, $3, $4, $5) RETURNING id, date"
 )
 
 type SubscribersRepository struct {
@@ -16,27 +112,43 @@ func NewSubscribersRepository(store *sqlx.DB) *SubscribersRepository {
 	}
 }
 
-// Create Errors:
+// Create payment with price fixed in pay token and not paid subscription.
+// Promo code of pay token is used by subscriber in the same transaction.
+// Subscription on limited award holds seat until pay token expires
+// Errors:
+//		AwardSoldOut
+//		repository_promo_codes.PromoCodeExhausted
+//		repository_promo_codes.PromoCodeAlreadyUsed
 //		app.GeneralError with Errors
 //			repository.DefaultErrDB
-func (repo *SubscribersRepository) Create(subscriber *models.Subscriber, payToken string) error {
-	queryAwardPrice := "SELECT price FROM awards WHERE awards_id = $1"
-	queryAddPayment := "INSERT INTO payments(amount, creator_id, users_id, awards_id, pay_token) VALUES($1, $2, $3, $4, $5)"
-	queryAddSubscribe := "INSERT INTO subscribers(users_id, creator_id, awards_id) VALUES ($1, $2, $3)"
-
-	price := 0
-	if err := repo.store.QueryRow(queryAwardPrice, subscriber.AwardID).Scan(&price); err != nil {
+func (repo *SubscribersRepository) Create(subscriber *models.Subscriber, payToken *models.PayTokenInfo) error {
+	begin, err := repo.store.Begin()
+	if err != nil {
 		return repository.NewDBError(err)
 	}
 
-	begin, err := repo.store.Begin()
-	if err != nil {
+	if err = TakeSeat(begin, subscriber.UserID, subscriber.AwardID, time.Now()); err != nil {
+		_ = begin.Rollback()
+		return err
+	}
+
+	var paymentID int64
+	if err = begin.QueryRow(queryAddPayment, payToken.Price, subscriber.CreatorID, subscriber.UserID,
+		subscriber.AwardID, payToken.Token, payToken.PromoCodeID, payToken.Discount,
+		payToken.Currency).Scan(&paymentID); err != nil {
+		_ = begin.Rollback()
 		return repository.NewDBError(err)
 	}
 
-	row, err := begin.Query(queryAddPayment, price,
-		subscriber.CreatorID, subscriber.UserID, subscriber.AwardID, payToken)
+	if payToken.PromoCodeID != 0 {
+		if err = usePromoCode(begin, payToken.PromoCodeID, subscriber.UserID, paymentID); err != nil {
+			_ = begin.Rollback()
+			return err
+		}
+	}
 
+	row, err := begin.Query(queryAddSubscribe, subscriber.UserID, subscriber.CreatorID, subscriber.AwardID,
+		payToken.ExpiresAt)
 	if err != nil {
 		_ = begin.Rollback()
 		return repository.NewDBError(err)
@@ -47,21 +159,69 @@ func (repo *SubscribersRepository) Create(subscriber *models.Subscriber, payToke
 		return repository.NewDBError(err)
 	}
 
-	if row, err = begin.Query(queryAddSubscribe,
-		subscriber.UserID, subscriber.CreatorID, subscriber.AwardID); err != nil {
-		_ = begin.Rollback()
+	if err = begin.Commit(); err != nil {
 		return repository.NewDBError(err)
 	}
 
-	if err = row.Close(); err != nil {
-		_ = begin.Rollback()
+	return nil
+}
+
+// TakeSeat check that limited award has free seat for user and remove user from award waitlist.
+// Award stays locked until the end of transaction, so seats can not be oversold by concurrent checkouts.
+// Every subscription which is added or moved to award takes seat with it, payments and gifts too.
+// Not paid subscription holds seat while its hold_until is after now
+// Errors:
+//		AwardSoldOut
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func TakeSeat(tx *sql.Tx, userID int64, awardID int64, now time.Time) error {
+	var maxSubscribers int64
+	if err := tx.QueryRow(queryLockAward, awardID).Scan(&maxSubscribers); err != nil {
 		return repository.NewDBError(err)
 	}
+	if maxSubscribers == 0 {
+		return nil
+	}
 
-	if err = begin.Commit(); err != nil {
+	var taken int64
+	if err := tx.QueryRow(queryCountTakenSeats, awardID, userID, now).Scan(&taken); err != nil {
+		return repository.NewDBError(err)
+	}
+	if taken >= maxSubscribers {
+		return AwardSoldOut
+	}
+
+	if _, err := tx.Exec(queryRemoveFromWaitlist, awardID, userID); err != nil {
+		return repository.NewDBError(err)
+	}
+	return nil
+}
+
+// usePromoCode count use of promo code by user with payment
+// Errors:
+//		repository_promo_codes.PromoCodeExhausted
+//		repository_promo_codes.PromoCodeAlreadyUsed
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func usePromoCode(tx *sql.Tx, codeID int64, userID int64, paymentID int64) error {
+	res, err := tx.Exec(queryUsePromoCode, codeID)
+	if err != nil {
 		return repository.NewDBError(err)
 	}
+	if cnt, err := res.RowsAffected(); err != nil {
+		return repository.NewDBError(err)
+	} else if cnt == 0 {
+		return repository_promo_codes.PromoCodeExhausted
+	}
 
+	if res, err = tx.Exec(queryAddPromoCodeUse, codeID, userID, paymentID); err != nil {
+		return repository.NewDBError(err)
+	}
+	if cnt, err := res.RowsAffected(); err != nil {
+		return repository.NewDBError(err)
+	} else if cnt == 0 {
+		return repository_promo_codes.PromoCodeAlreadyUsed
+	}
 	return nil
 }
 
@@ -105,32 +265,75 @@ func (repo *SubscribersRepository) GetCreators(userID int64) ([]models.CreatorSu
 
 }
 
-// GetSubscribers Errors:
+// subscribersOrder ORDER BY of querySelectSubscribers for each sort, users_id makes pages stable
+var subscribersOrder = map[models.SubscribersSort]string{
+	"":                             "ORDER BY date DESC, users_id ",
+	models.SortSubscribersNewest:   "ORDER BY date DESC, users_id ",
+	models.SortSubscribersOldest:   "ORDER BY date, users_id ",
+	models.SortSubscribersAmount:   "ORDER BY lifetime_amount DESC, users_id ",
+	models.SortSubscribersNickname: "ORDER BY lower(nickname), users_id ",
+}
+
+// addSubscribersFilter append conditions of filter to querySelectSubscribers, their args are added after args
+func addSubscribersFilter(query string, args []interface{}, filter *models.SubscribersFilter) (string, []interface{}) {
+	if filter == nil {
+		return query + subscribersOrder[""], args
+	}
+	if filter.AwardID != 0 {
+		args = append(args, filter.AwardID)
+		query += fmt.Sprintf("AND awards_id = $%d ", len(args))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status == models.SubscriptionActive)
+		query += fmt.Sprintf("AND status = $%d ", len(args))
+	}
+	if !filter.Since.IsZero() {
+		args = append(args, filter.Since)
+		query += fmt.Sprintf("AND date >= $%d ", len(args))
+	}
+	if filter.Nickname != "" {
+		args = append(args, filter.Nickname)
+		query += fmt.Sprintf("AND strpos(lower(nickname), lower($%d)) > 0 ", len(args))
+	}
+	return query + subscribersOrder[filter.Sort], args
+}
+
+// GetSubscribers return page of creator subscribers from filter, for each user only the last subscription is listed
+// Errors:
+//		repository.NotFound
 //		app.GeneralError with Errors
 //			repository.DefaultErrDB
-func (repo *SubscribersRepository) GetSubscribers(creatorID int64) ([]models.User, error) {
-	queryCount := "SELECT count(*) as cnt from subscribers WHERE creator_id = $1 and status = true"
-	querySelect := `
-	SELECT DISTINCT s.users_id, nickname, avatar
-	from subscribers s join users u on s.users_id = u.users_id WHERE s.creator_id = $1 and status = true`
+func (repo *SubscribersRepository) GetSubscribers(creatorID int64, pag *models.Pagination,
+	filter *models.SubscribersFilter) ([]models.CreatorSubscriber, error) {
+	query, args := addSubscribersFilter(querySelectSubscribers, []interface{}{creatorID}, filter)
 
-	count := 0
-	if err := repo.store.QueryRow(queryCount, creatorID).Scan(&count); err != nil {
-		return nil, repository.NewDBError(err)
+	limit, offset, err := putilits.AddPagination("subscribers", pag, repo.store)
+	if err != nil {
+		return nil, err
 	}
+	if limit == 0 {
+		return nil, repository.NotFound
+	}
+	query += fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
 
-	res := make([]models.User, 0, count)
-
-	rows, err := repo.store.Query(querySelect, creatorID)
+	rows, err := repo.store.Query(query, args...)
 	if err != nil {
 		return nil, repository.NewDBError(err)
 	}
-	var cur models.User
+
+	res := make([]models.CreatorSubscriber, 0, limit)
 	for rows.Next() {
-		if err = rows.Scan(&cur.ID, &cur.Nickname, &cur.Avatar); err != nil {
+		cur := models.CreatorSubscriber{}
+		active := false
+		if err = rows.Scan(&cur.UserID, &cur.Nickname, &cur.Avatar, &cur.AwardID, &cur.AwardName, &active,
+			&cur.SubscribedSince, &cur.LifetimeAmount, &cur.Currency, &cur.MonthsSubscribed); err != nil {
 			_ = rows.Close()
 			return nil, repository.NewDBError(err)
 		}
+		cur.Status = models.SubscriptionExpired
+		if active {
+			cur.Status = models.SubscriptionActive
+		}
 		res = append(res, cur)
 	}
 
@@ -171,3 +374,437 @@ func (repo *SubscribersRepository) Delete(subscriber *models.Subscriber) error {
 	}
 	return nil
 }
+
+// GetRenewalsDue Errors:
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (repo *SubscribersRepository) GetRenewalsDue(dueTo time.Time) ([]models.BillingSubscription, error) {
+	rows, err := repo.store.Query(queryGetRenewalsDue, dueTo)
+	if err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	var res []models.BillingSubscription
+	for rows.Next() {
+		cur := models.BillingSubscription{}
+		if err = rows.Scan(&cur.ID, &cur.UserID, &cur.CreatorID, &cur.AwardID, &cur.Price,
+			&cur.Currency, &cur.Period, &cur.PaidUntil, &cur.InTrial); err != nil {
+			_ = rows.Close()
+			return nil, repository.NewDBError(err)
+		}
+		res = append(res, cur)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	return res, nil
+}
+
+// CreateRenewal move subscription to grace and add unpaid payment with new token.
+// Return false if renewal for this period was already created
+// Errors:
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (repo *SubscribersRepository) CreateRenewal(subscription *models.BillingSubscription,
+	payToken string, graceUntil time.Time) (bool, error) {
+	begin, err := repo.store.Begin()
+	if err != nil {
+		return false, repository.NewDBError(err)
+	}
+
+	res, err := begin.Exec(queryStartGrace, subscription.ID, graceUntil)
+	if err != nil {
+		_ = begin.Rollback()
+		return false, repository.NewDBError(err)
+	}
+	if cnt, err := res.RowsAffected(); err != nil || cnt != 1 {
+		_ = begin.Rollback()
+		if err != nil {
+			return false, repository.NewDBError(err)
+		}
+		return false, nil
+	}
+
+	if _, err = begin.Exec(queryAddRenewPayment, subscription.Price, subscription.CreatorID,
+		subscription.UserID, subscription.AwardID, payToken, subscription.Currency, subscription.Period); err != nil {
+		_ = begin.Rollback()
+		return false, repository.NewDBError(err)
+	}
+
+	if err = begin.Commit(); err != nil {
+		return false, repository.NewDBError(err)
+	}
+	return true, nil
+}
+
+// ExpireSubscriptions close subscriptions with ended grace and return them
+// Errors:
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (repo *SubscribersRepository) ExpireSubscriptions(now time.Time) ([]models.BillingSubscription, error) {
+	return repo.selectChanged(queryExpire, now)
+}
+
+// selectChanged run query which return id, user, creator and award of changed subscriptions
+// Errors:
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (repo *SubscribersRepository) selectChanged(query string, args ...interface{}) ([]models.BillingSubscription, error) {
+	rows, err := repo.store.Query(query, args...)
+	if err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	var res []models.BillingSubscription
+	for rows.Next() {
+		cur := models.BillingSubscription{}
+		if err = rows.Scan(&cur.ID, &cur.UserID, &cur.CreatorID, &cur.AwardID); err != nil {
+			_ = rows.Close()
+			return nil, repository.NewDBError(err)
+		}
+		res = append(res, cur)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, repository.NewDBError(err)
+	}
+	return res, nil
+}
+
+// GetActive return active subscription of user on creator
+// Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (repo *SubscribersRepository) GetActive(userID int64, creatorID int64) (*models.BillingSubscription, error) {
+	res := &models.BillingSubscription{}
+	if err := repo.store.QueryRow(queryGetActive, userID, creatorID).Scan(&res.ID, &res.UserID, &res.CreatorID,
+		&res.AwardID, &res.Price, &res.Currency, &res.Period, &res.PaidUntil, &res.NextAwardID, &res.InGrace,
+		&res.InTrial); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, repository.NotFound
+		}
+		return nil, repository.NewDBError(err)
+	}
+	return res, nil
+}
+
+// CreateUpgrade save upgrade of subscription. If change has pay token, unpaid payment for change amount
+// is created and award will be changed when it succeeded, otherwise award is changed right now.
+// Upgraded award must have free seat, paid upgrade takes it again when payment succeeded
+// Errors:
+//		AwardSoldOut
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (repo *SubscribersRepository) CreateUpgrade(subscription *models.BillingSubscription, change *models.TierChange) error {
+	begin, err := repo.store.Begin()
+	if err != nil {
+		return repository.NewDBError(err)
+	}
+
+	if err = TakeSeat(begin, subscription.UserID, change.ToAwardID, time.Now()); err != nil {
+		_ = begin.Rollback()
+		return err
+	}
+
+	var paymentID *int64
+	if change.PayToken != "" {
+		paymentID = new(int64)
+		if err = begin.QueryRow(queryAddUpgradePayment, change.Amount, subscription.CreatorID,
+			subscription.UserID, change.ToAwardID, change.PayToken, change.Currency).Scan(paymentID); err != nil {
+			_ = begin.Rollback()
+			return repository.NewDBError(err)
+		}
+	} else {
+		if _, err = begin.Exec(queryChangeAward, subscription.ID, change.ToAwardID); err != nil {
+			_ = begin.Rollback()
+			return repository.NewDBError(err)
+		}
+		if _, err = begin.Exec(queryCancelDowngrades, subscription.ID); err != nil {
+			_ = begin.Rollback()
+			return repository.NewDBError(err)
+		}
+	}
+
+	if err = repo.addTierChange(begin, change, paymentID); err != nil {
+		_ = begin.Rollback()
+		return err
+	}
+
+	if err = begin.Commit(); err != nil {
+		return repository.NewDBError(err)
+	}
+	return nil
+}
+
+// ScheduleDowngrade save downgrade which will be applied at change.EffectiveAt,
+// previous not applied downgrade is cancelled
+// Errors:
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (repo *SubscribersRepository) ScheduleDowngrade(change *models.TierChange) error {
+	begin, err := repo.store.Begin()
+	if err != nil {
+		return repository.NewDBError(err)
+	}
+
+	if _, err = begin.Exec(queryCancelDowngrades, change.SubscriptionID); err != nil {
+		_ = begin.Rollback()
+		return repository.NewDBError(err)
+	}
+	if _, err = begin.Exec(querySetNextAward, change.SubscriptionID, change.ToAwardID); err != nil {
+		_ = begin.Rollback()
+		return repository.NewDBError(err)
+	}
+	if err = repo.addTierChange(begin, change, nil); err != nil {
+		_ = begin.Rollback()
+		return err
+	}
+
+	if err = begin.Commit(); err != nil {
+		return repository.NewDBError(err)
+	}
+	return nil
+}
+
+// CancelDowngrade cancel not applied downgrade of subscription
+// Errors:
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (repo *SubscribersRepository) CancelDowngrade(subscriptionID int64) error {
+	begin, err := repo.store.Begin()
+	if err != nil {
+		return repository.NewDBError(err)
+	}
+
+	if _, err = begin.Exec(queryCancelDowngrades, subscriptionID); err != nil {
+		_ = begin.Rollback()
+		return repository.NewDBError(err)
+	}
+	if _, err = begin.Exec(querySetNextAward, subscriptionID, nil); err != nil {
+		_ = begin.Rollback()
+		return repository.NewDBError(err)
+	}
+
+	if err = begin.Commit(); err != nil {
+		return repository.NewDBError(err)
+	}
+	return nil
+}
+
+// ApplyDowngrades move subscriptions to scheduled awards when paid period ended.
+// Downgrade to award without free seat is cancelled and subscription stays on its award.
+// Return changed subscriptions with their new awards
+// Errors:
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (repo *SubscribersRepository) ApplyDowngrades(now time.Time) ([]models.BillingSubscription, error) {
+	rows, err := repo.store.Query(queryGetDueDowngrades, now)
+	if err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	var changeIDs []int64
+	var due []models.BillingSubscription
+	for rows.Next() {
+		var changeID int64
+		cur := models.BillingSubscription{}
+		if err = rows.Scan(&changeID, &cur.ID, &cur.UserID, &cur.CreatorID, &cur.AwardID); err != nil {
+			_ = rows.Close()
+			return nil, repository.NewDBError(err)
+		}
+		changeIDs = append(changeIDs, changeID)
+		due = append(due, cur)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	var res []models.BillingSubscription
+	for i := range due {
+		applied, err := repo.applyDowngrade(changeIDs[i], &due[i], now)
+		if err != nil {
+			return nil, err
+		}
+		if applied {
+			res = append(res, due[i])
+		}
+	}
+	return res, nil
+}
+
+// applyDowngrade move subscription to award of downgrade with changeID in one transaction with taking seat at now.
+// Return false if downgrade was cancelled or already closed by other instance
+// Errors:
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (repo *SubscribersRepository) applyDowngrade(changeID int64, subscription *models.BillingSubscription,
+	now time.Time) (bool, error) {
+	begin, err := repo.store.Begin()
+	if err != nil {
+		return false, repository.NewDBError(err)
+	}
+
+	status := models.TierChangeApplied
+	if err = TakeSeat(begin, subscription.UserID, subscription.AwardID, now); err == AwardSoldOut {
+		status = models.TierChangeCancelled
+	} else if err != nil {
+		_ = begin.Rollback()
+		return false, err
+	}
+
+	res, err := begin.Exec(queryCloseDowngrade, changeID, status)
+	if err != nil {
+		_ = begin.Rollback()
+		return false, repository.NewDBError(err)
+	}
+	if cnt, err := res.RowsAffected(); err != nil {
+		_ = begin.Rollback()
+		return false, repository.NewDBError(err)
+	} else if cnt == 0 {
+		_ = begin.Rollback()
+		return false, nil
+	}
+
+	if status == models.TierChangeApplied {
+		_, err = begin.Exec(queryChangeAward, subscription.ID, subscription.AwardID)
+	} else {
+		_, err = begin.Exec(querySetNextAward, subscription.ID, nil)
+	}
+	if err != nil {
+		_ = begin.Rollback()
+		return false, repository.NewDBError(err)
+	}
+
+	if err = begin.Commit(); err != nil {
+		return false, repository.NewDBError(err)
+	}
+	return status == models.TierChangeApplied, nil
+}
+
+// GetTierChanges return history of tier changes of user subscriptions on creator, last first
+// Errors:
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (repo *SubscribersRepository) GetTierChanges(userID int64, creatorID int64) ([]models.TierChange, error) {
+	rows, err := repo.store.Query(queryGetTierChanges, userID, creatorID)
+	if err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	res := make([]models.TierChange, 0)
+	for rows.Next() {
+		cur := models.TierChange{}
+		if err = rows.Scan(&cur.ID, &cur.CreatorID, &cur.FromAwardID, &cur.ToAwardID, &cur.Kind, &cur.Status,
+			&cur.Amount, &cur.Currency, &cur.PayToken, &cur.EffectiveAt, &cur.Date); err != nil {
+			_ = rows.Close()
+			return nil, repository.NewDBError(err)
+		}
+		res = append(res, cur)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, repository.NewDBError(err)
+	}
+	return res, nil
+}
+
+// addTierChange Errors:
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (repo *SubscribersRepository) addTierChange(tx *sql.Tx, change *models.TierChange, paymentID *int64) error {
+	if err := tx.QueryRow(queryAddTierChange, change.SubscriptionID, change.FromAwardID, change.ToAwardID,
+		change.Kind, change.Status, change.Amount, paymentID, change.EffectiveAt).
+		Scan(&change.ID, &change.Date); err != nil {
+		return repository.NewDBError(err)
+	}
+	return nil
+}
+
+// StartTrial add subscription which is paid until the end of free trial without payment and save trial.
+// Trial is allowed only for user who was never subscribed on creator, even if subscription was removed,
+// so it can not be repeated
+// Errors:
+//		TrialNotAllowed
+//		TrialAlreadyUsed
+//		AwardSoldOut
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (repo *SubscribersRepository) StartTrial(trial *models.Trial) error {
+	begin, err := repo.store.Begin()
+	if err != nil {
+		return repository.NewDBError(err)
+	}
+
+	var cnt int64
+	if err = begin.QueryRow(queryCountUserSubscriptions, trial.UserID, trial.CreatorID).Scan(&cnt); err != nil {
+		_ = begin.Rollback()
+		return repository.NewDBError(err)
+	}
+	if cnt != 0 {
+		_ = begin.Rollback()
+		return TrialNotAllowed
+	}
+
+	if err = TakeSeat(begin, trial.UserID, trial.AwardID, time.Now()); err != nil {
+		_ = begin.Rollback()
+		return err
+	}
+
+	var subscriptionID int64
+	if err = begin.QueryRow(queryAddTrialSubscribe, trial.UserID, trial.CreatorID, trial.AwardID,
+		trial.EndsAt).Scan(&subscriptionID); err != nil {
+		_ = begin.Rollback()
+		return repository.NewDBError(err)
+	}
+
+	// subscription could be removed after trial, so used trial is found only by trials table
+	if err = begin.QueryRow(queryAddTrial, trial.UserID, trial.CreatorID, trial.AwardID, subscriptionID,
+		trial.EndsAt).Scan(&trial.ID, &trial.Date); err != nil {
+		_ = begin.Rollback()
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == codeDuplicateVal {
+			return TrialAlreadyUsed
+		}
+		return repository.NewDBError(err)
+	}
+
+	if err = begin.Commit(); err != nil {
+		return repository.NewDBError(err)
+	}
+	return nil
+}
+
+// AddToWaitlist add user to waitlist of award, user is not added twice
+// Errors:
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (repo *SubscribersRepository) AddToWaitlist(awardID int64, userID int64) error {
+	if _, err := repo.store.Exec(queryAddToWaitlist, awardID, userID); err != nil {
+		return repository.NewDBError(err)
+	}
+	return nil
+}
+
+// RemoveFromWaitlist Errors:
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (repo *SubscribersRepository) RemoveFromWaitlist(awardID int64, userID int64) error {
+	if _, err := repo.store.Exec(queryRemoveFromWaitlist, awardID, userID); err != nil {
+		return repository.NewDBError(err)
+	}
+	return nil
+}
+
+// StopRenewal stop renewal of active subscription of user on creator, it expires at the end of paid period.
+// Nothing happens if user has not active subscription
+// Errors:
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (repo *SubscribersRepository) StopRenewal(userID int64, creatorID int64) error {
+	if _, err := repo.store.Exec(queryStopRenewal, userID, creatorID); err != nil {
+		return repository.NewDBError(err)
+	}
+	return nil
+}
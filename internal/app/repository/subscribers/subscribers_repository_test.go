@@ -1,10 +1,15 @@
 package repository_subscribers
 
 import (
+	"database/sql"
+	"fmt"
 	"patreon/internal/app/models"
 	"patreon/internal/app/repository"
+	repository_promo_codes "patreon/internal/app/repository/promo_codes"
+	putilits "patreon/internal/app/utilits/postgresql"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/lib/pq"
 
@@ -29,392 +34,337 @@ func (s *SuiteSubscribersRepository) AfterTest(_, _ string) {
 	require.NoError(s.T(), s.Mock.ExpectationsWereMet())
 }
 
+func (s *SuiteSubscribersRepository) expectLockAward(awardID int64, maxSubscribers int64) {
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryLockAward)).
+		WithArgs(awardID).
+		WillReturnRows(sqlmock.NewRows([]string{"max_subscribers"}).AddRow(maxSubscribers))
+}
+
 func (s *SuiteSubscribersRepository) TestSubscribersRepository_Create_Ok() {
 	subscriber := models.TestSubscriber()
-	queryAwardPrice := "SELECT price FROM awards WHERE awards_id = $1"
-	queryAddPayment := "INSERT INTO payments(amount, creator_id, users_id, awards_id, pay_token) VALUES($1, $2, $3, $4, $5)"
-	queryAddSubscribe := "INSERT INTO subscribers(users_id, creator_id, awards_id) VALUES ($1, $2, $3)"
-	token := "25"
-	price := int64(1)
-	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAwardPrice)).
-		WithArgs(subscriber.AwardID).
-		WillReturnRows(sqlmock.NewRows([]string{"price"}).AddRow(price)).
+	payToken := &models.PayTokenInfo{Token: "25", Price: 1}
+	s.Mock.ExpectBegin()
+	s.expectLockAward(subscriber.AwardID, 0)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddPayment)).
+		WithArgs(payToken.Price, subscriber.CreatorID, subscriber.UserID, subscriber.AwardID, payToken.Token, 0,
+			models.Decimal(0), payToken.Currency).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id"}).AddRow(3))
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddSubscribe)).
+		WithArgs(subscriber.UserID, subscriber.CreatorID, subscriber.AwardID, payToken.ExpiresAt).
+		WillReturnRows(sqlmock.NewRows([]string{})).
 		RowsWillBeClosed()
+	s.Mock.ExpectCommit()
 
+	err := s.repo.Create(subscriber, payToken)
+	assert.NoError(s.T(), err)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_Create_LimitedAward() {
+	subscriber := models.TestSubscriber()
+	payToken := &models.PayTokenInfo{Token: "25", Price: 1, ExpiresAt: time.Now().Add(time.Hour)}
 	s.Mock.ExpectBegin()
+	s.expectLockAward(subscriber.AwardID, 20)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCountTakenSeats)).
+		WithArgs(subscriber.AwardID, subscriber.UserID, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(19))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryRemoveFromWaitlist)).
+		WithArgs(subscriber.AwardID, subscriber.UserID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
 
 	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddPayment)).
-		WithArgs(price, subscriber.CreatorID, subscriber.UserID, subscriber.AwardID, token).
+		WithArgs(payToken.Price, subscriber.CreatorID, subscriber.UserID, subscriber.AwardID, payToken.Token, 0,
+			models.Decimal(0), payToken.Currency).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id"}).AddRow(3))
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddSubscribe)).
+		WithArgs(subscriber.UserID, subscriber.CreatorID, subscriber.AwardID, payToken.ExpiresAt).
 		WillReturnRows(sqlmock.NewRows([]string{})).
 		RowsWillBeClosed()
+	s.Mock.ExpectCommit()
+
+	err := s.repo.Create(subscriber, payToken)
+	assert.NoError(s.T(), err)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_Create_SoldOut() {
+	subscriber := models.TestSubscriber()
+	payToken := &models.PayTokenInfo{Token: "25", Price: 1}
+	s.Mock.ExpectBegin()
+	s.expectLockAward(subscriber.AwardID, 20)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCountTakenSeats)).
+		WithArgs(subscriber.AwardID, subscriber.UserID, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(20))
+	s.Mock.ExpectRollback()
+
+	err := s.repo.Create(subscriber, payToken)
+	assert.Equal(s.T(), AwardSoldOut, err)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_Create_WithPromoCode() {
+	subscriber := models.TestSubscriber()
+	payToken := &models.PayTokenInfo{Token: "25", Price: 50, PromoCodeID: 7, PromoCode: "GOLD50", Discount: 50}
+	s.Mock.ExpectBegin()
+	s.expectLockAward(subscriber.AwardID, 0)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddPayment)).
+		WithArgs(payToken.Price, subscriber.CreatorID, subscriber.UserID, subscriber.AwardID, payToken.Token,
+			payToken.PromoCodeID, payToken.Discount, payToken.Currency).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id"}).AddRow(3))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryUsePromoCode)).
+		WithArgs(payToken.PromoCodeID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddPromoCodeUse)).
+		WithArgs(payToken.PromoCodeID, subscriber.UserID, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
 
 	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddSubscribe)).
-		WithArgs(subscriber.UserID, subscriber.CreatorID, subscriber.AwardID).
+		WithArgs(subscriber.UserID, subscriber.CreatorID, subscriber.AwardID, payToken.ExpiresAt).
 		WillReturnRows(sqlmock.NewRows([]string{})).
 		RowsWillBeClosed()
 	s.Mock.ExpectCommit()
 
-	err := s.repo.Create(subscriber, token)
+	err := s.repo.Create(subscriber, payToken)
 	assert.NoError(s.T(), err)
 }
 
-func (s *SuiteSubscribersRepository) TestSubscribersRepository_Create_FirstQueryDbError() {
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_Create_PromoCodeExhausted() {
 	subscriber := models.TestSubscriber()
-	queryAwardPrice := "SELECT price FROM awards WHERE awards_id = $1"
-	token := "25"
+	payToken := &models.PayTokenInfo{Token: "25", Price: 50, PromoCodeID: 7, Discount: 50}
+	s.Mock.ExpectBegin()
+	s.expectLockAward(subscriber.AwardID, 0)
 
-	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAwardPrice)).
-		WithArgs(subscriber.AwardID).WillReturnError(repository.DefaultErrDB)
-	err := s.repo.Create(subscriber, token)
-	assert.Error(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddPayment)).
+		WithArgs(payToken.Price, subscriber.CreatorID, subscriber.UserID, subscriber.AwardID, payToken.Token,
+			payToken.PromoCodeID, payToken.Discount, payToken.Currency).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id"}).AddRow(3))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryUsePromoCode)).
+		WithArgs(payToken.PromoCodeID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.Mock.ExpectRollback()
+
+	err := s.repo.Create(subscriber, payToken)
+	assert.Equal(s.T(), repository_promo_codes.PromoCodeExhausted, err)
 }
 
-func (s *SuiteSubscribersRepository) TestSubscribersRepository_Create_BeginDbError() {
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_Create_PromoCodeAlreadyUsed() {
 	subscriber := models.TestSubscriber()
-	token := "25"
-	queryAwardPrice := "SELECT price FROM awards WHERE awards_id = $1"
+	payToken := &models.PayTokenInfo{Token: "25", Price: 50, PromoCodeID: 7, Discount: 50}
+	s.Mock.ExpectBegin()
+	s.expectLockAward(subscriber.AwardID, 0)
 
-	price := int64(1)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddPayment)).
+		WithArgs(payToken.Price, subscriber.CreatorID, subscriber.UserID, subscriber.AwardID, payToken.Token,
+			payToken.PromoCodeID, payToken.Discount, payToken.Currency).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id"}).AddRow(3))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryUsePromoCode)).
+		WithArgs(payToken.PromoCodeID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddPromoCodeUse)).
+		WithArgs(payToken.PromoCodeID, subscriber.UserID, 3).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.Mock.ExpectRollback()
+
+	err := s.repo.Create(subscriber, payToken)
+	assert.Equal(s.T(), repository_promo_codes.PromoCodeAlreadyUsed, err)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_Create_BeginDbError() {
+	subscriber := models.TestSubscriber()
+	payToken := &models.PayTokenInfo{Token: "25", Price: 1}
 
-	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAwardPrice)).
-		WithArgs(subscriber.AwardID).
-		WillReturnRows(sqlmock.NewRows([]string{"price"}).AddRow(price))
 	s.Mock.ExpectBegin().WillReturnError(repository.DefaultErrDB)
 
-	err := s.repo.Create(subscriber, token)
+	err := s.repo.Create(subscriber, payToken)
 	assert.Error(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
 }
 
 func (s *SuiteSubscribersRepository) TestSubscribersRepository_Create_SecondQueryDbError() {
 	subscriber := models.TestSubscriber()
-	token := "25"
-	queryAwardPrice := "SELECT price FROM awards WHERE awards_id = $1"
-	queryAddPayment := "INSERT INTO payments(amount, creator_id, users_id, awards_id, pay_token) VALUES($1, $2, $3, $4, $5)"
-
-	price := int64(1)
-
-	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAwardPrice)).
-		WithArgs(subscriber.AwardID).
-		WillReturnRows(sqlmock.NewRows([]string{"price"}).AddRow(price)).
-		RowsWillBeClosed()
+	payToken := &models.PayTokenInfo{Token: "25", Price: 1}
 
 	s.Mock.ExpectBegin()
+	s.expectLockAward(subscriber.AwardID, 0)
 
 	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddPayment)).
-		WithArgs(price, subscriber.CreatorID, subscriber.UserID, subscriber.AwardID, token).
+		WithArgs(payToken.Price, subscriber.CreatorID, subscriber.UserID, subscriber.AwardID, payToken.Token, 0,
+			models.Decimal(0), payToken.Currency).
 		WillReturnError(repository.DefaultErrDB)
 
 	s.Mock.ExpectRollback()
 
-	err := s.repo.Create(subscriber, token)
+	err := s.repo.Create(subscriber, payToken)
 	assert.Error(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
 }
 
 func (s *SuiteSubscribersRepository) TestSubscribersRepository_Create_ThirdQueryDbError() {
 	subscriber := models.TestSubscriber()
-	token := "25"
-	queryAwardPrice := "SELECT price FROM awards WHERE awards_id = $1"
-	queryAddPayment := "INSERT INTO payments(amount, creator_id, users_id, awards_id, pay_token) VALUES($1, $2, $3, $4, $5)"
-	queryAddSubscribe := "INSERT INTO subscribers(users_id, creator_id, awards_id) VALUES ($1, $2, $3)"
-
-	price := int64(1)
+	payToken := &models.PayTokenInfo{Token: "25", Price: 1}
 
-	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAwardPrice)).
-		WithArgs(subscriber.AwardID).
-		WillReturnRows(sqlmock.NewRows([]string{"price"}).AddRow(price)).
-		RowsWillBeClosed()
 	s.Mock.ExpectBegin()
+	s.expectLockAward(subscriber.AwardID, 0)
 	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddPayment)).
-		WithArgs(price, subscriber.CreatorID, subscriber.UserID, subscriber.AwardID, token).
-		WillReturnRows(sqlmock.NewRows([]string{})).RowsWillBeClosed()
+		WithArgs(payToken.Price, subscriber.CreatorID, subscriber.UserID, subscriber.AwardID, payToken.Token, 0,
+			models.Decimal(0), payToken.Currency).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id"}).AddRow(3))
 
 	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddSubscribe)).
-		WithArgs(subscriber.UserID, subscriber.CreatorID, subscriber.AwardID).
+		WithArgs(subscriber.UserID, subscriber.CreatorID, subscriber.AwardID, payToken.ExpiresAt).
 		WillReturnError(repository.DefaultErrDB)
 
 	s.Mock.ExpectRollback()
-	err := s.repo.Create(subscriber, token)
+	err := s.repo.Create(subscriber, payToken)
 	assert.Error(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
 }
 
 func (s *SuiteSubscribersRepository) TestSubscribersRepository_Create_CommitDbError() {
 	subscriber := models.TestSubscriber()
-	token := "25"
-	queryAwardPrice := "SELECT price FROM awards WHERE awards_id = $1"
-	queryAddPayment := "INSERT INTO payments(amount, creator_id, users_id, awards_id, pay_token) VALUES($1, $2, $3, $4, $5)"
-	queryAddSubscribe := "INSERT INTO subscribers(users_id, creator_id, awards_id) VALUES ($1, $2, $3)"
-
-	price := int64(1)
-
-	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAwardPrice)).
-		WithArgs(subscriber.AwardID).
-		WillReturnRows(sqlmock.NewRows([]string{"price"}).AddRow(price)).
-		RowsWillBeClosed()
+	payToken := &models.PayTokenInfo{Token: "25", Price: 1}
 
 	s.Mock.ExpectBegin()
+	s.expectLockAward(subscriber.AwardID, 0)
 
 	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddPayment)).
-		WithArgs(price, subscriber.CreatorID, subscriber.UserID, subscriber.AwardID, token).
-		WillReturnRows(sqlmock.NewRows([]string{})).
-		RowsWillBeClosed()
+		WithArgs(payToken.Price, subscriber.CreatorID, subscriber.UserID, subscriber.AwardID, payToken.Token, 0,
+			models.Decimal(0), payToken.Currency).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id"}).AddRow(3))
 
 	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddSubscribe)).
-		WithArgs(subscriber.UserID, subscriber.CreatorID, subscriber.AwardID).
+		WithArgs(subscriber.UserID, subscriber.CreatorID, subscriber.AwardID, payToken.ExpiresAt).
 		WillReturnRows(sqlmock.NewRows([]string{})).
 		RowsWillBeClosed()
 
 	s.Mock.ExpectCommit().WillReturnError(repository.DefaultErrDB)
-	err := s.repo.Create(subscriber, token)
-	assert.Error(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
-}
-
-func (s *SuiteSubscribersRepository) TestSubscribersRepository_Create_FirstQueryCloseRowDbError() {
-	subscriber := models.TestSubscriber()
-	token := "25"
-	queryAwardPrice := "SELECT price FROM awards WHERE awards_id = $1"
-
-	price := int64(1)
-
-	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAwardPrice)).
-		WithArgs(subscriber.AwardID).
-		WillReturnRows(sqlmock.NewRows([]string{"price"}).AddRow(price).
-			CloseError(repository.DefaultErrDB))
-
-	err := s.repo.Create(subscriber, token)
+	err := s.repo.Create(subscriber, payToken)
 	assert.Error(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
 }
-func (s *SuiteSubscribersRepository) TestSubscribersRepository_Create_SecondQueryCloseRowDbError() {
-	subscriber := models.TestSubscriber()
-	token := "25"
-	queryAwardPrice := "SELECT price FROM awards WHERE awards_id = $1"
-	queryAddPayment := "INSERT INTO payments(amount, creator_id, users_id, awards_id, pay_token) VALUES($1, $2, $3, $4, $5)"
-
-	price := int64(1)
-
-	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAwardPrice)).
-		WithArgs(subscriber.AwardID).
-		WillReturnRows(sqlmock.NewRows([]string{"price"}).AddRow(price)).
-		RowsWillBeClosed()
-
-	s.Mock.ExpectBegin()
 
-	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddPayment)).
-		WithArgs(price, subscriber.CreatorID, subscriber.UserID, subscriber.AwardID, token).
-		WillReturnRows(sqlmock.NewRows([]string{}).
-			CloseError(repository.DefaultErrDB))
-	s.Mock.ExpectRollback()
-
-	err := s.repo.Create(subscriber, token)
-	assert.Error(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
-}
 func (s *SuiteSubscribersRepository) TestSubscribersRepository_Create_ThirdQueryCloseRowDbError() {
 	subscriber := models.TestSubscriber()
-	token := "25"
-	queryAwardPrice := "SELECT price FROM awards WHERE awards_id = $1"
-	queryAddPayment := "INSERT INTO payments(amount, creator_id, users_id, awards_id, pay_token) VALUES($1, $2, $3, $4, $5)"
-	queryAddSubscribe := "INSERT INTO subscribers(users_id, creator_id, awards_id) VALUES ($1, $2, $3)"
-
-	price := int64(1)
-
-	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAwardPrice)).
-		WithArgs(subscriber.AwardID).
-		WillReturnRows(sqlmock.NewRows([]string{"price"}).AddRow(price)).
-		RowsWillBeClosed()
+	payToken := &models.PayTokenInfo{Token: "25", Price: 1}
 
 	s.Mock.ExpectBegin()
+	s.expectLockAward(subscriber.AwardID, 0)
 
 	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddPayment)).
-		WithArgs(price, subscriber.CreatorID, subscriber.UserID, subscriber.AwardID, token).
-		WillReturnRows(sqlmock.NewRows([]string{})).
-		RowsWillBeClosed()
+		WithArgs(payToken.Price, subscriber.CreatorID, subscriber.UserID, subscriber.AwardID, payToken.Token, 0,
+			models.Decimal(0), payToken.Currency).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id"}).AddRow(3))
 
 	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddSubscribe)).
-		WithArgs(subscriber.UserID, subscriber.CreatorID, subscriber.AwardID).
+		WithArgs(subscriber.UserID, subscriber.CreatorID, subscriber.AwardID, payToken.ExpiresAt).
 		WillReturnRows(sqlmock.NewRows([]string{}).
 			CloseError(repository.DefaultErrDB))
 
 	s.Mock.ExpectRollback()
 
-	err := s.repo.Create(subscriber, token)
+	err := s.repo.Create(subscriber, payToken)
 	assert.Error(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
 }
 
-func (s *SuiteSubscribersRepository) TestSubscribersRepository_GetSubscribers_Ok_One() {
-	crId := 1
-	u := models.TestUser()
-	u.Login = ""
-	u.Password = ""
-	mockRes := []models.User{*u}
-
-	queryCount := "SELECT count(*) as cnt from subscribers WHERE creator_id = $1"
-	querySelect := `
-	SELECT DISTINCT s.users_id, nickname, avatar
-	from subscribers s join users u on s.users_id = u.users_id WHERE s.creator_id = $1`
-
-	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCount)).
-		WithArgs(crId).
-		WillReturnError(nil).
-		WillReturnRows(sqlmock.NewRows([]string{"cnt"}).AddRow(len(mockRes)))
-
-	s.Mock.ExpectQuery(regexp.QuoteMeta(querySelect)).
-		WithArgs(crId).
-		WillReturnError(nil).
-		WillReturnRows(sqlmock.NewRows([]string{"s.users_id", "nickname", "avatar"}).
-			AddRow(mockRes[0].ID, mockRes[0].Nickname, mockRes[0].Avatar)).RowsWillBeClosed()
-
-	res, err := s.repo.GetSubscribers(int64(crId))
-	assert.NoError(s.T(), err)
-	assert.Equal(s.T(), mockRes, res)
+func (s *SuiteSubscribersRepository) expectSubscribersPagination(pag *models.Pagination) (int64, int64) {
+	queryStat := "SELECT n_live_tup FROM pg_stat_all_tables WHERE relname = $1"
+	for i := 0; i < 2; i++ {
+		s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
+			WithArgs("subscribers").
+			WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).AddRow(int64(5000)))
+	}
+	limit, offset, err := putilits.AddPagination("subscribers", pag, s.DB)
+	require.NoError(s.T(), err)
+	return limit, offset
 }
 
-func (s *SuiteSubscribersRepository) TestSubscribersRepository_GetSubscribers_Ok_Few() {
-	crId := 1
-	mockRes := []models.User{*models.TestUser(), *models.TestUser(), *models.TestUser()}
-	for i := 0; i < len(mockRes); i++ {
-		mockRes[i].Login = ""
-		mockRes[i].Password = ""
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_GetSubscribers_Ok() {
+	crId := int64(1)
+	pag := &models.Pagination{Limit: 10, Offset: 20}
+	date := time.Now()
+	expected := []models.CreatorSubscriber{
+		{UserID: 2, Nickname: "first", Avatar: "img", AwardID: 3, AwardName: "gold", Status: models.SubscriptionActive,
+			SubscribedSince: date, LifetimeAmount: models.NewDecimal(300), Currency: models.DefaultCurrency,
+			MonthsSubscribed: 3},
+		{UserID: 4, Nickname: "second", AwardID: 5, AwardName: "silver", Status: models.SubscriptionExpired,
+			SubscribedSince: date, LifetimeAmount: models.NewDecimal(100), Currency: models.DefaultCurrency,
+			MonthsSubscribed: 1},
 	}
 
-	queryCount := "SELECT count(*) as cnt from subscribers WHERE creator_id = $1"
-	querySelect := `
-	SELECT DISTINCT s.users_id, nickname, avatar
-	from subscribers s join users u on s.users_id = u.users_id WHERE s.creator_id = $1`
-
-	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCount)).
-		WithArgs(crId).
-		WillReturnError(nil).
-		WillReturnRows(sqlmock.NewRows([]string{"cnt"}).AddRow(len(mockRes)))
-
-	s.Mock.ExpectQuery(regexp.QuoteMeta(querySelect)).
+	limit, offset := s.expectSubscribersPagination(pag)
+	query := querySelectSubscribers + subscribersOrder[""] + fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
+	rows := sqlmock.NewRows([]string{"users_id", "nickname", "avatar", "awards_id", "name", "status", "date",
+		"lifetime_amount", "currency", "months"})
+	for _, cur := range expected {
+		rows.AddRow(cur.UserID, cur.Nickname, cur.Avatar, cur.AwardID, cur.AwardName,
+			cur.Status == models.SubscriptionActive, cur.SubscribedSince, cur.LifetimeAmount, cur.Currency,
+			cur.MonthsSubscribed)
+	}
+	s.Mock.ExpectQuery(regexp.QuoteMeta(query)).
 		WithArgs(crId).
-		WillReturnError(nil).
-		WillReturnRows(sqlmock.NewRows([]string{"s.users_id", "nickname", "avatar"}).
-			AddRow(mockRes[0].ID, mockRes[0].Nickname, mockRes[0].Avatar).
-			AddRow(mockRes[1].ID, mockRes[1].Nickname, mockRes[1].Avatar).
-			AddRow(mockRes[2].ID, mockRes[2].Nickname, mockRes[2].Avatar)).
-		RowsWillBeClosed()
+		WillReturnRows(rows)
 
-	res, err := s.repo.GetSubscribers(int64(crId))
+	res, err := s.repo.GetSubscribers(crId, pag, nil)
 	assert.NoError(s.T(), err)
-	assert.Equal(s.T(), mockRes, res)
+	assert.Equal(s.T(), expected, res)
 }
 
-func (s *SuiteSubscribersRepository) TestSubscribersRepository_GetSubscribers_RowError_Few() {
-	crId := 1
-	mockRes := []models.User{*models.TestUser(), *models.TestUser(), *models.TestUser()}
-	for i := 0; i < len(mockRes); i++ {
-		mockRes[i].Login = ""
-		mockRes[i].Password = ""
-	}
-
-	queryCount := "SELECT count(*) as cnt from subscribers WHERE creator_id = $1"
-	querySelect := `
-	SELECT DISTINCT s.users_id, nickname, avatar
-	from subscribers s join users u on s.users_id = u.users_id WHERE s.creator_id = $1`
-
-	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCount)).
-		WithArgs(crId).
-		WillReturnError(nil).
-		WillReturnRows(sqlmock.NewRows([]string{"cnt"}).AddRow(len(mockRes)))
-
-	s.Mock.ExpectQuery(regexp.QuoteMeta(querySelect)).
-		WithArgs(crId).
-		WillReturnError(nil).
-		WillReturnRows(sqlmock.NewRows([]string{"s.users_id", "nickname", "avatar"}).
-			AddRow(mockRes[0].ID, mockRes[0].Nickname, mockRes[0].Avatar).
-			AddRow(mockRes[1].ID, mockRes[1].Nickname, mockRes[1].Avatar).
-			AddRow(mockRes[2].ID, mockRes[2].Nickname, mockRes[2].Avatar).RowError(0, repository.DefaultErrDB))
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_GetSubscribers_Filter() {
+	crId := int64(1)
+	pag := &models.Pagination{Limit: 10, Offset: 0}
+	since := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	filter := &models.SubscribersFilter{AwardID: 3, Status: models.SubscriptionExpired, Since: since,
+		Nickname: "nick", Sort: models.SortSubscribersAmount}
+
+	limit, offset := s.expectSubscribersPagination(pag)
+	query := querySelectSubscribers + "AND awards_id = $2 AND status = $3 AND date >= $4 " +
+		"AND strpos(lower(nickname), lower($5)) > 0 " + "ORDER BY lifetime_amount DESC, users_id " +
+		fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(crId, filter.AwardID, false, since, filter.Nickname).
+		WillReturnRows(sqlmock.NewRows([]string{"users_id"}))
 
-	_, err := s.repo.GetSubscribers(int64(crId))
-	assert.Error(s.T(), err, repository.NewDBError(repository.DefaultErrDB))
+	res, err := s.repo.GetSubscribers(crId, pag, filter)
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), res)
 }
 
-func (s *SuiteSubscribersRepository) TestSubscribersRepository_GetSubscribers_DBErrorScan_Few() {
-	crId := 1
-	mockRes := []models.User{*models.TestUser(), *models.TestUser(), *models.TestUser()}
-	for i := 0; i < len(mockRes); i++ {
-		mockRes[i].Login = ""
-		mockRes[i].Password = ""
-	}
-
-	queryCount := "SELECT count(*) as cnt from subscribers WHERE creator_id = $1"
-	querySelect := `
-	SELECT DISTINCT s.users_id, nickname, avatar
-	from subscribers s join users u on s.users_id = u.users_id WHERE s.creator_id = $1`
-
-	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCount)).
-		WithArgs(crId).
-		WillReturnError(nil).
-		WillReturnRows(sqlmock.NewRows([]string{"cnt"}).AddRow(len(mockRes)))
-
-	s.Mock.ExpectQuery(regexp.QuoteMeta(querySelect)).
-		WithArgs(crId).
-		WillReturnError(nil).
-		WillReturnRows(sqlmock.NewRows([]string{"s.users_id", "nickname", "avatar"}).
-			AddRow("id", "la", "o"))
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_GetSubscribers_NotFound() {
+	crId := int64(1)
+	pag := &models.Pagination{Limit: 0, Offset: 20}
+	queryStat := "SELECT n_live_tup FROM pg_stat_all_tables WHERE relname = $1"
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
+		WithArgs("subscribers").
+		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).AddRow(int64(10)))
 
-	_, err := s.repo.GetSubscribers(int64(crId))
-	assert.Error(s.T(), err)
+	res, err := s.repo.GetSubscribers(crId, pag, nil)
+	assert.Equal(s.T(), repository.NotFound, err)
+	assert.Nil(s.T(), res)
 }
 
-func (s *SuiteSubscribersRepository) TestSubscribersRepository_GetSubscribers_Ok_NoOne() {
-	crId := 1
-	mockRes := []models.User{}
-
-	queryCount := "SELECT count(*) as cnt from subscribers WHERE creator_id = $1"
-	querySelect := `
-	SELECT DISTINCT s.users_id, nickname, avatar
-	from subscribers s join users u on s.users_id = u.users_id WHERE s.creator_id = $1`
-
-	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCount)).
-		WithArgs(crId).
-		WillReturnError(nil).
-		WillReturnRows(sqlmock.NewRows([]string{"cnt"}).AddRow(len(mockRes)))
-
-	s.Mock.ExpectQuery(regexp.QuoteMeta(querySelect)).
-		WithArgs(crId).
-		WillReturnError(nil).
-		WillReturnRows(sqlmock.NewRows([]string{}))
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_GetSubscribers_ScanError() {
+	crId := int64(1)
+	pag := &models.Pagination{Limit: 10, Offset: 20}
 
-	res, err := s.repo.GetSubscribers(int64(crId))
-	assert.NoError(s.T(), err)
-	assert.Equal(s.T(), mockRes, res)
-}
-func (s *SuiteSubscribersRepository) TestSubscribersRepository_GetSubscribers_CountQueryError() {
-	crId := 1
-	queryCount := "SELECT count(*) as cnt from subscribers WHERE creator_id = $1"
-	expError := repository.NewDBError(models.BDError)
-
-	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCount)).
+	limit, offset := s.expectSubscribersPagination(pag)
+	query := querySelectSubscribers + subscribersOrder[""] + fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(query)).
 		WithArgs(crId).
-		WillReturnError(models.BDError)
+		WillReturnRows(sqlmock.NewRows([]string{"users_id", "nickname"}).AddRow("id", "la"))
 
-	res, err := s.repo.GetSubscribers(int64(crId))
-	assert.Equal(s.T(), expError, err)
-	assert.Nil(s.T(), res)
+	_, err := s.repo.GetSubscribers(crId, pag, nil)
+	assert.Error(s.T(), err)
 }
 
 func (s *SuiteSubscribersRepository) TestSubscribersRepository_GetSubscribers_SelectQueryError() {
-	crId := 1
-	queryCount := "SELECT count(*) as cnt from subscribers WHERE creator_id = $1"
-	querySelect := `
-	SELECT DISTINCT s.users_id, nickname, avatar
-	from subscribers s join users u on s.users_id = u.users_id WHERE s.creator_id = $1`
+	crId := int64(1)
+	pag := &models.Pagination{Limit: 10, Offset: 20}
 	expError := repository.NewDBError(models.BDError)
 
-	mockRes := []models.User{*models.TestUser()}
-	mockRes[0].Login = ""
-	mockRes[0].Password = ""
-
-	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCount)).
-		WithArgs(crId).
-		WillReturnError(nil).
-		WillReturnRows(sqlmock.NewRows([]string{"cnt"}).AddRow(len(mockRes)))
-	s.Mock.ExpectQuery(regexp.QuoteMeta(querySelect)).
+	limit, offset := s.expectSubscribersPagination(pag)
+	query := querySelectSubscribers + subscribersOrder[""] + fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(query)).
 		WithArgs(crId).
 		WillReturnError(models.BDError)
 
-	res, err := s.repo.GetSubscribers(int64(crId))
+	res, err := s.repo.GetSubscribers(crId, pag, nil)
 	assert.Equal(s.T(), expError, err)
 	assert.Nil(s.T(), res)
 }
@@ -721,6 +671,481 @@ func (s *SuiteSubscribersRepository) TestSubscribersRepository_Delete_ErrorClose
 	assert.Equal(s.T(), expErr, err)
 }
 
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_GetRenewalsDue_Ok() {
+	dueTo := time.Now()
+	expected := []models.BillingSubscription{
+		{ID: 1, UserID: 2, CreatorID: 3, AwardID: 4, Price: 100, Currency: models.DefaultCurrency, Period: 1,
+			PaidUntil: dueTo.Add(-time.Hour)},
+		{ID: 5, UserID: 6, CreatorID: 3, AwardID: 4, Price: 100, Currency: models.DefaultCurrency, Period: 1,
+			PaidUntil: dueTo, InTrial: true},
+	}
+	rows := sqlmock.NewRows([]string{"id", "users_id", "creator_id", "awards_id", "price", "currency", "period",
+		"paid_until", "in_trial"})
+	for _, sub := range expected {
+		rows.AddRow(sub.ID, sub.UserID, sub.CreatorID, sub.AwardID, sub.Price, sub.Currency, sub.Period,
+			sub.PaidUntil, sub.InTrial)
+	}
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetRenewalsDue)).
+		WithArgs(dueTo).
+		WillReturnRows(rows)
+
+	res, err := s.repo.GetRenewalsDue(dueTo)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), expected, res)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_GetRenewalsDue_DbError() {
+	dueTo := time.Now()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetRenewalsDue)).
+		WithArgs(dueTo).
+		WillReturnError(repository.DefaultErrDB)
+
+	_, err := s.repo.GetRenewalsDue(dueTo)
+	assert.Equal(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_CreateRenewal_Ok() {
+	sub := &models.BillingSubscription{ID: 1, UserID: 2, CreatorID: 3, AwardID: 4, Price: 100, Period: 1}
+	token := "renewal_token"
+	graceUntil := time.Now()
+
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryStartGrace)).
+		WithArgs(sub.ID, graceUntil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddRenewPayment)).
+		WithArgs(sub.Price, sub.CreatorID, sub.UserID, sub.AwardID, token, sub.Currency, sub.Period).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.Mock.ExpectCommit()
+
+	created, err := s.repo.CreateRenewal(sub, token, graceUntil)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), created)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_CreateRenewal_AlreadyInGrace() {
+	sub := &models.BillingSubscription{ID: 1, UserID: 2, CreatorID: 3, AwardID: 4, Price: 100, Period: 1}
+	token := "renewal_token"
+	graceUntil := time.Now()
+
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryStartGrace)).
+		WithArgs(sub.ID, graceUntil).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.Mock.ExpectRollback()
+
+	created, err := s.repo.CreateRenewal(sub, token, graceUntil)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), created)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_CreateRenewal_PaymentError() {
+	sub := &models.BillingSubscription{ID: 1, UserID: 2, CreatorID: 3, AwardID: 4, Price: 100, Period: 1}
+	token := "renewal_token"
+	graceUntil := time.Now()
+
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryStartGrace)).
+		WithArgs(sub.ID, graceUntil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddRenewPayment)).
+		WithArgs(sub.Price, sub.CreatorID, sub.UserID, sub.AwardID, token, sub.Currency, sub.Period).
+		WillReturnError(repository.DefaultErrDB)
+	s.Mock.ExpectRollback()
+
+	created, err := s.repo.CreateRenewal(sub, token, graceUntil)
+	assert.Equal(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
+	assert.False(s.T(), created)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_ExpireSubscriptions() {
+	now := time.Now()
+	expected := []models.BillingSubscription{
+		{ID: 1, UserID: 2, CreatorID: 3, AwardID: 4},
+		{ID: 5, UserID: 6, CreatorID: 3, AwardID: 7},
+	}
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryExpire)).
+		WithArgs(now).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "users_id", "creator_id", "awards_id"}).
+			AddRow(1, 2, 3, 4).AddRow(5, 6, 3, 7))
+
+	res, err := s.repo.ExpireSubscriptions(now)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), expected, res)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryExpire)).
+		WithArgs(now).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("id"))
+
+	_, err = s.repo.ExpireSubscriptions(now)
+	assert.Error(s.T(), err)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_GetActive_Ok() {
+	expected := &models.BillingSubscription{ID: 1, UserID: 2, CreatorID: 3, AwardID: 4, Price: 100,
+		Currency: models.DefaultCurrency, Period: 1, PaidUntil: time.Now(), NextAwardID: 5}
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetActive)).
+		WithArgs(expected.UserID, expected.CreatorID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "users_id", "creator_id", "awards_id", "price", "currency",
+			"period", "paid_until", "next_awards_id", "in_grace", "in_trial"}).
+			AddRow(expected.ID, expected.UserID, expected.CreatorID, expected.AwardID, expected.Price,
+				expected.Currency, expected.Period, expected.PaidUntil, expected.NextAwardID, expected.InGrace, expected.InTrial))
+
+	res, err := s.repo.GetActive(expected.UserID, expected.CreatorID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), expected, res)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_GetActive_NotFound() {
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetActive)).
+		WithArgs(int64(2), int64(3)).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err := s.repo.GetActive(2, 3)
+	assert.Equal(s.T(), repository.NotFound, err)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_CreateUpgrade_WithPayment() {
+	sub := &models.BillingSubscription{ID: 1, UserID: 2, CreatorID: 3, AwardID: 4, Price: 100, Period: 1}
+	change := &models.TierChange{SubscriptionID: sub.ID, CreatorID: sub.CreatorID, FromAwardID: sub.AwardID,
+		ToAwardID: 5, Kind: models.TierUpgrade, Status: models.TierChangePending, Amount: 50,
+		PayToken: "upgrade_token", EffectiveAt: time.Now()}
+	date := time.Now()
+
+	s.Mock.ExpectBegin()
+	ExpectTakeSeat(s.Mock, sub.UserID, change.ToAwardID, 0, 0)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddUpgradePayment)).
+		WithArgs(change.Amount, sub.CreatorID, sub.UserID, change.ToAwardID, change.PayToken, change.Currency).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id"}).AddRow(9))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddTierChange)).
+		WithArgs(change.SubscriptionID, change.FromAwardID, change.ToAwardID, change.Kind, change.Status,
+			change.Amount, int64(9), change.EffectiveAt).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "date"}).AddRow(7, date))
+	s.Mock.ExpectCommit()
+
+	err := s.repo.CreateUpgrade(sub, change)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(7), change.ID)
+	assert.Equal(s.T(), date, change.Date)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_CreateUpgrade_WithoutPayment() {
+	sub := &models.BillingSubscription{ID: 1, UserID: 2, CreatorID: 3, AwardID: 4, Price: 100, Period: 1}
+	change := &models.TierChange{SubscriptionID: sub.ID, CreatorID: sub.CreatorID, FromAwardID: sub.AwardID,
+		ToAwardID: 5, Kind: models.TierUpgrade, Status: models.TierChangeApplied, EffectiveAt: time.Now()}
+
+	s.Mock.ExpectBegin()
+	ExpectTakeSeat(s.Mock, sub.UserID, change.ToAwardID, 0, 0)
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryChangeAward)).
+		WithArgs(sub.ID, change.ToAwardID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryCancelDowngrades)).
+		WithArgs(sub.ID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddTierChange)).
+		WithArgs(change.SubscriptionID, change.FromAwardID, change.ToAwardID, change.Kind, change.Status,
+			change.Amount, nil, change.EffectiveAt).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "date"}).AddRow(7, time.Now()))
+	s.Mock.ExpectCommit()
+
+	err := s.repo.CreateUpgrade(sub, change)
+	require.NoError(s.T(), err)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_CreateUpgrade_PaymentError() {
+	sub := &models.BillingSubscription{ID: 1, UserID: 2, CreatorID: 3, AwardID: 4, Price: 100, Period: 1}
+	change := &models.TierChange{SubscriptionID: sub.ID, ToAwardID: 5, Amount: 50, PayToken: "upgrade_token"}
+
+	s.Mock.ExpectBegin()
+	ExpectTakeSeat(s.Mock, sub.UserID, change.ToAwardID, 0, 0)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddUpgradePayment)).
+		WithArgs(change.Amount, sub.CreatorID, sub.UserID, change.ToAwardID, change.PayToken, change.Currency).
+		WillReturnError(repository.DefaultErrDB)
+	s.Mock.ExpectRollback()
+
+	err := s.repo.CreateUpgrade(sub, change)
+	assert.Equal(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_CreateUpgrade_SoldOut() {
+	sub := &models.BillingSubscription{ID: 1, UserID: 2, CreatorID: 3, AwardID: 4, Price: 100, Period: 1}
+	change := &models.TierChange{SubscriptionID: sub.ID, ToAwardID: 5, Amount: 50, PayToken: "upgrade_token"}
+
+	s.Mock.ExpectBegin()
+	ExpectTakeSeat(s.Mock, sub.UserID, change.ToAwardID, 2, 2)
+	s.Mock.ExpectRollback()
+
+	err := s.repo.CreateUpgrade(sub, change)
+	assert.Equal(s.T(), AwardSoldOut, err)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_ScheduleDowngrade_Ok() {
+	change := &models.TierChange{SubscriptionID: 1, CreatorID: 3, FromAwardID: 4, ToAwardID: 5,
+		Kind: models.TierDowngrade, Status: models.TierChangePending, EffectiveAt: time.Now()}
+
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryCancelDowngrades)).
+		WithArgs(change.SubscriptionID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(querySetNextAward)).
+		WithArgs(change.SubscriptionID, change.ToAwardID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddTierChange)).
+		WithArgs(change.SubscriptionID, change.FromAwardID, change.ToAwardID, change.Kind, change.Status,
+			change.Amount, nil, change.EffectiveAt).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "date"}).AddRow(7, time.Now()))
+	s.Mock.ExpectCommit()
+
+	err := s.repo.ScheduleDowngrade(change)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(7), change.ID)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_ScheduleDowngrade_DbError() {
+	change := &models.TierChange{SubscriptionID: 1, ToAwardID: 5}
+
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryCancelDowngrades)).
+		WithArgs(change.SubscriptionID).
+		WillReturnError(repository.DefaultErrDB)
+	s.Mock.ExpectRollback()
+
+	err := s.repo.ScheduleDowngrade(change)
+	assert.Equal(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_CancelDowngrade_Ok() {
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryCancelDowngrades)).
+		WithArgs(int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(querySetNextAward)).
+		WithArgs(int64(1), nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectCommit()
+
+	err := s.repo.CancelDowngrade(1)
+	require.NoError(s.T(), err)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_ApplyDowngrades() {
+	now := time.Now()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetDueDowngrades)).
+		WithArgs(now).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "id", "users_id", "creator_id", "to_awards_id"}).
+			AddRow(7, 1, 2, 3, 4).AddRow(8, 5, 6, 3, 9))
+
+	s.Mock.ExpectBegin()
+	ExpectTakeSeat(s.Mock, 2, 4, 0, 0)
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryCloseDowngrade)).
+		WithArgs(7, models.TierChangeApplied).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryChangeAward)).
+		WithArgs(1, 4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectCommit()
+
+	// not paid subscriptions holding seats are counted at time of renewal run, not of database
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryLockAward)).
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"max_subscribers"}).AddRow(1))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCountTakenSeats)).
+		WithArgs(9, 6, now).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryCloseDowngrade)).
+		WithArgs(8, models.TierChangeCancelled).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(querySetNextAward)).
+		WithArgs(5, nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectCommit()
+
+	res, err := s.repo.ApplyDowngrades(now)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []models.BillingSubscription{{ID: 1, UserID: 2, CreatorID: 3, AwardID: 4}}, res)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetDueDowngrades)).
+		WithArgs(now).
+		WillReturnError(repository.DefaultErrDB)
+
+	_, err = s.repo.ApplyDowngrades(now)
+	assert.Equal(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_ApplyDowngrades_AlreadyClosed() {
+	now := time.Now()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetDueDowngrades)).
+		WithArgs(now).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "id", "users_id", "creator_id", "to_awards_id"}).
+			AddRow(7, 1, 2, 3, 4))
+	s.Mock.ExpectBegin()
+	ExpectTakeSeat(s.Mock, 2, 4, 0, 0)
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryCloseDowngrade)).
+		WithArgs(7, models.TierChangeApplied).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.Mock.ExpectRollback()
+
+	res, err := s.repo.ApplyDowngrades(now)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), res)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_GetTierChanges_Ok() {
+	now := time.Now()
+	expected := []models.TierChange{
+		{ID: 2, CreatorID: 3, FromAwardID: 4, ToAwardID: 5, Kind: models.TierUpgrade,
+			Status: models.TierChangePending, Amount: 50, Currency: models.DefaultCurrency, PayToken: "upgrade_token",
+			EffectiveAt: now, Date: now},
+		{ID: 1, CreatorID: 3, FromAwardID: 6, ToAwardID: 4, Kind: models.TierDowngrade,
+			Status: models.TierChangeApplied, Currency: models.DefaultCurrency, EffectiveAt: now, Date: now},
+	}
+	rows := sqlmock.NewRows([]string{"id", "creator_id", "from_awards_id", "to_awards_id", "kind", "status",
+		"amount", "currency", "pay_token", "effective_at", "date"})
+	for _, change := range expected {
+		rows.AddRow(change.ID, change.CreatorID, change.FromAwardID, change.ToAwardID, string(change.Kind),
+			string(change.Status), change.Amount, change.Currency, change.PayToken, change.EffectiveAt, change.Date)
+	}
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetTierChanges)).
+		WithArgs(int64(2), int64(3)).
+		WillReturnRows(rows)
+
+	res, err := s.repo.GetTierChanges(2, 3)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), expected, res)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_GetTierChanges_DbError() {
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetTierChanges)).
+		WithArgs(int64(2), int64(3)).
+		WillReturnError(repository.DefaultErrDB)
+
+	_, err := s.repo.GetTierChanges(2, 3)
+	assert.Equal(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_StartTrial_Ok() {
+	now := time.Now()
+	trial := &models.Trial{UserID: 2, CreatorID: 3, AwardID: 4, EndsAt: now.AddDate(0, 0, 7)}
+
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCountUserSubscriptions)).
+		WithArgs(trial.UserID, trial.CreatorID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	s.expectLockAward(trial.AwardID, 0)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddTrialSubscribe)).
+		WithArgs(trial.UserID, trial.CreatorID, trial.AwardID, trial.EndsAt).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddTrial)).
+		WithArgs(trial.UserID, trial.CreatorID, trial.AwardID, int64(5), trial.EndsAt).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "date"}).AddRow(1, now))
+	s.Mock.ExpectCommit()
+
+	err := s.repo.StartTrial(trial)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(1), trial.ID)
+	assert.Equal(s.T(), now, trial.Date)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_StartTrial_WasSubscribed() {
+	trial := &models.Trial{UserID: 2, CreatorID: 3, AwardID: 4, EndsAt: time.Now()}
+
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCountUserSubscriptions)).
+		WithArgs(trial.UserID, trial.CreatorID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	s.Mock.ExpectRollback()
+
+	err := s.repo.StartTrial(trial)
+	assert.Equal(s.T(), TrialNotAllowed, err)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_StartTrial_AlreadyUsed() {
+	trial := &models.Trial{UserID: 2, CreatorID: 3, AwardID: 4, EndsAt: time.Now()}
+
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCountUserSubscriptions)).
+		WithArgs(trial.UserID, trial.CreatorID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	s.expectLockAward(trial.AwardID, 0)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddTrialSubscribe)).
+		WithArgs(trial.UserID, trial.CreatorID, trial.AwardID, trial.EndsAt).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddTrial)).
+		WithArgs(trial.UserID, trial.CreatorID, trial.AwardID, int64(5), trial.EndsAt).
+		WillReturnError(&pq.Error{Code: codeDuplicateVal})
+	s.Mock.ExpectRollback()
+
+	err := s.repo.StartTrial(trial)
+	assert.Equal(s.T(), TrialAlreadyUsed, err)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_StartTrial_DbError() {
+	trial := &models.Trial{UserID: 2, CreatorID: 3, AwardID: 4, EndsAt: time.Now()}
+
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCountUserSubscriptions)).
+		WithArgs(trial.UserID, trial.CreatorID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	s.expectLockAward(trial.AwardID, 0)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddTrialSubscribe)).
+		WithArgs(trial.UserID, trial.CreatorID, trial.AwardID, trial.EndsAt).
+		WillReturnError(repository.DefaultErrDB)
+	s.Mock.ExpectRollback()
+
+	err := s.repo.StartTrial(trial)
+	assert.Equal(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_StartTrial_SoldOut() {
+	trial := &models.Trial{UserID: 2, CreatorID: 3, AwardID: 4, EndsAt: time.Now()}
+
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCountUserSubscriptions)).
+		WithArgs(trial.UserID, trial.CreatorID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	s.expectLockAward(trial.AwardID, 1)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCountTakenSeats)).
+		WithArgs(trial.AwardID, trial.UserID, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	s.Mock.ExpectRollback()
+
+	err := s.repo.StartTrial(trial)
+	assert.Equal(s.T(), AwardSoldOut, err)
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_AddToWaitlist() {
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddToWaitlist)).
+		WithArgs(int64(4), int64(2)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	assert.NoError(s.T(), s.repo.AddToWaitlist(4, 2))
+
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddToWaitlist)).
+		WithArgs(int64(4), int64(2)).
+		WillReturnError(repository.DefaultErrDB)
+	assert.Equal(s.T(), repository.NewDBError(repository.DefaultErrDB), s.repo.AddToWaitlist(4, 2))
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_RemoveFromWaitlist() {
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryRemoveFromWaitlist)).
+		WithArgs(int64(4), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(s.T(), s.repo.RemoveFromWaitlist(4, 2))
+}
+
+func (s *SuiteSubscribersRepository) TestSubscribersRepository_StopRenewal() {
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryStopRenewal)).
+		WithArgs(int64(2), int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(s.T(), s.repo.StopRenewal(2, 1))
+
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryStopRenewal)).
+		WithArgs(int64(2), int64(1)).
+		WillReturnError(repository.DefaultErrDB)
+	assert.Equal(s.T(), repository.NewDBError(repository.DefaultErrDB), s.repo.StopRenewal(2, 1))
+}
+
 func TestSubscribersRepository(t *testing.T) {
 	suite.Run(t, new(SuiteSubscribersRepository))
 }
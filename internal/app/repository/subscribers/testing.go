@@ -0,0 +1,27 @@
+package repository_subscribers
+
+import (
+	"regexp"
+
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+)
+
+// ExpectTakeSeat expect queries of TakeSeat by user on award with maxSubscribers seats, taken of them by others.
+// Award without limit of seats is only locked, time of seats hold is not checked
+func ExpectTakeSeat(mock sqlmock.Sqlmock, userID int64, awardID int64, maxSubscribers int64, taken int64) {
+	mock.ExpectQuery(regexp.QuoteMeta(queryLockAward)).
+		WithArgs(awardID).
+		WillReturnRows(sqlmock.NewRows([]string{"max_subscribers"}).AddRow(maxSubscribers))
+	if maxSubscribers == 0 {
+		return
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(queryCountTakenSeats)).
+		WithArgs(awardID, userID, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(taken))
+	if taken >= maxSubscribers {
+		return
+	}
+	mock.ExpectExec(regexp.QuoteMeta(queryRemoveFromWaitlist)).
+		WithArgs(awardID, userID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: patreon/internal/app/repository/subscription_events (interfaces: Repository)
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	models "patreon/internal/app/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// SubscriptionEventsRepository is a mock of Repository interface.
+type SubscriptionEventsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *SubscriptionEventsRepositoryMockRecorder
+}
+
+// SubscriptionEventsRepositoryMockRecorder is the mock recorder for SubscriptionEventsRepository.
+type SubscriptionEventsRepositoryMockRecorder struct {
+	mock *SubscriptionEventsRepository
+}
+
+// NewSubscriptionEventsRepository creates a new mock instance.
+func NewSubscriptionEventsRepository(ctrl *gomock.Controller) *SubscriptionEventsRepository {
+	mock := &SubscriptionEventsRepository{ctrl: ctrl}
+	mock.recorder = &SubscriptionEventsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *SubscriptionEventsRepository) EXPECT() *SubscriptionEventsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *SubscriptionEventsRepository) Add(arg0 *models.SubscriptionEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *SubscriptionEventsRepositoryMockRecorder) Add(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*SubscriptionEventsRepository)(nil).Add), arg0)
+}
+
+// GetCreatorEvents mocks base method.
+func (m *SubscriptionEventsRepository) GetCreatorEvents(arg0 int64, arg1 models.SubscriptionEventKind, arg2 *models.Pagination) ([]models.SubscriptionEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCreatorEvents", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.SubscriptionEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCreatorEvents indicates an expected call of GetCreatorEvents.
+func (mr *SubscriptionEventsRepositoryMockRecorder) GetCreatorEvents(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCreatorEvents", reflect.TypeOf((*SubscriptionEventsRepository)(nil).GetCreatorEvents), arg0, arg1, arg2)
+}
+
+// GetUserEvents mocks base method.
+func (m *SubscriptionEventsRepository) GetUserEvents(arg0 int64, arg1 *models.Pagination) ([]models.SubscriptionEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserEvents", arg0, arg1)
+	ret0, _ := ret[0].([]models.SubscriptionEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserEvents indicates an expected call of GetUserEvents.
+func (mr *SubscriptionEventsRepositoryMockRecorder) GetUserEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserEvents", reflect.TypeOf((*SubscriptionEventsRepository)(nil).GetUserEvents), arg0, arg1)
+}
@@ -0,0 +1,118 @@
+package repository_postgresql
+
+import (
+	"database/sql"
+	"fmt"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_subscription_events "patreon/internal/app/repository/subscription_events"
+	putilits "patreon/internal/app/utilits/postgresql"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	queryAdd = "INSERT INTO subscription_events (users_id, creator_id, awards_id, kind, reason) " +
+		"VALUES ($1, $2, NULLIF($3, 0), $4, $5) RETURNING id, date"
+
+	querySelectEvents = "SELECT e.id, e.users_id, u.nickname, e.creator_id, cu.nickname, COALESCE(e.awards_id, 0), " +
+		"COALESCE(a.name, ''), e.kind, e.reason, e.date FROM subscription_events e " +
+		"JOIN users u on e.users_id = u.users_id " +
+		"JOIN users cu on e.creator_id = cu.users_id " +
+		"LEFT JOIN awards a on e.awards_id = a.awards_id "
+	queryUserEventsWhere    = "WHERE e.users_id = $1 "
+	queryCreatorEventsWhere = "WHERE e.creator_id = $1 "
+	queryKindWhere          = "AND e.kind = $2 "
+	queryOrderEvents        = "ORDER BY e.date DESC, e.id DESC "
+)
+
+type SubscriptionEventsRepository struct {
+	store *sqlx.DB
+}
+
+var _ = repository_subscription_events.Repository(&SubscriptionEventsRepository{})
+
+func NewSubscriptionEventsRepository(store *sqlx.DB) *SubscriptionEventsRepository {
+	return &SubscriptionEventsRepository{
+		store: store,
+	}
+}
+
+// Add Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *SubscriptionEventsRepository) Add(event *models.SubscriptionEvent) error {
+	if err := repo.store.QueryRow(queryAdd, event.UserID, event.CreatorID, event.AwardID, event.Kind, event.Reason).
+		Scan(&event.ID, &event.Date); err != nil {
+		return repository.NewDBError(err)
+	}
+	return nil
+}
+
+// GetUserEvents return page of history of user subscriptions, the last first
+// Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *SubscriptionEventsRepository) GetUserEvents(userID int64,
+	pag *models.Pagination) ([]models.SubscriptionEvent, error) {
+	return repo.selectEvents(querySelectEvents+queryUserEventsWhere, []interface{}{userID}, pag)
+}
+
+// GetCreatorEvents return page of events of subscriptions on creator, the last first.
+// Empty kind does not restrict events
+// Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *SubscriptionEventsRepository) GetCreatorEvents(creatorID int64, kind models.SubscriptionEventKind,
+	pag *models.Pagination) ([]models.SubscriptionEvent, error) {
+	query := querySelectEvents + queryCreatorEventsWhere
+	args := []interface{}{creatorID}
+	if kind != "" {
+		query += queryKindWhere
+		args = append(args, kind)
+	}
+	return repo.selectEvents(query, args, pag)
+}
+
+// selectEvents Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *SubscriptionEventsRepository) selectEvents(query string, args []interface{},
+	pag *models.Pagination) ([]models.SubscriptionEvent, error) {
+	limit, offset, err := putilits.AddPagination("subscription_events", pag, repo.store)
+	if err != nil {
+		return nil, err
+	}
+	if limit == 0 {
+		return nil, repository.NotFound
+	}
+	query += queryOrderEvents + fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := repo.store.Query(query, args...)
+	if err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	res := make([]models.SubscriptionEvent, 0, limit)
+	for rows.Next() {
+		cur := models.SubscriptionEvent{}
+		if err = scanEvent(rows, &cur); err != nil {
+			_ = rows.Close()
+			return nil, repository.NewDBError(err)
+		}
+		res = append(res, cur)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, repository.NewDBError(err)
+	}
+	return res, nil
+}
+
+func scanEvent(rows *sql.Rows, cur *models.SubscriptionEvent) error {
+	return rows.Scan(&cur.ID, &cur.UserID, &cur.Nickname, &cur.CreatorID, &cur.CreatorNickname, &cur.AwardID,
+		&cur.AwardName, &cur.Kind, &cur.Reason, &cur.Date)
+}
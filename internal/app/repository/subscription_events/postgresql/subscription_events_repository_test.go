@@ -0,0 +1,148 @@
+package repository_postgresql
+
+import (
+	"fmt"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	putilits "patreon/internal/app/utilits/postgresql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+)
+
+type SuiteSubscriptionEventsRepository struct {
+	models.Suite
+	repo *SubscriptionEventsRepository
+}
+
+func (s *SuiteSubscriptionEventsRepository) SetupSuite() {
+	s.InitBD()
+	s.repo = NewSubscriptionEventsRepository(s.DB)
+}
+
+func (s *SuiteSubscriptionEventsRepository) AfterTest(_, _ string) {
+	require.NoError(s.T(), s.Mock.ExpectationsWereMet())
+}
+
+func (s *SuiteSubscriptionEventsRepository) expectPagination(pag *models.Pagination) (int64, int64) {
+	queryStat := "SELECT n_live_tup FROM pg_stat_all_tables WHERE relname = $1"
+	for i := 0; i < 2; i++ {
+		s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
+			WithArgs("subscription_events").
+			WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).AddRow(int64(5000)))
+	}
+	limit, offset, err := putilits.AddPagination("subscription_events", pag, s.DB)
+	require.NoError(s.T(), err)
+	return limit, offset
+}
+
+func eventsRows(events []models.SubscriptionEvent) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "users_id", "nickname", "creator_id", "nickname", "awards_id", "name",
+		"kind", "reason", "date"})
+	for _, cur := range events {
+		rows.AddRow(cur.ID, cur.UserID, cur.Nickname, cur.CreatorID, cur.CreatorNickname, cur.AwardID, cur.AwardName,
+			cur.Kind, cur.Reason, cur.Date)
+	}
+	return rows
+}
+
+func (s *SuiteSubscriptionEventsRepository) TestSubscriptionEventsRepository_Add_OK() {
+	event := &models.SubscriptionEvent{UserID: 1, CreatorID: 2, AwardID: 3, Kind: models.SubscriptionEventCancelled,
+		Reason: "too expensive"}
+	now := time.Now()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAdd)).
+		WithArgs(event.UserID, event.CreatorID, event.AwardID, event.Kind, event.Reason).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "date"}).AddRow(4, now))
+	err := s.repo.Add(event)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(4), event.ID)
+	assert.Equal(s.T(), now, event.Date)
+}
+
+func (s *SuiteSubscriptionEventsRepository) TestSubscriptionEventsRepository_Add_DBError() {
+	event := &models.SubscriptionEvent{UserID: 1, CreatorID: 2, Kind: models.SubscriptionEventRefunded}
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAdd)).
+		WithArgs(event.UserID, event.CreatorID, event.AwardID, event.Kind, event.Reason).
+		WillReturnError(repository.DefaultErrDB)
+	err := s.repo.Add(event)
+	assert.Equal(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
+}
+
+func (s *SuiteSubscriptionEventsRepository) TestSubscriptionEventsRepository_GetUserEvents_OK() {
+	userID := int64(1)
+	pag := &models.Pagination{Limit: 10, Offset: 20}
+	date := time.Now()
+	expected := []models.SubscriptionEvent{
+		{ID: 5, UserID: userID, Nickname: "user", CreatorID: 2, CreatorNickname: "creator", AwardID: 3,
+			AwardName: "gold", Kind: models.SubscriptionEventRenewed, Date: date},
+		{ID: 4, UserID: userID, Nickname: "user", CreatorID: 2, CreatorNickname: "creator",
+			Kind: models.SubscriptionEventSubscribed, Date: date},
+	}
+
+	limit, offset := s.expectPagination(pag)
+	query := querySelectEvents + queryUserEventsWhere + queryOrderEvents +
+		fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(userID).
+		WillReturnRows(eventsRows(expected))
+
+	res, err := s.repo.GetUserEvents(userID, pag)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), expected, res)
+}
+
+func (s *SuiteSubscriptionEventsRepository) TestSubscriptionEventsRepository_GetCreatorEvents_Kind() {
+	creatorID := int64(2)
+	pag := &models.Pagination{Limit: 10, Offset: 0}
+	expected := []models.SubscriptionEvent{
+		{ID: 5, UserID: 1, Nickname: "user", CreatorID: creatorID, CreatorNickname: "creator", AwardID: 3,
+			AwardName: "gold", Kind: models.SubscriptionEventCancelled, Reason: "too expensive", Date: time.Now()},
+	}
+
+	limit, offset := s.expectPagination(pag)
+	query := querySelectEvents + queryCreatorEventsWhere + queryKindWhere + queryOrderEvents +
+		fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(creatorID, models.SubscriptionEventCancelled).
+		WillReturnRows(eventsRows(expected))
+
+	res, err := s.repo.GetCreatorEvents(creatorID, models.SubscriptionEventCancelled, pag)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), expected, res)
+}
+
+func (s *SuiteSubscriptionEventsRepository) TestSubscriptionEventsRepository_GetCreatorEvents_NotFound() {
+	pag := &models.Pagination{Limit: 0, Offset: 20}
+	queryStat := "SELECT n_live_tup FROM pg_stat_all_tables WHERE relname = $1"
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryStat)).
+		WithArgs("subscription_events").
+		WillReturnRows(sqlmock.NewRows([]string{"n_live_tup"}).AddRow(int64(10)))
+
+	res, err := s.repo.GetCreatorEvents(2, "", pag)
+	assert.Equal(s.T(), repository.NotFound, err)
+	assert.Nil(s.T(), res)
+}
+
+func (s *SuiteSubscriptionEventsRepository) TestSubscriptionEventsRepository_GetCreatorEvents_ScanError() {
+	creatorID := int64(2)
+	pag := &models.Pagination{Limit: 10, Offset: 0}
+
+	limit, offset := s.expectPagination(pag)
+	query := querySelectEvents + queryCreatorEventsWhere + queryOrderEvents +
+		fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
+	s.Mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(creatorID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "users_id"}).AddRow("id", "la"))
+
+	_, err := s.repo.GetCreatorEvents(creatorID, "", pag)
+	assert.Error(s.T(), err)
+}
+
+func TestSubscriptionEventsRepository(t *testing.T) {
+	suite.Run(t, new(SuiteSubscriptionEventsRepository))
+}
@@ -0,0 +1,25 @@
+package repository_subscription_events
+
+import "patreon/internal/app/models"
+
+//go:generate mockgen -destination=mocks/mock_subscription_events_repository.go -package=mock_repository -mock_names=Repository=SubscriptionEventsRepository . Repository
+
+type Repository interface {
+	// Add Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	Add(event *models.SubscriptionEvent) error
+
+	// GetUserEvents Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetUserEvents(userID int64, pag *models.Pagination) ([]models.SubscriptionEvent, error)
+
+	// GetCreatorEvents Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetCreatorEvents(creatorID int64, kind models.SubscriptionEventKind,
+		pag *models.Pagination) ([]models.SubscriptionEvent, error)
+}
@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: patreon/internal/app/repository/tags (interfaces: Repository)
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	models "patreon/internal/app/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// TagsRepository is a mock of Repository interface.
+type TagsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *TagsRepositoryMockRecorder
+}
+
+// TagsRepositoryMockRecorder is the mock recorder for TagsRepository.
+type TagsRepositoryMockRecorder struct {
+	mock *TagsRepository
+}
+
+// NewTagsRepository creates a new mock instance.
+func NewTagsRepository(ctrl *gomock.Controller) *TagsRepository {
+	mock := &TagsRepository{ctrl: ctrl}
+	mock.recorder = &TagsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *TagsRepository) EXPECT() *TagsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddToPost mocks base method.
+func (m *TagsRepository) AddToPost(arg0 int64, arg1 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddToPost", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddToPost indicates an expected call of AddToPost.
+func (mr *TagsRepositoryMockRecorder) AddToPost(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToPost", reflect.TypeOf((*TagsRepository)(nil).AddToPost), arg0, arg1)
+}
+
+// GetCreatorTags mocks base method.
+func (m *TagsRepository) GetCreatorTags(arg0 int64, arg1 bool) ([]models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCreatorTags", arg0, arg1)
+	ret0, _ := ret[0].([]models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCreatorTags indicates an expected call of GetCreatorTags.
+func (mr *TagsRepositoryMockRecorder) GetCreatorTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCreatorTags", reflect.TypeOf((*TagsRepository)(nil).GetCreatorTags), arg0, arg1)
+}
+
+// GetPostsTags mocks base method.
+func (m *TagsRepository) GetPostsTags(arg0 []int64) (map[int64][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostsTags", arg0)
+	ret0, _ := ret[0].(map[int64][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostsTags indicates an expected call of GetPostsTags.
+func (mr *TagsRepositoryMockRecorder) GetPostsTags(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostsTags", reflect.TypeOf((*TagsRepository)(nil).GetPostsTags), arg0)
+}
+
+// RemoveFromPost mocks base method.
+func (m *TagsRepository) RemoveFromPost(arg0 int64, arg1 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFromPost", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFromPost indicates an expected call of RemoveFromPost.
+func (mr *TagsRepositoryMockRecorder) RemoveFromPost(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromPost", reflect.TypeOf((*TagsRepository)(nil).RemoveFromPost), arg0, arg1)
+}
@@ -0,0 +1,128 @@
+package repository_postgresql
+
+import (
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_tags "patreon/internal/app/repository/tags"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const (
+	queryCreateTags = `INSERT INTO tags (creator_id, name)
+			SELECT p.creator_id, t.name FROM posts p, unnest($2::text[]) AS t(name) WHERE p.posts_id = $1
+			ON CONFLICT (creator_id, name) DO NOTHING`
+	queryAddToPost = `INSERT INTO posts_tags (posts_id, tags_id)
+			SELECT p.posts_id, t.tags_id FROM posts p JOIN tags t ON t.creator_id = p.creator_id
+			WHERE p.posts_id = $1 AND t.name = ANY($2)
+			ON CONFLICT DO NOTHING`
+	queryRemoveFromPost = `DELETE FROM posts_tags pt USING tags t
+			WHERE pt.tags_id = t.tags_id AND pt.posts_id = $1 AND t.name = ANY($2)`
+
+	queryGetPostsTags = `SELECT pt.posts_id, t.name FROM posts_tags pt JOIN tags t ON t.tags_id = pt.tags_id
+			WHERE pt.posts_id = ANY($1) ORDER BY pt.posts_id, t.name`
+
+	queryGetCreatorTags = `SELECT t.name, count(*) FROM tags t
+			JOIN posts_tags pt ON pt.tags_id = t.tags_id
+			JOIN posts p ON p.posts_id = pt.posts_id
+			WHERE t.creator_id = $1 AND ($2 OR NOT p.is_draft)
+			GROUP BY t.name ORDER BY count(*) DESC, t.name`
+)
+
+type TagsRepository struct {
+	store *sqlx.DB
+}
+
+var _ = repository_tags.Repository(&TagsRepository{})
+
+func NewTagsRepository(store *sqlx.DB) *TagsRepository {
+	return &TagsRepository{
+		store: store,
+	}
+}
+
+// AddToPost Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *TagsRepository) AddToPost(postID int64, tags []string) error {
+	begin, err := repo.store.Begin()
+	if err != nil {
+		return repository.NewDBError(err)
+	}
+
+	if _, err = begin.Exec(queryCreateTags, postID, pq.Array(tags)); err != nil {
+		_ = begin.Rollback()
+		return repository.NewDBError(err)
+	}
+	if _, err = begin.Exec(queryAddToPost, postID, pq.Array(tags)); err != nil {
+		_ = begin.Rollback()
+		return repository.NewDBError(err)
+	}
+
+	if err = begin.Commit(); err != nil {
+		return repository.NewDBError(err)
+	}
+	return nil
+}
+
+// RemoveFromPost Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *TagsRepository) RemoveFromPost(postID int64, tags []string) error {
+	if _, err := repo.store.Exec(queryRemoveFromPost, postID, pq.Array(tags)); err != nil {
+		return repository.NewDBError(err)
+	}
+	return nil
+}
+
+// GetPostsTags Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *TagsRepository) GetPostsTags(postIDs []int64) (map[int64][]string, error) {
+	rows, err := repo.store.Query(queryGetPostsTags, pq.Array(postIDs))
+	if err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	res := make(map[int64][]string)
+	for rows.Next() {
+		var postID int64
+		var tag string
+		if err = rows.Scan(&postID, &tag); err != nil {
+			_ = rows.Close()
+			return nil, repository.NewDBError(err)
+		}
+		res[postID] = append(res[postID], tag)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, repository.NewDBError(err)
+	}
+	return res, nil
+}
+
+// GetCreatorTags Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *TagsRepository) GetCreatorTags(creatorID int64, withDraft bool) ([]models.Tag, error) {
+	rows, err := repo.store.Query(queryGetCreatorTags, creatorID, withDraft)
+	if err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	res := make([]models.Tag, 0)
+	for rows.Next() {
+		var tag models.Tag
+		if err = rows.Scan(&tag.Name, &tag.Posts); err != nil {
+			_ = rows.Close()
+			return nil, repository.NewDBError(err)
+		}
+		res = append(res, tag)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, repository.NewDBError(err)
+	}
+	return res, nil
+}
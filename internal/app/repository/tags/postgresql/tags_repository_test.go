@@ -0,0 +1,111 @@
+package repository_postgresql
+
+import (
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	"regexp"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+)
+
+type SuiteTagsRepository struct {
+	models.Suite
+	repo *TagsRepository
+}
+
+func (s *SuiteTagsRepository) SetupSuite() {
+	s.InitBD()
+	s.repo = NewTagsRepository(s.DB)
+}
+
+func (s *SuiteTagsRepository) AfterTest(_, _ string) {
+	require.NoError(s.T(), s.Mock.ExpectationsWereMet())
+}
+
+func (s *SuiteTagsRepository) TestTagsRepository_AddToPost() {
+	tags := []string{"tutorial", "wip"}
+
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryCreateTags)).
+		WithArgs(int64(2), pq.Array(tags)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddToPost)).
+		WithArgs(int64(2), pq.Array(tags)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	s.Mock.ExpectCommit()
+	err := s.repo.AddToPost(2, tags)
+	assert.NoError(s.T(), err)
+
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryCreateTags)).
+		WithArgs(int64(2), pq.Array(tags)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryAddToPost)).
+		WithArgs(int64(2), pq.Array(tags)).
+		WillReturnError(repository.DefaultErrDB)
+	s.Mock.ExpectRollback()
+	err = s.repo.AddToPost(2, tags)
+	assert.Equal(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
+}
+
+func (s *SuiteTagsRepository) TestTagsRepository_RemoveFromPost() {
+	tags := []string{"wip"}
+
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryRemoveFromPost)).
+		WithArgs(int64(2), pq.Array(tags)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	err := s.repo.RemoveFromPost(2, tags)
+	assert.NoError(s.T(), err)
+
+	s.Mock.ExpectExec(regexp.QuoteMeta(queryRemoveFromPost)).
+		WithArgs(int64(2), pq.Array(tags)).
+		WillReturnError(repository.DefaultErrDB)
+	err = s.repo.RemoveFromPost(2, tags)
+	assert.Equal(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
+}
+
+func (s *SuiteTagsRepository) TestTagsRepository_GetPostsTags() {
+	ids := []int64{2, 3, 4}
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetPostsTags)).
+		WithArgs(pq.Array(ids)).
+		WillReturnRows(sqlmock.NewRows([]string{"posts_id", "name"}).
+			AddRow(int64(2), "tutorial").
+			AddRow(int64(2), "wip").
+			AddRow(int64(4), "episode"))
+	res, err := s.repo.GetPostsTags(ids)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), map[int64][]string{2: {"tutorial", "wip"}, 4: {"episode"}}, res)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetPostsTags)).
+		WithArgs(pq.Array(ids)).
+		WillReturnError(repository.DefaultErrDB)
+	_, err = s.repo.GetPostsTags(ids)
+	assert.Equal(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
+}
+
+func (s *SuiteTagsRepository) TestTagsRepository_GetCreatorTags() {
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetCreatorTags)).
+		WithArgs(int64(1), false).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "count"}).
+			AddRow("tutorial", int64(5)).
+			AddRow("wip", int64(1)))
+	res, err := s.repo.GetCreatorTags(1, false)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []models.Tag{{Name: "tutorial", Posts: 5}, {Name: "wip", Posts: 1}}, res)
+
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryGetCreatorTags)).
+		WithArgs(int64(1), true).
+		WillReturnError(repository.DefaultErrDB)
+	_, err = s.repo.GetCreatorTags(1, true)
+	assert.Equal(s.T(), repository.NewDBError(repository.DefaultErrDB), err)
+}
+
+func TestTagsRepository(t *testing.T) {
+	suite.Run(t, new(SuiteTagsRepository))
+}
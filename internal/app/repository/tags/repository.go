@@ -0,0 +1,29 @@
+package repository_tags
+
+import "patreon/internal/app/models"
+
+//go:generate mockgen -destination=mocks/mock_tags_repository.go -package=mock_repository -mock_names=Repository=TagsRepository . Repository
+
+type Repository interface {
+	// AddToPost mark post by tags, missing tags are created for creator of post
+	// Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	AddToPost(postID int64, tags []string) error
+	// RemoveFromPost remove tags from post, unknown tags are skipped
+	// Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	RemoveFromPost(postID int64, tags []string) error
+	// GetPostsTags return tags of every post ordered by name, posts without tags are absent in result
+	// Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetPostsTags(postIDs []int64) (map[int64][]string, error)
+	// GetCreatorTags return tags of creator with count of posts, the most used first,
+	// drafts are counted only withDraft
+	// Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetCreatorTags(creatorID int64, withDraft bool) ([]models.Tag, error)
+}
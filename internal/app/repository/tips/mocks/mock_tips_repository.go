@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: patreon/internal/app/repository/tips (interfaces: Repository)
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	models "patreon/internal/app/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// TipsRepository is a mock of Repository interface.
+type TipsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *TipsRepositoryMockRecorder
+}
+
+// TipsRepositoryMockRecorder is the mock recorder for TipsRepository.
+type TipsRepositoryMockRecorder struct {
+	mock *TipsRepository
+}
+
+// NewTipsRepository creates a new mock instance.
+func NewTipsRepository(ctrl *gomock.Controller) *TipsRepository {
+	mock := &TipsRepository{ctrl: ctrl}
+	mock.recorder = &TipsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *TipsRepository) EXPECT() *TipsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *TipsRepository) Create(arg0 *models.Tip) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *TipsRepositoryMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*TipsRepository)(nil).Create), arg0)
+}
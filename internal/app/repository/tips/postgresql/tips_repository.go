@@ -0,0 +1,54 @@
+package repository_postgresql
+
+import (
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_tips "patreon/internal/app/repository/tips"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	queryAddPayment = "INSERT INTO payments(amount, creator_id, users_id, pay_token, type, currency) " +
+		"VALUES($1, $2, $3, $4, 'tip', $5) RETURNING payments_id, date"
+	queryCreate = "INSERT INTO tips (payments_id, posts_id, message) VALUES ($1, NULLIF($2, 0), $3) RETURNING tips_id"
+)
+
+type TipsRepository struct {
+	store *sqlx.DB
+}
+
+var _ = repository_tips.Repository(&TipsRepository{})
+
+func NewTipsRepository(store *sqlx.DB) *TipsRepository {
+	return &TipsRepository{
+		store: store,
+	}
+}
+
+// Create not paid payment of user with tip.PayToken and tip bound to it
+// Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *TipsRepository) Create(tip *models.Tip) error {
+	begin, err := repo.store.Begin()
+	if err != nil {
+		return repository.NewDBError(err)
+	}
+
+	var paymentID int64
+	if err = begin.QueryRow(queryAddPayment, tip.Amount, tip.CreatorID, tip.UserID, tip.PayToken, tip.Currency).
+		Scan(&paymentID, &tip.Date); err != nil {
+		_ = begin.Rollback()
+		return repository.NewDBError(err)
+	}
+	if err = begin.QueryRow(queryCreate, paymentID, tip.PostID, tip.Message).Scan(&tip.ID); err != nil {
+		_ = begin.Rollback()
+		return repository.NewDBError(err)
+	}
+
+	if err = begin.Commit(); err != nil {
+		return repository.NewDBError(err)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package repository_postgresql
+
+import (
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	sqlmock "github.com/zhashkevych/go-sqlxmock"
+)
+
+type SuiteTipsRepository struct {
+	models.Suite
+	repo *TipsRepository
+}
+
+func (s *SuiteTipsRepository) SetupSuite() {
+	s.InitBD()
+	s.repo = NewTipsRepository(s.DB)
+}
+
+func (s *SuiteTipsRepository) AfterTest(_, _ string) {
+	require.NoError(s.T(), s.Mock.ExpectationsWereMet())
+}
+
+func (s *SuiteTipsRepository) TestTipsRepository_Create_OK() {
+	tip := models.TestTip()
+	tip.PostID = 3
+	tip.PayToken = "token"
+	now := time.Now()
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddPayment)).
+		WithArgs(tip.Amount, tip.CreatorID, tip.UserID, tip.PayToken, tip.Currency).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id", "date"}).AddRow(5, now))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCreate)).
+		WithArgs(5, tip.PostID, tip.Message).
+		WillReturnRows(sqlmock.NewRows([]string{"tips_id"}).AddRow(9))
+	s.Mock.ExpectCommit()
+	err := s.repo.Create(tip)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(9), tip.ID)
+	assert.Equal(s.T(), now, tip.Date)
+}
+
+func (s *SuiteTipsRepository) TestTipsRepository_Create_DbError() {
+	tip := models.TestTip()
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddPayment)).
+		WithArgs(tip.Amount, tip.CreatorID, tip.UserID, tip.PayToken, tip.Currency).
+		WillReturnRows(sqlmock.NewRows([]string{"payments_id", "date"}).AddRow(5, time.Now()))
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryCreate)).
+		WithArgs(5, tip.PostID, tip.Message).
+		WillReturnError(models.BDError)
+	s.Mock.ExpectRollback()
+	err := s.repo.Create(tip)
+	assert.Equal(s.T(), repository.NewDBError(models.BDError), err)
+
+	s.Mock.ExpectBegin()
+	s.Mock.ExpectQuery(regexp.QuoteMeta(queryAddPayment)).
+		WithArgs(tip.Amount, tip.CreatorID, tip.UserID, tip.PayToken, tip.Currency).
+		WillReturnError(models.BDError)
+	s.Mock.ExpectRollback()
+	err = s.repo.Create(tip)
+	assert.Equal(s.T(), repository.NewDBError(models.BDError), err)
+}
+
+func TestTipsRepository(t *testing.T) {
+	suite.Run(t, new(SuiteTipsRepository))
+}
@@ -0,0 +1,12 @@
+package repository_tips
+
+import "patreon/internal/app/models"
+
+//go:generate mockgen -destination=mocks/mock_tips_repository.go -package=mock_repository -mock_names=Repository=TipsRepository . Repository
+
+type Repository interface {
+	// Create Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	Create(tip *models.Tip) error
+}
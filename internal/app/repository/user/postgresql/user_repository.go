@@ -17,10 +17,12 @@ const (
 
 	isAllowedAwardQuery = `WITH used_award AS (
 			 		SELECT count(*) as cnt FROM users 
-						JOIN subscribers AS sb ON sb.users_id = $2
+						JOIN subscribers AS sb ON sb.users_id = $2 AND sb.status = true
+							AND greatest(sb.paid_until, sb.grace_until) > now()
 						JOIN parents_awards AS pa ON pa.parent_id = sb.awards_id AND pa.awards_id = $1
 				UNION
-					SELECT count(*) as cnt FROM subscribers WHERE users_id = $2 AND awards_id = $1
+					SELECT count(*) as cnt FROM subscribers WHERE users_id = $2 AND awards_id = $1 AND status = true
+							AND greatest(paid_until, grace_until) > now()
 		 	)
 			SELECT sum(cnt) FROM used_award`
 
@@ -14,8 +14,14 @@ import (
 	"net/url"
 	"patreon/internal/app/delivery/http/handler_factory"
 	"patreon/internal/app/middleware"
+	"patreon/internal/app/models"
+	"patreon/internal/app/payment_provider"
+	fake_provider "patreon/internal/app/payment_provider/fake"
 	"patreon/internal/app/repository/repository_factory"
 	"patreon/internal/app/usecase/usecase_factory"
+	"patreon/internal/app/utilits/scheduler"
+	"patreon/pkg/utils"
+	"time"
 
 	"golang.org/x/crypto/acme/autocert"
 
@@ -69,7 +75,7 @@ func (s *Server) checkConnection() error {
 	return nil
 }
 
-//return http[0] and https[1] servers
+// return http[0] and https[1] servers
 func makingHTTPSServerWithRedirect(config *app.Config, router http.Handler) (*http.Server, *http.Server) {
 	serverHTTP := &http.Server{
 		Addr: config.BindHttpAddr,
@@ -144,7 +150,41 @@ func (s *Server) Start(config *app.Config) error {
 
 	repositoryFactory := repository_factory.NewRepositoryFactory(s.logger, s.connections)
 
-	usecaseFactory := usecase_factory.NewUsecaseFactory(repositoryFactory, s.connections.FilesGrpcConnection, s.config.PaymentsInfo)
+	if err = payment_provider.CheckConfig(&s.config.PaymentsInfo); err != nil {
+		return err
+	}
+	rates, err := models.NewExchangeRates(s.config.PaymentsInfo.ExchangeRates)
+	if err != nil {
+		return err
+	}
+
+	usecaseFactory := usecase_factory.NewUsecaseFactory(repositoryFactory, s.connections.FilesGrpcConnection,
+		s.config.PaymentsInfo, rates)
+	renewalScheduler := scheduler.NewRenewalScheduler(s.logger.WithField("service", "renewal_scheduler"),
+		usecaseFactory.GetBillingUsecase(), time.Duration(s.config.PaymentsInfo.RenewalCheckMinutes)*time.Minute)
+	defer renewalScheduler.Stop()
+	go renewalScheduler.Run()
+
+	sweeperMetrics := scheduler.NewSweeperMetrics("main")
+	if err = sweeperMetrics.SetupMonitoring(); err != nil {
+		return err
+	}
+	checkoutSweeper := scheduler.NewCheckoutSweeper(s.logger.WithField("service", "checkout_sweeper"),
+		usecaseFactory.GetPaymentsUsecase(), sweeperMetrics, utils.SystemClock{},
+		time.Duration(s.config.PaymentsInfo.CheckoutSweepMinutes)*time.Minute,
+		time.Duration(s.config.PaymentsInfo.CheckoutTTLMinutes)*time.Minute, s.config.PaymentsInfo.SweeperDryRun)
+	defer checkoutSweeper.Stop()
+	go checkoutSweeper.Run()
+
+	postPublisher := scheduler.NewPostPublisher(s.logger.WithField("service", "post_publisher"),
+		usecaseFactory.GetPostsUsecase(), time.Duration(s.config.PublishCheckMinutes)*time.Minute)
+	defer postPublisher.Stop()
+	go postPublisher.Run()
+
+	if fakeProvider, ok := usecaseFactory.GetPaymentProvider().(*fake_provider.FakeProvider); ok {
+		routerApi.PathPrefix("/payments/fake/").Handler(fakeProvider)
+	}
+
 	factory := handler_factory.NewFactory(s.logger, usecaseFactory, s.connections.SessionGrpcConnection)
 	hs := factory.GetHandleUrls()
 
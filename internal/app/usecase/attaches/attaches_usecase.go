@@ -7,6 +7,7 @@ import (
 	"patreon/internal/app"
 	"patreon/internal/app/models"
 	repoAttaches "patreon/internal/app/repository/attaches"
+	repoRevisions "patreon/internal/app/repository/post_revisions"
 	"patreon/internal/microservices/files/delivery/grpc/client"
 	repoFiles "patreon/internal/microservices/files/files/repository/files"
 
@@ -16,12 +17,13 @@ import (
 
 type AttachesUsecase struct {
 	repository      repoAttaches.Repository
+	repoRevisions   repoRevisions.Repository
 	filesRepository client.FileServiceClient
 	imageConvector  utils.ImageConverter
 }
 
-func NewAttachesUsecase(repository repoAttaches.Repository, fileClient client.FileServiceClient,
-	convector ...utils.ImageConverter) *AttachesUsecase {
+func NewAttachesUsecase(repository repoAttaches.Repository, repositoryRevisions repoRevisions.Repository,
+	fileClient client.FileServiceClient, convector ...utils.ImageConverter) *AttachesUsecase {
 	conv := utils.ImageConverter(&utils.ConverterToWebp{})
 	if len(convector) != 0 {
 		conv = convector[0]
@@ -29,6 +31,7 @@ func NewAttachesUsecase(repository repoAttaches.Repository, fileClient client.Fi
 
 	return &AttachesUsecase{
 		repository:      repository,
+		repoRevisions:   repositoryRevisions,
 		imageConvector:  conv,
 		filesRepository: fileClient,
 	}
@@ -36,8 +39,8 @@ func NewAttachesUsecase(repository repoAttaches.Repository, fileClient client.Fi
 
 // GetAttach Errors:
 //		repository.NotFound
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (usecase *AttachesUsecase) GetAttach(attachId int64) (*models.AttachWithoutLevel, error) {
 	return usecase.repository.Get(attachId)
 }
@@ -54,26 +57,34 @@ func (usecase *AttachesUsecase) processingValidateErrorAttach(err error) error {
 }
 
 // UpdateAttach Errors:
-//		repository.NotFound
-//		models.IncorrectType
-//  	models.IncorrectAttachId
-//      models.IncorrectLevel
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//				PollNotEditable
+//				repository.NotFound
+//				models.IncorrectType
+//				models.IncorrectPoll
+//		 	models.IncorrectAttachId
+//		     models.IncorrectLevel
+//				app.GeneralError with Errors:
+//					repository.DefaultErrDB
 func (usecase *AttachesUsecase) checkAttach(newAttach []models.Attach, updatedAttach []models.Attach) error {
 	var err error
-	for _, att := range newAttach {
+	for i, att := range newAttach {
 		if err = att.Validate(); err != nil {
 			err = usecase.processingValidateErrorAttach(err)
 			break
 		}
+		if att.Type == models.Poll {
+			var poll *models.PollAttach
+			if poll, err = models.ParsePoll(att.Value); err != nil {
+				break
+			}
+			newAttach[i].Value = poll.Value()
+		}
 	}
 
 	if err != nil {
 		return err
 	}
 
-	var checkIds []int64
 	for _, att := range updatedAttach {
 		if err = att.Validate(); err != nil {
 			err = usecase.processingValidateErrorAttach(err)
@@ -83,31 +94,67 @@ func (usecase *AttachesUsecase) checkAttach(newAttach []models.Attach, updatedAt
 			err = models.IncorrectAttachId
 			break
 		}
-		checkIds = append(checkIds, att.Id)
 	}
 
 	if err != nil {
 		return err
 	}
 
-	_, err = usecase.repository.ExistsAttach(checkIds...)
-	return err
+	for _, att := range updatedAttach {
+		if err = usecase.checkPollNotChanged(att.Id, att.Type, att.Value); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// UpdateAttach Errors:
+// checkPollNotChanged votes are bound to options of poll, so poll can not change its type or value
+// and other attach can not become poll. Empty value means that value of attach is not changed
+// Errors:
+//		PollNotEditable
 //		repository.NotFound
-//		repository_postgresql.UnknownDataFormat
-//		models.IncorrectType
-//  	models.IncorrectAttachId
-//      models.IncorrectLevel
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *AttachesUsecase) checkPollNotChanged(attachId int64, dataType models.DataType, value string) error {
+	current, err := usecase.repository.Get(attachId)
+	if err != nil {
+		return err
+	}
+	if current.Type != models.Poll && dataType != models.Poll {
+		return nil
+	}
+	if current.Type != dataType {
+		return PollNotEditable
+	}
+	if value == "" {
+		return nil
+	}
+	if poll, err := models.ParsePoll(value); err != nil || poll.Value() != current.Value {
+		return PollNotEditable
+	}
+	return nil
+}
+
+// UpdateAttach Errors:
+//				PollNotEditable
+//				repository.NotFound
+//				repository_postgresql.UnknownDataFormat
+//				models.IncorrectType
+//				models.IncorrectPoll
+//		 	models.IncorrectAttachId
+//		     models.IncorrectLevel
+//				app.GeneralError with Errors:
+//					repository.DefaultErrDB
 func (usecase *AttachesUsecase) UpdateAttach(postId int64,
 	newAttaches []models.Attach, updatedAttaches []models.Attach) ([]int64, error) {
 	if err := usecase.checkAttach(newAttaches, updatedAttaches); err != nil {
 		return nil, err
 	}
 
+	if err := usecase.repoRevisions.Save(postId); err != nil {
+		return nil, err
+	}
+
 	res, err := usecase.repository.ApplyChangeAttaches(postId, newAttaches, updatedAttaches)
 	if err != nil {
 		return nil, errors.Wrap(err, fmt.Sprintf("err with add attaches %d", postId))
@@ -116,24 +163,37 @@ func (usecase *AttachesUsecase) UpdateAttach(postId int64,
 	return res, nil
 }
 
+// updateAttach save current content of post as revision before update of its attach
+// Errors:
+//		repository_postgresql.UnknownDataFormat
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *AttachesUsecase) updateAttach(attach *models.AttachWithoutLevel) error {
+	if err := usecase.repoRevisions.SaveByAttach(attach.ID); err != nil {
+		return err
+	}
+	return usecase.repository.Update(attach)
+}
+
 // Delete Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (usecase *AttachesUsecase) Delete(postId int64) error {
 	return usecase.repository.Delete(postId)
 }
 
 // LoadImage Errors:
-//		models.InvalidPostId
-//		models.InvalidType
-//		repository_postgresql.UnknownDataFormat
-//		app.GeneralError with Errors:
-//			app.UnknownError
-//			repository.DefaultErrDB
-//			repository_os.ErrorCreate
-//   		repository_os.ErrorCopyFile
-//			utils.ConvertErr
-//  		utils.UnknownExtOfFileName
+//				models.InvalidPostId
+//				models.InvalidType
+//				repository_postgresql.UnknownDataFormat
+//				app.GeneralError with Errors:
+//					app.UnknownError
+//					repository.DefaultErrDB
+//					repository_os.ErrorCreate
+//		  		repository_os.ErrorCopyFile
+//					utils.ConvertErr
+//		 		utils.UnknownExtOfFileName
 func (usecase *AttachesUsecase) LoadImage(data io.Reader, name repoFiles.FileName, postId int64) (int64, error) {
 	var err error
 	data, name, err = usecase.imageConvector.Convert(context.Background(), data, name)
@@ -159,14 +219,14 @@ func (usecase *AttachesUsecase) LoadImage(data io.Reader, name repoFiles.FileNam
 }
 
 // LoadVideo Errors:
-//		models.InvalidPostId
-//		models.InvalidType
-//		repository_postgresql.UnknownDataFormat
-//		app.GeneralError with Errors:
-//			app.UnknownError
-//			repository.DefaultErrDB
-//			repository_os.ErrorCreate
-//   		repository_os.ErrorCopyFile
+//				models.InvalidPostId
+//				models.InvalidType
+//				repository_postgresql.UnknownDataFormat
+//				app.GeneralError with Errors:
+//					app.UnknownError
+//					repository.DefaultErrDB
+//					repository_os.ErrorCreate
+//		  		repository_os.ErrorCopyFile
 func (usecase *AttachesUsecase) LoadVideo(data io.Reader, name repoFiles.FileName, postId int64) (int64, error) {
 	path, err := usecase.filesRepository.SaveFile(context.Background(), data, name, repoFiles.Video)
 	if err != nil {
@@ -187,14 +247,14 @@ func (usecase *AttachesUsecase) LoadVideo(data io.Reader, name repoFiles.FileNam
 }
 
 // LoadAudio Errors:
-//		models.InvalidPostId
-//		models.InvalidType
-//		repository_postgresql.UnknownDataFormat
-//		app.GeneralError with Errors:
-//			app.UnknownError
-//			repository.DefaultErrDB
-//			repository_os.ErrorCreate
-//   		repository_os.ErrorCopyFile
+//				models.InvalidPostId
+//				models.InvalidType
+//				repository_postgresql.UnknownDataFormat
+//				app.GeneralError with Errors:
+//					app.UnknownError
+//					repository.DefaultErrDB
+//					repository_os.ErrorCreate
+//		  		repository_os.ErrorCopyFile
 func (usecase *AttachesUsecase) LoadAudio(data io.Reader, name repoFiles.FileName, postId int64) (int64, error) {
 	path, err := usecase.filesRepository.SaveFile(context.Background(), data, name, repoFiles.Music)
 	if err != nil {
@@ -236,20 +296,52 @@ func (usecase *AttachesUsecase) LoadText(postData *models.AttachWithoutLevel) (i
 	return usecase.repository.Create(postData)
 }
 
-// UpdateImage Errors:
+// LoadPoll value of poll attach is saved in normalized form, poll can not be changed after creation
+// because votes refer to numbers of its options
+// Errors:
 //		models.InvalidPostId
 //		models.InvalidType
+//		models.IncorrectPoll
 //		repository_postgresql.UnknownDataFormat
-//		repository.NotFound
 //		app.GeneralError with Errors:
 //			app.UnknownError
 //			repository.DefaultErrDB
-//			repository_os.ErrorCreate
-//   		repository_os.ErrorCopyFile
-//			utils.ConvertErr
-//  		utils.UnknownExtOfFileName
+func (usecase *AttachesUsecase) LoadPoll(postData *models.AttachWithoutLevel) (int64, error) {
+	postData.Type = models.Poll
+	if err := postData.Validate(); err != nil {
+		if errors.Is(err, models.InvalidType) || errors.Is(err, models.InvalidPostId) {
+			return app.InvalidInt, err
+		}
+		return app.InvalidInt, &app.GeneralError{
+			Err:         app.UnknownError,
+			ExternalErr: errors.Wrap(err, "failed process of validation creator"),
+		}
+	}
+
+	poll, err := models.ParsePoll(postData.Value)
+	if err != nil {
+		return app.InvalidInt, err
+	}
+	postData.Value = poll.Value()
+
+	return usecase.repository.Create(postData)
+}
+
+// UpdateImage Errors:
+//				PollNotEditable
+//				models.InvalidPostId
+//				models.InvalidType
+//				repository_postgresql.UnknownDataFormat
+//				repository.NotFound
+//				app.GeneralError with Errors:
+//					app.UnknownError
+//					repository.DefaultErrDB
+//					repository_os.ErrorCreate
+//		  		repository_os.ErrorCopyFile
+//					utils.ConvertErr
+//		 		utils.UnknownExtOfFileName
 func (usecase *AttachesUsecase) UpdateImage(data io.Reader, name repoFiles.FileName, postDataId int64) error {
-	if _, err := usecase.repository.ExistsAttach(postDataId); err != nil {
+	if err := usecase.checkPollNotChanged(postDataId, models.Image, ""); err != nil {
 		return err
 	}
 	var err error
@@ -273,21 +365,22 @@ func (usecase *AttachesUsecase) UpdateImage(data io.Reader, name repoFiles.FileN
 			ExternalErr: errors.Wrap(err, "failed process of validation creator"),
 		}
 	}
-	return usecase.repository.Update(post)
+	return usecase.updateAttach(post)
 }
 
 // UpdateAudio Errors:
-//		models.InvalidPostId
-//		models.InvalidType
-//		repository_postgresql.UnknownDataFormat
-//		repository.NotFound
-//		app.GeneralError with Errors:
-//			app.UnknownError
-//			repository.DefaultErrDB
-//			repository_os.ErrorCreate
-//   		repository_os.ErrorCopyFile
+//				PollNotEditable
+//				models.InvalidPostId
+//				models.InvalidType
+//				repository_postgresql.UnknownDataFormat
+//				repository.NotFound
+//				app.GeneralError with Errors:
+//					app.UnknownError
+//					repository.DefaultErrDB
+//					repository_os.ErrorCreate
+//		  		repository_os.ErrorCopyFile
 func (usecase *AttachesUsecase) UpdateAudio(data io.Reader, name repoFiles.FileName, postDataId int64) error {
-	if _, err := usecase.repository.ExistsAttach(postDataId); err != nil {
+	if err := usecase.checkPollNotChanged(postDataId, models.Music, ""); err != nil {
 		return err
 	}
 
@@ -306,21 +399,22 @@ func (usecase *AttachesUsecase) UpdateAudio(data io.Reader, name repoFiles.FileN
 			ExternalErr: errors.Wrap(err, "failed process of validation creator"),
 		}
 	}
-	return usecase.repository.Update(post)
+	return usecase.updateAttach(post)
 }
 
 // UpdateVideo Errors:
-//		models.InvalidPostId
-//		models.InvalidType
-//		repository_postgresql.UnknownDataFormat
-//		repository.NotFound
-//		app.GeneralError with Errors:
-//			app.UnknownError
-//			repository.DefaultErrDB
-//			repository_os.ErrorCreate
-//   		repository_os.ErrorCopyFile
+//				PollNotEditable
+//				models.InvalidPostId
+//				models.InvalidType
+//				repository_postgresql.UnknownDataFormat
+//				repository.NotFound
+//				app.GeneralError with Errors:
+//					app.UnknownError
+//					repository.DefaultErrDB
+//					repository_os.ErrorCreate
+//		  		repository_os.ErrorCopyFile
 func (usecase *AttachesUsecase) UpdateVideo(data io.Reader, name repoFiles.FileName, postDataId int64) error {
-	if _, err := usecase.repository.ExistsAttach(postDataId); err != nil {
+	if err := usecase.checkPollNotChanged(postDataId, models.Video, ""); err != nil {
 		return err
 	}
 
@@ -339,10 +433,11 @@ func (usecase *AttachesUsecase) UpdateVideo(data io.Reader, name repoFiles.FileN
 			ExternalErr: errors.Wrap(err, "failed process of validation creator"),
 		}
 	}
-	return usecase.repository.Update(post)
+	return usecase.updateAttach(post)
 }
 
 // UpdateText Errors:
+//		PollNotEditable
 //		models.InvalidPostId
 //		models.InvalidType
 //		repository.NotFound
@@ -361,6 +456,9 @@ func (usecase *AttachesUsecase) UpdateText(postData *models.AttachWithoutLevel)
 			ExternalErr: errors.Wrap(err, "failed process of validation creator"),
 		}
 	}
+	if err := usecase.checkPollNotChanged(postData.ID, postData.Type, postData.Value); err != nil {
+		return err
+	}
 
-	return usecase.repository.Update(postData)
+	return usecase.updateAttach(postData)
 }
@@ -22,7 +22,8 @@ type SuiteAttachesUsecase struct {
 
 func (s *SuiteAttachesUsecase) SetupSuite() {
 	s.SuiteUsecase.SetupSuite()
-	s.uc = NewAttachesUsecase(s.MockAttachesRepository, s.MockFileClient, s.MockConvector)
+	s.uc = NewAttachesUsecase(s.MockAttachesRepository, s.MockRevisionsRepository, s.MockFileClient,
+		s.MockConvector)
 }
 
 func (s *SuiteAttachesUsecase) TestCreatorUsecase_GetAttach() {
@@ -229,13 +230,17 @@ func (s *SuiteAttachesUsecase) TestCreatorUsecase_UpdateAudio() {
 	att.PostId = 0
 
 	s.MockAttachesRepository.EXPECT().
-		ExistsAttach(att.ID).
+		Get(att.ID).
 		Times(1).
-		Return(false, nil)
+		Return(&models.AttachWithoutLevel{ID: att.ID, Type: att.Type}, nil)
 	s.MockFileClient.EXPECT().
 		SaveFile(gomock.Any(), reader, fileName, repoFiles.Music).
 		Times(1).
 		Return(string(fileName), nil)
+	s.MockRevisionsRepository.EXPECT().
+		SaveByAttach(att.ID).
+		Times(1).
+		Return(nil)
 	s.MockAttachesRepository.EXPECT().
 		Update(att).
 		Times(1).
@@ -244,9 +249,9 @@ func (s *SuiteAttachesUsecase) TestCreatorUsecase_UpdateAudio() {
 	assert.NoError(s.T(), err)
 
 	s.MockAttachesRepository.EXPECT().
-		ExistsAttach(att.ID).
+		Get(att.ID).
 		Times(1).
-		Return(false, nil)
+		Return(&models.AttachWithoutLevel{ID: att.ID, Type: att.Type}, nil)
 	s.MockFileClient.EXPECT().
 		SaveFile(gomock.Any(), reader, fileName, repoFiles.Music).
 		Times(1).
@@ -255,13 +260,17 @@ func (s *SuiteAttachesUsecase) TestCreatorUsecase_UpdateAudio() {
 	assert.EqualError(s.T(), err, repository.DefaultErrDB.Error())
 
 	s.MockAttachesRepository.EXPECT().
-		ExistsAttach(att.ID).
+		Get(att.ID).
 		Times(1).
-		Return(false, nil)
+		Return(&models.AttachWithoutLevel{ID: att.ID, Type: att.Type}, nil)
 	s.MockFileClient.EXPECT().
 		SaveFile(gomock.Any(), reader, fileName, repoFiles.Music).
 		Times(1).
 		Return(string(fileName), nil)
+	s.MockRevisionsRepository.EXPECT().
+		SaveByAttach(att.ID).
+		Times(1).
+		Return(nil)
 	s.MockAttachesRepository.EXPECT().
 		Update(att).
 		Times(1).
@@ -270,9 +279,9 @@ func (s *SuiteAttachesUsecase) TestCreatorUsecase_UpdateAudio() {
 	assert.EqualError(s.T(), err, repository.DefaultErrDB.Error())
 
 	s.MockAttachesRepository.EXPECT().
-		ExistsAttach(att.ID).
+		Get(att.ID).
 		Times(1).
-		Return(false, repository.DefaultErrDB)
+		Return(nil, repository.DefaultErrDB)
 	err = s.uc.UpdateAudio(reader, fileName, att.ID)
 	assert.Error(s.T(), err)
 }
@@ -288,13 +297,17 @@ func (s *SuiteAttachesUsecase) TestCreatorUsecase_UpdateVideo() {
 	att.PostId = 0
 
 	s.MockAttachesRepository.EXPECT().
-		ExistsAttach(att.ID).
+		Get(att.ID).
 		Times(1).
-		Return(false, nil)
+		Return(&models.AttachWithoutLevel{ID: att.ID, Type: att.Type}, nil)
 	s.MockFileClient.EXPECT().
 		SaveFile(gomock.Any(), reader, fileName, repoFiles.Video).
 		Times(1).
 		Return(string(fileName), nil)
+	s.MockRevisionsRepository.EXPECT().
+		SaveByAttach(att.ID).
+		Times(1).
+		Return(nil)
 	s.MockAttachesRepository.EXPECT().
 		Update(att).
 		Times(1).
@@ -303,9 +316,9 @@ func (s *SuiteAttachesUsecase) TestCreatorUsecase_UpdateVideo() {
 	assert.NoError(s.T(), err)
 
 	s.MockAttachesRepository.EXPECT().
-		ExistsAttach(att.ID).
+		Get(att.ID).
 		Times(1).
-		Return(false, nil)
+		Return(&models.AttachWithoutLevel{ID: att.ID, Type: att.Type}, nil)
 	s.MockFileClient.EXPECT().
 		SaveFile(gomock.Any(), reader, fileName, repoFiles.Video).
 		Times(1).
@@ -314,13 +327,17 @@ func (s *SuiteAttachesUsecase) TestCreatorUsecase_UpdateVideo() {
 	assert.EqualError(s.T(), err, repository.DefaultErrDB.Error())
 
 	s.MockAttachesRepository.EXPECT().
-		ExistsAttach(att.ID).
+		Get(att.ID).
 		Times(1).
-		Return(false, nil)
+		Return(&models.AttachWithoutLevel{ID: att.ID, Type: att.Type}, nil)
 	s.MockFileClient.EXPECT().
 		SaveFile(gomock.Any(), reader, fileName, repoFiles.Video).
 		Times(1).
 		Return(string(fileName), nil)
+	s.MockRevisionsRepository.EXPECT().
+		SaveByAttach(att.ID).
+		Times(1).
+		Return(nil)
 	s.MockAttachesRepository.EXPECT().
 		Update(att).
 		Times(1).
@@ -329,9 +346,9 @@ func (s *SuiteAttachesUsecase) TestCreatorUsecase_UpdateVideo() {
 	assert.EqualError(s.T(), err, repository.DefaultErrDB.Error())
 
 	s.MockAttachesRepository.EXPECT().
-		ExistsAttach(att.ID).
+		Get(att.ID).
 		Times(1).
-		Return(false, repository.DefaultErrDB)
+		Return(nil, repository.DefaultErrDB)
 	err = s.uc.UpdateVideo(reader, fileName, att.ID)
 	assert.Error(s.T(), err)
 }
@@ -347,9 +364,9 @@ func (s *SuiteAttachesUsecase) TestCreatorUsecase_UpdateImage() {
 	att.PostId = 0
 
 	s.MockAttachesRepository.EXPECT().
-		ExistsAttach(att.ID).
+		Get(att.ID).
 		Times(1).
-		Return(false, nil)
+		Return(&models.AttachWithoutLevel{ID: att.ID, Type: att.Type}, nil)
 	s.MockConvector.EXPECT().
 		Convert(gomock.Any(), reader, fileName).
 		Times(1).
@@ -358,6 +375,10 @@ func (s *SuiteAttachesUsecase) TestCreatorUsecase_UpdateImage() {
 		SaveFile(gomock.Any(), reader, fileName, repoFiles.Image).
 		Times(1).
 		Return(string(fileName), nil)
+	s.MockRevisionsRepository.EXPECT().
+		SaveByAttach(att.ID).
+		Times(1).
+		Return(nil)
 	s.MockAttachesRepository.EXPECT().
 		Update(att).
 		Times(1).
@@ -366,9 +387,9 @@ func (s *SuiteAttachesUsecase) TestCreatorUsecase_UpdateImage() {
 	assert.NoError(s.T(), err)
 
 	s.MockAttachesRepository.EXPECT().
-		ExistsAttach(att.ID).
+		Get(att.ID).
 		Times(1).
-		Return(false, nil)
+		Return(&models.AttachWithoutLevel{ID: att.ID, Type: att.Type}, nil)
 	s.MockConvector.EXPECT().
 		Convert(gomock.Any(), reader, fileName).
 		Times(1).
@@ -381,9 +402,9 @@ func (s *SuiteAttachesUsecase) TestCreatorUsecase_UpdateImage() {
 	assert.EqualError(s.T(), err, repository.DefaultErrDB.Error())
 
 	s.MockAttachesRepository.EXPECT().
-		ExistsAttach(att.ID).
+		Get(att.ID).
 		Times(1).
-		Return(false, nil)
+		Return(&models.AttachWithoutLevel{ID: att.ID, Type: att.Type}, nil)
 	s.MockConvector.EXPECT().
 		Convert(gomock.Any(), reader, fileName).
 		Times(1).
@@ -392,6 +413,10 @@ func (s *SuiteAttachesUsecase) TestCreatorUsecase_UpdateImage() {
 		SaveFile(gomock.Any(), reader, fileName, repoFiles.Image).
 		Times(1).
 		Return(string(fileName), nil)
+	s.MockRevisionsRepository.EXPECT().
+		SaveByAttach(att.ID).
+		Times(1).
+		Return(nil)
 	s.MockAttachesRepository.EXPECT().
 		Update(att).
 		Times(1).
@@ -400,16 +425,16 @@ func (s *SuiteAttachesUsecase) TestCreatorUsecase_UpdateImage() {
 	assert.EqualError(s.T(), err, repository.DefaultErrDB.Error())
 
 	s.MockAttachesRepository.EXPECT().
-		ExistsAttach(att.ID).
+		Get(att.ID).
 		Times(1).
-		Return(false, repository.DefaultErrDB)
+		Return(nil, repository.DefaultErrDB)
 	err = s.uc.UpdateImage(reader, fileName, att.ID)
 	assert.Error(s.T(), err)
 
 	s.MockAttachesRepository.EXPECT().
-		ExistsAttach(att.ID).
+		Get(att.ID).
 		Times(1).
-		Return(false, nil)
+		Return(&models.AttachWithoutLevel{ID: att.ID, Type: att.Type}, nil)
 	s.MockConvector.EXPECT().
 		Convert(gomock.Any(), reader, fileName).
 		Times(1).
@@ -444,35 +469,52 @@ func (s *SuiteAttachesUsecase) TestCreatorUsecase_LoadText() {
 	assert.Error(s.T(), err)
 }
 
-func (s *SuiteAttachesUsecase) MockcheckAttach(updId int64) {
+func (s *SuiteAttachesUsecase) TestCreatorUsecase_LoadPoll() {
+	att := models.TestAttachWithoutLevel()
+	att.ID = 0
+	att.Value = `{"question": " Next episode? ", "options": ["cats", "dogs"]}`
+	resId := int64(1)
+
+	expected := *att
+	expected.Type = models.Poll
+	expected.Value = `{"question":"Next episode?","options":["cats","dogs"],"multiple":false,` +
+		`"results_before_vote":false}`
+	s.MockAttachesRepository.EXPECT().
+		Create(&expected).
+		Times(1).
+		Return(resId, nil)
+	id, err := s.uc.LoadPoll(att)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), resId, id)
+
+	att.Value = `{"question": "Next episode?", "options": ["cats"]}`
+	_, err = s.uc.LoadPoll(att)
+	assert.Equal(s.T(), models.IncorrectPoll, err)
+}
+
+func (s *SuiteAttachesUsecase) MockcheckAttach(updId int64, dataType models.DataType) {
 	s.MockAttachesRepository.EXPECT().
-		ExistsAttach(updId).
+		Get(updId).
 		Times(1).
-		Return(false, nil)
+		Return(&models.AttachWithoutLevel{ID: updId, Type: dataType}, nil)
 }
 
 func (s *SuiteAttachesUsecase) MockcheckAttachError(updId int64, err error) {
 	s.MockAttachesRepository.EXPECT().
-		ExistsAttach(updId).
+		Get(updId).
 		Times(1).
-		Return(false, err)
+		Return(nil, err)
 }
 
 func (s *SuiteAttachesUsecase) TestCreatorUsecase_checkAttach() {
 	newAtt := []models.Attach{*models.TestAttach()}
 	updAtt := []models.Attach{*models.TestAttach()}
 
-	s.MockAttachesRepository.EXPECT().
-		ExistsAttach(updAtt[0].Id).
-		Times(1).
-		Return(false, nil)
+	s.MockcheckAttach(updAtt[0].Id, updAtt[0].Type)
 	err := s.uc.checkAttach(newAtt, updAtt)
 	assert.NoError(s.T(), err)
 
-	s.MockAttachesRepository.EXPECT().
-		ExistsAttach(updAtt[0].Id).
-		Times(1).
-		Return(false, nil)
+	s.MockcheckAttach(updAtt[0].Id, updAtt[0].Type)
 	err = s.uc.checkAttach(newAtt, updAtt)
 	assert.NoError(s.T(), err)
 
@@ -496,7 +538,11 @@ func (s *SuiteAttachesUsecase) TestCreatorUsecase_UpdateAttaches() {
 	postId := int64(3)
 	res := []int64{1, 2}
 
-	s.MockcheckAttach(updAtt[0].Id)
+	s.MockcheckAttach(updAtt[0].Id, updAtt[0].Type)
+	s.MockRevisionsRepository.EXPECT().
+		Save(postId).
+		Times(1).
+		Return(nil)
 	s.MockAttachesRepository.EXPECT().
 		ApplyChangeAttaches(postId, newAtt, updAtt).
 		Times(1).
@@ -509,19 +555,63 @@ func (s *SuiteAttachesUsecase) TestCreatorUsecase_UpdateAttaches() {
 	_, err = s.uc.UpdateAttach(postId, newAtt, updAtt)
 	assert.ErrorIs(s.T(), err, repository.DefaultErrDB)
 
-	s.MockcheckAttach(updAtt[0].Id)
+	s.MockcheckAttach(updAtt[0].Id, updAtt[0].Type)
+	s.MockRevisionsRepository.EXPECT().
+		Save(postId).
+		Times(1).
+		Return(nil)
 	s.MockAttachesRepository.EXPECT().
 		ApplyChangeAttaches(postId, newAtt, updAtt).
 		Times(1).
 		Return(res, repository.DefaultErrDB)
 	_, err = s.uc.UpdateAttach(postId, newAtt, updAtt)
 	assert.ErrorIs(s.T(), err, repository.DefaultErrDB)
+
+	s.MockcheckAttach(updAtt[0].Id, updAtt[0].Type)
+	s.MockRevisionsRepository.EXPECT().
+		Save(postId).
+		Times(1).
+		Return(repository.DefaultErrDB)
+	_, err = s.uc.UpdateAttach(postId, newAtt, updAtt)
+	assert.ErrorIs(s.T(), err, repository.DefaultErrDB)
+}
+
+func (s *SuiteAttachesUsecase) TestCreatorUsecase_UpdateAttachesPoll() {
+	newAtt := []models.Attach{{Type: models.Poll, Level: 1,
+		Value: `{"question": "Next episode?", "options": [" cats", "dogs "], "multiple": true}`}}
+	updAtt := []models.Attach{{Id: 2, Type: models.Poll, Level: 2}}
+	postId := int64(3)
+	res := []int64{1}
+
+	expectedNew := []models.Attach{{Type: models.Poll, Level: 1,
+		Value: `{"question":"Next episode?","options":["cats","dogs"],"multiple":true,"results_before_vote":false}`}}
+	s.MockcheckAttach(updAtt[0].Id, updAtt[0].Type)
+	s.MockRevisionsRepository.EXPECT().
+		Save(postId).
+		Times(1).
+		Return(nil)
+	s.MockAttachesRepository.EXPECT().
+		ApplyChangeAttaches(postId, expectedNew, updAtt).
+		Times(1).
+		Return(res, nil)
+	got, err := s.uc.UpdateAttach(postId, newAtt, updAtt)
+	assert.Equal(s.T(), res, got)
+	assert.NoError(s.T(), err)
+
+	newAtt = []models.Attach{{Type: models.Poll, Level: 1, Value: `{"question": "Next episode?"}`}}
+	_, err = s.uc.UpdateAttach(postId, newAtt, updAtt)
+	assert.Equal(s.T(), models.IncorrectPoll, err)
 }
 
 func (s *SuiteAttachesUsecase) TestCreatorUsecase_UpdateText() {
 	att := models.TestAttachWithoutLevel()
 	att.Type = models.Music
 
+	s.MockcheckAttach(att.ID, models.Text)
+	s.MockRevisionsRepository.EXPECT().
+		SaveByAttach(att.ID).
+		Times(1).
+		Return(nil)
 	s.MockAttachesRepository.EXPECT().
 		Update(att).
 		Times(1).
@@ -529,6 +619,11 @@ func (s *SuiteAttachesUsecase) TestCreatorUsecase_UpdateText() {
 	err := s.uc.UpdateText(att)
 	assert.NoError(s.T(), err)
 
+	s.MockcheckAttach(att.ID, models.Text)
+	s.MockRevisionsRepository.EXPECT().
+		SaveByAttach(att.ID).
+		Times(1).
+		Return(nil)
 	s.MockAttachesRepository.EXPECT().
 		Update(att).
 		Times(1).
@@ -536,11 +631,43 @@ func (s *SuiteAttachesUsecase) TestCreatorUsecase_UpdateText() {
 	err = s.uc.UpdateText(att)
 	assert.EqualError(s.T(), err, repository.DefaultErrDB.Error())
 
+	s.MockcheckAttach(att.ID, models.Poll)
+	err = s.uc.UpdateText(att)
+	assert.Equal(s.T(), PollNotEditable, err)
+
 	att.PostId = -1
 	err = s.uc.UpdateText(att)
 	assert.Error(s.T(), err)
 }
 
+func (s *SuiteAttachesUsecase) TestCreatorUsecase_checkPollNotChanged() {
+	poll := &models.AttachWithoutLevel{ID: 2, Type: models.Poll,
+		Value: `{"question":"Next episode?","options":["cats","dogs"],"multiple":false,"results_before_vote":false}`}
+	s.MockAttachesRepository.EXPECT().
+		Get(poll.ID).
+		Times(4).
+		Return(poll, nil)
+	err := s.uc.checkPollNotChanged(poll.ID, models.Poll, "")
+	assert.NoError(s.T(), err)
+
+	err = s.uc.checkPollNotChanged(poll.ID, models.Poll, `{"question": "Next episode?", "options": ["cats", " dogs"]}`)
+	assert.NoError(s.T(), err)
+
+	err = s.uc.checkPollNotChanged(poll.ID, models.Poll, `{"question": "Next episode?", "options": ["cats", "birds"]}`)
+	assert.Equal(s.T(), PollNotEditable, err)
+
+	err = s.uc.checkPollNotChanged(poll.ID, models.Text, "text")
+	assert.Equal(s.T(), PollNotEditable, err)
+
+	s.MockcheckAttach(3, models.Text)
+	err = s.uc.checkPollNotChanged(3, models.Poll, poll.Value)
+	assert.Equal(s.T(), PollNotEditable, err)
+
+	s.MockcheckAttachError(3, repository.NotFound)
+	err = s.uc.checkPollNotChanged(3, models.Text, "text")
+	assert.Equal(s.T(), repository.NotFound, err)
+}
+
 func TestUsecaseCreator(t *testing.T) {
 	suite.Run(t, new(SuiteAttachesUsecase))
 }
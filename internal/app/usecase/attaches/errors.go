@@ -1 +1,7 @@
 package attaches
+
+import "github.com/pkg/errors"
+
+var (
+	PollNotEditable = errors.New("poll attach can not be changed")
+)
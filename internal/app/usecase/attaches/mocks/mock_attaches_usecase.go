@@ -95,6 +95,21 @@ func (mr *AttachesUsecaseMockRecorder) LoadImage(arg0, arg1, arg2 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadImage", reflect.TypeOf((*AttachesUsecase)(nil).LoadImage), arg0, arg1, arg2)
 }
 
+// LoadPoll mocks base method.
+func (m *AttachesUsecase) LoadPoll(arg0 *models.AttachWithoutLevel) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadPoll", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadPoll indicates an expected call of LoadPoll.
+func (mr *AttachesUsecaseMockRecorder) LoadPoll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadPoll", reflect.TypeOf((*AttachesUsecase)(nil).LoadPoll), arg0)
+}
+
 // LoadText mocks base method.
 func (m *AttachesUsecase) LoadText(arg0 *models.AttachWithoutLevel) (int64, error) {
 	m.ctrl.T.Helper()
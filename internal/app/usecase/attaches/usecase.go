@@ -22,9 +22,11 @@ type Usecase interface {
 	GetAttach(attachId int64) (*models.AttachWithoutLevel, error)
 
 	// UpdateAttach Errors:
+	//		PollNotEditable
 	//		repository.NotFound
 	//		repository_postgresql.UnknownDataFormat
 	//		models.IncorrectType
+	//		models.IncorrectPoll
 	//  	models.IncorrectAttachId
 	//      models.IncorrectLevel
 	// 		app.GeneralError with Errors:
@@ -78,7 +80,18 @@ type Usecase interface {
 	//			repository.DefaultErrDB
 	LoadText(postData *models.AttachWithoutLevel) (int64, error)
 
+	// LoadPoll Errors:
+	//		models.InvalidPostId
+	//		models.InvalidType
+	//		models.IncorrectPoll
+	//		repository_postgresql.UnknownDataFormat
+	//		app.GeneralError with Errors:
+	//			app.UnknownError
+	//			repository.DefaultErrDB
+	LoadPoll(postData *models.AttachWithoutLevel) (int64, error)
+
 	// UpdateText Errors:
+	//		PollNotEditable
 	//		models.InvalidPostId
 	//		models.InvalidType
 	//		repository.NotFound
@@ -89,6 +102,7 @@ type Usecase interface {
 	UpdateText(postData *models.AttachWithoutLevel) error
 
 	// UpdateImage Errors:
+	//		PollNotEditable
 	//		models.InvalidPostId
 	//		models.InvalidType
 	//		repository.NotFound
@@ -101,6 +115,7 @@ type Usecase interface {
 	UpdateImage(data io.Reader, name repoFiles.FileName, AttachId int64) error
 
 	// UpdateAudio Errors:
+	//		PollNotEditable
 	//		models.InvalidPostId
 	//		models.InvalidType
 	//		repository.NotFound
@@ -113,6 +128,7 @@ type Usecase interface {
 	UpdateAudio(data io.Reader, name repoFiles.FileName, AttachId int64) error
 
 	// UpdateVideo Errors:
+	//		PollNotEditable
 	//		models.InvalidPostId
 	//		models.InvalidType
 	//		repository.NotFound
@@ -34,32 +34,35 @@ func NewAwardsUsecase(repository repoAwrds.Repository, fileClient client.FileSer
 }
 
 // GetAwards Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (usecase *AwardsUsecase) GetAwards(creatorId int64) ([]models.Award, error) {
 	return usecase.repository.GetAwards(creatorId)
 }
 
 // Delete Errors:
-// 		repository.NotFound
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (usecase *AwardsUsecase) Delete(id int64) error {
 	return usecase.repository.Delete(id)
 }
 
 // Update Errors:
-// 		repository.NotFound
+//		repository.NotFound
 //		repository_postgresql.NameAlreadyExist
 //		repository_postgresql.PriceAlreadyExist
 //		models.IncorrectAwardsPrice
+//		models.IncorrectTrialDays
+//		models.IncorrectMaxSubscribers
 //		models.EmptyName
 //		app.GeneralError with Errors:
 //			app.UnknownError
 //			repository.DefaultErrDB
 func (usecase *AwardsUsecase) Update(awards *models.Award) error {
 	if err := awards.Validate(); err != nil {
-		if errors.Is(err, models.EmptyName) || errors.Is(err, models.IncorrectAwardsPrice) {
+		if errors.Is(err, models.EmptyName) || errors.Is(err, models.IncorrectAwardsPrice) ||
+			errors.Is(err, models.IncorrectTrialDays) || errors.Is(err, models.IncorrectMaxSubscribers) {
 			return err
 		}
 		return &app.GeneralError{
@@ -75,13 +78,16 @@ func (usecase *AwardsUsecase) Update(awards *models.Award) error {
 //		repository_postgresql.NameAlreadyExist
 //		repository_postgresql.PriceAlreadyExist
 //		models.IncorrectAwardsPrice
+//		models.IncorrectTrialDays
+//		models.IncorrectMaxSubscribers
 //		models.EmptyName
 //		app.GeneralError with Errors:
 //			app.UnknownError
 //			repository.DefaultErrDB
 func (usecase *AwardsUsecase) Create(awards *models.Award) (int64, error) {
 	if err := awards.Validate(); err != nil {
-		if errors.Is(err, models.EmptyName) || errors.Is(err, models.IncorrectAwardsPrice) {
+		if errors.Is(err, models.EmptyName) || errors.Is(err, models.IncorrectAwardsPrice) ||
+			errors.Is(err, models.IncorrectTrialDays) || errors.Is(err, models.IncorrectMaxSubscribers) {
 			return app.InvalidInt, err
 		}
 		return app.InvalidInt, &app.GeneralError{
@@ -94,9 +100,9 @@ func (usecase *AwardsUsecase) Create(awards *models.Award) (int64, error) {
 }
 
 // GetCreatorId Errors:
-//  	repository.NotFound
-//  	app.GeneralError with Errors
-//   		repository.DefaultErrDB
+//			repository.NotFound
+//			app.GeneralError with Errors
+//		 		repository.DefaultErrDB
 func (usecase *AwardsUsecase) GetCreatorId(awardsId int64) (int64, error) {
 	aw, err := usecase.repository.GetByID(awardsId)
 	if err != nil {
@@ -106,13 +112,13 @@ func (usecase *AwardsUsecase) GetCreatorId(awardsId int64) (int64, error) {
 }
 
 // UpdateCover Errors:
-// 		repository.NotFound
-// 		app.GeneralError with Errors:
-//			repository_os.ErrorCreate
-//   		repository_os.ErrorCopyFile
-// 			repository.DefaultErrDB
-//			utils.ConvertErr
-//  		utils.UnknownExtOfFileName
+//				repository.NotFound
+//				app.GeneralError with Errors:
+//					repository_os.ErrorCreate
+//		  		repository_os.ErrorCopyFile
+//					repository.DefaultErrDB
+//					utils.ConvertErr
+//		 		utils.UnknownExtOfFileName
 func (usecase *AwardsUsecase) UpdateCover(data io.Reader, name repoFiles.FileName, awardsId int64) error {
 	_, err := usecase.repository.CheckAwards(awardsId)
 	if err != nil {
@@ -29,6 +29,8 @@ type Usecase interface {
 	// 		repository.NotFound
 	//		repository_postgresql.NameAlreadyExist
 	//		models.IncorrectAwardsPrice
+	//		models.IncorrectTrialDays
+	//		models.IncorrectMaxSubscribers
 	//		models.EmptyName
 	//		app.GeneralError with Errors:
 	//			app.UnknownError
@@ -38,6 +40,8 @@ type Usecase interface {
 	// Create Errors:
 	//		repository_postgresql.NameAlreadyExist
 	//		models.IncorrectAwardsPrice
+	//		models.IncorrectTrialDays
+	//		models.IncorrectMaxSubscribers
 	//		models.EmptyName
 	//		app.GeneralError with Errors:
 	//			app.UnknownError
@@ -0,0 +1,175 @@
+package usecase_billing
+
+import (
+	"patreon/internal/app/models"
+	repository_pay_token "patreon/internal/app/repository/pay_token"
+	repository_subscribers "patreon/internal/app/repository/subscribers"
+	repository_subscription_events "patreon/internal/app/repository/subscription_events"
+	push_client "patreon/internal/microservices/push/delivery/client"
+	"patreon/pkg/utils"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	DefaultRenewalNotice = 72 * time.Hour
+	DefaultGracePeriod   = 72 * time.Hour
+	minTokenExp          = 3 * time.Hour
+)
+
+type BillingUsecase struct {
+	repoSubscr    repository_subscribers.Repository
+	repoPayToken  repository_pay_token.Repository
+	repoEvents    repository_subscription_events.Repository
+	pusher        push_client.Pusher
+	clock         utils.Clock
+	renewalNotice time.Duration
+	gracePeriod   time.Duration
+}
+
+func NewBillingUsecase(repoSubscr repository_subscribers.Repository, repoPayToken repository_pay_token.Repository,
+	repoEvents repository_subscription_events.Repository, pusher push_client.Pusher, clock utils.Clock, renewalNotice time.Duration, gracePeriod time.Duration) *BillingUsecase {
+	if renewalNotice <= 0 {
+		renewalNotice = DefaultRenewalNotice
+	}
+	if gracePeriod <= 0 {
+		gracePeriod = DefaultGracePeriod
+	}
+	return &BillingUsecase{
+		repoSubscr:    repoSubscr,
+		repoPayToken:  repoPayToken,
+		repoEvents:    repoEvents,
+		pusher:        pusher,
+		clock:         clock,
+		renewalNotice: renewalNotice,
+		gracePeriod:   gracePeriod,
+	}
+}
+
+// IssueRenewals create renewal payments with new pay tokens for subscriptions which period
+// ends soon, move them to grace and send renewal push. Free trial has not grace period,
+// it must be paid before trial ends. Return count of issued renewals
+// Errors:
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+//			repository_redis.SetError
+func (usecase *BillingUsecase) IssueRenewals(log *logrus.Entry) (int, error) {
+	now := usecase.clock.Now()
+	subscriptions, err := usecase.repoSubscr.GetRenewalsDue(now.Add(usecase.renewalNotice))
+	if err != nil {
+		return 0, err
+	}
+
+	issued := 0
+	for i := range subscriptions {
+		subscription := &subscriptions[i]
+		graceUntil := subscription.PaidUntil.Add(usecase.gracePeriod)
+		if subscription.InTrial {
+			graceUntil = subscription.PaidUntil
+		}
+
+		tokenExp := graceUntil.Sub(now)
+		if tokenExp < minTokenExp {
+			tokenExp = minTokenExp
+		}
+		payToken := uuid.NewV4().String()
+		err = usecase.repoPayToken.SetToken(&models.PayTokenInfo{
+			Token:     payToken,
+			UserID:    subscription.UserID,
+			CreatorID: subscription.CreatorID,
+			AwardID:   subscription.AwardID,
+			Price:     subscription.Price,
+			Currency:  subscription.Currency,
+			ExpiresAt: now.Add(tokenExp),
+		}, int(tokenExp.Seconds()))
+		if err != nil {
+			return issued, err
+		}
+		// renewal payment is created right here, so token can not be used for new subscription
+		if _, err = usecase.repoPayToken.MarkUsed(payToken, int(tokenExp.Seconds())); err != nil {
+			return issued, err
+		}
+
+		created, err := usecase.repoSubscr.CreateRenewal(subscription, payToken, graceUntil)
+		if err != nil {
+			return issued, err
+		}
+		if !created {
+			continue
+		}
+		issued++
+
+		if errPush := usecase.pusher.RenewalDue(payToken, subscription.PaidUntil); errPush != nil {
+			log.Errorf("Try push renewal due for subscription %d, and got err %s", subscription.ID, errPush)
+		}
+	}
+	return issued, nil
+}
+
+// ExpireSubscriptions close subscriptions with ended grace and add expired event for each of them.
+// Waitlists of their awards are notified about freed seats. Return count of expired subscriptions
+// Errors:
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (usecase *BillingUsecase) ExpireSubscriptions(log *logrus.Entry) (int64, error) {
+	expired, err := usecase.repoSubscr.ExpireSubscriptions(usecase.clock.Now())
+	if err != nil {
+		return 0, err
+	}
+	cnt, err := usecase.addEvents(expired, models.SubscriptionEventExpired, "")
+	usecase.pushFreedSeats(log, expired)
+	return cnt, err
+}
+
+// ApplyDowngrades move subscriptions to awards scheduled by downgrade when paid period ended
+// and add tier changed event for each of them. Return count of changed subscriptions
+// Errors:
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (usecase *BillingUsecase) ApplyDowngrades() (int64, error) {
+	changed, err := usecase.repoSubscr.ApplyDowngrades(usecase.clock.Now())
+	if err != nil {
+		return 0, err
+	}
+	return usecase.addEvents(changed, models.SubscriptionEventTierChanged, string(models.TierDowngrade))
+}
+
+// pushFreedSeats push once for each award left by subscriptions, push service notifies waitlist
+// only if award is limited and still has free seat
+func (usecase *BillingUsecase) pushFreedSeats(log *logrus.Entry, subscriptions []models.BillingSubscription) {
+	pushed := make(map[int64]bool)
+	for i := range subscriptions {
+		awardID := subscriptions[i].AwardID
+		if pushed[awardID] {
+			continue
+		}
+		pushed[awardID] = true
+		if errPush := usecase.pusher.SeatFreed(awardID); errPush != nil {
+			log.Errorf("Try push freed seat of award %d, and got err %s", awardID, errPush)
+		}
+	}
+}
+
+// addEvents add event of kind for each of subscriptions. Subscriptions are already changed,
+// so their count is returned even if some event was not added
+// Errors:
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (usecase *BillingUsecase) addEvents(subscriptions []models.BillingSubscription,
+	kind models.SubscriptionEventKind, reason string) (int64, error) {
+	for i := range subscriptions {
+		err := usecase.repoEvents.Add(&models.SubscriptionEvent{
+			UserID:    subscriptions[i].UserID,
+			CreatorID: subscriptions[i].CreatorID,
+			AwardID:   subscriptions[i].AwardID,
+			Kind:      kind,
+			Reason:    reason,
+		})
+		if err != nil {
+			return int64(len(subscriptions)), err
+		}
+	}
+	return int64(len(subscriptions)), nil
+}
@@ -0,0 +1,280 @@
+package usecase_billing
+
+import (
+	"patreon/internal/app"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_redis "patreon/internal/app/repository/pay_token/redis"
+	"patreon/internal/app/usecase"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type SuiteBillingUsecase struct {
+	usecase.SuiteUsecase
+	clock *usecase.FakeClock
+	uc    Usecase
+}
+
+func (s *SuiteBillingUsecase) SetupSuite() {
+	s.SuiteUsecase.SetupSuite()
+	s.clock = &usecase.FakeClock{Time: time.Date(2021, 12, 1, 12, 0, 0, 0, time.UTC)}
+	s.uc = NewBillingUsecase(s.MockSubscribersRepository, s.MockPayTokenRepository, s.MockEventsRepository,
+		s.MockPusher, s.clock, 24*time.Hour, 48*time.Hour)
+}
+
+func (s *SuiteBillingUsecase) testSubscription() models.BillingSubscription {
+	return models.BillingSubscription{
+		ID:        1,
+		UserID:    2,
+		CreatorID: 3,
+		AwardID:   4,
+		Price:     100,
+		Period:    1,
+		PaidUntil: s.clock.Now().Add(12 * time.Hour),
+	}
+}
+
+func (s *SuiteBillingUsecase) TestBillingUsecase_IssueRenewals_OK() {
+	subscription := s.testSubscription()
+	graceUntil := subscription.PaidUntil.Add(48 * time.Hour)
+	tokenExp := int(graceUntil.Sub(s.clock.Now()).Seconds())
+
+	var payToken string
+	s.MockSubscribersRepository.EXPECT().
+		GetRenewalsDue(s.clock.Now().Add(24*time.Hour)).
+		Times(1).
+		Return([]models.BillingSubscription{subscription}, nil)
+	s.MockPayTokenRepository.EXPECT().
+		SetToken(gomock.Any(), tokenExp).
+		Times(1).
+		DoAndReturn(func(info *models.PayTokenInfo, timeExp int) error {
+			assert.Equal(s.T(), subscription.UserID, info.UserID)
+			assert.Equal(s.T(), subscription.AwardID, info.AwardID)
+			assert.Equal(s.T(), subscription.Price, info.Price)
+			assert.Equal(s.T(), graceUntil, info.ExpiresAt)
+			payToken = info.Token
+			return nil
+		})
+	s.MockPayTokenRepository.EXPECT().
+		MarkUsed(gomock.Any(), tokenExp).
+		Times(1).
+		DoAndReturn(func(token string, _ int) (bool, error) {
+			assert.Equal(s.T(), payToken, token)
+			return true, nil
+		})
+	s.MockSubscribersRepository.EXPECT().
+		CreateRenewal(&subscription, gomock.Any(), graceUntil).
+		Times(1).
+		DoAndReturn(func(_ *models.BillingSubscription, token string, _ time.Time) (bool, error) {
+			assert.Equal(s.T(), payToken, token)
+			return true, nil
+		})
+	s.MockPusher.EXPECT().
+		RenewalDue(gomock.Any(), subscription.PaidUntil).
+		Times(1).
+		Return(nil)
+
+	issued, err := s.uc.IssueRenewals(s.Logger.WithField("test", true))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 1, issued)
+}
+
+func (s *SuiteBillingUsecase) TestBillingUsecase_IssueRenewals_TrialWithoutGrace() {
+	subscription := s.testSubscription()
+	subscription.InTrial = true
+	s.MockSubscribersRepository.EXPECT().
+		GetRenewalsDue(s.clock.Now().Add(24*time.Hour)).
+		Times(1).
+		Return([]models.BillingSubscription{subscription}, nil)
+	s.MockPayTokenRepository.EXPECT().
+		SetToken(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(nil)
+	s.MockPayTokenRepository.EXPECT().
+		MarkUsed(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(true, nil)
+	s.MockSubscribersRepository.EXPECT().
+		CreateRenewal(&subscription, gomock.Any(), subscription.PaidUntil).
+		Times(1).
+		Return(true, nil)
+	s.MockPusher.EXPECT().
+		RenewalDue(gomock.Any(), subscription.PaidUntil).
+		Times(1).
+		Return(nil)
+
+	issued, err := s.uc.IssueRenewals(s.Logger.WithField("test", true))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 1, issued)
+}
+
+func (s *SuiteBillingUsecase) TestBillingUsecase_IssueRenewals_AlreadyIssued() {
+	subscription := s.testSubscription()
+	s.MockSubscribersRepository.EXPECT().
+		GetRenewalsDue(s.clock.Now().Add(24*time.Hour)).
+		Times(1).
+		Return([]models.BillingSubscription{subscription}, nil)
+	s.MockPayTokenRepository.EXPECT().
+		SetToken(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(nil)
+	s.MockPayTokenRepository.EXPECT().
+		MarkUsed(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(true, nil)
+	s.MockSubscribersRepository.EXPECT().
+		CreateRenewal(&subscription, gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(false, nil)
+
+	issued, err := s.uc.IssueRenewals(s.Logger.WithField("test", true))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 0, issued)
+}
+
+func (s *SuiteBillingUsecase) TestBillingUsecase_IssueRenewals_GraceEndedTokenExp() {
+	subscription := s.testSubscription()
+	subscription.PaidUntil = s.clock.Now().Add(-72 * time.Hour)
+	s.MockSubscribersRepository.EXPECT().
+		GetRenewalsDue(s.clock.Now().Add(24*time.Hour)).
+		Times(1).
+		Return([]models.BillingSubscription{subscription}, nil)
+	s.MockPayTokenRepository.EXPECT().
+		SetToken(gomock.Any(), int(minTokenExp.Seconds())).
+		Times(1).
+		Return(nil)
+	s.MockPayTokenRepository.EXPECT().
+		MarkUsed(gomock.Any(), int(minTokenExp.Seconds())).
+		Times(1).
+		Return(true, nil)
+	s.MockSubscribersRepository.EXPECT().
+		CreateRenewal(&subscription, gomock.Any(), subscription.PaidUntil.Add(48*time.Hour)).
+		Times(1).
+		Return(true, nil)
+	s.MockPusher.EXPECT().
+		RenewalDue(gomock.Any(), subscription.PaidUntil).
+		Times(1).
+		Return(errors.New("rabbit error"))
+
+	issued, err := s.uc.IssueRenewals(s.Logger.WithField("test", true))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 1, issued)
+}
+
+func (s *SuiteBillingUsecase) TestBillingUsecase_IssueRenewals_SetTokenError() {
+	subscription := s.testSubscription()
+	s.MockSubscribersRepository.EXPECT().
+		GetRenewalsDue(s.clock.Now().Add(24*time.Hour)).
+		Times(1).
+		Return([]models.BillingSubscription{subscription}, nil)
+	s.MockPayTokenRepository.EXPECT().
+		SetToken(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(repository_redis.SetError)
+
+	issued, err := s.uc.IssueRenewals(s.Logger.WithField("test", true))
+	assert.Equal(s.T(), repository_redis.SetError, err)
+	assert.Equal(s.T(), 0, issued)
+}
+
+func (s *SuiteBillingUsecase) TestBillingUsecase_IssueRenewals_DBError() {
+	s.MockSubscribersRepository.EXPECT().
+		GetRenewalsDue(s.clock.Now().Add(24*time.Hour)).
+		Times(1).
+		Return(nil, repository.NewDBError(repository.DefaultErrDB))
+
+	_, err := s.uc.IssueRenewals(s.Logger.WithField("test", true))
+	assert.Equal(s.T(), repository.DefaultErrDB, errors.Cause(err).(*app.GeneralError).Err)
+}
+
+func (s *SuiteBillingUsecase) TestBillingUsecase_ExpireSubscriptions() {
+	expiredSubscriptions := []models.BillingSubscription{
+		{ID: 1, UserID: 2, CreatorID: 3, AwardID: 4},
+		{ID: 5, UserID: 6, CreatorID: 3, AwardID: 7},
+		{ID: 8, UserID: 9, CreatorID: 3, AwardID: 4},
+	}
+	s.MockSubscribersRepository.EXPECT().
+		ExpireSubscriptions(s.clock.Now()).
+		Times(1).
+		Return(expiredSubscriptions, nil)
+	for _, cur := range expiredSubscriptions {
+		s.MockEventsRepository.EXPECT().
+			Add(&models.SubscriptionEvent{UserID: cur.UserID, CreatorID: cur.CreatorID, AwardID: cur.AwardID,
+				Kind: models.SubscriptionEventExpired}).
+			Times(1).
+			Return(nil)
+	}
+	s.MockPusher.EXPECT().
+		SeatFreed(int64(4)).
+		Times(1).
+		Return(nil)
+	s.MockPusher.EXPECT().
+		SeatFreed(int64(7)).
+		Times(1).
+		Return(nil)
+
+	expired, err := s.uc.ExpireSubscriptions(s.Logger.WithField("test", true))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(3), expired)
+
+	s.clock.Time = s.clock.Time.Add(time.Hour)
+	s.MockSubscribersRepository.EXPECT().
+		ExpireSubscriptions(s.clock.Now()).
+		Times(1).
+		Return(nil, nil)
+
+	expired, err = s.uc.ExpireSubscriptions(s.Logger.WithField("test", true))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(0), expired)
+	s.clock.Time = s.clock.Time.Add(-time.Hour)
+}
+
+func (s *SuiteBillingUsecase) TestBillingUsecase_ApplyDowngrades() {
+	s.MockSubscribersRepository.EXPECT().
+		ApplyDowngrades(s.clock.Now()).
+		Times(1).
+		Return([]models.BillingSubscription{{ID: 1, UserID: 2, CreatorID: 3, AwardID: 4}}, nil)
+	s.MockEventsRepository.EXPECT().
+		Add(&models.SubscriptionEvent{UserID: 2, CreatorID: 3, AwardID: 4, Kind: models.SubscriptionEventTierChanged,
+			Reason: string(models.TierDowngrade)}).
+		Times(1).
+		Return(nil)
+
+	changed, err := s.uc.ApplyDowngrades()
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(1), changed)
+
+	s.MockSubscribersRepository.EXPECT().
+		ApplyDowngrades(s.clock.Now()).
+		Times(1).
+		Return(nil, repository.NewDBError(repository.DefaultErrDB))
+
+	_, err = s.uc.ApplyDowngrades()
+	assert.Error(s.T(), err)
+}
+
+func (s *SuiteBillingUsecase) TestBillingUsecase_ApplyDowngrades_EventError() {
+	s.MockSubscribersRepository.EXPECT().
+		ApplyDowngrades(s.clock.Now()).
+		Times(1).
+		Return([]models.BillingSubscription{{ID: 1, UserID: 2, CreatorID: 3, AwardID: 4}}, nil)
+	s.MockEventsRepository.EXPECT().
+		Add(gomock.Any()).
+		Times(1).
+		Return(repository.NewDBError(repository.DefaultErrDB))
+
+	changed, err := s.uc.ApplyDowngrades()
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), int64(1), changed)
+}
+
+func TestUsecaseBilling(t *testing.T) {
+	suite.Run(t, new(SuiteBillingUsecase))
+}
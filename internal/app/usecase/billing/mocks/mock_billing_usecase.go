@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: patreon/internal/app/usecase/billing (interfaces: Usecase)
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	logrus "github.com/sirupsen/logrus"
+)
+
+// BillingUsecase is a mock of Usecase interface.
+type BillingUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *BillingUsecaseMockRecorder
+}
+
+// BillingUsecaseMockRecorder is the mock recorder for BillingUsecase.
+type BillingUsecaseMockRecorder struct {
+	mock *BillingUsecase
+}
+
+// NewBillingUsecase creates a new mock instance.
+func NewBillingUsecase(ctrl *gomock.Controller) *BillingUsecase {
+	mock := &BillingUsecase{ctrl: ctrl}
+	mock.recorder = &BillingUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *BillingUsecase) EXPECT() *BillingUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ApplyDowngrades mocks base method.
+func (m *BillingUsecase) ApplyDowngrades() (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyDowngrades")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyDowngrades indicates an expected call of ApplyDowngrades.
+func (mr *BillingUsecaseMockRecorder) ApplyDowngrades() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyDowngrades", reflect.TypeOf((*BillingUsecase)(nil).ApplyDowngrades))
+}
+
+// ExpireSubscriptions mocks base method.
+func (m *BillingUsecase) ExpireSubscriptions(arg0 *logrus.Entry) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireSubscriptions", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireSubscriptions indicates an expected call of ExpireSubscriptions.
+func (mr *BillingUsecaseMockRecorder) ExpireSubscriptions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireSubscriptions", reflect.TypeOf((*BillingUsecase)(nil).ExpireSubscriptions), arg0)
+}
+
+// IssueRenewals mocks base method.
+func (m *BillingUsecase) IssueRenewals(arg0 *logrus.Entry) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueRenewals", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueRenewals indicates an expected call of IssueRenewals.
+func (mr *BillingUsecaseMockRecorder) IssueRenewals(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueRenewals", reflect.TypeOf((*BillingUsecase)(nil).IssueRenewals), arg0)
+}
@@ -0,0 +1,27 @@
+package usecase_billing
+
+import "github.com/sirupsen/logrus"
+
+//go:generate mockgen -destination=mocks/mock_billing_usecase.go -package=mock_usecase -mock_names=Usecase=BillingUsecase . Usecase
+
+type Usecase interface {
+	// IssueRenewals create renewal payments with new pay tokens for subscriptions which period
+	// ends soon, move them to grace and send renewal push. Return count of issued renewals
+	// Errors:
+	//		app.GeneralError with Errors
+	//			repository.DefaultErrDB
+	//			repository_redis.SetError
+	IssueRenewals(log *logrus.Entry) (int, error)
+	// ExpireSubscriptions close subscriptions with ended grace and push about their freed seats.
+	// Return count of expired subscriptions
+	// Errors:
+	//		app.GeneralError with Errors
+	//			repository.DefaultErrDB
+	ExpireSubscriptions(log *logrus.Entry) (int64, error)
+	// ApplyDowngrades move subscriptions to awards scheduled by downgrade when paid period ended.
+	// Return count of changed subscriptions
+	// Errors:
+	//		app.GeneralError with Errors
+	//			repository.DefaultErrDB
+	ApplyDowngrades() (int64, error)
+}
@@ -0,0 +1,57 @@
+package usecase_blocked_users
+
+import (
+	"patreon/internal/app/models"
+	repository_blocked_users "patreon/internal/app/repository/blocked_users"
+	repository_subscribers "patreon/internal/app/repository/subscribers"
+)
+
+type BlockedUsersUsecase struct {
+	repoBlocked repository_blocked_users.Repository
+	repoSubscr  repository_subscribers.Repository
+}
+
+func NewBlockedUsersUsecase(repoBlocked repository_blocked_users.Repository,
+	repoSubscr repository_subscribers.Repository) *BlockedUsersUsecase {
+	return &BlockedUsersUsecase{
+		repoBlocked: repoBlocked,
+		repoSubscr:  repoSubscr,
+	}
+}
+
+// Block add user to block list of creator. Active subscription of user stays until the end of paid period,
+// with cancelSubscription it is not renewed after it
+// Errors:
+//		CanNotBlockSelf
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (uc *BlockedUsersUsecase) Block(creatorID int64, userID int64, cancelSubscription bool) error {
+	if creatorID == userID {
+		return CanNotBlockSelf
+	}
+	if err := uc.repoBlocked.Add(creatorID, userID); err != nil {
+		return err
+	}
+	if !cancelSubscription {
+		return nil
+	}
+	return uc.repoSubscr.StopRenewal(userID, creatorID)
+}
+
+// Unblock Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (uc *BlockedUsersUsecase) Unblock(creatorID int64, userID int64) error {
+	return uc.repoBlocked.Remove(creatorID, userID)
+}
+
+// GetBlocked return page of users blocked by creator, the last blocked first
+// Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (uc *BlockedUsersUsecase) GetBlocked(creatorID int64, pag *models.Pagination) ([]models.BlockedUser, error) {
+	return uc.repoBlocked.GetBlocked(creatorID, pag)
+}
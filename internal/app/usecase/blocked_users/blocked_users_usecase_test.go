@@ -0,0 +1,71 @@
+package usecase_blocked_users
+
+import (
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	"patreon/internal/app/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type SuiteBlockedUsersUsecase struct {
+	usecase.SuiteUsecase
+	uc Usecase
+}
+
+func (s *SuiteBlockedUsersUsecase) SetupSuite() {
+	s.SuiteUsecase.SetupSuite()
+	s.uc = NewBlockedUsersUsecase(s.MockBlockedRepository, s.MockSubscribersRepository)
+}
+
+func (s *SuiteBlockedUsersUsecase) TestBlockedUsersUsecase_Block_OK() {
+	s.MockBlockedRepository.EXPECT().
+		Add(int64(1), int64(2)).
+		Times(1).
+		Return(nil)
+	err := s.uc.Block(1, 2, false)
+	assert.NoError(s.T(), err)
+}
+
+func (s *SuiteBlockedUsersUsecase) TestBlockedUsersUsecase_Block_CancelSubscription() {
+	s.MockBlockedRepository.EXPECT().
+		Add(int64(1), int64(2)).
+		Times(1).
+		Return(nil)
+	s.MockSubscribersRepository.EXPECT().
+		StopRenewal(int64(2), int64(1)).
+		Times(1).
+		Return(nil)
+	err := s.uc.Block(1, 2, true)
+	assert.NoError(s.T(), err)
+}
+
+func (s *SuiteBlockedUsersUsecase) TestBlockedUsersUsecase_Block_Error() {
+	err := s.uc.Block(1, 1, true)
+	assert.Equal(s.T(), CanNotBlockSelf, err)
+
+	s.MockBlockedRepository.EXPECT().
+		Add(int64(1), int64(2)).
+		Times(1).
+		Return(repository.NotFound)
+	err = s.uc.Block(1, 2, true)
+	assert.Equal(s.T(), repository.NotFound, err)
+}
+
+func (s *SuiteBlockedUsersUsecase) TestBlockedUsersUsecase_GetBlocked() {
+	pag := &models.Pagination{Limit: 10}
+	expected := []models.BlockedUser{{UserID: 2, Nickname: "troll"}}
+	s.MockBlockedRepository.EXPECT().
+		GetBlocked(int64(1), pag).
+		Times(1).
+		Return(expected, nil)
+	res, err := s.uc.GetBlocked(1, pag)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), expected, res)
+}
+
+func TestBlockedUsersUsecase(t *testing.T) {
+	suite.Run(t, new(SuiteBlockedUsersUsecase))
+}
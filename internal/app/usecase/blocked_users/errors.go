@@ -0,0 +1,7 @@
+package usecase_blocked_users
+
+import "errors"
+
+var (
+	CanNotBlockSelf = errors.New("creator can not block himself")
+)
@@ -0,0 +1,78 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: patreon/internal/app/usecase/blocked_users (interfaces: Usecase)
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	models "patreon/internal/app/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// BlockedUsersUsecase is a mock of Usecase interface.
+type BlockedUsersUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *BlockedUsersUsecaseMockRecorder
+}
+
+// BlockedUsersUsecaseMockRecorder is the mock recorder for BlockedUsersUsecase.
+type BlockedUsersUsecaseMockRecorder struct {
+	mock *BlockedUsersUsecase
+}
+
+// NewBlockedUsersUsecase creates a new mock instance.
+func NewBlockedUsersUsecase(ctrl *gomock.Controller) *BlockedUsersUsecase {
+	mock := &BlockedUsersUsecase{ctrl: ctrl}
+	mock.recorder = &BlockedUsersUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *BlockedUsersUsecase) EXPECT() *BlockedUsersUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Block mocks base method.
+func (m *BlockedUsersUsecase) Block(arg0, arg1 int64, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Block", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Block indicates an expected call of Block.
+func (mr *BlockedUsersUsecaseMockRecorder) Block(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Block", reflect.TypeOf((*BlockedUsersUsecase)(nil).Block), arg0, arg1, arg2)
+}
+
+// GetBlocked mocks base method.
+func (m *BlockedUsersUsecase) GetBlocked(arg0 int64, arg1 *models.Pagination) ([]models.BlockedUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlocked", arg0, arg1)
+	ret0, _ := ret[0].([]models.BlockedUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlocked indicates an expected call of GetBlocked.
+func (mr *BlockedUsersUsecaseMockRecorder) GetBlocked(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocked", reflect.TypeOf((*BlockedUsersUsecase)(nil).GetBlocked), arg0, arg1)
+}
+
+// Unblock mocks base method.
+func (m *BlockedUsersUsecase) Unblock(arg0, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unblock", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unblock indicates an expected call of Unblock.
+func (mr *BlockedUsersUsecaseMockRecorder) Unblock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unblock", reflect.TypeOf((*BlockedUsersUsecase)(nil).Unblock), arg0, arg1)
+}
@@ -0,0 +1,24 @@
+package usecase_blocked_users
+
+import "patreon/internal/app/models"
+
+//go:generate mockgen -destination=mocks/mock_blocked_users_usecase.go -package=mock_usecase -mock_names=Usecase=BlockedUsersUsecase . Usecase
+
+type Usecase interface {
+	// Block Errors:
+	//		CanNotBlockSelf
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	Block(creatorID int64, userID int64, cancelSubscription bool) error
+	// Unblock Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	Unblock(creatorID int64, userID int64) error
+	// GetBlocked Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetBlocked(creatorID int64, pag *models.Pagination) ([]models.BlockedUser, error)
+}
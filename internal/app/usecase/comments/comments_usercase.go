@@ -5,28 +5,34 @@ import (
 	"github.com/sirupsen/logrus"
 	"patreon/internal/app"
 	"patreon/internal/app/models"
+	repoBlockedUsers "patreon/internal/app/repository/blocked_users"
 	repoComments "patreon/internal/app/repository/comments"
 	push_client "patreon/internal/microservices/push/delivery/client"
 )
 
 type CommentsUsecase struct {
-	repository repoComments.Repository
-	pusher     push_client.Pusher
+	repository  repoComments.Repository
+	repoBlocked repoBlockedUsers.Repository
+	pusher      push_client.Pusher
 }
 
-func NewCommentsUsecase(repository repoComments.Repository, pusher push_client.Pusher) *CommentsUsecase {
+func NewCommentsUsecase(repository repoComments.Repository, repoBlocked repoBlockedUsers.Repository,
+	pusher push_client.Pusher) *CommentsUsecase {
 	return &CommentsUsecase{
-		repository: repository,
-		pusher:     pusher,
+		repository:  repository,
+		repoBlocked: repoBlocked,
+		pusher:      pusher,
 	}
 }
 
-// Create Errors:
-//		models.InvalidPostId
-//		models.InvalidUserId
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
-func (usecase *CommentsUsecase) Create(log *logrus.Entry, cm *models.Comment) (int64, error) {
+// Create comment on post of creator with creatorId, user blocked by creator can not comment his posts
+// Errors:
+//	UserBlocked
+//	models.InvalidPostId
+//	models.InvalidUserId
+//	app.GeneralError with Errors
+//		repository.DefaultErrDB
+func (usecase *CommentsUsecase) Create(log *logrus.Entry, creatorId int64, cm *models.Comment) (int64, error) {
 	if err := cm.Validate(); err != nil {
 		if errors.Is(err, models.InvalidPostId) || errors.Is(err, models.InvalidUserId) {
 			return app.InvalidInt, err
@@ -36,6 +42,13 @@ func (usecase *CommentsUsecase) Create(log *logrus.Entry, cm *models.Comment) (i
 			ExternalErr: errors.Wrap(err, "failed process of validation creator"),
 		}
 	}
+	blocked, err := usecase.repoBlocked.IsBlocked(creatorId, cm.AuthorId)
+	if err != nil {
+		return app.InvalidInt, err
+	}
+	if blocked {
+		return app.InvalidInt, UserBlocked
+	}
 	commentId, err := usecase.repository.Create(cm)
 	errPush := usecase.pusher.NewComment(commentId, cm.AuthorId, cm.PostId)
 	if errPush != nil {
@@ -45,46 +58,46 @@ func (usecase *CommentsUsecase) Create(log *logrus.Entry, cm *models.Comment) (i
 }
 
 // Get Errors:
-//		repository.NotFound
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
+//	repository.NotFound
+//	app.GeneralError with Errors
+//		repository.DefaultErrDB
 func (usecase *CommentsUsecase) Get(commentsId int64) (*models.Comment, error) {
 	return usecase.repository.Get(commentsId)
 }
 
 // Update Errors:
-//		repository.NotFound
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
+//	repository.NotFound
+//	app.GeneralError with Errors
+//		repository.DefaultErrDB
 func (usecase *CommentsUsecase) Update(cm *models.Comment) error {
 	return usecase.repository.Update(cm)
 }
 
 // CheckExists Errors:
-//		repository_postgresql.CommentAlreadyExist
-// 		app.GeneralError with Errors
-// 			repository.DefaultErrDB
+//	repository_postgresql.CommentAlreadyExist
+//	app.GeneralError with Errors
+//		repository.DefaultErrDB
 func (usecase *CommentsUsecase) CheckExists(commentId int64) error {
 	return usecase.repository.CheckExists(commentId)
 }
 
 // GetUserComments Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//	app.GeneralError with Errors:
+//		repository.DefaultErrDB
 func (usecase *CommentsUsecase) GetUserComments(userId int64, pag *models.Pagination) ([]models.UserComment, error) {
 	return usecase.repository.GetUserComments(userId, pag)
 }
 
 // GetPostComments Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//	app.GeneralError with Errors:
+//		repository.DefaultErrDB
 func (usecase *CommentsUsecase) GetPostComments(postId int64, pag *models.Pagination) ([]models.PostComment, error) {
 	return usecase.repository.GetPostComments(postId, pag)
 }
 
 // Delete Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//	app.GeneralError with Errors:
+//		repository.DefaultErrDB
 func (usecase *CommentsUsecase) Delete(commentId int64) error {
 	return usecase.repository.Delete(commentId)
 }
@@ -1 +1,7 @@
 package usecase_comments
+
+import "errors"
+
+var (
+	UserBlocked = errors.New("user is blocked by creator")
+)
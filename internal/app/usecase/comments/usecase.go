@@ -9,12 +9,13 @@ import (
 
 type Usecase interface {
 	// Create Errors:
+	//		UserBlocked
 	//		repository_postgresql.CommentAlreadyExist
 	//		models.InvalidPostId
 	//		models.InvalidUserId
 	// 		app.GeneralError with Errors
 	// 			repository.DefaultErrDB
-	Create(log *logrus.Entry, cm *models.Comment) (int64, error)
+	Create(log *logrus.Entry, creatorId int64, cm *models.Comment) (int64, error)
 
 	// Update Errors:
 	//		repository.NotFound
@@ -39,7 +40,8 @@ type Usecase interface {
 	// 			repository.DefaultErrDB
 	GetUserComments(userId int64, pag *models.Pagination) ([]models.UserComment, error)
 
-	// GetPostComments Errors:
+	// GetPostComments return page of post comments without comments of users blocked by creator
+	// Errors:
 	// 		app.GeneralError with Errors:
 	// 			repository.DefaultErrDB
 	GetPostComments(postId int64, pag *models.Pagination) ([]models.PostComment, error)
@@ -20,11 +20,12 @@ const NoUser int64 = -2
 type CreatorUsecase struct {
 	repository     repoCreator.Repository
 	repositoryFile client.FileServiceClient
+	rates          *models.ExchangeRates
 	imageConvector utils.ImageConverter
 }
 
 func NewCreatorUsecase(repository repoCreator.Repository, repoClient client.FileServiceClient,
-	convector ...utils.ImageConverter) *CreatorUsecase {
+	rates *models.ExchangeRates, convector ...utils.ImageConverter) *CreatorUsecase {
 	conv := utils.ImageConverter(&utils.ConverterToWebp{})
 	if len(convector) != 0 {
 		conv = convector[0]
@@ -32,15 +33,20 @@ func NewCreatorUsecase(repository repoCreator.Repository, repoClient client.File
 	return &CreatorUsecase{
 		repository:     repository,
 		repositoryFile: repoClient,
+		rates:          rates,
 		imageConvector: conv,
 	}
 }
 
-// Create Errors:
+// Create creator with settlement currency creator.Currency, DefaultCurrency if it is empty.
+// Currency can not be changed later, because balance of creator is kept in it
+// Errors:
 //		CreatorExist
 //		models.IncorrectCreatorNickname
 //		models.IncorrectCreatorCategory
 //		models.IncorrectCreatorDescription
+//		models.IncorrectCurrency
+//		models.UnknownCurrency
 //		repository_postgresql.IncorrectCategory
 //		app.GeneralError with Errors:
 //			app.UnknownError
@@ -55,9 +61,12 @@ func (usecase *CreatorUsecase) Create(creator *models.Creator) (int64, error) {
 		return app.InvalidInt, CreatorExist
 	}
 
+	if creator.Currency == "" {
+		creator.Currency = models.DefaultCurrency
+	}
 	if err = creator.Validate(); err != nil {
 		if errors.Is(err, models.IncorrectCreatorCategory) || errors.Is(err, models.IncorrectCreatorNickname) ||
-			errors.Is(err, models.IncorrectCreatorDescription) {
+			errors.Is(err, models.IncorrectCreatorDescription) || errors.Is(err, models.IncorrectCurrency) {
 			return -1, err
 		}
 		return app.InvalidInt, &app.GeneralError{
@@ -66,28 +75,32 @@ func (usecase *CreatorUsecase) Create(creator *models.Creator) (int64, error) {
 		}
 	}
 
+	if !usecase.rates.Supports(creator.Currency) {
+		return app.InvalidInt, models.UnknownCurrency
+	}
+
 	return usecase.repository.Create(creator)
 }
 
 // GetCreators Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (usecase *CreatorUsecase) GetCreators() ([]models.Creator, error) {
 	return usecase.repository.GetCreators()
 }
 
 // SearchCreators Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (usecase *CreatorUsecase) SearchCreators(pag *models.Pagination,
 	searchString string, categories ...string) ([]models.Creator, error) {
 	return usecase.repository.SearchCreators(pag, searchString, categories...)
 }
 
 // GetCreator Errors:
-// 		repository.NotFound
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (usecase *CreatorUsecase) GetCreator(id int64, userId int64) (*models.CreatorWithAwards, error) {
 	cr, err := usecase.repository.GetCreator(id, userId)
 	if err != nil {
@@ -97,13 +110,13 @@ func (usecase *CreatorUsecase) GetCreator(id int64, userId int64) (*models.Creat
 }
 
 // UpdateCover Errors:
-// 		repository.NotFound
-// 		app.GeneralError with Errors:
-//			repository_os.ErrorCreate
-//   		repository_os.ErrorCopyFile
-// 			repository.DefaultErrDB
-//			utils.ConvertErr
-//  		utils.UnknownExtOfFileName
+//				repository.NotFound
+//				app.GeneralError with Errors:
+//					repository_os.ErrorCreate
+//		  		repository_os.ErrorCopyFile
+//					repository.DefaultErrDB
+//					utils.ConvertErr
+//		 		utils.UnknownExtOfFileName
 func (usecase *CreatorUsecase) UpdateCover(data io.Reader, name repoFiles.FileName, id int64) error {
 	_, err := usecase.repository.ExistsCreator(id)
 	if err != nil {
@@ -127,13 +140,13 @@ func (usecase *CreatorUsecase) UpdateCover(data io.Reader, name repoFiles.FileNa
 }
 
 // UpdateAvatar Errors:
-// 		repository.NotFound
-// 		app.GeneralError with Errors:
-//			repository_os.ErrorCreate
-//   		repository_os.ErrorCopyFile
-// 			repository.DefaultErrDB
-//			utils.ConvertErr
-//  		utils.UnknownExtOfFileName
+//				repository.NotFound
+//				app.GeneralError with Errors:
+//					repository_os.ErrorCreate
+//		  		repository_os.ErrorCopyFile
+//					repository.DefaultErrDB
+//					utils.ConvertErr
+//		 		utils.UnknownExtOfFileName
 func (usecase *CreatorUsecase) UpdateAvatar(data io.Reader, name repoFiles.FileName, id int64) error {
 	_, err := usecase.repository.ExistsCreator(id)
 	if err != nil {
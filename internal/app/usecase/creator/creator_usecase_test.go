@@ -26,7 +26,9 @@ type SuiteCreatorUsecase struct {
 
 func (s *SuiteCreatorUsecase) SetupSuite() {
 	s.SuiteUsecase.SetupSuite()
-	s.uc = NewCreatorUsecase(s.MockCreatorRepository, s.MockFileClient, s.MockConvector)
+	rates, err := models.NewExchangeRates(map[string]string{"USD": "75.50"})
+	s.Require().NoError(err)
+	s.uc = NewCreatorUsecase(s.MockCreatorRepository, s.MockFileClient, rates, s.MockConvector)
 }
 
 func (s *SuiteCreatorUsecase) TestCreatorUsecase_Create_DB_Error() {
@@ -109,6 +111,37 @@ func (s *SuiteCreatorUsecase) TestCreatorUsecase_Create_Success() {
 	assert.Equal(s.T(), s.Tb.ExpectedError, err)
 }
 
+func (s *SuiteCreatorUsecase) TestCreatorUsecase_Create_Currency() {
+	s.Tb = usecase.TestTable{
+		Name:              "Currency without exchange rate",
+		Data:              models.TestCreator(),
+		ExpectedMockTimes: 1,
+		ExpectedError:     models.UnknownCurrency,
+	}
+	cr := models.TestCreator()
+	cr.Currency = "EUR"
+	s.MockCreatorRepository.EXPECT().
+		ExistsCreator(cr.ID).
+		Times(s.Tb.ExpectedMockTimes).
+		Return(false, repository.NotFound)
+	_, err := s.uc.Create(cr)
+	assert.Equal(s.T(), s.Tb.ExpectedError, err)
+
+	cr = models.TestCreator()
+	cr.Currency = ""
+	s.MockCreatorRepository.EXPECT().
+		ExistsCreator(cr.ID).
+		Times(s.Tb.ExpectedMockTimes).
+		Return(false, repository.NotFound)
+	s.MockCreatorRepository.EXPECT().
+		Create(cr).
+		Times(s.Tb.ExpectedMockTimes).
+		Return(cr.ID, nil)
+	_, err = s.uc.Create(cr)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), models.DefaultCurrency, cr.Currency)
+}
+
 func (s *SuiteCreatorUsecase) TestCreatorUsecase_UpdateAvatar_Success() {
 	cr := models.TestCreator()
 	name := "true"
@@ -0,0 +1,12 @@
+package usecase_gifts
+
+import "github.com/pkg/errors"
+
+var (
+	AwardNotBelongCreator = errors.New("award not belongs to this creator")
+	RecipientNotFound     = errors.New("gift recipient not found")
+	GiftToSelf            = errors.New("gift can not be bought for yourself")
+	RecipientSubscribed   = errors.New("recipient is already subscribed on other award of this creator")
+	GiftNotFound          = errors.New("gift with this code not found")
+	UserBlocked           = errors.New("user is blocked by creator")
+)
@@ -0,0 +1,223 @@
+package usecase_gifts
+
+import (
+	"patreon/internal/app"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_awards "patreon/internal/app/repository/awards"
+	repository_blocked_users "patreon/internal/app/repository/blocked_users"
+	repository_gifts "patreon/internal/app/repository/gifts"
+	repository_pay_token "patreon/internal/app/repository/pay_token"
+	repository_subscribers "patreon/internal/app/repository/subscribers"
+	repository_user "patreon/internal/app/repository/user"
+	push_client "patreon/internal/microservices/push/delivery/client"
+	"patreon/pkg/utils"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	giftTokenExp = 3 * time.Hour
+	giftCodeLen  = 16
+)
+
+type GiftsUsecase struct {
+	repository   repository_gifts.Repository
+	repoAwards   repository_awards.Repository
+	repoUser     repository_user.Repository
+	repoSubscr   repository_subscribers.Repository
+	repoPayToken repository_pay_token.Repository
+	repoBlocked  repository_blocked_users.Repository
+	pusher       push_client.Pusher
+	clock        utils.Clock
+}
+
+func NewGiftsUsecase(repository repository_gifts.Repository, repoAwards repository_awards.Repository,
+	repoUser repository_user.Repository, repoSubscr repository_subscribers.Repository,
+	repoPayToken repository_pay_token.Repository, repoBlocked repository_blocked_users.Repository,
+	pusher push_client.Pusher, clock utils.Clock) *GiftsUsecase {
+	return &GiftsUsecase{
+		repository:   repository,
+		repoAwards:   repoAwards,
+		repoUser:     repoUser,
+		repoSubscr:   repoSubscr,
+		repoPayToken: repoPayToken,
+		repoBlocked:  repoBlocked,
+		pusher:       pusher,
+		clock:        clock,
+	}
+}
+
+// Create gift of gift.Periods months of award subscription paid by gift.PayerID.
+// Gift is bought for gift.RecipientNickname if it is set, otherwise code returned to payer can be redeemed by anyone.
+// Payment of gift is created right here and paid by returned gift.PayToken
+// Errors:
+//		models.IncorrectGiftPeriods
+//		AwardNotBelongCreator
+//	UserBlocked
+//		RecipientNotFound
+//		GiftToSelf
+//		RecipientSubscribed
+//		repository.NotFound
+//		repository_subscribers.AwardSoldOut
+//		app.GeneralError with Errors:
+//			app.UnknownError
+//			repository.DefaultErrDB
+//			repository_redis.SetError
+func (usecase *GiftsUsecase) Create(gift *models.Gift) (*models.Gift, error) {
+	if err := gift.Validate(); err != nil {
+		if errors.Is(err, models.IncorrectGiftPeriods) {
+			return nil, err
+		}
+		return nil, &app.GeneralError{
+			Err:         app.UnknownError,
+			ExternalErr: errors.Wrap(err, "failed process of validation gift"),
+		}
+	}
+
+	award, err := usecase.repoAwards.GetByID(gift.AwardID)
+	if err != nil {
+		return nil, err
+	}
+	if award.CreatorId != gift.CreatorID {
+		return nil, AwardNotBelongCreator
+	}
+	if err = usecase.checkNotBlocked(gift.CreatorID, gift.PayerID); err != nil {
+		return nil, err
+	}
+
+	if gift.RecipientNickname != "" {
+		recipient, err := usecase.repoUser.FindByNickname(gift.RecipientNickname)
+		if err != nil {
+			if errors.Is(err, repository.NotFound) {
+				return nil, RecipientNotFound
+			}
+			return nil, err
+		}
+		if err = usecase.checkNotBlocked(gift.CreatorID, recipient.ID); err != nil {
+			return nil, err
+		}
+		if err = usecase.checkRecipient(gift, recipient.ID); err != nil {
+			return nil, err
+		}
+		gift.RecipientID = recipient.ID
+	}
+
+	now := usecase.clock.Now()
+	gift.Code = strings.ToUpper(strings.ReplaceAll(uuid.NewV4().String(), "-", "")[:giftCodeLen])
+	gift.Amount = award.Price.Mul(gift.Periods)
+	gift.Currency = award.Currency
+	gift.PayToken = uuid.NewV4().String()
+	err = usecase.repoPayToken.SetToken(&models.PayTokenInfo{
+		Token:     gift.PayToken,
+		UserID:    gift.PayerID,
+		CreatorID: gift.CreatorID,
+		AwardID:   award.ID,
+		Price:     gift.Amount,
+		Currency:  gift.Currency,
+		ExpiresAt: now.Add(giftTokenExp),
+	}, int(giftTokenExp.Seconds()))
+	if err != nil {
+		return nil, err
+	}
+	// gift payment is created right here, so token can not be used for subscription of payer
+	if _, err = usecase.repoPayToken.MarkUsed(gift.PayToken, int(giftTokenExp.Seconds())); err != nil {
+		return nil, err
+	}
+
+	if err = usecase.repository.Create(gift); err != nil {
+		// token without payment must not be shown as outstanding checkout
+		_ = usecase.repoPayToken.RemoveUserToken(gift.PayerID, gift.PayToken)
+		return nil, err
+	}
+	return gift, nil
+}
+
+// Redeem grant subscription of paid gift with code to user
+// Errors:
+//		GiftNotFound
+//	UserBlocked
+//		GiftToSelf
+//		RecipientSubscribed
+//		repository_gifts.GiftNotRedeemable
+//		repository_subscribers.AwardSoldOut
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *GiftsUsecase) Redeem(log *logrus.Entry, code string, userID int64) (*models.Gift, error) {
+	gift, err := usecase.repository.GetByCode(strings.ToUpper(code))
+	if err != nil {
+		if errors.Is(err, repository.NotFound) {
+			return nil, GiftNotFound
+		}
+		return nil, err
+	}
+	if gift.Status != models.GiftPaid {
+		return nil, repository_gifts.GiftNotRedeemable
+	}
+	if err = usecase.checkNotBlocked(gift.CreatorID, userID); err != nil {
+		return nil, err
+	}
+	if err = usecase.checkRecipient(gift, userID); err != nil {
+		return nil, err
+	}
+
+	if err = usecase.repository.Redeem(gift, userID); err != nil {
+		return nil, err
+	}
+
+	if errPush := usecase.pusher.GiftReceived(gift.ID); errPush != nil {
+		log.Errorf("Try push received gift, and got err %s", errPush)
+	}
+	return gift, nil
+}
+
+// GetUserGifts Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *GiftsUsecase) GetUserGifts(userID int64) ([]models.Gift, error) {
+	return usecase.repository.GetUserGifts(userID)
+}
+
+// checkRecipient gift can prolong subscription on the same award only
+// Errors:
+//		GiftToSelf
+//		RecipientSubscribed
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *GiftsUsecase) checkRecipient(gift *models.Gift, recipientID int64) error {
+	if recipientID == gift.PayerID {
+		return GiftToSelf
+	}
+
+	subscription, err := usecase.repoSubscr.GetActive(recipientID, gift.CreatorID)
+	if err != nil {
+		if errors.Is(err, repository.NotFound) {
+			return nil
+		}
+		return err
+	}
+	if subscription.AwardID != gift.AwardID {
+		return RecipientSubscribed
+	}
+	return nil
+}
+
+// checkNotBlocked user blocked by creator can not buy or receive gifts on creator
+// Errors:
+//		UserBlocked
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *GiftsUsecase) checkNotBlocked(creatorID int64, userID int64) error {
+	blocked, err := usecase.repoBlocked.IsBlocked(creatorID, userID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return UserBlocked
+	}
+	return nil
+}
@@ -0,0 +1,271 @@
+package usecase_gifts
+
+import (
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_gifts "patreon/internal/app/repository/gifts"
+	"patreon/internal/app/usecase"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type SuiteGiftsUsecase struct {
+	usecase.SuiteUsecase
+	clock *usecase.FakeClock
+	uc    Usecase
+}
+
+func (s *SuiteGiftsUsecase) SetupSuite() {
+	s.SuiteUsecase.SetupSuite()
+	s.clock = &usecase.FakeClock{Time: time.Date(2021, 12, 1, 12, 0, 0, 0, time.UTC)}
+	s.uc = NewGiftsUsecase(s.MockGiftsRepository, s.MockAwardsRepository, s.MockUserRepository,
+		s.MockSubscribersRepository, s.MockPayTokenRepository, s.MockBlockedRepository, s.MockPusher, s.clock)
+}
+
+func (s *SuiteGiftsUsecase) expectBlocked(creatorID int64, userID int64, blocked bool) {
+	s.MockBlockedRepository.EXPECT().
+		IsBlocked(creatorID, userID).
+		Times(1).
+		Return(blocked, nil)
+}
+
+func (s *SuiteGiftsUsecase) TestGiftsUsecase_Create_ByCode() {
+	award := models.TestAward()
+	gift := &models.Gift{PayerID: 2, CreatorID: award.CreatorId, AwardID: award.ID, Periods: 3}
+	s.MockAwardsRepository.EXPECT().
+		GetByID(award.ID).
+		Times(1).
+		Return(award, nil)
+	s.expectBlocked(award.CreatorId, gift.PayerID, false)
+	s.MockPayTokenRepository.EXPECT().
+		SetToken(gomock.Any(), int(giftTokenExp.Seconds())).
+		Times(1).
+		DoAndReturn(func(info *models.PayTokenInfo, _ int) error {
+			assert.Equal(s.T(), gift.PayerID, info.UserID)
+			assert.Equal(s.T(), models.NewDecimal(300), info.Price)
+			assert.Equal(s.T(), s.clock.Time.Add(giftTokenExp), info.ExpiresAt)
+			return nil
+		})
+	s.MockPayTokenRepository.EXPECT().
+		MarkUsed(gomock.Any(), int(giftTokenExp.Seconds())).
+		Times(1).
+		Return(true, nil)
+	s.MockGiftsRepository.EXPECT().
+		Create(gift).
+		Times(1).
+		Return(nil)
+	res, err := s.uc.Create(gift)
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), res.Code, giftCodeLen)
+	assert.NotEmpty(s.T(), res.PayToken)
+	assert.Equal(s.T(), models.NewDecimal(300), res.Amount)
+	assert.Equal(s.T(), int64(0), res.RecipientID)
+}
+
+func (s *SuiteGiftsUsecase) TestGiftsUsecase_Create_ForNickname() {
+	award := models.TestAward()
+	recipient := models.TestUser()
+	recipient.ID = 4
+	gift := &models.Gift{PayerID: 2, RecipientNickname: recipient.Nickname, CreatorID: award.CreatorId,
+		AwardID: award.ID, Periods: 1}
+	s.MockAwardsRepository.EXPECT().
+		GetByID(award.ID).
+		Times(1).
+		Return(award, nil)
+	s.expectBlocked(award.CreatorId, gift.PayerID, false)
+	s.MockUserRepository.EXPECT().
+		FindByNickname(recipient.Nickname).
+		Times(1).
+		Return(recipient, nil)
+	s.expectBlocked(award.CreatorId, recipient.ID, false)
+	s.MockSubscribersRepository.EXPECT().
+		GetActive(recipient.ID, award.CreatorId).
+		Times(1).
+		Return(&models.BillingSubscription{UserID: recipient.ID, CreatorID: award.CreatorId, AwardID: award.ID}, nil)
+	s.MockPayTokenRepository.EXPECT().
+		SetToken(gomock.Any(), int(giftTokenExp.Seconds())).
+		Times(1).
+		Return(nil)
+	s.MockPayTokenRepository.EXPECT().
+		MarkUsed(gomock.Any(), int(giftTokenExp.Seconds())).
+		Times(1).
+		Return(true, nil)
+	s.MockGiftsRepository.EXPECT().
+		Create(gift).
+		Times(1).
+		Return(nil)
+	res, err := s.uc.Create(gift)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), recipient.ID, res.RecipientID)
+}
+
+func (s *SuiteGiftsUsecase) TestGiftsUsecase_Create_Errors() {
+	award := models.TestAward()
+	gift := &models.Gift{PayerID: 2, CreatorID: award.CreatorId, AwardID: award.ID, Periods: 13}
+	_, err := s.uc.Create(gift)
+	assert.Equal(s.T(), models.IncorrectGiftPeriods, err)
+
+	gift = &models.Gift{PayerID: 2, CreatorID: award.CreatorId + 1, AwardID: award.ID, Periods: 1}
+	s.MockAwardsRepository.EXPECT().
+		GetByID(award.ID).
+		Times(1).
+		Return(award, nil)
+	_, err = s.uc.Create(gift)
+	assert.Equal(s.T(), AwardNotBelongCreator, err)
+
+	gift = &models.Gift{PayerID: 2, RecipientNickname: "nobody", CreatorID: award.CreatorId,
+		AwardID: award.ID, Periods: 1}
+	s.MockAwardsRepository.EXPECT().
+		GetByID(award.ID).
+		Times(1).
+		Return(award, nil)
+	s.expectBlocked(award.CreatorId, gift.PayerID, false)
+	s.MockUserRepository.EXPECT().
+		FindByNickname("nobody").
+		Times(1).
+		Return(nil, repository.NotFound)
+	_, err = s.uc.Create(gift)
+	assert.Equal(s.T(), RecipientNotFound, err)
+
+	payer := models.TestUser()
+	payer.ID = 2
+	gift = &models.Gift{PayerID: payer.ID, RecipientNickname: payer.Nickname, CreatorID: award.CreatorId,
+		AwardID: award.ID, Periods: 1}
+	s.MockAwardsRepository.EXPECT().
+		GetByID(award.ID).
+		Times(1).
+		Return(award, nil)
+	s.expectBlocked(award.CreatorId, gift.PayerID, false)
+	s.MockUserRepository.EXPECT().
+		FindByNickname(payer.Nickname).
+		Times(1).
+		Return(payer, nil)
+	s.expectBlocked(award.CreatorId, payer.ID, false)
+	_, err = s.uc.Create(gift)
+	assert.Equal(s.T(), GiftToSelf, err)
+}
+
+func (s *SuiteGiftsUsecase) TestGiftsUsecase_Create_RecipientSubscribed() {
+	award := models.TestAward()
+	recipient := models.TestUser()
+	recipient.ID = 4
+	gift := &models.Gift{PayerID: 2, RecipientNickname: recipient.Nickname, CreatorID: award.CreatorId,
+		AwardID: award.ID, Periods: 1}
+	s.MockAwardsRepository.EXPECT().
+		GetByID(award.ID).
+		Times(1).
+		Return(award, nil)
+	s.expectBlocked(award.CreatorId, gift.PayerID, false)
+	s.MockUserRepository.EXPECT().
+		FindByNickname(recipient.Nickname).
+		Times(1).
+		Return(recipient, nil)
+	s.expectBlocked(award.CreatorId, recipient.ID, false)
+	s.MockSubscribersRepository.EXPECT().
+		GetActive(recipient.ID, award.CreatorId).
+		Times(1).
+		Return(&models.BillingSubscription{UserID: recipient.ID, CreatorID: award.CreatorId, AwardID: award.ID + 1}, nil)
+	_, err := s.uc.Create(gift)
+	assert.Equal(s.T(), RecipientSubscribed, err)
+}
+
+func (s *SuiteGiftsUsecase) TestGiftsUsecase_Redeem_OK() {
+	gift := models.TestGift()
+	gift.Status = models.GiftPaid
+	userID := int64(4)
+	s.MockGiftsRepository.EXPECT().
+		GetByCode(gift.Code).
+		Times(1).
+		Return(gift, nil)
+	s.expectBlocked(gift.CreatorID, userID, false)
+	s.MockSubscribersRepository.EXPECT().
+		GetActive(userID, gift.CreatorID).
+		Times(1).
+		Return(nil, repository.NotFound)
+	s.MockGiftsRepository.EXPECT().
+		Redeem(gift, userID).
+		Times(1).
+		Return(nil)
+	s.MockPusher.EXPECT().
+		GiftReceived(gift.ID).
+		Times(1).
+		Return(nil)
+	res, err := s.uc.Redeem(s.Logger.WithField("test", true), "a1b2c3d4e5f6a7b8", userID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), gift, res)
+}
+
+func (s *SuiteGiftsUsecase) TestGiftsUsecase_Redeem_Errors() {
+	s.MockGiftsRepository.EXPECT().
+		GetByCode("OTHER").
+		Times(1).
+		Return(nil, repository.NotFound)
+	_, err := s.uc.Redeem(s.Logger.WithField("test", true), "other", 4)
+	assert.Equal(s.T(), GiftNotFound, err)
+
+	gift := models.TestGift()
+	s.MockGiftsRepository.EXPECT().
+		GetByCode(gift.Code).
+		Times(1).
+		Return(gift, nil)
+	_, err = s.uc.Redeem(s.Logger.WithField("test", true), gift.Code, 4)
+	assert.Equal(s.T(), repository_gifts.GiftNotRedeemable, err)
+
+	gift = models.TestGift()
+	gift.Status = models.GiftPaid
+	s.MockGiftsRepository.EXPECT().
+		GetByCode(gift.Code).
+		Times(1).
+		Return(gift, nil)
+	s.expectBlocked(gift.CreatorID, gift.PayerID, false)
+	_, err = s.uc.Redeem(s.Logger.WithField("test", true), gift.Code, gift.PayerID)
+	assert.Equal(s.T(), GiftToSelf, err)
+}
+
+func (s *SuiteGiftsUsecase) TestGiftsUsecase_Blocked() {
+	award := models.TestAward()
+	gift := &models.Gift{PayerID: 2, CreatorID: award.CreatorId, AwardID: award.ID, Periods: 1}
+	s.MockAwardsRepository.EXPECT().
+		GetByID(award.ID).
+		Times(1).
+		Return(award, nil)
+	s.expectBlocked(award.CreatorId, gift.PayerID, true)
+	_, err := s.uc.Create(gift)
+	assert.Equal(s.T(), UserBlocked, err)
+
+	recipient := models.TestUser()
+	recipient.ID = 4
+	gift = &models.Gift{PayerID: 2, RecipientNickname: recipient.Nickname, CreatorID: award.CreatorId,
+		AwardID: award.ID, Periods: 1}
+	s.MockAwardsRepository.EXPECT().
+		GetByID(award.ID).
+		Times(1).
+		Return(award, nil)
+	s.expectBlocked(award.CreatorId, gift.PayerID, false)
+	s.MockUserRepository.EXPECT().
+		FindByNickname(recipient.Nickname).
+		Times(1).
+		Return(recipient, nil)
+	s.expectBlocked(award.CreatorId, recipient.ID, true)
+	_, err = s.uc.Create(gift)
+	assert.Equal(s.T(), UserBlocked, err)
+
+	paid := models.TestGift()
+	paid.Status = models.GiftPaid
+	s.MockGiftsRepository.EXPECT().
+		GetByCode(paid.Code).
+		Times(1).
+		Return(paid, nil)
+	s.expectBlocked(paid.CreatorID, recipient.ID, true)
+	_, err = s.uc.Redeem(s.Logger.WithField("test", true), paid.Code, recipient.ID)
+	assert.Equal(s.T(), UserBlocked, err)
+}
+
+func TestGiftsUsecase(t *testing.T) {
+	suite.Run(t, new(SuiteGiftsUsecase))
+}
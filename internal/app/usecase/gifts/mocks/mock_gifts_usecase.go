@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: patreon/internal/app/usecase/gifts (interfaces: Usecase)
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	models "patreon/internal/app/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	logrus "github.com/sirupsen/logrus"
+)
+
+// GiftsUsecase is a mock of Usecase interface.
+type GiftsUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *GiftsUsecaseMockRecorder
+}
+
+// GiftsUsecaseMockRecorder is the mock recorder for GiftsUsecase.
+type GiftsUsecaseMockRecorder struct {
+	mock *GiftsUsecase
+}
+
+// NewGiftsUsecase creates a new mock instance.
+func NewGiftsUsecase(ctrl *gomock.Controller) *GiftsUsecase {
+	mock := &GiftsUsecase{ctrl: ctrl}
+	mock.recorder = &GiftsUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *GiftsUsecase) EXPECT() *GiftsUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *GiftsUsecase) Create(arg0 *models.Gift) (*models.Gift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(*models.Gift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *GiftsUsecaseMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*GiftsUsecase)(nil).Create), arg0)
+}
+
+// GetUserGifts mocks base method.
+func (m *GiftsUsecase) GetUserGifts(arg0 int64) ([]models.Gift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserGifts", arg0)
+	ret0, _ := ret[0].([]models.Gift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserGifts indicates an expected call of GetUserGifts.
+func (mr *GiftsUsecaseMockRecorder) GetUserGifts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserGifts", reflect.TypeOf((*GiftsUsecase)(nil).GetUserGifts), arg0)
+}
+
+// Redeem mocks base method.
+func (m *GiftsUsecase) Redeem(arg0 *logrus.Entry, arg1 string, arg2 int64) (*models.Gift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Gift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *GiftsUsecaseMockRecorder) Redeem(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*GiftsUsecase)(nil).Redeem), arg0, arg1, arg2)
+}
@@ -0,0 +1,40 @@
+package usecase_gifts
+
+import (
+	"patreon/internal/app/models"
+
+	"github.com/sirupsen/logrus"
+)
+
+//go:generate mockgen -destination=mocks/mock_gifts_usecase.go -package=mock_usecase -mock_names=Usecase=GiftsUsecase . Usecase
+
+type Usecase interface {
+	// Create Errors:
+	//		models.IncorrectGiftPeriods
+	//		AwardNotBelongCreator
+	//		UserBlocked
+	//		RecipientNotFound
+	//		GiftToSelf
+	//		RecipientSubscribed
+	//		repository.NotFound
+	//		repository_subscribers.AwardSoldOut
+	//		app.GeneralError with Errors:
+	//			app.UnknownError
+	//			repository.DefaultErrDB
+	//			repository_redis.SetError
+	Create(gift *models.Gift) (*models.Gift, error)
+	// Redeem Errors:
+	//		GiftNotFound
+	//		UserBlocked
+	//		GiftToSelf
+	//		RecipientSubscribed
+	//		repository_gifts.GiftNotRedeemable
+	//		repository_subscribers.AwardSoldOut
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	Redeem(log *logrus.Entry, code string, userID int64) (*models.Gift, error)
+	// GetUserGifts Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetUserGifts(userID int64) ([]models.Gift, error)
+}
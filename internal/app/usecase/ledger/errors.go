@@ -0,0 +1,9 @@
+package usecase_ledger
+
+import "github.com/pkg/errors"
+
+var (
+	InvalidPayoutAmount     = errors.New("payout amount must be positive")
+	InvalidPayoutTransition = errors.New("payout can not move to this state from current one")
+	NotPayoutAdmin          = errors.New("user is not allowed to manage payouts")
+)
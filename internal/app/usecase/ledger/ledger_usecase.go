@@ -0,0 +1,102 @@
+package usecase_ledger
+
+import (
+	"patreon/internal/app/models"
+	repository_ledger "patreon/internal/app/repository/ledger"
+)
+
+// payoutTransitions allowed moves of payout, money of rejected payout returns to creator balance
+var payoutTransitions = map[models.PayoutState][]models.PayoutState{
+	models.PayoutPending:  {models.PayoutApproved, models.PayoutRejected},
+	models.PayoutApproved: {models.PayoutPaid, models.PayoutRejected},
+	models.PayoutPaid:     {},
+	models.PayoutRejected: {},
+}
+
+type LedgerUsecase struct {
+	repository repository_ledger.Repository
+	admins     map[int64]bool
+}
+
+func NewLedgerUsecase(repository repository_ledger.Repository, payoutAdmins []int64) *LedgerUsecase {
+	admins := make(map[int64]bool, len(payoutAdmins))
+	for _, id := range payoutAdmins {
+		admins[id] = true
+	}
+	return &LedgerUsecase{
+		repository: repository,
+		admins:     admins,
+	}
+}
+
+// GetBalance Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *LedgerUsecase) GetBalance(creatorID int64) (*models.CreatorBalance, error) {
+	return usecase.repository.GetBalance(creatorID)
+}
+
+// GetEntries Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *LedgerUsecase) GetEntries(creatorID int64, pag *models.Pagination) ([]models.LedgerEntry, error) {
+	return usecase.repository.GetEntries(creatorID, pag)
+}
+
+// RequestPayout Errors:
+//		InvalidPayoutAmount
+//		repository_ledger.NotEnoughBalance
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *LedgerUsecase) RequestPayout(creatorID int64, amount models.Decimal) (*models.Payout, error) {
+	if amount <= 0 {
+		return nil, InvalidPayoutAmount
+	}
+	payout := &models.Payout{CreatorID: creatorID, Amount: amount}
+	if err := usecase.repository.CreatePayout(payout); err != nil {
+		return nil, err
+	}
+	return payout, nil
+}
+
+// GetPayouts Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *LedgerUsecase) GetPayouts(creatorID int64, pag *models.Pagination) ([]models.Payout, error) {
+	return usecase.repository.GetPayouts(creatorID, pag)
+}
+
+// ChangePayoutState Errors:
+//		NotPayoutAdmin
+//		InvalidPayoutTransition
+//		repository.NotFound
+//		repository_ledger.PayoutStateChanged
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *LedgerUsecase) ChangePayoutState(userID int64, payoutID int64, to models.PayoutState) (*models.Payout, error) {
+	if !usecase.admins[userID] {
+		return nil, NotPayoutAdmin
+	}
+	payout, err := usecase.repository.GetPayout(payoutID)
+	if err != nil {
+		return nil, err
+	}
+	if !canTransit(payout.State, to) {
+		return nil, InvalidPayoutTransition
+	}
+	if err = usecase.repository.UpdatePayoutState(payout, to); err != nil {
+		return nil, err
+	}
+	return payout, nil
+}
+
+func canTransit(from models.PayoutState, to models.PayoutState) bool {
+	for _, state := range payoutTransitions[from] {
+		if state == to {
+			return true
+		}
+	}
+	return false
+}
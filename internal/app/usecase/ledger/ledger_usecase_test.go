@@ -0,0 +1,101 @@
+package usecase_ledger
+
+import (
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_ledger "patreon/internal/app/repository/ledger"
+	"patreon/internal/app/usecase"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type SuiteLedgerUsecase struct {
+	usecase.SuiteUsecase
+	uc Usecase
+}
+
+func (s *SuiteLedgerUsecase) SetupSuite() {
+	s.SuiteUsecase.SetupSuite()
+	s.uc = NewLedgerUsecase(s.MockLedgerRepository, []int64{100})
+}
+
+func (s *SuiteLedgerUsecase) TestLedgerUsecase_RequestPayout_OK() {
+	s.MockLedgerRepository.EXPECT().
+		CreatePayout(&models.Payout{CreatorID: 1, Amount: 300}).
+		Times(1).
+		DoAndReturn(func(payout *models.Payout) error {
+			payout.ID = 5
+			payout.State = models.PayoutPending
+			return nil
+		})
+	res, err := s.uc.RequestPayout(1, 300)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), &models.Payout{ID: 5, CreatorID: 1, Amount: 300, State: models.PayoutPending}, res)
+}
+
+func (s *SuiteLedgerUsecase) TestLedgerUsecase_RequestPayout_InvalidAmount() {
+	_, err := s.uc.RequestPayout(1, 0)
+	assert.Equal(s.T(), InvalidPayoutAmount, err)
+}
+
+func (s *SuiteLedgerUsecase) TestLedgerUsecase_RequestPayout_NotEnoughBalance() {
+	s.MockLedgerRepository.EXPECT().
+		CreatePayout(gomock.Any()).
+		Times(1).
+		Return(repository_ledger.NotEnoughBalance)
+	_, err := s.uc.RequestPayout(1, 300)
+	assert.Equal(s.T(), repository_ledger.NotEnoughBalance, err)
+}
+
+func (s *SuiteLedgerUsecase) TestLedgerUsecase_ChangePayoutState_OK() {
+	payout := &models.Payout{ID: 5, CreatorID: 1, Amount: 300, State: models.PayoutPending}
+	s.MockLedgerRepository.EXPECT().
+		GetPayout(payout.ID).
+		Times(1).
+		Return(payout, nil)
+	s.MockLedgerRepository.EXPECT().
+		UpdatePayoutState(payout, models.PayoutApproved).
+		Times(1).
+		Return(nil)
+	res, err := s.uc.ChangePayoutState(100, payout.ID, models.PayoutApproved)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), payout, res)
+}
+
+func (s *SuiteLedgerUsecase) TestLedgerUsecase_ChangePayoutState_NotAdmin() {
+	_, err := s.uc.ChangePayoutState(1, 5, models.PayoutApproved)
+	assert.Equal(s.T(), NotPayoutAdmin, err)
+}
+
+func (s *SuiteLedgerUsecase) TestLedgerUsecase_ChangePayoutState_InvalidTransition() {
+	payout := &models.Payout{ID: 5, CreatorID: 1, Amount: 300, State: models.PayoutPending}
+	s.MockLedgerRepository.EXPECT().
+		GetPayout(payout.ID).
+		Times(1).
+		Return(payout, nil)
+	_, err := s.uc.ChangePayoutState(100, payout.ID, models.PayoutPaid)
+	assert.Equal(s.T(), InvalidPayoutTransition, err)
+}
+
+func (s *SuiteLedgerUsecase) TestLedgerUsecase_ChangePayoutState_NotFound() {
+	s.MockLedgerRepository.EXPECT().
+		GetPayout(int64(5)).
+		Times(1).
+		Return(nil, repository.NotFound)
+	_, err := s.uc.ChangePayoutState(100, 5, models.PayoutPaid)
+	assert.Equal(s.T(), repository.NotFound, err)
+}
+
+func (s *SuiteLedgerUsecase) TestLedgerUsecase_CanTransit() {
+	assert.True(s.T(), canTransit(models.PayoutPending, models.PayoutRejected))
+	assert.True(s.T(), canTransit(models.PayoutApproved, models.PayoutPaid))
+	assert.False(s.T(), canTransit(models.PayoutPaid, models.PayoutRejected))
+	assert.False(s.T(), canTransit(models.PayoutRejected, models.PayoutApproved))
+}
+
+func TestUsecaseLedger(t *testing.T) {
+	suite.Run(t, new(SuiteLedgerUsecase))
+}
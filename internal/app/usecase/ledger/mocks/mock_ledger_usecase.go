@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: patreon/internal/app/usecase/ledger (interfaces: Usecase)
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	models "patreon/internal/app/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// LedgerUsecase is a mock of Usecase interface.
+type LedgerUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *LedgerUsecaseMockRecorder
+}
+
+// LedgerUsecaseMockRecorder is the mock recorder for LedgerUsecase.
+type LedgerUsecaseMockRecorder struct {
+	mock *LedgerUsecase
+}
+
+// NewLedgerUsecase creates a new mock instance.
+func NewLedgerUsecase(ctrl *gomock.Controller) *LedgerUsecase {
+	mock := &LedgerUsecase{ctrl: ctrl}
+	mock.recorder = &LedgerUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *LedgerUsecase) EXPECT() *LedgerUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ChangePayoutState mocks base method.
+func (m *LedgerUsecase) ChangePayoutState(arg0, arg1 int64, arg2 models.PayoutState) (*models.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePayoutState", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePayoutState indicates an expected call of ChangePayoutState.
+func (mr *LedgerUsecaseMockRecorder) ChangePayoutState(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePayoutState", reflect.TypeOf((*LedgerUsecase)(nil).ChangePayoutState), arg0, arg1, arg2)
+}
+
+// GetBalance mocks base method.
+func (m *LedgerUsecase) GetBalance(arg0 int64) (*models.CreatorBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", arg0)
+	ret0, _ := ret[0].(*models.CreatorBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *LedgerUsecaseMockRecorder) GetBalance(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*LedgerUsecase)(nil).GetBalance), arg0)
+}
+
+// GetEntries mocks base method.
+func (m *LedgerUsecase) GetEntries(arg0 int64, arg1 *models.Pagination) ([]models.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntries", arg0, arg1)
+	ret0, _ := ret[0].([]models.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntries indicates an expected call of GetEntries.
+func (mr *LedgerUsecaseMockRecorder) GetEntries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntries", reflect.TypeOf((*LedgerUsecase)(nil).GetEntries), arg0, arg1)
+}
+
+// GetPayouts mocks base method.
+func (m *LedgerUsecase) GetPayouts(arg0 int64, arg1 *models.Pagination) ([]models.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayouts", arg0, arg1)
+	ret0, _ := ret[0].([]models.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayouts indicates an expected call of GetPayouts.
+func (mr *LedgerUsecaseMockRecorder) GetPayouts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayouts", reflect.TypeOf((*LedgerUsecase)(nil).GetPayouts), arg0, arg1)
+}
+
+// RequestPayout mocks base method.
+func (m *LedgerUsecase) RequestPayout(arg0 int64, arg1 models.Decimal) (*models.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPayout", arg0, arg1)
+	ret0, _ := ret[0].(*models.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestPayout indicates an expected call of RequestPayout.
+func (mr *LedgerUsecaseMockRecorder) RequestPayout(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPayout", reflect.TypeOf((*LedgerUsecase)(nil).RequestPayout), arg0, arg1)
+}
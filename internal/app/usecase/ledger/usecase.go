@@ -0,0 +1,36 @@
+package usecase_ledger
+
+import "patreon/internal/app/models"
+
+//go:generate mockgen -destination=mocks/mock_ledger_usecase.go -package=mock_usecase -mock_names=Usecase=LedgerUsecase . Usecase
+
+type Usecase interface {
+	// GetBalance Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetBalance(creatorID int64) (*models.CreatorBalance, error)
+	// GetEntries Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetEntries(creatorID int64, pag *models.Pagination) ([]models.LedgerEntry, error)
+	// RequestPayout Errors:
+	//		InvalidPayoutAmount
+	//		repository_ledger.NotEnoughBalance
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	RequestPayout(creatorID int64, amount models.Decimal) (*models.Payout, error)
+	// GetPayouts Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetPayouts(creatorID int64, pag *models.Pagination) ([]models.Payout, error)
+	// ChangePayoutState Errors:
+	//		NotPayoutAdmin
+	//		InvalidPayoutTransition
+	//		repository.NotFound
+	//		repository_ledger.PayoutStateChanged
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	ChangePayoutState(userID int64, payoutID int64, to models.PayoutState) (*models.Payout, error)
+}
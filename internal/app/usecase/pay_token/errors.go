@@ -3,5 +3,14 @@ package usecase_pay_token
 import "github.com/pkg/errors"
 
 var (
-	InvalidUserToken = errors.New("this user was not given this token")
+	InvalidUserToken      = errors.New("this user was not given this token")
+	TokenNotForAward      = errors.New("this token was given for other award")
+	TokenAlreadyUsed      = errors.New("this token already used")
+	AwardNotBelongCreator = errors.New("award not belongs to creator")
+	AmountNotMatchToken   = errors.New("payment amount not equal price fixed in token")
+	CurrencyNotMatchToken = errors.New("payment currency not equal currency fixed in token")
+	PromoCodeNotFound     = errors.New("promo code not found")
+	PromoCodeNotActive    = errors.New("promo code is not active")
+	PromoCodeNotForAward  = errors.New("promo code can not be applied to this award")
+	PromoCodeAlreadyUsed  = errors.New("user already used this promo code")
 )
@@ -0,0 +1,137 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: patreon/internal/app/usecase/pay_token (interfaces: Usecase)
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	models "patreon/internal/app/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// PayTokenUsecase is a mock of Usecase interface.
+type PayTokenUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *PayTokenUsecaseMockRecorder
+}
+
+// PayTokenUsecaseMockRecorder is the mock recorder for PayTokenUsecase.
+type PayTokenUsecaseMockRecorder struct {
+	mock *PayTokenUsecase
+}
+
+// NewPayTokenUsecase creates a new mock instance.
+func NewPayTokenUsecase(ctrl *gomock.Controller) *PayTokenUsecase {
+	mock := &PayTokenUsecase{ctrl: ctrl}
+	mock.recorder = &PayTokenUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *PayTokenUsecase) EXPECT() *PayTokenUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CheckToken mocks base method.
+func (m *PayTokenUsecase) CheckToken(arg0 models.PayToken, arg1 models.Decimal, arg2 string) (*models.PayTokenInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.PayTokenInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckToken indicates an expected call of CheckToken.
+func (mr *PayTokenUsecaseMockRecorder) CheckToken(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckToken", reflect.TypeOf((*PayTokenUsecase)(nil).CheckToken), arg0, arg1, arg2)
+}
+
+// CloseToken mocks base method.
+func (m *PayTokenUsecase) CloseToken(arg0 *models.PayTokenInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseToken", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseToken indicates an expected call of CloseToken.
+func (mr *PayTokenUsecaseMockRecorder) CloseToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseToken", reflect.TypeOf((*PayTokenUsecase)(nil).CloseToken), arg0)
+}
+
+// GetAccount mocks base method.
+func (m *PayTokenUsecase) GetAccount() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *PayTokenUsecaseMockRecorder) GetAccount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*PayTokenUsecase)(nil).GetAccount))
+}
+
+// GetOutstandingTokens mocks base method.
+func (m *PayTokenUsecase) GetOutstandingTokens(arg0 int64) ([]models.PayTokenInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutstandingTokens", arg0)
+	ret0, _ := ret[0].([]models.PayTokenInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutstandingTokens indicates an expected call of GetOutstandingTokens.
+func (mr *PayTokenUsecaseMockRecorder) GetOutstandingTokens(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutstandingTokens", reflect.TypeOf((*PayTokenUsecase)(nil).GetOutstandingTokens), arg0)
+}
+
+// GetToken mocks base method.
+func (m *PayTokenUsecase) GetToken(arg0, arg1, arg2 int64, arg3 string) (models.PayToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetToken", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(models.PayToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetToken indicates an expected call of GetToken.
+func (mr *PayTokenUsecaseMockRecorder) GetToken(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetToken", reflect.TypeOf((*PayTokenUsecase)(nil).GetToken), arg0, arg1, arg2, arg3)
+}
+
+// ReleaseToken mocks base method.
+func (m *PayTokenUsecase) ReleaseToken(arg0 *models.PayTokenInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseToken", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseToken indicates an expected call of ReleaseToken.
+func (mr *PayTokenUsecaseMockRecorder) ReleaseToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseToken", reflect.TypeOf((*PayTokenUsecase)(nil).ReleaseToken), arg0)
+}
+
+// UseToken mocks base method.
+func (m *PayTokenUsecase) UseToken(arg0 models.PayToken, arg1 *models.Subscriber) (*models.PayTokenInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseToken", arg0, arg1)
+	ret0, _ := ret[0].(*models.PayTokenInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseToken indicates an expected call of UseToken.
+func (mr *PayTokenUsecaseMockRecorder) UseToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseToken", reflect.TypeOf((*PayTokenUsecase)(nil).UseToken), arg0, arg1)
+}
@@ -3,8 +3,12 @@ package usecase_pay_token
 import (
 	uuid "github.com/satori/go.uuid"
 	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_awards "patreon/internal/app/repository/awards"
 	"patreon/internal/app/repository/pay_token"
-	"strconv"
+	repository_promo_codes "patreon/internal/app/repository/promo_codes"
+	"patreon/pkg/utils"
+	"strings"
 	"time"
 )
 
@@ -14,64 +18,189 @@ const (
 
 type PayTokenUsecase struct {
 	repository    repository_pay_token.Repository
+	repoAwards    repository_awards.Repository
+	repoPromo     repository_promo_codes.Repository
+	clock         utils.Clock
 	accountNumber string
 }
 
-func NewPayTokenUsecase(repository repository_pay_token.Repository, accountNumber string) *PayTokenUsecase {
+func NewPayTokenUsecase(repository repository_pay_token.Repository, repoAwards repository_awards.Repository,
+	repoPromo repository_promo_codes.Repository, clock utils.Clock, accountNumber string) *PayTokenUsecase {
 	return &PayTokenUsecase{
 		repository:    repository,
+		repoAwards:    repoAwards,
+		repoPromo:     repoPromo,
+		clock:         clock,
 		accountNumber: accountNumber,
 	}
 }
 
-// GetToken with Errors:
+// GetToken issue token with snapshot of award price, discounted by promoCode if it is not empty
+// Errors:
+//		AwardNotBelongCreator
+//		PromoCodeNotFound
+//		PromoCodeNotActive
+//		PromoCodeNotForAward
+//		PromoCodeAlreadyUsed
+//		repository.NotFound
 //		app.GeneralError with Errors
+//			repository.DefaultErrDB
 //			repository_redis.SetError
-func (u *PayTokenUsecase) GetToken(userID int64) (models.PayToken, error) {
-	payToken := uuid.NewV4().String()
-	userIDtoStr := strconv.Itoa(int(userID))
-	err := u.repository.Set(payToken, userIDtoStr, int(timeExp.Seconds()))
-
+func (u *PayTokenUsecase) GetToken(userID int64, creatorID int64, awardID int64,
+	promoCode string) (models.PayToken, error) {
+	award, err := u.repoAwards.GetByID(awardID)
 	if err != nil {
 		return models.PayToken{}, err
 	}
+	if award.CreatorId != creatorID {
+		return models.PayToken{}, AwardNotBelongCreator
+	}
+
+	info := &models.PayTokenInfo{
+		Token:     uuid.NewV4().String(),
+		UserID:    userID,
+		CreatorID: creatorID,
+		AwardID:   awardID,
+		Price:     award.Price,
+		Currency:  award.Currency,
+		ExpiresAt: u.clock.Now().Add(timeExp),
+	}
+	if promoCode != "" {
+		if err = u.applyPromoCode(info, promoCode); err != nil {
+			return models.PayToken{}, err
+		}
+	}
+	if err = u.repository.SetToken(info, int(timeExp.Seconds())); err != nil {
+		return models.PayToken{}, err
+	}
 
-	return models.PayToken{Token: payToken}, nil
+	return models.PayToken{Token: info.Token}, nil
 }
 
-//	CheckToken with Errors:
-//	repository_redis.NotFound
-//	app.GeneralError with Errors
-//		repository_redis.InvalidStorageData
-func (u *PayTokenUsecase) CheckToken(token models.PayToken) (bool, error) {
-	_, err := u.repository.Get(token.Token)
+// applyPromoCode Errors:
+//		PromoCodeNotFound
+//		PromoCodeNotActive
+//		PromoCodeNotForAward
+//		PromoCodeAlreadyUsed
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (u *PayTokenUsecase) applyPromoCode(info *models.PayTokenInfo, promoCode string) error {
+	code, err := u.repoPromo.GetByCode(info.CreatorID, strings.ToUpper(promoCode))
+	if err != nil {
+		if err == repository.NotFound {
+			return PromoCodeNotFound
+		}
+		return err
+	}
+	if !code.IsValid(u.clock.Now()) {
+		return PromoCodeNotActive
+	}
+	if !code.AllowsAward(info.AwardID) {
+		return PromoCodeNotForAward
+	}
+
+	used, err := u.repoPromo.IsUsed(code.ID, info.UserID)
 	if err != nil {
-		return false, err
+		return err
 	}
-	return true, nil
+	if used {
+		return PromoCodeAlreadyUsed
+	}
+
+	info.PromoCodeID = code.ID
+	info.PromoCode = code.Code
+	info.Discount = code.DiscountFor(info.Price)
+	info.Price -= info.Discount
+	return nil
 }
 
-//	CheckTokenByUser with Errors:
+// CheckToken check that payment amount and currency are equal price fixed in token
+// Errors:
+//		AmountNotMatchToken
+//		CurrencyNotMatchToken
+//		repository_redis.NotFound
+//		app.GeneralError with Errors
+//			repository_redis.InvalidStorageData
+func (u *PayTokenUsecase) CheckToken(token models.PayToken, amount models.Decimal,
+	currency string) (*models.PayTokenInfo, error) {
+	info, err := u.repository.GetToken(token.Token)
+	if err != nil {
+		return nil, err
+	}
+	if info.Price != amount {
+		return nil, AmountNotMatchToken
+	}
+	if info.Currency != currency {
+		return nil, CurrencyNotMatchToken
+	}
+	return info, nil
+}
+
+// UseToken check that token was given to subscriber for this award and mark it as used,
+// so one token can create only one subscription
+// Errors:
 //		InvalidUserToken
+//		TokenNotForAward
+//		TokenAlreadyUsed
 //		repository_redis.NotFound
 //		app.GeneralError with Errors
 //			repository_redis.InvalidStorageData
-func (u *PayTokenUsecase) CheckTokenByUser(token models.PayToken, userID int64) error {
-	userTokenID, err := u.repository.Get(token.Token)
+//			repository_redis.SetError
+func (u *PayTokenUsecase) UseToken(token models.PayToken, subscriber *models.Subscriber) (*models.PayTokenInfo, error) {
+	info, err := u.repository.GetToken(token.Token)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if info.UserID != subscriber.UserID {
+		return nil, InvalidUserToken
 	}
-	userTokenIDToInt, err := strconv.Atoi(userTokenID)
+	if info.CreatorID != subscriber.CreatorID || info.AwardID != subscriber.AwardID {
+		return nil, TokenNotForAward
+	}
+
+	ttl := info.ExpiresAt.Sub(u.clock.Now())
+	if ttl < time.Second {
+		ttl = time.Second
+	}
+	marked, err := u.repository.MarkUsed(token.Token, int(ttl.Seconds()))
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if int64(userTokenIDToInt) != userID {
-		return InvalidUserToken
+	if !marked {
+		return nil, TokenAlreadyUsed
 	}
+	info.Used = true
+	return info, nil
+}
 
+// ReleaseToken return token used by failed subscription, so it can be used again
+// Errors:
+//		app.GeneralError with Errors
+//			repository_redis.SetError
+func (u *PayTokenUsecase) ReleaseToken(info *models.PayTokenInfo) error {
+	if err := u.repository.UnmarkUsed(info.Token); err != nil {
+		return err
+	}
+	info.Used = false
 	return nil
 }
 
+// CloseToken remove paid token from outstanding tokens of user
+// Errors:
+//		app.GeneralError with Errors
+//			repository_redis.SetError
+func (u *PayTokenUsecase) CloseToken(info *models.PayTokenInfo) error {
+	return u.repository.RemoveUserToken(info.UserID, info.Token)
+}
+
+// GetOutstandingTokens return not expired and not paid tokens of user
+// Errors:
+//		app.GeneralError with Errors
+//			repository_redis.InvalidStorageData
+func (u *PayTokenUsecase) GetOutstandingTokens(userID int64) ([]models.PayTokenInfo, error) {
+	return u.repository.GetUserTokens(userID, u.clock.Now())
+}
+
 func (u *PayTokenUsecase) GetAccount() string {
 	return u.accountNumber
 }
@@ -0,0 +1,301 @@
+package usecase_pay_token
+
+import (
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_redis "patreon/internal/app/repository/pay_token/redis"
+	"patreon/internal/app/usecase"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type SuitePayTokenUsecase struct {
+	usecase.SuiteUsecase
+	clock *usecase.FakeClock
+	uc    Usecase
+}
+
+func (s *SuitePayTokenUsecase) SetupSuite() {
+	s.SuiteUsecase.SetupSuite()
+	s.clock = &usecase.FakeClock{Time: time.Date(2021, 12, 1, 12, 0, 0, 0, time.UTC)}
+	s.uc = NewPayTokenUsecase(s.MockPayTokenRepository, s.MockAwardsRepository, s.MockPromoCodesRepository, s.clock,
+		"account")
+}
+
+func (s *SuitePayTokenUsecase) testInfo() *models.PayTokenInfo {
+	return &models.PayTokenInfo{
+		Token:     "pay_token",
+		UserID:    1,
+		CreatorID: 2,
+		AwardID:   3,
+		Price:     models.NewDecimal(100),
+		Currency:  models.DefaultCurrency,
+		ExpiresAt: s.clock.Now().Add(timeExp),
+	}
+}
+
+func (s *SuitePayTokenUsecase) TestPayTokenUsecase_GetToken_OK() {
+	award := models.TestAward()
+	award.ID = 3
+	award.CreatorId = 2
+	var stored *models.PayTokenInfo
+	s.MockAwardsRepository.EXPECT().
+		GetByID(award.ID).
+		Times(1).
+		Return(award, nil)
+	s.MockPayTokenRepository.EXPECT().
+		SetToken(gomock.Any(), int(timeExp.Seconds())).
+		Times(1).
+		DoAndReturn(func(info *models.PayTokenInfo, _ int) error {
+			stored = info
+			return nil
+		})
+	token, err := s.uc.GetToken(1, award.CreatorId, award.ID, "")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), stored.Token, token.Token)
+	assert.Equal(s.T(), &models.PayTokenInfo{
+		Token:     token.Token,
+		UserID:    1,
+		CreatorID: award.CreatorId,
+		AwardID:   award.ID,
+		Price:     award.Price,
+		Currency:  models.DefaultCurrency,
+		ExpiresAt: s.clock.Now().Add(timeExp),
+	}, stored)
+}
+
+func (s *SuitePayTokenUsecase) TestPayTokenUsecase_GetToken_AwardErrors() {
+	award := models.TestAward()
+	award.CreatorId = 2
+	s.MockAwardsRepository.EXPECT().
+		GetByID(award.ID).
+		Times(1).
+		Return(award, nil)
+	_, err := s.uc.GetToken(1, award.CreatorId+1, award.ID, "")
+	assert.Equal(s.T(), AwardNotBelongCreator, err)
+
+	s.MockAwardsRepository.EXPECT().
+		GetByID(award.ID).
+		Times(1).
+		Return(nil, repository.NotFound)
+	_, err = s.uc.GetToken(1, award.CreatorId, award.ID, "")
+	assert.Equal(s.T(), repository.NotFound, err)
+}
+
+func (s *SuitePayTokenUsecase) TestPayTokenUsecase_GetToken_PromoCode() {
+	award := models.TestAward()
+	award.ID = 3
+	award.CreatorId = 2
+	award.Price = models.NewDecimal(1000)
+	code := models.TestPromoCode()
+	code.ID = 7
+	code.CreatorID = award.CreatorId
+	code.ValidFrom = s.clock.Now().Add(-time.Hour)
+	var stored *models.PayTokenInfo
+	s.MockAwardsRepository.EXPECT().
+		GetByID(award.ID).
+		Times(1).
+		Return(award, nil)
+	s.MockPromoCodesRepository.EXPECT().
+		GetByCode(award.CreatorId, code.Code).
+		Times(1).
+		Return(code, nil)
+	s.MockPromoCodesRepository.EXPECT().
+		IsUsed(code.ID, int64(1)).
+		Times(1).
+		Return(false, nil)
+	s.MockPayTokenRepository.EXPECT().
+		SetToken(gomock.Any(), int(timeExp.Seconds())).
+		Times(1).
+		DoAndReturn(func(info *models.PayTokenInfo, _ int) error {
+			stored = info
+			return nil
+		})
+	_, err := s.uc.GetToken(1, award.CreatorId, award.ID, "gold50")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.NewDecimal(500), stored.Price)
+	assert.Equal(s.T(), models.NewDecimal(500), stored.Discount)
+	assert.Equal(s.T(), code.ID, stored.PromoCodeID)
+	assert.Equal(s.T(), code.Code, stored.PromoCode)
+}
+
+func (s *SuitePayTokenUsecase) TestPayTokenUsecase_GetToken_PromoCodeErrors() {
+	award := models.TestAward()
+	award.ID = 3
+	award.CreatorId = 2
+	code := models.TestPromoCode()
+	code.ID = 7
+	code.CreatorID = award.CreatorId
+	code.ValidFrom = s.clock.Now().Add(-time.Hour)
+
+	s.MockAwardsRepository.EXPECT().
+		GetByID(award.ID).
+		Times(1).
+		Return(award, nil)
+	s.MockPromoCodesRepository.EXPECT().
+		GetByCode(award.CreatorId, "UNKNOWN").
+		Times(1).
+		Return(nil, repository.NotFound)
+	_, err := s.uc.GetToken(1, award.CreatorId, award.ID, "unknown")
+	assert.Equal(s.T(), PromoCodeNotFound, err)
+
+	inactive := *code
+	inactive.Active = false
+	s.MockAwardsRepository.EXPECT().
+		GetByID(award.ID).
+		Times(1).
+		Return(award, nil)
+	s.MockPromoCodesRepository.EXPECT().
+		GetByCode(award.CreatorId, code.Code).
+		Times(1).
+		Return(&inactive, nil)
+	_, err = s.uc.GetToken(1, award.CreatorId, award.ID, code.Code)
+	assert.Equal(s.T(), PromoCodeNotActive, err)
+
+	otherAward := *code
+	otherAward.AwardIDs = []int64{award.ID + 1}
+	s.MockAwardsRepository.EXPECT().
+		GetByID(award.ID).
+		Times(1).
+		Return(award, nil)
+	s.MockPromoCodesRepository.EXPECT().
+		GetByCode(award.CreatorId, code.Code).
+		Times(1).
+		Return(&otherAward, nil)
+	_, err = s.uc.GetToken(1, award.CreatorId, award.ID, code.Code)
+	assert.Equal(s.T(), PromoCodeNotForAward, err)
+
+	s.MockAwardsRepository.EXPECT().
+		GetByID(award.ID).
+		Times(1).
+		Return(award, nil)
+	s.MockPromoCodesRepository.EXPECT().
+		GetByCode(award.CreatorId, code.Code).
+		Times(1).
+		Return(code, nil)
+	s.MockPromoCodesRepository.EXPECT().
+		IsUsed(code.ID, int64(1)).
+		Times(1).
+		Return(true, nil)
+	_, err = s.uc.GetToken(1, award.CreatorId, award.ID, code.Code)
+	assert.Equal(s.T(), PromoCodeAlreadyUsed, err)
+}
+
+func (s *SuitePayTokenUsecase) TestPayTokenUsecase_CheckToken() {
+	info := s.testInfo()
+	s.MockPayTokenRepository.EXPECT().
+		GetToken(info.Token).
+		Times(1).
+		Return(info, nil)
+	res, err := s.uc.CheckToken(models.PayToken{Token: info.Token}, models.NewDecimal(100), models.DefaultCurrency)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), info, res)
+
+	s.MockPayTokenRepository.EXPECT().
+		GetToken(info.Token).
+		Times(1).
+		Return(info, nil)
+	_, err = s.uc.CheckToken(models.PayToken{Token: info.Token}, models.NewDecimal(150), models.DefaultCurrency)
+	assert.Equal(s.T(), AmountNotMatchToken, err)
+
+	s.MockPayTokenRepository.EXPECT().
+		GetToken(info.Token).
+		Times(1).
+		Return(info, nil)
+	_, err = s.uc.CheckToken(models.PayToken{Token: info.Token}, models.NewDecimal(100), "USD")
+	assert.Equal(s.T(), CurrencyNotMatchToken, err)
+
+	s.MockPayTokenRepository.EXPECT().
+		GetToken(info.Token).
+		Times(1).
+		Return(nil, repository_redis.NotFound)
+	_, err = s.uc.CheckToken(models.PayToken{Token: info.Token}, models.NewDecimal(100), models.DefaultCurrency)
+	assert.Equal(s.T(), repository_redis.NotFound, err)
+}
+
+func (s *SuitePayTokenUsecase) TestPayTokenUsecase_UseToken_OK() {
+	info := s.testInfo()
+	subscriber := &models.Subscriber{UserID: info.UserID, CreatorID: info.CreatorID, AwardID: info.AwardID}
+	s.MockPayTokenRepository.EXPECT().
+		GetToken(info.Token).
+		Times(1).
+		Return(info, nil)
+	s.MockPayTokenRepository.EXPECT().
+		MarkUsed(info.Token, int(timeExp.Seconds())).
+		Times(1).
+		Return(true, nil)
+	res, err := s.uc.UseToken(models.PayToken{Token: info.Token}, subscriber)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), res.Used)
+	assert.Equal(s.T(), info.Price, res.Price)
+}
+
+func (s *SuitePayTokenUsecase) TestPayTokenUsecase_UseToken_Errors() {
+	info := s.testInfo()
+	subscriber := &models.Subscriber{UserID: info.UserID, CreatorID: info.CreatorID, AwardID: info.AwardID}
+
+	s.MockPayTokenRepository.EXPECT().
+		GetToken(info.Token).
+		Times(1).
+		Return(info, nil)
+	s.MockPayTokenRepository.EXPECT().
+		MarkUsed(info.Token, gomock.Any()).
+		Times(1).
+		Return(false, nil)
+	_, err := s.uc.UseToken(models.PayToken{Token: info.Token}, subscriber)
+	assert.Equal(s.T(), TokenAlreadyUsed, err)
+
+	s.MockPayTokenRepository.EXPECT().
+		GetToken(info.Token).
+		Times(1).
+		Return(info, nil)
+	_, err = s.uc.UseToken(models.PayToken{Token: info.Token},
+		&models.Subscriber{UserID: info.UserID + 1, CreatorID: info.CreatorID, AwardID: info.AwardID})
+	assert.Equal(s.T(), InvalidUserToken, err)
+
+	s.MockPayTokenRepository.EXPECT().
+		GetToken(info.Token).
+		Times(1).
+		Return(info, nil)
+	_, err = s.uc.UseToken(models.PayToken{Token: info.Token},
+		&models.Subscriber{UserID: info.UserID, CreatorID: info.CreatorID, AwardID: info.AwardID + 1})
+	assert.Equal(s.T(), TokenNotForAward, err)
+}
+
+func (s *SuitePayTokenUsecase) TestPayTokenUsecase_ReleaseToken() {
+	info := s.testInfo()
+	info.Used = true
+	s.MockPayTokenRepository.EXPECT().
+		UnmarkUsed(info.Token).
+		Times(1).
+		Return(nil)
+	err := s.uc.ReleaseToken(info)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), info.Used)
+}
+
+func (s *SuitePayTokenUsecase) TestPayTokenUsecase_Outstanding() {
+	info := s.testInfo()
+	s.MockPayTokenRepository.EXPECT().
+		GetUserTokens(info.UserID, s.clock.Now()).
+		Times(1).
+		Return([]models.PayTokenInfo{*info}, nil)
+	res, err := s.uc.GetOutstandingTokens(info.UserID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []models.PayTokenInfo{*info}, res)
+
+	s.MockPayTokenRepository.EXPECT().
+		RemoveUserToken(info.UserID, info.Token).
+		Times(1).
+		Return(nil)
+	assert.NoError(s.T(), s.uc.CloseToken(info))
+}
+
+func TestUsecasePayToken(t *testing.T) {
+	suite.Run(t, new(SuitePayTokenUsecase))
+}
@@ -6,20 +6,44 @@ import "patreon/internal/app/models"
 
 type Usecase interface {
 	// GetToken with Errors:
+	//		AwardNotBelongCreator
+	//		PromoCodeNotFound
+	//		PromoCodeNotActive
+	//		PromoCodeNotForAward
+	//		PromoCodeAlreadyUsed
+	//		repository.NotFound
 	//		app.GeneralError with Errors
+	//			repository.DefaultErrDB
 	//			repository_redis.SetError
-	GetToken(userID int64) (models.PayToken, error)
+	GetToken(userID int64, creatorID int64, awardID int64, promoCode string) (models.PayToken, error)
 	//	CheckToken with Errors:
-	//	repository_redis.NotFound
-	//	app.GeneralError with Errors
-	//		repository_redis.InvalidStorageData
-	CheckToken(token models.PayToken) (bool, error)
-	//	CheckTokenByUser with Errors:
+	//		AmountNotMatchToken
+	//		CurrencyNotMatchToken
+	//		repository_redis.NotFound
+	//		app.GeneralError with Errors
+	//			repository_redis.InvalidStorageData
+	CheckToken(token models.PayToken, amount models.Decimal, currency string) (*models.PayTokenInfo, error)
+	//	UseToken with Errors:
 	//		InvalidUserToken
+	//		TokenNotForAward
+	//		TokenAlreadyUsed
 	//		repository_redis.NotFound
 	//		app.GeneralError with Errors
 	//			repository_redis.InvalidStorageData
-	CheckTokenByUser(token models.PayToken, userID int64) error
+	//			repository_redis.SetError
+	UseToken(token models.PayToken, subscriber *models.Subscriber) (*models.PayTokenInfo, error)
+	//	ReleaseToken with Errors:
+	//		app.GeneralError with Errors
+	//			repository_redis.SetError
+	ReleaseToken(info *models.PayTokenInfo) error
+	//	CloseToken with Errors:
+	//		app.GeneralError with Errors
+	//			repository_redis.SetError
+	CloseToken(info *models.PayTokenInfo) error
+	//	GetOutstandingTokens with Errors:
+	//		app.GeneralError with Errors
+	//			repository_redis.InvalidStorageData
+	GetOutstandingTokens(userID int64) ([]models.PayTokenInfo, error)
 
 	GetAccount() string
 }
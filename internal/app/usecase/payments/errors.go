@@ -0,0 +1,11 @@
+package payments
+
+import "errors"
+
+var (
+	NotificationAlreadyProcessed = errors.New("notification with this operation_id already processed")
+	PaymentNotBelongUser         = errors.New("payment with this token belongs to other user")
+	PaymentAlreadyPaid           = errors.New("payment with this token already paid")
+	InvalidStateTransition       = errors.New("payment can not move to this state from current one")
+	InvalidRefundAmount          = errors.New("refund amount must be positive and not greater than not refunded amount")
+)
@@ -5,10 +5,13 @@
 package mock_usecase
 
 import (
+	url "net/url"
 	models "patreon/internal/app/models"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
+	logrus "github.com/sirupsen/logrus"
 )
 
 // PaymentsUsecase is a mock of Usecase interface.
@@ -34,32 +37,162 @@ func (m *PaymentsUsecase) EXPECT() *PaymentsUsecaseMockRecorder {
 	return m.recorder
 }
 
+// ChangeState mocks base method.
+func (m *PaymentsUsecase) ChangeState(arg0 string, arg1 models.PaymentState, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeState", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeState indicates an expected call of ChangeState.
+func (mr *PaymentsUsecaseMockRecorder) ChangeState(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeState", reflect.TypeOf((*PaymentsUsecase)(nil).ChangeState), arg0, arg1, arg2)
+}
+
+// CreateCheckout mocks base method.
+func (m *PaymentsUsecase) CreateCheckout(arg0 int64, arg1 string) (*models.CheckoutInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCheckout", arg0, arg1)
+	ret0, _ := ret[0].(*models.CheckoutInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCheckout indicates an expected call of CreateCheckout.
+func (mr *PaymentsUsecaseMockRecorder) CreateCheckout(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCheckout", reflect.TypeOf((*PaymentsUsecase)(nil).CreateCheckout), arg0, arg1)
+}
+
+// ExpireCheckouts mocks base method.
+func (m *PaymentsUsecase) ExpireCheckouts(arg0 *logrus.Entry, arg1 time.Time, arg2 bool) (*models.CheckoutsSweep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireCheckouts", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.CheckoutsSweep)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireCheckouts indicates an expected call of ExpireCheckouts.
+func (mr *PaymentsUsecaseMockRecorder) ExpireCheckouts(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireCheckouts", reflect.TypeOf((*PaymentsUsecase)(nil).ExpireCheckouts), arg0, arg1, arg2)
+}
+
+// ExportCreatorPayments mocks base method.
+func (m *PaymentsUsecase) ExportCreatorPayments(arg0 int64, arg1 *models.PaymentsFilter, arg2 func(*models.CreatorPayments) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportCreatorPayments", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportCreatorPayments indicates an expected call of ExportCreatorPayments.
+func (mr *PaymentsUsecaseMockRecorder) ExportCreatorPayments(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCreatorPayments", reflect.TypeOf((*PaymentsUsecase)(nil).ExportCreatorPayments), arg0, arg1, arg2)
+}
+
+// GetCreatorMonthlyTotals mocks base method.
+func (m *PaymentsUsecase) GetCreatorMonthlyTotals(arg0 int64, arg1 *models.PaymentsFilter) ([]models.PaymentsMonthTotal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCreatorMonthlyTotals", arg0, arg1)
+	ret0, _ := ret[0].([]models.PaymentsMonthTotal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCreatorMonthlyTotals indicates an expected call of GetCreatorMonthlyTotals.
+func (mr *PaymentsUsecaseMockRecorder) GetCreatorMonthlyTotals(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCreatorMonthlyTotals", reflect.TypeOf((*PaymentsUsecase)(nil).GetCreatorMonthlyTotals), arg0, arg1)
+}
+
 // GetCreatorPayments mocks base method.
-func (m *PaymentsUsecase) GetCreatorPayments(arg0 int64, arg1 *models.Pagination) ([]models.CreatorPayments, error) {
+func (m *PaymentsUsecase) GetCreatorPayments(arg0 int64, arg1 *models.Pagination, arg2 *models.PaymentsFilter) ([]models.CreatorPayments, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCreatorPayments", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetCreatorPayments", arg0, arg1, arg2)
 	ret0, _ := ret[0].([]models.CreatorPayments)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCreatorPayments indicates an expected call of GetCreatorPayments.
-func (mr *PaymentsUsecaseMockRecorder) GetCreatorPayments(arg0, arg1 interface{}) *gomock.Call {
+func (mr *PaymentsUsecaseMockRecorder) GetCreatorPayments(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCreatorPayments", reflect.TypeOf((*PaymentsUsecase)(nil).GetCreatorPayments), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCreatorPayments", reflect.TypeOf((*PaymentsUsecase)(nil).GetCreatorPayments), arg0, arg1, arg2)
 }
 
 // GetUserPayments mocks base method.
-func (m *PaymentsUsecase) GetUserPayments(arg0 int64, arg1 *models.Pagination) ([]models.UserPayments, error) {
+func (m *PaymentsUsecase) GetUserPayments(arg0 int64, arg1 *models.Pagination, arg2 *models.PaymentsFilter) ([]models.UserPayments, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserPayments", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetUserPayments", arg0, arg1, arg2)
 	ret0, _ := ret[0].([]models.UserPayments)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserPayments indicates an expected call of GetUserPayments.
-func (mr *PaymentsUsecaseMockRecorder) GetUserPayments(arg0, arg1 interface{}) *gomock.Call {
+func (mr *PaymentsUsecaseMockRecorder) GetUserPayments(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPayments", reflect.TypeOf((*PaymentsUsecase)(nil).GetUserPayments), arg0, arg1, arg2)
+}
+
+// ParseNotification mocks base method.
+func (m *PaymentsUsecase) ParseNotification(arg0 url.Values) (*models.PaymentNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseNotification", arg0)
+	ret0, _ := ret[0].(*models.PaymentNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseNotification indicates an expected call of ParseNotification.
+func (mr *PaymentsUsecaseMockRecorder) ParseNotification(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseNotification", reflect.TypeOf((*PaymentsUsecase)(nil).ParseNotification), arg0)
+}
+
+// Refund mocks base method.
+func (m *PaymentsUsecase) Refund(arg0 string, arg1 models.Decimal, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *PaymentsUsecaseMockRecorder) Refund(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*PaymentsUsecase)(nil).Refund), arg0, arg1, arg2)
+}
+
+// RefundCreatorPayment mocks base method.
+func (m *PaymentsUsecase) RefundCreatorPayment(arg0, arg1 int64, arg2 models.Decimal, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundCreatorPayment", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefundCreatorPayment indicates an expected call of RefundCreatorPayment.
+func (mr *PaymentsUsecaseMockRecorder) RefundCreatorPayment(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundCreatorPayment", reflect.TypeOf((*PaymentsUsecase)(nil).RefundCreatorPayment), arg0, arg1, arg2, arg3)
+}
+
+// UpdateStatus mocks base method.
+func (m *PaymentsUsecase) UpdateStatus(arg0 *logrus.Entry, arg1 *models.PaymentNotification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *PaymentsUsecaseMockRecorder) UpdateStatus(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPayments", reflect.TypeOf((*PaymentsUsecase)(nil).GetUserPayments), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*PaymentsUsecase)(nil).UpdateStatus), arg0, arg1)
 }
@@ -1,22 +1,44 @@
 package payments
 
 import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"patreon/internal/app/models"
 	db_models "patreon/internal/app/models"
+	"patreon/internal/app/payment_provider"
+	"patreon/internal/app/repository"
 	repository_payments "patreon/internal/app/repository/payments"
+	repository_subscription_events "patreon/internal/app/repository/subscription_events"
 	push_client "patreon/internal/microservices/push/delivery/client"
 )
 
+const (
+	checkoutDescription       = "Subscription payment"
+	expireCheckoutReason      = "checkout abandoned"
+	cancelledTierChangeReason = "paid tier change was cancelled"
+)
+
 type PaymentsUsecase struct {
 	repository repository_payments.Repository
 	pusher     push_client.Pusher
+	provider   payment_provider.PaymentProvider
+	repoEvents repository_subscription_events.Repository
+	feePercent int64
 }
 
-func NewPaymentsUsecase(repo repository_payments.Repository, pusher push_client.Pusher) *PaymentsUsecase {
+func NewPaymentsUsecase(repo repository_payments.Repository, pusher push_client.Pusher,
+	provider payment_provider.PaymentProvider, repoEvents repository_subscription_events.Repository,
+	feePercent int) *PaymentsUsecase {
 	return &PaymentsUsecase{
 		repository: repo,
 		pusher:     pusher,
+		provider:   provider,
+		repoEvents: repoEvents,
+		feePercent: int64(feePercent),
 	}
 }
 
@@ -24,8 +46,9 @@ func NewPaymentsUsecase(repo repository_payments.Repository, pusher push_client.
 //		repository.NotFound
 //		app.GeneralError with Errors:
 //			repository.DefaultErrDB
-func (usecase *PaymentsUsecase) GetUserPayments(userID int64, pag *db_models.Pagination) ([]models.UserPayments, error) {
-	userPayments, err := usecase.repository.GetUserPayments(userID, pag)
+func (usecase *PaymentsUsecase) GetUserPayments(userID int64, pag *db_models.Pagination,
+	filter *models.PaymentsFilter) ([]models.UserPayments, error) {
+	userPayments, err := usecase.repository.GetUserPayments(userID, pag, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -37,8 +60,9 @@ func (usecase *PaymentsUsecase) GetUserPayments(userID int64, pag *db_models.Pag
 //		repository.NotFound
 //		app.GeneralError with Errors:
 //			repository.DefaultErrDB
-func (usecase *PaymentsUsecase) GetCreatorPayments(creatorID int64, pag *db_models.Pagination) ([]models.CreatorPayments, error) {
-	creatorPayments, err := usecase.repository.GetCreatorPayments(creatorID, pag)
+func (usecase *PaymentsUsecase) GetCreatorPayments(creatorID int64, pag *db_models.Pagination,
+	filter *models.PaymentsFilter) ([]models.CreatorPayments, error) {
+	creatorPayments, err := usecase.repository.GetCreatorPayments(creatorID, pag, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -46,13 +70,108 @@ func (usecase *PaymentsUsecase) GetCreatorPayments(creatorID int64, pag *db_mode
 	return creatorPayments, nil
 }
 
-// UpdateStatus Errors:
+// ExportCreatorPayments call handle for each paid creator payment from filter, the last first.
+// Error of handle stops export and is returned
+// Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *PaymentsUsecase) ExportCreatorPayments(creatorID int64, filter *models.PaymentsFilter,
+	handle func(payment *models.CreatorPayments) error) error {
+	return usecase.repository.ExportCreatorPayments(creatorID, filter, handle)
+}
+
+// GetCreatorMonthlyTotals return sums of paid creator payments from filter grouped by months and awards
+// Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *PaymentsUsecase) GetCreatorMonthlyTotals(creatorID int64,
+	filter *models.PaymentsFilter) ([]models.PaymentsMonthTotal, error) {
+	return usecase.repository.GetCreatorMonthlyTotals(creatorID, filter)
+}
+
+// CreateCheckout create checkout on payment provider and move payment to pending state
+// Errors:
+//		PaymentNotBelongUser
+//		PaymentAlreadyPaid
+//		InvalidStateTransition
+//		repository.NotFound
+//		repository_payments.PaymentStateChanged
+//		payment_provider.InvalidCheckout
+//		payment_provider.UnsupportedCurrency
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *PaymentsUsecase) CreateCheckout(userID int64, token string) (*models.CheckoutInfo, error) {
+	payment, err := usecase.repository.GetPaymentByToken(token)
+	if err != nil {
+		return nil, err
+	}
+	if payment.UserID != userID {
+		return nil, PaymentNotBelongUser
+	}
+	if payment.State.IsPaid() {
+		return nil, PaymentAlreadyPaid
+	}
+	if payment.State != models.PaymentPending && !canTransit(payment.State, models.PaymentPending) {
+		return nil, InvalidStateTransition
+	}
+
+	checkout, err := usecase.provider.CreateCheckout(&models.Checkout{
+		Token:       token,
+		Amount:      payment.Amount,
+		Currency:    payment.Currency,
+		Description: checkoutDescription,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payment.State != models.PaymentPending {
+		err = usecase.repository.ChangeState(token, &models.PaymentEvent{
+			FromState: payment.State,
+			ToState:   models.PaymentPending,
+			Reason:    fmt.Sprintf("checkout created by %s", checkout.Provider),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return checkout, nil
+}
+
+// ParseNotification Errors:
+//		payment_provider.InvalidNotification
+//		payment_provider.InvalidNotificationHash
+//		payment_provider.ProtectedPaymentNotSupported
+//		payment_provider.UnacceptedPayment
+func (usecase *PaymentsUsecase) ParseNotification(form url.Values) (*models.PaymentNotification, error) {
+	return usecase.provider.ParseNotification(form)
+}
+
+// UpdateStatus move payment to succeeded state by notification from payment provider
+// and credit creator balance minus platform fee, payment with not equal amount or currency is marked as failed.
+// Event of subscription changed by payment is added to subscription history,
+// payment of tier change cancelled before it was paid is refunded
+// Errors:
+//		NotificationAlreadyProcessed
+//		InvalidStateTransition
 //		repository_payments.NotEqualPaymentAmount
+//		repository_payments.NotEqualPaymentCurrency
+//		repository.NotFound
 //		repository_payments.CountPaymentsByTokenError
+//		repository_payments.PaymentStateChanged
 //		app.GeneralError with Errors:
 //			repository.DefaultErrDB
-func (usecase *PaymentsUsecase) UpdateStatus(log *logrus.Entry, token string, recieveAmount float64) error {
-	err := usecase.repository.CheckCountPaymentsByToken(token)
+func (usecase *PaymentsUsecase) UpdateStatus(log *logrus.Entry, notification *models.PaymentNotification) error {
+	processed, err := usecase.repository.CheckOperationProcessed(notification.OperationID)
+	if err != nil {
+		return err
+	}
+	if processed {
+		return NotificationAlreadyProcessed
+	}
+
+	token := notification.Token
+	err = usecase.repository.CheckCountPaymentsByToken(token)
 	if err != nil {
 		return err
 	}
@@ -60,14 +179,272 @@ func (usecase *PaymentsUsecase) UpdateStatus(log *logrus.Entry, token string, re
 	if err != nil {
 		return err
 	}
-	if res.Amount != recieveAmount {
-		return repository_payments.NotEqualPaymentAmount
+	var reason string
+	var errNotEqual error
+	switch {
+	case res.Amount != notification.Amount:
+		reason = fmt.Sprintf("operation %s: received amount %s, expected %s",
+			notification.OperationID, notification.Amount, res.Amount)
+		errNotEqual = repository_payments.NotEqualPaymentAmount
+	case res.Currency != notification.Currency:
+		reason = fmt.Sprintf("operation %s: received currency %s, expected %s",
+			notification.OperationID, notification.Currency, res.Currency)
+		errNotEqual = repository_payments.NotEqualPaymentCurrency
+	}
+	if errNotEqual != nil {
+		if canTransit(res.State, models.PaymentFailed) {
+			errFail := usecase.repository.ChangeState(token, &models.PaymentEvent{
+				FromState: res.State,
+				ToState:   models.PaymentFailed,
+				Reason:    reason,
+			})
+			if errFail != nil {
+				log.Errorf("Try mark payment as failed, and got err %s", errFail)
+			}
+		}
+		return errNotEqual
+	}
+	if !canTransit(res.State, models.PaymentSucceeded) {
+		return InvalidStateTransition
+	}
+
+	fee := res.Amount.Percent(usecase.feePercent)
+	subscrEvent, err := usecase.repository.UpdateStatus(token, notification.OperationID, &models.PaymentEvent{
+		FromState: res.State,
+		ToState:   models.PaymentSucceeded,
+		Reason:    fmt.Sprintf("payment notification, operation %s", notification.OperationID),
+	}, fee)
+	if errors.Is(err, repository_payments.OperationAlreadyProcessed) {
+		return NotificationAlreadyProcessed
+	}
+	// user must not pay for upgrade which was not applied, notification itself is processed
+	if errors.Is(err, repository_payments.TierChangeCancelled) {
+		res.Token = token
+		res.State = models.PaymentSucceeded
+		if errRefund := usecase.refund(&res, res.Amount, cancelledTierChangeReason); errRefund != nil {
+			log.Errorf("Try refund payment of cancelled tier change, and got err %s", errRefund)
+		}
+		return nil
+	}
+	// concurrent notification with the same operation could apply payment after check above
+	if errors.Is(err, repository_payments.PaymentStateChanged) {
+		processed, errCheck := usecase.repository.CheckOperationProcessed(notification.OperationID)
+		if errCheck == nil && processed {
+			return NotificationAlreadyProcessed
+		}
+	}
+	if err != nil {
+		return err
 	}
 
+	// push only after payment is applied, so user is not notified about payment which was not applied
 	errPush := usecase.pusher.ApplyPayments(token)
 	if errPush != nil {
 		log.Errorf("Try push new post, and got err %s", errPush)
 	}
 
-	return usecase.repository.UpdateStatus(token)
+	// payment is already applied, so notification must not fail because of history
+	if subscrEvent != nil {
+		if errEvent := usecase.repoEvents.Add(subscrEvent); errEvent != nil {
+			log.Errorf("Try add %s subscription event, and got err %s", subscrEvent.Kind, errEvent)
+		}
+	}
+
+	if res.GiftID != 0 {
+		if errPush := usecase.pusher.GiftReceived(res.GiftID); errPush != nil {
+			log.Errorf("Try push received gift, and got err %s", errPush)
+		}
+	}
+	if res.TipID != 0 {
+		if errPush := usecase.pusher.TipReceived(res.TipID); errPush != nil {
+			log.Errorf("Try push received tip, and got err %s", errPush)
+		}
+	}
+	return nil
+}
+
+// ChangeState Errors:
+//		InvalidStateTransition
+//		repository.NotFound
+//		repository_payments.PaymentStateChanged
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *PaymentsUsecase) ChangeState(token string, to models.PaymentState, reason string) error {
+	payment, err := usecase.repository.GetPaymentByToken(token)
+	if err != nil {
+		return err
+	}
+	// succeeded and refund states must be reached only by UpdateStatus and Refund, as they move money
+	if to == models.PaymentSucceeded || to == models.PaymentRefunded || to == models.PaymentPartiallyRefunded ||
+		!canTransit(payment.State, to) {
+		return InvalidStateTransition
+	}
+
+	return usecase.repository.ChangeState(token, &models.PaymentEvent{
+		FromState: payment.State,
+		ToState:   to,
+		Reason:    reason,
+	})
+}
+
+// Refund return amount of paid payment to user, payment becomes refunded when whole amount is returned.
+// Money is returned by payment provider first and refund is saved only if provider succeeded.
+// Refund of subscription payment is added to subscription history with reason
+// Errors:
+//		InvalidRefundAmount
+//		InvalidStateTransition
+//		repository.NotFound
+//		repository_payments.PaymentStateChanged
+//		payment_provider.NotSupported
+//		payment_provider.PaymentNotFound
+//		payment_provider.InvalidRefund
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+//			payment_provider.ProviderError
+func (usecase *PaymentsUsecase) Refund(token string, amount models.Decimal, reason string) error {
+	payment, err := usecase.repository.GetPaymentByToken(token)
+	if err != nil {
+		return err
+	}
+	payment.Token = token
+	return usecase.refund(&payment, amount, reason)
+}
+
+// RefundCreatorPayment refund payment with paymentID received by creator, as Refund does
+// Errors:
+//		InvalidRefundAmount
+//		InvalidStateTransition
+//		repository.NotFound
+//		repository_payments.PaymentStateChanged
+//		payment_provider.NotSupported
+//		payment_provider.PaymentNotFound
+//		payment_provider.InvalidRefund
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+//			payment_provider.ProviderError
+func (usecase *PaymentsUsecase) RefundCreatorPayment(creatorID int64, paymentID int64, amount models.Decimal,
+	reason string) error {
+	payment, err := usecase.repository.GetPaymentByID(paymentID)
+	if err != nil {
+		return err
+	}
+	// payment of other creator is not shown
+	if payment.CreatorID != creatorID {
+		return repository.NotFound
+	}
+	return usecase.refund(&payment, amount, reason)
+}
+
+// refund Errors:
+//		InvalidRefundAmount
+//		InvalidStateTransition
+//		repository_payments.PaymentStateChanged
+//		payment_provider.NotSupported
+//		payment_provider.PaymentNotFound
+//		payment_provider.InvalidRefund
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+//			payment_provider.ProviderError
+func (usecase *PaymentsUsecase) refund(payment *models.Payments, amount models.Decimal, reason string) error {
+	token := payment.Token
+	left := payment.Amount - payment.RefundedAmount
+	if amount <= 0 || amount > left {
+		return InvalidRefundAmount
+	}
+
+	to := models.PaymentPartiallyRefunded
+	if amount == left {
+		to = models.PaymentRefunded
+	}
+	if !canTransit(payment.State, to) {
+		return InvalidStateTransition
+	}
+	if err := usecase.provider.Refund(token, amount); err != nil {
+		return err
+	}
+
+	err := usecase.repository.Refund(token, &models.PaymentEvent{
+		FromState: payment.State,
+		ToState:   to,
+		Reason:    reason,
+	}, amount)
+	if err != nil || payment.Type != models.PaymentSubscription {
+		return err
+	}
+	return usecase.repoEvents.Add(&models.SubscriptionEvent{
+		UserID:    payment.UserID,
+		CreatorID: payment.CreatorID,
+		Kind:      models.SubscriptionEventRefunded,
+		Reason:    reason,
+	})
+}
+
+// ExpireCheckouts move not paid payments created before createdBefore to expired state and remove
+// not paid subscriptions created with them, seats held by removed subscriptions are pushed as freed.
+// Pending checkouts paid in payment provider are left as they are.
+// In dry run nothing is changed and result is what would be expired
+// Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *PaymentsUsecase) ExpireCheckouts(log *logrus.Entry, createdBefore time.Time,
+	dryRun bool) (*models.CheckoutsSweep, error) {
+	checkouts, err := usecase.repository.GetAbandonedCheckouts(createdBefore)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &models.CheckoutsSweep{}
+	for i := range checkouts {
+		checkout := &checkouts[i]
+		if !canTransit(checkout.State, models.PaymentExpired) || usecase.paidInProvider(log, checkout) {
+			continue
+		}
+		if dryRun {
+			res.Expired++
+			if checkout.HasPendingSubscription {
+				res.RemovedSubscriptions++
+			}
+			continue
+		}
+
+		removed, err := usecase.repository.ExpireCheckout(checkout, expireCheckoutReason)
+		if err != nil {
+			// payment was paid or expired by other instance after it was selected
+			if err == repository_payments.PaymentStateChanged {
+				continue
+			}
+			return res, err
+		}
+		res.Expired++
+		res.RemovedSubscriptions += removed
+		if removed == 0 {
+			continue
+		}
+		if errPush := usecase.pusher.SeatFreed(checkout.AwardID); errPush != nil {
+			log.Errorf("Try push freed seat of award %d, and got err %s", checkout.AwardID, errPush)
+		}
+	}
+	return res, nil
+}
+
+// paidInProvider check status of pending checkout in payment provider, checkout which was paid
+// but notification of it was not received yet must not be expired.
+// Checkout with unknown status is not expired too, it is checked again by next sweep
+func (usecase *PaymentsUsecase) paidInProvider(log *logrus.Entry, checkout *models.AbandonedCheckout) bool {
+	if checkout.State != models.PaymentPending {
+		return false
+	}
+	status, err := usecase.provider.GetStatus(checkout.Token)
+	if err == payment_provider.NotSupported || err == payment_provider.PaymentNotFound {
+		return false
+	}
+	if err != nil {
+		log.Errorf("Try get status of payment %d, and got err %s", checkout.PaymentID, err)
+		return true
+	}
+	if status == payment_provider.StatusPending {
+		return false
+	}
+	log.Warnf("Payment %d is %s in payment provider, but notification was not received", checkout.PaymentID, status)
+	return true
 }
@@ -0,0 +1,738 @@
+package payments
+
+import (
+	"net/url"
+	"patreon/internal/app"
+	"patreon/internal/app/models"
+	"patreon/internal/app/payment_provider"
+	"patreon/internal/app/repository"
+	repository_payments "patreon/internal/app/repository/payments"
+	"patreon/internal/app/usecase"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type SuitePaymentsUsecase struct {
+	usecase.SuiteUsecase
+	uc Usecase
+}
+
+func (s *SuitePaymentsUsecase) SetupSuite() {
+	s.SuiteUsecase.SetupSuite()
+	s.uc = NewPaymentsUsecase(s.MockPaymentsRepository, s.MockPusher, s.MockPaymentProvider,
+		s.MockEventsRepository, 15)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_CreateCheckout_OK() {
+	payment := models.TestPayment()
+	token := "pay_token"
+	expected := &models.CheckoutInfo{Provider: payment_provider.Fake, Url: "/checkout?token=pay_token"}
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByToken(token).
+		Times(1).
+		Return(*payment, nil)
+	s.MockPaymentProvider.EXPECT().
+		CreateCheckout(&models.Checkout{Token: token, Amount: payment.Amount, Currency: payment.Currency,
+			Description: checkoutDescription}).
+		Times(1).
+		Return(expected, nil)
+	s.MockPaymentsRepository.EXPECT().
+		ChangeState(token, &models.PaymentEvent{FromState: models.PaymentCreated, ToState: models.PaymentPending,
+			Reason: "checkout created by fake"}).
+		Times(1).
+		Return(nil)
+	res, err := s.uc.CreateCheckout(payment.UserID, token)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), expected, res)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_CreateCheckout_NotFound() {
+	token := "pay_token"
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByToken(token).
+		Times(1).
+		Return(models.Payments{}, repository.NotFound)
+	_, err := s.uc.CreateCheckout(1, token)
+	assert.Equal(s.T(), repository.NotFound, err)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_CreateCheckout_NotBelongUser() {
+	payment := models.TestPayment()
+	token := "pay_token"
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByToken(token).
+		Times(1).
+		Return(*payment, nil)
+	_, err := s.uc.CreateCheckout(payment.UserID+1, token)
+	assert.Equal(s.T(), PaymentNotBelongUser, err)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_CreateCheckout_AlreadyPaid() {
+	payment := models.TestPayment()
+	payment.State = models.PaymentRefunded
+	token := "pay_token"
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByToken(token).
+		Times(1).
+		Return(*payment, nil)
+	_, err := s.uc.CreateCheckout(payment.UserID, token)
+	assert.Equal(s.T(), PaymentAlreadyPaid, err)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_CreateCheckout_Pending() {
+	payment := models.TestPayment()
+	payment.State = models.PaymentPending
+	token := "pay_token"
+	expected := &models.CheckoutInfo{Provider: payment_provider.Fake, Url: "/checkout?token=pay_token"}
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByToken(token).
+		Times(1).
+		Return(*payment, nil)
+	s.MockPaymentProvider.EXPECT().
+		CreateCheckout(&models.Checkout{Token: token, Amount: payment.Amount, Currency: payment.Currency,
+			Description: checkoutDescription}).
+		Times(1).
+		Return(expected, nil)
+	res, err := s.uc.CreateCheckout(payment.UserID, token)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), expected, res)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_CreateCheckout_Expired() {
+	payment := models.TestPayment()
+	payment.State = models.PaymentExpired
+	token := "pay_token"
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByToken(token).
+		Times(1).
+		Return(*payment, nil)
+	_, err := s.uc.CreateCheckout(payment.UserID, token)
+	assert.Equal(s.T(), InvalidStateTransition, err)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_ParseNotification() {
+	form := url.Values{"label": {"pay_token"}}
+	notification := models.TestPaymentNotification()
+	s.MockPaymentProvider.EXPECT().
+		ParseNotification(form).
+		Times(1).
+		Return(notification, nil)
+	res, err := s.uc.ParseNotification(form)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), notification, res)
+
+	s.MockPaymentProvider.EXPECT().
+		ParseNotification(form).
+		Times(1).
+		Return(nil, payment_provider.InvalidNotificationHash)
+	_, err = s.uc.ParseNotification(form)
+	assert.Equal(s.T(), payment_provider.InvalidNotificationHash, err)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_UpdateStatus_OK() {
+	notification := models.TestPaymentNotification()
+	payment := models.TestPayment()
+	subscrEvent := &models.SubscriptionEvent{UserID: payment.UserID, CreatorID: payment.CreatorID, AwardID: 2,
+		Kind: models.SubscriptionEventRenewed}
+	s.MockPaymentsRepository.EXPECT().
+		CheckOperationProcessed(notification.OperationID).
+		Times(1).
+		Return(false, nil)
+	s.MockPaymentsRepository.EXPECT().
+		CheckCountPaymentsByToken(notification.Token).
+		Times(1).
+		Return(nil)
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByToken(notification.Token).
+		Times(1).
+		Return(*payment, nil)
+	s.MockPaymentsRepository.EXPECT().
+		UpdateStatus(notification.Token, notification.OperationID, &models.PaymentEvent{
+			FromState: models.PaymentCreated, ToState: models.PaymentSucceeded,
+			Reason: "payment notification, operation 1234567"}, models.NewDecimal(15)).
+		Times(1).
+		Return(subscrEvent, nil)
+	s.MockPusher.EXPECT().
+		ApplyPayments(notification.Token).
+		Times(1).
+		Return(nil)
+	s.MockEventsRepository.EXPECT().
+		Add(subscrEvent).
+		Times(1).
+		Return(repository.NewDBError(repository.DefaultErrDB))
+	err := s.uc.UpdateStatus(s.Logger.WithField("test", true), notification)
+	assert.NoError(s.T(), err)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_UpdateStatus_Gift() {
+	notification := models.TestPaymentNotification()
+	payment := models.TestPayment()
+	payment.GiftID = 7
+	s.MockPaymentsRepository.EXPECT().
+		CheckOperationProcessed(notification.OperationID).
+		Times(1).
+		Return(false, nil)
+	s.MockPaymentsRepository.EXPECT().
+		CheckCountPaymentsByToken(notification.Token).
+		Times(1).
+		Return(nil)
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByToken(notification.Token).
+		Times(1).
+		Return(*payment, nil)
+	s.MockPaymentsRepository.EXPECT().
+		UpdateStatus(notification.Token, notification.OperationID, &models.PaymentEvent{
+			FromState: models.PaymentCreated, ToState: models.PaymentSucceeded,
+			Reason: "payment notification, operation 1234567"}, models.NewDecimal(15)).
+		Times(1).
+		Return(nil, nil)
+	s.MockPusher.EXPECT().
+		ApplyPayments(notification.Token).
+		Times(1).
+		Return(nil)
+	s.MockPusher.EXPECT().
+		GiftReceived(payment.GiftID).
+		Times(1).
+		Return(nil)
+	err := s.uc.UpdateStatus(s.Logger.WithField("test", true), notification)
+	assert.NoError(s.T(), err)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_UpdateStatus_Tip() {
+	notification := models.TestPaymentNotification()
+	payment := models.TestPayment()
+	payment.Type = models.PaymentTip
+	payment.TipID = 5
+	s.MockPaymentsRepository.EXPECT().
+		CheckOperationProcessed(notification.OperationID).
+		Times(1).
+		Return(false, nil)
+	s.MockPaymentsRepository.EXPECT().
+		CheckCountPaymentsByToken(notification.Token).
+		Times(1).
+		Return(nil)
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByToken(notification.Token).
+		Times(1).
+		Return(*payment, nil)
+	s.MockPaymentsRepository.EXPECT().
+		UpdateStatus(notification.Token, notification.OperationID, &models.PaymentEvent{
+			FromState: models.PaymentCreated, ToState: models.PaymentSucceeded,
+			Reason: "payment notification, operation 1234567"}, models.NewDecimal(15)).
+		Times(1).
+		Return(nil, nil)
+	s.MockPusher.EXPECT().
+		ApplyPayments(notification.Token).
+		Times(1).
+		Return(nil)
+	s.MockPusher.EXPECT().
+		TipReceived(payment.TipID).
+		Times(1).
+		Return(nil)
+	err := s.uc.UpdateStatus(s.Logger.WithField("test", true), notification)
+	assert.NoError(s.T(), err)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_UpdateStatus_TierChangeCancelled() {
+	notification := models.TestPaymentNotification()
+	payment := models.TestPayment()
+	s.MockPaymentsRepository.EXPECT().
+		CheckOperationProcessed(notification.OperationID).
+		Times(1).
+		Return(false, nil)
+	s.MockPaymentsRepository.EXPECT().
+		CheckCountPaymentsByToken(notification.Token).
+		Times(1).
+		Return(nil)
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByToken(notification.Token).
+		Times(1).
+		Return(*payment, nil)
+	s.MockPaymentsRepository.EXPECT().
+		UpdateStatus(notification.Token, notification.OperationID, &models.PaymentEvent{
+			FromState: models.PaymentCreated, ToState: models.PaymentSucceeded,
+			Reason: "payment notification, operation 1234567"}, models.NewDecimal(15)).
+		Times(1).
+		Return(nil, repository_payments.TierChangeCancelled)
+	s.MockPaymentProvider.EXPECT().
+		Refund(notification.Token, payment.Amount).
+		Times(1).
+		Return(nil)
+	s.MockPaymentsRepository.EXPECT().
+		Refund(notification.Token, &models.PaymentEvent{FromState: models.PaymentSucceeded,
+			ToState: models.PaymentRefunded, Reason: cancelledTierChangeReason}, payment.Amount).
+		Times(1).
+		Return(nil)
+	s.MockEventsRepository.EXPECT().
+		Add(&models.SubscriptionEvent{UserID: payment.UserID, CreatorID: payment.CreatorID,
+			Kind: models.SubscriptionEventRefunded, Reason: cancelledTierChangeReason}).
+		Times(1).
+		Return(nil)
+	err := s.uc.UpdateStatus(s.Logger.WithField("test", true), notification)
+	assert.NoError(s.T(), err)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_UpdateStatus_ExpiredRestored() {
+	notification := models.TestPaymentNotification()
+	payment := models.TestPayment()
+	// checkout was expired by sweeper after its pay token was gone, money arrived later
+	payment.State = models.PaymentExpired
+	subscrEvent := &models.SubscriptionEvent{UserID: payment.UserID, CreatorID: payment.CreatorID, AwardID: 2,
+		Kind: models.SubscriptionEventSubscribed}
+	s.MockPaymentsRepository.EXPECT().
+		CheckOperationProcessed(notification.OperationID).
+		Times(1).
+		Return(false, nil)
+	s.MockPaymentsRepository.EXPECT().
+		CheckCountPaymentsByToken(notification.Token).
+		Times(1).
+		Return(nil)
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByToken(notification.Token).
+		Times(1).
+		Return(*payment, nil)
+	s.MockPaymentsRepository.EXPECT().
+		UpdateStatus(notification.Token, notification.OperationID, &models.PaymentEvent{
+			FromState: models.PaymentExpired, ToState: models.PaymentSucceeded,
+			Reason: "payment notification, operation 1234567"}, models.NewDecimal(15)).
+		Times(1).
+		Return(subscrEvent, nil)
+	s.MockPusher.EXPECT().
+		ApplyPayments(notification.Token).
+		Times(1).
+		Return(nil)
+	s.MockEventsRepository.EXPECT().
+		Add(subscrEvent).
+		Times(1).
+		Return(nil)
+	err := s.uc.UpdateStatus(s.Logger.WithField("test", true), notification)
+	assert.NoError(s.T(), err)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_UpdateStatus_AlreadyProcessed() {
+	notification := models.TestPaymentNotification()
+	s.MockPaymentsRepository.EXPECT().
+		CheckOperationProcessed(notification.OperationID).
+		Times(1).
+		Return(true, nil)
+	err := s.uc.UpdateStatus(s.Logger.WithField("test", true), notification)
+	assert.Equal(s.T(), NotificationAlreadyProcessed, err)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_UpdateStatus_ProcessedConcurrently() {
+	notification := models.TestPaymentNotification()
+	payment := models.TestPayment()
+	s.MockPaymentsRepository.EXPECT().
+		CheckOperationProcessed(notification.OperationID).
+		Times(1).
+		Return(false, nil)
+	s.MockPaymentsRepository.EXPECT().
+		CheckCountPaymentsByToken(notification.Token).
+		Times(1).
+		Return(nil)
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByToken(notification.Token).
+		Times(1).
+		Return(*payment, nil)
+	s.MockPaymentsRepository.EXPECT().
+		UpdateStatus(notification.Token, notification.OperationID, &models.PaymentEvent{
+			FromState: models.PaymentCreated, ToState: models.PaymentSucceeded,
+			Reason: "payment notification, operation 1234567"}, models.NewDecimal(15)).
+		Times(1).
+		Return(nil, repository_payments.PaymentStateChanged)
+	s.MockPaymentsRepository.EXPECT().
+		CheckOperationProcessed(notification.OperationID).
+		Times(1).
+		Return(true, nil)
+	err := s.uc.UpdateStatus(s.Logger.WithField("test", true), notification)
+	assert.Equal(s.T(), NotificationAlreadyProcessed, err)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_UpdateStatus_DuplicateOperation() {
+	notification := models.TestPaymentNotification()
+	payment := models.TestPayment()
+	s.MockPaymentsRepository.EXPECT().
+		CheckOperationProcessed(notification.OperationID).
+		Times(1).
+		Return(false, nil)
+	s.MockPaymentsRepository.EXPECT().
+		CheckCountPaymentsByToken(notification.Token).
+		Times(1).
+		Return(nil)
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByToken(notification.Token).
+		Times(1).
+		Return(*payment, nil)
+	s.MockPaymentsRepository.EXPECT().
+		UpdateStatus(notification.Token, notification.OperationID, &models.PaymentEvent{
+			FromState: models.PaymentCreated, ToState: models.PaymentSucceeded,
+			Reason: "payment notification, operation 1234567"}, models.NewDecimal(15)).
+		Times(1).
+		Return(nil, repository_payments.OperationAlreadyProcessed)
+	err := s.uc.UpdateStatus(s.Logger.WithField("test", true), notification)
+	assert.Equal(s.T(), NotificationAlreadyProcessed, err)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_UpdateStatus_CheckOperationError() {
+	notification := models.TestPaymentNotification()
+	s.MockPaymentsRepository.EXPECT().
+		CheckOperationProcessed(notification.OperationID).
+		Times(1).
+		Return(false, repository.NewDBError(repository.DefaultErrDB))
+	err := s.uc.UpdateStatus(s.Logger.WithField("test", true), notification)
+	assert.Equal(s.T(), repository.DefaultErrDB, errors.Cause(err).(*app.GeneralError).Err)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_UpdateStatus_NotEqualAmount() {
+	notification := models.TestPaymentNotification()
+	payment := models.TestPayment()
+	payment.Amount = notification.Amount + models.NewDecimal(1)
+	s.MockPaymentsRepository.EXPECT().
+		CheckOperationProcessed(notification.OperationID).
+		Times(1).
+		Return(false, nil)
+	s.MockPaymentsRepository.EXPECT().
+		CheckCountPaymentsByToken(notification.Token).
+		Times(1).
+		Return(nil)
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByToken(notification.Token).
+		Times(1).
+		Return(*payment, nil)
+	s.MockPaymentsRepository.EXPECT().
+		ChangeState(notification.Token, &models.PaymentEvent{
+			FromState: models.PaymentCreated, ToState: models.PaymentFailed,
+			Reason: "operation 1234567: received amount 100.00, expected 101.00"}).
+		Times(1).
+		Return(nil)
+	err := s.uc.UpdateStatus(s.Logger.WithField("test", true), notification)
+	assert.Equal(s.T(), repository_payments.NotEqualPaymentAmount, err)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_UpdateStatus_NotEqualCurrency() {
+	notification := models.TestPaymentNotification()
+	notification.Currency = "USD"
+	payment := models.TestPayment()
+	s.MockPaymentsRepository.EXPECT().
+		CheckOperationProcessed(notification.OperationID).
+		Times(1).
+		Return(false, nil)
+	s.MockPaymentsRepository.EXPECT().
+		CheckCountPaymentsByToken(notification.Token).
+		Times(1).
+		Return(nil)
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByToken(notification.Token).
+		Times(1).
+		Return(*payment, nil)
+	s.MockPaymentsRepository.EXPECT().
+		ChangeState(notification.Token, &models.PaymentEvent{
+			FromState: models.PaymentCreated, ToState: models.PaymentFailed,
+			Reason: "operation 1234567: received currency USD, expected RUB"}).
+		Times(1).
+		Return(nil)
+	err := s.uc.UpdateStatus(s.Logger.WithField("test", true), notification)
+	assert.Equal(s.T(), repository_payments.NotEqualPaymentCurrency, err)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_UpdateStatus_Refunded() {
+	notification := models.TestPaymentNotification()
+	payment := models.TestPayment()
+	payment.State = models.PaymentRefunded
+	s.MockPaymentsRepository.EXPECT().
+		CheckOperationProcessed(notification.OperationID).
+		Times(1).
+		Return(false, nil)
+	s.MockPaymentsRepository.EXPECT().
+		CheckCountPaymentsByToken(notification.Token).
+		Times(1).
+		Return(nil)
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByToken(notification.Token).
+		Times(1).
+		Return(*payment, nil)
+	err := s.uc.UpdateStatus(s.Logger.WithField("test", true), notification)
+	assert.Equal(s.T(), InvalidStateTransition, err)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_ChangeState() {
+	token := "pay_token"
+	payment := models.TestPayment()
+	payment.State = models.PaymentPending
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByToken(token).
+		Times(1).
+		Return(*payment, nil)
+	s.MockPaymentsRepository.EXPECT().
+		ChangeState(token, &models.PaymentEvent{FromState: models.PaymentPending, ToState: models.PaymentExpired,
+			Reason: "checkout abandoned"}).
+		Times(1).
+		Return(nil)
+	err := s.uc.ChangeState(token, models.PaymentExpired, "checkout abandoned")
+	assert.NoError(s.T(), err)
+
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByToken(token).
+		Times(1).
+		Return(*payment, nil)
+	err = s.uc.ChangeState(token, models.PaymentSucceeded, "")
+	assert.Equal(s.T(), InvalidStateTransition, err)
+
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByToken(token).
+		Times(1).
+		Return(*payment, nil)
+	err = s.uc.ChangeState(token, models.PaymentRefunded, "")
+	assert.Equal(s.T(), InvalidStateTransition, err)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_Refund() {
+	token := "pay_token"
+	payment := models.TestPayment()
+	payment.State = models.PaymentSucceeded
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByToken(token).
+		Times(1).
+		Return(*payment, nil)
+	s.MockPaymentProvider.EXPECT().
+		Refund(token, models.NewDecimal(40)).
+		Times(1).
+		Return(nil)
+	s.MockPaymentsRepository.EXPECT().
+		Refund(token, &models.PaymentEvent{FromState: models.PaymentSucceeded,
+			ToState: models.PaymentPartiallyRefunded, Reason: "by request"}, models.NewDecimal(40)).
+		Times(1).
+		Return(nil)
+	s.MockEventsRepository.EXPECT().
+		Add(&models.SubscriptionEvent{UserID: payment.UserID, CreatorID: payment.CreatorID,
+			Kind: models.SubscriptionEventRefunded, Reason: "by request"}).
+		Times(1).
+		Return(nil)
+	err := s.uc.Refund(token, models.NewDecimal(40), "by request")
+	assert.NoError(s.T(), err)
+
+	payment.State = models.PaymentPartiallyRefunded
+	payment.RefundedAmount = models.NewDecimal(40)
+	payment.Type = models.PaymentTip
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByToken(token).
+		Times(1).
+		Return(*payment, nil)
+	s.MockPaymentProvider.EXPECT().
+		Refund(token, models.NewDecimal(60)).
+		Times(1).
+		Return(nil)
+	s.MockPaymentsRepository.EXPECT().
+		Refund(token, &models.PaymentEvent{FromState: models.PaymentPartiallyRefunded,
+			ToState: models.PaymentRefunded, Reason: "by request"}, models.NewDecimal(60)).
+		Times(1).
+		Return(nil)
+	err = s.uc.Refund(token, models.NewDecimal(60), "by request")
+	assert.NoError(s.T(), err)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_Refund_ProviderError() {
+	token := "pay_token"
+	payment := models.TestPayment()
+	payment.State = models.PaymentSucceeded
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByToken(token).
+		Times(1).
+		Return(*payment, nil)
+	s.MockPaymentProvider.EXPECT().
+		Refund(token, payment.Amount).
+		Times(1).
+		Return(payment_provider.NotSupported)
+	err := s.uc.Refund(token, payment.Amount, "by request")
+	assert.Equal(s.T(), payment_provider.NotSupported, err)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_RefundCreatorPayment() {
+	payment := models.TestPayment()
+	payment.ID = 4
+	payment.Token = "pay_token"
+	payment.State = models.PaymentSucceeded
+	payment.Type = models.PaymentTip
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByID(payment.ID).
+		Times(1).
+		Return(*payment, nil)
+	s.MockPaymentProvider.EXPECT().
+		Refund(payment.Token, payment.Amount).
+		Times(1).
+		Return(nil)
+	s.MockPaymentsRepository.EXPECT().
+		Refund(payment.Token, &models.PaymentEvent{FromState: models.PaymentSucceeded,
+			ToState: models.PaymentRefunded, Reason: "by creator"}, payment.Amount).
+		Times(1).
+		Return(nil)
+	err := s.uc.RefundCreatorPayment(payment.CreatorID, payment.ID, payment.Amount, "by creator")
+	assert.NoError(s.T(), err)
+
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByID(payment.ID).
+		Times(1).
+		Return(*payment, nil)
+	err = s.uc.RefundCreatorPayment(payment.CreatorID+1, payment.ID, payment.Amount, "by creator")
+	assert.Equal(s.T(), repository.NotFound, err)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_Refund_InvalidAmount() {
+	token := "pay_token"
+	payment := models.TestPayment()
+	payment.State = models.PaymentPartiallyRefunded
+	payment.RefundedAmount = models.NewDecimal(40)
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByToken(token).
+		Times(2).
+		Return(*payment, nil)
+	err := s.uc.Refund(token, models.NewDecimal(61), "")
+	assert.Equal(s.T(), InvalidRefundAmount, err)
+	err = s.uc.Refund(token, models.NewDecimal(0), "")
+	assert.Equal(s.T(), InvalidRefundAmount, err)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_Refund_NotPaid() {
+	token := "pay_token"
+	payment := models.TestPayment()
+	s.MockPaymentsRepository.EXPECT().
+		GetPaymentByToken(token).
+		Times(1).
+		Return(*payment, nil)
+	err := s.uc.Refund(token, models.NewDecimal(100), "")
+	assert.Equal(s.T(), InvalidStateTransition, err)
+}
+
+func (s *SuitePaymentsUsecase) testCheckouts() []models.AbandonedCheckout {
+	return []models.AbandonedCheckout{
+		{PaymentID: 4, Token: "pay_token", State: models.PaymentPending, Type: models.PaymentSubscription,
+			UserID: 1, CreatorID: 2, AwardID: 3, HasPendingSubscription: true},
+		{PaymentID: 5, Token: "paid_token", State: models.PaymentCreated, Type: models.PaymentSubscription,
+			UserID: 6, CreatorID: 2, AwardID: 3, HasPendingSubscription: true},
+		{PaymentID: 7, Token: "tip_token", State: models.PaymentFailed, Type: models.PaymentTip,
+			UserID: 1, CreatorID: 2},
+	}
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_ExpireCheckouts() {
+	createdBefore := time.Date(2021, 12, 1, 9, 0, 0, 0, time.UTC)
+	checkouts := s.testCheckouts()
+	s.MockPaymentsRepository.EXPECT().
+		GetAbandonedCheckouts(createdBefore).
+		Times(1).
+		Return(checkouts, nil)
+	s.MockPaymentProvider.EXPECT().
+		GetStatus(checkouts[0].Token).
+		Times(1).
+		Return(payment_provider.StatusPending, nil)
+	s.MockPaymentsRepository.EXPECT().
+		ExpireCheckout(&checkouts[0], expireCheckoutReason).
+		Times(1).
+		Return(int64(1), nil)
+	s.MockPusher.EXPECT().
+		SeatFreed(checkouts[0].AwardID).
+		Times(1).
+		Return(nil)
+	s.MockPaymentsRepository.EXPECT().
+		ExpireCheckout(&checkouts[1], expireCheckoutReason).
+		Times(1).
+		Return(int64(0), repository_payments.PaymentStateChanged)
+	s.MockPaymentsRepository.EXPECT().
+		ExpireCheckout(&checkouts[2], expireCheckoutReason).
+		Times(1).
+		Return(int64(0), nil)
+	res, err := s.uc.ExpireCheckouts(s.Logger.WithField("test", true), createdBefore, false)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), &models.CheckoutsSweep{Expired: 2, RemovedSubscriptions: 1}, res)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_ExpireCheckouts_DryRun() {
+	createdBefore := time.Date(2021, 12, 1, 9, 0, 0, 0, time.UTC)
+	s.MockPaymentsRepository.EXPECT().
+		GetAbandonedCheckouts(createdBefore).
+		Times(1).
+		Return(s.testCheckouts(), nil)
+	s.MockPaymentProvider.EXPECT().
+		GetStatus("pay_token").
+		Times(1).
+		Return(payment_provider.Status(""), payment_provider.NotSupported)
+	res, err := s.uc.ExpireCheckouts(s.Logger.WithField("test", true), createdBefore, true)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), &models.CheckoutsSweep{Expired: 3, RemovedSubscriptions: 2}, res)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_ExpireCheckouts_PaidInProvider() {
+	createdBefore := time.Date(2021, 12, 1, 9, 0, 0, 0, time.UTC)
+	checkouts := s.testCheckouts()[:1]
+	s.MockPaymentsRepository.EXPECT().
+		GetAbandonedCheckouts(createdBefore).
+		Times(2).
+		Return(checkouts, nil)
+	s.MockPaymentProvider.EXPECT().
+		GetStatus(checkouts[0].Token).
+		Times(1).
+		Return(payment_provider.StatusSucceeded, nil)
+	res, err := s.uc.ExpireCheckouts(s.Logger.WithField("test", true), createdBefore, false)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), &models.CheckoutsSweep{}, res)
+
+	s.MockPaymentProvider.EXPECT().
+		GetStatus(checkouts[0].Token).
+		Times(1).
+		Return(payment_provider.Status(""), payment_provider.NewProviderError(errors.New("timeout")))
+	res, err = s.uc.ExpireCheckouts(s.Logger.WithField("test", true), createdBefore, false)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), &models.CheckoutsSweep{}, res)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_ExpireCheckouts_Error() {
+	createdBefore := time.Date(2021, 12, 1, 9, 0, 0, 0, time.UTC)
+	checkouts := s.testCheckouts()
+	s.MockPaymentsRepository.EXPECT().
+		GetAbandonedCheckouts(createdBefore).
+		Times(1).
+		Return(checkouts, nil)
+	s.MockPaymentProvider.EXPECT().
+		GetStatus(checkouts[0].Token).
+		Times(1).
+		Return(payment_provider.StatusPending, nil)
+	s.MockPaymentsRepository.EXPECT().
+		ExpireCheckout(&checkouts[0], expireCheckoutReason).
+		Times(1).
+		Return(int64(1), nil)
+	s.MockPusher.EXPECT().
+		SeatFreed(checkouts[0].AwardID).
+		Times(1).
+		Return(errors.New("push error"))
+	s.MockPaymentsRepository.EXPECT().
+		ExpireCheckout(&checkouts[1], expireCheckoutReason).
+		Times(1).
+		Return(int64(0), repository.DefaultErrDB)
+	res, err := s.uc.ExpireCheckouts(s.Logger.WithField("test", true), createdBefore, false)
+	assert.Equal(s.T(), repository.DefaultErrDB, err)
+	assert.Equal(s.T(), &models.CheckoutsSweep{Expired: 1, RemovedSubscriptions: 1}, res)
+
+	s.MockPaymentsRepository.EXPECT().
+		GetAbandonedCheckouts(createdBefore).
+		Times(1).
+		Return(nil, repository.DefaultErrDB)
+	_, err = s.uc.ExpireCheckouts(s.Logger.WithField("test", true), createdBefore, false)
+	assert.Equal(s.T(), repository.DefaultErrDB, err)
+}
+
+func (s *SuitePaymentsUsecase) TestPaymentsUsecase_CanTransit() {
+	assert.True(s.T(), canTransit(models.PaymentCreated, models.PaymentPending))
+	assert.True(s.T(), canTransit(models.PaymentExpired, models.PaymentSucceeded))
+	assert.True(s.T(), canTransit(models.PaymentPartiallyRefunded, models.PaymentRefunded))
+	assert.False(s.T(), canTransit(models.PaymentRefunded, models.PaymentSucceeded))
+	assert.False(s.T(), canTransit(models.PaymentSucceeded, models.PaymentFailed))
+	assert.False(s.T(), canTransit(models.PaymentExpired, models.PaymentPending))
+}
+
+func TestUsecasePayments(t *testing.T) {
+	suite.Run(t, new(SuitePaymentsUsecase))
+}
@@ -0,0 +1,25 @@
+package payments
+
+import "patreon/internal/app/models"
+
+// paymentTransitions allowed moves of payment state machine.
+// Money may arrive for failed or expired checkout, so they still can become succeeded
+var paymentTransitions = map[models.PaymentState][]models.PaymentState{
+	models.PaymentCreated: {models.PaymentPending, models.PaymentSucceeded, models.PaymentFailed,
+		models.PaymentExpired},
+	models.PaymentPending:           {models.PaymentSucceeded, models.PaymentFailed, models.PaymentExpired},
+	models.PaymentFailed:            {models.PaymentPending, models.PaymentSucceeded, models.PaymentExpired},
+	models.PaymentExpired:           {models.PaymentSucceeded},
+	models.PaymentSucceeded:         {models.PaymentRefunded, models.PaymentPartiallyRefunded},
+	models.PaymentPartiallyRefunded: {models.PaymentRefunded, models.PaymentPartiallyRefunded},
+	models.PaymentRefunded:          {},
+}
+
+func canTransit(from models.PaymentState, to models.PaymentState) bool {
+	for _, state := range paymentTransitions[from] {
+		if state == to {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,9 @@
 package payments
 
 import (
+	"net/url"
+	"time"
+
 	"github.com/sirupsen/logrus"
 	"patreon/internal/app/models"
 	db_models "patreon/internal/app/models"
@@ -17,16 +20,83 @@ type Usecase interface {
 	//		repository.NotFound
 	//		app.GeneralError with Errors:
 	//			repository.DefaultErrDB
-	GetUserPayments(userID int64, pag *db_models.Pagination) ([]models.UserPayments, error)
+	GetUserPayments(userID int64, pag *db_models.Pagination, filter *models.PaymentsFilter) ([]models.UserPayments, error)
 	// GetCreatorPayments Errors:
 	//		repository.NotFound
 	//		app.GeneralError with Errors:
 	//			repository.DefaultErrDB
-	GetCreatorPayments(creatorID int64, pag *db_models.Pagination) ([]models.CreatorPayments, error)
+	GetCreatorPayments(creatorID int64, pag *db_models.Pagination,
+		filter *models.PaymentsFilter) ([]models.CreatorPayments, error)
+	// ExportCreatorPayments Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	ExportCreatorPayments(creatorID int64, filter *models.PaymentsFilter,
+		handle func(payment *models.CreatorPayments) error) error
+	// GetCreatorMonthlyTotals Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetCreatorMonthlyTotals(creatorID int64, filter *models.PaymentsFilter) ([]models.PaymentsMonthTotal, error)
+	// CreateCheckout Errors:
+	//		PaymentNotBelongUser
+	//		PaymentAlreadyPaid
+	//		InvalidStateTransition
+	//		repository.NotFound
+	//		repository_payments.PaymentStateChanged
+	//		payment_provider.InvalidCheckout
+	//		payment_provider.UnsupportedCurrency
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	CreateCheckout(userID int64, token string) (*models.CheckoutInfo, error)
+	// ParseNotification Errors:
+	//		payment_provider.InvalidNotification
+	//		payment_provider.InvalidNotificationHash
+	//		payment_provider.ProtectedPaymentNotSupported
+	//		payment_provider.UnacceptedPayment
+	ParseNotification(form url.Values) (*models.PaymentNotification, error)
 	// UpdateStatus Errors:
+	//		NotificationAlreadyProcessed
+	//		InvalidStateTransition
 	//		repository_payments.NotEqualPaymentAmount
+	//		repository_payments.NotEqualPaymentCurrency
+	//		repository.NotFound
 	//		repository_payments.CountPaymentsByTokenError
+	//		repository_payments.PaymentStateChanged
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	UpdateStatus(log *logrus.Entry, notification *models.PaymentNotification) error
+	// ChangeState Errors:
+	//		InvalidStateTransition
+	//		repository.NotFound
+	//		repository_payments.PaymentStateChanged
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	ChangeState(token string, to models.PaymentState, reason string) error
+	// Refund Errors:
+	//		InvalidRefundAmount
+	//		InvalidStateTransition
+	//		repository.NotFound
+	//		repository_payments.PaymentStateChanged
+	//		payment_provider.NotSupported
+	//		payment_provider.PaymentNotFound
+	//		payment_provider.InvalidRefund
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	//			payment_provider.ProviderError
+	Refund(token string, amount models.Decimal, reason string) error
+	// RefundCreatorPayment Errors:
+	//		InvalidRefundAmount
+	//		InvalidStateTransition
+	//		repository.NotFound
+	//		repository_payments.PaymentStateChanged
+	//		payment_provider.NotSupported
+	//		payment_provider.PaymentNotFound
+	//		payment_provider.InvalidRefund
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	//			payment_provider.ProviderError
+	RefundCreatorPayment(creatorID int64, paymentID int64, amount models.Decimal, reason string) error
+	// ExpireCheckouts Errors:
 	//		app.GeneralError with Errors:
 	//			repository.DefaultErrDB
-	UpdateStatus(log *logrus.Entry, token string, receiveAmount float64) error
+	ExpireCheckouts(log *logrus.Entry, createdBefore time.Time, dryRun bool) (*models.CheckoutsSweep, error)
 }
@@ -0,0 +1,9 @@
+package usecase_polls
+
+import "errors"
+
+var (
+	NotPoll    = errors.New("attach is not poll")
+	PollClosed = errors.New("poll is closed")
+	NotVoted   = errors.New("user has not voted in this poll")
+)
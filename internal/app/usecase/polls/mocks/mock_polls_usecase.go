@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: patreon/internal/app/usecase/polls (interfaces: Usecase)
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	models "patreon/internal/app/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// PollsUsecase is a mock of Usecase interface.
+type PollsUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *PollsUsecaseMockRecorder
+}
+
+// PollsUsecaseMockRecorder is the mock recorder for PollsUsecase.
+type PollsUsecaseMockRecorder struct {
+	mock *PollsUsecase
+}
+
+// NewPollsUsecase creates a new mock instance.
+func NewPollsUsecase(ctrl *gomock.Controller) *PollsUsecase {
+	mock := &PollsUsecase{ctrl: ctrl}
+	mock.recorder = &PollsUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *PollsUsecase) EXPECT() *PollsUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetResults mocks base method.
+func (m *PollsUsecase) GetResults(arg0, arg1 int64) (*models.PollResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResults", arg0, arg1)
+	ret0, _ := ret[0].(*models.PollResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResults indicates an expected call of GetResults.
+func (mr *PollsUsecaseMockRecorder) GetResults(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResults", reflect.TypeOf((*PollsUsecase)(nil).GetResults), arg0, arg1)
+}
+
+// Retract mocks base method.
+func (m *PollsUsecase) Retract(arg0, arg1 int64) (*models.PollResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retract", arg0, arg1)
+	ret0, _ := ret[0].(*models.PollResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Retract indicates an expected call of Retract.
+func (mr *PollsUsecaseMockRecorder) Retract(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retract", reflect.TypeOf((*PollsUsecase)(nil).Retract), arg0, arg1)
+}
+
+// Vote mocks base method.
+func (m *PollsUsecase) Vote(arg0, arg1 int64, arg2 []int64) (*models.PollResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Vote", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.PollResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Vote indicates an expected call of Vote.
+func (mr *PollsUsecaseMockRecorder) Vote(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Vote", reflect.TypeOf((*PollsUsecase)(nil).Vote), arg0, arg1, arg2)
+}
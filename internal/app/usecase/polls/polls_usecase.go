@@ -0,0 +1,152 @@
+package usecase_polls
+
+import (
+	"patreon/internal/app"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_attaches "patreon/internal/app/repository/attaches"
+	repository_polls "patreon/internal/app/repository/polls"
+	"patreon/pkg/utils"
+
+	"github.com/pkg/errors"
+)
+
+type PollsUsecase struct {
+	repository   repository_polls.Repository
+	repoAttaches repository_attaches.Repository
+	clock        utils.Clock
+}
+
+func NewPollsUsecase(repository repository_polls.Repository, repoAttaches repository_attaches.Repository,
+	clock utils.Clock) *PollsUsecase {
+	return &PollsUsecase{
+		repository:   repository,
+		repoAttaches: repoAttaches,
+		clock:        clock,
+	}
+}
+
+// getPoll return poll of attach placed in post, polls uploaded or removed from post can not be voted
+// Errors:
+//		NotPoll
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			app.UnknownError
+//			repository.DefaultErrDB
+func (uc *PollsUsecase) getPoll(attachId int64) (*models.PollAttach, error) {
+	attach, err := uc.repoAttaches.Get(attachId)
+	if err != nil {
+		return nil, err
+	}
+	if attach.Type != models.Poll {
+		return nil, NotPoll
+	}
+
+	poll, err := models.ParsePoll(attach.Value)
+	if err != nil {
+		return nil, &app.GeneralError{
+			Err:         app.UnknownError,
+			ExternalErr: errors.Wrapf(err, "failed parse poll of attach %d", attachId),
+		}
+	}
+	return poll, nil
+}
+
+// getResults counts of votes are hidden from user who has not voted while poll is open,
+// if poll does not show results before vote
+// Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (uc *PollsUsecase) getResults(attachId int64, userId int64, poll *models.PollAttach) (*models.PollResults, error) {
+	res := &models.PollResults{AttachID: attachId, Poll: poll, Closed: poll.IsClosed(uc.clock.Now())}
+	if userId != EmptyUser {
+		voted, err := uc.repository.GetVote(attachId, userId)
+		if err != nil && !errors.Is(err, repository.NotFound) {
+			return nil, err
+		}
+		res.Voted = voted
+	}
+
+	counts, voters, err := uc.repository.GetCounts(attachId)
+	if err != nil {
+		return nil, err
+	}
+	res.Voters = voters
+	if res.Closed || res.Voted != nil || poll.ResultsBeforeVote {
+		res.Counts = make([]int64, len(poll.Options))
+		for option := range res.Counts {
+			res.Counts[option] = counts[int64(option)]
+		}
+	}
+	return res, nil
+}
+
+// GetResults return poll with live count of votes for its options
+// Errors:
+//		NotPoll
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			app.UnknownError
+//			repository.DefaultErrDB
+func (uc *PollsUsecase) GetResults(attachId int64, userId int64) (*models.PollResults, error) {
+	poll, err := uc.getPoll(attachId)
+	if err != nil {
+		return nil, err
+	}
+	return uc.getResults(attachId, userId, poll)
+}
+
+// Vote save vote of user in open poll and return poll results after it
+// Errors:
+//		NotPoll
+//		PollClosed
+//		models.IncorrectVote
+//		repository_postgresql.VoteAlreadyExist
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			app.UnknownError
+//			repository.DefaultErrDB
+func (uc *PollsUsecase) Vote(attachId int64, userId int64, options []int64) (*models.PollResults, error) {
+	poll, err := uc.getPoll(attachId)
+	if err != nil {
+		return nil, err
+	}
+	if poll.IsClosed(uc.clock.Now()) {
+		return nil, PollClosed
+	}
+	if err = poll.CheckVote(options); err != nil {
+		return nil, err
+	}
+
+	if err = uc.repository.Vote(attachId, userId, options); err != nil {
+		return nil, err
+	}
+	return uc.getResults(attachId, userId, poll)
+}
+
+// Retract remove vote of user from open poll, so user can vote again
+// Errors:
+//		NotPoll
+//		PollClosed
+//		NotVoted
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			app.UnknownError
+//			repository.DefaultErrDB
+func (uc *PollsUsecase) Retract(attachId int64, userId int64) (*models.PollResults, error) {
+	poll, err := uc.getPoll(attachId)
+	if err != nil {
+		return nil, err
+	}
+	if poll.IsClosed(uc.clock.Now()) {
+		return nil, PollClosed
+	}
+
+	if err = uc.repository.Retract(attachId, userId); err != nil {
+		if errors.Is(err, repository.NotFound) {
+			return nil, NotVoted
+		}
+		return nil, err
+	}
+	return uc.getResults(attachId, userId, poll)
+}
@@ -0,0 +1,142 @@
+package usecase_polls
+
+import (
+	"patreon/internal/app"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_postgresql "patreon/internal/app/repository/polls/postgresql"
+	"patreon/internal/app/usecase"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type SuitePollsUsecase struct {
+	usecase.SuiteUsecase
+	clock *usecase.FakeClock
+	uc    Usecase
+}
+
+func (s *SuitePollsUsecase) SetupSuite() {
+	s.SuiteUsecase.SetupSuite()
+	s.clock = &usecase.FakeClock{Time: time.Date(2021, 12, 1, 12, 0, 0, 0, time.UTC)}
+	s.uc = NewPollsUsecase(s.MockPollsRepository, s.MockAttachesRepository, s.clock)
+}
+
+func (s *SuitePollsUsecase) pollAttach(attachId int64, value string) {
+	s.MockAttachesRepository.EXPECT().
+		Get(attachId).
+		Times(1).
+		Return(&models.AttachWithoutLevel{ID: attachId, PostId: 1, Type: models.Poll, Value: value}, nil)
+}
+
+func (s *SuitePollsUsecase) TestPollsUsecase_GetResults() {
+	attachId, userId := int64(5), int64(2)
+	value := `{"question":"Next episode?","options":["cats","dogs","birds"],"multiple":false,` +
+		`"results_before_vote":false}`
+	poll, err := models.ParsePoll(value)
+	require.NoError(s.T(), err)
+
+	s.pollAttach(attachId, value)
+	s.MockPollsRepository.EXPECT().GetVote(attachId, userId).Times(1).Return([]int64{1}, nil)
+	s.MockPollsRepository.EXPECT().GetCounts(attachId).Times(1).Return(map[int64]int64{0: 3, 1: 2}, int64(5), nil)
+	res, err := s.uc.GetResults(attachId, userId)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), &models.PollResults{AttachID: attachId, Poll: poll, Counts: []int64{3, 2, 0},
+		Voters: 5, Voted: []int64{1}}, res)
+
+	s.pollAttach(attachId, value)
+	s.MockPollsRepository.EXPECT().GetVote(attachId, userId).Times(1).Return(nil, repository.NotFound)
+	s.MockPollsRepository.EXPECT().GetCounts(attachId).Times(1).Return(map[int64]int64{0: 3, 1: 2}, int64(5), nil)
+	res, err = s.uc.GetResults(attachId, userId)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), &models.PollResults{AttachID: attachId, Poll: poll, Voters: 5}, res)
+
+	closeAt := s.clock.Time.Add(-time.Minute)
+	poll.CloseAt = &closeAt
+	s.pollAttach(attachId, poll.Value())
+	s.MockPollsRepository.EXPECT().GetCounts(attachId).Times(1).Return(map[int64]int64{2: 1}, int64(1), nil)
+	res, err = s.uc.GetResults(attachId, EmptyUser)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []int64{0, 0, 1}, res.Counts)
+	assert.True(s.T(), res.Closed)
+}
+
+func (s *SuitePollsUsecase) TestPollsUsecase_GetResults_Errors() {
+	attachId := int64(5)
+	s.MockAttachesRepository.EXPECT().
+		Get(attachId).
+		Times(1).
+		Return(&models.AttachWithoutLevel{ID: attachId, Type: models.Text, Value: "text"}, nil)
+	_, err := s.uc.GetResults(attachId, EmptyUser)
+	assert.Equal(s.T(), NotPoll, err)
+
+	s.pollAttach(attachId, "broken")
+	_, err = s.uc.GetResults(attachId, EmptyUser)
+	require.IsType(s.T(), &app.GeneralError{}, err)
+	assert.Equal(s.T(), app.UnknownError, err.(*app.GeneralError).Err)
+
+	s.MockAttachesRepository.EXPECT().Get(attachId).Times(1).Return(nil, repository.NotFound)
+	_, err = s.uc.GetResults(attachId, EmptyUser)
+	assert.Equal(s.T(), repository.NotFound, err)
+}
+
+func (s *SuitePollsUsecase) TestPollsUsecase_Vote() {
+	attachId, userId := int64(5), int64(2)
+	value := `{"question":"Next episode?","options":["cats","dogs"],"multiple":true,"results_before_vote":false}`
+
+	s.pollAttach(attachId, value)
+	s.MockPollsRepository.EXPECT().Vote(attachId, userId, []int64{0, 1}).Times(1).Return(nil)
+	s.MockPollsRepository.EXPECT().GetVote(attachId, userId).Times(1).Return([]int64{0, 1}, nil)
+	s.MockPollsRepository.EXPECT().GetCounts(attachId).Times(1).Return(map[int64]int64{0: 1, 1: 1}, int64(1), nil)
+	res, err := s.uc.Vote(attachId, userId, []int64{0, 1})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []int64{1, 1}, res.Counts)
+	assert.Equal(s.T(), []int64{0, 1}, res.Voted)
+
+	s.pollAttach(attachId, value)
+	s.MockPollsRepository.EXPECT().
+		Vote(attachId, userId, []int64{0}).
+		Times(1).
+		Return(repository_postgresql.VoteAlreadyExist)
+	_, err = s.uc.Vote(attachId, userId, []int64{0})
+	assert.Equal(s.T(), repository_postgresql.VoteAlreadyExist, err)
+
+	s.pollAttach(attachId, value)
+	_, err = s.uc.Vote(attachId, userId, []int64{2})
+	assert.Equal(s.T(), models.IncorrectVote, err)
+
+	s.pollAttach(attachId, `{"question":"Next episode?","options":["cats","dogs"],"close_at":"2021-11-01T00:00:00Z"}`)
+	_, err = s.uc.Vote(attachId, userId, []int64{0})
+	assert.Equal(s.T(), PollClosed, err)
+}
+
+func (s *SuitePollsUsecase) TestPollsUsecase_Retract() {
+	attachId, userId := int64(5), int64(2)
+	value := `{"question":"Next episode?","options":["cats","dogs"],"multiple":false,"results_before_vote":true}`
+
+	s.pollAttach(attachId, value)
+	s.MockPollsRepository.EXPECT().Retract(attachId, userId).Times(1).Return(nil)
+	s.MockPollsRepository.EXPECT().GetVote(attachId, userId).Times(1).Return(nil, repository.NotFound)
+	s.MockPollsRepository.EXPECT().GetCounts(attachId).Times(1).Return(map[int64]int64{1: 4}, int64(4), nil)
+	res, err := s.uc.Retract(attachId, userId)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []int64{0, 4}, res.Counts)
+	assert.Nil(s.T(), res.Voted)
+
+	s.pollAttach(attachId, value)
+	s.MockPollsRepository.EXPECT().Retract(attachId, userId).Times(1).Return(repository.NotFound)
+	_, err = s.uc.Retract(attachId, userId)
+	assert.Equal(s.T(), NotVoted, err)
+
+	s.pollAttach(attachId, `{"question":"Next episode?","options":["cats","dogs"],"close_at":"2021-11-01T00:00:00Z"}`)
+	_, err = s.uc.Retract(attachId, userId)
+	assert.Equal(s.T(), PollClosed, err)
+}
+
+func TestPollsUsecase(t *testing.T) {
+	suite.Run(t, new(SuitePollsUsecase))
+}
@@ -0,0 +1,36 @@
+package usecase_polls
+
+import "patreon/internal/app/models"
+
+const EmptyUser = -2
+
+//go:generate mockgen -destination=mocks/mock_polls_usecase.go -package=mock_usecase -mock_names=Usecase=PollsUsecase . Usecase
+
+type Usecase interface {
+	// GetResults Errors:
+	//		NotPoll
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			app.UnknownError
+	//			repository.DefaultErrDB
+	GetResults(attachId int64, userId int64) (*models.PollResults, error)
+	// Vote Errors:
+	//		NotPoll
+	//		PollClosed
+	//		models.IncorrectVote
+	//		repository_postgresql.VoteAlreadyExist
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			app.UnknownError
+	//			repository.DefaultErrDB
+	Vote(attachId int64, userId int64, options []int64) (*models.PollResults, error)
+	// Retract Errors:
+	//		NotPoll
+	//		PollClosed
+	//		NotVoted
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			app.UnknownError
+	//			repository.DefaultErrDB
+	Retract(attachId int64, userId int64) (*models.PollResults, error)
+}
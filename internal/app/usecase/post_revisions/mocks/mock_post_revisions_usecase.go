@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: patreon/internal/app/usecase/post_revisions (interfaces: Usecase)
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	models "patreon/internal/app/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// PostRevisionsUsecase is a mock of Usecase interface.
+type PostRevisionsUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *PostRevisionsUsecaseMockRecorder
+}
+
+// PostRevisionsUsecaseMockRecorder is the mock recorder for PostRevisionsUsecase.
+type PostRevisionsUsecaseMockRecorder struct {
+	mock *PostRevisionsUsecase
+}
+
+// NewPostRevisionsUsecase creates a new mock instance.
+func NewPostRevisionsUsecase(ctrl *gomock.Controller) *PostRevisionsUsecase {
+	mock := &PostRevisionsUsecase{ctrl: ctrl}
+	mock.recorder = &PostRevisionsUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *PostRevisionsUsecase) EXPECT() *PostRevisionsUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Diff mocks base method.
+func (m *PostRevisionsUsecase) Diff(arg0, arg1, arg2 int64) ([]models.PostRevisionChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Diff", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.PostRevisionChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Diff indicates an expected call of Diff.
+func (mr *PostRevisionsUsecaseMockRecorder) Diff(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Diff", reflect.TypeOf((*PostRevisionsUsecase)(nil).Diff), arg0, arg1, arg2)
+}
+
+// GetRevisions mocks base method.
+func (m *PostRevisionsUsecase) GetRevisions(arg0 int64, arg1 *models.Pagination) ([]models.PostRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", arg0, arg1)
+	ret0, _ := ret[0].([]models.PostRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *PostRevisionsUsecaseMockRecorder) GetRevisions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*PostRevisionsUsecase)(nil).GetRevisions), arg0, arg1)
+}
+
+// Restore mocks base method.
+func (m *PostRevisionsUsecase) Restore(arg0, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *PostRevisionsUsecaseMockRecorder) Restore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*PostRevisionsUsecase)(nil).Restore), arg0, arg1)
+}
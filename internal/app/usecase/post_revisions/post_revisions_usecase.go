@@ -0,0 +1,78 @@
+package usecase_post_revisions
+
+import (
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_post_revisions "patreon/internal/app/repository/post_revisions"
+)
+
+type PostRevisionsUsecase struct {
+	repository repository_post_revisions.Repository
+}
+
+func NewPostRevisionsUsecase(repository repository_post_revisions.Repository) *PostRevisionsUsecase {
+	return &PostRevisionsUsecase{
+		repository: repository,
+	}
+}
+
+// getRevision return revision only if it belongs to post
+// Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (uc *PostRevisionsUsecase) getRevision(postID int64, revisionID int64) (*models.PostRevision, error) {
+	if revisionID == CurrentRevision {
+		return uc.repository.GetCurrent(postID)
+	}
+	rev, err := uc.repository.Get(revisionID)
+	if err != nil {
+		return nil, err
+	}
+	if rev.PostID != postID {
+		return nil, repository.NotFound
+	}
+	return rev, nil
+}
+
+// GetRevisions Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (uc *PostRevisionsUsecase) GetRevisions(postID int64, pag *models.Pagination) ([]models.PostRevision, error) {
+	return uc.repository.GetRevisions(postID, pag)
+}
+
+// Diff Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (uc *PostRevisionsUsecase) Diff(postID int64, fromID int64, toID int64) ([]models.PostRevisionChange, error) {
+	from, err := uc.getRevision(postID, fromID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := uc.getRevision(postID, toID)
+	if err != nil {
+		return nil, err
+	}
+	return models.DiffPostRevisions(from, to), nil
+}
+
+// Restore Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (uc *PostRevisionsUsecase) Restore(postID int64, revisionID int64) error {
+	if revisionID == CurrentRevision {
+		return nil
+	}
+	rev, err := uc.getRevision(postID, revisionID)
+	if err != nil {
+		return err
+	}
+
+	if err = uc.repository.Save(postID); err != nil {
+		return err
+	}
+	return uc.repository.Restore(rev)
+}
@@ -0,0 +1,98 @@
+package usecase_post_revisions
+
+import (
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	"patreon/internal/app/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type SuitePostRevisionsUsecase struct {
+	usecase.SuiteUsecase
+	uc Usecase
+}
+
+func (s *SuitePostRevisionsUsecase) SetupSuite() {
+	s.SuiteUsecase.SetupSuite()
+	s.uc = NewPostRevisionsUsecase(s.MockRevisionsRepository)
+}
+
+func (s *SuitePostRevisionsUsecase) TestPostRevisionsUsecase_GetRevisions() {
+	pag := &models.Pagination{Limit: 10}
+	expected := []models.PostRevision{{ID: 1, PostID: 2, Title: "title"}}
+	s.MockRevisionsRepository.EXPECT().
+		GetRevisions(int64(2), pag).
+		Times(1).
+		Return(expected, nil)
+	res, err := s.uc.GetRevisions(2, pag)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), expected, res)
+}
+
+func (s *SuitePostRevisionsUsecase) TestPostRevisionsUsecase_Diff_WithCurrent() {
+	s.MockRevisionsRepository.EXPECT().
+		Get(int64(1)).
+		Times(1).
+		Return(&models.PostRevision{ID: 1, PostID: 2, Title: "old"}, nil)
+	s.MockRevisionsRepository.EXPECT().
+		GetCurrent(int64(2)).
+		Times(1).
+		Return(&models.PostRevision{PostID: 2, Title: "new"}, nil)
+	res, err := s.uc.Diff(2, 1, CurrentRevision)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []models.PostRevisionChange{{Field: models.RevisionFieldTitle, Old: "old", New: "new"}}, res)
+}
+
+func (s *SuitePostRevisionsUsecase) TestPostRevisionsUsecase_Diff_OtherPost() {
+	s.MockRevisionsRepository.EXPECT().
+		Get(int64(1)).
+		Times(1).
+		Return(&models.PostRevision{ID: 1, PostID: 3}, nil)
+	_, err := s.uc.Diff(2, 1, CurrentRevision)
+	assert.Equal(s.T(), repository.NotFound, err)
+}
+
+func (s *SuitePostRevisionsUsecase) TestPostRevisionsUsecase_Restore() {
+	rev := &models.PostRevision{ID: 1, PostID: 2, Title: "old"}
+	s.MockRevisionsRepository.EXPECT().
+		Get(int64(1)).
+		Times(1).
+		Return(rev, nil)
+	s.MockRevisionsRepository.EXPECT().
+		Save(int64(2)).
+		Times(1).
+		Return(nil)
+	s.MockRevisionsRepository.EXPECT().
+		Restore(rev).
+		Times(1).
+		Return(nil)
+	err := s.uc.Restore(2, 1)
+	assert.NoError(s.T(), err)
+}
+
+func (s *SuitePostRevisionsUsecase) TestPostRevisionsUsecase_Restore_Error() {
+	s.MockRevisionsRepository.EXPECT().
+		Get(int64(1)).
+		Times(1).
+		Return(nil, repository.NotFound)
+	err := s.uc.Restore(2, 1)
+	assert.Equal(s.T(), repository.NotFound, err)
+
+	s.MockRevisionsRepository.EXPECT().
+		Get(int64(1)).
+		Times(1).
+		Return(&models.PostRevision{ID: 1, PostID: 2}, nil)
+	s.MockRevisionsRepository.EXPECT().
+		Save(int64(2)).
+		Times(1).
+		Return(repository.DefaultErrDB)
+	err = s.uc.Restore(2, 1)
+	assert.Equal(s.T(), repository.DefaultErrDB, err)
+}
+
+func TestPostRevisionsUsecase(t *testing.T) {
+	suite.Run(t, new(SuitePostRevisionsUsecase))
+}
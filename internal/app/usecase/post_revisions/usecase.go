@@ -0,0 +1,28 @@
+package usecase_post_revisions
+
+import "patreon/internal/app/models"
+
+// CurrentRevision id used in Diff instead of revision id to compare with current post content
+const CurrentRevision = 0
+
+//go:generate mockgen -destination=mocks/mock_post_revisions_usecase.go -package=mock_usecase -mock_names=Usecase=PostRevisionsUsecase . Usecase
+
+type Usecase interface {
+	// GetRevisions return page of post revisions, the last first
+	// Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetRevisions(postID int64, pag *models.Pagination) ([]models.PostRevision, error)
+	// Diff return changes of post fields from revision fromID to revision toID, toID can be CurrentRevision
+	// Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	Diff(postID int64, fromID int64, toID int64) ([]models.PostRevisionChange, error)
+	// Restore set post content from revision, current content is saved as revision before it
+	// Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	Restore(postID int64, revisionID int64) error
+}
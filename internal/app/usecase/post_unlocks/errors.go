@@ -0,0 +1,9 @@
+package usecase_post_unlocks
+
+import "github.com/pkg/errors"
+
+var (
+	PostNotBelongCreator = errors.New("post not belongs to this creator")
+	PostNotForSale       = errors.New("post can not be unlocked by payment")
+	PostAlreadyAvailable = errors.New("post is already available for user")
+)
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: patreon/internal/app/usecase/post_unlocks (interfaces: Usecase)
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	models "patreon/internal/app/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// PostUnlocksUsecase is a mock of Usecase interface.
+type PostUnlocksUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *PostUnlocksUsecaseMockRecorder
+}
+
+// PostUnlocksUsecaseMockRecorder is the mock recorder for PostUnlocksUsecase.
+type PostUnlocksUsecaseMockRecorder struct {
+	mock *PostUnlocksUsecase
+}
+
+// NewPostUnlocksUsecase creates a new mock instance.
+func NewPostUnlocksUsecase(ctrl *gomock.Controller) *PostUnlocksUsecase {
+	mock := &PostUnlocksUsecase{ctrl: ctrl}
+	mock.recorder = &PostUnlocksUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *PostUnlocksUsecase) EXPECT() *PostUnlocksUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *PostUnlocksUsecase) Create(arg0 *models.PostUnlock) (*models.PostUnlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(*models.PostUnlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *PostUnlocksUsecaseMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*PostUnlocksUsecase)(nil).Create), arg0)
+}
@@ -0,0 +1,101 @@
+package usecase_post_unlocks
+
+import (
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_creator "patreon/internal/app/repository/creator"
+	repository_pay_token "patreon/internal/app/repository/pay_token"
+	repository_post_unlocks "patreon/internal/app/repository/post_unlocks"
+	repository_posts "patreon/internal/app/repository/posts"
+	repository_user "patreon/internal/app/repository/user"
+	"patreon/pkg/utils"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+const unlockTokenExp = 3 * time.Hour
+
+type PostUnlocksUsecase struct {
+	repository   repository_post_unlocks.Repository
+	repoPosts    repository_posts.Repository
+	repoUser     repository_user.Repository
+	repoCreator  repository_creator.Repository
+	repoPayToken repository_pay_token.Repository
+	clock        utils.Clock
+}
+
+func NewPostUnlocksUsecase(repository repository_post_unlocks.Repository, repoPosts repository_posts.Repository,
+	repoUser repository_user.Repository, repoCreator repository_creator.Repository,
+	repoPayToken repository_pay_token.Repository, clock utils.Clock) *PostUnlocksUsecase {
+	return &PostUnlocksUsecase{
+		repository:   repository,
+		repoPosts:    repoPosts,
+		repoUser:     repoUser,
+		repoCreator:  repoCreator,
+		repoPayToken: repoPayToken,
+		clock:        clock,
+	}
+}
+
+// Create payment of unlock.UserID for post unlock.PostID by its unlock price in settlement currency
+// of creator, post is opened for user after payment with returned unlock.PayToken succeeded
+// Errors:
+//		PostNotBelongCreator
+//		PostNotForSale
+//		PostAlreadyAvailable
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+//			repository_redis.SetError
+func (usecase *PostUnlocksUsecase) Create(unlock *models.PostUnlock) (*models.PostUnlock, error) {
+	post, err := usecase.repoPosts.GetPost(unlock.PostID, unlock.UserID, false)
+	if err != nil {
+		return nil, err
+	}
+	if post.CreatorId != unlock.CreatorID {
+		return nil, PostNotBelongCreator
+	}
+	if post.IsDraft || post.UnlockPrice <= 0 {
+		return nil, PostNotForSale
+	}
+	if post.Unlocked || post.Awards == repository.NoAwards || unlock.UserID == post.CreatorId {
+		return nil, PostAlreadyAvailable
+	}
+	allowed, err := usecase.repoUser.IsAllowedAward(unlock.UserID, post.Awards)
+	if err != nil {
+		return nil, err
+	}
+	if allowed {
+		return nil, PostAlreadyAvailable
+	}
+
+	currency, err := usecase.repoCreator.GetCurrency(post.CreatorId)
+	if err != nil {
+		return nil, err
+	}
+
+	unlock.Amount = post.UnlockPrice
+	unlock.Currency = currency
+	unlock.PayToken = uuid.NewV4().String()
+	err = usecase.repoPayToken.SetToken(&models.PayTokenInfo{
+		Token:     unlock.PayToken,
+		UserID:    unlock.UserID,
+		CreatorID: unlock.CreatorID,
+		Price:     unlock.Amount,
+		Currency:  unlock.Currency,
+		ExpiresAt: usecase.clock.Now().Add(unlockTokenExp),
+	}, int(unlockTokenExp.Seconds()))
+	if err != nil {
+		return nil, err
+	}
+	// token only pays for this unlock and must not be reused for award subscription
+	if _, err = usecase.repoPayToken.MarkUsed(unlock.PayToken, int(unlockTokenExp.Seconds())); err != nil {
+		return nil, err
+	}
+
+	if err = usecase.repository.Create(unlock); err != nil {
+		return nil, err
+	}
+	return unlock, nil
+}
@@ -0,0 +1,112 @@
+package usecase_post_unlocks
+
+import (
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	"patreon/internal/app/usecase"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type SuitePostUnlocksUsecase struct {
+	usecase.SuiteUsecase
+	clock *usecase.FakeClock
+	uc    Usecase
+}
+
+func (s *SuitePostUnlocksUsecase) SetupSuite() {
+	s.SuiteUsecase.SetupSuite()
+	s.clock = &usecase.FakeClock{Time: time.Date(2021, 12, 1, 12, 0, 0, 0, time.UTC)}
+	s.uc = NewPostUnlocksUsecase(s.MockPostUnlocksRepository, s.MockPostsRepository, s.MockUserRepository,
+		s.MockCreatorRepository, s.MockPayTokenRepository, s.clock)
+}
+
+func (s *SuitePostUnlocksUsecase) TestPostUnlocksUsecase_Create_OK() {
+	unlock := models.TestPostUnlock()
+	post := &models.Post{ID: unlock.PostID, CreatorId: unlock.CreatorID, Awards: 4, UnlockPrice: models.NewDecimal(250)}
+	s.MockPostsRepository.EXPECT().
+		GetPost(unlock.PostID, unlock.UserID, false).
+		Times(1).
+		Return(post, nil)
+	s.MockUserRepository.EXPECT().
+		IsAllowedAward(unlock.UserID, post.Awards).
+		Times(1).
+		Return(false, nil)
+	s.MockCreatorRepository.EXPECT().
+		GetCurrency(post.CreatorId).
+		Times(1).
+		Return(models.DefaultCurrency, nil)
+	s.MockPayTokenRepository.EXPECT().
+		SetToken(gomock.Any(), int(unlockTokenExp.Seconds())).
+		Times(1).
+		DoAndReturn(func(info *models.PayTokenInfo, _ int) error {
+			assert.Equal(s.T(), unlock.UserID, info.UserID)
+			assert.Equal(s.T(), post.UnlockPrice, info.Price)
+			assert.Equal(s.T(), models.DefaultCurrency, info.Currency)
+			assert.Equal(s.T(), s.clock.Time.Add(unlockTokenExp), info.ExpiresAt)
+			return nil
+		})
+	s.MockPayTokenRepository.EXPECT().
+		MarkUsed(gomock.Any(), int(unlockTokenExp.Seconds())).
+		Times(1).
+		Return(true, nil)
+	s.MockPostUnlocksRepository.EXPECT().
+		Create(unlock).
+		Times(1).
+		Return(nil)
+	res, err := s.uc.Create(unlock)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), post.UnlockPrice, res.Amount)
+	assert.NotEmpty(s.T(), res.PayToken)
+}
+
+func (s *SuitePostUnlocksUsecase) TestPostUnlocksUsecase_Create_Errors() {
+	unlock := models.TestPostUnlock()
+	s.MockPostsRepository.EXPECT().
+		GetPost(unlock.PostID, unlock.UserID, false).
+		Times(1).
+		Return(nil, repository.NotFound)
+	_, err := s.uc.Create(unlock)
+	assert.Equal(s.T(), repository.NotFound, err)
+
+	s.MockPostsRepository.EXPECT().
+		GetPost(unlock.PostID, unlock.UserID, false).
+		Times(1).
+		Return(&models.Post{CreatorId: unlock.CreatorID + 1, Awards: 4, UnlockPrice: models.NewDecimal(250)}, nil)
+	_, err = s.uc.Create(unlock)
+	assert.Equal(s.T(), PostNotBelongCreator, err)
+
+	s.MockPostsRepository.EXPECT().
+		GetPost(unlock.PostID, unlock.UserID, false).
+		Times(1).
+		Return(&models.Post{CreatorId: unlock.CreatorID, Awards: 4}, nil)
+	_, err = s.uc.Create(unlock)
+	assert.Equal(s.T(), PostNotForSale, err)
+
+	s.MockPostsRepository.EXPECT().
+		GetPost(unlock.PostID, unlock.UserID, false).
+		Times(1).
+		Return(&models.Post{CreatorId: unlock.CreatorID, Awards: 4, UnlockPrice: 250, Unlocked: true}, nil)
+	_, err = s.uc.Create(unlock)
+	assert.Equal(s.T(), PostAlreadyAvailable, err)
+
+	s.MockPostsRepository.EXPECT().
+		GetPost(unlock.PostID, unlock.UserID, false).
+		Times(1).
+		Return(&models.Post{CreatorId: unlock.CreatorID, Awards: 4, UnlockPrice: models.NewDecimal(250)}, nil)
+	s.MockUserRepository.EXPECT().
+		IsAllowedAward(unlock.UserID, int64(4)).
+		Times(1).
+		Return(true, nil)
+	_, err = s.uc.Create(unlock)
+	assert.Equal(s.T(), PostAlreadyAvailable, err)
+}
+
+func TestPostUnlocksUsecase(t *testing.T) {
+	suite.Run(t, new(SuitePostUnlocksUsecase))
+}
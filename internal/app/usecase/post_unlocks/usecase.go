@@ -0,0 +1,17 @@
+package usecase_post_unlocks
+
+import "patreon/internal/app/models"
+
+//go:generate mockgen -destination=mocks/mock_post_unlocks_usecase.go -package=mock_usecase -mock_names=Usecase=PostUnlocksUsecase . Usecase
+
+type Usecase interface {
+	// Create Errors:
+	//		PostNotBelongCreator
+	//		PostNotForSale
+	//		PostAlreadyAvailable
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	//			repository_redis.SetError
+	Create(unlock *models.PostUnlock) (*models.PostUnlock, error)
+}
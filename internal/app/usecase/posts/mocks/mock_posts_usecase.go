@@ -67,18 +67,18 @@ func (mr *PostsUsecaseMockRecorder) Delete(arg0 interface{}) *gomock.Call {
 }
 
 // GetAvailablePosts mocks base method.
-func (m *PostsUsecase) GetAvailablePosts(arg0 int64, arg1 *models.Pagination) ([]models.AvailablePost, error) {
+func (m *PostsUsecase) GetAvailablePosts(arg0 int64, arg1 *models.Pagination, arg2 string) ([]models.AvailablePost, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAvailablePosts", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetAvailablePosts", arg0, arg1, arg2)
 	ret0, _ := ret[0].([]models.AvailablePost)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAvailablePosts indicates an expected call of GetAvailablePosts.
-func (mr *PostsUsecaseMockRecorder) GetAvailablePosts(arg0, arg1 interface{}) *gomock.Call {
+func (mr *PostsUsecaseMockRecorder) GetAvailablePosts(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailablePosts", reflect.TypeOf((*PostsUsecase)(nil).GetAvailablePosts), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailablePosts", reflect.TypeOf((*PostsUsecase)(nil).GetAvailablePosts), arg0, arg1, arg2)
 }
 
 // GetCreatorId mocks base method.
@@ -96,6 +96,21 @@ func (mr *PostsUsecaseMockRecorder) GetCreatorId(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCreatorId", reflect.TypeOf((*PostsUsecase)(nil).GetCreatorId), arg0)
 }
 
+// GetCreatorTags mocks base method.
+func (m *PostsUsecase) GetCreatorTags(arg0 int64, arg1 bool) ([]models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCreatorTags", arg0, arg1)
+	ret0, _ := ret[0].([]models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCreatorTags indicates an expected call of GetCreatorTags.
+func (mr *PostsUsecaseMockRecorder) GetCreatorTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCreatorTags", reflect.TypeOf((*PostsUsecase)(nil).GetCreatorTags), arg0, arg1)
+}
+
 // GetPost mocks base method.
 func (m *PostsUsecase) GetPost(arg0, arg1 int64, arg2 bool) (*models.PostWithAttach, error) {
 	m.ctrl.T.Helper()
@@ -112,18 +127,18 @@ func (mr *PostsUsecaseMockRecorder) GetPost(arg0, arg1, arg2 interface{}) *gomoc
 }
 
 // GetPosts mocks base method.
-func (m *PostsUsecase) GetPosts(arg0, arg1 int64, arg2 *models.Pagination, arg3 bool) ([]models.Post, error) {
+func (m *PostsUsecase) GetPosts(arg0, arg1 int64, arg2 *models.Pagination, arg3 bool, arg4 string) ([]models.Post, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPosts", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "GetPosts", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].([]models.Post)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPosts indicates an expected call of GetPosts.
-func (mr *PostsUsecaseMockRecorder) GetPosts(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *PostsUsecaseMockRecorder) GetPosts(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPosts", reflect.TypeOf((*PostsUsecase)(nil).GetPosts), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPosts", reflect.TypeOf((*PostsUsecase)(nil).GetPosts), arg0, arg1, arg2, arg3, arg4)
 }
 
 // LoadCover mocks base method.
@@ -140,6 +155,36 @@ func (mr *PostsUsecaseMockRecorder) LoadCover(arg0, arg1, arg2 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadCover", reflect.TypeOf((*PostsUsecase)(nil).LoadCover), arg0, arg1, arg2)
 }
 
+// PublishScheduled mocks base method.
+func (m *PostsUsecase) PublishScheduled(arg0 *logrus.Entry) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishScheduled", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishScheduled indicates an expected call of PublishScheduled.
+func (mr *PostsUsecaseMockRecorder) PublishScheduled(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishScheduled", reflect.TypeOf((*PostsUsecase)(nil).PublishScheduled), arg0)
+}
+
+// SearchPosts mocks base method.
+func (m *PostsUsecase) SearchPosts(arg0, arg1 int64, arg2 string, arg3 *models.Pagination) ([]models.SearchedPost, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchPosts", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.SearchedPost)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchPosts indicates an expected call of SearchPosts.
+func (mr *PostsUsecaseMockRecorder) SearchPosts(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPosts", reflect.TypeOf((*PostsUsecase)(nil).SearchPosts), arg0, arg1, arg2, arg3)
+}
+
 // Update mocks base method.
 func (m *PostsUsecase) Update(arg0 *logrus.Entry, arg1 *models.UpdatePost) error {
 	m.ctrl.T.Helper()
@@ -1 +1,283 @@
 package posts
+
+import (
+	"patreon/internal/app"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	"patreon/internal/app/usecase"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type SuitePostsUsecase struct {
+	usecase.SuiteUsecase
+	uc    Usecase
+	clock *usecase.FakeClock
+	log   *logrus.Entry
+}
+
+func (s *SuitePostsUsecase) SetupSuite() {
+	s.SuiteUsecase.SetupSuite()
+	s.clock = &usecase.FakeClock{Time: time.Date(2021, 12, 1, 10, 0, 0, 0, time.UTC)}
+	s.uc = NewPostsUsecase(s.MockPostsRepository, s.MockAttachesRepository, s.MockRevisionsRepository,
+		s.MockTagsRepository, s.MockFileClient, s.MockPusher, s.clock, s.MockConvector)
+	s.log = logrus.NewEntry(s.Logger)
+}
+
+func (s *SuitePostsUsecase) TestPostsUsecase_Create_Published() {
+	post := &models.CreatePost{Title: "title", Awards: 1, CreatorId: 2}
+	s.MockPostsRepository.EXPECT().
+		Create(post).
+		Times(1).
+		Return(int64(3), nil)
+	s.MockPusher.EXPECT().
+		NewPost(int64(2), int64(3), "title").
+		Times(1).
+		Return(nil)
+	id, err := s.uc.Create(s.log, post)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(3), id)
+}
+
+func (s *SuitePostsUsecase) TestPostsUsecase_Create_Scheduled() {
+	publishAt := s.clock.Now().Add(time.Hour)
+	post := &models.CreatePost{Title: "title", Awards: 1, CreatorId: 2, PublishAt: &publishAt}
+	s.MockPostsRepository.EXPECT().
+		Create(post).
+		Times(1).
+		Return(int64(3), nil)
+	id, err := s.uc.Create(s.log, post)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(3), id)
+	assert.True(s.T(), post.IsDraft)
+}
+
+func (s *SuitePostsUsecase) TestPostsUsecase_Create_IncorrectPublishAt() {
+	publishAt := s.clock.Now().Add(-time.Hour)
+	post := &models.CreatePost{Title: "title", Awards: 1, CreatorId: 2, PublishAt: &publishAt}
+	_, err := s.uc.Create(s.log, post)
+	assert.Equal(s.T(), models.IncorrectPublishAt, err)
+
+	publishAt = s.clock.Now().Add(time.Hour)
+	post = &models.CreatePost{Awards: 1, CreatorId: 2, IsDraft: true, PublishAt: &publishAt}
+	_, err = s.uc.Create(s.log, post)
+	assert.True(s.T(), errors.Is(err, models.EmptyTitle))
+}
+
+func (s *SuitePostsUsecase) TestPostsUsecase_Update_Scheduled() {
+	publishAt := s.clock.Now().Add(time.Hour)
+	post := &models.UpdatePost{ID: 3, Title: "title", Awards: 1, PublishAt: &publishAt}
+	s.MockRevisionsRepository.EXPECT().
+		Save(post.ID).
+		Times(1).
+		Return(nil)
+	s.MockPostsRepository.EXPECT().
+		UpdatePost(post).
+		Times(1).
+		Return(nil)
+	err := s.uc.Update(s.log, post)
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), post.IsDraft)
+
+	publishAt = s.clock.Now()
+	err = s.uc.Update(s.log, &models.UpdatePost{ID: 3, Title: "title", Awards: 1, PublishAt: &publishAt})
+	assert.Equal(s.T(), models.IncorrectPublishAt, err)
+}
+
+func (s *SuitePostsUsecase) TestPostsUsecase_Update_SaveRevisionError() {
+	post := &models.UpdatePost{ID: 3, Title: "title", Awards: 1, IsDraft: true}
+	s.MockRevisionsRepository.EXPECT().
+		Save(post.ID).
+		Times(1).
+		Return(repository.DefaultErrDB)
+	err := s.uc.Update(s.log, post)
+	assert.Equal(s.T(), repository.DefaultErrDB, err)
+}
+
+func (s *SuitePostsUsecase) TestPostsUsecase_PublishScheduled() {
+	posts := []models.Post{
+		{ID: 3, CreatorId: 2, Title: "first"},
+		{ID: 4, CreatorId: 5, Title: "second"},
+	}
+	s.MockPostsRepository.EXPECT().
+		PublishDuePosts(s.clock.Now()).
+		Times(1).
+		Return(posts, nil)
+	s.MockPusher.EXPECT().
+		NewPost(int64(2), int64(3), "first").
+		Times(1).
+		Return(nil)
+	s.MockPusher.EXPECT().
+		NewPost(int64(5), int64(4), "second").
+		Times(1).
+		Return(app.UnknownError)
+	published, err := s.uc.PublishScheduled(s.log)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 2, published)
+
+	s.MockPostsRepository.EXPECT().
+		PublishDuePosts(s.clock.Now()).
+		Times(1).
+		Return(nil, repository.DefaultErrDB)
+	_, err = s.uc.PublishScheduled(s.log)
+	assert.Equal(s.T(), repository.DefaultErrDB, err)
+}
+
+func (s *SuitePostsUsecase) TestPostsUsecase_Create_WithTags() {
+	post := &models.CreatePost{Title: "title", Awards: 1, CreatorId: 2, IsDraft: true,
+		Tags: []string{"Tutorial", " wip", "tutorial"}}
+	s.MockPostsRepository.EXPECT().
+		Create(post).
+		Times(1).
+		Return(int64(3), nil)
+	s.MockTagsRepository.EXPECT().
+		AddToPost(int64(3), []string{"tutorial", "wip"}).
+		Times(1).
+		Return(nil)
+	id, err := s.uc.Create(s.log, post)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(3), id)
+
+	post = &models.CreatePost{Title: "title", Awards: 1, CreatorId: 2, Tags: []string{""}}
+	_, err = s.uc.Create(s.log, post)
+	assert.Equal(s.T(), models.IncorrectTag, err)
+}
+
+func (s *SuitePostsUsecase) TestPostsUsecase_Update_Tags() {
+	post := &models.UpdatePost{ID: 3, Title: "title", Awards: 1, IsDraft: true,
+		AddTags: []string{"Episode"}, RemoveTags: []string{"wip"}}
+	s.MockRevisionsRepository.EXPECT().
+		Save(post.ID).
+		Times(1).
+		Return(nil)
+	s.MockPostsRepository.EXPECT().
+		UpdatePost(post).
+		Times(1).
+		Return(nil)
+	s.MockTagsRepository.EXPECT().
+		RemoveFromPost(post.ID, []string{"wip"}).
+		Times(1).
+		Return(nil)
+	s.MockTagsRepository.EXPECT().
+		AddToPost(post.ID, []string{"episode"}).
+		Times(1).
+		Return(nil)
+	err := s.uc.Update(s.log, post)
+	assert.NoError(s.T(), err)
+
+	err = s.uc.Update(s.log, &models.UpdatePost{ID: 3, Title: "title", RemoveTags: []string{"a,b"}})
+	assert.Equal(s.T(), models.IncorrectTag, err)
+}
+
+func (s *SuitePostsUsecase) TestPostsUsecase_GetPosts_Tags() {
+	pag := &models.Pagination{Limit: 10}
+	posts := []models.Post{{ID: 3, CreatorId: 2}, {ID: 4, CreatorId: 2}}
+	s.MockPostsRepository.EXPECT().
+		GetPosts(int64(2), int64(5), pag, false, "tutorial").
+		Times(1).
+		Return(posts, nil)
+	s.MockTagsRepository.EXPECT().
+		GetPostsTags([]int64{3, 4}).
+		Times(1).
+		Return(map[int64][]string{3: {"tutorial", "wip"}}, nil)
+	res, err := s.uc.GetPosts(2, 5, pag, false, " Tutorial")
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []models.Post{{ID: 3, CreatorId: 2, Tags: []string{"tutorial", "wip"}},
+		{ID: 4, CreatorId: 2}}, res)
+
+	s.MockPostsRepository.EXPECT().
+		GetPosts(int64(2), int64(5), pag, false, "").
+		Times(1).
+		Return([]models.Post{}, nil)
+	res, err = s.uc.GetPosts(2, 5, pag, false, "")
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), res)
+
+	_, err = s.uc.GetPosts(2, 5, pag, false, "a,b")
+	assert.Equal(s.T(), models.IncorrectTag, err)
+}
+
+func (s *SuitePostsUsecase) TestPostsUsecase_GetAvailablePosts_Tags() {
+	pag := &models.Pagination{Limit: 10}
+	posts := []models.AvailablePost{{CreatorNickname: "creator", Post: models.Post{ID: 3}}}
+	s.MockPostsRepository.EXPECT().
+		GetAvailablePosts(int64(5), pag, "").
+		Times(1).
+		Return(posts, nil)
+	s.MockTagsRepository.EXPECT().
+		GetPostsTags([]int64{3}).
+		Times(1).
+		Return(nil, repository.DefaultErrDB)
+	_, err := s.uc.GetAvailablePosts(5, pag, "")
+	assert.Equal(s.T(), repository.DefaultErrDB, err)
+}
+
+func (s *SuitePostsUsecase) TestPostsUsecase_SearchPosts() {
+	pag := &models.Pagination{Limit: 10}
+	posts := []models.SearchedPost{{AvailablePost: models.AvailablePost{Post: models.Post{ID: 3}},
+		Snippet: "<b>guitar</b> lesson"}}
+	s.MockPostsRepository.EXPECT().
+		SearchPosts(int64(5), int64(AllCreators), "guitar", pag).
+		Times(1).
+		Return(posts, nil)
+	s.MockTagsRepository.EXPECT().
+		GetPostsTags([]int64{3}).
+		Times(1).
+		Return(map[int64][]string{3: {"lesson"}}, nil)
+	res, err := s.uc.SearchPosts(5, AllCreators, "guitar", pag)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []models.SearchedPost{{AvailablePost: models.AvailablePost{
+		Post: models.Post{ID: 3, Tags: []string{"lesson"}}}, Snippet: "<b>guitar</b> lesson"}}, res)
+
+	s.MockPostsRepository.EXPECT().
+		SearchPosts(int64(5), int64(2), "guitar", pag).
+		Times(1).
+		Return(nil, repository.DefaultErrDB)
+	_, err = s.uc.SearchPosts(5, 2, "guitar", pag)
+	assert.Equal(s.T(), repository.DefaultErrDB, err)
+}
+
+func (s *SuitePostsUsecase) TestPostsUsecase_GetPost_Locked() {
+	post := &models.Post{ID: 3, CreatorId: 2, Awards: 4, Preview: "first lines", Locked: true}
+	s.MockPostsRepository.EXPECT().
+		GetPost(post.ID, int64(5), true).
+		Times(1).
+		Return(post, nil)
+	s.MockTagsRepository.EXPECT().
+		GetPostsTags([]int64{post.ID}).
+		Times(1).
+		Return(map[int64][]string{}, nil)
+	s.MockAttachesRepository.EXPECT().
+		GetAttaches(post.ID).
+		Times(1).
+		Return([]models.AttachWithoutLevel{{ID: 1, PostId: post.ID, Type: models.Text, Value: "secret"}}, nil)
+	res, err := s.uc.GetPost(post.ID, 5, true)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []models.AttachWithoutLevel{{ID: 1, PostId: post.ID, Type: models.Text}}, res.Data)
+
+	opened := &models.Post{ID: 3, CreatorId: 2}
+	s.MockPostsRepository.EXPECT().
+		GetPost(opened.ID, int64(2), false).
+		Times(1).
+		Return(opened, nil)
+	s.MockTagsRepository.EXPECT().
+		GetPostsTags([]int64{opened.ID}).
+		Times(1).
+		Return(map[int64][]string{}, nil)
+	s.MockAttachesRepository.EXPECT().
+		GetAttaches(opened.ID).
+		Times(1).
+		Return([]models.AttachWithoutLevel{{ID: 1, PostId: opened.ID, Type: models.Text, Value: "secret"}}, nil)
+	res, err = s.uc.GetPost(opened.ID, 2, false)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "secret", res.Data[0].Value)
+}
+
+func TestPostsUsecase(t *testing.T) {
+	suite.Run(t, new(SuitePostsUsecase))
+}
@@ -7,7 +7,9 @@ import (
 	"patreon/internal/app"
 	"patreon/internal/app/models"
 	repoAttaches "patreon/internal/app/repository/attaches"
+	repoRevisions "patreon/internal/app/repository/post_revisions"
 	repoPosts "patreon/internal/app/repository/posts"
+	repoTags "patreon/internal/app/repository/tags"
 	"patreon/internal/microservices/files/delivery/grpc/client"
 	repoFiles "patreon/internal/microservices/files/files/repository/files"
 	push_client "patreon/internal/microservices/push/delivery/client"
@@ -19,13 +21,17 @@ import (
 type PostsUsecase struct {
 	repository      repoPosts.Repository
 	repositoryData  repoAttaches.Repository
+	repoRevisions   repoRevisions.Repository
+	repoTags        repoTags.Repository
 	filesRepository client.FileServiceClient
 	imageConvector  utils.ImageConverter
 	pusher          push_client.Pusher
+	clock           utils.Clock
 }
 
 func NewPostsUsecase(repository repoPosts.Repository, repositoryData repoAttaches.Repository,
-	fileClient client.FileServiceClient, pusher push_client.Pusher, convector ...utils.ImageConverter) *PostsUsecase {
+	repositoryRevisions repoRevisions.Repository, repositoryTags repoTags.Repository, fileClient client.FileServiceClient,
+	pusher push_client.Pusher, clock utils.Clock, convector ...utils.ImageConverter) *PostsUsecase {
 	conv := utils.ImageConverter(&utils.ConverterToWebp{})
 	if len(convector) != 0 {
 		conv = convector[0]
@@ -33,63 +39,189 @@ func NewPostsUsecase(repository repoPosts.Repository, repositoryData repoAttache
 	return &PostsUsecase{
 		repository:      repository,
 		repositoryData:  repositoryData,
+		repoRevisions:   repositoryRevisions,
+		repoTags:        repositoryTags,
 		imageConvector:  conv,
 		filesRepository: fileClient,
 		pusher:          pusher,
+		clock:           clock,
 	}
 }
 
+// normalizeTagFilter Errors:
+//		models.IncorrectTag
+func normalizeTagFilter(tag string) (string, error) {
+	if tag == "" {
+		return tag, nil
+	}
+	tags, err := models.NormalizeTags([]string{tag})
+	if err != nil {
+		return "", err
+	}
+	return tags[0], nil
+}
+
+// getPostsTags Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *PostsUsecase) getPostsTags(postIDs []int64) (map[int64][]string, error) {
+	if len(postIDs) == 0 {
+		return map[int64][]string{}, nil
+	}
+	return usecase.repoTags.GetPostsTags(postIDs)
+}
+
 // GetPosts Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		models.IncorrectTag
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (usecase *PostsUsecase) GetPosts(creatorId int64, userId int64,
-	pag *models.Pagination, withDraft bool) ([]models.Post, error) {
-	return usecase.repository.GetPosts(creatorId, userId, pag, withDraft)
+	pag *models.Pagination, withDraft bool, tag string) ([]models.Post, error) {
+	tag, err := normalizeTagFilter(tag)
+	if err != nil {
+		return nil, err
+	}
+	posts, err := usecase.repository.GetPosts(creatorId, userId, pag, withDraft, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, len(posts))
+	for i, post := range posts {
+		ids[i] = post.ID
+	}
+	tags, err := usecase.getPostsTags(ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range posts {
+		posts[i].Tags = tags[posts[i].ID]
+	}
+	return posts, nil
 }
 
 // GetAvailablePosts Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
-func (usecase *PostsUsecase) GetAvailablePosts(userID int64, pag *models.Pagination) ([]models.AvailablePost, error) {
-	return usecase.repository.GetAvailablePosts(userID, pag)
+//		models.IncorrectTag
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *PostsUsecase) GetAvailablePosts(userID int64, pag *models.Pagination,
+	tag string) ([]models.AvailablePost, error) {
+	tag, err := normalizeTagFilter(tag)
+	if err != nil {
+		return nil, err
+	}
+	posts, err := usecase.repository.GetAvailablePosts(userID, pag, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, len(posts))
+	for i, post := range posts {
+		ids[i] = post.ID
+	}
+	tags, err := usecase.getPostsTags(ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range posts {
+		posts[i].Tags = tags[posts[i].ID]
+	}
+	return posts, nil
+}
+
+// SearchPosts Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *PostsUsecase) SearchPosts(userId int64, creatorId int64, searchString string,
+	pag *models.Pagination) ([]models.SearchedPost, error) {
+	posts, err := usecase.repository.SearchPosts(userId, creatorId, searchString, pag)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, len(posts))
+	for i, post := range posts {
+		ids[i] = post.ID
+	}
+	tags, err := usecase.getPostsTags(ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range posts {
+		posts[i].Tags = tags[posts[i].ID]
+	}
+	return posts, nil
+}
+
+// GetCreatorTags Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *PostsUsecase) GetCreatorTags(creatorId int64, withDraft bool) ([]models.Tag, error) {
+	return usecase.repoTags.GetCreatorTags(creatorId, withDraft)
 }
 
 // GetPost Errors:
 //		repository.NotFound
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (usecase *PostsUsecase) GetPost(postId int64, userId int64, addView bool) (*models.PostWithAttach, error) {
 	post, err := usecase.repository.GetPost(postId, userId, addView)
 	if err != nil {
 		return nil, err
 	}
+	tags, err := usecase.repoTags.GetPostsTags([]int64{postId})
+	if err != nil {
+		return nil, err
+	}
+	post.Tags = tags[postId]
+
 	res := &models.PostWithAttach{Post: post, Data: []models.AttachWithoutLevel{}}
 	res.Data, err = usecase.repositoryData.GetAttaches(postId)
 	if err != nil {
 		return nil, err
 	}
+	if post.Locked {
+		for i := range res.Data {
+			res.Data[i].Value = ""
+		}
+	}
 	return res, err
 }
 
 // Delete Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (usecase *PostsUsecase) Delete(postId int64) error {
 	return usecase.repository.Delete(postId)
 }
 
 // Update Errors:
-// 		repository.NotFound
+//		repository.NotFound
 //		models.InvalidAwardsId
 //		models.EmptyTitle
+//		models.InvalidUnlockPrice
+//		models.IncorrectPublishAt
+//		models.IncorrectTag
 //		app.GeneralError with Errors:
 //			app.UnknownError
 //			repository.DefaultErrDB
 func (usecase *PostsUsecase) Update(log *logrus.Entry, post *models.UpdatePost) error {
+	if err := models.ValidatePublishAt(post.PublishAt, usecase.clock.Now()); err != nil {
+		return err
+	}
+	var err error
+	if post.AddTags, err = models.NormalizeTags(post.AddTags); err != nil {
+		return err
+	}
+	if post.RemoveTags, err = models.NormalizeTags(post.RemoveTags); err != nil {
+		return err
+	}
+
 	if err := post.Validate(); err != nil {
-		if errors.Is(err, models.EmptyTitle) || errors.Is(err, models.InvalidAwardsId) {
-			if post.IsDraft && errors.Is(err, models.EmptyTitle) {
-				return usecase.repository.UpdatePost(post)
+		if errors.Is(err, models.EmptyTitle) || errors.Is(err, models.InvalidAwardsId) ||
+			errors.Is(err, models.InvalidUnlockPrice) {
+			if post.IsDraft && post.PublishAt == nil && errors.Is(err, models.EmptyTitle) {
+				return usecase.updatePost(post)
 			}
 			return err
 		}
@@ -99,39 +231,92 @@ func (usecase *PostsUsecase) Update(log *logrus.Entry, post *models.UpdatePost)
 		}
 	}
 
+	if post.PublishAt != nil {
+		post.IsDraft = true
+	}
+
 	if !post.IsDraft {
-		if oldPost, err := usecase.repository.GetPost(post.ID, EmptyUser, false); err == nil {
-			if oldPost.IsDraft {
-				if creatorId, err := usecase.repository.GetPostCreator(post.ID); err == nil {
+		if creatorId, err := usecase.repository.GetPostCreator(post.ID); err == nil {
+			// draft is found only for its creator
+			if oldPost, err := usecase.repository.GetPost(post.ID, creatorId, false); err == nil {
+				if oldPost.IsDraft {
 					errPush := usecase.pusher.NewPost(creatorId, post.ID, post.Title)
 					if errPush != nil {
 						log.Errorf("Try push new post, and got err %s", errPush)
 					}
-				} else {
-					log.Errorf("Try get cretor post, and got err %s", err)
 				}
+			} else {
+				log.Errorf("Try get cretor old post, and got err %s", err)
 			}
 		} else {
-			log.Errorf("Try get cretor old post, and got err %s", err)
+			log.Errorf("Try get cretor post, and got err %s", err)
+		}
+	}
+
+	return usecase.updatePost(post)
+}
+
+// updatePost save current content of post as revision before update, then change tags of post
+// Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *PostsUsecase) updatePost(post *models.UpdatePost) error {
+	if err := usecase.repoRevisions.Save(post.ID); err != nil {
+		return err
+	}
+	if err := usecase.repository.UpdatePost(post); err != nil {
+		return err
+	}
+
+	if len(post.RemoveTags) != 0 {
+		if err := usecase.repoTags.RemoveFromPost(post.ID, post.RemoveTags); err != nil {
+			return err
 		}
 	}
+	if len(post.AddTags) != 0 {
+		return usecase.repoTags.AddToPost(post.ID, post.AddTags)
+	}
+	return nil
+}
 
-	return usecase.repository.UpdatePost(post)
+// createPost create post with its tags
+// Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *PostsUsecase) createPost(post *models.CreatePost) (int64, error) {
+	postId, err := usecase.repository.Create(post)
+	if err != nil || len(post.Tags) == 0 {
+		return postId, err
+	}
+	return postId, usecase.repoTags.AddToPost(postId, post.Tags)
 }
 
 // Create Errors:
 //		models.InvalidAwardsId
 //		models.InvalidCreatorId
 //		models.EmptyTitle
+//		models.InvalidUnlockPrice
+//		models.IncorrectPublishAt
+//		models.IncorrectTag
 //		app.GeneralError with Errors:
 //			app.UnknownError
 //			repository.DefaultErrDB
 func (usecase *PostsUsecase) Create(log *logrus.Entry, post *models.CreatePost) (int64, error) {
+	if err := models.ValidatePublishAt(post.PublishAt, usecase.clock.Now()); err != nil {
+		return app.InvalidInt, err
+	}
+	tags, err := models.NormalizeTags(post.Tags)
+	if err != nil {
+		return app.InvalidInt, err
+	}
+	post.Tags = tags
+
 	if err := post.Validate(); err != nil {
 		if errors.Is(err, models.EmptyTitle) || errors.Is(err, models.InvalidCreatorId) ||
-			errors.Is(err, models.InvalidAwardsId) {
-			if errors.Is(err, models.EmptyTitle) && post.IsDraft {
-				return usecase.repository.Create(post)
+			errors.Is(err, models.InvalidAwardsId) || errors.Is(err, models.InvalidUnlockPrice) {
+			if errors.Is(err, models.EmptyTitle) && post.IsDraft && post.PublishAt == nil {
+				return usecase.createPost(post)
 			}
 			return app.InvalidInt, err
 		}
@@ -140,7 +325,11 @@ func (usecase *PostsUsecase) Create(log *logrus.Entry, post *models.CreatePost)
 			ExternalErr: errors.Wrap(err, "failed process of validation creator"),
 		}
 	}
-	postId, err := usecase.repository.Create(post)
+	if post.PublishAt != nil {
+		post.IsDraft = true
+	}
+
+	postId, err := usecase.createPost(post)
 	if !post.IsDraft {
 		errPush := usecase.pusher.NewPost(post.CreatorId, postId, post.Title)
 		if errPush != nil {
@@ -150,10 +339,27 @@ func (usecase *PostsUsecase) Create(log *logrus.Entry, post *models.CreatePost)
 	return postId, err
 }
 
+// PublishScheduled Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *PostsUsecase) PublishScheduled(log *logrus.Entry) (int, error) {
+	posts, err := usecase.repository.PublishDuePosts(usecase.clock.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	for _, post := range posts {
+		if errPush := usecase.pusher.NewPost(post.CreatorId, post.ID, post.Title); errPush != nil {
+			log.Errorf("Try push new scheduled post %d, and got err %s", post.ID, errPush)
+		}
+	}
+	return len(posts), nil
+}
+
 // GetCreatorId Errors:
-//  	repository.NotFound
-//  	app.GeneralError with Errors:
-//   		repository.DefaultErrDB
+//			repository.NotFound
+//			app.GeneralError with Errors:
+//		 		repository.DefaultErrDB
 func (usecase *PostsUsecase) GetCreatorId(postId int64) (int64, error) {
 	aw, err := usecase.repository.GetPostCreator(postId)
 	if err != nil {
@@ -163,13 +369,13 @@ func (usecase *PostsUsecase) GetCreatorId(postId int64) (int64, error) {
 }
 
 // LoadCover Errors:
-//		repository.NotFound
-//		app.GeneralError with Errors:
-//			repository.DefaultErrDB
-//			repository_os.ErrorCreate
-//   		repository_os.ErrorCopyFile
-//			utils.ConvertErr
-//  		utils.UnknownExtOfFileName
+//				repository.NotFound
+//				app.GeneralError with Errors:
+//					repository.DefaultErrDB
+//					repository_os.ErrorCreate
+//		  		repository_os.ErrorCopyFile
+//					utils.ConvertErr
+//		 		utils.UnknownExtOfFileName
 func (usecase *PostsUsecase) LoadCover(data io.Reader, name repoFiles.FileName, postId int64) error {
 	if _, err := usecase.repository.GetPostCreator(postId); err != nil {
 		return err
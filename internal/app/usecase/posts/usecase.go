@@ -4,28 +4,50 @@ import (
 	"github.com/sirupsen/logrus"
 	"io"
 	"patreon/internal/app/models"
+	repoPosts "patreon/internal/app/repository/posts"
 	repoFiles "patreon/internal/microservices/files/files/repository/files"
 )
 
 const (
 	BaseLimit = 10
 	EmptyUser = -2
+	// AllCreators used in SearchPosts instead of creator id for searching among posts of all creators
+	AllCreators = repoPosts.AllCreators
 )
 
 //go:generate mockgen -destination=mocks/mock_posts_usecase.go -package=mock_usecase -mock_names=Usecase=PostsUsecase . Usecase
 
 type Usecase interface {
 
-	// GetAvailablePosts Errors:
+	// GetAvailablePosts return posts available for user with their tags, filtered by tag if it is not empty
+	// Errors:
+	// 		models.IncorrectTag
 	// 		app.GeneralError with Errors:
 	// 			repository.DefaultErrDB
-	GetAvailablePosts(userID int64, pag *models.Pagination) ([]models.AvailablePost, error)
-	// GetPosts Errors:
+	GetAvailablePosts(userID int64, pag *models.Pagination, tag string) ([]models.AvailablePost, error)
+	// GetPosts return posts of creator with their tags, filtered by tag if it is not empty
+	// Errors:
+	// 		models.IncorrectTag
 	// 		app.GeneralError with Errors:
 	// 			repository.DefaultErrDB
-	GetPosts(creatorId int64, userId int64, pag *models.Pagination, withDraft bool) ([]models.Post, error)
+	GetPosts(creatorId int64, userId int64, pag *models.Pagination, withDraft bool, tag string) ([]models.Post, error)
 
-	// GetPost Errors:
+	// SearchPosts return posts of creator or of all creators if creatorId is AllCreators, matched searchString
+	// and available for user, with their tags and snippets of matched text
+	// Errors:
+	// 		app.GeneralError with Errors:
+	// 			repository.DefaultErrDB
+	SearchPosts(userId int64, creatorId int64, searchString string, pag *models.Pagination) ([]models.SearchedPost, error)
+
+	// GetCreatorTags return tags of creator with count of posts, drafts are counted only withDraft
+	// Errors:
+	// 		app.GeneralError with Errors:
+	// 			repository.DefaultErrDB
+	GetCreatorTags(creatorId int64, withDraft bool) ([]models.Tag, error)
+
+	// GetPost return post with attaches, attaches of locked post are returned without values.
+	// Draft is found only for its creator
+	// Errors:
 	//		repository.NotFound
 	// 		app.GeneralError with Errors:
 	// 			repository.DefaultErrDB
@@ -41,6 +63,9 @@ type Usecase interface {
 	//		models.InvalidAwardsId
 	//		models.InvalidCreatorId
 	//		models.EmptyTitle
+	//		models.InvalidUnlockPrice
+	//		models.IncorrectPublishAt
+	//		models.IncorrectTag
 	//		app.GeneralError with Errors:
 	//			app.UnknownError
 	//			repository.DefaultErrDB
@@ -50,11 +75,21 @@ type Usecase interface {
 	//		models.InvalidAwardsId
 	//		models.InvalidCreatorId
 	//		models.EmptyTitle
+	//		models.InvalidUnlockPrice
+	//		models.IncorrectPublishAt
+	//		models.IncorrectTag
 	//		app.GeneralError with Errors:
 	//			app.UnknownError
 	//			repository.DefaultErrDB
 	Create(log *logrus.Entry, post *models.CreatePost) (int64, error)
 
+	// PublishScheduled publish posts which scheduled time has come and push about them.
+	// Return count of published posts
+	// Errors:
+	// 		app.GeneralError with Errors:
+	// 			repository.DefaultErrDB
+	PublishScheduled(log *logrus.Entry) (int, error)
+
 	// GetCreatorId Errors:
 	//  	repository.NotFound
 	//  	app.GeneralError with Errors:
@@ -0,0 +1,7 @@
+package usecase_promo_codes
+
+import "github.com/pkg/errors"
+
+var (
+	AwardNotFound = errors.New("award of promo code not found or not belongs to creator")
+)
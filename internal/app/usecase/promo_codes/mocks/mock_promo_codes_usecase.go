@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: patreon/internal/app/usecase/promo_codes (interfaces: Usecase)
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	models "patreon/internal/app/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// PromoCodesUsecase is a mock of Usecase interface.
+type PromoCodesUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *PromoCodesUsecaseMockRecorder
+}
+
+// PromoCodesUsecaseMockRecorder is the mock recorder for PromoCodesUsecase.
+type PromoCodesUsecaseMockRecorder struct {
+	mock *PromoCodesUsecase
+}
+
+// NewPromoCodesUsecase creates a new mock instance.
+func NewPromoCodesUsecase(ctrl *gomock.Controller) *PromoCodesUsecase {
+	mock := &PromoCodesUsecase{ctrl: ctrl}
+	mock.recorder = &PromoCodesUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *PromoCodesUsecase) EXPECT() *PromoCodesUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *PromoCodesUsecase) Create(arg0 *models.PromoCode) (*models.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(*models.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *PromoCodesUsecaseMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*PromoCodesUsecase)(nil).Create), arg0)
+}
+
+// Deactivate mocks base method.
+func (m *PromoCodesUsecase) Deactivate(arg0, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deactivate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Deactivate indicates an expected call of Deactivate.
+func (mr *PromoCodesUsecaseMockRecorder) Deactivate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deactivate", reflect.TypeOf((*PromoCodesUsecase)(nil).Deactivate), arg0, arg1)
+}
+
+// GetCreatorCodes mocks base method.
+func (m *PromoCodesUsecase) GetCreatorCodes(arg0 int64) ([]models.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCreatorCodes", arg0)
+	ret0, _ := ret[0].([]models.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCreatorCodes indicates an expected call of GetCreatorCodes.
+func (mr *PromoCodesUsecaseMockRecorder) GetCreatorCodes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCreatorCodes", reflect.TypeOf((*PromoCodesUsecase)(nil).GetCreatorCodes), arg0)
+}
@@ -0,0 +1,92 @@
+package usecase_promo_codes
+
+import (
+	"patreon/internal/app"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_awards "patreon/internal/app/repository/awards"
+	repository_promo_codes "patreon/internal/app/repository/promo_codes"
+	"patreon/pkg/utils"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type PromoCodesUsecase struct {
+	repository repository_promo_codes.Repository
+	repoAwards repository_awards.Repository
+	clock      utils.Clock
+}
+
+func NewPromoCodesUsecase(repository repository_promo_codes.Repository,
+	repoAwards repository_awards.Repository, clock utils.Clock) *PromoCodesUsecase {
+	return &PromoCodesUsecase{
+		repository: repository,
+		repoAwards: repoAwards,
+		clock:      clock,
+	}
+}
+
+// Create code is stored in upper case, so users can enter it in any case.
+// Code without valid_from starts to work immediately
+// Errors:
+//		models.IncorrectPromoCode
+//		models.IncorrectDiscountType
+//		models.IncorrectDiscount
+//		models.IncorrectMaxUses
+//		models.IncorrectPromoPeriod
+//		AwardNotFound
+//		repository_promo_codes.PromoCodeAlreadyExists
+//		app.GeneralError with Errors:
+//			app.UnknownError
+//			repository.DefaultErrDB
+func (usecase *PromoCodesUsecase) Create(code *models.PromoCode) (*models.PromoCode, error) {
+	code.Code = strings.ToUpper(code.Code)
+	if code.ValidFrom.IsZero() {
+		code.ValidFrom = usecase.clock.Now()
+	}
+	if err := code.Validate(); err != nil {
+		if errors.Is(err, models.IncorrectPromoCode) || errors.Is(err, models.IncorrectDiscountType) ||
+			errors.Is(err, models.IncorrectDiscount) || errors.Is(err, models.IncorrectMaxUses) ||
+			errors.Is(err, models.IncorrectPromoPeriod) {
+			return nil, err
+		}
+		return nil, &app.GeneralError{
+			Err:         app.UnknownError,
+			ExternalErr: errors.Wrap(err, "failed process of validation promo code"),
+		}
+	}
+
+	for _, awardID := range code.AwardIDs {
+		award, err := usecase.repoAwards.GetByID(awardID)
+		if err != nil {
+			if err == repository.NotFound {
+				return nil, AwardNotFound
+			}
+			return nil, err
+		}
+		if award.CreatorId != code.CreatorID {
+			return nil, AwardNotFound
+		}
+	}
+
+	if err := usecase.repository.Create(code); err != nil {
+		return nil, err
+	}
+	return code, nil
+}
+
+// GetCreatorCodes Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *PromoCodesUsecase) GetCreatorCodes(creatorID int64) ([]models.PromoCode, error) {
+	return usecase.repository.GetByCreator(creatorID)
+}
+
+// Deactivate Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *PromoCodesUsecase) Deactivate(creatorID int64, codeID int64) error {
+	return usecase.repository.Deactivate(creatorID, codeID)
+}
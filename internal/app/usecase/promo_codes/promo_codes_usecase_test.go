@@ -0,0 +1,105 @@
+package usecase_promo_codes
+
+import (
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_promo_codes "patreon/internal/app/repository/promo_codes"
+	"patreon/internal/app/usecase"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type SuitePromoCodesUsecase struct {
+	usecase.SuiteUsecase
+	clock *usecase.FakeClock
+	uc    Usecase
+}
+
+func (s *SuitePromoCodesUsecase) SetupSuite() {
+	s.SuiteUsecase.SetupSuite()
+	s.clock = &usecase.FakeClock{Time: time.Date(2021, 12, 1, 12, 0, 0, 0, time.UTC)}
+	s.uc = NewPromoCodesUsecase(s.MockPromoCodesRepository, s.MockAwardsRepository, s.clock)
+}
+
+func (s *SuitePromoCodesUsecase) TestPromoCodesUsecase_Create_OK() {
+	award := models.TestAward()
+	award.ID = 3
+	award.CreatorId = 1
+	code := &models.PromoCode{
+		CreatorID:    1,
+		Code:         "gold50",
+		DiscountType: models.DiscountPercent,
+		Discount:     50,
+		AwardIDs:     []int64{award.ID},
+	}
+	s.MockAwardsRepository.EXPECT().
+		GetByID(award.ID).
+		Times(1).
+		Return(award, nil)
+	s.MockPromoCodesRepository.EXPECT().
+		Create(gomock.Any()).
+		Times(1).
+		DoAndReturn(func(code *models.PromoCode) error {
+			code.ID = 7
+			code.Active = true
+			return nil
+		})
+
+	res, err := s.uc.Create(code)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(7), res.ID)
+	assert.Equal(s.T(), "GOLD50", res.Code)
+	assert.Equal(s.T(), s.clock.Now(), res.ValidFrom)
+}
+
+func (s *SuitePromoCodesUsecase) TestPromoCodesUsecase_Create_Errors() {
+	code := models.TestPromoCode()
+	code.Discount = 100
+	_, err := s.uc.Create(code)
+	assert.Equal(s.T(), models.IncorrectDiscount, err)
+
+	code = models.TestPromoCode()
+	code.AwardIDs = []int64{3}
+	s.MockAwardsRepository.EXPECT().
+		GetByID(int64(3)).
+		Times(1).
+		Return(nil, repository.NotFound)
+	_, err = s.uc.Create(code)
+	assert.Equal(s.T(), AwardNotFound, err)
+
+	award := models.TestAward()
+	award.ID = 3
+	award.CreatorId = code.CreatorID + 1
+	s.MockAwardsRepository.EXPECT().
+		GetByID(award.ID).
+		Times(1).
+		Return(award, nil)
+	_, err = s.uc.Create(code)
+	assert.Equal(s.T(), AwardNotFound, err)
+
+	code = models.TestPromoCode()
+	s.MockPromoCodesRepository.EXPECT().
+		Create(code).
+		Times(1).
+		Return(repository_promo_codes.PromoCodeAlreadyExists)
+	_, err = s.uc.Create(code)
+	assert.Equal(s.T(), repository_promo_codes.PromoCodeAlreadyExists, err)
+}
+
+func (s *SuitePromoCodesUsecase) TestPromoCodesUsecase_Deactivate() {
+	s.MockPromoCodesRepository.EXPECT().
+		Deactivate(int64(1), int64(7)).
+		Times(1).
+		Return(repository.NotFound)
+	err := s.uc.Deactivate(1, 7)
+	assert.Equal(s.T(), repository.NotFound, err)
+}
+
+func TestUsecasePromoCodes(t *testing.T) {
+	suite.Run(t, new(SuitePromoCodesUsecase))
+}
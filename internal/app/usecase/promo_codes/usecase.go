@@ -0,0 +1,29 @@
+package usecase_promo_codes
+
+import "patreon/internal/app/models"
+
+//go:generate mockgen -destination=mocks/mock_promo_codes_usecase.go -package=mock_usecase -mock_names=Usecase=PromoCodesUsecase . Usecase
+
+type Usecase interface {
+	// Create Errors:
+	//		models.IncorrectPromoCode
+	//		models.IncorrectDiscountType
+	//		models.IncorrectDiscount
+	//		models.IncorrectMaxUses
+	//		models.IncorrectPromoPeriod
+	//		AwardNotFound
+	//		repository_promo_codes.PromoCodeAlreadyExists
+	//		app.GeneralError with Errors:
+	//			app.UnknownError
+	//			repository.DefaultErrDB
+	Create(code *models.PromoCode) (*models.PromoCode, error)
+	// GetCreatorCodes Errors:
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	GetCreatorCodes(creatorID int64) ([]models.PromoCode, error)
+	// Deactivate Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			repository.DefaultErrDB
+	Deactivate(creatorID int64, codeID int64) error
+}
@@ -5,6 +5,7 @@
 package mock_usecase
 
 import (
+	models "patreon/internal/app/models"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -79,10 +80,10 @@ func (mr *StatisticsUsecaseMockRecorder) GetCountCreatorViews(arg0, arg1 interfa
 }
 
 // GetTotalIncome mocks base method.
-func (m *StatisticsUsecase) GetTotalIncome(arg0, arg1 int64) (float64, error) {
+func (m *StatisticsUsecase) GetTotalIncome(arg0, arg1 int64) (models.Money, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetTotalIncome", arg0, arg1)
-	ret0, _ := ret[0].(float64)
+	ret0, _ := ret[0].(models.Money)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -92,3 +93,18 @@ func (mr *StatisticsUsecaseMockRecorder) GetTotalIncome(arg0, arg1 interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalIncome", reflect.TypeOf((*StatisticsUsecase)(nil).GetTotalIncome), arg0, arg1)
 }
+
+// GetTrialStats mocks base method.
+func (m *StatisticsUsecase) GetTrialStats(arg0, arg1 int64) (*models.TrialStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrialStats", arg0, arg1)
+	ret0, _ := ret[0].(*models.TrialStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrialStats indicates an expected call of GetTrialStats.
+func (mr *StatisticsUsecaseMockRecorder) GetTrialStats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrialStats", reflect.TypeOf((*StatisticsUsecase)(nil).GetTrialStats), arg0, arg1)
+}
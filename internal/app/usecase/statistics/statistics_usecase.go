@@ -2,23 +2,33 @@ package statistics
 
 import (
 	"patreon/internal/app"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_creator "patreon/internal/app/repository/creator"
 	repository_statistics "patreon/internal/app/repository/statistics"
+
+	"github.com/pkg/errors"
 )
 
 type StatisticsUsecase struct {
-	repository repository_statistics.Repository
+	repository  repository_statistics.Repository
+	repoCreator repository_creator.Repository
+	rates       *models.ExchangeRates
 }
 
-func NewStatisticsUsecase(repository repository_statistics.Repository) *StatisticsUsecase {
+func NewStatisticsUsecase(repository repository_statistics.Repository, repoCreator repository_creator.Repository,
+	rates *models.ExchangeRates) *StatisticsUsecase {
 	return &StatisticsUsecase{
-		repository: repository,
+		repository:  repository,
+		repoCreator: repoCreator,
+		rates:       rates,
 	}
 }
 
 // GetCountCreatorPosts Errors:
 //		CreatorDoesNotExists
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (u *StatisticsUsecase) GetCountCreatorPosts(creatorID int64) (int64, error) {
 	isExists, err := u.repository.CreatorExists(creatorID)
 	if err != nil {
@@ -34,8 +44,8 @@ func (u *StatisticsUsecase) GetCountCreatorPosts(creatorID int64) (int64, error)
 
 // GetCountCreatorSubscribers Errors:
 //		CreatorDoesNotExists
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (u *StatisticsUsecase) GetCountCreatorSubscribers(creatorID int64) (int64, error) {
 	isExists, err := u.repository.CreatorExists(creatorID)
 	if err != nil {
@@ -51,8 +61,8 @@ func (u *StatisticsUsecase) GetCountCreatorSubscribers(creatorID int64) (int64,
 
 // GetCountCreatorViews Errors:
 //		CreatorDoesNotExists
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (u *StatisticsUsecase) GetCountCreatorViews(creatorID int64, days int64) (int64, error) {
 	isExists, err := u.repository.CreatorExists(creatorID)
 	if err != nil {
@@ -66,19 +76,60 @@ func (u *StatisticsUsecase) GetCountCreatorViews(creatorID int64, days int64) (i
 	return u.repository.GetCountCreatorViews(creatorID, days)
 }
 
-// GetTotalIncome Errors:
+// GetTotalIncome sum of creator payments for last days converted by exchange rates
+// to settlement currency of creator
+// Errors:
+//		CreatorDoesNotExists
+//		app.GeneralError with Errors:
+//			app.UnknownError
+//			repository.DefaultErrDB
+func (u *StatisticsUsecase) GetTotalIncome(creatorID int64, days int64) (models.Money, error) {
+	currency, err := u.repoCreator.GetCurrency(creatorID)
+	if err != nil {
+		if errors.Is(err, repository.NotFound) {
+			return models.Money{}, CreatorDoesNotExists
+		}
+		return models.Money{}, err
+	}
+
+	incomes, err := u.repository.GetTotalIncome(creatorID, days)
+	if err != nil {
+		return models.Money{}, err
+	}
+
+	total, err := u.rates.Sum(incomes, currency)
+	if err != nil {
+		return models.Money{}, &app.GeneralError{
+			Err:         app.UnknownError,
+			ExternalErr: errors.Wrap(err, "failed convert total income of creator"),
+		}
+	}
+	return total, nil
+}
+
+// GetTrialStats count trials of creator started in last days and percent of converted ones
+// among trials which already ended
+// Errors:
 //		CreatorDoesNotExists
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
-func (u *StatisticsUsecase) GetTotalIncome(creatorID int64, days int64) (float64, error) {
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (u *StatisticsUsecase) GetTrialStats(creatorID int64, days int64) (*models.TrialStats, error) {
 	isExists, err := u.repository.CreatorExists(creatorID)
 	if err != nil {
-		return app.InvalidFloat, err
+		return nil, err
 	}
 
 	if !isExists {
-		return app.InvalidFloat, CreatorDoesNotExists
+		return nil, CreatorDoesNotExists
 	}
 
-	return u.repository.GetTotalIncome(creatorID, days)
+	stats, err := u.repository.GetTrialStats(creatorID, days)
+	if err != nil {
+		return nil, err
+	}
+
+	if ended := stats.Started - stats.Active; ended > 0 {
+		stats.ConversionRate = float64(stats.Converted) * 100 / float64(ended)
+	}
+	return stats, nil
 }
@@ -1,5 +1,7 @@
 package statistics
 
+import "patreon/internal/app/models"
+
 //go:generate mockgen -destination=mocks/mock_statistics_usecase.go -package=mock_usecase -mock_names=Usecase=StatisticsUsecase . Usecase
 
 type Usecase interface {
@@ -24,6 +26,13 @@ type Usecase interface {
 	// GetTotalIncome Errors:
 	//		CreatorDoesNotExists
 	// 		app.GeneralError with Errors
+	//			app.UnknownError
+	// 			repository.DefaultErrDB
+	GetTotalIncome(creatorID int64, days int64) (models.Money, error)
+
+	// GetTrialStats Errors:
+	//		CreatorDoesNotExists
+	// 		app.GeneralError with Errors
 	// 			repository.DefaultErrDB
-	GetTotalIncome(creatorID int64, days int64) (float64, error)
+	GetTrialStats(creatorID int64, days int64) (*models.TrialStats, error)
 }
@@ -5,4 +5,12 @@ import "errors"
 var (
 	SubscriptionAlreadyExists = errors.New("this subscribe already exists")
 	SubscriptionsNotFound     = errors.New("the user is not subscribed on this creator")
+	AwardNotBelongCreator     = errors.New("award not belongs to this creator")
+	SubscriptionInGrace       = errors.New("subscription waits for renewal payment, tier can not be changed")
+	AwardAlreadySubscribed    = errors.New("subscription already on this award")
+	AwardsNotRelated          = errors.New("awards are not in the same hierarchy")
+	SubscriptionInTrial       = errors.New("subscription is in free trial, tier can not be changed")
+	TrialNotAvailable         = errors.New("award has not free trial")
+	AwardHasFreeSeats         = errors.New("award is not limited or has free seats")
+	UserBlocked               = errors.New("user is blocked by creator")
 )
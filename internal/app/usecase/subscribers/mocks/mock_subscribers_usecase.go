@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	logrus "github.com/sirupsen/logrus"
 )
 
 // SubscribersUsecase is a mock of Usecase interface.
@@ -34,6 +35,36 @@ func (m *SubscribersUsecase) EXPECT() *SubscribersUsecaseMockRecorder {
 	return m.recorder
 }
 
+// ChangeTier mocks base method.
+func (m *SubscribersUsecase) ChangeTier(arg0 *models.Subscriber) (*models.TierChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeTier", arg0)
+	ret0, _ := ret[0].(*models.TierChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeTier indicates an expected call of ChangeTier.
+func (mr *SubscribersUsecaseMockRecorder) ChangeTier(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeTier", reflect.TypeOf((*SubscribersUsecase)(nil).ChangeTier), arg0)
+}
+
+// GetCreatorEvents mocks base method.
+func (m *SubscribersUsecase) GetCreatorEvents(arg0 int64, arg1 models.SubscriptionEventKind, arg2 *models.Pagination) ([]models.SubscriptionEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCreatorEvents", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.SubscriptionEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCreatorEvents indicates an expected call of GetCreatorEvents.
+func (mr *SubscribersUsecaseMockRecorder) GetCreatorEvents(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCreatorEvents", reflect.TypeOf((*SubscribersUsecase)(nil).GetCreatorEvents), arg0, arg1, arg2)
+}
+
 // GetCreators mocks base method.
 func (m *SubscribersUsecase) GetCreators(arg0 int64) ([]models.CreatorSubscribe, error) {
 	m.ctrl.T.Helper()
@@ -50,22 +81,94 @@ func (mr *SubscribersUsecaseMockRecorder) GetCreators(arg0 interface{}) *gomock.
 }
 
 // GetSubscribers mocks base method.
-func (m *SubscribersUsecase) GetSubscribers(arg0 int64) ([]models.User, error) {
+func (m *SubscribersUsecase) GetSubscribers(arg0 int64, arg1 *models.Pagination, arg2 *models.SubscribersFilter) ([]models.CreatorSubscriber, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSubscribers", arg0)
-	ret0, _ := ret[0].([]models.User)
+	ret := m.ctrl.Call(m, "GetSubscribers", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.CreatorSubscriber)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSubscribers indicates an expected call of GetSubscribers.
-func (mr *SubscribersUsecaseMockRecorder) GetSubscribers(arg0 interface{}) *gomock.Call {
+func (mr *SubscribersUsecaseMockRecorder) GetSubscribers(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscribers", reflect.TypeOf((*SubscribersUsecase)(nil).GetSubscribers), arg0, arg1, arg2)
+}
+
+// GetTierChanges mocks base method.
+func (m *SubscribersUsecase) GetTierChanges(arg0, arg1 int64) ([]models.TierChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTierChanges", arg0, arg1)
+	ret0, _ := ret[0].([]models.TierChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTierChanges indicates an expected call of GetTierChanges.
+func (mr *SubscribersUsecaseMockRecorder) GetTierChanges(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTierChanges", reflect.TypeOf((*SubscribersUsecase)(nil).GetTierChanges), arg0, arg1)
+}
+
+// GetUserEvents mocks base method.
+func (m *SubscribersUsecase) GetUserEvents(arg0 int64, arg1 *models.Pagination) ([]models.SubscriptionEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserEvents", arg0, arg1)
+	ret0, _ := ret[0].([]models.SubscriptionEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserEvents indicates an expected call of GetUserEvents.
+func (mr *SubscribersUsecaseMockRecorder) GetUserEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserEvents", reflect.TypeOf((*SubscribersUsecase)(nil).GetUserEvents), arg0, arg1)
+}
+
+// JoinWaitlist mocks base method.
+func (m *SubscribersUsecase) JoinWaitlist(arg0 *models.Subscriber) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinWaitlist", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JoinWaitlist indicates an expected call of JoinWaitlist.
+func (mr *SubscribersUsecaseMockRecorder) JoinWaitlist(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinWaitlist", reflect.TypeOf((*SubscribersUsecase)(nil).JoinWaitlist), arg0)
+}
+
+// LeaveWaitlist mocks base method.
+func (m *SubscribersUsecase) LeaveWaitlist(arg0 *models.Subscriber) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveWaitlist", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LeaveWaitlist indicates an expected call of LeaveWaitlist.
+func (mr *SubscribersUsecaseMockRecorder) LeaveWaitlist(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveWaitlist", reflect.TypeOf((*SubscribersUsecase)(nil).LeaveWaitlist), arg0)
+}
+
+// StartTrial mocks base method.
+func (m *SubscribersUsecase) StartTrial(arg0 *models.Trial) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTrial", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartTrial indicates an expected call of StartTrial.
+func (mr *SubscribersUsecaseMockRecorder) StartTrial(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscribers", reflect.TypeOf((*SubscribersUsecase)(nil).GetSubscribers), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTrial", reflect.TypeOf((*SubscribersUsecase)(nil).StartTrial), arg0)
 }
 
 // Subscribe mocks base method.
-func (m *SubscribersUsecase) Subscribe(arg0 *models.Subscriber, arg1 string) error {
+func (m *SubscribersUsecase) Subscribe(arg0 *models.Subscriber, arg1 *models.PayTokenInfo) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Subscribe", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -79,15 +182,15 @@ func (mr *SubscribersUsecaseMockRecorder) Subscribe(arg0, arg1 interface{}) *gom
 }
 
 // UnSubscribe mocks base method.
-func (m *SubscribersUsecase) UnSubscribe(arg0 *models.Subscriber) error {
+func (m *SubscribersUsecase) UnSubscribe(arg0 *logrus.Entry, arg1 *models.Subscriber, arg2 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UnSubscribe", arg0)
+	ret := m.ctrl.Call(m, "UnSubscribe", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UnSubscribe indicates an expected call of UnSubscribe.
-func (mr *SubscribersUsecaseMockRecorder) UnSubscribe(arg0 interface{}) *gomock.Call {
+func (mr *SubscribersUsecaseMockRecorder) UnSubscribe(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnSubscribe", reflect.TypeOf((*SubscribersUsecase)(nil).UnSubscribe), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnSubscribe", reflect.TypeOf((*SubscribersUsecase)(nil).UnSubscribe), arg0, arg1, arg2)
 }
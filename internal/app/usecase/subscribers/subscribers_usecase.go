@@ -2,31 +2,63 @@ package usecase_subscribers
 
 import (
 	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
 	repository_awards "patreon/internal/app/repository/awards"
+	repository_blocked_users "patreon/internal/app/repository/blocked_users"
+	repository_pay_token "patreon/internal/app/repository/pay_token"
 	repository_subscribers "patreon/internal/app/repository/subscribers"
+	repository_subscription_events "patreon/internal/app/repository/subscription_events"
+	push_client "patreon/internal/microservices/push/delivery/client"
+	"patreon/pkg/utils"
+	"time"
 
 	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	upgradeTokenExp  = 3 * time.Hour
+	trialEventReason = "free trial"
 )
 
 type SubscribersUsecase struct {
-	repoSubscr repository_subscribers.Repository
-	repoAwards repository_awards.Repository
+	repoSubscr   repository_subscribers.Repository
+	repoAwards   repository_awards.Repository
+	repoPayToken repository_pay_token.Repository
+	repoEvents   repository_subscription_events.Repository
+	repoBlocked  repository_blocked_users.Repository
+	pusher       push_client.Pusher
+	clock        utils.Clock
 }
 
-func NewSubscribersUsecase(repoSubscr repository_subscribers.Repository,
-	repoAwards repository_awards.Repository) *SubscribersUsecase {
+func NewSubscribersUsecase(repoSubscr repository_subscribers.Repository, repoAwards repository_awards.Repository,
+	repoPayToken repository_pay_token.Repository, repoEvents repository_subscription_events.Repository,
+	repoBlocked repository_blocked_users.Repository, pusher push_client.Pusher, clock utils.Clock) *SubscribersUsecase {
 	return &SubscribersUsecase{
-		repoSubscr: repoSubscr,
-		repoAwards: repoAwards,
+		repoSubscr:   repoSubscr,
+		repoAwards:   repoAwards,
+		repoPayToken: repoPayToken,
+		repoEvents:   repoEvents,
+		repoBlocked:  repoBlocked,
+		pusher:       pusher,
+		clock:        clock,
 	}
 }
 
 // Subscribe Errors:
+//		UserBlocked
 //		SubscriptionAlreadyExists
+//		repository_subscribers.AwardSoldOut
 //		repository_postgresql.AwardNameNotFound
+//		repository_promo_codes.PromoCodeExhausted
+//		repository_promo_codes.PromoCodeAlreadyUsed
 //		app.GeneralError with Errors
 //			repository.DefaultErrDB
-func (uc *SubscribersUsecase) Subscribe(subscriber *models.Subscriber, token string) error {
+func (uc *SubscribersUsecase) Subscribe(subscriber *models.Subscriber, payToken *models.PayTokenInfo) error {
+	if err := uc.checkNotBlocked(subscriber.CreatorID, subscriber.UserID); err != nil {
+		return err
+	}
 	exist, err := uc.repoSubscr.Get(subscriber)
 	if err != nil {
 		return errors.Wrapf(err, "METHOD: subscribers_usecase.Subscribe; "+
@@ -36,7 +68,7 @@ func (uc *SubscribersUsecase) Subscribe(subscriber *models.Subscriber, token str
 		return SubscriptionAlreadyExists
 	}
 
-	return uc.repoSubscr.Create(subscriber, token)
+	return uc.repoSubscr.Create(subscriber, payToken)
 }
 
 // GetCreators Errors:
@@ -46,18 +78,23 @@ func (uc *SubscribersUsecase) GetCreators(userID int64) ([]models.CreatorSubscri
 	return uc.repoSubscr.GetCreators(userID)
 }
 
-// GetSubscribers Errors:
+// GetSubscribers return page of creator subscribers from filter
+// Errors:
+//		repository.NotFound
 //		app.GeneralError with Errors
 //			repository.DefaultErrDB
-func (uc *SubscribersUsecase) GetSubscribers(creatorID int64) ([]models.User, error) {
-	return uc.repoSubscr.GetSubscribers(creatorID)
+func (uc *SubscribersUsecase) GetSubscribers(creatorID int64, pag *models.Pagination,
+	filter *models.SubscribersFilter) ([]models.CreatorSubscriber, error) {
+	return uc.repoSubscr.GetSubscribers(creatorID, pag, filter)
 }
 
-// UnSubscribe Errors:
+// UnSubscribe remove subscription and add cancelled event with reason given by user, reason can be empty.
+// Seat of subscription award is pushed as freed for its waitlist
+// Errors:
 //		SubscriptionsNotFound
 //		app.GeneralError with Errors
 //			repository.DefaultErrDB
-func (uc *SubscribersUsecase) UnSubscribe(subscriber *models.Subscriber) error {
+func (uc *SubscribersUsecase) UnSubscribe(log *logrus.Entry, subscriber *models.Subscriber, reason string) error {
 	exists, err := uc.repoSubscr.Get(subscriber)
 	if err != nil {
 		return err
@@ -68,5 +105,272 @@ func (uc *SubscribersUsecase) UnSubscribe(subscriber *models.Subscriber) error {
 	if err = uc.repoSubscr.Delete(subscriber); err != nil {
 		return err
 	}
+	if errPush := uc.pusher.SeatFreed(subscriber.AwardID); errPush != nil {
+		log.Errorf("Try push freed seat of award %d, and got err %s", subscriber.AwardID, errPush)
+	}
+	return uc.repoEvents.Add(&models.SubscriptionEvent{
+		UserID:    subscriber.UserID,
+		CreatorID: subscriber.CreatorID,
+		AwardID:   subscriber.AwardID,
+		Kind:      models.SubscriptionEventCancelled,
+		Reason:    reason,
+	})
+}
+
+// GetUserEvents return page of history of user subscriptions, the last first
+// Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (uc *SubscribersUsecase) GetUserEvents(userID int64, pag *models.Pagination) ([]models.SubscriptionEvent, error) {
+	return uc.repoEvents.GetUserEvents(userID, pag)
+}
+
+// GetCreatorEvents return page of events of subscriptions on creator with kind, the last first.
+// Empty kind does not restrict events
+// Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (uc *SubscribersUsecase) GetCreatorEvents(creatorID int64, kind models.SubscriptionEventKind,
+	pag *models.Pagination) ([]models.SubscriptionEvent, error) {
+	return uc.repoEvents.GetCreatorEvents(creatorID, kind, pag)
+}
+
+// ChangeTier move subscription of subscriber on creator to subscriber.AwardID.
+// Upgrade to parent award creates payment for prorated price difference with new pay token and
+// is applied when it paid, upgrade without anything to pay is applied at once.
+// Downgrade to child award is applied at the end of paid period.
+// Change to current award cancels scheduled downgrade
+// Errors:
+//		SubscriptionsNotFound
+//		SubscriptionInGrace
+//		SubscriptionInTrial
+//		AwardAlreadySubscribed
+//		AwardNotBelongCreator
+//		AwardsNotRelated
+//		repository.NotFound
+//		repository_subscribers.AwardSoldOut
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+//			repository_redis.SetError
+func (uc *SubscribersUsecase) ChangeTier(subscriber *models.Subscriber) (*models.TierChange, error) {
+	subscription, err := uc.repoSubscr.GetActive(subscriber.UserID, subscriber.CreatorID)
+	if err != nil {
+		if err == repository.NotFound {
+			return nil, SubscriptionsNotFound
+		}
+		return nil, err
+	}
+	if subscription.InGrace {
+		return nil, SubscriptionInGrace
+	}
+	// prorated price of trial period is zero, so upgrade in trial would be free
+	if subscription.InTrial {
+		return nil, SubscriptionInTrial
+	}
+
+	if subscription.AwardID == subscriber.AwardID {
+		if subscription.NextAwardID == 0 {
+			return nil, AwardAlreadySubscribed
+		}
+		return nil, uc.repoSubscr.CancelDowngrade(subscription.ID)
+	}
+
+	award, err := uc.repoAwards.GetByID(subscriber.AwardID)
+	if err != nil {
+		return nil, err
+	}
+	if award.CreatorId != subscriber.CreatorID {
+		return nil, AwardNotBelongCreator
+	}
+
+	change := &models.TierChange{
+		SubscriptionID: subscription.ID,
+		CreatorID:      subscription.CreatorID,
+		FromAwardID:    subscription.AwardID,
+		ToAwardID:      award.ID,
+		Status:         models.TierChangePending,
+		Currency:       award.Currency,
+	}
+
+	isUpgrade, err := uc.repoAwards.IsParent(subscription.AwardID, award.ID)
+	if err != nil {
+		return nil, err
+	}
+	if isUpgrade {
+		if err = uc.upgrade(subscription, award, change); err != nil {
+			return nil, err
+		}
+		return change, nil
+	}
+
+	isDowngrade, err := uc.repoAwards.IsParent(award.ID, subscription.AwardID)
+	if err != nil {
+		return nil, err
+	}
+	if !isDowngrade {
+		return nil, AwardsNotRelated
+	}
+
+	change.Kind = models.TierDowngrade
+	change.EffectiveAt = subscription.PaidUntil
+	if err = uc.repoSubscr.ScheduleDowngrade(change); err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// upgrade Errors:
+//		repository_subscribers.AwardSoldOut
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+//			repository_redis.SetError
+func (uc *SubscribersUsecase) upgrade(subscription *models.BillingSubscription,
+	award *models.Award, change *models.TierChange) error {
+	now := uc.clock.Now()
+	change.Kind = models.TierUpgrade
+	change.EffectiveAt = now
+	change.Amount = prorate(award.Price-subscription.Price, subscription, now)
+
+	if change.Amount == 0 {
+		change.Status = models.TierChangeApplied
+		if err := uc.repoSubscr.CreateUpgrade(subscription, change); err != nil {
+			return err
+		}
+		return uc.repoEvents.Add(&models.SubscriptionEvent{
+			UserID:    subscription.UserID,
+			CreatorID: subscription.CreatorID,
+			AwardID:   award.ID,
+			Kind:      models.SubscriptionEventTierChanged,
+			Reason:    string(models.TierUpgrade),
+		})
+	}
+
+	change.PayToken = uuid.NewV4().String()
+	err := uc.repoPayToken.SetToken(&models.PayTokenInfo{
+		Token:     change.PayToken,
+		UserID:    subscription.UserID,
+		CreatorID: subscription.CreatorID,
+		AwardID:   award.ID,
+		Price:     change.Amount,
+		Currency:  change.Currency,
+		ExpiresAt: now.Add(upgradeTokenExp),
+	}, int(upgradeTokenExp.Seconds()))
+	if err != nil {
+		return err
+	}
+	// upgrade payment is created right here, so token can not be used for new subscription
+	if _, err = uc.repoPayToken.MarkUsed(change.PayToken, int(upgradeTokenExp.Seconds())); err != nil {
+		return err
+	}
+	if err = uc.repoSubscr.CreateUpgrade(subscription, change); err != nil {
+		// token without payment must not be shown as outstanding checkout
+		_ = uc.repoPayToken.RemoveUserToken(subscription.UserID, change.PayToken)
+		return err
+	}
+	return nil
+}
+
+// prorate return part of price difference for time left in paid period, rounded up
+func prorate(diff models.Decimal, subscription *models.BillingSubscription, now time.Time) models.Decimal {
+	left := int64(subscription.PaidUntil.Sub(now).Seconds())
+	period := int64(subscription.PaidUntil.Sub(subscription.PaidUntil.AddDate(0, -int(subscription.Period), 0)).Seconds())
+	if diff <= 0 || left <= 0 || period <= 0 {
+		return 0
+	}
+	if left > period {
+		left = period
+	}
+	return models.Decimal((int64(diff)*left + period - 1) / period)
+}
+
+// GetTierChanges Errors:
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (uc *SubscribersUsecase) GetTierChanges(userID int64, creatorID int64) ([]models.TierChange, error) {
+	return uc.repoSubscr.GetTierChanges(userID, creatorID)
+}
+
+// StartTrial start free trial of trial.AwardID for user who was never subscribed on creator.
+// Trial subscription gives access until the end of trial without payment
+// Errors:
+//		UserBlocked
+//		AwardNotBelongCreator
+//		TrialNotAvailable
+//		repository_subscribers.TrialNotAllowed
+//		repository_subscribers.TrialAlreadyUsed
+//		repository_subscribers.AwardSoldOut
+//		repository.NotFound
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (uc *SubscribersUsecase) StartTrial(trial *models.Trial) error {
+	award, err := uc.repoAwards.GetByID(trial.AwardID)
+	if err != nil {
+		return err
+	}
+	if award.CreatorId != trial.CreatorID {
+		return AwardNotBelongCreator
+	}
+	if award.TrialDays <= 0 {
+		return TrialNotAvailable
+	}
+	if err = uc.checkNotBlocked(trial.CreatorID, trial.UserID); err != nil {
+		return err
+	}
+
+	trial.EndsAt = uc.clock.Now().AddDate(0, 0, int(award.TrialDays))
+	if err = uc.repoSubscr.StartTrial(trial); err != nil {
+		return err
+	}
+	return uc.repoEvents.Add(&models.SubscriptionEvent{
+		UserID:    trial.UserID,
+		CreatorID: trial.CreatorID,
+		AwardID:   trial.AwardID,
+		Kind:      models.SubscriptionEventSubscribed,
+		Reason:    trialEventReason,
+	})
+}
+
+// JoinWaitlist add user to waitlist of sold out award, users of waitlist are notified when seat is freed
+// Errors:
+//		AwardNotBelongCreator
+//		AwardHasFreeSeats
+//		repository.NotFound
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (uc *SubscribersUsecase) JoinWaitlist(subscriber *models.Subscriber) error {
+	award, err := uc.repoAwards.GetByID(subscriber.AwardID)
+	if err != nil {
+		return err
+	}
+	if award.CreatorId != subscriber.CreatorID {
+		return AwardNotBelongCreator
+	}
+	if award.MaxSubscribers == 0 || award.SeatsLeft > 0 {
+		return AwardHasFreeSeats
+	}
+	return uc.repoSubscr.AddToWaitlist(subscriber.AwardID, subscriber.UserID)
+}
+
+// LeaveWaitlist Errors:
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (uc *SubscribersUsecase) LeaveWaitlist(subscriber *models.Subscriber) error {
+	return uc.repoSubscr.RemoveFromWaitlist(subscriber.AwardID, subscriber.UserID)
+}
+
+// checkNotBlocked Errors:
+//		UserBlocked
+//		app.GeneralError with Errors
+//			repository.DefaultErrDB
+func (uc *SubscribersUsecase) checkNotBlocked(creatorID int64, userID int64) error {
+	blocked, err := uc.repoBlocked.IsBlocked(creatorID, userID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return UserBlocked
+	}
 	return nil
 }
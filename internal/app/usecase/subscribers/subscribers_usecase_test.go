@@ -4,9 +4,12 @@ import (
 	"patreon/internal/app"
 	"patreon/internal/app/models"
 	"patreon/internal/app/repository"
+	repository_subscribers "patreon/internal/app/repository/subscribers"
 	"patreon/internal/app/usecase"
 	"testing"
+	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
 
 	"github.com/stretchr/testify/assert"
@@ -15,17 +18,36 @@ import (
 
 type SuiteSubscribersUsecase struct {
 	usecase.SuiteUsecase
-	uc Usecase
+	uc    Usecase
+	clock *usecase.FakeClock
 }
 
 func (s *SuiteSubscribersUsecase) SetupSuite() {
 	s.SuiteUsecase.SetupSuite()
-	s.uc = NewSubscribersUsecase(s.MockSubscribersRepository, s.MockAwardsRepository)
+	s.clock = &usecase.FakeClock{Time: time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)}
+	s.uc = NewSubscribersUsecase(s.MockSubscribersRepository, s.MockAwardsRepository,
+		s.MockPayTokenRepository, s.MockEventsRepository, s.MockBlockedRepository, s.MockPusher, s.clock)
+}
+
+func (s *SuiteSubscribersUsecase) testSubscription() *models.BillingSubscription {
+	return &models.BillingSubscription{
+		ID:        5,
+		UserID:    1,
+		CreatorID: 2,
+		AwardID:   4,
+		Price:     models.NewDecimal(100),
+		Period:    1,
+		PaidUntil: time.Date(2021, 12, 16, 0, 0, 0, 0, time.UTC),
+	}
 }
 
 func (s *SuiteSubscribersUsecase) TestSubscribersUsecaseSubscribe_OK() {
-	token := "25"
+	token := &models.PayTokenInfo{Token: "25", Price: models.NewDecimal(100)}
 	subscriber := models.TestSubscriber()
+	s.MockBlockedRepository.EXPECT().
+		IsBlocked(subscriber.CreatorID, subscriber.UserID).
+		Times(1).
+		Return(false, nil)
 	s.MockSubscribersRepository.EXPECT().
 		Get(subscriber).
 		Return(false, nil).
@@ -38,8 +60,12 @@ func (s *SuiteSubscribersUsecase) TestSubscribersUsecaseSubscribe_OK() {
 }
 
 func (s *SuiteSubscribersUsecase) TestSubscribersUsecaseSubscribe_AlreadyExists() {
-	token := "25"
+	token := &models.PayTokenInfo{Token: "25", Price: models.NewDecimal(100)}
 	subscriber := models.TestSubscriber()
+	s.MockBlockedRepository.EXPECT().
+		IsBlocked(subscriber.CreatorID, subscriber.UserID).
+		Times(1).
+		Return(false, nil)
 	s.MockSubscribersRepository.EXPECT().
 		Get(subscriber).
 		Return(true, nil).
@@ -50,8 +76,12 @@ func (s *SuiteSubscribersUsecase) TestSubscribersUsecaseSubscribe_AlreadyExists(
 }
 
 func (s *SuiteSubscribersUsecase) TestSubscribersUsecaseSubscribe_CheckExistsError() {
-	token := "25"
+	token := &models.PayTokenInfo{Token: "25", Price: models.NewDecimal(100)}
 	subscriber := models.TestSubscriber()
+	s.MockBlockedRepository.EXPECT().
+		IsBlocked(subscriber.CreatorID, subscriber.UserID).
+		Times(1).
+		Return(false, nil)
 	s.MockSubscribersRepository.EXPECT().
 		Get(subscriber).
 		Return(false, repository.NewDBError(repository.DefaultErrDB)).
@@ -61,8 +91,12 @@ func (s *SuiteSubscribersUsecase) TestSubscribersUsecaseSubscribe_CheckExistsErr
 }
 
 func (s *SuiteSubscribersUsecase) TestSubscribersUsecaseSubscribe_RepositoryCreateError() {
-	token := "25"
+	token := &models.PayTokenInfo{Token: "25", Price: models.NewDecimal(100)}
 	subscriber := models.TestSubscriber()
+	s.MockBlockedRepository.EXPECT().
+		IsBlocked(subscriber.CreatorID, subscriber.UserID).
+		Times(1).
+		Return(false, nil)
 	s.MockSubscribersRepository.EXPECT().
 		Get(subscriber).
 		Return(false, nil).
@@ -77,6 +111,18 @@ func (s *SuiteSubscribersUsecase) TestSubscribersUsecaseSubscribe_RepositoryCrea
 	assert.Equal(s.T(), repository.DefaultErrDB, errors.Cause(err).(*app.GeneralError).Err)
 }
 
+func (s *SuiteSubscribersUsecase) TestSubscribersUsecaseSubscribe_Blocked() {
+	token := &models.PayTokenInfo{Token: "25", Price: models.NewDecimal(100)}
+	subscriber := models.TestSubscriber()
+	s.MockBlockedRepository.EXPECT().
+		IsBlocked(subscriber.CreatorID, subscriber.UserID).
+		Times(1).
+		Return(true, nil)
+
+	err := s.uc.Subscribe(subscriber, token)
+	assert.Equal(s.T(), UserBlocked, err)
+}
+
 func (s *SuiteSubscribersUsecase) TestSubscriberUsecaseGetCreators_OK() {
 	subscriber := models.TestSubscriber()
 	creatorSubsc := models.TestCreatorSubscribe()
@@ -107,32 +153,403 @@ func (s *SuiteSubscribersUsecase) TestSubscriberUsecaseGetCreators_RepositoryErr
 
 func (s *SuiteSubscribersUsecase) TestSubscriberUsecaseGetSubscribers_OK() {
 	subscriber := models.TestSubscriber()
-	users := models.TestUsers()
-	expUsers := users
+	pag := &models.Pagination{Limit: 10}
+	filter := &models.SubscribersFilter{Status: models.SubscriptionActive}
+	expSubscribers := []models.CreatorSubscriber{
+		{UserID: subscriber.UserID, AwardID: subscriber.AwardID, Status: models.SubscriptionActive},
+	}
 	s.MockSubscribersRepository.EXPECT().
-		GetSubscribers(subscriber.CreatorID).
+		GetSubscribers(subscriber.CreatorID, pag, filter).
 		Times(1).
-		Return(expUsers, nil)
-	res, err := s.uc.GetSubscribers(subscriber.CreatorID)
+		Return(expSubscribers, nil)
+	res, err := s.uc.GetSubscribers(subscriber.CreatorID, pag, filter)
 
 	assert.NoError(s.T(), err)
-	assert.Equal(s.T(), expUsers, res)
+	assert.Equal(s.T(), expSubscribers, res)
 }
 
 func (s *SuiteSubscribersUsecase) TestSubscriberUsecaseGetSubscribers_RepositoryError() {
 	subscriber := models.TestSubscriber()
-	users := models.TestUsers()
-	expUsers := users
+	pag := &models.Pagination{Limit: 10}
 	s.MockSubscribersRepository.EXPECT().
-		GetSubscribers(subscriber.CreatorID).
+		GetSubscribers(subscriber.CreatorID, pag, nil).
 		Times(1).
-		Return(expUsers, repository.NewDBError(repository.DefaultErrDB))
+		Return(nil, repository.NewDBError(repository.DefaultErrDB))
 
-	res, err := s.uc.GetSubscribers(subscriber.CreatorID)
-	assert.Equal(s.T(), expUsers, res)
+	res, err := s.uc.GetSubscribers(subscriber.CreatorID, pag, nil)
+	assert.Nil(s.T(), res)
 	assert.Error(s.T(), err)
 	assert.Equal(s.T(), repository.DefaultErrDB, errors.Cause(err).(*app.GeneralError).Err)
 }
+func (s *SuiteSubscribersUsecase) TestSubscribersUsecaseUnSubscribe_OK() {
+	subscriber := models.TestSubscriber()
+	s.MockSubscribersRepository.EXPECT().
+		Get(subscriber).
+		Times(1).
+		Return(true, nil)
+	s.MockSubscribersRepository.EXPECT().
+		Delete(subscriber).
+		Times(1).
+		Return(nil)
+	s.MockEventsRepository.EXPECT().
+		Add(&models.SubscriptionEvent{UserID: subscriber.UserID, CreatorID: subscriber.CreatorID,
+			AwardID: subscriber.AwardID, Kind: models.SubscriptionEventCancelled, Reason: "too expensive"}).
+		Times(1).
+		Return(nil)
+	s.MockPusher.EXPECT().
+		SeatFreed(subscriber.AwardID).
+		Times(1).
+		Return(nil)
+
+	err := s.uc.UnSubscribe(s.Logger.WithField("test", true), subscriber, "too expensive")
+	assert.NoError(s.T(), err)
+}
+
+func (s *SuiteSubscribersUsecase) TestSubscribersUsecaseUnSubscribe_NotFound() {
+	subscriber := models.TestSubscriber()
+	s.MockSubscribersRepository.EXPECT().
+		Get(subscriber).
+		Times(1).
+		Return(false, nil)
+
+	err := s.uc.UnSubscribe(s.Logger.WithField("test", true), subscriber, "")
+	assert.Equal(s.T(), SubscriptionsNotFound, err)
+}
+
+func (s *SuiteSubscribersUsecase) TestSubscriberUsecaseGetCreatorEvents_OK() {
+	pag := &models.Pagination{Limit: 10}
+	expEvents := []models.SubscriptionEvent{
+		{ID: 1, UserID: 2, CreatorID: 3, Kind: models.SubscriptionEventCancelled, Reason: "too expensive"},
+	}
+	s.MockEventsRepository.EXPECT().
+		GetCreatorEvents(int64(3), models.SubscriptionEventCancelled, pag).
+		Times(1).
+		Return(expEvents, nil)
+
+	res, err := s.uc.GetCreatorEvents(3, models.SubscriptionEventCancelled, pag)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), expEvents, res)
+}
+
+func (s *SuiteSubscribersUsecase) TestSubscribersUsecaseChangeTier_Upgrade() {
+	subscriber := models.TestSubscriber()
+	subscription := s.testSubscription()
+	award := &models.Award{ID: subscriber.AwardID, Price: models.NewDecimal(300), CreatorId: subscriber.CreatorID}
+	s.MockSubscribersRepository.EXPECT().
+		GetActive(subscriber.UserID, subscriber.CreatorID).
+		Times(1).
+		Return(subscription, nil)
+	s.MockAwardsRepository.EXPECT().
+		GetByID(subscriber.AwardID).
+		Times(1).
+		Return(award, nil)
+	s.MockAwardsRepository.EXPECT().
+		IsParent(subscription.AwardID, award.ID).
+		Times(1).
+		Return(true, nil)
+	var tokenInfo *models.PayTokenInfo
+	s.MockPayTokenRepository.EXPECT().
+		SetToken(gomock.Any(), int(upgradeTokenExp.Seconds())).
+		Times(1).
+		Do(func(info *models.PayTokenInfo, _ int) { tokenInfo = info }).
+		Return(nil)
+	s.MockPayTokenRepository.EXPECT().
+		MarkUsed(gomock.Any(), int(upgradeTokenExp.Seconds())).
+		Times(1).
+		Return(true, nil)
+	s.MockSubscribersRepository.EXPECT().
+		CreateUpgrade(subscription, gomock.Any()).
+		Times(1).
+		Return(nil)
+
+	res, err := s.uc.ChangeTier(subscriber)
+	assert.NoError(s.T(), err)
+	// half of period left, so half of price difference must be paid
+	assert.Equal(s.T(), models.NewDecimal(100), res.Amount)
+	assert.Equal(s.T(), models.TierUpgrade, res.Kind)
+	assert.Equal(s.T(), models.TierChangePending, res.Status)
+	assert.Equal(s.T(), subscription.AwardID, res.FromAwardID)
+	assert.Equal(s.T(), award.ID, res.ToAwardID)
+	assert.Equal(s.T(), res.PayToken, tokenInfo.Token)
+	assert.Equal(s.T(), res.Amount, tokenInfo.Price)
+	assert.Equal(s.T(), award.ID, tokenInfo.AwardID)
+}
+
+func (s *SuiteSubscribersUsecase) TestSubscribersUsecaseChangeTier_UpgradeNothingToPay() {
+	subscriber := models.TestSubscriber()
+	subscription := s.testSubscription()
+	subscription.PaidUntil = s.clock.Time
+	award := &models.Award{ID: subscriber.AwardID, Price: models.NewDecimal(300), CreatorId: subscriber.CreatorID}
+	s.MockSubscribersRepository.EXPECT().
+		GetActive(subscriber.UserID, subscriber.CreatorID).
+		Times(1).
+		Return(subscription, nil)
+	s.MockAwardsRepository.EXPECT().
+		GetByID(subscriber.AwardID).
+		Times(1).
+		Return(award, nil)
+	s.MockAwardsRepository.EXPECT().
+		IsParent(subscription.AwardID, award.ID).
+		Times(1).
+		Return(true, nil)
+	s.MockSubscribersRepository.EXPECT().
+		CreateUpgrade(subscription, gomock.Any()).
+		Times(1).
+		Return(nil)
+	s.MockEventsRepository.EXPECT().
+		Add(&models.SubscriptionEvent{UserID: subscription.UserID, CreatorID: subscription.CreatorID,
+			AwardID: award.ID, Kind: models.SubscriptionEventTierChanged, Reason: string(models.TierUpgrade)}).
+		Times(1).
+		Return(nil)
+
+	res, err := s.uc.ChangeTier(subscriber)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), models.NewDecimal(0), res.Amount)
+	assert.Equal(s.T(), models.TierChangeApplied, res.Status)
+	assert.Empty(s.T(), res.PayToken)
+}
+
+func (s *SuiteSubscribersUsecase) TestSubscribersUsecaseChangeTier_Downgrade() {
+	subscriber := models.TestSubscriber()
+	subscription := s.testSubscription()
+	award := &models.Award{ID: subscriber.AwardID, Price: models.NewDecimal(50), CreatorId: subscriber.CreatorID}
+	s.MockSubscribersRepository.EXPECT().
+		GetActive(subscriber.UserID, subscriber.CreatorID).
+		Times(1).
+		Return(subscription, nil)
+	s.MockAwardsRepository.EXPECT().
+		GetByID(subscriber.AwardID).
+		Times(1).
+		Return(award, nil)
+	s.MockAwardsRepository.EXPECT().
+		IsParent(subscription.AwardID, award.ID).
+		Times(1).
+		Return(false, nil)
+	s.MockAwardsRepository.EXPECT().
+		IsParent(award.ID, subscription.AwardID).
+		Times(1).
+		Return(true, nil)
+	s.MockSubscribersRepository.EXPECT().
+		ScheduleDowngrade(gomock.Any()).
+		Times(1).
+		Return(nil)
+
+	res, err := s.uc.ChangeTier(subscriber)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), models.TierDowngrade, res.Kind)
+	assert.Equal(s.T(), models.TierChangePending, res.Status)
+	assert.Equal(s.T(), subscription.PaidUntil, res.EffectiveAt)
+	assert.Equal(s.T(), models.NewDecimal(0), res.Amount)
+}
+
+func (s *SuiteSubscribersUsecase) TestSubscribersUsecaseChangeTier_NotRelated() {
+	subscriber := models.TestSubscriber()
+	subscription := s.testSubscription()
+	award := &models.Award{ID: subscriber.AwardID, Price: models.NewDecimal(50), CreatorId: subscriber.CreatorID}
+	s.MockSubscribersRepository.EXPECT().
+		GetActive(subscriber.UserID, subscriber.CreatorID).
+		Times(1).
+		Return(subscription, nil)
+	s.MockAwardsRepository.EXPECT().
+		GetByID(subscriber.AwardID).
+		Times(1).
+		Return(award, nil)
+	s.MockAwardsRepository.EXPECT().
+		IsParent(gomock.Any(), gomock.Any()).
+		Times(2).
+		Return(false, nil)
+
+	_, err := s.uc.ChangeTier(subscriber)
+	assert.Equal(s.T(), AwardsNotRelated, err)
+}
+
+func (s *SuiteSubscribersUsecase) TestSubscribersUsecaseChangeTier_CancelDowngrade() {
+	subscriber := models.TestSubscriber()
+	subscription := s.testSubscription()
+	subscription.AwardID = subscriber.AwardID
+	subscription.NextAwardID = 7
+	s.MockSubscribersRepository.EXPECT().
+		GetActive(subscriber.UserID, subscriber.CreatorID).
+		Times(1).
+		Return(subscription, nil)
+	s.MockSubscribersRepository.EXPECT().
+		CancelDowngrade(subscription.ID).
+		Times(1).
+		Return(nil)
+
+	res, err := s.uc.ChangeTier(subscriber)
+	assert.NoError(s.T(), err)
+	assert.Nil(s.T(), res)
+
+	subscription.NextAwardID = 0
+	s.MockSubscribersRepository.EXPECT().
+		GetActive(subscriber.UserID, subscriber.CreatorID).
+		Times(1).
+		Return(subscription, nil)
+	_, err = s.uc.ChangeTier(subscriber)
+	assert.Equal(s.T(), AwardAlreadySubscribed, err)
+}
+
+func (s *SuiteSubscribersUsecase) TestSubscribersUsecaseChangeTier_Errors() {
+	subscriber := models.TestSubscriber()
+	s.MockSubscribersRepository.EXPECT().
+		GetActive(subscriber.UserID, subscriber.CreatorID).
+		Times(1).
+		Return(nil, repository.NotFound)
+	_, err := s.uc.ChangeTier(subscriber)
+	assert.Equal(s.T(), SubscriptionsNotFound, err)
+
+	subscription := s.testSubscription()
+	subscription.InGrace = true
+	s.MockSubscribersRepository.EXPECT().
+		GetActive(subscriber.UserID, subscriber.CreatorID).
+		Times(1).
+		Return(subscription, nil)
+	_, err = s.uc.ChangeTier(subscriber)
+	assert.Equal(s.T(), SubscriptionInGrace, err)
+
+	subscription = s.testSubscription()
+	subscription.InTrial = true
+	s.MockSubscribersRepository.EXPECT().
+		GetActive(subscriber.UserID, subscriber.CreatorID).
+		Times(1).
+		Return(subscription, nil)
+	_, err = s.uc.ChangeTier(subscriber)
+	assert.Equal(s.T(), SubscriptionInTrial, err)
+
+	s.MockSubscribersRepository.EXPECT().
+		GetActive(subscriber.UserID, subscriber.CreatorID).
+		Times(1).
+		Return(s.testSubscription(), nil)
+	s.MockAwardsRepository.EXPECT().
+		GetByID(subscriber.AwardID).
+		Times(1).
+		Return(&models.Award{ID: subscriber.AwardID, CreatorId: subscriber.CreatorID + 1}, nil)
+	_, err = s.uc.ChangeTier(subscriber)
+	assert.Equal(s.T(), AwardNotBelongCreator, err)
+}
+
+func (s *SuiteSubscribersUsecase) TestSubscribersUsecaseStartTrial_OK() {
+	trial := &models.Trial{UserID: 1, CreatorID: 2, AwardID: 4}
+	s.MockAwardsRepository.EXPECT().
+		GetByID(trial.AwardID).
+		Times(1).
+		Return(&models.Award{ID: 4, CreatorId: 2, Price: models.NewDecimal(100), TrialDays: 7}, nil)
+	s.MockBlockedRepository.EXPECT().
+		IsBlocked(trial.CreatorID, trial.UserID).
+		Times(1).
+		Return(false, nil)
+	s.MockSubscribersRepository.EXPECT().
+		StartTrial(trial).
+		Times(1).
+		Return(nil)
+	s.MockEventsRepository.EXPECT().
+		Add(&models.SubscriptionEvent{UserID: trial.UserID, CreatorID: trial.CreatorID, AwardID: trial.AwardID,
+			Kind: models.SubscriptionEventSubscribed, Reason: trialEventReason}).
+		Times(1).
+		Return(nil)
+	err := s.uc.StartTrial(trial)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), time.Date(2021, 12, 8, 0, 0, 0, 0, time.UTC), trial.EndsAt)
+}
+
+func (s *SuiteSubscribersUsecase) TestSubscribersUsecaseStartTrial_Errors() {
+	trial := &models.Trial{UserID: 1, CreatorID: 2, AwardID: 4}
+	s.MockAwardsRepository.EXPECT().
+		GetByID(trial.AwardID).
+		Times(1).
+		Return(&models.Award{ID: 4, CreatorId: 3, TrialDays: 7}, nil)
+	err := s.uc.StartTrial(trial)
+	assert.Equal(s.T(), AwardNotBelongCreator, err)
+
+	s.MockAwardsRepository.EXPECT().
+		GetByID(trial.AwardID).
+		Times(1).
+		Return(&models.Award{ID: 4, CreatorId: 2}, nil)
+	err = s.uc.StartTrial(trial)
+	assert.Equal(s.T(), TrialNotAvailable, err)
+
+	s.MockAwardsRepository.EXPECT().
+		GetByID(trial.AwardID).
+		Times(1).
+		Return(nil, repository.NotFound)
+	err = s.uc.StartTrial(trial)
+	assert.Equal(s.T(), repository.NotFound, err)
+
+	s.MockAwardsRepository.EXPECT().
+		GetByID(trial.AwardID).
+		Times(1).
+		Return(&models.Award{ID: 4, CreatorId: 2, TrialDays: 7}, nil)
+	s.MockBlockedRepository.EXPECT().
+		IsBlocked(trial.CreatorID, trial.UserID).
+		Times(1).
+		Return(true, nil)
+	err = s.uc.StartTrial(trial)
+	assert.Equal(s.T(), UserBlocked, err)
+
+	s.MockAwardsRepository.EXPECT().
+		GetByID(trial.AwardID).
+		Times(1).
+		Return(&models.Award{ID: 4, CreatorId: 2, TrialDays: 7}, nil)
+	s.MockBlockedRepository.EXPECT().
+		IsBlocked(trial.CreatorID, trial.UserID).
+		Times(1).
+		Return(false, nil)
+	s.MockSubscribersRepository.EXPECT().
+		StartTrial(trial).
+		Times(1).
+		Return(repository_subscribers.TrialAlreadyUsed)
+	err = s.uc.StartTrial(trial)
+	assert.Equal(s.T(), repository_subscribers.TrialAlreadyUsed, err)
+}
+
+func (s *SuiteSubscribersUsecase) TestSubscribersUsecaseJoinWaitlist_OK() {
+	subscriber := models.TestSubscriber()
+	award := &models.Award{ID: subscriber.AwardID, CreatorId: subscriber.CreatorID, MaxSubscribers: 10}
+	s.MockAwardsRepository.EXPECT().
+		GetByID(subscriber.AwardID).
+		Times(1).
+		Return(award, nil)
+	s.MockSubscribersRepository.EXPECT().
+		AddToWaitlist(subscriber.AwardID, subscriber.UserID).
+		Times(1).
+		Return(nil)
+
+	err := s.uc.JoinWaitlist(subscriber)
+	assert.NoError(s.T(), err)
+}
+
+func (s *SuiteSubscribersUsecase) TestSubscribersUsecaseJoinWaitlist_HasFreeSeats() {
+	subscriber := models.TestSubscriber()
+	award := &models.Award{ID: subscriber.AwardID, CreatorId: subscriber.CreatorID, MaxSubscribers: 10, SeatsLeft: 1}
+	s.MockAwardsRepository.EXPECT().
+		GetByID(subscriber.AwardID).
+		Times(1).
+		Return(award, nil)
+	err := s.uc.JoinWaitlist(subscriber)
+	assert.Equal(s.T(), AwardHasFreeSeats, err)
+
+	award = &models.Award{ID: subscriber.AwardID, CreatorId: subscriber.CreatorID}
+	s.MockAwardsRepository.EXPECT().
+		GetByID(subscriber.AwardID).
+		Times(1).
+		Return(award, nil)
+	err = s.uc.JoinWaitlist(subscriber)
+	assert.Equal(s.T(), AwardHasFreeSeats, err)
+}
+
+func (s *SuiteSubscribersUsecase) TestSubscribersUsecaseJoinWaitlist_NotBelongCreator() {
+	subscriber := models.TestSubscriber()
+	award := &models.Award{ID: subscriber.AwardID, CreatorId: subscriber.CreatorID + 1, MaxSubscribers: 10}
+	s.MockAwardsRepository.EXPECT().
+		GetByID(subscriber.AwardID).
+		Times(1).
+		Return(award, nil)
+
+	err := s.uc.JoinWaitlist(subscriber)
+	assert.Equal(s.T(), AwardNotBelongCreator, err)
+}
+
 func TestSubscribersUsecase(t *testing.T) {
 	suite.Run(t, new(SuiteSubscribersUsecase))
 }
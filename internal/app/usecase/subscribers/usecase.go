@@ -1,22 +1,43 @@
 package usecase_subscribers
 
-import "patreon/internal/app/models"
+import (
+	"patreon/internal/app/models"
+
+	"github.com/sirupsen/logrus"
+)
 
 //go:generate mockgen -destination=mocks/mock_subscribers_usecase.go -package=mock_usecase -mock_names=Usecase=SubscribersUsecase . Usecase
 
 type Usecase interface {
 	// Subscribe Errors:
+	//		UserBlocked
 	//		SubscriptionAlreadyExists
+	//		repository_subscribers.AwardSoldOut
 	//		repository_postgresql.AwardNameNotFound
+	//		repository_promo_codes.PromoCodeExhausted
+	//		repository_promo_codes.PromoCodeAlreadyUsed
 	//		app.generalError with Errors
 	//			repository.DefaultErrDB
-	Subscribe(subscriber *models.Subscriber, token string) error
+	Subscribe(subscriber *models.Subscriber, payToken *models.PayTokenInfo) error
 
 	// UnSubscribe Errors:
 	//		SubscriptionsNotFound
 	//		app.generalError with Errors
 	//			repository.DefaultErrDB
-	UnSubscribe(subscriber *models.Subscriber) error
+	UnSubscribe(log *logrus.Entry, subscriber *models.Subscriber, reason string) error
+
+	// GetUserEvents Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors
+	//			repository.DefaultErrDB
+	GetUserEvents(userID int64, pag *models.Pagination) ([]models.SubscriptionEvent, error)
+
+	// GetCreatorEvents Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors
+	//			repository.DefaultErrDB
+	GetCreatorEvents(creatorID int64, kind models.SubscriptionEventKind,
+		pag *models.Pagination) ([]models.SubscriptionEvent, error)
 
 	// GetCreators Errors:
 	//		app.GeneralError with Errors
@@ -24,7 +45,53 @@ type Usecase interface {
 	GetCreators(userID int64) ([]models.CreatorSubscribe, error)
 
 	// GetSubscribers Errors:
+	//		repository.NotFound
+	//		app.GeneralError with Errors
+	//			repository.DefaultErrDB
+	GetSubscribers(creatorID int64, pag *models.Pagination,
+		filter *models.SubscribersFilter) ([]models.CreatorSubscriber, error)
+
+	// ChangeTier Errors:
+	//		SubscriptionsNotFound
+	//		SubscriptionInGrace
+	//		SubscriptionInTrial
+	//		AwardAlreadySubscribed
+	//		AwardNotBelongCreator
+	//		AwardsNotRelated
+	//		repository.NotFound
+	//		repository_subscribers.AwardSoldOut
+	//		app.GeneralError with Errors
+	//			repository.DefaultErrDB
+	//			repository_redis.SetError
+	ChangeTier(subscriber *models.Subscriber) (*models.TierChange, error)
+
+	// GetTierChanges Errors:
+	//		app.GeneralError with Errors
+	//			repository.DefaultErrDB
+	GetTierChanges(userID int64, creatorID int64) ([]models.TierChange, error)
+
+	// StartTrial Errors:
+	//		UserBlocked
+	//		AwardNotBelongCreator
+	//		TrialNotAvailable
+	//		repository_subscribers.TrialNotAllowed
+	//		repository_subscribers.TrialAlreadyUsed
+	//		repository_subscribers.AwardSoldOut
+	//		repository.NotFound
+	//		app.GeneralError with Errors
+	//			repository.DefaultErrDB
+	StartTrial(trial *models.Trial) error
+
+	// JoinWaitlist Errors:
+	//		AwardNotBelongCreator
+	//		AwardHasFreeSeats
+	//		repository.NotFound
+	//		app.GeneralError with Errors
+	//			repository.DefaultErrDB
+	JoinWaitlist(subscriber *models.Subscriber) error
+
+	// LeaveWaitlist Errors:
 	//		app.GeneralError with Errors
 	//			repository.DefaultErrDB
-	GetSubscribers(creatorID int64) ([]models.User, error)
+	LeaveWaitlist(subscriber *models.Subscriber) error
 }
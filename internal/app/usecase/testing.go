@@ -2,23 +2,47 @@ package usecase
 
 import (
 	"io"
+	mock_payment_provider "patreon/internal/app/payment_provider/mocks"
 	mock_repository "patreon/internal/app/repository/access/mocks"
 	mock_repository_attaches "patreon/internal/app/repository/attaches/mocks"
 	mock_repository_awards "patreon/internal/app/repository/awards/mocks"
+	mock_repository_blocked_users "patreon/internal/app/repository/blocked_users/mocks"
 	mock_repository_creator "patreon/internal/app/repository/creator/mocks"
+	mock_repository_gifts "patreon/internal/app/repository/gifts/mocks"
 	mock_repository_info "patreon/internal/app/repository/info/mocks"
+	mock_repository_ledger "patreon/internal/app/repository/ledger/mocks"
 	mock_repository_likes "patreon/internal/app/repository/likes/mocks"
+	mock_repository_pay_token "patreon/internal/app/repository/pay_token/mocks"
+	mock_repository_payments "patreon/internal/app/repository/payments/mocks"
+	mock_repository_polls "patreon/internal/app/repository/polls/mocks"
+	mock_repository_post_revisions "patreon/internal/app/repository/post_revisions/mocks"
+	mock_repository_post_unlocks "patreon/internal/app/repository/post_unlocks/mocks"
 	mock_repository_posts "patreon/internal/app/repository/posts/mocks"
+	mock_repository_promo_codes "patreon/internal/app/repository/promo_codes/mocks"
 	mock_repository_subscribers "patreon/internal/app/repository/subscribers/mocks"
+	mock_repository_subscription_events "patreon/internal/app/repository/subscription_events/mocks"
+	mock_repository_tags "patreon/internal/app/repository/tags/mocks"
+	mock_repository_tips "patreon/internal/app/repository/tips/mocks"
 	mock_repository_user "patreon/internal/app/repository/user/mocks"
 	mock_files "patreon/internal/microservices/files/delivery/grpc/client/mocks"
+	mock_push_client "patreon/internal/microservices/push/delivery/client/mocks"
 	mock_utils "patreon/pkg/utils/mocks"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/suite"
 )
 
+// FakeClock utils.Clock with manually set time
+type FakeClock struct {
+	Time time.Time
+}
+
+func (c *FakeClock) Now() time.Time {
+	return c.Time
+}
+
 type TestTable struct {
 	Name              string
 	Data              interface{}
@@ -37,6 +61,20 @@ type SuiteUsecase struct {
 	MockAccessRepository      *mock_repository.AccessRepository
 	MockInfoRepository        *mock_repository_info.InfoRepository
 	MockAttachesRepository    *mock_repository_attaches.AttachesRepository
+	MockPaymentsRepository    *mock_repository_payments.PaymentsRepository
+	MockPayTokenRepository    *mock_repository_pay_token.PayTokenRepository
+	MockLedgerRepository      *mock_repository_ledger.LedgerRepository
+	MockPromoCodesRepository  *mock_repository_promo_codes.PromoCodesRepository
+	MockGiftsRepository       *mock_repository_gifts.GiftsRepository
+	MockTipsRepository        *mock_repository_tips.TipsRepository
+	MockPostUnlocksRepository *mock_repository_post_unlocks.PostUnlocksRepository
+	MockEventsRepository      *mock_repository_subscription_events.SubscriptionEventsRepository
+	MockBlockedRepository     *mock_repository_blocked_users.BlockedUsersRepository
+	MockRevisionsRepository   *mock_repository_post_revisions.PostRevisionsRepository
+	MockTagsRepository        *mock_repository_tags.TagsRepository
+	MockPollsRepository       *mock_repository_polls.PollsRepository
+	MockPusher                *mock_push_client.MockPusher
+	MockPaymentProvider       *mock_payment_provider.MockPaymentProvider
 	MockFileClient            *mock_files.MockFileServiceClient
 	MockConvector             *mock_utils.MockImageConverter
 	MockSubscriberRepository  *mock_repository_subscribers.SubscribersRepository
@@ -57,6 +95,20 @@ func (s *SuiteUsecase) SetupSuite() {
 	s.MockInfoRepository = mock_repository_info.NewInfoRepository(s.Mock)
 	s.MockConvector = mock_utils.NewMockImageConverter(s.Mock)
 	s.MockAccessRepository = mock_repository.NewAccessRepository(s.Mock)
+	s.MockPaymentsRepository = mock_repository_payments.NewPaymentsRepository(s.Mock)
+	s.MockPayTokenRepository = mock_repository_pay_token.NewPayTokenRepository(s.Mock)
+	s.MockLedgerRepository = mock_repository_ledger.NewLedgerRepository(s.Mock)
+	s.MockPromoCodesRepository = mock_repository_promo_codes.NewPromoCodesRepository(s.Mock)
+	s.MockGiftsRepository = mock_repository_gifts.NewGiftsRepository(s.Mock)
+	s.MockTipsRepository = mock_repository_tips.NewTipsRepository(s.Mock)
+	s.MockPostUnlocksRepository = mock_repository_post_unlocks.NewPostUnlocksRepository(s.Mock)
+	s.MockEventsRepository = mock_repository_subscription_events.NewSubscriptionEventsRepository(s.Mock)
+	s.MockBlockedRepository = mock_repository_blocked_users.NewBlockedUsersRepository(s.Mock)
+	s.MockRevisionsRepository = mock_repository_post_revisions.NewPostRevisionsRepository(s.Mock)
+	s.MockTagsRepository = mock_repository_tags.NewTagsRepository(s.Mock)
+	s.MockPollsRepository = mock_repository_polls.NewPollsRepository(s.Mock)
+	s.MockPusher = mock_push_client.NewMockPusher(s.Mock)
+	s.MockPaymentProvider = mock_payment_provider.NewMockPaymentProvider(s.Mock)
 
 	s.Logger = logrus.New()
 	s.Logger.SetOutput(io.Discard)
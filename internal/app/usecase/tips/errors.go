@@ -0,0 +1,10 @@
+package usecase_tips
+
+import "github.com/pkg/errors"
+
+var (
+	TipAmountTooSmall    = errors.New("tip amount is less than minimal")
+	CreatorNotFound      = errors.New("creator not found")
+	TipToSelf            = errors.New("creator can not tip himself")
+	PostNotBelongCreator = errors.New("post not belongs to this creator")
+)
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: patreon/internal/app/usecase/tips (interfaces: Usecase)
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	models "patreon/internal/app/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// TipsUsecase is a mock of Usecase interface.
+type TipsUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *TipsUsecaseMockRecorder
+}
+
+// TipsUsecaseMockRecorder is the mock recorder for TipsUsecase.
+type TipsUsecaseMockRecorder struct {
+	mock *TipsUsecase
+}
+
+// NewTipsUsecase creates a new mock instance.
+func NewTipsUsecase(ctrl *gomock.Controller) *TipsUsecase {
+	mock := &TipsUsecase{ctrl: ctrl}
+	mock.recorder = &TipsUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *TipsUsecase) EXPECT() *TipsUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *TipsUsecase) Create(arg0 *models.Tip) (*models.Tip, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(*models.Tip)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *TipsUsecaseMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*TipsUsecase)(nil).Create), arg0)
+}
@@ -0,0 +1,120 @@
+package usecase_tips
+
+import (
+	"patreon/internal/app"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	repository_creator "patreon/internal/app/repository/creator"
+	repository_pay_token "patreon/internal/app/repository/pay_token"
+	repository_posts "patreon/internal/app/repository/posts"
+	repository_tips "patreon/internal/app/repository/tips"
+	"patreon/pkg/utils"
+	"time"
+
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	DefaultMinTipAmount = 10
+	tipTokenExp         = 3 * time.Hour
+)
+
+type TipsUsecase struct {
+	repository   repository_tips.Repository
+	repoCreator  repository_creator.Repository
+	repoPosts    repository_posts.Repository
+	repoPayToken repository_pay_token.Repository
+	clock        utils.Clock
+	minAmount    models.Decimal
+}
+
+func NewTipsUsecase(repository repository_tips.Repository, repoCreator repository_creator.Repository,
+	repoPosts repository_posts.Repository, repoPayToken repository_pay_token.Repository,
+	clock utils.Clock, minAmount int64) *TipsUsecase {
+	if minAmount <= 0 {
+		minAmount = DefaultMinTipAmount
+	}
+	return &TipsUsecase{
+		repository:   repository,
+		repoCreator:  repoCreator,
+		repoPosts:    repoPosts,
+		repoPayToken: repoPayToken,
+		clock:        clock,
+		minAmount:    models.NewDecimal(minAmount),
+	}
+}
+
+// Create tip of tip.UserID to creator or to post of creator if tip.PostID is set.
+// Tip amount is in settlement currency of creator, payment of tip is created right here
+// and paid by returned tip.PayToken
+// Errors:
+//		models.IncorrectTipAmount
+//		models.IncorrectTipMessage
+//		TipAmountTooSmall
+//		CreatorNotFound
+//		TipToSelf
+//		PostNotBelongCreator
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			app.UnknownError
+//			repository.DefaultErrDB
+//			repository_redis.SetError
+func (usecase *TipsUsecase) Create(tip *models.Tip) (*models.Tip, error) {
+	if err := tip.Validate(); err != nil {
+		if errors.Is(err, models.IncorrectTipAmount) || errors.Is(err, models.IncorrectTipMessage) {
+			return nil, err
+		}
+		return nil, &app.GeneralError{
+			Err:         app.UnknownError,
+			ExternalErr: errors.Wrap(err, "failed process of validation tip"),
+		}
+	}
+	if tip.Amount < usecase.minAmount {
+		return nil, TipAmountTooSmall
+	}
+
+	currency, err := usecase.repoCreator.GetCurrency(tip.CreatorID)
+	if err != nil {
+		if errors.Is(err, repository.NotFound) {
+			return nil, CreatorNotFound
+		}
+		return nil, err
+	}
+	if tip.UserID == tip.CreatorID {
+		return nil, TipToSelf
+	}
+
+	if tip.PostID != 0 {
+		creatorID, err := usecase.repoPosts.GetPostCreator(tip.PostID)
+		if err != nil {
+			return nil, err
+		}
+		if creatorID != tip.CreatorID {
+			return nil, PostNotBelongCreator
+		}
+	}
+
+	tip.Currency = currency
+	tip.PayToken = uuid.NewV4().String()
+	err = usecase.repoPayToken.SetToken(&models.PayTokenInfo{
+		Token:     tip.PayToken,
+		UserID:    tip.UserID,
+		CreatorID: tip.CreatorID,
+		Price:     tip.Amount,
+		Currency:  tip.Currency,
+		ExpiresAt: usecase.clock.Now().Add(tipTokenExp),
+	}, int(tipTokenExp.Seconds()))
+	if err != nil {
+		return nil, err
+	}
+	// tip payment is created right here, so token can not be used for subscription
+	if _, err = usecase.repoPayToken.MarkUsed(tip.PayToken, int(tipTokenExp.Seconds())); err != nil {
+		return nil, err
+	}
+
+	if err = usecase.repository.Create(tip); err != nil {
+		return nil, err
+	}
+	return tip, nil
+}
@@ -0,0 +1,108 @@
+package usecase_tips
+
+import (
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	"patreon/internal/app/usecase"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type SuiteTipsUsecase struct {
+	usecase.SuiteUsecase
+	clock *usecase.FakeClock
+	uc    Usecase
+}
+
+func (s *SuiteTipsUsecase) SetupSuite() {
+	s.SuiteUsecase.SetupSuite()
+	s.clock = &usecase.FakeClock{Time: time.Date(2021, 12, 1, 12, 0, 0, 0, time.UTC)}
+	s.uc = NewTipsUsecase(s.MockTipsRepository, s.MockCreatorRepository, s.MockPostsRepository,
+		s.MockPayTokenRepository, s.clock, 0)
+}
+
+func (s *SuiteTipsUsecase) TestTipsUsecase_Create_ToPost() {
+	tip := models.TestTip()
+	tip.PostID = 3
+	s.MockCreatorRepository.EXPECT().
+		GetCurrency(tip.CreatorID).
+		Times(1).
+		Return(models.DefaultCurrency, nil)
+	s.MockPostsRepository.EXPECT().
+		GetPostCreator(tip.PostID).
+		Times(1).
+		Return(tip.CreatorID, nil)
+	s.MockPayTokenRepository.EXPECT().
+		SetToken(gomock.Any(), int(tipTokenExp.Seconds())).
+		Times(1).
+		DoAndReturn(func(info *models.PayTokenInfo, _ int) error {
+			assert.Equal(s.T(), tip.UserID, info.UserID)
+			assert.Equal(s.T(), tip.Amount, info.Price)
+			assert.Equal(s.T(), models.DefaultCurrency, info.Currency)
+			assert.Equal(s.T(), int64(0), info.AwardID)
+			assert.Equal(s.T(), s.clock.Time.Add(tipTokenExp), info.ExpiresAt)
+			return nil
+		})
+	s.MockPayTokenRepository.EXPECT().
+		MarkUsed(gomock.Any(), int(tipTokenExp.Seconds())).
+		Times(1).
+		Return(true, nil)
+	s.MockTipsRepository.EXPECT().
+		Create(tip).
+		Times(1).
+		Return(nil)
+	res, err := s.uc.Create(tip)
+	require.NoError(s.T(), err)
+	assert.NotEmpty(s.T(), res.PayToken)
+}
+
+func (s *SuiteTipsUsecase) TestTipsUsecase_Create_Errors() {
+	tip := models.TestTip()
+	tip.Amount = models.NewDecimal(DefaultMinTipAmount) - 1
+	_, err := s.uc.Create(tip)
+	assert.Equal(s.T(), TipAmountTooSmall, err)
+
+	tip = models.TestTip()
+	tip.Amount = -5
+	_, err = s.uc.Create(tip)
+	assert.Equal(s.T(), models.IncorrectTipAmount, err)
+
+	tip = models.TestTip()
+	s.MockCreatorRepository.EXPECT().
+		GetCurrency(tip.CreatorID).
+		Times(1).
+		Return("", repository.NotFound)
+	_, err = s.uc.Create(tip)
+	assert.Equal(s.T(), CreatorNotFound, err)
+
+	tip = models.TestTip()
+	tip.UserID = tip.CreatorID
+	s.MockCreatorRepository.EXPECT().
+		GetCurrency(tip.CreatorID).
+		Times(1).
+		Return(models.DefaultCurrency, nil)
+	_, err = s.uc.Create(tip)
+	assert.Equal(s.T(), TipToSelf, err)
+
+	tip = models.TestTip()
+	tip.PostID = 3
+	s.MockCreatorRepository.EXPECT().
+		GetCurrency(tip.CreatorID).
+		Times(1).
+		Return(models.DefaultCurrency, nil)
+	s.MockPostsRepository.EXPECT().
+		GetPostCreator(tip.PostID).
+		Times(1).
+		Return(tip.CreatorID+1, nil)
+	_, err = s.uc.Create(tip)
+	assert.Equal(s.T(), PostNotBelongCreator, err)
+}
+
+func TestTipsUsecase(t *testing.T) {
+	suite.Run(t, new(SuiteTipsUsecase))
+}
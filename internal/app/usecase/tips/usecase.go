@@ -0,0 +1,21 @@
+package usecase_tips
+
+import "patreon/internal/app/models"
+
+//go:generate mockgen -destination=mocks/mock_tips_usecase.go -package=mock_usecase -mock_names=Usecase=TipsUsecase . Usecase
+
+type Usecase interface {
+	// Create Errors:
+	//		models.IncorrectTipAmount
+	//		models.IncorrectTipMessage
+	//		TipAmountTooSmall
+	//		CreatorNotFound
+	//		TipToSelf
+	//		PostNotBelongCreator
+	//		repository.NotFound
+	//		app.GeneralError with Errors:
+	//			app.UnknownError
+	//			repository.DefaultErrDB
+	//			repository_redis.SetError
+	Create(tip *models.Tip) (*models.Tip, error)
+}
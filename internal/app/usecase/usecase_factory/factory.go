@@ -1,28 +1,45 @@
 package usecase_factory
 
 import (
+	"net/http"
 	"patreon/internal/app"
 	usecase_csrf "patreon/internal/app/csrf/usecase"
+	"patreon/internal/app/models"
+	"patreon/internal/app/payment_provider"
+	fake_provider "patreon/internal/app/payment_provider/fake"
+	yoomoney_provider "patreon/internal/app/payment_provider/yoomoney"
 	useAccess "patreon/internal/app/usecase/access"
 	useAttaches "patreon/internal/app/usecase/attaches"
 	useAwards "patreon/internal/app/usecase/awards"
+	useBilling "patreon/internal/app/usecase/billing"
+	useBlocked "patreon/internal/app/usecase/blocked_users"
 	useComments "patreon/internal/app/usecase/comments"
 	useCreator "patreon/internal/app/usecase/creator"
+	useGifts "patreon/internal/app/usecase/gifts"
 	useInfo "patreon/internal/app/usecase/info"
+	useLedger "patreon/internal/app/usecase/ledger"
 	useLikes "patreon/internal/app/usecase/likes"
 	usePayToken "patreon/internal/app/usecase/pay_token"
 	usePayments "patreon/internal/app/usecase/payments"
+	usePolls "patreon/internal/app/usecase/polls"
+	usePostRevisions "patreon/internal/app/usecase/post_revisions"
+	usePostUnlocks "patreon/internal/app/usecase/post_unlocks"
 	usePosts "patreon/internal/app/usecase/posts"
+	usePromoCodes "patreon/internal/app/usecase/promo_codes"
 	useStats "patreon/internal/app/usecase/statistics"
 	useSubscr "patreon/internal/app/usecase/subscribers"
+	useTips "patreon/internal/app/usecase/tips"
 	useUser "patreon/internal/app/usecase/user"
 	"patreon/internal/microservices/files/delivery/grpc/client"
+	"patreon/pkg/utils"
+	"time"
 
 	"google.golang.org/grpc"
 )
 
 type UsecaseFactory struct {
 	paymentsConfig     app.Payments
+	rates              *models.ExchangeRates
 	repositoryFactory  RepositoryFactory
 	userUsecase        useUser.Usecase
 	creatorUsecase     useCreator.Usecase
@@ -39,14 +56,26 @@ type UsecaseFactory struct {
 	statsUsecase       useStats.Usecase
 	commentsUsecase    useComments.Usecase
 	payTokenUsecase    usePayToken.Usecase
+	billingUsecase     useBilling.Usecase
+	ledgerUsecase      useLedger.Usecase
+	promoCodesUsecase  usePromoCodes.Usecase
+	giftsUsecase       useGifts.Usecase
+	tipsUsecase        useTips.Usecase
+	postUnlocksUsecase usePostUnlocks.Usecase
+	blockedUsecase     useBlocked.Usecase
+	revisionsUsecase   usePostRevisions.Usecase
+	pollsUsecase       usePolls.Usecase
+	paymentProvider    payment_provider.PaymentProvider
 }
 
-func NewUsecaseFactory(repositoryFactory RepositoryFactory, fileConn *grpc.ClientConn, paymentsConf app.Payments) *UsecaseFactory {
+func NewUsecaseFactory(repositoryFactory RepositoryFactory, fileConn *grpc.ClientConn, paymentsConf app.Payments,
+	rates *models.ExchangeRates) *UsecaseFactory {
 	fileClient := client.NewFileServiceClient(fileConn)
 	return &UsecaseFactory{
 		repositoryFactory: repositoryFactory,
 		fileClient:        fileClient,
 		paymentsConfig:    paymentsConf,
+		rates:             rates,
 	}
 }
 
@@ -60,7 +89,7 @@ func (f *UsecaseFactory) GetUserUsecase() useUser.Usecase {
 func (f *UsecaseFactory) GetCreatorUsecase() useCreator.Usecase {
 	if f.creatorUsecase == nil {
 		f.creatorUsecase = useCreator.NewCreatorUsecase(f.repositoryFactory.GetCreatorRepository(),
-			f.fileClient)
+			f.fileClient, f.rates)
 	}
 	return f.creatorUsecase
 }
@@ -80,7 +109,9 @@ func (f *UsecaseFactory) GetAccessUsecase() useAccess.Usecase {
 func (f *UsecaseFactory) GetSubscribersUsecase() useSubscr.Usecase {
 	if f.subscribersUsecase == nil {
 		f.subscribersUsecase = useSubscr.NewSubscribersUsecase(f.repositoryFactory.GetSubscribersRepository(),
-			f.repositoryFactory.GetAwardsRepository())
+			f.repositoryFactory.GetAwardsRepository(), f.repositoryFactory.GetPayTokenRepository(),
+			f.repositoryFactory.GetSubscriptionEventsRepository(), f.repositoryFactory.GetBlockedUsersRepository(),
+			f.repositoryFactory.GetPusher(), utils.SystemClock{})
 	}
 	return f.subscribersUsecase
 }
@@ -96,7 +127,8 @@ func (f *UsecaseFactory) GetAwardsUsecase() useAwards.Usecase {
 func (f *UsecaseFactory) GetPostsUsecase() usePosts.Usecase {
 	if f.postsUsecase == nil {
 		f.postsUsecase = usePosts.NewPostsUsecase(f.repositoryFactory.GetPostsRepository(),
-			f.repositoryFactory.GetAttachesRepository(), f.fileClient, f.repositoryFactory.GetPusher())
+			f.repositoryFactory.GetAttachesRepository(), f.repositoryFactory.GetPostRevisionsRepository(),
+			f.repositoryFactory.GetTagsRepository(), f.fileClient, f.repositoryFactory.GetPusher(), utils.SystemClock{})
 	}
 	return f.postsUsecase
 }
@@ -111,14 +143,30 @@ func (f *UsecaseFactory) GetLikesUsecase() useLikes.Usecase {
 func (f *UsecaseFactory) GetAttachesUsecase() useAttaches.Usecase {
 	if f.attachesUsecase == nil {
 		f.attachesUsecase = useAttaches.NewAttachesUsecase(f.repositoryFactory.GetAttachesRepository(),
-			f.fileClient)
+			f.repositoryFactory.GetPostRevisionsRepository(), f.fileClient)
 	}
 	return f.attachesUsecase
 }
 
+func (f *UsecaseFactory) GetPaymentProvider() payment_provider.PaymentProvider {
+	if f.paymentProvider == nil {
+		client := &http.Client{Timeout: 10 * time.Second}
+		switch f.paymentsConfig.Provider {
+		case payment_provider.Fake:
+			f.paymentProvider = fake_provider.NewFakeProvider(f.paymentsConfig.FakeWebhookUrl,
+				f.paymentsConfig.NotificationSecret, client)
+		default:
+			f.paymentProvider = yoomoney_provider.NewYooMoneyProvider(f.paymentsConfig.AccountNumber,
+				f.paymentsConfig.NotificationSecret, f.paymentsConfig.AccessToken, client)
+		}
+	}
+	return f.paymentProvider
+}
+
 func (f *UsecaseFactory) GetPaymentsUsecase() usePayments.Usecase {
 	if f.paymentsUsecase == nil {
-		f.paymentsUsecase = usePayments.NewPaymentsUsecase(f.repositoryFactory.GetPaymentsRepository(), f.repositoryFactory.GetPusher())
+		f.paymentsUsecase = usePayments.NewPaymentsUsecase(f.repositoryFactory.GetPaymentsRepository(), f.repositoryFactory.GetPusher(),
+			f.GetPaymentProvider(), f.repositoryFactory.GetSubscriptionEventsRepository(), f.paymentsConfig.PlatformFeePercent)
 	}
 	return f.paymentsUsecase
 }
@@ -131,20 +179,103 @@ func (f *UsecaseFactory) GetInfoUsecase() useInfo.Usecase {
 }
 func (f *UsecaseFactory) GetStatsUsecase() useStats.Usecase {
 	if f.statsUsecase == nil {
-		f.statsUsecase = useStats.NewStatisticsUsecase(f.repositoryFactory.GetStatsRepository())
+		f.statsUsecase = useStats.NewStatisticsUsecase(f.repositoryFactory.GetStatsRepository(),
+			f.repositoryFactory.GetCreatorRepository(), f.rates)
 	}
 	return f.statsUsecase
 }
 
 func (f *UsecaseFactory) GetCommentsUsecase() useComments.Usecase {
 	if f.commentsUsecase == nil {
-		f.commentsUsecase = useComments.NewCommentsUsecase(f.repositoryFactory.GetCommentsRepository(), f.repositoryFactory.GetPusher())
+		f.commentsUsecase = useComments.NewCommentsUsecase(f.repositoryFactory.GetCommentsRepository(),
+			f.repositoryFactory.GetBlockedUsersRepository(), f.repositoryFactory.GetPusher())
 	}
 	return f.commentsUsecase
 }
 func (f *UsecaseFactory) GetPayTokenUsecase() usePayToken.Usecase {
 	if f.payTokenUsecase == nil {
-		f.payTokenUsecase = usePayToken.NewPayTokenUsecase(f.repositoryFactory.GetPayTokenRepository(), f.paymentsConfig.AccountNumber)
+		f.payTokenUsecase = usePayToken.NewPayTokenUsecase(f.repositoryFactory.GetPayTokenRepository(),
+			f.repositoryFactory.GetAwardsRepository(), f.repositoryFactory.GetPromoCodesRepository(),
+			utils.SystemClock{}, f.paymentsConfig.AccountNumber)
 	}
 	return f.payTokenUsecase
 }
+
+func (f *UsecaseFactory) GetLedgerUsecase() useLedger.Usecase {
+	if f.ledgerUsecase == nil {
+		f.ledgerUsecase = useLedger.NewLedgerUsecase(f.repositoryFactory.GetLedgerRepository(),
+			f.paymentsConfig.PayoutAdmins)
+	}
+	return f.ledgerUsecase
+}
+
+func (f *UsecaseFactory) GetPromoCodesUsecase() usePromoCodes.Usecase {
+	if f.promoCodesUsecase == nil {
+		f.promoCodesUsecase = usePromoCodes.NewPromoCodesUsecase(f.repositoryFactory.GetPromoCodesRepository(),
+			f.repositoryFactory.GetAwardsRepository(), utils.SystemClock{})
+	}
+	return f.promoCodesUsecase
+}
+
+func (f *UsecaseFactory) GetGiftsUsecase() useGifts.Usecase {
+	if f.giftsUsecase == nil {
+		f.giftsUsecase = useGifts.NewGiftsUsecase(f.repositoryFactory.GetGiftsRepository(),
+			f.repositoryFactory.GetAwardsRepository(), f.repositoryFactory.GetUserRepository(),
+			f.repositoryFactory.GetSubscribersRepository(), f.repositoryFactory.GetPayTokenRepository(),
+			f.repositoryFactory.GetBlockedUsersRepository(), f.repositoryFactory.GetPusher(), utils.SystemClock{})
+	}
+	return f.giftsUsecase
+}
+
+func (f *UsecaseFactory) GetTipsUsecase() useTips.Usecase {
+	if f.tipsUsecase == nil {
+		f.tipsUsecase = useTips.NewTipsUsecase(f.repositoryFactory.GetTipsRepository(),
+			f.repositoryFactory.GetCreatorRepository(), f.repositoryFactory.GetPostsRepository(),
+			f.repositoryFactory.GetPayTokenRepository(), utils.SystemClock{}, f.paymentsConfig.MinTipAmount)
+	}
+	return f.tipsUsecase
+}
+
+func (f *UsecaseFactory) GetPostUnlocksUsecase() usePostUnlocks.Usecase {
+	if f.postUnlocksUsecase == nil {
+		f.postUnlocksUsecase = usePostUnlocks.NewPostUnlocksUsecase(f.repositoryFactory.GetPostUnlocksRepository(),
+			f.repositoryFactory.GetPostsRepository(), f.repositoryFactory.GetUserRepository(),
+			f.repositoryFactory.GetCreatorRepository(), f.repositoryFactory.GetPayTokenRepository(),
+			utils.SystemClock{})
+	}
+	return f.postUnlocksUsecase
+}
+
+func (f *UsecaseFactory) GetBillingUsecase() useBilling.Usecase {
+	if f.billingUsecase == nil {
+		f.billingUsecase = useBilling.NewBillingUsecase(f.repositoryFactory.GetSubscribersRepository(),
+			f.repositoryFactory.GetPayTokenRepository(), f.repositoryFactory.GetSubscriptionEventsRepository(),
+			f.repositoryFactory.GetPusher(), utils.SystemClock{},
+			time.Duration(f.paymentsConfig.RenewalNoticeHours)*time.Hour,
+			time.Duration(f.paymentsConfig.GracePeriodHours)*time.Hour)
+	}
+	return f.billingUsecase
+}
+
+func (f *UsecaseFactory) GetBlockedUsersUsecase() useBlocked.Usecase {
+	if f.blockedUsecase == nil {
+		f.blockedUsecase = useBlocked.NewBlockedUsersUsecase(f.repositoryFactory.GetBlockedUsersRepository(),
+			f.repositoryFactory.GetSubscribersRepository())
+	}
+	return f.blockedUsecase
+}
+
+func (f *UsecaseFactory) GetPostRevisionsUsecase() usePostRevisions.Usecase {
+	if f.revisionsUsecase == nil {
+		f.revisionsUsecase = usePostRevisions.NewPostRevisionsUsecase(f.repositoryFactory.GetPostRevisionsRepository())
+	}
+	return f.revisionsUsecase
+}
+
+func (f *UsecaseFactory) GetPollsUsecase() usePolls.Usecase {
+	if f.pollsUsecase == nil {
+		f.pollsUsecase = usePolls.NewPollsUsecase(f.repositoryFactory.GetPollsRepository(),
+			f.repositoryFactory.GetAttachesRepository(), utils.SystemClock{})
+	}
+	return f.pollsUsecase
+}
@@ -24,7 +24,7 @@ func (s *FactorySuite) SetupSuite() {
 	s.fileConn, _ = grpc.Dial("", grpc.WithInsecure())
 }
 func (s *FactorySuite) TestGetUserUsecaseFirstCall() {
-	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"})
+	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"}, nil)
 	s.mockRepositoryFactory.EXPECT().GetUserRepository()
 
 	defer func() {
@@ -35,7 +35,7 @@ func (s *FactorySuite) TestGetUserUsecaseFirstCall() {
 	factory.GetUserUsecase()
 }
 func (s *FactorySuite) TestGetUserUsecaseSecondCall() {
-	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"})
+	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"}, nil)
 	factory.userUsecase = s.MockUserUsecase
 
 	defer func() {
@@ -46,7 +46,7 @@ func (s *FactorySuite) TestGetUserUsecaseSecondCall() {
 	factory.GetUserUsecase()
 }
 func (s *FactorySuite) TestGetCreatorUsecaseFirstCall() {
-	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"})
+	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"}, nil)
 	s.mockRepositoryFactory.EXPECT().GetCreatorRepository()
 
 	defer func() {
@@ -57,7 +57,7 @@ func (s *FactorySuite) TestGetCreatorUsecaseFirstCall() {
 	factory.GetCreatorUsecase()
 }
 func (s *FactorySuite) TestGetCreatorUsecaseSecondCall() {
-	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"})
+	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"}, nil)
 	factory.creatorUsecase = s.MockCreatorUsecase
 
 	defer func() {
@@ -68,7 +68,7 @@ func (s *FactorySuite) TestGetCreatorUsecaseSecondCall() {
 	factory.GetCreatorUsecase()
 }
 func (s *FactorySuite) TestGetCsrfrUsecaseFirstCall() {
-	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"})
+	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"}, nil)
 	s.mockRepositoryFactory.EXPECT().GetCsrfRepository()
 
 	defer func() {
@@ -79,7 +79,7 @@ func (s *FactorySuite) TestGetCsrfrUsecaseFirstCall() {
 	factory.GetCsrfUsecase()
 }
 func (s *FactorySuite) TestGetCsrfUsecaseSecondCall() {
-	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"})
+	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"}, nil)
 	factory.csrfUsecase = s.MockCsrfUsecase
 
 	defer func() {
@@ -90,7 +90,7 @@ func (s *FactorySuite) TestGetCsrfUsecaseSecondCall() {
 	factory.GetCsrfUsecase()
 }
 func (s *FactorySuite) TestGetAccessUsecaseFirstCall() {
-	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"})
+	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"}, nil)
 
 	s.mockRepositoryFactory.EXPECT().GetAccessRepository()
 
@@ -102,7 +102,7 @@ func (s *FactorySuite) TestGetAccessUsecaseFirstCall() {
 	factory.GetAccessUsecase()
 }
 func (s *FactorySuite) TestGetAccessUsecaseSecondCall() {
-	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"})
+	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"}, nil)
 
 	factory.accessUsecase = s.MockAccessUsecase
 
@@ -114,10 +114,14 @@ func (s *FactorySuite) TestGetAccessUsecaseSecondCall() {
 	factory.GetAccessUsecase()
 }
 func (s *FactorySuite) TestGetSubscribersUsecaseFirstCall() {
-	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"})
+	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"}, nil)
 
 	s.mockRepositoryFactory.EXPECT().GetSubscribersRepository()
 	s.mockRepositoryFactory.EXPECT().GetAwardsRepository()
+	s.mockRepositoryFactory.EXPECT().GetPayTokenRepository()
+	s.mockRepositoryFactory.EXPECT().GetSubscriptionEventsRepository()
+	s.mockRepositoryFactory.EXPECT().GetBlockedUsersRepository()
+	s.mockRepositoryFactory.EXPECT().GetPusher()
 
 	defer func() {
 		if r := recover(); r != nil {
@@ -128,7 +132,7 @@ func (s *FactorySuite) TestGetSubscribersUsecaseFirstCall() {
 }
 
 func (s *FactorySuite) TestGetSubscribersUsecaseSecondCall() {
-	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"})
+	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"}, nil)
 
 	factory.subscribersUsecase = s.MockSubscribersUsecase
 
@@ -141,7 +145,7 @@ func (s *FactorySuite) TestGetSubscribersUsecaseSecondCall() {
 }
 
 func (s *FactorySuite) TestGetAwardsUsecaseFirstCall() {
-	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"})
+	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"}, nil)
 
 	factory.awardsUsecase = nil
 	s.mockRepositoryFactory.EXPECT().GetAwardsRepository()
@@ -155,7 +159,7 @@ func (s *FactorySuite) TestGetAwardsUsecaseFirstCall() {
 }
 
 func (s *FactorySuite) TestGetAwardsUsecaseSecondCall() {
-	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"})
+	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"}, nil)
 	factory.awardsUsecase = s.MockAwardsUsecase
 
 	defer func() {
@@ -167,10 +171,12 @@ func (s *FactorySuite) TestGetAwardsUsecaseSecondCall() {
 }
 
 func (s *FactorySuite) TestGetPostsUsecaseFirstCall() {
-	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"})
+	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"}, nil)
 
 	s.mockRepositoryFactory.EXPECT().GetPostsRepository()
 	s.mockRepositoryFactory.EXPECT().GetAttachesRepository()
+	s.mockRepositoryFactory.EXPECT().GetPostRevisionsRepository()
+	s.mockRepositoryFactory.EXPECT().GetTagsRepository()
 	s.mockRepositoryFactory.EXPECT().GetPusher()
 
 	defer func() {
@@ -182,7 +188,7 @@ func (s *FactorySuite) TestGetPostsUsecaseFirstCall() {
 }
 
 func (s *FactorySuite) TestGetPostsUsecaseSecondCall() {
-	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"})
+	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"}, nil)
 	factory.postsUsecase = s.MockPostsUsecase
 
 	defer func() {
@@ -193,7 +199,7 @@ func (s *FactorySuite) TestGetPostsUsecaseSecondCall() {
 	factory.GetPostsUsecase()
 }
 func (s *FactorySuite) TestGetLikesUsecaseFirstCall() {
-	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"})
+	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"}, nil)
 	s.mockRepositoryFactory.EXPECT().GetLikesRepository()
 
 	defer func() {
@@ -205,7 +211,7 @@ func (s *FactorySuite) TestGetLikesUsecaseFirstCall() {
 }
 
 func (s *FactorySuite) TestGetLikesUsecaseSecondCall() {
-	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"})
+	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"}, nil)
 	factory.likesUsecase = s.MockLikeUsecase
 
 	defer func() {
@@ -216,8 +222,9 @@ func (s *FactorySuite) TestGetLikesUsecaseSecondCall() {
 	factory.GetLikesUsecase()
 }
 func (s *FactorySuite) TestGetAttachesUsecaseFirstCall() {
-	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"})
+	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"}, nil)
 	s.mockRepositoryFactory.EXPECT().GetAttachesRepository()
+	s.mockRepositoryFactory.EXPECT().GetPostRevisionsRepository()
 
 	defer func() {
 		if r := recover(); r != nil {
@@ -228,7 +235,7 @@ func (s *FactorySuite) TestGetAttachesUsecaseFirstCall() {
 }
 
 func (s *FactorySuite) TestGetInfoUsecaseFirstCall() {
-	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"})
+	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"}, nil)
 	s.mockRepositoryFactory.EXPECT().GetInfoRepository()
 
 	defer func() {
@@ -240,7 +247,7 @@ func (s *FactorySuite) TestGetInfoUsecaseFirstCall() {
 }
 
 func (s *FactorySuite) TestGetInfoUsecaseSecondCall() {
-	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"})
+	factory := NewUsecaseFactory(s.mockRepositoryFactory, s.fileConn, app.Payments{AccountNumber: "dorre"}, nil)
 	factory.infoUsecase = s.MockInfoUsecase
 
 	defer func() {
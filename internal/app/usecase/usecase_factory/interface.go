@@ -5,15 +5,25 @@ import (
 	repAccess "patreon/internal/app/repository/access"
 	repoAttaches "patreon/internal/app/repository/attaches"
 	repoAwrds "patreon/internal/app/repository/awards"
+	repoBlocked "patreon/internal/app/repository/blocked_users"
 	repoComments "patreon/internal/app/repository/comments"
 	repCreator "patreon/internal/app/repository/creator"
+	repoGifts "patreon/internal/app/repository/gifts"
 	repoInfo "patreon/internal/app/repository/info"
+	repoLedger "patreon/internal/app/repository/ledger"
 	repoLikes "patreon/internal/app/repository/likes"
 	repoPayToken "patreon/internal/app/repository/pay_token"
 	repoPayments "patreon/internal/app/repository/payments"
+	repoPolls "patreon/internal/app/repository/polls"
+	repoPostRevisions "patreon/internal/app/repository/post_revisions"
+	repoPostUnlocks "patreon/internal/app/repository/post_unlocks"
 	repoPosts "patreon/internal/app/repository/posts"
+	repoPromoCodes "patreon/internal/app/repository/promo_codes"
 	repoStats "patreon/internal/app/repository/statistics"
 	useSubscr "patreon/internal/app/repository/subscribers"
+	repoSubscrEvents "patreon/internal/app/repository/subscription_events"
+	repoTags "patreon/internal/app/repository/tags"
+	repoTips "patreon/internal/app/repository/tips"
 	repUser "patreon/internal/app/repository/user"
 	push_client "patreon/internal/microservices/push/delivery/client"
 )
@@ -35,5 +45,15 @@ type RepositoryFactory interface {
 	GetCommentsRepository() repoComments.Repository
 	GetStatsRepository() repoStats.Repository
 	GetPayTokenRepository() repoPayToken.Repository
+	GetLedgerRepository() repoLedger.Repository
+	GetPromoCodesRepository() repoPromoCodes.Repository
+	GetGiftsRepository() repoGifts.Repository
+	GetTipsRepository() repoTips.Repository
+	GetPostUnlocksRepository() repoPostUnlocks.Repository
+	GetSubscriptionEventsRepository() repoSubscrEvents.Repository
+	GetBlockedUsersRepository() repoBlocked.Repository
+	GetPostRevisionsRepository() repoPostRevisions.Repository
+	GetTagsRepository() repoTags.Repository
+	GetPollsRepository() repoPolls.Repository
 	GetPusher() push_client.Pusher
 }
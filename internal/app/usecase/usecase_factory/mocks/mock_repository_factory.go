@@ -9,15 +9,25 @@ import (
 	repository_access "patreon/internal/app/repository/access"
 	repository_attaches "patreon/internal/app/repository/attaches"
 	repository_awards "patreon/internal/app/repository/awards"
+	repository_blocked_users "patreon/internal/app/repository/blocked_users"
 	repository_comments "patreon/internal/app/repository/comments"
 	repository_creator "patreon/internal/app/repository/creator"
+	repository_gifts "patreon/internal/app/repository/gifts"
 	repository_info "patreon/internal/app/repository/info"
+	repository_ledger "patreon/internal/app/repository/ledger"
 	repository_likes "patreon/internal/app/repository/likes"
 	repository_pay_token "patreon/internal/app/repository/pay_token"
 	repository_payments "patreon/internal/app/repository/payments"
+	repository_polls "patreon/internal/app/repository/polls"
+	repository_post_revisions "patreon/internal/app/repository/post_revisions"
+	repository_post_unlocks "patreon/internal/app/repository/post_unlocks"
 	repository_posts "patreon/internal/app/repository/posts"
+	repository_promo_codes "patreon/internal/app/repository/promo_codes"
 	repository_statistics "patreon/internal/app/repository/statistics"
 	repository_subscribers "patreon/internal/app/repository/subscribers"
+	repository_subscription_events "patreon/internal/app/repository/subscription_events"
+	repository_tags "patreon/internal/app/repository/tags"
+	repository_tips "patreon/internal/app/repository/tips"
 	repository_user "patreon/internal/app/repository/user"
 	push_client "patreon/internal/microservices/push/delivery/client"
 	reflect "reflect"
@@ -90,6 +100,20 @@ func (mr *MockRepositoryFactoryMockRecorder) GetAwardsRepository() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAwardsRepository", reflect.TypeOf((*MockRepositoryFactory)(nil).GetAwardsRepository))
 }
 
+// GetBlockedUsersRepository mocks base method.
+func (m *MockRepositoryFactory) GetBlockedUsersRepository() repository_blocked_users.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedUsersRepository")
+	ret0, _ := ret[0].(repository_blocked_users.Repository)
+	return ret0
+}
+
+// GetBlockedUsersRepository indicates an expected call of GetBlockedUsersRepository.
+func (mr *MockRepositoryFactoryMockRecorder) GetBlockedUsersRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedUsersRepository", reflect.TypeOf((*MockRepositoryFactory)(nil).GetBlockedUsersRepository))
+}
+
 // GetCommentsRepository mocks base method.
 func (m *MockRepositoryFactory) GetCommentsRepository() repository_comments.Repository {
 	m.ctrl.T.Helper()
@@ -132,6 +156,20 @@ func (mr *MockRepositoryFactoryMockRecorder) GetCsrfRepository() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCsrfRepository", reflect.TypeOf((*MockRepositoryFactory)(nil).GetCsrfRepository))
 }
 
+// GetGiftsRepository mocks base method.
+func (m *MockRepositoryFactory) GetGiftsRepository() repository_gifts.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGiftsRepository")
+	ret0, _ := ret[0].(repository_gifts.Repository)
+	return ret0
+}
+
+// GetGiftsRepository indicates an expected call of GetGiftsRepository.
+func (mr *MockRepositoryFactoryMockRecorder) GetGiftsRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGiftsRepository", reflect.TypeOf((*MockRepositoryFactory)(nil).GetGiftsRepository))
+}
+
 // GetInfoRepository mocks base method.
 func (m *MockRepositoryFactory) GetInfoRepository() repository_info.Repository {
 	m.ctrl.T.Helper()
@@ -146,6 +184,20 @@ func (mr *MockRepositoryFactoryMockRecorder) GetInfoRepository() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInfoRepository", reflect.TypeOf((*MockRepositoryFactory)(nil).GetInfoRepository))
 }
 
+// GetLedgerRepository mocks base method.
+func (m *MockRepositoryFactory) GetLedgerRepository() repository_ledger.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLedgerRepository")
+	ret0, _ := ret[0].(repository_ledger.Repository)
+	return ret0
+}
+
+// GetLedgerRepository indicates an expected call of GetLedgerRepository.
+func (mr *MockRepositoryFactoryMockRecorder) GetLedgerRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLedgerRepository", reflect.TypeOf((*MockRepositoryFactory)(nil).GetLedgerRepository))
+}
+
 // GetLikesRepository mocks base method.
 func (m *MockRepositoryFactory) GetLikesRepository() repository_likes.Repository {
 	m.ctrl.T.Helper()
@@ -188,6 +240,48 @@ func (mr *MockRepositoryFactoryMockRecorder) GetPaymentsRepository() *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentsRepository", reflect.TypeOf((*MockRepositoryFactory)(nil).GetPaymentsRepository))
 }
 
+// GetPollsRepository mocks base method.
+func (m *MockRepositoryFactory) GetPollsRepository() repository_polls.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPollsRepository")
+	ret0, _ := ret[0].(repository_polls.Repository)
+	return ret0
+}
+
+// GetPollsRepository indicates an expected call of GetPollsRepository.
+func (mr *MockRepositoryFactoryMockRecorder) GetPollsRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPollsRepository", reflect.TypeOf((*MockRepositoryFactory)(nil).GetPollsRepository))
+}
+
+// GetPostRevisionsRepository mocks base method.
+func (m *MockRepositoryFactory) GetPostRevisionsRepository() repository_post_revisions.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostRevisionsRepository")
+	ret0, _ := ret[0].(repository_post_revisions.Repository)
+	return ret0
+}
+
+// GetPostRevisionsRepository indicates an expected call of GetPostRevisionsRepository.
+func (mr *MockRepositoryFactoryMockRecorder) GetPostRevisionsRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostRevisionsRepository", reflect.TypeOf((*MockRepositoryFactory)(nil).GetPostRevisionsRepository))
+}
+
+// GetPostUnlocksRepository mocks base method.
+func (m *MockRepositoryFactory) GetPostUnlocksRepository() repository_post_unlocks.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostUnlocksRepository")
+	ret0, _ := ret[0].(repository_post_unlocks.Repository)
+	return ret0
+}
+
+// GetPostUnlocksRepository indicates an expected call of GetPostUnlocksRepository.
+func (mr *MockRepositoryFactoryMockRecorder) GetPostUnlocksRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostUnlocksRepository", reflect.TypeOf((*MockRepositoryFactory)(nil).GetPostUnlocksRepository))
+}
+
 // GetPostsRepository mocks base method.
 func (m *MockRepositoryFactory) GetPostsRepository() repository_posts.Repository {
 	m.ctrl.T.Helper()
@@ -202,6 +296,20 @@ func (mr *MockRepositoryFactoryMockRecorder) GetPostsRepository() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostsRepository", reflect.TypeOf((*MockRepositoryFactory)(nil).GetPostsRepository))
 }
 
+// GetPromoCodesRepository mocks base method.
+func (m *MockRepositoryFactory) GetPromoCodesRepository() repository_promo_codes.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromoCodesRepository")
+	ret0, _ := ret[0].(repository_promo_codes.Repository)
+	return ret0
+}
+
+// GetPromoCodesRepository indicates an expected call of GetPromoCodesRepository.
+func (mr *MockRepositoryFactoryMockRecorder) GetPromoCodesRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromoCodesRepository", reflect.TypeOf((*MockRepositoryFactory)(nil).GetPromoCodesRepository))
+}
+
 // GetPusher mocks base method.
 func (m *MockRepositoryFactory) GetPusher() push_client.Pusher {
 	m.ctrl.T.Helper()
@@ -244,6 +352,48 @@ func (mr *MockRepositoryFactoryMockRecorder) GetSubscribersRepository() *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscribersRepository", reflect.TypeOf((*MockRepositoryFactory)(nil).GetSubscribersRepository))
 }
 
+// GetSubscriptionEventsRepository mocks base method.
+func (m *MockRepositoryFactory) GetSubscriptionEventsRepository() repository_subscription_events.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriptionEventsRepository")
+	ret0, _ := ret[0].(repository_subscription_events.Repository)
+	return ret0
+}
+
+// GetSubscriptionEventsRepository indicates an expected call of GetSubscriptionEventsRepository.
+func (mr *MockRepositoryFactoryMockRecorder) GetSubscriptionEventsRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionEventsRepository", reflect.TypeOf((*MockRepositoryFactory)(nil).GetSubscriptionEventsRepository))
+}
+
+// GetTagsRepository mocks base method.
+func (m *MockRepositoryFactory) GetTagsRepository() repository_tags.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagsRepository")
+	ret0, _ := ret[0].(repository_tags.Repository)
+	return ret0
+}
+
+// GetTagsRepository indicates an expected call of GetTagsRepository.
+func (mr *MockRepositoryFactoryMockRecorder) GetTagsRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagsRepository", reflect.TypeOf((*MockRepositoryFactory)(nil).GetTagsRepository))
+}
+
+// GetTipsRepository mocks base method.
+func (m *MockRepositoryFactory) GetTipsRepository() repository_tips.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTipsRepository")
+	ret0, _ := ret[0].(repository_tips.Repository)
+	return ret0
+}
+
+// GetTipsRepository indicates an expected call of GetTipsRepository.
+func (mr *MockRepositoryFactoryMockRecorder) GetTipsRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTipsRepository", reflect.TypeOf((*MockRepositoryFactory)(nil).GetTipsRepository))
+}
+
 // GetUserRepository mocks base method.
 func (m *MockRepositoryFactory) GetUserRepository() repository_user.Repository {
 	m.ctrl.T.Helper()
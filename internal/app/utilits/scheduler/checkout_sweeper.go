@@ -0,0 +1,129 @@
+package scheduler
+
+import (
+	"patreon/internal/app/usecase/payments"
+	"patreon/pkg/utils"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	DefaultSweepInterval = 30 * time.Minute
+	// DefaultCheckoutTTL is lifetime of pay token, checkout can not be paid after it
+	DefaultCheckoutTTL = 3 * time.Hour
+)
+
+// SweeperMetrics counters of checkout sweeper, they are labeled by dry_run,
+// because in dry run they count what would be expired
+type SweeperMetrics struct {
+	ExpiredCheckouts     *prometheus.CounterVec
+	RemovedSubscriptions *prometheus.CounterVec
+	Errors               prometheus.Counter
+}
+
+func NewSweeperMetrics(serviceName string) *SweeperMetrics {
+	return &SweeperMetrics{
+		ExpiredCheckouts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: serviceName + "_sweeper_expired_checkouts",
+			Help: "Count not paid checkouts moved to expired state",
+		}, []string{"dry_run"}),
+		RemovedSubscriptions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: serviceName + "_sweeper_removed_subscriptions",
+			Help: "Count not paid subscriptions removed with expired checkouts",
+		}, []string{"dry_run"}),
+		Errors: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: serviceName + "_sweeper_errors",
+			Help: "Count failed sweeps",
+		}),
+	}
+}
+
+func (sm *SweeperMetrics) SetupMonitoring() error {
+	if err := prometheus.Register(sm.ExpiredCheckouts); err != nil {
+		return err
+	}
+	if err := prometheus.Register(sm.RemovedSubscriptions); err != nil {
+		return err
+	}
+	if err := prometheus.Register(sm.Errors); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CheckoutSweeper periodically expires checkouts which were not paid while pay token lived
+// and removes not paid subscriptions created with them, so user can subscribe again.
+// In dry run it only logs and counts what would be expired
+type CheckoutSweeper struct {
+	logger   *logrus.Entry
+	usecase  payments.Usecase
+	metrics  *SweeperMetrics
+	clock    utils.Clock
+	interval time.Duration
+	ttl      time.Duration
+	dryRun   bool
+	stop     chan bool
+}
+
+func NewCheckoutSweeper(logger *logrus.Entry, usecase payments.Usecase, metrics *SweeperMetrics, clock utils.Clock,
+	interval time.Duration, ttl time.Duration, dryRun bool) *CheckoutSweeper {
+	if interval <= 0 {
+		interval = DefaultSweepInterval
+	}
+	if ttl <= 0 {
+		ttl = DefaultCheckoutTTL
+	}
+	return &CheckoutSweeper{
+		logger:   logger,
+		usecase:  usecase,
+		metrics:  metrics,
+		clock:    clock,
+		interval: interval,
+		ttl:      ttl,
+		dryRun:   dryRun,
+		stop:     make(chan bool),
+	}
+}
+
+func (cs *CheckoutSweeper) Stop() {
+	cs.stop <- true
+}
+
+func (cs *CheckoutSweeper) Run() {
+	ticker := time.NewTicker(cs.interval)
+	defer ticker.Stop()
+
+	cs.process()
+	for {
+		select {
+		case <-cs.stop:
+			return
+		case <-ticker.C:
+			cs.process()
+		}
+	}
+}
+
+func (cs *CheckoutSweeper) process() {
+	res, err := cs.usecase.ExpireCheckouts(cs.logger, cs.clock.Now().Add(-cs.ttl), cs.dryRun)
+	if res != nil {
+		label := strconv.FormatBool(cs.dryRun)
+		cs.metrics.ExpiredCheckouts.WithLabelValues(label).Add(float64(res.Expired))
+		cs.metrics.RemovedSubscriptions.WithLabelValues(label).Add(float64(res.RemovedSubscriptions))
+
+		if cs.dryRun && res.Expired != 0 {
+			cs.logger.Infof("dry run: would be expired %d checkouts and removed %d subscriptions",
+				res.Expired, res.RemovedSubscriptions)
+		} else if res.Expired != 0 {
+			cs.logger.Infof("was expired %d checkouts and removed %d subscriptions",
+				res.Expired, res.RemovedSubscriptions)
+		}
+	}
+	if err != nil {
+		cs.metrics.Errors.Inc()
+		cs.logger.Errorf("error expire checkouts with err: %s", err)
+	}
+}
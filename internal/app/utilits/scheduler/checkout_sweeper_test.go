@@ -0,0 +1,122 @@
+package scheduler
+
+import (
+	"io"
+	"patreon/internal/app/models"
+	"patreon/internal/app/repository"
+	"patreon/internal/app/usecase"
+	mock_usecase "patreon/internal/app/usecase/payments/mocks"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// runStopped run scheduler in background and check that it returns after stop
+func runStopped(t *testing.T, run func(), stop func()) {
+	done := make(chan bool)
+	go func() {
+		run()
+		done <- true
+	}()
+	stop()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Error("scheduler was not stopped")
+	}
+}
+
+type SuiteCheckoutSweeper struct {
+	suite.Suite
+	mock    *gomock.Controller
+	usecase *mock_usecase.PaymentsUsecase
+	metrics *SweeperMetrics
+	clock   *usecase.FakeClock
+	logger  *logrus.Entry
+}
+
+func (s *SuiteCheckoutSweeper) SetupTest() {
+	s.mock = gomock.NewController(s.T())
+	s.usecase = mock_usecase.NewPaymentsUsecase(s.mock)
+	s.metrics = NewSweeperMetrics("test")
+	s.clock = &usecase.FakeClock{Time: time.Date(2021, 12, 1, 12, 0, 0, 0, time.UTC)}
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	s.logger = logrus.NewEntry(logger)
+}
+
+func (s *SuiteCheckoutSweeper) TearDownTest() {
+	s.mock.Finish()
+}
+
+func (s *SuiteCheckoutSweeper) TestProcess() {
+	sweeper := NewCheckoutSweeper(s.logger, s.usecase, s.metrics, s.clock, time.Hour, time.Hour, false)
+	s.usecase.EXPECT().
+		ExpireCheckouts(s.logger, s.clock.Time.Add(-time.Hour), false).
+		Times(1).
+		Return(&models.CheckoutsSweep{Expired: 3, RemovedSubscriptions: 2}, nil)
+	sweeper.process()
+
+	assert.Equal(s.T(), float64(3), testutil.ToFloat64(s.metrics.ExpiredCheckouts.WithLabelValues("false")))
+	assert.Equal(s.T(), float64(2), testutil.ToFloat64(s.metrics.RemovedSubscriptions.WithLabelValues("false")))
+	assert.Equal(s.T(), float64(0), testutil.ToFloat64(s.metrics.ExpiredCheckouts.WithLabelValues("true")))
+	assert.Equal(s.T(), float64(0), testutil.ToFloat64(s.metrics.Errors))
+}
+
+func (s *SuiteCheckoutSweeper) TestProcess_DryRun() {
+	sweeper := NewCheckoutSweeper(s.logger, s.usecase, s.metrics, s.clock, time.Hour, 0, true)
+	s.usecase.EXPECT().
+		ExpireCheckouts(s.logger, s.clock.Time.Add(-DefaultCheckoutTTL), true).
+		Times(1).
+		Return(&models.CheckoutsSweep{Expired: 4, RemovedSubscriptions: 1}, nil)
+	sweeper.process()
+
+	assert.Equal(s.T(), float64(4), testutil.ToFloat64(s.metrics.ExpiredCheckouts.WithLabelValues("true")))
+	assert.Equal(s.T(), float64(1), testutil.ToFloat64(s.metrics.RemovedSubscriptions.WithLabelValues("true")))
+	assert.Equal(s.T(), float64(0), testutil.ToFloat64(s.metrics.ExpiredCheckouts.WithLabelValues("false")))
+}
+
+func (s *SuiteCheckoutSweeper) TestProcess_PaidInProvider() {
+	sweeper := NewCheckoutSweeper(s.logger, s.usecase, s.metrics, s.clock, time.Hour, time.Hour, false)
+	// checkouts paid in provider are skipped by usecase, so nothing is expired
+	s.usecase.EXPECT().
+		ExpireCheckouts(s.logger, s.clock.Time.Add(-time.Hour), false).
+		Times(1).
+		Return(&models.CheckoutsSweep{}, nil)
+	sweeper.process()
+
+	assert.Equal(s.T(), float64(0), testutil.ToFloat64(s.metrics.ExpiredCheckouts.WithLabelValues("false")))
+	assert.Equal(s.T(), float64(0), testutil.ToFloat64(s.metrics.RemovedSubscriptions.WithLabelValues("false")))
+	assert.Equal(s.T(), float64(0), testutil.ToFloat64(s.metrics.Errors))
+}
+
+func (s *SuiteCheckoutSweeper) TestProcess_Error() {
+	sweeper := NewCheckoutSweeper(s.logger, s.usecase, s.metrics, s.clock, time.Hour, time.Hour, false)
+	// checkouts expired before error are counted too
+	s.usecase.EXPECT().
+		ExpireCheckouts(s.logger, s.clock.Time.Add(-time.Hour), false).
+		Times(1).
+		Return(&models.CheckoutsSweep{Expired: 1}, repository.NewDBError(repository.DefaultErrDB))
+	sweeper.process()
+
+	assert.Equal(s.T(), float64(1), testutil.ToFloat64(s.metrics.ExpiredCheckouts.WithLabelValues("false")))
+	assert.Equal(s.T(), float64(1), testutil.ToFloat64(s.metrics.Errors))
+}
+
+func (s *SuiteCheckoutSweeper) TestRunStop() {
+	sweeper := NewCheckoutSweeper(s.logger, s.usecase, s.metrics, s.clock, time.Hour, time.Hour, false)
+	s.usecase.EXPECT().
+		ExpireCheckouts(s.logger, s.clock.Time.Add(-time.Hour), false).
+		Times(1).
+		Return(&models.CheckoutsSweep{}, nil)
+	runStopped(s.T(), sweeper.Run, sweeper.Stop)
+}
+
+func TestCheckoutSweeper(t *testing.T) {
+	suite.Run(t, new(SuiteCheckoutSweeper))
+}
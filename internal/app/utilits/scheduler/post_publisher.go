@@ -0,0 +1,59 @@
+package scheduler
+
+import (
+	"patreon/internal/app/usecase/posts"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const DefaultPublishInterval = time.Minute
+
+// PostPublisher periodically publishes scheduled posts which time has come and pushes about them.
+// It is safe to run it on several instances: every post is published and pushed only once
+type PostPublisher struct {
+	logger   *logrus.Entry
+	usecase  posts.Usecase
+	interval time.Duration
+	stop     chan bool
+}
+
+func NewPostPublisher(logger *logrus.Entry, usecase posts.Usecase, interval time.Duration) *PostPublisher {
+	if interval <= 0 {
+		interval = DefaultPublishInterval
+	}
+	return &PostPublisher{
+		logger:   logger,
+		usecase:  usecase,
+		interval: interval,
+		stop:     make(chan bool),
+	}
+}
+
+func (pp *PostPublisher) Stop() {
+	pp.stop <- true
+}
+
+func (pp *PostPublisher) Run() {
+	ticker := time.NewTicker(pp.interval)
+	defer ticker.Stop()
+
+	pp.process()
+	for {
+		select {
+		case <-pp.stop:
+			return
+		case <-ticker.C:
+			pp.process()
+		}
+	}
+}
+
+func (pp *PostPublisher) process() {
+	published, err := pp.usecase.PublishScheduled(pp.logger)
+	if err != nil {
+		pp.logger.Errorf("error publish scheduled posts with err: %s", err)
+	} else if published != 0 {
+		pp.logger.Infof("was published %d scheduled posts", published)
+	}
+}
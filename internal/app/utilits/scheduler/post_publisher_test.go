@@ -0,0 +1,60 @@
+package scheduler
+
+import (
+	"io"
+	"patreon/internal/app/repository"
+	mock_usecase "patreon/internal/app/usecase/posts/mocks"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/suite"
+)
+
+type SuitePostPublisher struct {
+	suite.Suite
+	mock    *gomock.Controller
+	usecase *mock_usecase.PostsUsecase
+	logger  *logrus.Entry
+}
+
+func (s *SuitePostPublisher) SetupTest() {
+	s.mock = gomock.NewController(s.T())
+	s.usecase = mock_usecase.NewPostsUsecase(s.mock)
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	s.logger = logrus.NewEntry(logger)
+}
+
+func (s *SuitePostPublisher) TearDownTest() {
+	s.mock.Finish()
+}
+
+func (s *SuitePostPublisher) TestProcess() {
+	publisher := NewPostPublisher(s.logger, s.usecase, time.Hour)
+	s.usecase.EXPECT().
+		PublishScheduled(s.logger).
+		Times(1).
+		Return(2, nil)
+	publisher.process()
+
+	s.usecase.EXPECT().
+		PublishScheduled(s.logger).
+		Times(1).
+		Return(0, repository.NewDBError(repository.DefaultErrDB))
+	publisher.process()
+}
+
+func (s *SuitePostPublisher) TestRunStop() {
+	publisher := NewPostPublisher(s.logger, s.usecase, 0)
+	s.usecase.EXPECT().
+		PublishScheduled(s.logger).
+		Times(1).
+		Return(0, nil)
+	runStopped(s.T(), publisher.Run, publisher.Stop)
+}
+
+func TestPostPublisher(t *testing.T) {
+	suite.Run(t, new(SuitePostPublisher))
+}
@@ -0,0 +1,73 @@
+package scheduler
+
+import (
+	usecase_billing "patreon/internal/app/usecase/billing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const DefaultCheckInterval = 10 * time.Minute
+
+// RenewalScheduler periodically issues renewals, applies scheduled downgrades and expires unpaid subscriptions.
+// It is safe to run it on several instances: renewal for one period is created only once
+type RenewalScheduler struct {
+	logger   *logrus.Entry
+	usecase  usecase_billing.Usecase
+	interval time.Duration
+	stop     chan bool
+}
+
+func NewRenewalScheduler(logger *logrus.Entry, usecase usecase_billing.Usecase, interval time.Duration) *RenewalScheduler {
+	if interval <= 0 {
+		interval = DefaultCheckInterval
+	}
+	return &RenewalScheduler{
+		logger:   logger,
+		usecase:  usecase,
+		interval: interval,
+		stop:     make(chan bool),
+	}
+}
+
+func (rs *RenewalScheduler) Stop() {
+	rs.stop <- true
+}
+
+func (rs *RenewalScheduler) Run() {
+	ticker := time.NewTicker(rs.interval)
+	defer ticker.Stop()
+
+	rs.process()
+	for {
+		select {
+		case <-rs.stop:
+			return
+		case <-ticker.C:
+			rs.process()
+		}
+	}
+}
+
+func (rs *RenewalScheduler) process() {
+	issued, err := rs.usecase.IssueRenewals(rs.logger)
+	if err != nil {
+		rs.logger.Errorf("error issue renewals with err: %s", err)
+	} else if issued != 0 {
+		rs.logger.Infof("was issued %d renewals", issued)
+	}
+
+	changed, err := rs.usecase.ApplyDowngrades()
+	if err != nil {
+		rs.logger.Errorf("error apply downgrades with err: %s", err)
+	} else if changed != 0 {
+		rs.logger.Infof("was downgraded %d subscriptions", changed)
+	}
+
+	expired, err := rs.usecase.ExpireSubscriptions(rs.logger)
+	if err != nil {
+		rs.logger.Errorf("error expire subscriptions with err: %s", err)
+	} else if expired != 0 {
+		rs.logger.Infof("was expired %d subscriptions", expired)
+	}
+}
@@ -0,0 +1,80 @@
+package scheduler
+
+import (
+	"io"
+	"patreon/internal/app/repository"
+	mock_usecase "patreon/internal/app/usecase/billing/mocks"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/suite"
+)
+
+type SuiteRenewalScheduler struct {
+	suite.Suite
+	mock    *gomock.Controller
+	usecase *mock_usecase.BillingUsecase
+	logger  *logrus.Entry
+}
+
+func (s *SuiteRenewalScheduler) SetupTest() {
+	s.mock = gomock.NewController(s.T())
+	s.usecase = mock_usecase.NewBillingUsecase(s.mock)
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	s.logger = logrus.NewEntry(logger)
+}
+
+func (s *SuiteRenewalScheduler) TearDownTest() {
+	s.mock.Finish()
+}
+
+func (s *SuiteRenewalScheduler) TestProcess() {
+	scheduler := NewRenewalScheduler(s.logger, s.usecase, time.Hour)
+	gomock.InOrder(
+		s.usecase.EXPECT().
+			IssueRenewals(s.logger).
+			Times(1).
+			Return(2, nil),
+		s.usecase.EXPECT().
+			ApplyDowngrades().
+			Times(1).
+			Return(int64(1), nil),
+		s.usecase.EXPECT().
+			ExpireSubscriptions(s.logger).
+			Times(1).
+			Return(int64(3), nil),
+	)
+	scheduler.process()
+}
+
+func (s *SuiteRenewalScheduler) TestProcess_ErrorDoesNotStopOthers() {
+	scheduler := NewRenewalScheduler(s.logger, s.usecase, time.Hour)
+	s.usecase.EXPECT().
+		IssueRenewals(s.logger).
+		Times(1).
+		Return(0, repository.NewDBError(repository.DefaultErrDB))
+	s.usecase.EXPECT().
+		ApplyDowngrades().
+		Times(1).
+		Return(int64(0), repository.NewDBError(repository.DefaultErrDB))
+	s.usecase.EXPECT().
+		ExpireSubscriptions(s.logger).
+		Times(1).
+		Return(int64(0), nil)
+	scheduler.process()
+}
+
+func (s *SuiteRenewalScheduler) TestRunStop() {
+	scheduler := NewRenewalScheduler(s.logger, s.usecase, 0)
+	s.usecase.EXPECT().IssueRenewals(s.logger).Times(1).Return(0, nil)
+	s.usecase.EXPECT().ApplyDowngrades().Times(1).Return(int64(0), nil)
+	s.usecase.EXPECT().ExpireSubscriptions(s.logger).Times(1).Return(int64(0), nil)
+	runStopped(s.T(), scheduler.Run, scheduler.Stop)
+}
+
+func TestRenewalScheduler(t *testing.T) {
+	suite.Run(t, new(SuiteRenewalScheduler))
+}
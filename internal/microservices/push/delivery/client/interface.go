@@ -1,7 +1,15 @@
 package push_client
 
+import "time"
+
+//go:generate mockgen -destination=mocks/pusher_mock.go -package=mock_push_client . Pusher
+
 type Pusher interface {
 	NewPost(creatorId int64, postId int64, postTitle string) error
 	ApplyPayments(token string) error
 	NewComment(commentId int64, authorId int64, postId int64) error
+	RenewalDue(token string, paidUntil time.Time) error
+	GiftReceived(giftId int64) error
+	TipReceived(tipId int64) error
+	SeatFreed(awardsId int64) error
 }
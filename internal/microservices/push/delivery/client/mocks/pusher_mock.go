@@ -0,0 +1,133 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: patreon/internal/microservices/push/delivery/client (interfaces: Pusher)
+
+// Package mock_push_client is a generated GoMock package.
+package mock_push_client
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPusher is a mock of Pusher interface.
+type MockPusher struct {
+	ctrl     *gomock.Controller
+	recorder *MockPusherMockRecorder
+}
+
+// MockPusherMockRecorder is the mock recorder for MockPusher.
+type MockPusherMockRecorder struct {
+	mock *MockPusher
+}
+
+// NewMockPusher creates a new mock instance.
+func NewMockPusher(ctrl *gomock.Controller) *MockPusher {
+	mock := &MockPusher{ctrl: ctrl}
+	mock.recorder = &MockPusherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPusher) EXPECT() *MockPusherMockRecorder {
+	return m.recorder
+}
+
+// ApplyPayments mocks base method.
+func (m *MockPusher) ApplyPayments(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyPayments", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyPayments indicates an expected call of ApplyPayments.
+func (mr *MockPusherMockRecorder) ApplyPayments(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyPayments", reflect.TypeOf((*MockPusher)(nil).ApplyPayments), arg0)
+}
+
+// GiftReceived mocks base method.
+func (m *MockPusher) GiftReceived(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GiftReceived", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GiftReceived indicates an expected call of GiftReceived.
+func (mr *MockPusherMockRecorder) GiftReceived(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GiftReceived", reflect.TypeOf((*MockPusher)(nil).GiftReceived), arg0)
+}
+
+// NewComment mocks base method.
+func (m *MockPusher) NewComment(arg0, arg1, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewComment", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NewComment indicates an expected call of NewComment.
+func (mr *MockPusherMockRecorder) NewComment(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewComment", reflect.TypeOf((*MockPusher)(nil).NewComment), arg0, arg1, arg2)
+}
+
+// NewPost mocks base method.
+func (m *MockPusher) NewPost(arg0, arg1 int64, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewPost", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NewPost indicates an expected call of NewPost.
+func (mr *MockPusherMockRecorder) NewPost(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewPost", reflect.TypeOf((*MockPusher)(nil).NewPost), arg0, arg1, arg2)
+}
+
+// RenewalDue mocks base method.
+func (m *MockPusher) RenewalDue(arg0 string, arg1 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenewalDue", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenewalDue indicates an expected call of RenewalDue.
+func (mr *MockPusherMockRecorder) RenewalDue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewalDue", reflect.TypeOf((*MockPusher)(nil).RenewalDue), arg0, arg1)
+}
+
+// SeatFreed mocks base method.
+func (m *MockPusher) SeatFreed(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeatFreed", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeatFreed indicates an expected call of SeatFreed.
+func (mr *MockPusherMockRecorder) SeatFreed(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeatFreed", reflect.TypeOf((*MockPusher)(nil).SeatFreed), arg0)
+}
+
+// TipReceived mocks base method.
+func (m *MockPusher) TipReceived(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TipReceived", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TipReceived indicates an expected call of TipReceived.
+func (mr *MockPusherMockRecorder) TipReceived(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TipReceived", reflect.TypeOf((*MockPusher)(nil).TipReceived), arg0)
+}
@@ -64,4 +64,29 @@ func (ph *PushSender) ApplyPayments(token string) error {
 		Token: token,
 		Date:  time.Now(),
 	})
-}
\ No newline at end of file
+}
+func (ph *PushSender) RenewalDue(token string, paidUntil time.Time) error {
+	return ph.push(models.RenewalPush, &models.RenewalInfo{
+		Token:     token,
+		PaidUntil: paidUntil,
+		Date:      time.Now(),
+	})
+}
+func (ph *PushSender) GiftReceived(giftId int64) error {
+	return ph.push(models.GiftPush, &models.GiftInfo{
+		GiftId: giftId,
+		Date:   time.Now(),
+	})
+}
+func (ph *PushSender) TipReceived(tipId int64) error {
+	return ph.push(models.TipPush, &models.TipInfo{
+		TipId: tipId,
+		Date:  time.Now(),
+	})
+}
+func (ph *PushSender) SeatFreed(awardsId int64) error {
+	return ph.push(models.SeatPush, &models.SeatInfo{
+		AwardsId: awardsId,
+		Date:     time.Now(),
+	})
+}
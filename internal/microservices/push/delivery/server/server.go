@@ -108,6 +108,10 @@ func (s *Server) Start() error {
 	go processingPush.RunProcessPost()
 	go processingPush.RunProcessComment()
 	go processingPush.RunProcessPayment()
+	go processingPush.RunProcessRenewal()
+	go processingPush.RunProcessGift()
+	go processingPush.RunProcessTip()
+	go processingPush.RunProcessSeat()
 
 	h2 := NewPushesHandler(s.logger, sManager, pushUsecase)
 	h2.Connect(routerApi.Path("/user/pushes"))
@@ -8,6 +8,10 @@ const (
 	CommentPush = "Comment"
 	PaymentPush = "Payment"
 	PostPush    = "Post"
+	RenewalPush = "Renewal"
+	GiftPush    = "Gift"
+	TipPush     = "Tip"
+	SeatPush    = "Seat"
 )
 
 //easyjson:json
@@ -31,3 +35,28 @@ type PaymentApply struct {
 	Token string    `json:"token"`
 	Date  time.Time `json:"date"`
 }
+
+//easyjson:json
+type RenewalInfo struct {
+	Token     string    `json:"token"`
+	PaidUntil time.Time `json:"paid_until"`
+	Date      time.Time `json:"date"`
+}
+
+//easyjson:json
+type GiftInfo struct {
+	GiftId int64     `json:"gift_id"`
+	Date   time.Time `json:"date"`
+}
+
+//easyjson:json
+type TipInfo struct {
+	TipId int64     `json:"tip_id"`
+	Date  time.Time `json:"date"`
+}
+
+//easyjson:json
+type SeatInfo struct {
+	AwardsId int64     `json:"awards_id"`
+	Date     time.Time `json:"date"`
+}
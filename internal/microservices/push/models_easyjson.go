@@ -17,7 +17,253 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjsonD2b7633eDecodePatreonInternalMicroservicesPush(in *jlexer.Lexer, out *PostInfo) {
+func easyjsonD2b7633eDecodePatreonInternalMicroservicesPush(in *jlexer.Lexer, out *TipInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "tip_id":
+			out.TipId = int64(in.Int64())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodePatreonInternalMicroservicesPush(out *jwriter.Writer, in TipInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"tip_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.TipId))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TipInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodePatreonInternalMicroservicesPush(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TipInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodePatreonInternalMicroservicesPush(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TipInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodePatreonInternalMicroservicesPush(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TipInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodePatreonInternalMicroservicesPush(l, v)
+}
+func easyjsonD2b7633eDecodePatreonInternalMicroservicesPush1(in *jlexer.Lexer, out *SeatInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "awards_id":
+			out.AwardsId = int64(in.Int64())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodePatreonInternalMicroservicesPush1(out *jwriter.Writer, in SeatInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"awards_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.AwardsId))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SeatInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodePatreonInternalMicroservicesPush1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SeatInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodePatreonInternalMicroservicesPush1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SeatInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodePatreonInternalMicroservicesPush1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SeatInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodePatreonInternalMicroservicesPush1(l, v)
+}
+func easyjsonD2b7633eDecodePatreonInternalMicroservicesPush2(in *jlexer.Lexer, out *RenewalInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "token":
+			out.Token = string(in.String())
+		case "paid_until":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PaidUntil).UnmarshalJSON(data))
+			}
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodePatreonInternalMicroservicesPush2(out *jwriter.Writer, in RenewalInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Token))
+	}
+	{
+		const prefix string = ",\"paid_until\":"
+		out.RawString(prefix)
+		out.Raw((in.PaidUntil).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RenewalInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodePatreonInternalMicroservicesPush2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RenewalInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodePatreonInternalMicroservicesPush2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RenewalInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodePatreonInternalMicroservicesPush2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RenewalInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodePatreonInternalMicroservicesPush2(l, v)
+}
+func easyjsonD2b7633eDecodePatreonInternalMicroservicesPush3(in *jlexer.Lexer, out *PostInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -60,7 +306,7 @@ func easyjsonD2b7633eDecodePatreonInternalMicroservicesPush(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodePatreonInternalMicroservicesPush(out *jwriter.Writer, in PostInfo) {
+func easyjsonD2b7633eEncodePatreonInternalMicroservicesPush3(out *jwriter.Writer, in PostInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -90,27 +336,27 @@ func easyjsonD2b7633eEncodePatreonInternalMicroservicesPush(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v PostInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodePatreonInternalMicroservicesPush(&w, v)
+	easyjsonD2b7633eEncodePatreonInternalMicroservicesPush3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PostInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodePatreonInternalMicroservicesPush(w, v)
+	easyjsonD2b7633eEncodePatreonInternalMicroservicesPush3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PostInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodePatreonInternalMicroservicesPush(&r, v)
+	easyjsonD2b7633eDecodePatreonInternalMicroservicesPush3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PostInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodePatreonInternalMicroservicesPush(l, v)
+	easyjsonD2b7633eDecodePatreonInternalMicroservicesPush3(l, v)
 }
-func easyjsonD2b7633eDecodePatreonInternalMicroservicesPush1(in *jlexer.Lexer, out *PaymentApply) {
+func easyjsonD2b7633eDecodePatreonInternalMicroservicesPush4(in *jlexer.Lexer, out *PaymentApply) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -149,7 +395,7 @@ func easyjsonD2b7633eDecodePatreonInternalMicroservicesPush1(in *jlexer.Lexer, o
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodePatreonInternalMicroservicesPush1(out *jwriter.Writer, in PaymentApply) {
+func easyjsonD2b7633eEncodePatreonInternalMicroservicesPush4(out *jwriter.Writer, in PaymentApply) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -169,27 +415,106 @@ func easyjsonD2b7633eEncodePatreonInternalMicroservicesPush1(out *jwriter.Writer
 // MarshalJSON supports json.Marshaler interface
 func (v PaymentApply) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodePatreonInternalMicroservicesPush1(&w, v)
+	easyjsonD2b7633eEncodePatreonInternalMicroservicesPush4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PaymentApply) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodePatreonInternalMicroservicesPush1(w, v)
+	easyjsonD2b7633eEncodePatreonInternalMicroservicesPush4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PaymentApply) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodePatreonInternalMicroservicesPush1(&r, v)
+	easyjsonD2b7633eDecodePatreonInternalMicroservicesPush4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PaymentApply) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodePatreonInternalMicroservicesPush1(l, v)
+	easyjsonD2b7633eDecodePatreonInternalMicroservicesPush4(l, v)
 }
-func easyjsonD2b7633eDecodePatreonInternalMicroservicesPush2(in *jlexer.Lexer, out *CommentInfo) {
+func easyjsonD2b7633eDecodePatreonInternalMicroservicesPush5(in *jlexer.Lexer, out *GiftInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "gift_id":
+			out.GiftId = int64(in.Int64())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodePatreonInternalMicroservicesPush5(out *jwriter.Writer, in GiftInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"gift_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.GiftId))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GiftInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodePatreonInternalMicroservicesPush5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GiftInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodePatreonInternalMicroservicesPush5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GiftInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodePatreonInternalMicroservicesPush5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GiftInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodePatreonInternalMicroservicesPush5(l, v)
+}
+func easyjsonD2b7633eDecodePatreonInternalMicroservicesPush6(in *jlexer.Lexer, out *CommentInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -232,7 +557,7 @@ func easyjsonD2b7633eDecodePatreonInternalMicroservicesPush2(in *jlexer.Lexer, o
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodePatreonInternalMicroservicesPush2(out *jwriter.Writer, in CommentInfo) {
+func easyjsonD2b7633eEncodePatreonInternalMicroservicesPush6(out *jwriter.Writer, in CommentInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -262,23 +587,23 @@ func easyjsonD2b7633eEncodePatreonInternalMicroservicesPush2(out *jwriter.Writer
 // MarshalJSON supports json.Marshaler interface
 func (v CommentInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodePatreonInternalMicroservicesPush2(&w, v)
+	easyjsonD2b7633eEncodePatreonInternalMicroservicesPush6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CommentInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodePatreonInternalMicroservicesPush2(w, v)
+	easyjsonD2b7633eEncodePatreonInternalMicroservicesPush6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CommentInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodePatreonInternalMicroservicesPush2(&r, v)
+	easyjsonD2b7633eDecodePatreonInternalMicroservicesPush6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CommentInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodePatreonInternalMicroservicesPush2(l, v)
+	easyjsonD2b7633eDecodePatreonInternalMicroservicesPush6(l, v)
 }
@@ -1,5 +1,10 @@
 package push_models
 
+import (
+	"patreon/internal/app/models"
+	"time"
+)
+
 //go:generate easyjson -all -disallow_unknown_fields push_models.go
 
 //easyjson:json
@@ -30,3 +35,51 @@ type PaymentApplyPush struct {
 	AwardsId        int64  `json:"awards_id"`
 	AwardsName      string `json:"awards_name"`
 }
+
+//easyjson:json
+type RenewalPush struct {
+	CreatorId       int64     `json:"creator_id"`
+	CreatorNickname string    `json:"creator_nickname"`
+	CreatorAvatar   string    `json:"creator_avatar"`
+	AwardsId        int64     `json:"awards_id"`
+	AwardsName      string    `json:"awards_name"`
+	Token           string    `json:"token"`
+	PaidUntil       time.Time `json:"paid_until"`
+}
+
+//easyjson:json
+type GiftPush struct {
+	GiftId          int64  `json:"gift_id"`
+	PayerId         int64  `json:"payer_id"`
+	PayerNickname   string `json:"payer_nickname"`
+	PayerAvatar     string `json:"payer_avatar"`
+	CreatorId       int64  `json:"creator_id"`
+	CreatorNickname string `json:"creator_nickname"`
+	CreatorAvatar   string `json:"creator_avatar"`
+	AwardsId        int64  `json:"awards_id"`
+	AwardsName      string `json:"awards_name"`
+	Periods         int64  `json:"periods"`
+}
+
+//easyjson:json
+type TipPush struct {
+	TipId        int64          `json:"tip_id"`
+	UserId       int64          `json:"user_id"`
+	UserNickname string         `json:"user_nickname"`
+	UserAvatar   string         `json:"user_avatar"`
+	PostId       int64          `json:"post_id,omitempty"`
+	PostTitle    string         `json:"post_title,omitempty"`
+	Amount       models.Decimal `json:"amount"`
+	Currency     string         `json:"currency"`
+	Message      string         `json:"message"`
+}
+
+//easyjson:json
+type SeatPush struct {
+	CreatorId       int64  `json:"creator_id"`
+	CreatorNickname string `json:"creator_nickname"`
+	CreatorAvatar   string `json:"creator_avatar"`
+	AwardsId        int64  `json:"awards_id"`
+	AwardsName      string `json:"awards_name"`
+	SeatsLeft       int64  `json:"seats_left"`
+}
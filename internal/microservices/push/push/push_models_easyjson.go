@@ -17,7 +17,354 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush(in *jlexer.Lexer, out *PostPush) {
+func easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush(in *jlexer.Lexer, out *TipPush) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "tip_id":
+			out.TipId = int64(in.Int64())
+		case "user_id":
+			out.UserId = int64(in.Int64())
+		case "user_nickname":
+			out.UserNickname = string(in.String())
+		case "user_avatar":
+			out.UserAvatar = string(in.String())
+		case "post_id":
+			out.PostId = int64(in.Int64())
+		case "post_title":
+			out.PostTitle = string(in.String())
+		case "amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		case "currency":
+			out.Currency = string(in.String())
+		case "message":
+			out.Message = string(in.String())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush(out *jwriter.Writer, in TipPush) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"tip_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.TipId))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UserId))
+	}
+	{
+		const prefix string = ",\"user_nickname\":"
+		out.RawString(prefix)
+		out.String(string(in.UserNickname))
+	}
+	{
+		const prefix string = ",\"user_avatar\":"
+		out.RawString(prefix)
+		out.String(string(in.UserAvatar))
+	}
+	if in.PostId != 0 {
+		const prefix string = ",\"post_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PostId))
+	}
+	if in.PostTitle != "" {
+		const prefix string = ",\"post_title\":"
+		out.RawString(prefix)
+		out.String(string(in.PostTitle))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.Raw((in.Amount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TipPush) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TipPush) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TipPush) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TipPush) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush(l, v)
+}
+func easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush1(in *jlexer.Lexer, out *SeatPush) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "creator_id":
+			out.CreatorId = int64(in.Int64())
+		case "creator_nickname":
+			out.CreatorNickname = string(in.String())
+		case "creator_avatar":
+			out.CreatorAvatar = string(in.String())
+		case "awards_id":
+			out.AwardsId = int64(in.Int64())
+		case "awards_name":
+			out.AwardsName = string(in.String())
+		case "seats_left":
+			out.SeatsLeft = int64(in.Int64())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush1(out *jwriter.Writer, in SeatPush) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"creator_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.CreatorId))
+	}
+	{
+		const prefix string = ",\"creator_nickname\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatorNickname))
+	}
+	{
+		const prefix string = ",\"creator_avatar\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatorAvatar))
+	}
+	{
+		const prefix string = ",\"awards_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AwardsId))
+	}
+	{
+		const prefix string = ",\"awards_name\":"
+		out.RawString(prefix)
+		out.String(string(in.AwardsName))
+	}
+	{
+		const prefix string = ",\"seats_left\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.SeatsLeft))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SeatPush) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SeatPush) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SeatPush) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SeatPush) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush1(l, v)
+}
+func easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush2(in *jlexer.Lexer, out *RenewalPush) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "creator_id":
+			out.CreatorId = int64(in.Int64())
+		case "creator_nickname":
+			out.CreatorNickname = string(in.String())
+		case "creator_avatar":
+			out.CreatorAvatar = string(in.String())
+		case "awards_id":
+			out.AwardsId = int64(in.Int64())
+		case "awards_name":
+			out.AwardsName = string(in.String())
+		case "token":
+			out.Token = string(in.String())
+		case "paid_until":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PaidUntil).UnmarshalJSON(data))
+			}
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush2(out *jwriter.Writer, in RenewalPush) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"creator_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.CreatorId))
+	}
+	{
+		const prefix string = ",\"creator_nickname\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatorNickname))
+	}
+	{
+		const prefix string = ",\"creator_avatar\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatorAvatar))
+	}
+	{
+		const prefix string = ",\"awards_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AwardsId))
+	}
+	{
+		const prefix string = ",\"awards_name\":"
+		out.RawString(prefix)
+		out.String(string(in.AwardsName))
+	}
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix)
+		out.String(string(in.Token))
+	}
+	{
+		const prefix string = ",\"paid_until\":"
+		out.RawString(prefix)
+		out.Raw((in.PaidUntil).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RenewalPush) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RenewalPush) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RenewalPush) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RenewalPush) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush2(l, v)
+}
+func easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush3(in *jlexer.Lexer, out *PostPush) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -60,7 +407,7 @@ func easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush(out *jwriter.Writer, in PostPush) {
+func easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush3(out *jwriter.Writer, in PostPush) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -95,27 +442,27 @@ func easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v PostPush) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush(&w, v)
+	easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PostPush) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush(w, v)
+	easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PostPush) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush(&r, v)
+	easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PostPush) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush(l, v)
+	easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush3(l, v)
 }
-func easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush1(in *jlexer.Lexer, out *PaymentApplyPush) {
+func easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush4(in *jlexer.Lexer, out *PaymentApplyPush) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -158,7 +505,7 @@ func easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush1(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush1(out *jwriter.Writer, in PaymentApplyPush) {
+func easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush4(out *jwriter.Writer, in PaymentApplyPush) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -193,27 +540,160 @@ func easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush1(out *jwriter.Wr
 // MarshalJSON supports json.Marshaler interface
 func (v PaymentApplyPush) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush1(&w, v)
+	easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PaymentApplyPush) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush1(w, v)
+	easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PaymentApplyPush) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush1(&r, v)
+	easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PaymentApplyPush) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush1(l, v)
+	easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush4(l, v)
 }
-func easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush2(in *jlexer.Lexer, out *CommentPush) {
+func easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush5(in *jlexer.Lexer, out *GiftPush) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "gift_id":
+			out.GiftId = int64(in.Int64())
+		case "payer_id":
+			out.PayerId = int64(in.Int64())
+		case "payer_nickname":
+			out.PayerNickname = string(in.String())
+		case "payer_avatar":
+			out.PayerAvatar = string(in.String())
+		case "creator_id":
+			out.CreatorId = int64(in.Int64())
+		case "creator_nickname":
+			out.CreatorNickname = string(in.String())
+		case "creator_avatar":
+			out.CreatorAvatar = string(in.String())
+		case "awards_id":
+			out.AwardsId = int64(in.Int64())
+		case "awards_name":
+			out.AwardsName = string(in.String())
+		case "periods":
+			out.Periods = int64(in.Int64())
+		default:
+			in.AddError(&jlexer.LexerError{
+				Offset: in.GetPos(),
+				Reason: "unknown field",
+				Data:   key,
+			})
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush5(out *jwriter.Writer, in GiftPush) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"gift_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.GiftId))
+	}
+	{
+		const prefix string = ",\"payer_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PayerId))
+	}
+	{
+		const prefix string = ",\"payer_nickname\":"
+		out.RawString(prefix)
+		out.String(string(in.PayerNickname))
+	}
+	{
+		const prefix string = ",\"payer_avatar\":"
+		out.RawString(prefix)
+		out.String(string(in.PayerAvatar))
+	}
+	{
+		const prefix string = ",\"creator_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CreatorId))
+	}
+	{
+		const prefix string = ",\"creator_nickname\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatorNickname))
+	}
+	{
+		const prefix string = ",\"creator_avatar\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatorAvatar))
+	}
+	{
+		const prefix string = ",\"awards_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AwardsId))
+	}
+	{
+		const prefix string = ",\"awards_name\":"
+		out.RawString(prefix)
+		out.String(string(in.AwardsName))
+	}
+	{
+		const prefix string = ",\"periods\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Periods))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GiftPush) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GiftPush) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GiftPush) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GiftPush) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush5(l, v)
+}
+func easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush6(in *jlexer.Lexer, out *CommentPush) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -260,7 +740,7 @@ func easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush2(in *jlexer.Lexe
 		in.Consumed()
 	}
 }
-func easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush2(out *jwriter.Writer, in CommentPush) {
+func easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush6(out *jwriter.Writer, in CommentPush) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -305,23 +785,23 @@ func easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush2(out *jwriter.Wr
 // MarshalJSON supports json.Marshaler interface
 func (v CommentPush) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush2(&w, v)
+	easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CommentPush) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush2(w, v)
+	easyjson7aa4b9ffEncodePatreonInternalMicroservicesPushPush6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CommentPush) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush2(&r, v)
+	easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CommentPush) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush2(l, v)
+	easyjson7aa4b9ffDecodePatreonInternalMicroservicesPushPush6(l, v)
 }
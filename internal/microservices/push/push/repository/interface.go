@@ -2,6 +2,7 @@ package repository
 
 import (
 	"errors"
+	"patreon/internal/app/models"
 	"time"
 )
 
@@ -14,7 +15,6 @@ type PushJson struct {
 	Push interface{} `json:"push"`
 }
 
-
 type PaymentsInfo struct {
 	CreatorId  int64
 	UserId     int64
@@ -22,6 +22,31 @@ type PaymentsInfo struct {
 	AwardsName string
 }
 
+type GiftInfo struct {
+	PayerId     int64
+	RecipientId int64
+	CreatorId   int64
+	AwardsId    int64
+	AwardsName  string
+	Periods     int64
+}
+
+type TipInfo struct {
+	UserId    int64
+	CreatorId int64
+	PostId    int64
+	PostTitle string
+	Amount    models.Decimal
+	Currency  string
+	Message   string
+}
+
+type SeatInfo struct {
+	CreatorId  int64
+	AwardsName string
+	SeatsLeft  int64
+}
+
 type Push struct {
 	Id     int64
 	Type   string
@@ -73,6 +98,29 @@ type Repository interface {
 	// 			repository.DefaultErrDB
 	GetAwardsInfoAndCreatorIdAndUserIdFromPayments(token string) (*PaymentsInfo, error)
 
+	// GetGiftInfo Errors:
+	//		repository.NotFound
+	// 		app.GeneralError with Errors:
+	// 			repository.DefaultErrDB
+	GetGiftInfo(giftId int64) (*GiftInfo, error)
+
+	// GetTipInfo Errors:
+	//		repository.NotFound
+	// 		app.GeneralError with Errors:
+	// 			repository.DefaultErrDB
+	GetTipInfo(tipId int64) (*TipInfo, error)
+
+	// GetSeatInfo Errors:
+	//		repository.NotFound
+	// 		app.GeneralError with Errors:
+	// 			repository.DefaultErrDB
+	GetSeatInfo(awardsId int64) (*SeatInfo, error)
+
+	// GetAwardWaitlist Errors:
+	// 		app.GeneralError with Errors:
+	// 			repository.DefaultErrDB
+	GetAwardWaitlist(awardsId int64) ([]int64, error)
+
 	// CheckCreatorForGetCommentPush Errors:
 	//		repository.NotFound
 	// 		app.GeneralError with Errors:
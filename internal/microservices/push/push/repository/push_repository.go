@@ -27,15 +27,30 @@ const (
 					SELECT users_id FROM subscribers AS sb
 					JOIN posts AS ps ON (ps.creator_id = sb.creator_id AND ps.posts_id = $1)
 					JOIN user_settings AS us ON (us.user_id = users_id AND us.get_post)
-					WHERE ps.is_draft = false AND (ps.type_awards is null OR ps.type_awards = sb.awards_id OR ps.type_awards IN
+					WHERE ps.is_draft = false AND sb.status = true AND greatest(sb.paid_until, sb.grace_until) > now()
+					AND (ps.type_awards is null OR ps.type_awards = sb.awards_id OR ps.type_awards IN
 			 		(SELECT awa.awards_id FROM restapi_dev.public.parents_awards AS awa WHERE awa.parent_id = sb.awards_id))
 	`
 	checkCreatorForGetSubPushQuery = `SELECT get_sub FROM user_settings WHERE user_id = $1`
 
 	checkCreatorForGetCommentPushQuery = `SELECT get_comment FROM user_settings WHERE user_id = $1`
 
-	getAwardsInfoAndCreatorIdAndUserIdFromPaymentsQuery = `SELECT p.creator_id, p.awards_id, a.name, p.users_id FROM payments as p 
-												 JOIN awards a on p.awards_id = a.awards_id WHERE pay_token = $1`
+	getAwardsInfoAndCreatorIdAndUserIdFromPaymentsQuery = `SELECT p.creator_id, COALESCE(p.awards_id, 0), COALESCE(a.name, ''), p.users_id 
+												 FROM payments as p LEFT JOIN awards a on p.awards_id = a.awards_id WHERE pay_token = $1`
+
+	getGiftInfoQuery = `SELECT g.payer_id, COALESCE(g.recipient_id, 0), g.creator_id, g.awards_id, a.name, g.periods 
+						FROM gifts as g JOIN awards a on g.awards_id = a.awards_id WHERE g.gifts_id = $1`
+
+	getTipInfoQuery = `SELECT p.users_id, p.creator_id, COALESCE(t.posts_id, 0), COALESCE(ps.title, ''), p.amount, p.currency, t.message 
+						FROM tips as t JOIN payments p on t.payments_id = p.payments_id 
+						LEFT JOIN posts ps on t.posts_id = ps.posts_id WHERE t.tips_id = $1`
+
+	// award without limit of subscribers has not seats, so it is not found
+	getSeatInfoQuery = `SELECT a.creator_id, a.name, GREATEST(a.max_subscribers - (SELECT count(*) FROM subscribers s 
+						WHERE s.awards_id = a.awards_id AND (s.status = true OR (s.paid_until IS NULL AND s.hold_until > now()))), 0) 
+						FROM awards as a WHERE a.awards_id = $1 AND a.max_subscribers != 0`
+
+	getAwardWaitlistQuery = `SELECT users_id FROM award_waitlist WHERE awards_id = $1 ORDER BY date`
 
 	addPushInfoQuery = `INSERT INTO push_history (users_id, push_type, push) VALUES `
 
@@ -54,11 +69,10 @@ func NewPushRepository(st *sqlx.DB) *PushRepository {
 	}
 }
 
-
 // GetUserNameAndAvatar Errors:
 //		repository.NotFound
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *PushRepository) GetUserNameAndAvatar(userId int64) (nickname string, avatar string, err error) {
 	if err = repo.store.QueryRow(getUserNameAndAvatarQuery, userId).Scan(&nickname, &avatar); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -72,8 +86,8 @@ func (repo *PushRepository) GetUserNameAndAvatar(userId int64) (nickname string,
 
 // GetCreatorNameAndAvatar Errors:
 //		repository.NotFound
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *PushRepository) GetCreatorNameAndAvatar(creatorId int64) (nickname string, avatar string, err error) {
 	if err = repo.store.QueryRow(getCreatorNameAndAvatarQuery, creatorId).Scan(&nickname, &avatar); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -87,8 +101,8 @@ func (repo *PushRepository) GetCreatorNameAndAvatar(creatorId int64) (nickname s
 
 // GetAwardsNameAndPrice Errors:
 //		repository.NotFound
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *PushRepository) GetAwardsNameAndPrice(awardsId int64) (name string, price int64, err error) {
 	if err = repo.store.QueryRow(getAwardsNameAndPriceQuery, awardsId).Scan(&name, &price); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -102,8 +116,8 @@ func (repo *PushRepository) GetAwardsNameAndPrice(awardsId int64) (name string,
 
 // GetCreatorPostAndTitle Errors:
 //		repository.NotFound
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *PushRepository) GetCreatorPostAndTitle(postId int64) (int64, string, error) {
 	var creatorId int64
 	var title string
@@ -119,8 +133,8 @@ func (repo *PushRepository) GetCreatorPostAndTitle(postId int64) (int64, string,
 
 // GetSubUserForPushPost Errors:
 //		repository.NotFound
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *PushRepository) GetSubUserForPushPost(postId int64) ([]int64, error) {
 	var res []int64
 	row, err := repo.store.Query(getSubUserForPushPostQuery, postId)
@@ -146,8 +160,8 @@ func (repo *PushRepository) GetSubUserForPushPost(postId int64) ([]int64, error)
 
 // CheckCreatorForGetSubPush Errors:
 //		repository.NotFound
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *PushRepository) CheckCreatorForGetSubPush(creatorId int64) (bool, error) {
 	var enable bool
 	if err := repo.store.QueryRow(checkCreatorForGetSubPushQuery, creatorId).Scan(&enable); err != nil {
@@ -162,8 +176,8 @@ func (repo *PushRepository) CheckCreatorForGetSubPush(creatorId int64) (bool, er
 
 // CheckCreatorForGetCommentPush Errors:
 //		repository.NotFound
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *PushRepository) CheckCreatorForGetCommentPush(creatorId int64) (bool, error) {
 	var enable bool
 	if err := repo.store.QueryRow(checkCreatorForGetCommentPushQuery, creatorId).Scan(&enable); err != nil {
@@ -178,8 +192,8 @@ func (repo *PushRepository) CheckCreatorForGetCommentPush(creatorId int64) (bool
 
 // GetAwardsInfoAndCreatorIdAndUserIdFromPayments Errors:
 //		repository.NotFound
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *PushRepository) GetAwardsInfoAndCreatorIdAndUserIdFromPayments(token string) (*PaymentsInfo, error) {
 	res := &PaymentsInfo{}
 	if err := repo.store.QueryRow(getAwardsInfoAndCreatorIdAndUserIdFromPaymentsQuery, token).
@@ -193,9 +207,87 @@ func (repo *PushRepository) GetAwardsInfoAndCreatorIdAndUserIdFromPayments(token
 	return res, nil
 }
 
+// GetGiftInfo Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PushRepository) GetGiftInfo(giftId int64) (*GiftInfo, error) {
+	res := &GiftInfo{}
+	if err := repo.store.QueryRow(getGiftInfoQuery, giftId).
+		Scan(&res.PayerId, &res.RecipientId, &res.CreatorId, &res.AwardsId, &res.AwardsName, &res.Periods); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.NotFound
+		}
+		return nil, repository.NewDBError(err)
+	}
+
+	return res, nil
+}
+
+// GetTipInfo Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PushRepository) GetTipInfo(tipId int64) (*TipInfo, error) {
+	res := &TipInfo{}
+	if err := repo.store.QueryRow(getTipInfoQuery, tipId).
+		Scan(&res.UserId, &res.CreatorId, &res.PostId, &res.PostTitle, &res.Amount, &res.Currency, &res.Message); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.NotFound
+		}
+		return nil, repository.NewDBError(err)
+	}
+
+	return res, nil
+}
+
+// GetSeatInfo Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PushRepository) GetSeatInfo(awardsId int64) (*SeatInfo, error) {
+	res := &SeatInfo{}
+	if err := repo.store.QueryRow(getSeatInfoQuery, awardsId).
+		Scan(&res.CreatorId, &res.AwardsName, &res.SeatsLeft); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.NotFound
+		}
+		return nil, repository.NewDBError(err)
+	}
+
+	return res, nil
+}
+
+// GetAwardWaitlist return users waiting for seat of award, the earliest first
+// Errors:
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (repo *PushRepository) GetAwardWaitlist(awardsId int64) ([]int64, error) {
+	var res []int64
+	row, err := repo.store.Query(getAwardWaitlistQuery, awardsId)
+	if err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	for row.Next() {
+		var userId int64
+		if err = row.Scan(&userId); err != nil {
+			_ = row.Close()
+			return nil, repository.NewDBError(err)
+		}
+		res = append(res, userId)
+	}
+
+	if err = row.Err(); err != nil {
+		return nil, repository.NewDBError(err)
+	}
+
+	return res, nil
+}
+
 // AddPushInfo Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *PushRepository) AddPushInfo(userId []int64, pushType string, push interface{}) error {
 	var argsString []string
 	var args []interface{}
@@ -226,8 +318,8 @@ func (repo *PushRepository) AddPushInfo(userId []int64, pushType string, push in
 }
 
 // GetPushInfo Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *PushRepository) GetPushInfo(userId int64) ([]Push, error) {
 	var res []Push
 	row, err := repo.store.Query(getPushInfoQuery, userId)
@@ -258,8 +350,8 @@ func (repo *PushRepository) GetPushInfo(userId int64) ([]Push, error) {
 
 // MarkViewed Errors:
 //		repository.NotModify
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (repo *PushRepository) MarkViewed(pushId int64, userId int64) error {
 	if res, err := repo.store.Exec(markViewedQuery, pushId, userId); err != nil {
 		return repository.NewDBError(err)
@@ -273,4 +365,4 @@ func (repo *PushRepository) MarkViewed(pushId int64, userId int64) error {
 		}
 	}
 	return nil
-}
\ No newline at end of file
+}
@@ -621,6 +621,234 @@ func (s *SuitePushRepository) TestPushRepository_GetCreatorNameAndAvatar() {
 	}
 }
 
+func (s *SuitePushRepository) TestPushRepository_GetGiftInfo() {
+	runFunc := func(input ...interface{}) (res []interface{}) {
+		values, err := s.repo.GetGiftInfo(input[0].(int64))
+		return []interface{}{values, err}
+	}
+	id := int64(1)
+	info := &GiftInfo{PayerId: 2, RecipientId: 3, CreatorId: 4, AwardsId: 5, AwardsName: "dore", Periods: 3}
+
+	testings := []models.TestCase{
+		{
+			Name: "Correct",
+			Args: []interface{}{id},
+			Expected: models.TestExpected{
+				HaveError:       true,
+				ExpectedErr:     nil,
+				ExpectedReturns: []interface{}{info},
+			},
+			RunFunc: runFunc,
+			Queries: []models.TestQuery{
+				{
+					Query: getGiftInfoQuery,
+					Err:   nil,
+					Rows: &models.TestRow{
+						ReturnRows: sqlmock.NewRows([]string{"payer_id", "recipient_id", "creator_id",
+							"awards_id", "name", "periods"}).
+							AddRow(info.PayerId, info.RecipientId, info.CreatorId, info.AwardsId,
+								info.AwardsName, info.Periods),
+					},
+					RunType: models.Query,
+					Args:    []driver.Value{id},
+				},
+			},
+		},
+		{
+			Name: "NotFound",
+			Args: []interface{}{id},
+			Expected: models.TestExpected{
+				HaveError:       true,
+				ExpectedErr:     repository.NotFound,
+				ExpectedReturns: []interface{}{(*GiftInfo)(nil)},
+			},
+			RunFunc: runFunc,
+			Queries: []models.TestQuery{
+				{
+					Query:   getGiftInfoQuery,
+					Err:     sql.ErrNoRows,
+					RunType: models.Query,
+					Args:    []driver.Value{id},
+				},
+			},
+		},
+	}
+
+	for _, test := range testings {
+		s.RunTestCase(test)
+	}
+}
+
+func (s *SuitePushRepository) TestPushRepository_GetTipInfo() {
+	runFunc := func(input ...interface{}) (res []interface{}) {
+		values, err := s.repo.GetTipInfo(input[0].(int64))
+		return []interface{}{values, err}
+	}
+	id := int64(1)
+	info := &TipInfo{UserId: 2, CreatorId: 4, PostId: 5, PostTitle: "dore", Amount: models.NewDecimal(150),
+		Currency: models.DefaultCurrency, Message: "thanks"}
+
+	testings := []models.TestCase{
+		{
+			Name: "Correct",
+			Args: []interface{}{id},
+			Expected: models.TestExpected{
+				HaveError:       true,
+				ExpectedErr:     nil,
+				ExpectedReturns: []interface{}{info},
+			},
+			RunFunc: runFunc,
+			Queries: []models.TestQuery{
+				{
+					Query: getTipInfoQuery,
+					Err:   nil,
+					Rows: &models.TestRow{
+						ReturnRows: sqlmock.NewRows([]string{"users_id", "creator_id", "posts_id",
+							"title", "amount", "currency", "message"}).
+							AddRow(info.UserId, info.CreatorId, info.PostId, info.PostTitle,
+								info.Amount, info.Currency, info.Message),
+					},
+					RunType: models.Query,
+					Args:    []driver.Value{id},
+				},
+			},
+		},
+		{
+			Name: "NotFound",
+			Args: []interface{}{id},
+			Expected: models.TestExpected{
+				HaveError:       true,
+				ExpectedErr:     repository.NotFound,
+				ExpectedReturns: []interface{}{(*TipInfo)(nil)},
+			},
+			RunFunc: runFunc,
+			Queries: []models.TestQuery{
+				{
+					Query:   getTipInfoQuery,
+					Err:     sql.ErrNoRows,
+					RunType: models.Query,
+					Args:    []driver.Value{id},
+				},
+			},
+		},
+	}
+
+	for _, test := range testings {
+		s.RunTestCase(test)
+	}
+}
+
+func (s *SuitePushRepository) TestPushRepository_GetSeatInfo() {
+	runFunc := func(input ...interface{}) (res []interface{}) {
+		values, err := s.repo.GetSeatInfo(input[0].(int64))
+		return []interface{}{values, err}
+	}
+	id := int64(1)
+	info := &SeatInfo{CreatorId: 4, AwardsName: "dore", SeatsLeft: 1}
+
+	testings := []models.TestCase{
+		{
+			Name: "Correct",
+			Args: []interface{}{id},
+			Expected: models.TestExpected{
+				HaveError:       true,
+				ExpectedErr:     nil,
+				ExpectedReturns: []interface{}{info},
+			},
+			RunFunc: runFunc,
+			Queries: []models.TestQuery{
+				{
+					Query: getSeatInfoQuery,
+					Err:   nil,
+					Rows: &models.TestRow{
+						ReturnRows: sqlmock.NewRows([]string{"creator_id", "name", "seats_left"}).
+							AddRow(info.CreatorId, info.AwardsName, info.SeatsLeft),
+					},
+					RunType: models.Query,
+					Args:    []driver.Value{id},
+				},
+			},
+		},
+		{
+			Name: "NotFound",
+			Args: []interface{}{id},
+			Expected: models.TestExpected{
+				HaveError:       true,
+				ExpectedErr:     repository.NotFound,
+				ExpectedReturns: []interface{}{(*SeatInfo)(nil)},
+			},
+			RunFunc: runFunc,
+			Queries: []models.TestQuery{
+				{
+					Query:   getSeatInfoQuery,
+					Err:     sql.ErrNoRows,
+					RunType: models.Query,
+					Args:    []driver.Value{id},
+				},
+			},
+		},
+	}
+
+	for _, test := range testings {
+		s.RunTestCase(test)
+	}
+}
+
+func (s *SuitePushRepository) TestPushRepository_GetAwardWaitlist() {
+	runFunc := func(input ...interface{}) (res []interface{}) {
+		values, err := s.repo.GetAwardWaitlist(input[0].(int64))
+		return []interface{}{values, err}
+	}
+	id := int64(1)
+
+	testings := []models.TestCase{
+		{
+			Name: "Correct",
+			Args: []interface{}{id},
+			Expected: models.TestExpected{
+				HaveError:       true,
+				ExpectedErr:     nil,
+				ExpectedReturns: []interface{}{[]int64{2, 3}},
+			},
+			RunFunc: runFunc,
+			Queries: []models.TestQuery{
+				{
+					Query: getAwardWaitlistQuery,
+					Err:   nil,
+					Rows: &models.TestRow{
+						ReturnRows: sqlmock.NewRows([]string{"users_id"}).
+							AddRow(2).AddRow(3),
+					},
+					RunType: models.Query,
+					Args:    []driver.Value{id},
+				},
+			},
+		},
+		{
+			Name: "Err",
+			Args: []interface{}{id},
+			Expected: models.TestExpected{
+				HaveError:       true,
+				ExpectedErr:     repository.NewDBError(repository.DefaultErrDB),
+				ExpectedReturns: []interface{}{[]int64(nil)},
+			},
+			RunFunc: runFunc,
+			Queries: []models.TestQuery{
+				{
+					Query:   getAwardWaitlistQuery,
+					Err:     repository.DefaultErrDB,
+					RunType: models.Query,
+					Args:    []driver.Value{id},
+				},
+			},
+		},
+	}
+
+	for _, test := range testings {
+		s.RunTestCase(test)
+	}
+}
+
 func TestAttachesRepository(t *testing.T) {
 	suite.Run(t, new(SuitePushRepository))
 }
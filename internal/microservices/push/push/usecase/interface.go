@@ -25,6 +25,30 @@ type Usecase interface {
 	// 			repository.DefaultErrDB
 	PreparePaymentsPush(info *push.PaymentApply) ([]int64, *push_models.PaymentApplyPush, error)
 
+	// PrepareRenewalPush with Errors:
+	//		repository.NotFound
+	// 		app.GeneralError with Errors:
+	// 			repository.DefaultErrDB
+	PrepareRenewalPush(info *push.RenewalInfo) ([]int64, *push_models.RenewalPush, error)
+
+	// PrepareGiftPush with Errors:
+	//		repository.NotFound
+	// 		app.GeneralError with Errors:
+	// 			repository.DefaultErrDB
+	PrepareGiftPush(info *push.GiftInfo) ([]int64, *push_models.GiftPush, error)
+
+	// PrepareTipPush with Errors:
+	//		repository.NotFound
+	// 		app.GeneralError with Errors:
+	// 			repository.DefaultErrDB
+	PrepareTipPush(info *push.TipInfo) ([]int64, *push_models.TipPush, error)
+
+	// PrepareSeatPush with Errors:
+	//		repository.NotFound
+	// 		app.GeneralError with Errors:
+	// 			repository.DefaultErrDB
+	PrepareSeatPush(info *push.SeatInfo) ([]int64, *push_models.SeatPush, error)
+
 	// AddPushInfo Errors:
 	// 		app.GeneralError with Errors:
 	// 			repository.DefaultErrDB
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"github.com/pkg/errors"
+	rp "patreon/internal/app/repository"
 	"patreon/internal/microservices/push"
 	"patreon/internal/microservices/push/push"
 	"patreon/internal/microservices/push/push/repository"
@@ -19,8 +20,8 @@ func NewPushUsecase(repository repository.Repository) *PushUsecase {
 
 // PreparePostPush with Errors:
 //		repository.NotFound
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (usecase *PushUsecase) PreparePostPush(info *push.PostInfo) ([]int64, *push_models.PostPush, error) {
 	result := &push_models.PostPush{
 		PostId:    info.PostId,
@@ -42,8 +43,8 @@ func (usecase *PushUsecase) PreparePostPush(info *push.PostInfo) ([]int64, *push
 
 // PrepareCommentPush with Errors:
 //		repository.NotFound
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (usecase *PushUsecase) PrepareCommentPush(info *push.CommentInfo) ([]int64, *push_models.CommentPush, error) {
 	result := &push_models.CommentPush{
 		CommentId: info.CommentId,
@@ -80,8 +81,8 @@ func (usecase *PushUsecase) PrepareCommentPush(info *push.CommentInfo) ([]int64,
 
 // PreparePaymentsPush with Errors:
 //		repository.NotFound
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (usecase *PushUsecase) PreparePaymentsPush(info *push.PaymentApply) ([]int64, *push_models.PaymentApplyPush, error) {
 	result := &push_models.PaymentApplyPush{}
 
@@ -103,24 +104,156 @@ func (usecase *PushUsecase) PreparePaymentsPush(info *push.PaymentApply) ([]int6
 	return []int64{payment.UserId}, result, err
 }
 
+// PrepareRenewalPush with Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *PushUsecase) PrepareRenewalPush(info *push.RenewalInfo) ([]int64, *push_models.RenewalPush, error) {
+	result := &push_models.RenewalPush{
+		Token:     info.Token,
+		PaidUntil: info.PaidUntil,
+	}
+
+	payment, err := usecase.repository.GetAwardsInfoAndCreatorIdAndUserIdFromPayments(info.Token)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "Get renewal payment info")
+	}
+
+	result.AwardsId = payment.AwardsId
+	result.AwardsName = payment.AwardsName
+
+	nickname, avatar, err := usecase.repository.GetCreatorNameAndAvatar(payment.CreatorId)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "Get creator info")
+	}
+	result.CreatorId = payment.CreatorId
+	result.CreatorNickname = nickname
+	result.CreatorAvatar = avatar
+	return []int64{payment.UserId}, result, nil
+}
+
+// PrepareGiftPush with Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *PushUsecase) PrepareGiftPush(info *push.GiftInfo) ([]int64, *push_models.GiftPush, error) {
+	result := &push_models.GiftPush{GiftId: info.GiftId}
+
+	gift, err := usecase.repository.GetGiftInfo(info.GiftId)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "Get gift info")
+	}
+	if gift.RecipientId == 0 {
+		return []int64{}, result, nil
+	}
+
+	result.AwardsId = gift.AwardsId
+	result.AwardsName = gift.AwardsName
+	result.Periods = gift.Periods
+
+	nickname, avatar, err := usecase.repository.GetUserNameAndAvatar(gift.PayerId)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "Get payer info")
+	}
+	result.PayerId = gift.PayerId
+	result.PayerNickname = nickname
+	result.PayerAvatar = avatar
+
+	nickname, avatar, err = usecase.repository.GetCreatorNameAndAvatar(gift.CreatorId)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "Get creator info")
+	}
+	result.CreatorId = gift.CreatorId
+	result.CreatorNickname = nickname
+	result.CreatorAvatar = avatar
+	return []int64{gift.RecipientId}, result, nil
+}
+
+// PrepareTipPush push with tip message is sent to creator, creator id is same as his user id
+// Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *PushUsecase) PrepareTipPush(info *push.TipInfo) ([]int64, *push_models.TipPush, error) {
+	tip, err := usecase.repository.GetTipInfo(info.TipId)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "Get tip info")
+	}
+	result := &push_models.TipPush{
+		TipId:     info.TipId,
+		PostId:    tip.PostId,
+		PostTitle: tip.PostTitle,
+		Amount:    tip.Amount,
+		Currency:  tip.Currency,
+		Message:   tip.Message,
+	}
+
+	nickname, avatar, err := usecase.repository.GetUserNameAndAvatar(tip.UserId)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "Get tipper info")
+	}
+	result.UserId = tip.UserId
+	result.UserNickname = nickname
+	result.UserAvatar = avatar
+	return []int64{tip.CreatorId}, result, nil
+}
+
+// PrepareSeatPush push about free seat of limited award is sent to users from its waitlist.
+// Nobody is notified if award is not limited or seat was already taken again
+// Errors:
+//		repository.NotFound
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
+func (usecase *PushUsecase) PrepareSeatPush(info *push.SeatInfo) ([]int64, *push_models.SeatPush, error) {
+	seat, err := usecase.repository.GetSeatInfo(info.AwardsId)
+	if errors.Is(err, rp.NotFound) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "Get seat info")
+	}
+	if seat.SeatsLeft == 0 {
+		return nil, nil, nil
+	}
+
+	users, err := usecase.repository.GetAwardWaitlist(info.AwardsId)
+	if err != nil || len(users) == 0 {
+		return nil, nil, err
+	}
+
+	result := &push_models.SeatPush{
+		CreatorId:  seat.CreatorId,
+		AwardsId:   info.AwardsId,
+		AwardsName: seat.AwardsName,
+		SeatsLeft:  seat.SeatsLeft,
+	}
+	nickname, avatar, err := usecase.repository.GetCreatorNameAndAvatar(seat.CreatorId)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "Get creator info")
+	}
+	result.CreatorNickname = nickname
+	result.CreatorAvatar = avatar
+	return users, result, nil
+}
+
 // AddPushInfo Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (usecase *PushUsecase) AddPushInfo(userId []int64, pushType string, push interface{}) error {
 	return usecase.repository.AddPushInfo(userId, pushType, push)
 }
 
 // GetPushInfo Errors:
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (usecase *PushUsecase) GetPushInfo(userId int64) ([]repository.Push, error) {
 	return usecase.repository.GetPushInfo(userId)
 }
 
 // MarkViewed Errors:
 //		repository.NotModify
-// 		app.GeneralError with Errors:
-// 			repository.DefaultErrDB
+//		app.GeneralError with Errors:
+//			repository.DefaultErrDB
 func (usecase *PushUsecase) MarkViewed(pushId int64, userId int64) error {
 	return usecase.repository.MarkViewed(pushId, userId)
 }
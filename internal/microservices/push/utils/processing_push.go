@@ -106,6 +106,42 @@ func (pp *ProcessingPush) RunProcessPayment() {
 	pp.processPayment(msg)
 }
 
+func (pp *ProcessingPush) RunProcessRenewal() {
+	msg, err := pp.initMsg(push.RenewalPush)
+	if err != nil {
+		pp.logger.Errorf("error init renewal query from msg with err: %s", err)
+		return
+	}
+	pp.processRenewal(msg)
+}
+
+func (pp *ProcessingPush) RunProcessGift() {
+	msg, err := pp.initMsg(push.GiftPush)
+	if err != nil {
+		pp.logger.Errorf("error init gift query from msg with err: %s", err)
+		return
+	}
+	pp.processGift(msg)
+}
+
+func (pp *ProcessingPush) RunProcessTip() {
+	msg, err := pp.initMsg(push.TipPush)
+	if err != nil {
+		pp.logger.Errorf("error init tip query from msg with err: %s", err)
+		return
+	}
+	pp.processTip(msg)
+}
+
+func (pp *ProcessingPush) RunProcessSeat() {
+	msg, err := pp.initMsg(push.SeatPush)
+	if err != nil {
+		pp.logger.Errorf("error init seat query from msg with err: %s", err)
+		return
+	}
+	pp.processSeat(msg)
+}
+
 func (pp *ProcessingPush) processPostMsg(msg <-chan amqp.Delivery) {
 	for {
 		var pushMsg amqp.Delivery
@@ -188,3 +224,121 @@ func (pp *ProcessingPush) processPayment(msg <-chan amqp.Delivery) {
 		pp.sendMsg.SendMessage(users, PushResponse{Type: push.PaymentPush, Push: sendPush})
 	}
 }
+
+func (pp *ProcessingPush) processRenewal(msg <-chan amqp.Delivery) {
+	for {
+		var pushMsg amqp.Delivery
+		select {
+		case <-pp.stop:
+			return
+		case pushMsg = <-msg:
+			break
+		}
+
+		renewal := &push.RenewalInfo{}
+		reader := bytes.NewBuffer(pushMsg.Body)
+		if err := easyjson.UnmarshalFromReader(reader, renewal); err != nil {
+			pp.logger.Errorf("error decode info renewal from msg with err: %s", err)
+			continue
+		}
+
+		users, sendPush, err := pp.usecase.PrepareRenewalPush(renewal)
+		if err != nil {
+			pp.logger.Errorf("error prepare info renewal with err: %s %v", err, renewal)
+			continue
+		}
+		pp.logger.Infof("Was send message about renewal due %v", pushMsg.Body)
+		pp.saveHistory(users, push.RenewalPush, sendPush)
+		pp.sendMsg.SendMessage(users, PushResponse{Type: push.RenewalPush, Push: sendPush})
+	}
+}
+
+func (pp *ProcessingPush) processGift(msg <-chan amqp.Delivery) {
+	for {
+		var pushMsg amqp.Delivery
+		select {
+		case <-pp.stop:
+			return
+		case pushMsg = <-msg:
+			break
+		}
+
+		gift := &push.GiftInfo{}
+		reader := bytes.NewBuffer(pushMsg.Body)
+		if err := easyjson.UnmarshalFromReader(reader, gift); err != nil {
+			pp.logger.Errorf("error decode info gift from msg with err: %s", err)
+			continue
+		}
+
+		users, sendPush, err := pp.usecase.PrepareGiftPush(gift)
+		if err != nil {
+			pp.logger.Errorf("error prepare info gift with err: %s %v", err, gift)
+			continue
+		}
+		if len(users) == 0 {
+			continue
+		}
+		pp.logger.Infof("Was send message about received gift %v", pushMsg.Body)
+		pp.saveHistory(users, push.GiftPush, sendPush)
+		pp.sendMsg.SendMessage(users, PushResponse{Type: push.GiftPush, Push: sendPush})
+	}
+}
+
+func (pp *ProcessingPush) processTip(msg <-chan amqp.Delivery) {
+	for {
+		var pushMsg amqp.Delivery
+		select {
+		case <-pp.stop:
+			return
+		case pushMsg = <-msg:
+			break
+		}
+
+		tip := &push.TipInfo{}
+		reader := bytes.NewBuffer(pushMsg.Body)
+		if err := easyjson.UnmarshalFromReader(reader, tip); err != nil {
+			pp.logger.Errorf("error decode info tip from msg with err: %s", err)
+			continue
+		}
+
+		users, sendPush, err := pp.usecase.PrepareTipPush(tip)
+		if err != nil {
+			pp.logger.Errorf("error prepare info tip with err: %s %v", err, tip)
+			continue
+		}
+		pp.logger.Infof("Was send message about received tip %v", pushMsg.Body)
+		pp.saveHistory(users, push.TipPush, sendPush)
+		pp.sendMsg.SendMessage(users, PushResponse{Type: push.TipPush, Push: sendPush})
+	}
+}
+
+func (pp *ProcessingPush) processSeat(msg <-chan amqp.Delivery) {
+	for {
+		var pushMsg amqp.Delivery
+		select {
+		case <-pp.stop:
+			return
+		case pushMsg = <-msg:
+			break
+		}
+
+		seat := &push.SeatInfo{}
+		reader := bytes.NewBuffer(pushMsg.Body)
+		if err := easyjson.UnmarshalFromReader(reader, seat); err != nil {
+			pp.logger.Errorf("error decode info seat from msg with err: %s", err)
+			continue
+		}
+
+		users, sendPush, err := pp.usecase.PrepareSeatPush(seat)
+		if err != nil {
+			pp.logger.Errorf("error prepare info seat with err: %s %v", err, seat)
+			continue
+		}
+		if len(users) == 0 {
+			continue
+		}
+		pp.logger.Infof("Was send message about free seat %v", pushMsg.Body)
+		pp.saveHistory(users, push.SeatPush, sendPush)
+		pp.sendMsg.SendMessage(users, PushResponse{Type: push.SeatPush, Push: sendPush})
+	}
+}
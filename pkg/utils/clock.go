@@ -0,0 +1,14 @@
+package utils
+
+import "time"
+
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock return current time, used everywhere except tests
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
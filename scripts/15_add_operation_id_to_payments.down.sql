@@ -0,0 +1,2 @@
+alter table payments
+    drop column operation_id;
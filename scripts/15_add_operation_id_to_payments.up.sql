@@ -0,0 +1,2 @@
+alter table payments
+    add column operation_id text unique;
@@ -0,0 +1,4 @@
+alter table subscribers
+    drop column period,
+    drop column paid_until,
+    drop column grace_until;
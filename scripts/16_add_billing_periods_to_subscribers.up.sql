@@ -0,0 +1,8 @@
+alter table subscribers
+    add column period      smallint not null default 1,
+    add column paid_until  timestamptz,
+    add column grace_until timestamptz;
+
+update subscribers
+set paid_until = now()::timestamptz + interval '1 month'
+where status = true;
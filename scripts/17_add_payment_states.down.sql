@@ -0,0 +1,11 @@
+drop table payment_events;
+
+alter table payments
+    add column status bool not null default false;
+
+update payments
+set status = true
+where state in ('succeeded', 'refunded', 'partially_refunded');
+
+alter table payments
+    drop column state;
@@ -0,0 +1,22 @@
+alter table payments
+    add column state text not null default 'created'
+        check (state in ('created', 'pending', 'succeeded', 'failed', 'expired', 'refunded', 'partially_refunded'));
+
+update payments
+set state = 'succeeded'
+where status = true;
+
+alter table payments
+    drop column status;
+
+CREATE TABLE payment_events
+(
+    id          bigserial                              not null primary key,
+    payments_id bigint                                 not null references payments (payments_id) on delete cascade,
+    from_state  text                                   not null,
+    to_state    text                                   not null,
+    reason      text                                   not null default '',
+    date        timestamptz default now()::timestamptz not null
+);
+
+CREATE INDEX payment_events_payments_id_idx ON payment_events (payments_id);
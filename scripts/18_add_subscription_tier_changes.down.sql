@@ -0,0 +1,4 @@
+drop table subscription_changes;
+
+alter table subscribers
+    drop column next_awards_id;
@@ -0,0 +1,20 @@
+alter table subscribers
+    add column next_awards_id bigint references awards (awards_id) on delete set null;
+
+CREATE TABLE subscription_changes
+(
+    id             bigserial                              not null primary key,
+    subscribers_id bigint                                 not null references subscribers (id) on delete cascade,
+    from_awards_id bigint                                 not null references awards (awards_id) on delete cascade,
+    to_awards_id   bigint                                 not null references awards (awards_id) on delete cascade,
+    kind           text                                   not null check (kind in ('upgrade', 'downgrade')),
+    status         text                                   not null default 'pending'
+        check (status in ('pending', 'applied', 'cancelled')),
+    amount         bigint                                 not null default 0,
+    payments_id    bigint references payments (payments_id) on delete set null,
+    effective_at   timestamptz                            not null,
+    date           timestamptz default now()::timestamptz not null
+);
+
+CREATE INDEX subscription_changes_subscribers_id_idx ON subscription_changes (subscribers_id);
+CREATE INDEX subscription_changes_payments_id_idx ON subscription_changes (payments_id);
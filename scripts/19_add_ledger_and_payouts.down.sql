@@ -0,0 +1,5 @@
+drop table ledger_entries;
+drop table payouts;
+
+alter table payments
+    drop column refunded_amount;
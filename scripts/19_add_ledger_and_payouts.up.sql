@@ -0,0 +1,51 @@
+alter table payments
+    add column refunded_amount bigint not null default 0;
+
+update payments
+set refunded_amount = amount::bigint
+where state = 'refunded';
+
+CREATE TABLE payouts
+(
+    payouts_id bigserial                              not null primary key,
+    creator_id bigint                                 not null references creator_profile (creator_id) on delete cascade,
+    amount     bigint                                 not null check (amount > 0),
+    state      text                                   not null default 'pending'
+        check (state in ('pending', 'approved', 'paid', 'rejected')),
+    date       timestamptz default now()::timestamptz not null,
+    updated    timestamptz default now()::timestamptz not null
+);
+
+CREATE INDEX payouts_creator_id_idx ON payouts (creator_id);
+
+-- every operation is recorded by postings with zero sum:
+-- payer - money received from users, creator - creator balance,
+-- platform - platform fee, payout - money sent to creators
+CREATE TABLE ledger_entries
+(
+    id          bigserial                              not null primary key,
+    creator_id  bigint                                 not null references creator_profile (creator_id) on delete cascade,
+    operation   text                                   not null
+        check (operation in ('payment', 'refund', 'payout', 'payout_rejected')),
+    account     text                                   not null check (account in ('payer', 'creator', 'platform', 'payout')),
+    amount      bigint                                 not null,
+    payments_id bigint references payments (payments_id) on delete set null,
+    payouts_id  bigint references payouts (payouts_id) on delete set null,
+    date        timestamptz default now()::timestamptz not null
+);
+
+CREATE INDEX ledger_entries_creator_id_idx ON ledger_entries (creator_id, account);
+CREATE INDEX ledger_entries_payments_id_idx ON ledger_entries (payments_id);
+
+-- payments received before ledger was introduced were taken without platform fee
+INSERT INTO ledger_entries (creator_id, operation, account, amount, payments_id, date)
+SELECT p.creator_id, 'payment', acc.account, acc.sign * p.amount::bigint, p.payments_id, p.date
+FROM payments p
+         CROSS JOIN (VALUES ('payer', -1), ('creator', 1)) AS acc(account, sign)
+WHERE p.state in ('succeeded', 'refunded', 'partially_refunded');
+
+INSERT INTO ledger_entries (creator_id, operation, account, amount, payments_id, date)
+SELECT p.creator_id, 'refund', acc.account, acc.sign * p.refunded_amount, p.payments_id, p.date
+FROM payments p
+         CROSS JOIN (VALUES ('payer', 1), ('creator', -1)) AS acc(account, sign)
+WHERE p.refunded_amount > 0;
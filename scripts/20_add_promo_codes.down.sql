@@ -0,0 +1,7 @@
+alter table payments
+    drop column promo_codes_id,
+    drop column discount;
+
+drop table promo_code_uses;
+drop table promo_code_awards;
+drop table promo_codes;
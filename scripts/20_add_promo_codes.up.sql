@@ -0,0 +1,36 @@
+CREATE TABLE promo_codes
+(
+    promo_codes_id bigserial                              not null primary key,
+    creator_id     bigint                                 not null references creator_profile (creator_id) on delete cascade,
+    code           text                                   not null,
+    discount_type  text                                   not null check (discount_type in ('percent', 'fixed')),
+    discount       bigint                                 not null check (discount > 0),
+    max_uses       bigint                                 not null default 0 check (max_uses >= 0),
+    uses           bigint                                 not null default 0,
+    valid_from     timestamptz default now()::timestamptz not null,
+    valid_until    timestamptz,
+    active         boolean                                not null default true,
+    date           timestamptz default now()::timestamptz not null,
+    unique (creator_id, code)
+);
+
+-- promo code without awards can be used for any award of creator
+CREATE TABLE promo_code_awards
+(
+    promo_codes_id bigint not null references promo_codes (promo_codes_id) on delete cascade,
+    awards_id      bigint not null references awards (awards_id) on delete cascade,
+    primary key (promo_codes_id, awards_id)
+);
+
+CREATE TABLE promo_code_uses
+(
+    promo_codes_id bigint                                 not null references promo_codes (promo_codes_id) on delete cascade,
+    users_id       bigint                                 not null references users (users_id) on delete cascade,
+    payments_id    bigint references payments (payments_id) on delete set null,
+    date           timestamptz default now()::timestamptz not null,
+    primary key (promo_codes_id, users_id)
+);
+
+alter table payments
+    add column promo_codes_id bigint references promo_codes (promo_codes_id) on delete set null,
+    add column discount       bigint not null default 0;
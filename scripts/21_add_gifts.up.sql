@@ -0,0 +1,20 @@
+-- gift is paid by payer, subscription is granted to recipient on redeem.
+-- Gift bought for nickname is redeemed at once when payment succeeds
+CREATE TABLE gifts
+(
+    gifts_id     bigserial                              not null primary key,
+    code         text                                   not null unique,
+    payer_id     bigint                                 not null references users (users_id) on delete cascade,
+    recipient_id bigint references users (users_id) on delete cascade,
+    creator_id   bigint                                 not null references creator_profile (creator_id) on delete cascade,
+    awards_id    bigint                                 not null references awards (awards_id) on delete cascade,
+    periods      smallint                               not null check (periods between 1 and 12),
+    payments_id  bigint                                 not null unique references payments (payments_id) on delete cascade,
+    status       text                                   not null default 'pending'
+        check (status in ('pending', 'paid', 'redeemed')),
+    date         timestamptz default now()::timestamptz not null,
+    redeemed_at  timestamptz
+);
+
+CREATE INDEX gifts_payer_id_idx ON gifts (payer_id);
+CREATE INDEX gifts_recipient_id_idx ON gifts (recipient_id);
@@ -0,0 +1,7 @@
+drop table subscription_trials;
+
+alter table subscribers
+    drop column trial_until;
+
+alter table awards
+    drop column trial_days;
@@ -0,0 +1,22 @@
+alter table awards
+    add column trial_days smallint not null default 0 check (trial_days between 0 and 90);
+
+-- trial_until equals paid_until while subscription was not paid after free trial
+alter table subscribers
+    add column trial_until timestamptz;
+
+-- trial is allowed once for user on creator, row is kept when subscription is removed
+CREATE TABLE subscription_trials
+(
+    id             bigserial                              not null primary key,
+    users_id       bigint                                 not null references users (users_id) on delete cascade,
+    creator_id     bigint                                 not null references creator_profile (creator_id) on delete cascade,
+    awards_id      bigint                                 not null references awards (awards_id) on delete cascade,
+    subscribers_id bigint references subscribers (id) on delete set null,
+    ends_at        timestamptz                            not null,
+    converted_at   timestamptz,
+    date           timestamptz default now()::timestamptz not null,
+    unique (users_id, creator_id)
+);
+
+CREATE INDEX subscription_trials_creator_id_idx ON subscription_trials (creator_id);
@@ -0,0 +1,9 @@
+drop table tips;
+
+delete
+from payments
+where awards_id is null;
+
+alter table payments
+    drop column type,
+    alter column awards_id set not null;
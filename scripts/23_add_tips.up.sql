@@ -0,0 +1,19 @@
+alter table payments
+    add column type text not null default 'subscription'
+        check (type in ('subscription', 'gift', 'tip')),
+    alter column awards_id drop not null;
+
+update payments
+set type = 'gift'
+where payments_id in (select payments_id from gifts);
+
+-- tip is one-time payment to creator or to creator post, payment of tip has not award
+CREATE TABLE tips
+(
+    tips_id     bigserial not null primary key,
+    payments_id bigint    not null unique references payments (payments_id) on delete cascade,
+    posts_id    bigint references posts (posts_id) on delete set null,
+    message     text      not null default ''
+);
+
+CREATE INDEX tips_posts_id_idx ON tips (posts_id);
@@ -0,0 +1,13 @@
+drop table post_unlocks;
+
+delete
+from payments
+where type = 'unlock';
+
+alter table payments
+    drop column posts_id,
+    drop constraint payments_type_check,
+    add constraint payments_type_check check (type in ('subscription', 'gift', 'tip'));
+
+alter table posts
+    drop column unlock_price;
@@ -0,0 +1,21 @@
+-- post with unlock_price can be bought by single payment without subscription on its award
+alter table posts
+    add column unlock_price bigint not null default 0 check (unlock_price >= 0);
+
+alter table payments
+    add column posts_id bigint references posts (posts_id) on delete set null,
+    drop constraint payments_type_check,
+    add constraint payments_type_check check (type in ('subscription', 'gift', 'tip', 'unlock'));
+
+-- row is added only when payment of unlock succeeded
+CREATE TABLE post_unlocks
+(
+    post_unlocks_id bigserial                              not null primary key,
+    posts_id        bigint                                 not null references posts (posts_id) on delete cascade,
+    users_id        bigint                                 not null references users (users_id) on delete cascade,
+    payments_id     bigint                                 not null unique references payments (payments_id) on delete cascade,
+    date            timestamptz default now()::timestamptz not null,
+    unique (posts_id, users_id)
+);
+
+CREATE INDEX post_unlocks_users_id_idx ON post_unlocks (users_id);
@@ -0,0 +1,24 @@
+alter table payouts
+    alter column amount type bigint;
+
+alter table ledger_entries
+    alter column amount type bigint;
+
+alter table subscription_changes
+    alter column amount type bigint;
+
+alter table payments
+    drop column currency,
+    alter column discount type bigint,
+    alter column refunded_amount type bigint,
+    alter column amount type numeric;
+
+alter table posts
+    alter column unlock_price type bigint;
+
+alter table awards
+    drop column currency,
+    alter column price type integer;
+
+alter table creator_profile
+    drop column currency;
@@ -0,0 +1,26 @@
+-- money is stored exactly with two fractional digits,
+-- currency is ISO 4217 code, all prices and balance of creator are in its settlement currency
+alter table creator_profile
+    add column currency text not null default 'RUB' check (currency ~ '^[A-Z]{3}$');
+
+alter table awards
+    alter column price type numeric(12, 2),
+    add column currency text not null default 'RUB';
+
+alter table posts
+    alter column unlock_price type numeric(12, 2);
+
+alter table payments
+    alter column amount type numeric(12, 2),
+    alter column refunded_amount type numeric(12, 2),
+    alter column discount type numeric(12, 2),
+    add column currency text not null default 'RUB';
+
+alter table subscription_changes
+    alter column amount type numeric(12, 2);
+
+alter table ledger_entries
+    alter column amount type numeric(12, 2);
+
+alter table payouts
+    alter column amount type numeric(12, 2);
@@ -0,0 +1,2 @@
+drop index payments_users_id_date_idx;
+drop index payments_creator_id_date_idx;
@@ -0,0 +1,2 @@
+CREATE INDEX payments_creator_id_date_idx ON payments (creator_id, date);
+CREATE INDEX payments_users_id_date_idx ON payments (users_id, date);
@@ -0,0 +1,4 @@
+drop index subscribers_creator_id_idx;
+
+alter table subscribers
+    drop column date;
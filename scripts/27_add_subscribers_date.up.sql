@@ -0,0 +1,15 @@
+alter table subscribers
+    add column date timestamptz default now()::timestamptz;
+
+-- subscription started with the first subscription payment of user on creator
+update subscribers s
+set date = COALESCE((select min(p.date)
+                     from payments p
+                     where p.users_id = s.users_id
+                       and p.creator_id = s.creator_id
+                       and p.type = 'subscription'), s.date);
+
+alter table subscribers
+    alter column date set not null;
+
+CREATE INDEX subscribers_creator_id_idx ON subscribers (creator_id, users_id);
@@ -0,0 +1,22 @@
+-- append-only history of subscriptions, rows stay after subscription is removed
+CREATE TABLE subscription_events
+(
+    id         bigserial                              not null primary key,
+    users_id   bigint                                 not null references users (users_id) on delete cascade,
+    creator_id bigint                                 not null references creator_profile (creator_id) on delete cascade,
+    awards_id  bigint references awards (awards_id) on delete set null,
+    kind       text                                   not null
+        check (kind in ('subscribed', 'renewed', 'tier_changed', 'cancelled', 'expired', 'refunded')),
+    reason     text                                   not null default '',
+    date       timestamptz default now()::timestamptz not null
+);
+
+CREATE INDEX subscription_events_users_id_idx ON subscription_events (users_id, date);
+CREATE INDEX subscription_events_creator_id_idx ON subscription_events (creator_id, date);
+
+-- subscriptions paid before history was kept start it
+insert into subscription_events (users_id, creator_id, awards_id, kind, date)
+select users_id, creator_id, awards_id, 'subscribed', date
+from subscribers
+where status = true
+   or paid_until is not null;
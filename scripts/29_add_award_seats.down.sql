@@ -0,0 +1,9 @@
+drop table award_waitlist;
+
+drop index subscribers_awards_id_idx;
+
+alter table subscribers
+    drop column hold_until;
+
+alter table awards
+    drop column max_subscribers;
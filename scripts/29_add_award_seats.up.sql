@@ -0,0 +1,19 @@
+-- 0 means that count of award subscribers is not limited
+alter table awards
+    add column max_subscribers integer not null default 0 check (max_subscribers >= 0);
+
+-- not paid subscription holds seat of limited award until pay token of its checkout expires
+alter table subscribers
+    add column hold_until timestamptz;
+
+CREATE INDEX subscribers_awards_id_idx ON subscribers (awards_id);
+
+-- users waiting for free seat of limited award, user leaves waitlist when subscribes on award
+CREATE TABLE award_waitlist
+(
+    id        bigserial                              not null primary key,
+    awards_id bigint                                 not null references awards (awards_id) on delete cascade,
+    users_id  bigint                                 not null references users (users_id) on delete cascade,
+    date      timestamptz default now()::timestamptz not null,
+    unique (awards_id, users_id)
+);
@@ -0,0 +1,9 @@
+-- blocked user can not subscribe on creator and comment his posts, his comments are hidden
+CREATE TABLE blocked_users
+(
+    id         bigserial                              not null primary key,
+    creator_id bigint                                 not null references creator_profile (creator_id) on delete cascade,
+    users_id   bigint                                 not null references users (users_id) on delete cascade,
+    date       timestamptz default now()::timestamptz not null,
+    unique (creator_id, users_id)
+);
@@ -0,0 +1,3 @@
+drop index posts_publish_at_idx;
+alter table posts
+    drop column publish_at;
@@ -0,0 +1,4 @@
+-- scheduled post is draft with publish_at, scheduler publishes it when publish_at comes
+ALTER TABLE posts
+    ADD COLUMN publish_at timestamptz;
+CREATE INDEX posts_publish_at_idx ON posts (publish_at) WHERE is_draft and publish_at is not null;
@@ -0,0 +1 @@
+drop table post_revisions;
@@ -0,0 +1,13 @@
+-- snapshot of post content before every change, attaches are json array of {id, type, value, level},
+-- where id is data_id of attach, so restore of revision keeps ids of attaches
+CREATE TABLE post_revisions
+(
+    id          bigserial                              not null primary key,
+    posts_id    bigint                                 not null references posts (posts_id) on delete cascade,
+    title       text                                   not null,
+    description text                                   not null,
+    type_awards bigint                                 null,
+    attaches    jsonb       default '[]'::jsonb        not null,
+    date        timestamptz default now()::timestamptz not null
+);
+CREATE INDEX post_revisions_posts_id_idx ON post_revisions (posts_id);
@@ -0,0 +1,2 @@
+drop table posts_tags;
+drop table tags;
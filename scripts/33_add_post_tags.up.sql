@@ -0,0 +1,16 @@
+-- tags are scoped per creator, so the same name of different creators is different tags
+CREATE TABLE tags
+(
+    tags_id    bigserial not null primary key,
+    creator_id bigint    not null references creator_profile (creator_id) on delete cascade,
+    name       text      not null,
+    unique (creator_id, name)
+);
+
+CREATE TABLE posts_tags
+(
+    posts_id bigint not null references posts (posts_id) on delete cascade,
+    tags_id  bigint not null references tags (tags_id) on delete cascade,
+    primary key (posts_id, tags_id)
+);
+CREATE INDEX posts_tags_tags_id_idx ON posts_tags (tags_id);
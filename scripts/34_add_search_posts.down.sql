@@ -0,0 +1,12 @@
+DROP TRIGGER data_search ON posts_data;
+DROP TRIGGER update_posts_search ON posts;
+DROP TRIGGER insert_posts_search ON posts;
+
+DROP FUNCTION upd_data_search_posts();
+DROP FUNCTION upd_posts_search_posts();
+DROP PROCEDURE update_search_posts();
+DROP FUNCTION refresh_search_posts(bigint);
+
+DROP INDEX idx_rum_search_posts;
+
+DROP TABLE search_posts;
@@ -0,0 +1,118 @@
+-- content is title, description and used text attaches of post, it is kept for building of snippets
+CREATE TABLE search_posts
+(
+    posts_id   bigint   not null primary key references posts (posts_id) on delete cascade,
+    content    text     not null,
+    content_en tsvector not null,
+    content_ru tsvector not null
+);
+
+-- refresh_search_posts does nothing for not existing post, so it is safe to call it while post is deleted
+CREATE FUNCTION refresh_search_posts(refreshed_post bigint)
+    RETURNS void AS
+$$
+BEGIN
+    INSERT INTO search_posts (posts_id, content, content_en, content_ru)
+    SELECT p.posts_id, c.content, to_tsvector('english', c.content), to_tsvector('russian_hunspell', c.content)
+    FROM posts AS p,
+         LATERAL (SELECT concat_ws(' ', p.title, p.description,
+                                   (SELECT string_agg(pd.data, ' ' ORDER BY pd.level)
+                                    FROM posts_data AS pd
+                                             JOIN posts_type AS pt ON pt.posts_type_id = pd.type
+                                    WHERE pd.post_id = p.posts_id
+                                      AND pt.type = 'text'
+                                      AND pd.level != -1)) AS content) AS c
+    WHERE p.posts_id = refreshed_post
+    ON CONFLICT (posts_id) DO UPDATE SET content    = EXCLUDED.content,
+                                         content_en = EXCLUDED.content_en,
+                                         content_ru = EXCLUDED.content_ru;
+END;
+$$
+    LANGUAGE plpgsql;
+
+
+CREATE FUNCTION upd_posts_search_posts()
+    RETURNS trigger AS
+$$
+BEGIN
+    PERFORM refresh_search_posts(NEW.posts_id);
+    RETURN NEW;
+END;
+$$
+    LANGUAGE plpgsql;
+
+CREATE TRIGGER insert_posts_search
+    AFTER INSERT
+    ON posts
+    FOR EACH ROW
+EXECUTE FUNCTION upd_posts_search_posts();
+
+-- views and likes are updated often, so only changes of text refresh search
+CREATE TRIGGER update_posts_search
+    AFTER UPDATE OF title, description
+    ON posts
+    FOR EACH ROW
+EXECUTE FUNCTION upd_posts_search_posts();
+
+
+-- attach can change its type or move to other post on update, so posts of both old and new rows are refreshed
+CREATE FUNCTION upd_data_search_posts()
+    RETURNS trigger AS
+$$
+DECLARE
+    OldText bool = false;
+    NewText bool = false;
+BEGIN
+    IF TG_OP != 'INSERT' THEN
+        OldText = EXISTS(SELECT FROM posts_type WHERE posts_type_id = OLD.type AND type = 'text');
+    END IF;
+    IF TG_OP != 'DELETE' THEN
+        NewText = EXISTS(SELECT FROM posts_type WHERE posts_type_id = NEW.type AND type = 'text');
+    END IF;
+
+    IF TG_OP = 'DELETE' THEN
+        IF OldText THEN
+            PERFORM refresh_search_posts(OLD.post_id);
+        END IF;
+        RETURN OLD;
+    END IF;
+
+    IF TG_OP = 'UPDATE' AND (OldText OR NewText) THEN
+        PERFORM refresh_search_posts(OLD.post_id);
+        IF OLD.post_id != NEW.post_id THEN
+            PERFORM refresh_search_posts(NEW.post_id);
+        END IF;
+    ELSIF TG_OP = 'INSERT' AND NewText THEN
+        PERFORM refresh_search_posts(NEW.post_id);
+    END IF;
+    RETURN NEW;
+END;
+$$
+    LANGUAGE plpgsql;
+
+CREATE TRIGGER data_search
+    AFTER INSERT OR UPDATE OR DELETE
+    ON posts_data
+    FOR EACH ROW
+EXECUTE FUNCTION upd_data_search_posts();
+
+
+CREATE OR REPLACE PROCEDURE update_search_posts()
+AS
+$$
+DECLARE
+    IdPost bigint;
+BEGIN
+    FOR IdPost IN SELECT posts_id FROM posts
+        LOOP
+            PERFORM refresh_search_posts(IdPost);
+        END LOOP;
+END;
+$$
+    LANGUAGE plpgsql;
+
+CALL update_search_posts();
+
+CREATE INDEX idx_rum_search_posts
+    ON search_posts
+        USING RUM (content_en rum_tsvector_ops, content_ru rum_tsvector_ops);
@@ -0,0 +1,3 @@
+-- preview is shown instead of post to users who have no access to it
+ALTER TABLE posts
+    ADD COLUMN preview text default '' not null;
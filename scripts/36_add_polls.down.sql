@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS poll_votes;
+
+-- attaches of poll type are removed by cascade
+DELETE FROM posts_type
+WHERE type = 'poll';
@@ -0,0 +1,14 @@
+INSERT INTO posts_type (type)
+VALUES ('poll');
+
+-- options contain numbers of chosen poll options, user can vote in poll only once
+CREATE TABLE poll_votes
+(
+    data_id  bigint      not null,
+    users_id bigint      not null,
+    options  int[]       not null,
+    date     timestamptz not null default now(),
+    PRIMARY KEY (data_id, users_id),
+    FOREIGN KEY (data_id) REFERENCES posts_data (data_id) ON DELETE CASCADE,
+    FOREIGN KEY (users_id) REFERENCES users (users_id) ON DELETE CASCADE
+);
@@ -0,0 +1,3 @@
+-- period in months paid by subscription payment, subscription removed before payment is restored with it
+ALTER TABLE payments
+    ADD COLUMN period smallint default 1 not null;